@@ -3,6 +3,7 @@ package main
 import (
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -40,14 +41,25 @@ import (
 	notificationwebsocket "gestao-financeira/backend/internal/notification/infrastructure/websocket"
 	notificationhandlers "gestao-financeira/backend/internal/notification/presentation/handlers"
 	notificationroutes "gestao-financeira/backend/internal/notification/presentation/routes"
+	payeeusecases "gestao-financeira/backend/internal/payee/application/usecases"
+	payeepersistence "gestao-financeira/backend/internal/payee/infrastructure/persistence"
+	payeehandlers "gestao-financeira/backend/internal/payee/presentation/handlers"
+	payeeroutes "gestao-financeira/backend/internal/payee/presentation/routes"
 	reportingusecases "gestao-financeira/backend/internal/reporting/application/usecases"
+	reportingpersistence "gestao-financeira/backend/internal/reporting/infrastructure/persistence"
 	reportingservices "gestao-financeira/backend/internal/reporting/infrastructure/services"
 	reporthandlers "gestao-financeira/backend/internal/reporting/presentation/handlers"
 	reportroutes "gestao-financeira/backend/internal/reporting/presentation/routes"
+	"gestao-financeira/backend/internal/shared/domain/storage"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
 	sharedhandlers "gestao-financeira/backend/internal/shared/infrastructure/handlers"
 	sharedpersistence "gestao-financeira/backend/internal/shared/infrastructure/persistence"
+	sharedstorage "gestao-financeira/backend/internal/shared/infrastructure/storage"
 	sharedloghandlers "gestao-financeira/backend/internal/shared/presentation/handlers"
+	tagusecases "gestao-financeira/backend/internal/tag/application/usecases"
+	tagpersistence "gestao-financeira/backend/internal/tag/infrastructure/persistence"
+	taghandlers "gestao-financeira/backend/internal/tag/presentation/handlers"
+	tagroutes "gestao-financeira/backend/internal/tag/presentation/routes"
 	transactionusecases "gestao-financeira/backend/internal/transaction/application/usecases"
 	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
 	transactionhandlers "gestao-financeira/backend/internal/transaction/presentation/handlers"
@@ -61,6 +73,7 @@ import (
 	"gestao-financeira/backend/pkg/middleware"
 	"gestao-financeira/backend/pkg/migrations"
 	"gestao-financeira/backend/pkg/observability"
+	"gestao-financeira/backend/pkg/pagination"
 	"gestao-financeira/backend/pkg/validator"
 
 	"github.com/gofiber/fiber/v2"
@@ -220,9 +233,29 @@ func main() {
 	eventBus.Subscribe("AccountNameChanged", eventLoggerHandler.Handle)
 	eventBus.Subscribe("AccountDeactivated", eventLoggerHandler.Handle)
 	eventBus.Subscribe("AccountActivated", eventLoggerHandler.Handle)
+	eventBus.Subscribe("AccountBalanceLimitsChanged", eventLoggerHandler.Handle)
+	eventBus.Subscribe("AccountBalanceLimitExceeded", eventLoggerHandler.Handle)
+	eventBus.Subscribe("AccountBalanceAdjusted", eventLoggerHandler.Handle)
 	eventBus.Subscribe("TransactionCreated", eventLoggerHandler.Handle)
 	eventBus.Subscribe("TransactionUpdated", eventLoggerHandler.Handle)
 	eventBus.Subscribe("TransactionDeleted", eventLoggerHandler.Handle)
+	eventBus.Subscribe("TransactionDuplicateMerged", eventLoggerHandler.Handle)
+	eventBus.Subscribe("TransactionAccountChanged", eventLoggerHandler.Handle)
+	eventBus.Subscribe("TransactionRuleCreated", eventLoggerHandler.Handle)
+	eventBus.Subscribe("TransactionRuleUpdated", eventLoggerHandler.Handle)
+	eventBus.Subscribe("TransactionAttachmentAdded", eventLoggerHandler.Handle)
+	eventBus.Subscribe("ReconciliationStarted", eventLoggerHandler.Handle)
+	eventBus.Subscribe("ReconciliationCompleted", eventLoggerHandler.Handle)
+	eventBus.Subscribe("ReconciliationCanceled", eventLoggerHandler.Handle)
+	eventBus.Subscribe("InstallmentPurchaseCreated", eventLoggerHandler.Handle)
+	eventBus.Subscribe("InstallmentPurchaseCanceled", eventLoggerHandler.Handle)
+	eventBus.Subscribe("InstallmentsPrepaid", eventLoggerHandler.Handle)
+	eventBus.Subscribe("RecurringSeriesPaused", eventLoggerHandler.Handle)
+	eventBus.Subscribe("RecurringSeriesResumed", eventLoggerHandler.Handle)
+	eventBus.Subscribe("RecurringOccurrenceSkipped", eventLoggerHandler.Handle)
+	eventBus.Subscribe("RecurringSeriesAmountChanged", eventLoggerHandler.Handle)
+	eventBus.Subscribe("RecurringSeriesEnded", eventLoggerHandler.Handle)
+	eventBus.Subscribe("RecurringSeriesScheduleChanged", eventLoggerHandler.Handle)
 	eventBus.Subscribe("BudgetCreated", eventLoggerHandler.Handle)
 	eventBus.Subscribe("BudgetDeleted", eventLoggerHandler.Handle)
 	eventBus.Subscribe("NotificationCreated", eventLoggerHandler.Handle)
@@ -240,6 +273,24 @@ func main() {
 		}
 	}
 
+	// Initialize file storage for transaction attachments
+	var fileStorage storage.FileStorage
+	if strings.ToLower(cfg.Storage.Driver) == "s3" {
+		fileStorage, err = sharedstorage.NewS3FileStorage(sharedstorage.S3Config{
+			Endpoint:        cfg.Storage.S3.Endpoint,
+			Region:          cfg.Storage.S3.Region,
+			Bucket:          cfg.Storage.S3.Bucket,
+			AccessKeyID:     cfg.Storage.S3.AccessKeyID,
+			SecretAccessKey: cfg.Storage.S3.SecretAccessKey,
+		}, nil)
+	} else {
+		fileStorage, err = sharedstorage.NewLocalFileStorage(cfg.Storage.LocalPath)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Str("driver", cfg.Storage.Driver).Msg("Failed to initialize file storage")
+	}
+	log.Info().Str("driver", cfg.Storage.Driver).Msg("File storage initialized")
+
 	// Initialize repositories
 	userRepository := persistence.NewGormUserRepository(db)
 
@@ -250,8 +301,14 @@ func main() {
 		cacheService,
 		15*time.Minute, // Cache accounts for 15 minutes
 	).(accountrepositories.AccountRepository)
+	creditCardStatementRepository := accountpersistence.NewGormCreditCardStatementRepository(db)
 
 	transactionRepository := transactionpersistence.NewGormTransactionRepository(db)
+	importBatchRepository := transactionpersistence.NewGormImportBatchRepository(db)
+	transactionRuleRepository := transactionpersistence.NewGormTransactionRuleRepository(db)
+	attachmentRepository := transactionpersistence.NewGormAttachmentRepository(db)
+	reconciliationRepository := transactionpersistence.NewGormReconciliationRepository(db)
+	transactionRevisionRepository := transactionpersistence.NewGormTransactionRevisionRepository(db)
 
 	// Initialize category repository with cache
 	baseCategoryRepository := categorypersistence.NewGormCategoryRepository(db)
@@ -261,6 +318,12 @@ func main() {
 		15*time.Minute, // Cache categories for 15 minutes
 	).(categoryrepositories.CategoryRepository)
 
+	tagRepository := tagpersistence.NewGormTagRepository(db)
+
+	payeeRepository := payeepersistence.NewGormPayeeRepository(db)
+
+	balanceSnapshotRepository := reportingpersistence.NewGormBalanceSnapshotRepository(db)
+
 	budgetRepository := budgetpersistence.NewGormBudgetRepository(db)
 
 	investmentRepository := investmentpersistence.NewGormInvestmentRepository(db)
@@ -292,9 +355,14 @@ func main() {
 	loginUseCase := usecases.NewLoginUseCase(userRepository, jwtService)
 
 	// Initialize account use cases
-	createAccountUseCase := accountusecases.NewCreateAccountUseCase(accountRepository, eventBus)
 	listAccountsUseCase := accountusecases.NewListAccountsUseCase(accountRepository)
 	getAccountUseCase := accountusecases.NewGetAccountUseCase(accountRepository)
+	updateAccountUseCase := accountusecases.NewUpdateAccountUseCase(accountRepository, eventBus)
+	archiveAccountUseCase := accountusecases.NewArchiveAccountUseCase(accountRepository, eventBus)
+	reactivateAccountUseCase := accountusecases.NewReactivateAccountUseCase(accountRepository, eventBus)
+	updateCreditCardTermsUseCase := accountusecases.NewUpdateCreditCardTermsUseCase(accountRepository, eventBus)
+	updateBalanceLimitsUseCase := accountusecases.NewUpdateBalanceLimitsUseCase(accountRepository, eventBus)
+	listStatementsUseCase := accountusecases.NewListStatementsUseCase(accountRepository, transactionRepository, creditCardStatementRepository)
 
 	// Initialize account event handlers
 	updateBalanceHandler := accountinfrahandlers.NewUpdateBalanceHandler(accountRepository)
@@ -309,16 +377,70 @@ func main() {
 	// Initialize UnitOfWork for atomic operations
 	unitOfWork := sharedpersistence.NewGormUnitOfWork(db)
 
+	// Creating an account records its initial balance as an opening adjustment transaction atomically
+	createAccountUseCase := accountusecases.NewCreateAccountUseCase(unitOfWork, eventBus)
+
+	// Closing an account moves its remaining balance atomically
+	deleteAccountUseCase := accountusecases.NewDeleteAccountUseCase(unitOfWork, eventBus)
+
+	// Paying a credit card statement transfers the payment and settles the statement atomically
+	payStatementUseCase := accountusecases.NewPayStatementUseCase(unitOfWork, eventBus)
+
+	// Adjusting a balance records the difference as an adjustment transaction atomically
+	adjustBalanceUseCase := accountusecases.NewAdjustBalanceUseCase(unitOfWork, eventBus)
+
+	// Cursors of keyset-paginated lists are signed so clients cannot forge positions
+	cursorSigner := pagination.NewCursorSigner(cfg.Pagination.CursorSecret)
+
 	// Initialize transaction use cases
 	// CreateTransactionUseCase, UpdateTransactionUseCase, and DeleteTransactionUseCase
 	// now use UnitOfWork to ensure atomicity
-	createTransactionUseCase := transactionusecases.NewCreateTransactionUseCase(unitOfWork, eventBus)
-	listTransactionsUseCase := transactionusecases.NewListTransactionsUseCase(transactionRepository)
+	createTransactionUseCase := transactionusecases.NewCreateTransactionUseCase(unitOfWork, categoryRepository, tagRepository, transactionRuleRepository, payeeRepository, eventBus)
+	listTransactionsUseCase := transactionusecases.NewListTransactionsUseCase(transactionRepository, cursorSigner)
 	getTransactionUseCase := transactionusecases.NewGetTransactionUseCase(transactionRepository)
-	updateTransactionUseCase := transactionusecases.NewUpdateTransactionUseCase(unitOfWork, eventBus)
+	updateTransactionUseCase := transactionusecases.NewUpdateTransactionUseCase(unitOfWork, categoryRepository, tagRepository, payeeRepository, eventBus)
 	deleteTransactionUseCase := transactionusecases.NewDeleteTransactionUseCase(unitOfWork, eventBus)
-	restoreTransactionUseCase := transactionusecases.NewRestoreTransactionUseCase(transactionRepository)
-	permanentDeleteTransactionUseCase := transactionusecases.NewPermanentDeleteTransactionUseCase(transactionRepository)
+	createTransferUseCase := transactionusecases.NewCreateTransferUseCase(unitOfWork, eventBus)
+	previewCSVImportUseCase := transactionusecases.NewPreviewCSVImportUseCase(unitOfWork, transactionRuleRepository, payeeRepository)
+	importCSVUseCase := transactionusecases.NewImportCSVUseCase(unitOfWork, transactionRuleRepository, payeeRepository, eventBus)
+	previewStatementImportUseCase := transactionusecases.NewPreviewStatementImportUseCase(unitOfWork, transactionRuleRepository, payeeRepository)
+	importStatementUseCase := transactionusecases.NewImportStatementUseCase(unitOfWork, transactionRuleRepository, payeeRepository, eventBus)
+	listImportBatchesUseCase := transactionusecases.NewListImportBatchesUseCase(importBatchRepository)
+	rollbackImportBatchUseCase := transactionusecases.NewRollbackImportBatchUseCase(unitOfWork, eventBus)
+	listDuplicateTransactionsUseCase := transactionusecases.NewListDuplicateTransactionsUseCase(transactionRepository)
+	mergeDuplicateTransactionsUseCase := transactionusecases.NewMergeDuplicateTransactionsUseCase(unitOfWork, eventBus)
+	bulkTransactionsUseCase := transactionusecases.NewBulkTransactionsUseCase(unitOfWork, categoryRepository, tagRepository, eventBus)
+	getTransactionHistoryUseCase := transactionusecases.NewGetTransactionHistoryUseCase(transactionRepository, transactionRevisionRepository)
+	revertTransactionUseCase := transactionusecases.NewRevertTransactionUseCase(unitOfWork, categoryRepository, tagRepository, payeeRepository, eventBus)
+	exportTransactionsUseCase := transactionusecases.NewExportTransactionsUseCase(transactionRepository, accountRepository, categoryRepository)
+	createTransactionRuleUseCase := transactionusecases.NewCreateTransactionRuleUseCase(transactionRuleRepository, accountRepository, categoryRepository, tagRepository, eventBus)
+	listTransactionRulesUseCase := transactionusecases.NewListTransactionRulesUseCase(transactionRuleRepository)
+	updateTransactionRuleUseCase := transactionusecases.NewUpdateTransactionRuleUseCase(transactionRuleRepository, accountRepository, categoryRepository, tagRepository, eventBus)
+	deleteTransactionRuleUseCase := transactionusecases.NewDeleteTransactionRuleUseCase(transactionRuleRepository)
+	applyTransactionRulesUseCase := transactionusecases.NewApplyTransactionRulesUseCase(unitOfWork, transactionRuleRepository, eventBus)
+	restoreTransactionUseCase := transactionusecases.NewRestoreTransactionUseCase(unitOfWork, eventBus)
+	permanentDeleteTransactionUseCase := transactionusecases.NewPermanentDeleteTransactionUseCase(transactionRepository, attachmentRepository, fileStorage)
+	uploadAttachmentUseCase := transactionusecases.NewUploadAttachmentUseCase(transactionRepository, attachmentRepository, fileStorage, eventBus)
+	listAttachmentsUseCase := transactionusecases.NewListAttachmentsUseCase(transactionRepository, attachmentRepository)
+	downloadAttachmentUseCase := transactionusecases.NewDownloadAttachmentUseCase(attachmentRepository, fileStorage)
+	deleteAttachmentUseCase := transactionusecases.NewDeleteAttachmentUseCase(attachmentRepository, fileStorage)
+	startReconciliationUseCase := transactionusecases.NewStartReconciliationUseCase(reconciliationRepository, accountRepository, transactionRepository, eventBus)
+	getReconciliationUseCase := transactionusecases.NewGetReconciliationUseCase(reconciliationRepository, accountRepository, transactionRepository)
+	listReconciliationsUseCase := transactionusecases.NewListReconciliationsUseCase(reconciliationRepository, accountRepository)
+	completeReconciliationUseCase := transactionusecases.NewCompleteReconciliationUseCase(unitOfWork, eventBus)
+	cancelReconciliationUseCase := transactionusecases.NewCancelReconciliationUseCase(reconciliationRepository, eventBus)
+	createInstallmentPurchaseUseCase := transactionusecases.NewCreateInstallmentPurchaseUseCase(unitOfWork, categoryRepository, tagRepository, eventBus)
+	getInstallmentPurchaseUseCase := transactionusecases.NewGetInstallmentPurchaseUseCase(transactionRepository)
+	cancelInstallmentPurchaseUseCase := transactionusecases.NewCancelInstallmentPurchaseUseCase(unitOfWork, eventBus)
+	prepayInstallmentsUseCase := transactionusecases.NewPrepayInstallmentsUseCase(unitOfWork, eventBus)
+	listRecurringSeriesUseCase := transactionusecases.NewListRecurringSeriesUseCase(transactionRepository)
+	previewRecurringSeriesUseCase := transactionusecases.NewPreviewRecurringSeriesUseCase(transactionRepository)
+	pauseRecurringSeriesUseCase := transactionusecases.NewPauseRecurringSeriesUseCase(transactionRepository, eventBus)
+	resumeRecurringSeriesUseCase := transactionusecases.NewResumeRecurringSeriesUseCase(transactionRepository, eventBus)
+	skipRecurringOccurrenceUseCase := transactionusecases.NewSkipRecurringOccurrenceUseCase(unitOfWork, eventBus)
+	changeRecurringAmountUseCase := transactionusecases.NewChangeRecurringAmountUseCase(unitOfWork, eventBus)
+	endRecurringSeriesUseCase := transactionusecases.NewEndRecurringSeriesUseCase(transactionRepository, eventBus)
+	updateRecurringScheduleUseCase := transactionusecases.NewUpdateRecurringScheduleUseCase(transactionRepository, eventBus)
 
 	// Initialize category use cases
 	createCategoryUseCase := categoryusecases.NewCreateCategoryUseCase(categoryRepository, eventBus)
@@ -329,6 +451,20 @@ func main() {
 	restoreCategoryUseCase := categoryusecases.NewRestoreCategoryUseCase(categoryRepository)
 	permanentDeleteCategoryUseCase := categoryusecases.NewPermanentDeleteCategoryUseCase(categoryRepository)
 
+	// Initialize tag use cases
+	createTagUseCase := tagusecases.NewCreateTagUseCase(tagRepository, eventBus)
+	listTagsUseCase := tagusecases.NewListTagsUseCase(tagRepository)
+	updateTagUseCase := tagusecases.NewUpdateTagUseCase(tagRepository, eventBus)
+	deleteTagUseCase := tagusecases.NewDeleteTagUseCase(tagRepository)
+	mergeTagsUseCase := tagusecases.NewMergeTagsUseCase(tagRepository)
+
+	// Initialize payee use cases
+	createPayeeUseCase := payeeusecases.NewCreatePayeeUseCase(payeeRepository, categoryRepository, eventBus)
+	listPayeesUseCase := payeeusecases.NewListPayeesUseCase(payeeRepository)
+	updatePayeeUseCase := payeeusecases.NewUpdatePayeeUseCase(payeeRepository, categoryRepository, eventBus)
+	deletePayeeUseCase := payeeusecases.NewDeletePayeeUseCase(payeeRepository)
+	matchPayeeUseCase := payeeusecases.NewMatchPayeeUseCase(payeeRepository)
+
 	// Initialize budget use cases
 	createBudgetUseCase := budgetusecases.NewCreateBudgetUseCase(budgetRepository, eventBus)
 	listBudgetsUseCase := budgetusecases.NewListBudgetsUseCase(budgetRepository)
@@ -340,8 +476,11 @@ func main() {
 	// Initialize reporting use cases
 	monthlyReportUseCase := reportingusecases.NewMonthlyReportUseCase(transactionRepository, reportCacheService)
 	annualReportUseCase := reportingusecases.NewAnnualReportUseCase(transactionRepository)
-	categoryReportUseCase := reportingusecases.NewCategoryReportUseCase(transactionRepository)
+	categoryReportUseCase := reportingusecases.NewCategoryReportUseCase(transactionRepository, categoryRepository)
 	incomeVsExpenseUseCase := reportingusecases.NewIncomeVsExpenseUseCase(transactionRepository)
+	tagReportUseCase := reportingusecases.NewTagReportUseCase(transactionRepository, tagRepository)
+	payeeReportUseCase := reportingusecases.NewPayeeReportUseCase(transactionRepository, payeeRepository)
+	balanceHistoryUseCase := reportingusecases.NewBalanceHistoryUseCase(accountRepository, transactionRepository, balanceSnapshotRepository)
 
 	// Initialize investment use cases
 	createInvestmentUseCase := investmentusecases.NewCreateInvestmentUseCase(investmentRepository, accountRepository, eventBus)
@@ -368,9 +507,26 @@ func main() {
 	archiveNotificationUseCase := notificationusecases.NewArchiveNotificationUseCase(notificationRepository)
 	deleteNotificationUseCase := notificationusecases.NewDeleteNotificationUseCase(notificationRepository)
 
+	// Debits beyond the balance floor of accounts in warn-only mode notify the user
+	balanceLimitNotificationHandler := accountinfrahandlers.NewBalanceLimitNotificationHandler(createNotificationUseCase)
+	eventBus.Subscribe("AccountBalanceLimitExceeded", balanceLimitNotificationHandler.HandleAccountBalanceLimitExceeded)
+
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(registerUserUseCase, loginUseCase)
-	accountHandler := accounthandlers.NewAccountHandler(createAccountUseCase, listAccountsUseCase, getAccountUseCase)
+	accountHandler := accounthandlers.NewAccountHandler(accounthandlers.AccountHandlerDeps{
+		CreateAccountUseCase:         createAccountUseCase,
+		ListAccountsUseCase:          listAccountsUseCase,
+		GetAccountUseCase:            getAccountUseCase,
+		UpdateAccountUseCase:         updateAccountUseCase,
+		ArchiveAccountUseCase:        archiveAccountUseCase,
+		ReactivateAccountUseCase:     reactivateAccountUseCase,
+		DeleteAccountUseCase:         deleteAccountUseCase,
+		UpdateCreditCardTermsUseCase: updateCreditCardTermsUseCase,
+		UpdateBalanceLimitsUseCase:   updateBalanceLimitsUseCase,
+		AdjustBalanceUseCase:         adjustBalanceUseCase,
+		ListStatementsUseCase:        listStatementsUseCase,
+		PayStatementUseCase:          payStatementUseCase,
+	})
 	transactionHandler := transactionhandlers.NewTransactionHandler(
 		createTransactionUseCase,
 		listTransactionsUseCase,
@@ -380,6 +536,43 @@ func main() {
 		restoreTransactionUseCase,
 		permanentDeleteTransactionUseCase,
 	)
+	transferHandler := transactionhandlers.NewTransferHandler(createTransferUseCase)
+	importHandler := transactionhandlers.NewImportHandler(previewCSVImportUseCase, importCSVUseCase, previewStatementImportUseCase, importStatementUseCase, listImportBatchesUseCase, rollbackImportBatchUseCase)
+	duplicateHandler := transactionhandlers.NewDuplicateHandler(listDuplicateTransactionsUseCase, mergeDuplicateTransactionsUseCase)
+	ruleHandler := transactionhandlers.NewRuleHandler(
+		createTransactionRuleUseCase,
+		listTransactionRulesUseCase,
+		updateTransactionRuleUseCase,
+		deleteTransactionRuleUseCase,
+		applyTransactionRulesUseCase,
+	)
+	attachmentHandler := transactionhandlers.NewAttachmentHandler(uploadAttachmentUseCase, listAttachmentsUseCase, downloadAttachmentUseCase, deleteAttachmentUseCase)
+	reconciliationHandler := transactionhandlers.NewReconciliationHandler(
+		startReconciliationUseCase,
+		getReconciliationUseCase,
+		listReconciliationsUseCase,
+		completeReconciliationUseCase,
+		cancelReconciliationUseCase,
+	)
+	installmentHandler := transactionhandlers.NewInstallmentHandler(
+		createInstallmentPurchaseUseCase,
+		getInstallmentPurchaseUseCase,
+		cancelInstallmentPurchaseUseCase,
+		prepayInstallmentsUseCase,
+	)
+	recurringSeriesHandler := transactionhandlers.NewRecurringSeriesHandler(
+		listRecurringSeriesUseCase,
+		previewRecurringSeriesUseCase,
+		pauseRecurringSeriesUseCase,
+		resumeRecurringSeriesUseCase,
+		skipRecurringOccurrenceUseCase,
+		changeRecurringAmountUseCase,
+		endRecurringSeriesUseCase,
+		updateRecurringScheduleUseCase,
+	)
+	bulkHandler := transactionhandlers.NewBulkHandler(bulkTransactionsUseCase)
+	historyHandler := transactionhandlers.NewHistoryHandler(getTransactionHistoryUseCase, revertTransactionUseCase)
+	exportHandler := transactionhandlers.NewExportHandler(exportTransactionsUseCase)
 	categoryHandler := categoryhandlers.NewCategoryHandler(
 		createCategoryUseCase,
 		listCategoriesUseCase,
@@ -389,6 +582,20 @@ func main() {
 		restoreCategoryUseCase,
 		permanentDeleteCategoryUseCase,
 	)
+	tagHandler := taghandlers.NewTagHandler(
+		createTagUseCase,
+		listTagsUseCase,
+		updateTagUseCase,
+		deleteTagUseCase,
+		mergeTagsUseCase,
+	)
+	payeeHandler := payeehandlers.NewPayeeHandler(
+		createPayeeUseCase,
+		listPayeesUseCase,
+		updatePayeeUseCase,
+		deletePayeeUseCase,
+		matchPayeeUseCase,
+	)
 	budgetHandler := budgethandlers.NewBudgetHandler(
 		createBudgetUseCase,
 		listBudgetsUseCase,
@@ -428,6 +635,9 @@ func main() {
 		annualReportUseCase,
 		categoryReportUseCase,
 		incomeVsExpenseUseCase,
+		tagReportUseCase,
+		payeeReportUseCase,
+		balanceHistoryUseCase,
 	)
 
 	// Create Fiber app
@@ -533,11 +743,17 @@ func main() {
 		accountroutes.SetupAccountRoutes(api, accountHandler, jwtService, userRepository, cacheService)
 
 		// Setup transaction routes (protected)
-		transactionroutes.SetupTransactionRoutes(api, transactionHandler, jwtService, userRepository, cacheService)
+		transactionroutes.SetupTransactionRoutes(api, transactionHandler, transferHandler, importHandler, duplicateHandler, ruleHandler, attachmentHandler, reconciliationHandler, installmentHandler, recurringSeriesHandler, bulkHandler, historyHandler, exportHandler, jwtService, userRepository, cacheService)
 
 		// Setup category routes (protected)
 		categoryroutes.SetupCategoryRoutes(api, categoryHandler, jwtService, userRepository, cacheService)
 
+		// Setup tag routes (protected)
+		tagroutes.SetupTagRoutes(api, tagHandler, jwtService, userRepository, cacheService)
+
+		// Setup payee routes (protected)
+		payeeroutes.SetupPayeeRoutes(api, payeeHandler, jwtService, userRepository, cacheService)
+
 		// Setup budget routes (protected)
 		budgetroutes.SetupBudgetRoutes(api, budgetHandler, jwtService, userRepository, cacheService)
 
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	accountusecases "gestao-financeira/backend/internal/account/application/usecases"
+	accountpersistence "gestao-financeira/backend/internal/account/infrastructure/persistence"
+	notificationusecases "gestao-financeira/backend/internal/notification/application/usecases"
+	notificationpersistence "gestao-financeira/backend/internal/notification/infrastructure/persistence"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
+	"gestao-financeira/backend/pkg/database"
+	"gestao-financeira/backend/pkg/logger"
+
+	"github.com/rs/zerolog/log"
+)
+
+func main() {
+	// Initialize structured logger
+	logLevel := os.Getenv("LOG_LEVEL")
+	if logLevel == "" {
+		logLevel = "info"
+	}
+	logger.InitLogger(logLevel)
+
+	log.Info().Msg("Starting Overdue Credit Card Statements Notifier")
+
+	// Initialize database connection
+	db, err := database.NewDatabase()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to database")
+	}
+	defer database.Close()
+
+	log.Info().Msg("Database connection established")
+
+	// Initialize Event Bus
+	eventBus := eventbus.NewEventBus()
+
+	// Initialize repositories
+	accountRepository := accountpersistence.NewGormAccountRepository(db)
+	statementRepository := accountpersistence.NewGormCreditCardStatementRepository(db)
+	transactionRepository := transactionpersistence.NewGormTransactionRepository(db)
+	notificationRepository := notificationpersistence.NewGormNotificationRepository(db)
+
+	// Initialize use cases
+	createNotificationUseCase := notificationusecases.NewCreateNotificationUseCase(notificationRepository, eventBus)
+	notifyUseCase := accountusecases.NewNotifyOverdueStatementsUseCase(
+		accountRepository,
+		transactionRepository,
+		statementRepository,
+		createNotificationUseCase,
+	)
+
+	// Notify overdue statements
+	log.Info().Msg("Checking credit card statements...")
+	notifiedCount, err := notifyUseCase.Execute(time.Now())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to notify overdue statements")
+	}
+
+	log.Info().Int("notified_count", notifiedCount).Msg("Overdue statements notified successfully")
+
+	// Close database connection
+	if err := database.Close(); err != nil {
+		log.Error().Err(err).Msg("Error closing database")
+	}
+
+	log.Info().Msg("Overdue Credit Card Statements Notifier completed")
+}
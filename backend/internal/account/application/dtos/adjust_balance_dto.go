@@ -0,0 +1,22 @@
+package dtos
+
+// AdjustBalanceInput represents the input for setting the balance of an account by hand, e.g. when
+// it drifted from the bank. The difference is recorded by a balance adjustment transaction.
+type AdjustBalanceInput struct {
+	AccountID   string   `json:"account_id" validate:"required,uuid"`
+	UserID      string   `json:"user_id" validate:"required,uuid"`
+	Balance     *float64 `json:"balance" validate:"required"`                                                           // New balance of the account (may be negative)
+	Description string   `json:"description,omitempty" validate:"omitempty,min=3,max=500,no_sql_injection,no_xss,utf8"` // Defaults to "Balance adjustment"
+	Date        string   `json:"date,omitempty"`                                                                        // Date of the adjustment (YYYY-MM-DD, default: today)
+	RequestID   string   `json:"-"`                                                                                     // Identifies the HTTP request in the edit history
+}
+
+// AdjustBalanceOutput represents the output after adjusting the balance of an account.
+type AdjustBalanceOutput struct {
+	Account         *AccountOutput `json:"account"`
+	TransactionID   string         `json:"transaction_id"`   // ADJUSTMENT_IN or ADJUSTMENT_OUT transaction recording the difference
+	TransactionType string         `json:"transaction_type"` // ADJUSTMENT_IN (balance raised) or ADJUSTMENT_OUT (balance lowered)
+	PreviousBalance float64        `json:"previous_balance"`
+	Difference      float64        `json:"difference"` // New balance minus previous balance
+	Currency        string         `json:"currency"`
+}
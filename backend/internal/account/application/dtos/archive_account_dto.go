@@ -0,0 +1,14 @@
+package dtos
+
+// ArchiveAccountInput represents the input for archiving an account.
+// Archived accounts are hidden from account listings by default but keep their transactions.
+type ArchiveAccountInput struct {
+	AccountID string `json:"account_id" validate:"required,uuid"`
+	UserID    string `json:"user_id" validate:"required,uuid"`
+}
+
+// ReactivateAccountInput represents the input for reactivating an archived account.
+type ReactivateAccountInput struct {
+	AccountID string `json:"account_id" validate:"required,uuid"`
+	UserID    string `json:"user_id" validate:"required,uuid"`
+}
@@ -0,0 +1,18 @@
+package dtos
+
+// BalanceLimitsOutput represents how far a debit can take the balance of an account.
+type BalanceLimitsOutput struct {
+	Mode           string  `json:"mode"`            // ENFORCE (debits beyond the floor are rejected) or WARN
+	OverdraftLimit float64 `json:"overdraft_limit"` // BANK accounts only (cheque especial)
+	BalanceFloor   float64 `json:"balance_floor"`   // Lowest balance a debit can leave the account with
+	LimitKind      string  `json:"limit_kind"`      // BALANCE, OVERDRAFT or CREDIT_LIMIT
+}
+
+// UpdateBalanceLimitsInput represents the input for setting the overdraft limit of an account and
+// whether debits beyond its balance floor are rejected or only warned about.
+type UpdateBalanceLimitsInput struct {
+	AccountID      string  `json:"account_id" validate:"required,uuid"`
+	UserID         string  `json:"user_id" validate:"required,uuid"`
+	OverdraftLimit float64 `json:"overdraft_limit" validate:"gte=0"`
+	Mode           string  `json:"mode" validate:"required,oneof=ENFORCE WARN"`
+}
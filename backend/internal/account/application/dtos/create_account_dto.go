@@ -8,17 +8,28 @@ type CreateAccountInput struct {
 	InitialBalance float64 `json:"initial_balance" validate:"gte=0"`
 	Currency       string  `json:"currency" validate:"required,oneof=BRL USD EUR"`
 	Context        string  `json:"context" validate:"required,oneof=PERSONAL BUSINESS"`
+	// Credit card terms (CREDIT_CARD accounts only; give all three or none)
+	CreditLimit *float64 `json:"credit_limit,omitempty" validate:"omitempty,gt=0"`
+	ClosingDay  *int     `json:"closing_day,omitempty" validate:"omitempty,min=1,max=31"`
+	DueDay      *int     `json:"due_day,omitempty" validate:"omitempty,min=1,max=31"`
+	// Balance floor policy (overdraft limit for BANK accounts only; mode defaults to ENFORCE)
+	OverdraftLimit   *float64 `json:"overdraft_limit,omitempty" validate:"omitempty,gte=0"`
+	BalanceLimitMode string   `json:"balance_limit_mode,omitempty" validate:"omitempty,oneof=ENFORCE WARN"`
+	// Identifies the HTTP request in the edit history of the opening balance transaction
+	RequestID string `json:"-"`
 }
 
 // CreateAccountOutput represents the output data after account creation.
 type CreateAccountOutput struct {
-	AccountID string  `json:"account_id"`
-	UserID    string  `json:"user_id"`
-	Name      string  `json:"name"`
-	Type      string  `json:"type"`
-	Balance   float64 `json:"balance"`
-	Currency  string  `json:"currency"`
-	Context   string  `json:"context"`
-	IsActive  bool    `json:"is_active"`
-	CreatedAt string  `json:"created_at"`
+	AccountID     string              `json:"account_id"`
+	UserID        string              `json:"user_id"`
+	Name          string              `json:"name"`
+	Type          string              `json:"type"`
+	Balance       float64             `json:"balance"`
+	Currency      string              `json:"currency"`
+	Context       string              `json:"context"`
+	IsActive      bool                `json:"is_active"`
+	CreatedAt     string              `json:"created_at"`
+	CreditCard    *CreditCardOutput   `json:"credit_card,omitempty"` // CREDIT_CARD accounts with terms only
+	BalanceLimits BalanceLimitsOutput `json:"balance_limits"`
 }
@@ -0,0 +1,73 @@
+package dtos
+
+// CreditCardOutput represents the credit card terms of a CREDIT_CARD account.
+type CreditCardOutput struct {
+	CreditLimit     float64 `json:"credit_limit"`
+	ClosingDay      int     `json:"closing_day"`
+	DueDay          int     `json:"due_day"`
+	AvailableCredit float64 `json:"available_credit"` // Credit limit minus the outstanding balance
+}
+
+// UpdateCreditCardTermsInput represents the input for setting the credit limit, closing day and
+// due day of a credit card account.
+type UpdateCreditCardTermsInput struct {
+	AccountID   string  `json:"account_id" validate:"required,uuid"`
+	UserID      string  `json:"user_id" validate:"required,uuid"`
+	CreditLimit float64 `json:"credit_limit" validate:"required,gt=0"`
+	ClosingDay  int     `json:"closing_day" validate:"required,min=1,max=31"`
+	DueDay      int     `json:"due_day" validate:"required,min=1,max=31"`
+}
+
+// ListStatementsInput represents the input for listing the statements of a credit card account.
+type ListStatementsInput struct {
+	AccountID string `json:"account_id" validate:"required,uuid"`
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	Status    string `json:"status,omitempty" validate:"omitempty,oneof=OPEN CLOSED PAID"` // Query parameter
+}
+
+// StatementOutput represents a single credit card statement (fatura).
+type StatementOutput struct {
+	Month          string  `json:"month"` // Month the statement closes in (YYYY-MM), which identifies it
+	PeriodStart    string  `json:"period_start"`
+	ClosingDate    string  `json:"closing_date"`
+	DueDate        string  `json:"due_date"`
+	Status         string  `json:"status"` // OPEN, CLOSED or PAID
+	IsOverdue      bool    `json:"is_overdue"`
+	Total          float64 `json:"total"`
+	PaidAmount     float64 `json:"paid_amount"`
+	AmountDue      float64 `json:"amount_due"`
+	MinimumPayment float64 `json:"minimum_payment"`
+	Currency       string  `json:"currency"`
+	ChargeCount    int     `json:"charge_count"`
+	PaidAt         string  `json:"paid_at,omitempty"`
+}
+
+// ListStatementsOutput represents the statements of a credit card account, most recent first.
+type ListStatementsOutput struct {
+	AccountID       string             `json:"account_id"`
+	Currency        string             `json:"currency"`
+	CreditLimit     float64            `json:"credit_limit"`
+	Balance         float64            `json:"balance"`
+	AvailableCredit float64            `json:"available_credit"`
+	Statements      []*StatementOutput `json:"statements"`
+	Count           int                `json:"count"`
+}
+
+// PayStatementInput represents the input for paying a credit card statement from another account.
+type PayStatementInput struct {
+	AccountID     string   `json:"account_id" validate:"required,uuid"`
+	UserID        string   `json:"user_id" validate:"required,uuid"`
+	Month         string   `json:"month" validate:"required"` // Month the statement closes in (YYYY-MM)
+	FromAccountID string   `json:"from_account_id" validate:"required,uuid"`
+	Amount        *float64 `json:"amount,omitempty" validate:"omitempty,gt=0"` // Defaults to the amount due
+	Date          string   `json:"date,omitempty"`                             // Payment date (YYYY-MM-DD), defaults to today
+	RequestID     string   `json:"-"`
+}
+
+// PayStatementOutput represents the output after paying a credit card statement.
+type PayStatementOutput struct {
+	Statement      *StatementOutput `json:"statement"`
+	TransactionIDs []string         `json:"transaction_ids"` // Outgoing and incoming legs of the payment transfer
+	PaidAmount     float64          `json:"paid_amount"`
+	Currency       string           `json:"currency"`
+}
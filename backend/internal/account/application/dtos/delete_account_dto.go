@@ -0,0 +1,23 @@
+package dtos
+
+// DeleteAccountInput represents the input for closing (soft deleting) an account.
+// An account with a non-zero balance can only be closed by transferring the balance
+// to another account or by writing it off.
+type DeleteAccountInput struct {
+	AccountID           string `json:"account_id" validate:"required,uuid"`
+	UserID              string `json:"user_id" validate:"required,uuid"`
+	TransferToAccountID string `json:"transfer_to_account_id,omitempty" validate:"omitempty,uuid"` // Account that receives the remaining balance
+	WriteOff            bool   `json:"write_off,omitempty"`                                        // Zero the balance with a balance adjustment
+	Date                string `json:"date,omitempty"`                                             // Date of the closing transaction (YYYY-MM-DD, default: today)
+	RequestID           string `json:"-"`                                                          // Identifies the HTTP request in the edit history
+}
+
+// DeleteAccountOutput represents the output after closing an account.
+type DeleteAccountOutput struct {
+	Message                string   `json:"message"`
+	AccountID              string   `json:"account_id"`
+	ClosingTransactionIDs  []string `json:"closing_transaction_ids,omitempty"`
+	TransferredToAccountID string   `json:"transferred_to_account_id,omitempty"`
+	ClosingBalance         float64  `json:"closing_balance"`
+	Currency               string   `json:"currency"`
+}
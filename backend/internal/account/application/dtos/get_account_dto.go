@@ -8,14 +8,16 @@ type GetAccountInput struct {
 // GetAccountOutput represents the output for getting a single account.
 // Uses the same structure as AccountOutput from list_accounts_dto.go
 type GetAccountOutput struct {
-	AccountID string  `json:"account_id"`
-	UserID    string  `json:"user_id"`
-	Name      string  `json:"name"`
-	Type      string  `json:"type"`
-	Balance   float64 `json:"balance"`
-	Currency  string  `json:"currency"`
-	Context   string  `json:"context"`
-	IsActive  bool    `json:"is_active"`
-	CreatedAt string  `json:"created_at"`
-	UpdatedAt string  `json:"updated_at"`
+	AccountID     string              `json:"account_id"`
+	UserID        string              `json:"user_id"`
+	Name          string              `json:"name"`
+	Type          string              `json:"type"`
+	Balance       float64             `json:"balance"`
+	Currency      string              `json:"currency"`
+	Context       string              `json:"context"`
+	IsActive      bool                `json:"is_active"`
+	CreatedAt     string              `json:"created_at"`
+	UpdatedAt     string              `json:"updated_at"`
+	CreditCard    *CreditCardOutput   `json:"credit_card,omitempty"` // CREDIT_CARD accounts with terms only
+	BalanceLimits BalanceLimitsOutput `json:"balance_limits"`
 }
@@ -4,24 +4,27 @@ import "gestao-financeira/backend/pkg/pagination"
 
 // ListAccountsInput represents the input for listing accounts.
 type ListAccountsInput struct {
-	UserID  string `json:"user_id" validate:"required,uuid"`
-	Context string `json:"context,omitempty" validate:"omitempty,oneof=PERSONAL BUSINESS"`
-	Page    string `json:"page,omitempty"`  // Query parameter
-	Limit   string `json:"limit,omitempty"` // Query parameter
+	UserID          string `json:"user_id" validate:"required,uuid"`
+	Context         string `json:"context,omitempty" validate:"omitempty,oneof=PERSONAL BUSINESS"`
+	Page            string `json:"page,omitempty"`             // Query parameter
+	Limit           string `json:"limit,omitempty"`            // Query parameter
+	IncludeArchived bool   `json:"include_archived,omitempty"` // Query parameter: archived accounts are hidden by default
 }
 
 // AccountOutput represents a single account in the list.
 type AccountOutput struct {
-	AccountID string  `json:"account_id"`
-	UserID    string  `json:"user_id"`
-	Name      string  `json:"name"`
-	Type      string  `json:"type"`
-	Balance   float64 `json:"balance"`
-	Currency  string  `json:"currency"`
-	Context   string  `json:"context"`
-	IsActive  bool    `json:"is_active"`
-	CreatedAt string  `json:"created_at"`
-	UpdatedAt string  `json:"updated_at"`
+	AccountID     string              `json:"account_id"`
+	UserID        string              `json:"user_id"`
+	Name          string              `json:"name"`
+	Type          string              `json:"type"`
+	Balance       float64             `json:"balance"`
+	Currency      string              `json:"currency"`
+	Context       string              `json:"context"`
+	IsActive      bool                `json:"is_active"`
+	CreatedAt     string              `json:"created_at"`
+	UpdatedAt     string              `json:"updated_at"`
+	CreditCard    *CreditCardOutput   `json:"credit_card,omitempty"` // CREDIT_CARD accounts with terms only
+	BalanceLimits BalanceLimitsOutput `json:"balance_limits"`
 }
 
 // ListAccountsOutput represents the output for listing accounts.
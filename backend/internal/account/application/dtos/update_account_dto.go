@@ -0,0 +1,8 @@
+package dtos
+
+// UpdateAccountInput represents the input for renaming an account.
+type UpdateAccountInput struct {
+	AccountID string `json:"account_id" validate:"required,uuid"`
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	Name      string `json:"name" validate:"required,min=3,max=100,no_sql_injection,no_xss,utf8"`
+}
@@ -0,0 +1,142 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// defaultAdjustmentDescription is the description of balance adjustments created without one.
+const defaultAdjustmentDescription = "Balance adjustment"
+
+// AdjustBalanceUseCase handles setting the balance of an account by hand, e.g. when it drifted
+// from the bank. The difference is recorded by a balance adjustment transaction (ADJUSTMENT_IN or
+// ADJUSTMENT_OUT), left out of income and expense reports, so the balance keeps matching the
+// sum of the account transactions. It uses UnitOfWork so the adjustment transaction and the
+// balance are saved atomically.
+type AdjustBalanceUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewAdjustBalanceUseCase creates a new AdjustBalanceUseCase instance.
+func NewAdjustBalanceUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *AdjustBalanceUseCase {
+	return &AdjustBalanceUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute performs the balance adjustment.
+func (uc *AdjustBalanceUseCase) Execute(input dtos.AdjustBalanceInput) (*dtos.AdjustBalanceOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Balance == nil {
+		return nil, errors.New("invalid balance: the new balance of the account is required")
+	}
+
+	rawDescription := input.Description
+	if rawDescription == "" {
+		rawDescription = defaultAdjustmentDescription
+	}
+	description, err := transactionvalueobjects.NewTransactionDescription(rawDescription)
+	if err != nil {
+		return nil, fmt.Errorf("invalid description: %w", err)
+	}
+
+	// Parse adjustment date (defaults to today)
+	date := currentDay()
+	if input.Date != "" {
+		date, err = time.Parse("2006-01-02", input.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date format: expected YYYY-MM-DD, got %s", input.Date)
+		}
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				// Log rollback error but don't fail the function
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	// Get repositories from UnitOfWork (within transaction)
+	accountRepository := uc.unitOfWork.AccountRepository()
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
+
+	account, err := findUserAccount(accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousBalance := account.Balance()
+	balance, err := sharedvalueobjects.NewMoneyFromFloat(*input.Balance, previousBalance.Currency())
+	if err != nil {
+		return nil, fmt.Errorf("invalid balance: %w", err)
+	}
+	difference, err := account.AdjustBalance(balance)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustment, err := transactionentities.NewBalanceAdjustment(userID, accountID, difference, description, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create balance adjustment: %w", err)
+	}
+
+	// Save the adjustment transaction and the balance (within transaction)
+	if err := transactionRepository.Save(adjustment); err != nil {
+		return nil, fmt.Errorf("failed to save balance adjustment: %w", err)
+	}
+	if err := recordTransactionCreation(revisionRepository, adjustment, userID, input.RequestID); err != nil {
+		return nil, err
+	}
+	if err := accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, event := range adjustment.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			// Log error but don't fail the adjustment
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	adjustment.ClearEvents()
+	publishAccountEvents(uc.eventBus, account)
+
+	return &dtos.AdjustBalanceOutput{
+		Account:         toAccountOutput(account),
+		TransactionID:   adjustment.ID().Value(),
+		TransactionType: adjustment.TransactionType().Value(),
+		PreviousBalance: previousBalance.Float64(),
+		Difference:      difference.Float64(),
+		Currency:        balance.Currency().Code(),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"testing"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	accountpersistence "gestao-financeira/backend/internal/account/infrastructure/persistence"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	sharedpersistence "gestao-financeira/backend/internal/shared/infrastructure/persistence"
+	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
+)
+
+func TestAdjustBalanceUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	floatPointer := func(value float64) *float64 { return &value }
+
+	t.Run("records the difference as an adjustment transaction", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		created, err := NewCreateAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.CreateAccountInput{
+			UserID:         userID.Value(),
+			Name:           "Conta Corrente",
+			Type:           "BANK",
+			InitialBalance: 150.00,
+			Currency:       "BRL",
+			Context:        "PERSONAL",
+		})
+		if err != nil {
+			t.Fatalf("failed to create account: %v", err)
+		}
+		accountID, _ := accountvalueobjects.NewAccountID(created.AccountID)
+
+		output, err := NewAdjustBalanceUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.AdjustBalanceInput{
+			AccountID: created.AccountID,
+			UserID:    userID.Value(),
+			Balance:   floatPointer(120.50),
+			Date:      "2026-10-15",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.TransactionType != "ADJUSTMENT_OUT" || output.PreviousBalance != 150.00 || output.Difference != -29.50 {
+			t.Errorf("expected a -29.50 ADJUSTMENT_OUT from 150.00, got %+v", output)
+		}
+		if output.Account.Balance != 120.50 {
+			t.Errorf("expected balance 120.50, got %+v", output.Account)
+		}
+
+		// The balance equals the sum of the transactions, starting with the opening balance
+		found, _ := accountpersistence.NewGormAccountRepository(db).FindByID(accountID)
+		transactions, _ := transactionpersistence.NewGormTransactionRepository(db).FindByAccountID(accountID)
+		if len(transactions) != 2 {
+			t.Fatalf("expected the opening balance and the balance adjustment, got %d transactions", len(transactions))
+		}
+		total := int64(0)
+		for _, transaction := range transactions {
+			if transaction.TransactionType().IsDebit() {
+				total -= transaction.Amount().Amount()
+			} else {
+				total += transaction.Amount().Amount()
+			}
+		}
+		if total != found.Balance().Amount() {
+			t.Errorf("expected the sum of the transactions %d to equal the balance %d", total, found.Balance().Amount())
+		}
+	})
+
+	t.Run("sets a balance below the balance floor", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Corrente", 10.00, "BRL")
+
+		output, err := NewAdjustBalanceUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.AdjustBalanceInput{
+			AccountID:   account.ID().Value(),
+			UserID:      userID.Value(),
+			Balance:     floatPointer(-35.00),
+			Description: "Tarifas não lançadas",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Account.Balance != -35.00 {
+			t.Errorf("expected balance -35.00, got %f", output.Account.Balance)
+		}
+	})
+
+	t.Run("refuses an unchanged balance", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Corrente", 150.00, "BRL")
+
+		_, err := NewAdjustBalanceUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.AdjustBalanceInput{
+			AccountID: account.ID().Value(),
+			UserID:    userID.Value(),
+			Balance:   floatPointer(150.00),
+		})
+		if err == nil || !containsString(err.Error(), "already") {
+			t.Fatalf("expected an unchanged balance error, got %v", err)
+		}
+
+		transactions, _ := transactionpersistence.NewGormTransactionRepository(db).FindByAccountID(account.ID())
+		if len(transactions) != 0 {
+			t.Errorf("expected no adjustment transaction, got %d", len(transactions))
+		}
+	})
+
+	t.Run("refuses an account of another user", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, identityvalueobjects.GenerateUserID(), "Conta Corrente", 0, "BRL")
+
+		_, err := NewAdjustBalanceUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.AdjustBalanceInput{
+			AccountID: account.ID().Value(),
+			UserID:    userID.Value(),
+			Balance:   floatPointer(10.00),
+		})
+		if err == nil || !containsString(err.Error(), "does not belong to user") {
+			t.Fatalf("expected a forbidden error, got %v", err)
+		}
+	})
+}
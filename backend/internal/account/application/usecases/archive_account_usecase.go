@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"fmt"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// ArchiveAccountUseCase handles archiving an account.
+// An archived account is inactive: it no longer accepts transactions and is hidden from
+// account listings by default, while its transactions stay in reports.
+type ArchiveAccountUseCase struct {
+	accountRepository repositories.AccountRepository
+	eventBus          *eventbus.EventBus
+}
+
+// NewArchiveAccountUseCase creates a new ArchiveAccountUseCase instance.
+func NewArchiveAccountUseCase(
+	accountRepository repositories.AccountRepository,
+	eventBus *eventbus.EventBus,
+) *ArchiveAccountUseCase {
+	return &ArchiveAccountUseCase{
+		accountRepository: accountRepository,
+		eventBus:          eventBus,
+	}
+}
+
+// Execute performs the account archiving.
+func (uc *ArchiveAccountUseCase) Execute(input dtos.ArchiveAccountInput) (*dtos.AccountOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := findUserAccount(uc.accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := account.Deactivate(); err != nil {
+		return nil, fmt.Errorf("cannot archive account: %w", err)
+	}
+
+	if err := uc.accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	publishAccountEvents(uc.eventBus, account)
+
+	return toAccountOutput(account), nil
+}
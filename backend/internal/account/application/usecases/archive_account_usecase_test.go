@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"testing"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+func TestArchiveAccountUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	mockRepo := newMockAccountRepository()
+	account, _ := createTestAccount(userID, "Conta Corrente", "BANK", 1000.00, "BRL", "PERSONAL")
+	_ = mockRepo.Save(account)
+
+	useCase := NewArchiveAccountUseCase(mockRepo, eventbus.NewEventBus())
+	input := dtos.ArchiveAccountInput{AccountID: account.ID().Value(), UserID: userID.Value()}
+
+	output, err := useCase.Execute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.IsActive {
+		t.Errorf("expected account to be archived")
+	}
+	// The balance is kept
+	if output.Balance != 1000.00 {
+		t.Errorf("expected balance 1000.00, got %f", output.Balance)
+	}
+
+	if _, err := useCase.Execute(input); err == nil || !containsString(err.Error(), "already inactive") {
+		t.Errorf("expected archiving twice to fail, got %v", err)
+	}
+
+	otherUser := identityvalueobjects.GenerateUserID()
+	if _, err := useCase.Execute(dtos.ArchiveAccountInput{AccountID: account.ID().Value(), UserID: otherUser.Value()}); err == nil {
+		t.Errorf("expected archiving an account of another user to fail")
+	}
+
+	missing := valueobjects.GenerateAccountID()
+	if _, err := useCase.Execute(dtos.ArchiveAccountInput{AccountID: missing.Value(), UserID: userID.Value()}); err == nil || !containsString(err.Error(), "not found") {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
+
+func TestReactivateAccountUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	mockRepo := newMockAccountRepository()
+	account, _ := createTestAccount(userID, "Conta Corrente", "BANK", 1000.00, "BRL", "PERSONAL")
+	_ = account.Deactivate()
+	_ = mockRepo.Save(account)
+
+	useCase := NewReactivateAccountUseCase(mockRepo, eventbus.NewEventBus())
+	input := dtos.ReactivateAccountInput{AccountID: account.ID().Value(), UserID: userID.Value()}
+
+	output, err := useCase.Execute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !output.IsActive {
+		t.Errorf("expected account to be active")
+	}
+
+	if _, err := useCase.Execute(input); err == nil || !containsString(err.Error(), "already active") {
+		t.Errorf("expected reactivating an active account to fail, got %v", err)
+	}
+}
@@ -1,31 +1,41 @@
 package usecases
 
 import (
+	"errors"
 	"fmt"
 
 	"gestao-financeira/backend/internal/account/application/dtos"
 	"gestao-financeira/backend/internal/account/domain/entities"
-	"gestao-financeira/backend/internal/account/domain/repositories"
 	"gestao-financeira/backend/internal/account/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
+// openingBalanceDescription is the description of the transaction recording the initial balance of an account.
+const openingBalanceDescription = "Opening balance"
+
 // CreateAccountUseCase handles account creation.
+// A non-zero initial balance is recorded by an ADJUSTMENT_IN transaction dated at the account
+// creation, so the balance always equals the sum of the account transactions and the balance
+// history starts from zero before the account existed. It uses UnitOfWork so the account and the
+// opening balance transaction are saved atomically.
 type CreateAccountUseCase struct {
-	accountRepository repositories.AccountRepository
-	eventBus          *eventbus.EventBus
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
 }
 
 // NewCreateAccountUseCase creates a new CreateAccountUseCase instance.
 func NewCreateAccountUseCase(
-	accountRepository repositories.AccountRepository,
+	unitOfWork sharedrepositories.UnitOfWork,
 	eventBus *eventbus.EventBus,
 ) *CreateAccountUseCase {
 	return &CreateAccountUseCase{
-		accountRepository: accountRepository,
-		eventBus:          eventBus,
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
 	}
 }
 
@@ -76,12 +86,93 @@ func (uc *CreateAccountUseCase) Execute(input dtos.CreateAccountInput) (*dtos.Cr
 		return nil, fmt.Errorf("failed to create account: %w", err)
 	}
 
-	// Save account to repository
-	if err := uc.accountRepository.Save(account); err != nil {
+	// Set credit card terms (all three are required together)
+	if input.CreditLimit != nil || input.ClosingDay != nil || input.DueDay != nil {
+		if input.CreditLimit == nil || input.ClosingDay == nil || input.DueDay == nil {
+			return nil, errors.New("invalid credit card terms: credit limit, closing day and due day must be given together")
+		}
+		terms, err := newCreditCardTerms(*input.CreditLimit, *input.ClosingDay, *input.DueDay, currency)
+		if err != nil {
+			return nil, err
+		}
+		if err := account.SetCreditCardTerms(terms); err != nil {
+			return nil, err
+		}
+	}
+
+	// Set the balance floor policy (defaults: no overdraft, ENFORCE)
+	if input.OverdraftLimit != nil || input.BalanceLimitMode != "" {
+		overdraftLimit := 0.0
+		if input.OverdraftLimit != nil {
+			overdraftLimit = *input.OverdraftLimit
+		}
+		mode := input.BalanceLimitMode
+		if mode == "" {
+			mode = valueobjects.BalanceLimitEnforce
+		}
+		if err := setBalanceLimits(account, overdraftLimit, mode); err != nil {
+			return nil, err
+		}
+	}
+
+	// Record the initial balance in the ledger
+	var opening *transactionentities.Transaction
+	if !initialBalance.IsZero() {
+		opening, err = transactionentities.NewBalanceAdjustment(
+			userID,
+			account.ID(),
+			initialBalance,
+			transactionvalueobjects.MustTransactionDescription(openingBalanceDescription),
+			currentDay(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create opening balance transaction: %w", err)
+		}
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				// Log rollback error but don't fail the function
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	// Save account and opening balance transaction (within transaction)
+	if err := uc.unitOfWork.AccountRepository().Save(account); err != nil {
 		return nil, fmt.Errorf("failed to save account: %w", err)
 	}
+	if opening != nil {
+		if err := uc.unitOfWork.TransactionRepository().Save(opening); err != nil {
+			return nil, fmt.Errorf("failed to save opening balance transaction: %w", err)
+		}
+		if err := recordTransactionCreation(uc.unitOfWork.TransactionRevisionRepository(), opening, userID, input.RequestID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
 	// Publish domain events
+	if opening != nil {
+		for _, event := range opening.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				// Log error but don't fail the account creation
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		opening.ClearEvents()
+	}
 	domainEvents := account.GetEvents()
 	for _, event := range domainEvents {
 		if err := uc.eventBus.Publish(event); err != nil {
@@ -96,15 +187,17 @@ func (uc *CreateAccountUseCase) Execute(input dtos.CreateAccountInput) (*dtos.Cr
 	// Build output
 	balance := account.Balance()
 	output := &dtos.CreateAccountOutput{
-		AccountID: account.ID().Value(),
-		UserID:    account.UserID().Value(),
-		Name:      account.Name().Value(),
-		Type:      account.AccountType().Value(),
-		Balance:   balance.Float64(),
-		Currency:  balance.Currency().Code(),
-		Context:   account.Context().Value(),
-		IsActive:  account.IsActive(),
-		CreatedAt: account.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		AccountID:     account.ID().Value(),
+		UserID:        account.UserID().Value(),
+		Name:          account.Name().Value(),
+		Type:          account.AccountType().Value(),
+		Balance:       balance.Float64(),
+		Currency:      balance.Currency().Code(),
+		Context:       account.Context().Value(),
+		IsActive:      account.IsActive(),
+		CreatedAt:     account.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreditCard:    toCreditCardOutput(account),
+		BalanceLimits: toBalanceLimitsOutput(account),
 	}
 
 	return output, nil
@@ -6,12 +6,85 @@ import (
 
 	"gestao-financeira/backend/internal/account/application/dtos"
 	"gestao-financeira/backend/internal/account/domain/entities"
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
 	"gestao-financeira/backend/internal/account/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	sharedpersistence "gestao-financeira/backend/internal/shared/infrastructure/persistence"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
 )
 
+// mockUnitOfWork is a mock implementation of UnitOfWork for testing.
+// It only provides the repositories used to create an account.
+type mockUnitOfWork struct {
+	sharedrepositories.UnitOfWork
+	accountRepository     *mockAccountRepository
+	transactionRepository *mockTransactionRepository
+	inTransaction         bool
+}
+
+func newMockUnitOfWork(accountRepository *mockAccountRepository) *mockUnitOfWork {
+	return &mockUnitOfWork{
+		accountRepository:     accountRepository,
+		transactionRepository: &mockTransactionRepository{},
+	}
+}
+
+func (m *mockUnitOfWork) Begin() error {
+	m.inTransaction = true
+	return nil
+}
+
+func (m *mockUnitOfWork) Commit() error {
+	m.inTransaction = false
+	return nil
+}
+
+func (m *mockUnitOfWork) Rollback() error {
+	m.inTransaction = false
+	return nil
+}
+
+func (m *mockUnitOfWork) IsInTransaction() bool {
+	return m.inTransaction
+}
+
+func (m *mockUnitOfWork) AccountRepository() accountrepositories.AccountRepository {
+	return m.accountRepository
+}
+
+func (m *mockUnitOfWork) TransactionRepository() transactionrepositories.TransactionRepository {
+	return m.transactionRepository
+}
+
+func (m *mockUnitOfWork) TransactionRevisionRepository() transactionrepositories.TransactionRevisionRepository {
+	return &mockTransactionRevisionRepository{}
+}
+
+// mockTransactionRepository records the transactions saved with an account.
+type mockTransactionRepository struct {
+	transactionrepositories.TransactionRepository
+	transactions []*transactionentities.Transaction
+}
+
+func (m *mockTransactionRepository) Save(transaction *transactionentities.Transaction) error {
+	m.transactions = append(m.transactions, transaction)
+	return nil
+}
+
+// mockTransactionRevisionRepository discards the edit history of the saved transactions.
+type mockTransactionRevisionRepository struct {
+	transactionrepositories.TransactionRevisionRepository
+}
+
+func (m *mockTransactionRevisionRepository) Save(revision *transactionentities.TransactionRevision) error {
+	return nil
+}
+
 // mockAccountRepository is a mock implementation of AccountRepository for testing.
 type mockAccountRepository struct {
 	accounts map[string]*entities.Account
@@ -52,6 +125,16 @@ func (m *mockAccountRepository) FindByUserIDAndContext(userID identityvalueobjec
 	return result, nil
 }
 
+func (m *mockAccountRepository) FindByType(accountType valueobjects.AccountType) ([]*entities.Account, error) {
+	var result []*entities.Account
+	for _, account := range m.accounts {
+		if account.AccountType().Equals(accountType) && account.IsActive() {
+			result = append(result, account)
+		}
+	}
+	return result, nil
+}
+
 func (m *mockAccountRepository) Save(account *entities.Account) error {
 	if m.saveErr != nil {
 		return m.saveErr
@@ -193,6 +276,79 @@ func TestCreateAccountUseCase_Execute(t *testing.T) {
 			wantError: true,
 			errorMsg:  "invalid account context",
 		},
+		{
+			name: "credit card with terms",
+			input: dtos.CreateAccountInput{
+				UserID:      userID.Value(),
+				Name:        "Cartão Visa",
+				Type:        "CREDIT_CARD",
+				Currency:    "BRL",
+				Context:     "PERSONAL",
+				CreditLimit: floatPtr(5000.00),
+				ClosingDay:  intPtr(25),
+				DueDay:      intPtr(5),
+			},
+			setupMock: func(m *mockAccountRepository) {},
+			wantError: false,
+		},
+		{
+			name: "incomplete credit card terms",
+			input: dtos.CreateAccountInput{
+				UserID:      userID.Value(),
+				Name:        "Cartão Visa",
+				Type:        "CREDIT_CARD",
+				Currency:    "BRL",
+				Context:     "PERSONAL",
+				CreditLimit: floatPtr(5000.00),
+			},
+			setupMock: func(m *mockAccountRepository) {},
+			wantError: true,
+			errorMsg:  "must be given together",
+		},
+		{
+			name: "credit card terms on a bank account",
+			input: dtos.CreateAccountInput{
+				UserID:      userID.Value(),
+				Name:        "Conta Corrente",
+				Type:        "BANK",
+				Currency:    "BRL",
+				Context:     "PERSONAL",
+				CreditLimit: floatPtr(5000.00),
+				ClosingDay:  intPtr(25),
+				DueDay:      intPtr(5),
+			},
+			setupMock: func(m *mockAccountRepository) {},
+			wantError: true,
+			errorMsg:  "only credit card accounts",
+		},
+		{
+			name: "bank account with overdraft in warn only mode",
+			input: dtos.CreateAccountInput{
+				UserID:           userID.Value(),
+				Name:             "Conta Corrente",
+				Type:             "BANK",
+				Currency:         "BRL",
+				Context:          "PERSONAL",
+				OverdraftLimit:   floatPtr(1000.00),
+				BalanceLimitMode: "WARN",
+			},
+			setupMock: func(m *mockAccountRepository) {},
+			wantError: false,
+		},
+		{
+			name: "overdraft on a wallet",
+			input: dtos.CreateAccountInput{
+				UserID:         userID.Value(),
+				Name:           "Carteira",
+				Type:           "WALLET",
+				Currency:       "BRL",
+				Context:        "PERSONAL",
+				OverdraftLimit: floatPtr(100.00),
+			},
+			setupMock: func(m *mockAccountRepository) {},
+			wantError: true,
+			errorMsg:  "only bank accounts",
+		},
 		{
 			name: "repository save error",
 			input: dtos.CreateAccountInput{
@@ -216,7 +372,7 @@ func TestCreateAccountUseCase_Execute(t *testing.T) {
 			mockRepo := newMockAccountRepository()
 			tt.setupMock(mockRepo)
 
-			useCase := NewCreateAccountUseCase(mockRepo, eventBus)
+			useCase := NewCreateAccountUseCase(newMockUnitOfWork(mockRepo), eventBus)
 			output, err := useCase.Execute(tt.input)
 
 			if (err != nil) != tt.wantError {
@@ -255,6 +411,23 @@ func TestCreateAccountUseCase_Execute(t *testing.T) {
 				t.Errorf("CreateAccountUseCase.Execute() output.Type = %v, want %v", output.Type, tt.input.Type)
 			}
 
+			if tt.input.CreditLimit != nil {
+				if output.CreditCard == nil || output.CreditCard.CreditLimit != *tt.input.CreditLimit || output.CreditCard.AvailableCredit != *tt.input.CreditLimit {
+					t.Errorf("CreateAccountUseCase.Execute() output.CreditCard = %+v, want the credit card terms", output.CreditCard)
+				}
+			} else if output.CreditCard != nil {
+				t.Errorf("CreateAccountUseCase.Execute() output.CreditCard = %+v, want nil", output.CreditCard)
+			}
+
+			if tt.input.OverdraftLimit != nil {
+				if output.BalanceLimits.OverdraftLimit != *tt.input.OverdraftLimit || output.BalanceLimits.BalanceFloor != -*tt.input.OverdraftLimit ||
+					output.BalanceLimits.Mode != tt.input.BalanceLimitMode {
+					t.Errorf("CreateAccountUseCase.Execute() output.BalanceLimits = %+v, want the overdraft limit", output.BalanceLimits)
+				}
+			} else if output.BalanceLimits.Mode != "ENFORCE" {
+				t.Errorf("CreateAccountUseCase.Execute() output.BalanceLimits.Mode = %v, want ENFORCE", output.BalanceLimits.Mode)
+			}
+
 			// Verify account was saved
 			accountID, _ := valueobjects.NewAccountID(output.AccountID)
 			savedAccount, err := mockRepo.FindByID(accountID)
@@ -268,6 +441,67 @@ func TestCreateAccountUseCase_Execute(t *testing.T) {
 	}
 }
 
+func TestCreateAccountUseCase_OpeningBalance(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+
+	t.Run("records the initial balance as an opening adjustment", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+
+		output, err := NewCreateAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.CreateAccountInput{
+			UserID:         userID.Value(),
+			Name:           "Conta Corrente",
+			Type:           "BANK",
+			InitialBalance: 1500.00,
+			Currency:       "BRL",
+			Context:        "PERSONAL",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Balance != 1500.00 {
+			t.Errorf("expected balance 1500.00, got %+v", output)
+		}
+
+		accountID, _ := valueobjects.NewAccountID(output.AccountID)
+		transactions, _ := transactionpersistence.NewGormTransactionRepository(db).FindByAccountID(accountID)
+		if len(transactions) != 1 {
+			t.Fatalf("expected a single opening balance transaction, got %d", len(transactions))
+		}
+		opening := transactions[0]
+		if opening.TransactionType().Value() != "ADJUSTMENT_IN" || opening.Amount().Amount() != 150000 ||
+			opening.Description().Value() != "Opening balance" || !opening.Date().Equal(currentDay()) {
+			t.Errorf("expected a 1500.00 ADJUSTMENT_IN dated today, got %s %d %q %s",
+				opening.TransactionType().Value(), opening.Amount().Amount(), opening.Description().Value(), opening.Date())
+		}
+	})
+
+	t.Run("records no transaction for a zero initial balance", func(t *testing.T) {
+		unitOfWork := newMockUnitOfWork(newMockAccountRepository())
+
+		_, err := NewCreateAccountUseCase(unitOfWork, eventbus.NewEventBus()).Execute(dtos.CreateAccountInput{
+			UserID:   userID.Value(),
+			Name:     "Carteira",
+			Type:     "WALLET",
+			Currency: "BRL",
+			Context:  "PERSONAL",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(unitOfWork.transactionRepository.transactions) != 0 {
+			t.Errorf("expected no opening balance transaction, got %d", len(unitOfWork.transactionRepository.transactions))
+		}
+	})
+}
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	if len(substr) == 0 {
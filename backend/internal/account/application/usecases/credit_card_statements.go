@@ -0,0 +1,122 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/entities"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+)
+
+// statementDateLayout is the layout of the dates of a statement in DTOs.
+const statementDateLayout = "2006-01-02"
+
+// buildStatements derives the statements of a credit card account from its transactions, merged
+// with the persisted payments and late payment notices, most recent first. Purchases and outgoing
+// transfers are charges and income is a refund; incoming transfers are payments and are not part
+// of any statement. The statement that is open today is always included.
+func buildStatements(
+	account *entities.Account,
+	transactions []*transactionentities.Transaction,
+	persisted []*entities.CreditCardStatement,
+	today time.Time,
+) ([]*entities.CreditCardStatement, error) {
+	terms := account.CreditCardTerms()
+	if terms == nil {
+		return nil, errors.New("invalid account: statements are only available for credit card accounts with a credit limit, closing day and due day")
+	}
+
+	statements := make(map[time.Time]*entities.CreditCardStatement)
+	for _, statement := range persisted {
+		statements[statement.ClosingDate().UTC()] = statement
+	}
+	statementFor := func(date time.Time) (*entities.CreditCardStatement, error) {
+		closingDate := terms.StatementClosingDate(date)
+		if statement, ok := statements[closingDate]; ok {
+			return statement, nil
+		}
+		statement, err := entities.NewCreditCardStatement(account, closingDate)
+		if err != nil {
+			return nil, err
+		}
+		statements[closingDate] = statement
+		return statement, nil
+	}
+
+	for _, transaction := range transactions {
+		transactionType := transaction.TransactionType()
+		if transactionType.IsTransfer() && transactionType.IsCredit() {
+			continue
+		}
+
+		statement, err := statementFor(transaction.Date())
+		if err != nil {
+			return nil, err
+		}
+		if transactionType.IsDebit() {
+			err = statement.AddCharge(transaction.Amount())
+		} else {
+			err = statement.AddRefund(transaction.Amount())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := statementFor(today); err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.CreditCardStatement, 0, len(statements))
+	for _, statement := range statements {
+		result = append(result, statement)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ClosingDate().After(result[j].ClosingDate())
+	})
+
+	return result, nil
+}
+
+// findStatement returns the statement closing in the given month (YYYY-MM).
+func findStatement(statements []*entities.CreditCardStatement, month string) (*entities.CreditCardStatement, error) {
+	if _, err := time.Parse("2006-01", month); err != nil {
+		return nil, fmt.Errorf("invalid month format: expected YYYY-MM, got %s", month)
+	}
+	for _, statement := range statements {
+		if statement.Month() == month {
+			return statement, nil
+		}
+	}
+	return nil, fmt.Errorf("statement not found for month %s", month)
+}
+
+// currentDay returns the current day at midnight UTC.
+func currentDay() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// toStatementOutput converts a statement to a DTO, with its status on the given day.
+func toStatementOutput(statement *entities.CreditCardStatement, day time.Time) *dtos.StatementOutput {
+	output := &dtos.StatementOutput{
+		Month:          statement.Month(),
+		PeriodStart:    statement.PeriodStart().Format(statementDateLayout),
+		ClosingDate:    statement.ClosingDate().Format(statementDateLayout),
+		DueDate:        statement.DueDate().Format(statementDateLayout),
+		Status:         statement.Status(day),
+		IsOverdue:      statement.IsOverdue(day),
+		Total:          statement.Total().Float64(),
+		PaidAmount:     statement.PaidAmount().Float64(),
+		AmountDue:      statement.AmountDue().Float64(),
+		MinimumPayment: statement.MinimumPayment().Float64(),
+		Currency:       statement.Total().Currency().Code(),
+		ChargeCount:    statement.ChargeCount(),
+	}
+	if statement.PaidAt() != nil {
+		output.PaidAt = statement.PaidAt().Format(statementDateLayout)
+	}
+	return output
+}
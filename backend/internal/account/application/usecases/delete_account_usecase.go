@@ -0,0 +1,284 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/entities"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// DeleteAccountUseCase handles closing an account.
+// The account is soft deleted, so its transactions stay in the history and in reports.
+// A remaining balance must first be moved out of the account, either by a transfer to
+// another account of the user or by a write-off transaction. It uses UnitOfWork so the
+// closing transactions, the balances and the deletion are saved atomically.
+type DeleteAccountUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewDeleteAccountUseCase creates a new DeleteAccountUseCase instance.
+func NewDeleteAccountUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute performs the account closing.
+func (uc *DeleteAccountUseCase) Execute(input dtos.DeleteAccountInput) (*dtos.DeleteAccountOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var transferToAccountID *valueobjects.AccountID
+	if input.TransferToAccountID != "" {
+		id, err := valueobjects.NewAccountID(input.TransferToAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transfer account ID: %w", err)
+		}
+		if id.Equals(accountID) {
+			return nil, errors.New("invalid transfer account: the balance must be transferred to a different account")
+		}
+		if input.WriteOff {
+			return nil, errors.New("invalid account closing: give either a transfer account or a write-off, not both")
+		}
+		transferToAccountID = &id
+	}
+
+	// Parse closing date (defaults to today)
+	date := time.Now().UTC().Truncate(24 * time.Hour)
+	if input.Date != "" {
+		date, err = time.Parse("2006-01-02", input.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date format: expected YYYY-MM-DD, got %s", input.Date)
+		}
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				// Log rollback error but don't fail the function
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	// Get repositories from UnitOfWork (within transaction)
+	accountRepository := uc.unitOfWork.AccountRepository()
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
+
+	account, err := findUserAccount(accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	closingBalance := account.Balance()
+	var closingTransactions []*transactionentities.Transaction
+
+	if !closingBalance.IsZero() {
+		if !account.IsActive() {
+			return nil, errors.New("cannot close an archived account with a non-zero balance: reactivate it first")
+		}
+
+		switch {
+		case transferToAccountID != nil:
+			destination, err := findTransferAccount(accountRepository, userID, *transferToAccountID)
+			if err != nil {
+				return nil, err
+			}
+			closingTransactions, err = transferClosingBalance(account, destination, date)
+			if err != nil {
+				return nil, err
+			}
+			if err := accountRepository.Save(destination); err != nil {
+				return nil, fmt.Errorf("failed to save account: %w", err)
+			}
+		case input.WriteOff:
+			writeOff, err := writeOffClosingBalance(account, date)
+			if err != nil {
+				return nil, err
+			}
+			closingTransactions = append(closingTransactions, writeOff)
+		default:
+			return nil, fmt.Errorf("cannot close account with a non-zero balance (%s): transfer the balance to another account or write it off", closingBalance.String())
+		}
+
+		// Save closing transactions (within transaction)
+		for _, transaction := range closingTransactions {
+			if err := transactionRepository.Save(transaction); err != nil {
+				return nil, fmt.Errorf("failed to save closing transaction: %w", err)
+			}
+			if err := recordTransactionCreation(revisionRepository, transaction, userID, input.RequestID); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := accountRepository.Save(account); err != nil {
+			return nil, fmt.Errorf("failed to save account: %w", err)
+		}
+	}
+
+	// Soft delete the account: its transactions are kept
+	if err := accountRepository.Delete(accountID); err != nil {
+		return nil, fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	closingTransactionIDs := make([]string, 0, len(closingTransactions))
+	for _, transaction := range closingTransactions {
+		for _, event := range transaction.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				// Log error but don't fail the account closing
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		transaction.ClearEvents()
+		closingTransactionIDs = append(closingTransactionIDs, transaction.ID().Value())
+	}
+	publishAccountEvents(uc.eventBus, account)
+
+	output := &dtos.DeleteAccountOutput{
+		Message:               "Account closed successfully",
+		AccountID:             accountID.Value(),
+		ClosingTransactionIDs: closingTransactionIDs,
+		ClosingBalance:        closingBalance.Float64(),
+		Currency:              closingBalance.Currency().Code(),
+	}
+	if transferToAccountID != nil && len(closingTransactions) > 0 {
+		output.TransferredToAccountID = transferToAccountID.Value()
+	}
+
+	return output, nil
+}
+
+// findTransferAccount loads the account that receives the balance of a closed account
+// and checks that it belongs to the user.
+func findTransferAccount(
+	accountRepository repositories.AccountRepository,
+	userID identityvalueobjects.UserID,
+	accountID valueobjects.AccountID,
+) (*entities.Account, error) {
+	account, err := accountRepository.FindByID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transfer account: %w", err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("transfer account not found: %s", accountID.Value())
+	}
+	if !account.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("transfer account does not belong to user")
+	}
+	return account, nil
+}
+
+// transferClosingBalance moves the balance of an account being closed to another account
+// of the same currency, and returns both legs of the transfer. A negative balance is
+// covered by a transfer from the other account.
+func transferClosingBalance(account, destination *entities.Account, date time.Time) ([]*transactionentities.Transaction, error) {
+	balance := account.Balance()
+	if !balance.Currency().Equals(destination.Balance().Currency()) {
+		return nil, fmt.Errorf("cannot transfer the closing balance to an account in a different currency (%s to %s)",
+			balance.Currency().Code(), destination.Balance().Currency().Code())
+	}
+
+	description, err := transactionvalueobjects.NewTransactionDescription("Closing balance transfer: " + account.Name().Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create closing transfer: %w", err)
+	}
+
+	from, to := account, destination
+	amount := balance
+	if balance.IsNegative() {
+		from, to = destination, account
+		amount = balance.Negate()
+	}
+
+	outgoing, incoming, err := transactionentities.NewTransfer(account.UserID(), from.ID(), to.ID(), amount, amount, description, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create closing transfer: %w", err)
+	}
+	if err := from.Debit(amount); err != nil {
+		return nil, fmt.Errorf("failed to debit account: %w", err)
+	}
+	if err := to.Credit(amount); err != nil {
+		return nil, fmt.Errorf("failed to credit account: %w", err)
+	}
+
+	return []*transactionentities.Transaction{outgoing, incoming}, nil
+}
+
+// writeOffClosingBalance zeroes the balance of an account being closed with a balance adjustment
+// (ADJUSTMENT_OUT, or ADJUSTMENT_IN if the balance is negative), so the write-off is left out of
+// income and expense reports and budgets.
+func writeOffClosingBalance(account *entities.Account, date time.Time) (*transactionentities.Transaction, error) {
+	description, err := transactionvalueobjects.NewTransactionDescription("Closing balance write-off: " + account.Name().Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create write-off transaction: %w", err)
+	}
+
+	difference, err := account.AdjustBalance(sharedvalueobjects.Zero(account.Balance().Currency()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to write off balance: %w", err)
+	}
+
+	writeOff, err := transactionentities.NewBalanceAdjustment(account.UserID(), account.ID(), difference, description, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create write-off transaction: %w", err)
+	}
+
+	return writeOff, nil
+}
+
+// recordTransactionCreation records the creation of a transaction in its edit history.
+func recordTransactionCreation(
+	revisionRepository transactionrepositories.TransactionRevisionRepository,
+	transaction *transactionentities.Transaction,
+	userID identityvalueobjects.UserID,
+	requestID string,
+) error {
+	revision, err := transactionentities.NewTransactionRevision(
+		transaction.ID(),
+		transaction.UserID(),
+		transactionentities.RevisionActionCreate,
+		nil,
+		transactionentities.SnapshotOf(transaction),
+		&userID,
+		requestID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record transaction revision: %w", err)
+	}
+	if err := revisionRepository.Save(revision); err != nil {
+		return fmt.Errorf("failed to record transaction revision: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package usecases
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/entities"
+	accountpersistence "gestao-financeira/backend/internal/account/infrastructure/persistence"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	reportingdtos "gestao-financeira/backend/internal/reporting/application/dtos"
+	reportingusecases "gestao-financeira/backend/internal/reporting/application/usecases"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	sharedpersistence "gestao-financeira/backend/internal/shared/infrastructure/persistence"
+	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupDeleteAccountTestDB creates a temporary SQLite database with the account and transaction tables.
+func setupDeleteAccountTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "accounts.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(
+		&accountpersistence.AccountModel{},
+		&transactionpersistence.TransactionModel{},
+		&transactionpersistence.TransactionSplitModel{},
+		&transactionpersistence.TransactionTagModel{},
+		&transactionpersistence.TransactionRecurrenceSkipModel{},
+		&transactionpersistence.TransactionRecurrenceAmountModel{},
+		&transactionpersistence.TransactionRevisionModel{},
+	); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return db
+}
+
+func saveTestAccount(t *testing.T, db *gorm.DB, userID identityvalueobjects.UserID, name string, balance float64, currency string) *entities.Account {
+	t.Helper()
+
+	account, err := createTestAccount(userID, name, "BANK", balance, currency, "PERSONAL")
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+	if err := accountpersistence.NewGormAccountRepository(db).Save(account); err != nil {
+		t.Fatalf("failed to save account: %v", err)
+	}
+	return account
+}
+
+func TestDeleteAccountUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+
+	t.Run("closes an account with a zero balance", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Vazia", 0, "BRL")
+
+		output, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID: account.ID().Value(),
+			UserID:    userID.Value(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.ClosingTransactionIDs) != 0 {
+			t.Errorf("expected no closing transactions, got %d", len(output.ClosingTransactionIDs))
+		}
+
+		found, _ := accountpersistence.NewGormAccountRepository(db).FindByID(account.ID())
+		if found != nil {
+			t.Errorf("expected account to be deleted")
+		}
+	})
+
+	t.Run("refuses to close an account with a balance", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Corrente", 150.00, "BRL")
+
+		_, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID: account.ID().Value(),
+			UserID:    userID.Value(),
+		})
+		if err == nil || !containsString(err.Error(), "non-zero balance") {
+			t.Fatalf("expected a non-zero balance error, got %v", err)
+		}
+
+		found, _ := accountpersistence.NewGormAccountRepository(db).FindByID(account.ID())
+		if found == nil {
+			t.Errorf("expected account to be kept")
+		}
+	})
+
+	t.Run("transfers the balance to another account", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Corrente", 150.00, "BRL")
+		destination := saveTestAccount(t, db, userID, "Conta Poupança", 50.00, "BRL")
+
+		output, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID:           account.ID().Value(),
+			UserID:              userID.Value(),
+			TransferToAccountID: destination.ID().Value(),
+			Date:                "2026-10-15",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.ClosingTransactionIDs) != 2 || output.TransferredToAccountID != destination.ID().Value() {
+			t.Errorf("expected a two-leg transfer to the destination, got %+v", output)
+		}
+		if output.ClosingBalance != 150.00 {
+			t.Errorf("expected closing balance 150.00, got %f", output.ClosingBalance)
+		}
+
+		found, _ := accountpersistence.NewGormAccountRepository(db).FindByID(destination.ID())
+		if found.Balance().Amount() != 20000 {
+			t.Errorf("expected destination balance 20000, got %d", found.Balance().Amount())
+		}
+
+		// The history of the closed account is kept
+		transactions, _ := transactionpersistence.NewGormTransactionRepository(db).FindByAccountID(account.ID())
+		if len(transactions) != 1 || !transactions[0].TransactionType().IsTransfer() {
+			t.Errorf("expected the outgoing transfer to stay on the closed account, got %d transactions", len(transactions))
+		}
+	})
+
+	t.Run("refuses a transfer to an account in another currency", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Corrente", 150.00, "BRL")
+		destination := saveTestAccount(t, db, userID, "Conta Dólar", 0, "USD")
+
+		_, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID:           account.ID().Value(),
+			UserID:              userID.Value(),
+			TransferToAccountID: destination.ID().Value(),
+		})
+		if err == nil || !containsString(err.Error(), "different currency") {
+			t.Fatalf("expected a currency error, got %v", err)
+		}
+	})
+
+	t.Run("writes off the balance", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Carteira", 42.50, "BRL")
+
+		output, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID: account.ID().Value(),
+			UserID:    userID.Value(),
+			WriteOff:  true,
+			Date:      "2026-10-15",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.ClosingTransactionIDs) != 1 {
+			t.Fatalf("expected a write-off transaction, got %d", len(output.ClosingTransactionIDs))
+		}
+
+		transactionRepository := transactionpersistence.NewGormTransactionRepository(db)
+		transactions, _ := transactionRepository.FindByAccountID(account.ID())
+		if len(transactions) != 1 || transactions[0].TransactionType().Value() != "ADJUSTMENT_OUT" || transactions[0].Amount().Amount() != 4250 {
+			t.Errorf("expected a 42.50 balance adjustment on the closed account")
+		}
+
+		// The write-off is not an expense of the month
+		report, err := reportingusecases.NewMonthlyReportUseCase(transactionRepository, nil).Execute(reportingdtos.MonthlyReportInput{
+			UserID: userID.Value(),
+			Year:   2026,
+			Month:  10,
+		})
+		if err != nil {
+			t.Fatalf("unexpected report error: %v", err)
+		}
+		if report.TotalExpense != 0 || report.TotalIncome != 0 || report.TotalCount != 0 {
+			t.Errorf("expected the write-off to be left out of the report, got %+v", report)
+		}
+	})
+
+	t.Run("refuses to close an archived account with a balance", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Antiga", 10.00, "BRL")
+		_ = account.Deactivate()
+		_ = accountpersistence.NewGormAccountRepository(db).Save(account)
+
+		_, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID: account.ID().Value(),
+			UserID:    userID.Value(),
+			WriteOff:  true,
+		})
+		if err == nil || !containsString(err.Error(), "reactivate it first") {
+			t.Fatalf("expected an archived account error, got %v", err)
+		}
+	})
+
+	t.Run("refuses both a transfer and a write-off", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, userID, "Conta Corrente", 150.00, "BRL")
+		destination := saveTestAccount(t, db, userID, "Conta Poupança", 0, "BRL")
+
+		_, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID:           account.ID().Value(),
+			UserID:              userID.Value(),
+			TransferToAccountID: destination.ID().Value(),
+			WriteOff:            true,
+		})
+		if err == nil || !containsString(err.Error(), "invalid account closing") {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+	})
+
+	t.Run("refuses an account of another user", func(t *testing.T) {
+		db := setupDeleteAccountTestDB(t)
+		account := saveTestAccount(t, db, identityvalueobjects.GenerateUserID(), "Conta Corrente", 0, "BRL")
+
+		_, err := NewDeleteAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus()).Execute(dtos.DeleteAccountInput{
+			AccountID: account.ID().Value(),
+			UserID:    userID.Value(),
+		})
+		if err == nil || !containsString(err.Error(), "does not belong to user") {
+			t.Fatalf("expected a forbidden error, got %v", err)
+		}
+	})
+}
@@ -47,16 +47,18 @@ func (uc *GetAccountUseCase) Execute(input dtos.GetAccountInput) (*dtos.GetAccou
 	// Convert to output DTO
 	balance := account.Balance()
 	output := &dtos.GetAccountOutput{
-		AccountID: account.ID().Value(),
-		UserID:    account.UserID().Value(),
-		Name:      account.Name().Value(),
-		Type:      account.AccountType().Value(),
-		Balance:   balance.Float64(),
-		Currency:  balance.Currency().Code(),
-		Context:   account.Context().Value(),
-		IsActive:  account.IsActive(),
-		CreatedAt: account.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: account.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		AccountID:     account.ID().Value(),
+		UserID:        account.UserID().Value(),
+		Name:          account.Name().Value(),
+		Type:          account.AccountType().Value(),
+		Balance:       balance.Float64(),
+		Currency:      balance.Currency().Code(),
+		Context:       account.Context().Value(),
+		IsActive:      account.IsActive(),
+		CreatedAt:     account.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     account.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreditCard:    toCreditCardOutput(account),
+		BalanceLimits: toBalanceLimitsOutput(account),
 	}
 
 	return output, nil
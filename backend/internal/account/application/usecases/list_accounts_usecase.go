@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"fmt"
+	"sort"
 
 	"gestao-financeira/backend/internal/account/application/dtos"
 	"gestao-financeira/backend/internal/account/domain/entities"
@@ -43,7 +44,9 @@ func (uc *ListAccountsUseCase) Execute(input dtos.ListAccountsInput) (*dtos.List
 	var total int64
 
 	// Check if we should use pagination
-	if usePagination {
+	// Archived accounts are filtered out after loading, so only listings that include
+	// them can be paginated by the repository.
+	if usePagination && input.IncludeArchived {
 		// Use paginated query
 		domainAccounts, total, err = uc.accountRepository.FindByUserIDWithPagination(
 			userID,
@@ -68,14 +71,22 @@ func (uc *ListAccountsUseCase) Execute(input dtos.ListAccountsInput) (*dtos.List
 			if err != nil {
 				return nil, fmt.Errorf("failed to find accounts: %w", err)
 			}
-			total = int64(len(domainAccounts))
 		} else {
 			// Find all accounts for the user
 			domainAccounts, err = uc.accountRepository.FindByUserID(userID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to find accounts: %w", err)
 			}
-			total = int64(len(domainAccounts))
+		}
+
+		// Hide archived accounts (e.g. from account pickers) unless asked for
+		if !input.IncludeArchived {
+			domainAccounts = activeAccounts(domainAccounts)
+		}
+		total = int64(len(domainAccounts))
+
+		if usePagination {
+			domainAccounts = paginateAccounts(domainAccounts, paginationParams.CalculateOffset(), paginationParams.Limit)
 		}
 	}
 
@@ -99,20 +110,35 @@ func (uc *ListAccountsUseCase) Execute(input dtos.ListAccountsInput) (*dtos.List
 func (uc *ListAccountsUseCase) toAccountOutputs(domainAccounts []*entities.Account) []*dtos.AccountOutput {
 	outputs := make([]*dtos.AccountOutput, 0, len(domainAccounts))
 	for _, account := range domainAccounts {
-		balance := account.Balance()
-		output := &dtos.AccountOutput{
-			AccountID: account.ID().Value(),
-			UserID:    account.UserID().Value(),
-			Name:      account.Name().Value(),
-			Type:      account.AccountType().Value(),
-			Balance:   balance.Float64(),
-			Currency:  balance.Currency().Code(),
-			Context:   account.Context().Value(),
-			IsActive:  account.IsActive(),
-			CreatedAt: account.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt: account.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
-		}
-		outputs = append(outputs, output)
+		outputs = append(outputs, toAccountOutput(account))
 	}
 	return outputs
 }
+
+// activeAccounts returns the accounts that are not archived.
+func activeAccounts(domainAccounts []*entities.Account) []*entities.Account {
+	active := make([]*entities.Account, 0, len(domainAccounts))
+	for _, account := range domainAccounts {
+		if account.IsActive() {
+			active = append(active, account)
+		}
+	}
+	return active
+}
+
+// paginateAccounts returns the page of accounts starting at offset,
+// ordered by creation date (most recent first) like the paginated repository query.
+func paginateAccounts(domainAccounts []*entities.Account, offset, limit int) []*entities.Account {
+	sort.SliceStable(domainAccounts, func(i, j int) bool {
+		return domainAccounts[i].CreatedAt().After(domainAccounts[j].CreatedAt())
+	})
+
+	if offset >= len(domainAccounts) {
+		return []*entities.Account{}
+	}
+	end := offset + limit
+	if end > len(domainAccounts) {
+		end = len(domainAccounts)
+	}
+	return domainAccounts[offset:end]
+}
@@ -86,6 +86,39 @@ func TestListAccountsUseCase_Execute(t *testing.T) {
 			wantError: false,
 			wantCount: 2,
 		},
+		{
+			name: "archived accounts are hidden by default",
+			input: dtos.ListAccountsInput{
+				UserID:  userID.Value(),
+				Context: "",
+			},
+			setupMock: func(m *mockListAccountRepository) {
+				account1, _ := createTestAccount(userID, "Conta Corrente", "BANK", 1000.00, "BRL", "PERSONAL")
+				account2, _ := createTestAccount(userID, "Conta Antiga", "BANK", 0, "BRL", "PERSONAL")
+				_ = account2.Deactivate()
+				_ = m.Save(account1)
+				_ = m.Save(account2)
+			},
+			wantError: false,
+			wantCount: 1,
+		},
+		{
+			name: "list archived accounts when requested",
+			input: dtos.ListAccountsInput{
+				UserID:          userID.Value(),
+				Context:         "",
+				IncludeArchived: true,
+			},
+			setupMock: func(m *mockListAccountRepository) {
+				account1, _ := createTestAccount(userID, "Conta Corrente", "BANK", 1000.00, "BRL", "PERSONAL")
+				account2, _ := createTestAccount(userID, "Conta Antiga", "BANK", 0, "BRL", "PERSONAL")
+				_ = account2.Deactivate()
+				_ = m.Save(account1)
+				_ = m.Save(account2)
+			},
+			wantError: false,
+			wantCount: 2,
+		},
 		{
 			name: "list accounts for user with no accounts",
 			input: dtos.ListAccountsInput{
@@ -201,6 +234,35 @@ func TestListAccountsUseCase_Execute(t *testing.T) {
 	}
 }
 
+func TestListAccountsUseCase_Execute_PaginationHidesArchived(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	mockRepo := newMockListAccountRepository()
+	for i, name := range []string{"Conta 1", "Conta 2", "Conta 3"} {
+		account, _ := createTestAccount(userID, name, "BANK", 0, "BRL", "PERSONAL")
+		if i == 1 {
+			_ = account.Deactivate()
+		}
+		_ = mockRepo.Save(account)
+	}
+
+	output, err := NewListAccountsUseCase(mockRepo).Execute(dtos.ListAccountsInput{
+		UserID: userID.Value(),
+		Page:   "1",
+		Limit:  "10",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Count != 2 || output.Pagination == nil || output.Pagination.Total != 2 {
+		t.Errorf("expected 2 active accounts in the page and in the total, got %d and %+v", output.Count, output.Pagination)
+	}
+	for _, account := range output.Accounts {
+		if !account.IsActive {
+			t.Errorf("expected archived account %s to be hidden", account.Name)
+		}
+	}
+}
+
 // Helper function to create a test account
 func createTestAccount(
 	userID identityvalueobjects.UserID,
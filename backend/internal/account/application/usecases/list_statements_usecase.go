@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"fmt"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// ListStatementsUseCase handles listing the statements (faturas) of a credit card account.
+type ListStatementsUseCase struct {
+	accountRepository     repositories.AccountRepository
+	transactionRepository transactionrepositories.TransactionRepository
+	statementRepository   repositories.CreditCardStatementRepository
+}
+
+// NewListStatementsUseCase creates a new ListStatementsUseCase instance.
+func NewListStatementsUseCase(
+	accountRepository repositories.AccountRepository,
+	transactionRepository transactionrepositories.TransactionRepository,
+	statementRepository repositories.CreditCardStatementRepository,
+) *ListStatementsUseCase {
+	return &ListStatementsUseCase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		statementRepository:   statementRepository,
+	}
+}
+
+// Execute lists the statements of a credit card account, most recent first,
+// optionally filtered by status.
+func (uc *ListStatementsUseCase) Execute(input dtos.ListStatementsInput) (*dtos.ListStatementsOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := findUserAccount(uc.accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.transactionRepository.FindByAccountID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	persisted, err := uc.statementRepository.FindByAccountID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find statements: %w", err)
+	}
+
+	day := currentDay()
+	statements, err := buildStatements(account, transactions, persisted, day)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*dtos.StatementOutput, 0, len(statements))
+	for _, statement := range statements {
+		output := toStatementOutput(statement, day)
+		if input.Status != "" && output.Status != input.Status {
+			continue
+		}
+		outputs = append(outputs, output)
+	}
+
+	balance := account.Balance()
+	availableCredit, _ := account.AvailableCredit()
+
+	return &dtos.ListStatementsOutput{
+		AccountID:       accountID.Value(),
+		Currency:        balance.Currency().Code(),
+		CreditLimit:     account.CreditCardTerms().CreditLimit().Float64(),
+		Balance:         balance.Float64(),
+		AvailableCredit: availableCredit.Float64(),
+		Statements:      outputs,
+		Count:           len(outputs),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	"gestao-financeira/backend/internal/account/domain/entities"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	notificationdtos "gestao-financeira/backend/internal/notification/application/dtos"
+	notificationusecases "gestao-financeira/backend/internal/notification/application/usecases"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// NotifyOverdueStatementsUseCase handles notifying users of credit card statements that are past
+// their due date without the minimum payment. Each statement is notified once.
+type NotifyOverdueStatementsUseCase struct {
+	accountRepository         repositories.AccountRepository
+	transactionRepository     transactionrepositories.TransactionRepository
+	statementRepository       repositories.CreditCardStatementRepository
+	createNotificationUseCase *notificationusecases.CreateNotificationUseCase
+}
+
+// NewNotifyOverdueStatementsUseCase creates a new NotifyOverdueStatementsUseCase instance.
+func NewNotifyOverdueStatementsUseCase(
+	accountRepository repositories.AccountRepository,
+	transactionRepository transactionrepositories.TransactionRepository,
+	statementRepository repositories.CreditCardStatementRepository,
+	createNotificationUseCase *notificationusecases.CreateNotificationUseCase,
+) *NotifyOverdueStatementsUseCase {
+	return &NotifyOverdueStatementsUseCase{
+		accountRepository:         accountRepository,
+		transactionRepository:     transactionRepository,
+		statementRepository:       statementRepository,
+		createNotificationUseCase: createNotificationUseCase,
+	}
+}
+
+// Execute notifies the overdue statements of all credit card accounts on the given day
+// and returns how many notifications were created.
+func (uc *NotifyOverdueStatementsUseCase) Execute(day time.Time) (int, error) {
+	day = day.UTC().Truncate(24 * time.Hour)
+
+	accounts, err := uc.accountRepository.FindByType(valueobjects.CreditCardType())
+	if err != nil {
+		return 0, fmt.Errorf("failed to find credit card accounts: %w", err)
+	}
+
+	notified := 0
+	for _, account := range accounts {
+		if account.CreditCardTerms() == nil {
+			continue
+		}
+
+		transactions, err := uc.transactionRepository.FindByAccountID(account.ID())
+		if err != nil {
+			return notified, fmt.Errorf("failed to find transactions: %w", err)
+		}
+		persisted, err := uc.statementRepository.FindByAccountID(account.ID())
+		if err != nil {
+			return notified, fmt.Errorf("failed to find statements: %w", err)
+		}
+		statements, err := buildStatements(account, transactions, persisted, day)
+		if err != nil {
+			return notified, err
+		}
+
+		for _, statement := range statements {
+			if !statement.IsOverdue(day) || statement.LateNotifiedAt() != nil {
+				continue
+			}
+			if err := uc.notify(account, statement); err != nil {
+				return notified, err
+			}
+			statement.MarkLateNotified(time.Now())
+			if err := uc.statementRepository.Save(statement); err != nil {
+				return notified, fmt.Errorf("failed to save statement: %w", err)
+			}
+			notified++
+		}
+	}
+
+	return notified, nil
+}
+
+// notify creates the late payment notification of a statement.
+func (uc *NotifyOverdueStatementsUseCase) notify(account *entities.Account, statement *entities.CreditCardStatement) error {
+	_, err := uc.createNotificationUseCase.Execute(notificationdtos.CreateNotificationInput{
+		UserID: account.UserID().Value(),
+		Title:  "Credit card statement overdue",
+		Message: fmt.Sprintf("The %s statement of %s was due on %s and %s is still due (minimum payment: %s).",
+			statement.Month(), account.Name().Value(), statement.DueDate().Format(statementDateLayout),
+			statement.AmountDue().String(), statement.MinimumPayment().String()),
+		Type: "WARNING",
+		Metadata: map[string]interface{}{
+			"account_id":      account.ID().Value(),
+			"statement_month": statement.Month(),
+			"due_date":        statement.DueDate().Format(statementDateLayout),
+			"amount_due":      statement.AmountDue().Float64(),
+			"minimum_payment": statement.MinimumPayment().Float64(),
+			"currency":        statement.AmountDue().Currency().Code(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create late payment notification: %w", err)
+	}
+	return nil
+}
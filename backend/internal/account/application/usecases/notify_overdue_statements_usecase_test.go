@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"testing"
+
+	accountpersistence "gestao-financeira/backend/internal/account/infrastructure/persistence"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	notificationusecases "gestao-financeira/backend/internal/notification/application/usecases"
+	notificationpersistence "gestao-financeira/backend/internal/notification/infrastructure/persistence"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
+)
+
+func TestNotifyOverdueStatementsUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	db := setupStatementTestDB(t)
+	if err := db.AutoMigrate(&notificationpersistence.NotificationModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	notificationRepository := notificationpersistence.NewGormNotificationRepository(db)
+
+	// An unpaid statement that closed about three months ago, and purchases on the open statement
+	card := saveTestCreditCard(t, db, userID)
+	closingDate := card.CreditCardTerms().StatementClosingDate(currentDay().AddDate(0, -3, 0))
+	saveTestCardTransaction(t, db, card, "EXPENSE", 300.00, closingDate)
+	saveTestCardTransaction(t, db, card, "EXPENSE", 80.00, currentDay())
+
+	// A card with terms and no purchases, and a bank account
+	saveTestCreditCard(t, db, identityvalueobjects.GenerateUserID())
+	saveTestAccount(t, db, userID, "Conta Corrente", 100.00, "BRL")
+
+	useCase := NewNotifyOverdueStatementsUseCase(
+		accountpersistence.NewGormAccountRepository(db),
+		transactionpersistence.NewGormTransactionRepository(db),
+		accountpersistence.NewGormCreditCardStatementRepository(db),
+		notificationusecases.NewCreateNotificationUseCase(notificationRepository, eventbus.NewEventBus()),
+	)
+
+	notified, err := useCase.Execute(currentDay())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if notified != 1 {
+		t.Fatalf("expected 1 notification, got %d", notified)
+	}
+
+	notifications, _ := notificationRepository.FindByUserID(userID)
+	if len(notifications) != 1 || notifications[0].Type().Value() != "WARNING" {
+		t.Fatalf("expected a warning for the user, got %d notifications", len(notifications))
+	}
+	if !containsString(notifications[0].Message().Value(), closingDate.Format("2006-01")) {
+		t.Errorf("expected the message to name the statement, got %q", notifications[0].Message().Value())
+	}
+
+	statement, _ := accountpersistence.NewGormCreditCardStatementRepository(db).FindByAccountIDAndClosingDate(card.ID(), closingDate)
+	if statement == nil || statement.LateNotifiedAt() == nil {
+		t.Errorf("expected the statement to be marked as notified")
+	}
+
+	// Each statement is notified once
+	notified, err = useCase.Execute(currentDay())
+	if err != nil || notified != 0 {
+		t.Errorf("expected no new notifications, got %d, %v", notified, err)
+	}
+}
@@ -0,0 +1,187 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// PayStatementUseCase handles paying a credit card statement from another account of the user.
+// The payment is a transfer from the paying account to the credit card. It uses UnitOfWork so
+// the transfer, the balances and the payment of the statement are saved atomically.
+type PayStatementUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewPayStatementUseCase creates a new PayStatementUseCase instance.
+func NewPayStatementUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *PayStatementUseCase {
+	return &PayStatementUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute performs the statement payment.
+// The amount defaults to the amount due; a smaller amount is a partial payment.
+func (uc *PayStatementUseCase) Execute(input dtos.PayStatementInput) (*dtos.PayStatementOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccountID, err := valueobjects.NewAccountID(input.FromAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid paying account ID: %w", err)
+	}
+	if fromAccountID.Equals(accountID) {
+		return nil, errors.New("invalid paying account: the statement must be paid from a different account")
+	}
+
+	// Parse payment date (defaults to today)
+	date := currentDay()
+	if input.Date != "" {
+		date, err = time.Parse("2006-01-02", input.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date format: expected YYYY-MM-DD, got %s", input.Date)
+		}
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				// Log rollback error but don't fail the function
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	// Get repositories from UnitOfWork (within transaction)
+	accountRepository := uc.unitOfWork.AccountRepository()
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
+	statementRepository := uc.unitOfWork.CreditCardStatementRepository()
+
+	card, err := findUserAccount(accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	fromAccount, err := findTransferAccount(accountRepository, userID, fromAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := transactionRepository.FindByAccountID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+	persisted, err := statementRepository.FindByAccountID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find statements: %w", err)
+	}
+	statements, err := buildStatements(card, transactions, persisted, date)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := findStatement(statements, input.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := card.Balance().Currency()
+	if !fromAccount.Balance().Currency().Equals(currency) {
+		return nil, fmt.Errorf("cannot pay statement from an account in a different currency (%s to %s)",
+			fromAccount.Balance().Currency().Code(), currency.Code())
+	}
+
+	amount := statement.AmountDue()
+	if input.Amount != nil {
+		amount, err = sharedvalueobjects.NewMoneyFromFloat(*input.Amount, currency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payment amount: %w", err)
+		}
+	}
+	if err := statement.Pay(amount, date); err != nil {
+		return nil, err
+	}
+
+	description, err := transactionvalueobjects.NewTransactionDescription(
+		fmt.Sprintf("Credit card payment: %s %s", card.Name().Value(), statement.Month()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment transfer: %w", err)
+	}
+	outgoing, incoming, err := transactionentities.NewTransfer(userID, fromAccountID, accountID, amount, amount, description, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment transfer: %w", err)
+	}
+	if err := fromAccount.Debit(amount); err != nil {
+		return nil, fmt.Errorf("failed to debit account: %w", err)
+	}
+	if err := card.Credit(amount); err != nil {
+		return nil, fmt.Errorf("failed to credit account: %w", err)
+	}
+
+	// Save the payment transfer and the balances (within transaction)
+	payment := []*transactionentities.Transaction{outgoing, incoming}
+	for _, transaction := range payment {
+		if err := transactionRepository.Save(transaction); err != nil {
+			return nil, fmt.Errorf("failed to save payment transaction: %w", err)
+		}
+		if err := recordTransactionCreation(revisionRepository, transaction, userID, input.RequestID); err != nil {
+			return nil, err
+		}
+	}
+	if err := accountRepository.Save(fromAccount); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+	if err := accountRepository.Save(card); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+	if err := statementRepository.Save(statement); err != nil {
+		return nil, fmt.Errorf("failed to save statement: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	transactionIDs := make([]string, 0, len(payment))
+	for _, transaction := range payment {
+		for _, event := range transaction.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				// Log error but don't fail the payment
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		transaction.ClearEvents()
+		transactionIDs = append(transactionIDs, transaction.ID().Value())
+	}
+	publishAccountEvents(uc.eventBus, fromAccount)
+	publishAccountEvents(uc.eventBus, card)
+
+	return &dtos.PayStatementOutput{
+		Statement:      toStatementOutput(statement, currentDay()),
+		TransactionIDs: transactionIDs,
+		PaidAmount:     amount.Float64(),
+		Currency:       currency.Code(),
+	}, nil
+}
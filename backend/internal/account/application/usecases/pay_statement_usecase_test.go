@@ -0,0 +1,222 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/entities"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	accountpersistence "gestao-financeira/backend/internal/account/infrastructure/persistence"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	sharedpersistence "gestao-financeira/backend/internal/shared/infrastructure/persistence"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
+
+	"gorm.io/gorm"
+)
+
+// setupStatementTestDB creates a temporary SQLite database with the account, transaction and statement tables.
+func setupStatementTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db := setupDeleteAccountTestDB(t)
+	if err := db.AutoMigrate(&accountpersistence.CreditCardStatementModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
+
+// saveTestCreditCard saves a credit card with a 5000.00 limit, closing on the 25th and due on the 5th.
+func saveTestCreditCard(t *testing.T, db *gorm.DB, userID identityvalueobjects.UserID) *entities.Account {
+	t.Helper()
+
+	card, err := createTestAccount(userID, "Cartão Visa", "CREDIT_CARD", 0, "BRL", "PERSONAL")
+	if err != nil {
+		t.Fatalf("failed to create credit card: %v", err)
+	}
+	terms, err := newCreditCardTerms(5000.00, 25, 5, sharedvalueobjects.MustCurrency("BRL"))
+	if err != nil {
+		t.Fatalf("failed to create credit card terms: %v", err)
+	}
+	if err := card.SetCreditCardTerms(terms); err != nil {
+		t.Fatalf("failed to set credit card terms: %v", err)
+	}
+	if err := accountpersistence.NewGormAccountRepository(db).Save(card); err != nil {
+		t.Fatalf("failed to save credit card: %v", err)
+	}
+	return card
+}
+
+// saveTestCardTransaction saves a purchase (EXPENSE) or refund (INCOME) on a credit card and updates its balance.
+func saveTestCardTransaction(t *testing.T, db *gorm.DB, card *entities.Account, transactionType string, amount float64, date time.Time) {
+	t.Helper()
+
+	money, _ := sharedvalueobjects.NewMoneyFromFloat(amount, card.Balance().Currency())
+	transaction, err := transactionentities.NewTransaction(
+		card.UserID(),
+		card.ID(),
+		transactionvalueobjects.MustTransactionType(transactionType),
+		money,
+		transactionvalueobjects.MustTransactionDescription("Compra no cartão"),
+		date,
+	)
+	if err != nil {
+		t.Fatalf("failed to create transaction: %v", err)
+	}
+	if err := transactionpersistence.NewGormTransactionRepository(db).Save(transaction); err != nil {
+		t.Fatalf("failed to save transaction: %v", err)
+	}
+
+	if transactionType == "EXPENSE" {
+		err = card.Debit(money)
+	} else {
+		err = card.Credit(money)
+	}
+	if err != nil {
+		t.Fatalf("failed to update card balance: %v", err)
+	}
+	if err := accountpersistence.NewGormAccountRepository(db).Save(card); err != nil {
+		t.Fatalf("failed to save credit card: %v", err)
+	}
+}
+
+func TestPayStatementUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	db := setupStatementTestDB(t)
+	card := saveTestCreditCard(t, db, userID)
+	checking := saveTestAccount(t, db, userID, "Conta Corrente", 2000.00, "BRL")
+	dollars := saveTestAccount(t, db, userID, "Conta Dólar", 2000.00, "USD")
+
+	// A statement that closed about three months ago: 1000.00 + 200.00 - 50.00
+	closingDate := card.CreditCardTerms().StatementClosingDate(currentDay().AddDate(0, -3, 0))
+	month := closingDate.Format("2006-01")
+	saveTestCardTransaction(t, db, card, "EXPENSE", 1000.00, closingDate.AddDate(0, 0, -10))
+	saveTestCardTransaction(t, db, card, "EXPENSE", 200.00, closingDate)
+	saveTestCardTransaction(t, db, card, "INCOME", 50.00, closingDate.AddDate(0, 0, -1))
+
+	listStatements := NewListStatementsUseCase(
+		accountpersistence.NewGormAccountRepository(db),
+		transactionpersistence.NewGormTransactionRepository(db),
+		accountpersistence.NewGormCreditCardStatementRepository(db),
+	)
+	payStatement := NewPayStatementUseCase(sharedpersistence.NewGormUnitOfWork(db), eventbus.NewEventBus())
+
+	t.Run("lists the statements with totals and available credit", func(t *testing.T) {
+		output, err := listStatements.Execute(dtos.ListStatementsInput{AccountID: card.ID().Value(), UserID: userID.Value()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.CreditLimit != 5000.00 || output.Balance != -1150.00 || output.AvailableCredit != 3850.00 {
+			t.Errorf("expected limit 5000.00, balance -1150.00 and 3850.00 available, got %+v", output)
+		}
+		if len(output.Statements) != 2 || output.Statements[0].Status != entities.StatementStatusOpen {
+			t.Fatalf("expected the open statement and the closed one, got %d statements", len(output.Statements))
+		}
+
+		statement := output.Statements[1]
+		if statement.Month != month || statement.Status != entities.StatementStatusClosed || !statement.IsOverdue {
+			t.Errorf("expected the %s statement to be closed and overdue, got %+v", month, statement)
+		}
+		if statement.Total != 1150.00 || statement.ChargeCount != 3 || statement.MinimumPayment != 172.50 {
+			t.Errorf("expected total 1150.00 in 3 charges with a minimum payment of 172.50, got %+v", statement)
+		}
+
+		closed, _ := listStatements.Execute(dtos.ListStatementsInput{AccountID: card.ID().Value(), UserID: userID.Value(), Status: "CLOSED"})
+		if closed.Count != 1 {
+			t.Errorf("expected 1 closed statement, got %d", closed.Count)
+		}
+	})
+
+	t.Run("refuses a payment from an account in another currency", func(t *testing.T) {
+		_, err := payStatement.Execute(dtos.PayStatementInput{
+			AccountID:     card.ID().Value(),
+			UserID:        userID.Value(),
+			Month:         month,
+			FromAccountID: dollars.ID().Value(),
+		})
+		if err == nil || !containsString(err.Error(), "different currency") {
+			t.Errorf("expected a different currency error, got %v", err)
+		}
+	})
+
+	t.Run("pays part of the statement", func(t *testing.T) {
+		amount := 500.00
+		output, err := payStatement.Execute(dtos.PayStatementInput{
+			AccountID:     card.ID().Value(),
+			UserID:        userID.Value(),
+			Month:         month,
+			FromAccountID: checking.ID().Value(),
+			Amount:        &amount,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.TransactionIDs) != 2 || output.PaidAmount != 500.00 {
+			t.Errorf("expected a two-leg transfer of 500.00, got %+v", output)
+		}
+		if output.Statement.AmountDue != 650.00 || output.Statement.Status != entities.StatementStatusClosed || output.Statement.IsOverdue {
+			t.Errorf("expected 650.00 still due without being overdue, got %+v", output.Statement)
+		}
+
+		found, _ := accountpersistence.NewGormAccountRepository(db).FindByID(checking.ID())
+		if found.Balance().Amount() != 150000 {
+			t.Errorf("expected checking balance 150000, got %d", found.Balance().Amount())
+		}
+		found, _ = accountpersistence.NewGormAccountRepository(db).FindByID(card.ID())
+		if found.Balance().Amount() != -65000 {
+			t.Errorf("expected card balance -65000, got %d", found.Balance().Amount())
+		}
+	})
+
+	t.Run("settles the rest of the statement", func(t *testing.T) {
+		output, err := payStatement.Execute(dtos.PayStatementInput{
+			AccountID:     card.ID().Value(),
+			UserID:        userID.Value(),
+			Month:         month,
+			FromAccountID: checking.ID().Value(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PaidAmount != 650.00 || output.Statement.Status != entities.StatementStatusPaid {
+			t.Errorf("expected the statement to be paid with 650.00, got %+v", output)
+		}
+
+		// The payments are not charges of the open statement
+		listed, _ := listStatements.Execute(dtos.ListStatementsInput{AccountID: card.ID().Value(), UserID: userID.Value()})
+		if listed.Statements[0].Total != 0 || listed.Balance != 0 || listed.Statements[1].PaidAmount != 1150.00 {
+			t.Errorf("expected an empty open statement and a paid one, got %+v and %+v", listed.Statements[0], listed.Statements[1])
+		}
+
+		_, err = payStatement.Execute(dtos.PayStatementInput{
+			AccountID:     card.ID().Value(),
+			UserID:        userID.Value(),
+			Month:         month,
+			FromAccountID: checking.ID().Value(),
+		})
+		if err == nil || !containsString(err.Error(), "no amount is due") {
+			t.Errorf("expected a no amount due error, got %v", err)
+		}
+	})
+
+	t.Run("rejects statements of accounts that are not credit cards", func(t *testing.T) {
+		_, err := listStatements.Execute(dtos.ListStatementsInput{AccountID: checking.ID().Value(), UserID: userID.Value()})
+		if err == nil || !containsString(err.Error(), "invalid account") {
+			t.Errorf("expected an invalid account error, got %v", err)
+		}
+
+		_, err = payStatement.Execute(dtos.PayStatementInput{
+			AccountID:     card.ID().Value(),
+			UserID:        userID.Value(),
+			Month:         "1999-01",
+			FromAccountID: valueobjects.GenerateAccountID().Value(),
+		})
+		if err == nil || !containsString(err.Error(), "not found") {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+}
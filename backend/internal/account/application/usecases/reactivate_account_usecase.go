@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"fmt"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// ReactivateAccountUseCase handles reactivating an archived account.
+type ReactivateAccountUseCase struct {
+	accountRepository repositories.AccountRepository
+	eventBus          *eventbus.EventBus
+}
+
+// NewReactivateAccountUseCase creates a new ReactivateAccountUseCase instance.
+func NewReactivateAccountUseCase(
+	accountRepository repositories.AccountRepository,
+	eventBus *eventbus.EventBus,
+) *ReactivateAccountUseCase {
+	return &ReactivateAccountUseCase{
+		accountRepository: accountRepository,
+		eventBus:          eventBus,
+	}
+}
+
+// Execute performs the account reactivation.
+func (uc *ReactivateAccountUseCase) Execute(input dtos.ReactivateAccountInput) (*dtos.AccountOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := findUserAccount(uc.accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := account.Activate(); err != nil {
+		return nil, fmt.Errorf("cannot reactivate account: %w", err)
+	}
+
+	if err := uc.accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	publishAccountEvents(uc.eventBus, account)
+
+	return toAccountOutput(account), nil
+}
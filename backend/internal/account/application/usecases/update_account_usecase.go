@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"fmt"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// UpdateAccountUseCase handles renaming an account.
+type UpdateAccountUseCase struct {
+	accountRepository repositories.AccountRepository
+	eventBus          *eventbus.EventBus
+}
+
+// NewUpdateAccountUseCase creates a new UpdateAccountUseCase instance.
+func NewUpdateAccountUseCase(
+	accountRepository repositories.AccountRepository,
+	eventBus *eventbus.EventBus,
+) *UpdateAccountUseCase {
+	return &UpdateAccountUseCase{
+		accountRepository: accountRepository,
+		eventBus:          eventBus,
+	}
+}
+
+// Execute performs the account update.
+// Archived accounts must be reactivated before they can be renamed.
+func (uc *UpdateAccountUseCase) Execute(input dtos.UpdateAccountInput) (*dtos.AccountOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create account name value object
+	accountName, err := valueobjects.NewAccountName(input.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account name: %w", err)
+	}
+
+	account, err := findUserAccount(uc.accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := account.UpdateName(accountName); err != nil {
+		return nil, err
+	}
+
+	if err := uc.accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	publishAccountEvents(uc.eventBus, account)
+
+	return toAccountOutput(account), nil
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"testing"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+func TestUpdateAccountUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	otherUserID := identityvalueobjects.GenerateUserID()
+
+	tests := []struct {
+		name      string
+		userID    string
+		newName   string
+		archived  bool
+		wantError bool
+		errorMsg  string
+	}{
+		{
+			name:    "rename account",
+			userID:  userID.Value(),
+			newName: "Conta Salário",
+		},
+		{
+			name:      "invalid name",
+			userID:    userID.Value(),
+			newName:   "",
+			wantError: true,
+			errorMsg:  "invalid account name",
+		},
+		{
+			name:      "account of another user",
+			userID:    otherUserID.Value(),
+			newName:   "Conta Salário",
+			wantError: true,
+			errorMsg:  "does not belong to user",
+		},
+		{
+			name:      "archived account",
+			userID:    userID.Value(),
+			newName:   "Conta Salário",
+			archived:  true,
+			wantError: true,
+			errorMsg:  "inactive account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newMockAccountRepository()
+			account, _ := createTestAccount(userID, "Conta Corrente", "BANK", 1000.00, "BRL", "PERSONAL")
+			if tt.archived {
+				_ = account.Deactivate()
+			}
+			_ = mockRepo.Save(account)
+
+			output, err := NewUpdateAccountUseCase(mockRepo, eventbus.NewEventBus()).Execute(dtos.UpdateAccountInput{
+				AccountID: account.ID().Value(),
+				UserID:    tt.userID,
+				Name:      tt.newName,
+			})
+
+			if tt.wantError {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+				if !containsString(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error message to contain %q, got %q", tt.errorMsg, err.Error())
+				}
+				if account.Name().Value() != "Conta Corrente" {
+					t.Errorf("expected name to stay 'Conta Corrente', got %s", account.Name().Value())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.Name != tt.newName {
+				t.Errorf("expected name %q, got %q", tt.newName, output.Name)
+			}
+			saved, _ := mockRepo.FindByID(account.ID())
+			if saved.Name().Value() != tt.newName {
+				t.Errorf("expected saved name %q, got %q", tt.newName, saved.Name().Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"fmt"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// UpdateBalanceLimitsUseCase handles setting the overdraft limit of an account and whether debits
+// beyond its balance floor are rejected or only warned about.
+type UpdateBalanceLimitsUseCase struct {
+	accountRepository repositories.AccountRepository
+	eventBus          *eventbus.EventBus
+}
+
+// NewUpdateBalanceLimitsUseCase creates a new UpdateBalanceLimitsUseCase instance.
+func NewUpdateBalanceLimitsUseCase(
+	accountRepository repositories.AccountRepository,
+	eventBus *eventbus.EventBus,
+) *UpdateBalanceLimitsUseCase {
+	return &UpdateBalanceLimitsUseCase{
+		accountRepository: accountRepository,
+		eventBus:          eventBus,
+	}
+}
+
+// Execute performs the balance limits update.
+func (uc *UpdateBalanceLimitsUseCase) Execute(input dtos.UpdateBalanceLimitsInput) (*dtos.AccountOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := findUserAccount(uc.accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setBalanceLimits(account, input.OverdraftLimit, input.Mode); err != nil {
+		return nil, err
+	}
+
+	if err := uc.accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	publishAccountEvents(uc.eventBus, account)
+
+	return toAccountOutput(account), nil
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"fmt"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// UpdateCreditCardTermsUseCase handles setting the credit limit, closing day and due day of a credit card account.
+type UpdateCreditCardTermsUseCase struct {
+	accountRepository repositories.AccountRepository
+	eventBus          *eventbus.EventBus
+}
+
+// NewUpdateCreditCardTermsUseCase creates a new UpdateCreditCardTermsUseCase instance.
+func NewUpdateCreditCardTermsUseCase(
+	accountRepository repositories.AccountRepository,
+	eventBus *eventbus.EventBus,
+) *UpdateCreditCardTermsUseCase {
+	return &UpdateCreditCardTermsUseCase{
+		accountRepository: accountRepository,
+		eventBus:          eventBus,
+	}
+}
+
+// Execute performs the credit card terms update.
+func (uc *UpdateCreditCardTermsUseCase) Execute(input dtos.UpdateCreditCardTermsInput) (*dtos.AccountOutput, error) {
+	userID, accountID, err := parseUserAccountIDs(input.UserID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := findUserAccount(uc.accountRepository, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	terms, err := newCreditCardTerms(input.CreditLimit, input.ClosingDay, input.DueDay, account.Balance().Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := account.SetCreditCardTerms(terms); err != nil {
+		return nil, err
+	}
+
+	if err := uc.accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	publishAccountEvents(uc.eventBus, account)
+
+	return toAccountOutput(account), nil
+}
@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	"gestao-financeira/backend/internal/account/application/dtos"
+	"gestao-financeira/backend/internal/account/domain/entities"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// findUserAccount loads an account and checks that it belongs to the user.
+func findUserAccount(
+	accountRepository repositories.AccountRepository,
+	userID identityvalueobjects.UserID,
+	accountID valueobjects.AccountID,
+) (*entities.Account, error) {
+	account, err := accountRepository.FindByID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+	if account == nil {
+		return nil, errors.New("account not found")
+	}
+	if !account.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("account does not belong to user")
+	}
+	return account, nil
+}
+
+// parseUserAccountIDs creates the user ID and account ID value objects of a request on a single account.
+func parseUserAccountIDs(rawUserID, rawAccountID string) (identityvalueobjects.UserID, valueobjects.AccountID, error) {
+	userID, err := identityvalueobjects.NewUserID(rawUserID)
+	if err != nil {
+		return identityvalueobjects.UserID{}, valueobjects.AccountID{}, fmt.Errorf("invalid user ID: %w", err)
+	}
+	accountID, err := valueobjects.NewAccountID(rawAccountID)
+	if err != nil {
+		return identityvalueobjects.UserID{}, valueobjects.AccountID{}, fmt.Errorf("invalid account ID: %w", err)
+	}
+	return userID, accountID, nil
+}
+
+// toAccountOutput converts a domain account to a DTO.
+func toAccountOutput(account *entities.Account) *dtos.AccountOutput {
+	balance := account.Balance()
+	return &dtos.AccountOutput{
+		AccountID:     account.ID().Value(),
+		UserID:        account.UserID().Value(),
+		Name:          account.Name().Value(),
+		Type:          account.AccountType().Value(),
+		Balance:       balance.Float64(),
+		Currency:      balance.Currency().Code(),
+		Context:       account.Context().Value(),
+		IsActive:      account.IsActive(),
+		CreatedAt:     account.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     account.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreditCard:    toCreditCardOutput(account),
+		BalanceLimits: toBalanceLimitsOutput(account),
+	}
+}
+
+// toCreditCardOutput converts the credit card terms of an account to a DTO (nil if the account has none).
+func toCreditCardOutput(account *entities.Account) *dtos.CreditCardOutput {
+	terms := account.CreditCardTerms()
+	if terms == nil {
+		return nil
+	}
+	availableCredit, _ := account.AvailableCredit()
+	return &dtos.CreditCardOutput{
+		CreditLimit:     terms.CreditLimit().Float64(),
+		ClosingDay:      terms.ClosingDay(),
+		DueDay:          terms.DueDay(),
+		AvailableCredit: availableCredit.Float64(),
+	}
+}
+
+// toBalanceLimitsOutput converts the balance floor policy of an account to a DTO.
+func toBalanceLimitsOutput(account *entities.Account) dtos.BalanceLimitsOutput {
+	floor, limitKind := account.BalanceFloor()
+	return dtos.BalanceLimitsOutput{
+		Mode:           account.BalanceLimitMode().Value(),
+		OverdraftLimit: account.OverdraftLimit().Float64(),
+		BalanceFloor:   floor.Float64(),
+		LimitKind:      limitKind,
+	}
+}
+
+// setBalanceLimits sets the balance floor policy of a request on an account, from an overdraft
+// limit in units of the account currency.
+func setBalanceLimits(account *entities.Account, overdraftLimit float64, mode string) error {
+	limit, err := sharedvalueobjects.NewMoneyFromFloat(overdraftLimit, account.Balance().Currency())
+	if err != nil {
+		return fmt.Errorf("invalid overdraft limit: %w", err)
+	}
+	balanceLimitMode, err := valueobjects.NewBalanceLimitMode(mode)
+	if err != nil {
+		return err
+	}
+	return account.SetBalanceLimits(limit, balanceLimitMode)
+}
+
+// newCreditCardTerms creates the credit card terms of a request from a credit limit in units of the currency.
+func newCreditCardTerms(creditLimit float64, closingDay, dueDay int, currency sharedvalueobjects.Currency) (valueobjects.CreditCardTerms, error) {
+	limit, err := sharedvalueobjects.NewMoneyFromFloat(creditLimit, currency)
+	if err != nil {
+		return valueobjects.CreditCardTerms{}, fmt.Errorf("invalid credit limit: %w", err)
+	}
+	return valueobjects.NewCreditCardTerms(limit, closingDay, dueDay)
+}
+
+// publishAccountEvents publishes and clears the domain events of an account.
+func publishAccountEvents(eventBus *eventbus.EventBus, account *entities.Account) {
+	for _, event := range account.GetEvents() {
+		if err := eventBus.Publish(event); err != nil {
+			// Log error but don't fail the operation
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	account.ClearEvents()
+}
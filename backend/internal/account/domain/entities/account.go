@@ -2,6 +2,7 @@ package entities
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	accountevents "gestao-financeira/backend/internal/account/domain/events"
@@ -23,6 +24,14 @@ type Account struct {
 	updatedAt   time.Time
 	isActive    bool
 
+	// Credit limit and billing days (credit card accounts only; nil if not set)
+	creditCardTerms *valueobjects.CreditCardTerms
+
+	// Overdraft limit (bank accounts only; zero if none) and what happens when a debit
+	// would take the balance below its floor
+	overdraftLimit   sharedvalueobjects.Money
+	balanceLimitMode valueobjects.BalanceLimitMode
+
 	// Domain events
 	events []events.DomainEvent
 }
@@ -56,16 +65,18 @@ func NewAccount(
 	now := time.Now()
 
 	account := &Account{
-		id:          valueobjects.GenerateAccountID(),
-		userID:      userID,
-		name:        name,
-		accountType: accountType,
-		balance:     initialBalance,
-		context:     context,
-		createdAt:   now,
-		updatedAt:   now,
-		isActive:    true,
-		events:      []events.DomainEvent{},
+		id:               valueobjects.GenerateAccountID(),
+		userID:           userID,
+		name:             name,
+		accountType:      accountType,
+		balance:          initialBalance,
+		context:          context,
+		createdAt:        now,
+		updatedAt:        now,
+		isActive:         true,
+		overdraftLimit:   sharedvalueobjects.Zero(initialBalance.Currency()),
+		balanceLimitMode: valueobjects.EnforceBalanceLimitMode(),
+		events:           []events.DomainEvent{},
 	}
 
 	// Add domain event with account details
@@ -105,19 +116,47 @@ func AccountFromPersistence(
 	}
 
 	return &Account{
-		id:          id,
-		userID:      userID,
-		name:        name,
-		accountType: accountType,
-		balance:     balance,
-		context:     context,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
-		isActive:    isActive,
-		events:      []events.DomainEvent{},
+		id:               id,
+		userID:           userID,
+		name:             name,
+		accountType:      accountType,
+		balance:          balance,
+		context:          context,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+		isActive:         isActive,
+		overdraftLimit:   sharedvalueobjects.Zero(balance.Currency()),
+		balanceLimitMode: valueobjects.EnforceBalanceLimitMode(),
+		events:           []events.DomainEvent{},
 	}, nil
 }
 
+// AccountFromPersistenceWithLimits reconstructs an Account aggregate from persisted data, including
+// its credit card terms (nil if not set), overdraft limit and balance limit mode.
+func AccountFromPersistenceWithLimits(
+	id valueobjects.AccountID,
+	userID identityvalueobjects.UserID,
+	name valueobjects.AccountName,
+	accountType valueobjects.AccountType,
+	balance sharedvalueobjects.Money,
+	context sharedvalueobjects.AccountContext,
+	createdAt time.Time,
+	updatedAt time.Time,
+	isActive bool,
+	creditCardTerms *valueobjects.CreditCardTerms,
+	overdraftLimit sharedvalueobjects.Money,
+	balanceLimitMode valueobjects.BalanceLimitMode,
+) (*Account, error) {
+	account, err := AccountFromPersistence(id, userID, name, accountType, balance, context, createdAt, updatedAt, isActive)
+	if err != nil {
+		return nil, err
+	}
+	account.creditCardTerms = creditCardTerms
+	account.overdraftLimit = overdraftLimit
+	account.balanceLimitMode = balanceLimitMode
+	return account, nil
+}
+
 // ID returns the account ID.
 func (a *Account) ID() valueobjects.AccountID {
 	return a.id
@@ -153,6 +192,104 @@ func (a *Account) IsActive() bool {
 	return a.isActive
 }
 
+// CreditCardTerms returns the credit limit and billing days of a credit card account
+// (nil if the account is not a credit card or has no terms set).
+func (a *Account) CreditCardTerms() *valueobjects.CreditCardTerms {
+	return a.creditCardTerms
+}
+
+// AvailableCredit returns how much can still be spent on a credit card account: the credit limit
+// plus the balance (negative while purchases are owed). Returns false if the account has no credit limit.
+func (a *Account) AvailableCredit() (sharedvalueobjects.Money, bool) {
+	if a.creditCardTerms == nil {
+		return sharedvalueobjects.Money{}, false
+	}
+	available, err := a.creditCardTerms.CreditLimit().Add(a.balance)
+	if err != nil {
+		return sharedvalueobjects.Money{}, false
+	}
+	return available, true
+}
+
+// SetCreditCardTerms sets the credit limit and billing days of a credit card account.
+func (a *Account) SetCreditCardTerms(terms valueobjects.CreditCardTerms) error {
+	if !a.accountType.IsCreditCard() {
+		return errors.New("invalid credit card terms: only credit card accounts have a credit limit, closing day and due day")
+	}
+
+	if !terms.CreditLimit().Currency().Equals(a.balance.Currency()) {
+		return errors.New("invalid credit limit: must be in the account currency")
+	}
+
+	a.creditCardTerms = &terms
+	a.updatedAt = time.Now()
+
+	a.addEvent(events.NewBaseDomainEvent(
+		"AccountCreditCardTermsChanged",
+		a.id.Value(),
+		"Account",
+	))
+
+	return nil
+}
+
+// OverdraftLimit returns how far below zero a bank account can go (zero if it has no overdraft).
+func (a *Account) OverdraftLimit() sharedvalueobjects.Money {
+	return a.overdraftLimit
+}
+
+// BalanceLimitMode returns whether debits beyond the balance floor are rejected or only warned about.
+func (a *Account) BalanceLimitMode() valueobjects.BalanceLimitMode {
+	return a.balanceLimitMode
+}
+
+// BalanceFloor returns the lowest balance a debit can leave the account with: minus the overdraft
+// limit for bank accounts, minus the credit limit for credit cards with terms, and zero otherwise.
+// It also returns the kind of limit the floor comes from (LimitKindBalance, LimitKindOverdraft or
+// LimitKindCreditLimit).
+func (a *Account) BalanceFloor() (sharedvalueobjects.Money, string) {
+	switch {
+	case a.creditCardTerms != nil:
+		return a.creditCardTerms.CreditLimit().Negate(), LimitKindCreditLimit
+	case a.accountType.IsBank() && a.overdraftLimit.IsPositive():
+		return a.overdraftLimit.Negate(), LimitKindOverdraft
+	default:
+		return sharedvalueobjects.Zero(a.balance.Currency()), LimitKindBalance
+	}
+}
+
+// SetBalanceLimits sets the overdraft limit (bank accounts only; zero removes it) and whether
+// debits beyond the balance floor are rejected or only warned about.
+func (a *Account) SetBalanceLimits(overdraftLimit sharedvalueobjects.Money, mode valueobjects.BalanceLimitMode) error {
+	if !a.isActive {
+		return errors.New("cannot update balance limits for inactive account")
+	}
+
+	if !overdraftLimit.Currency().Equals(a.balance.Currency()) {
+		return errors.New("invalid overdraft limit: must be in the account currency")
+	}
+
+	if overdraftLimit.IsNegative() {
+		return errors.New("invalid overdraft limit: cannot be negative")
+	}
+
+	if overdraftLimit.IsPositive() && !a.accountType.IsBank() {
+		return errors.New("invalid overdraft limit: only bank accounts have an overdraft limit")
+	}
+
+	a.overdraftLimit = overdraftLimit
+	a.balanceLimitMode = mode
+	a.updatedAt = time.Now()
+
+	a.addEvent(events.NewBaseDomainEvent(
+		"AccountBalanceLimitsChanged",
+		a.id.Value(),
+		"Account",
+	))
+
+	return nil
+}
+
 // CreatedAt returns the creation timestamp.
 func (a *Account) CreatedAt() time.Time {
 	return a.createdAt
@@ -192,6 +329,9 @@ func (a *Account) Credit(amount sharedvalueobjects.Money) error {
 }
 
 // Debit subtracts money from the account balance.
+// A debit that would leave the balance below the floor of the account (see BalanceFloor) fails with
+// a *BalanceLimitError, unless the account is in warn-only mode: then it goes through and an
+// AccountBalanceLimitExceeded event is added.
 func (a *Account) Debit(amount sharedvalueobjects.Money) error {
 	if !a.isActive {
 		return errors.New("cannot debit inactive account")
@@ -202,14 +342,22 @@ func (a *Account) Debit(amount sharedvalueobjects.Money) error {
 		return errors.New("cannot debit with different currency")
 	}
 
-	// Check if balance would become negative
 	newBalance, err := a.balance.Subtract(amount)
 	if err != nil {
 		return err
 	}
 
-	if newBalance.IsNegative() {
-		return errors.New("insufficient balance")
+	// Check if the balance would go below its floor
+	floor, limitKind := a.BalanceFloor()
+	exceeded := newBalance.Amount() < floor.Amount()
+	if exceeded && !a.balanceLimitMode.IsWarnOnly() {
+		return &BalanceLimitError{
+			AccountID:   a.id.Value(),
+			AccountName: a.name.Value(),
+			LimitKind:   limitKind,
+			Balance:     newBalance,
+			Floor:       floor,
+		}
 	}
 
 	a.balance = newBalance
@@ -221,9 +369,61 @@ func (a *Account) Debit(amount sharedvalueobjects.Money) error {
 		"Account",
 	))
 
+	if exceeded {
+		a.addEvent(accountevents.NewAccountBalanceLimitExceeded(
+			a.id.Value(),
+			a.userID.Value(),
+			a.name.Value(),
+			limitKind,
+			newBalance,
+			floor,
+		))
+	}
+
 	return nil
 }
 
+// AdjustBalance sets the balance of the account to the given balance, e.g. to match the bank when
+// the account drifted from it, and returns the difference. The difference must be recorded by a
+// balance adjustment transaction so the balance keeps matching the sum of the transactions. An
+// adjustment records the actual balance, so it is not checked against the balance floor.
+func (a *Account) AdjustBalance(balance sharedvalueobjects.Money) (sharedvalueobjects.Money, error) {
+	if !a.isActive {
+		return sharedvalueobjects.Money{}, errors.New("cannot adjust the balance of an inactive account")
+	}
+
+	// Ensure currencies match
+	if !a.balance.Currency().Equals(balance.Currency()) {
+		return sharedvalueobjects.Money{}, errors.New("cannot adjust balance with different currency")
+	}
+
+	difference, err := balance.Subtract(a.balance)
+	if err != nil {
+		return sharedvalueobjects.Money{}, err
+	}
+	if difference.IsZero() {
+		return sharedvalueobjects.Money{}, fmt.Errorf("cannot adjust balance: the account balance is already %s", balance.String())
+	}
+
+	previousBalance := a.balance
+	a.balance = balance
+	a.updatedAt = time.Now()
+
+	a.addEvent(events.NewBaseDomainEvent(
+		"AccountBalanceUpdated",
+		a.id.Value(),
+		"Account",
+	))
+	a.addEvent(accountevents.NewAccountBalanceAdjusted(
+		a.id.Value(),
+		a.userID.Value(),
+		previousBalance,
+		balance,
+	))
+
+	return difference, nil
+}
+
 // UpdateName updates the account name.
 func (a *Account) UpdateName(name valueobjects.AccountName) error {
 	if !a.isActive {
@@ -1,9 +1,12 @@
 package entities
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	accountevents "gestao-financeira/backend/internal/account/domain/events"
 	"gestao-financeira/backend/internal/account/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
@@ -333,3 +336,178 @@ func TestAccount_Debit_DifferentCurrency(t *testing.T) {
 		t.Error("Account.Debit() should fail with different currency")
 	}
 }
+
+func TestAccount_CreditCardTerms(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountName, _ := valueobjects.NewAccountName("Cartão Nubank")
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	context := sharedvalueobjects.PersonalContext()
+	limit, _ := sharedvalueobjects.NewMoney(100000, brl) // 1000.00 BRL
+	terms, _ := valueobjects.NewCreditCardTerms(limit, 25, 5)
+
+	card, _ := NewAccount(userID, accountName, valueobjects.CreditCardType(), sharedvalueobjects.Zero(brl), context)
+	if err := card.SetCreditCardTerms(terms); err != nil {
+		t.Fatalf("Account.SetCreditCardTerms() error = %v", err)
+	}
+
+	// Purchases are owed up to the credit limit
+	purchase, _ := sharedvalueobjects.NewMoney(80000, brl)
+	if err := card.Debit(purchase); err != nil {
+		t.Fatalf("Account.Debit() error = %v", err)
+	}
+	if card.Balance().Amount() != -80000 {
+		t.Errorf("Account.Balance() = %d, want -80000", card.Balance().Amount())
+	}
+	if available, ok := card.AvailableCredit(); !ok || available.Amount() != 20000 {
+		t.Errorf("Account.AvailableCredit() = %d, want 20000", available.Amount())
+	}
+
+	overLimit, _ := sharedvalueobjects.NewMoney(20001, brl)
+	var limitErr *BalanceLimitError
+	if err := card.Debit(overLimit); !errors.As(err, &limitErr) || limitErr.LimitKind != LimitKindCreditLimit ||
+		!strings.HasPrefix(err.Error(), "insufficient credit limit") {
+		t.Errorf("Account.Debit() error = %v, want insufficient credit limit", err)
+	}
+
+	// Only credit cards have terms, in the account currency
+	bank, _ := NewAccount(userID, accountName, valueobjects.BankType(), sharedvalueobjects.Zero(brl), context)
+	if err := bank.SetCreditCardTerms(terms); err == nil {
+		t.Error("Account.SetCreditCardTerms() should fail for a bank account")
+	}
+	if _, ok := bank.AvailableCredit(); ok {
+		t.Error("Account.AvailableCredit() should not be available for a bank account")
+	}
+	usdCard, _ := NewAccount(userID, accountName, valueobjects.CreditCardType(), sharedvalueobjects.Zero(sharedvalueobjects.MustCurrency("USD")), context)
+	if err := usdCard.SetCreditCardTerms(terms); err == nil {
+		t.Error("Account.SetCreditCardTerms() should fail for a limit in another currency")
+	}
+}
+
+func TestAccount_BalanceLimits(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	context := sharedvalueobjects.PersonalContext()
+	money := func(cents int64) sharedvalueobjects.Money {
+		amount, _ := sharedvalueobjects.NewMoney(cents, brl)
+		return amount
+	}
+
+	t.Run("bank account can use its overdraft limit", func(t *testing.T) {
+		bank, _ := NewAccount(userID, valueobjects.MustAccountName("Conta Corrente"), valueobjects.BankType(), money(10000), context)
+		if err := bank.SetBalanceLimits(money(50000), valueobjects.EnforceBalanceLimitMode()); err != nil {
+			t.Fatalf("Account.SetBalanceLimits() error = %v", err)
+		}
+		if floor, kind := bank.BalanceFloor(); floor.Amount() != -50000 || kind != LimitKindOverdraft {
+			t.Errorf("Account.BalanceFloor() = %d %s, want -50000 OVERDRAFT", floor.Amount(), kind)
+		}
+
+		if err := bank.Debit(money(60000)); err != nil {
+			t.Fatalf("Account.Debit() error = %v", err)
+		}
+		if bank.Balance().Amount() != -50000 {
+			t.Errorf("Account.Balance() = %d, want -50000", bank.Balance().Amount())
+		}
+
+		var limitErr *BalanceLimitError
+		err := bank.Debit(money(1))
+		if !errors.As(err, &limitErr) || limitErr.LimitKind != LimitKindOverdraft || limitErr.Balance.Amount() != -50001 {
+			t.Fatalf("Account.Debit() error = %v, want an overdraft limit error", err)
+		}
+		if details := limitErr.ErrorDetails(); details["balance_floor"] != -500.0 || details["account_name"] != "Conta Corrente" {
+			t.Errorf("BalanceLimitError.ErrorDetails() = %v", details)
+		}
+		if bank.Balance().Amount() != -50000 {
+			t.Errorf("a rejected debit should not change the balance, got %d", bank.Balance().Amount())
+		}
+	})
+
+	t.Run("wallet cannot go below zero", func(t *testing.T) {
+		wallet, _ := NewAccount(userID, valueobjects.MustAccountName("Carteira"), valueobjects.WalletType(), money(1000), context)
+		var limitErr *BalanceLimitError
+		if err := wallet.Debit(money(1001)); !errors.As(err, &limitErr) || limitErr.LimitKind != LimitKindBalance {
+			t.Errorf("Account.Debit() error = %v, want an insufficient balance error", err)
+		}
+		if err := wallet.SetBalanceLimits(money(1000), valueobjects.EnforceBalanceLimitMode()); err == nil {
+			t.Error("Account.SetBalanceLimits() should fail for an overdraft on a wallet")
+		}
+	})
+
+	t.Run("warn only mode lets the debit through with an event", func(t *testing.T) {
+		wallet, _ := NewAccount(userID, valueobjects.MustAccountName("Carteira"), valueobjects.WalletType(), money(1000), context)
+		if err := wallet.SetBalanceLimits(money(0), valueobjects.WarnBalanceLimitMode()); err != nil {
+			t.Fatalf("Account.SetBalanceLimits() error = %v", err)
+		}
+		wallet.ClearEvents()
+
+		if err := wallet.Debit(money(500)); err != nil || len(wallet.GetEvents()) != 1 {
+			t.Fatalf("a debit within the floor should only update the balance, got %v and %d events", err, len(wallet.GetEvents()))
+		}
+		if err := wallet.Debit(money(1500)); err != nil {
+			t.Fatalf("Account.Debit() error = %v", err)
+		}
+		if wallet.Balance().Amount() != -1000 {
+			t.Errorf("Account.Balance() = %d, want -1000", wallet.Balance().Amount())
+		}
+
+		domainEvents := wallet.GetEvents()
+		exceeded, ok := domainEvents[len(domainEvents)-1].(*accountevents.AccountBalanceLimitExceeded)
+		if !ok {
+			t.Fatalf("expected an AccountBalanceLimitExceeded event, got %v", domainEvents)
+		}
+		if exceeded.UserID() != userID.Value() || exceeded.LimitKind() != LimitKindBalance || exceeded.Balance().Amount() != -1000 {
+			t.Errorf("unexpected event %+v", exceeded)
+		}
+	})
+
+	t.Run("rejects invalid limits", func(t *testing.T) {
+		bank, _ := NewAccount(userID, valueobjects.MustAccountName("Conta Corrente"), valueobjects.BankType(), money(0), context)
+		if err := bank.SetBalanceLimits(money(-100), valueobjects.EnforceBalanceLimitMode()); err == nil {
+			t.Error("Account.SetBalanceLimits() should fail for a negative overdraft limit")
+		}
+		usd, _ := sharedvalueobjects.NewMoney(100, sharedvalueobjects.MustCurrency("USD"))
+		if err := bank.SetBalanceLimits(usd, valueobjects.EnforceBalanceLimitMode()); err == nil {
+			t.Error("Account.SetBalanceLimits() should fail for a limit in another currency")
+		}
+	})
+}
+
+func TestAccount_AdjustBalance(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountName, _ := valueobjects.NewAccountName("Carteira")
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	initialBalance, _ := sharedvalueobjects.NewMoney(10000, brl) // 100.00 BRL
+
+	account, _ := NewAccount(userID, accountName, valueobjects.WalletType(), initialBalance, sharedvalueobjects.PersonalContext())
+	account.ClearEvents()
+
+	// An adjustment records the actual balance, even below the balance floor
+	balance, _ := sharedvalueobjects.NewMoney(-2500, brl) // -25.00 BRL
+	difference, err := account.AdjustBalance(balance)
+	if err != nil {
+		t.Fatalf("Account.AdjustBalance() error = %v, want nil", err)
+	}
+	if difference.Amount() != -12500 || !account.Balance().Equals(balance) {
+		t.Errorf("Account.AdjustBalance() difference = %v, balance = %v, want -125.00 BRL and -25.00 BRL", difference, account.Balance())
+	}
+	events := account.GetEvents()
+	if len(events) != 2 || events[1].EventType() != "AccountBalanceAdjusted" {
+		t.Errorf("Account.AdjustBalance() events = %v, want AccountBalanceUpdated and AccountBalanceAdjusted", events)
+	}
+
+	// Nothing to adjust
+	if _, err := account.AdjustBalance(balance); err == nil {
+		t.Error("Account.AdjustBalance() should fail when the balance is unchanged")
+	}
+
+	// Other currency
+	usd, _ := sharedvalueobjects.NewMoney(1000, sharedvalueobjects.MustCurrency("USD"))
+	if _, err := account.AdjustBalance(usd); err == nil {
+		t.Error("Account.AdjustBalance() should fail with a different currency")
+	}
+
+	// Inactive account
+	_ = account.Deactivate()
+	if _, err := account.AdjustBalance(initialBalance); err == nil {
+		t.Error("Account.AdjustBalance() should fail on an inactive account")
+	}
+}
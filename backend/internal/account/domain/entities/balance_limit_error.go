@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"fmt"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// Balance limit kinds: which floor a debit ran into
+const (
+	LimitKindBalance     = "BALANCE"      // Wallets, investments and bank accounts without overdraft cannot go below zero
+	LimitKindOverdraft   = "OVERDRAFT"    // Bank accounts can go down to minus their overdraft limit (cheque especial)
+	LimitKindCreditLimit = "CREDIT_LIMIT" // Credit cards can owe up to their credit limit
+)
+
+// BalanceLimitError is returned when a debit would take an account below its balance floor.
+// It implements ErrorDetails so the error response tells the client which limit was hit.
+type BalanceLimitError struct {
+	AccountID   string
+	AccountName string
+	LimitKind   string
+	Balance     sharedvalueobjects.Money // Balance the account would have been left with
+	Floor       sharedvalueobjects.Money // Lowest balance the account is allowed to reach
+}
+
+// Error returns the error message.
+func (e *BalanceLimitError) Error() string {
+	switch e.LimitKind {
+	case LimitKindOverdraft:
+		return fmt.Sprintf("insufficient balance: account %s would be left at %s, beyond its overdraft limit of %s",
+			e.AccountName, e.Balance.String(), e.Floor.Negate().String())
+	case LimitKindCreditLimit:
+		return fmt.Sprintf("insufficient credit limit: account %s would owe %s, beyond its credit limit of %s",
+			e.AccountName, e.Balance.Negate().String(), e.Floor.Negate().String())
+	default:
+		return fmt.Sprintf("insufficient balance: account %s would be left at %s", e.AccountName, e.Balance.String())
+	}
+}
+
+// ErrorDetails returns the account, the limit and the balance the debit would have left.
+func (e *BalanceLimitError) ErrorDetails() map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":    e.AccountID,
+		"account_name":  e.AccountName,
+		"limit_kind":    e.LimitKind,
+		"balance":       e.Balance.Float64(),
+		"balance_floor": e.Floor.Float64(),
+		"currency":      e.Balance.Currency().Code(),
+	}
+}
@@ -0,0 +1,266 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// Credit card statement status values
+const (
+	StatementStatusOpen   = "OPEN"   // The statement has not closed yet: purchases are still added to it
+	StatementStatusClosed = "CLOSED" // Closed with an amount still due
+	StatementStatusPaid   = "PAID"   // Closed and fully paid (or nothing was due)
+)
+
+// MinimumPaymentPercent is the share of the statement total that must be paid by the due date.
+const MinimumPaymentPercent = 15
+
+// CreditCardStatement represents a statement (fatura) of a credit card account: the purchases and
+// refunds dated within one billing cycle, and the payments made towards it.
+//
+// Totals are derived from the transactions of the cycle every time the statement is loaded; only
+// the payments and the late payment notice are persisted.
+type CreditCardStatement struct {
+	userID         identityvalueobjects.UserID
+	accountID      valueobjects.AccountID
+	periodStart    time.Time
+	closingDate    time.Time
+	dueDate        time.Time
+	total          sharedvalueobjects.Money
+	chargeCount    int
+	paidAmount     sharedvalueobjects.Money
+	paidAt         *time.Time
+	lateNotifiedAt *time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewCreditCardStatement creates the statement of a credit card account closing on the given date,
+// with no charges or payments yet.
+func NewCreditCardStatement(account *Account, closingDate time.Time) (*CreditCardStatement, error) {
+	terms := account.CreditCardTerms()
+	if terms == nil {
+		return nil, errors.New("invalid account: statements are only available for credit card accounts with a credit limit, closing day and due day")
+	}
+
+	closingDate = terms.StatementClosingDate(closingDate)
+	zero := sharedvalueobjects.Zero(account.Balance().Currency())
+	now := time.Now()
+
+	return &CreditCardStatement{
+		userID:      account.UserID(),
+		accountID:   account.ID(),
+		periodStart: terms.PreviousClosingDate(closingDate).AddDate(0, 0, 1),
+		closingDate: closingDate,
+		dueDate:     terms.DueDate(closingDate),
+		total:       zero,
+		paidAmount:  zero,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// CreditCardStatementFromPersistence reconstructs a CreditCardStatement from persisted data.
+// The total is not persisted: charges are added again from the transactions of the cycle.
+func CreditCardStatementFromPersistence(
+	userID identityvalueobjects.UserID,
+	accountID valueobjects.AccountID,
+	periodStart time.Time,
+	closingDate time.Time,
+	dueDate time.Time,
+	paidAmount sharedvalueobjects.Money,
+	paidAt *time.Time,
+	lateNotifiedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *CreditCardStatement {
+	return &CreditCardStatement{
+		userID:         userID,
+		accountID:      accountID,
+		periodStart:    periodStart,
+		closingDate:    closingDate,
+		dueDate:        dueDate,
+		total:          sharedvalueobjects.Zero(paidAmount.Currency()),
+		paidAmount:     paidAmount,
+		paidAt:         paidAt,
+		lateNotifiedAt: lateNotifiedAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// UserID returns the owner of the credit card.
+func (s *CreditCardStatement) UserID() identityvalueobjects.UserID {
+	return s.userID
+}
+
+// AccountID returns the credit card account.
+func (s *CreditCardStatement) AccountID() valueobjects.AccountID {
+	return s.accountID
+}
+
+// Month returns the month the statement closes in (YYYY-MM), which identifies it.
+func (s *CreditCardStatement) Month() string {
+	return s.closingDate.Format("2006-01")
+}
+
+// PeriodStart returns the first day of the billing cycle.
+func (s *CreditCardStatement) PeriodStart() time.Time {
+	return s.periodStart
+}
+
+// ClosingDate returns the last day of the billing cycle.
+func (s *CreditCardStatement) ClosingDate() time.Time {
+	return s.closingDate
+}
+
+// DueDate returns the day the statement must be paid by.
+func (s *CreditCardStatement) DueDate() time.Time {
+	return s.dueDate
+}
+
+// Total returns the purchases minus the refunds of the cycle (negative if refunds exceed purchases).
+func (s *CreditCardStatement) Total() sharedvalueobjects.Money {
+	return s.total
+}
+
+// ChargeCount returns the number of purchases and refunds in the cycle.
+func (s *CreditCardStatement) ChargeCount() int {
+	return s.chargeCount
+}
+
+// PaidAmount returns the amount paid towards the statement.
+func (s *CreditCardStatement) PaidAmount() sharedvalueobjects.Money {
+	return s.paidAmount
+}
+
+// PaidAt returns the date of the last payment (nil if not paid).
+func (s *CreditCardStatement) PaidAt() *time.Time {
+	return s.paidAt
+}
+
+// LateNotifiedAt returns when the user was notified that the statement is overdue (nil if not notified).
+func (s *CreditCardStatement) LateNotifiedAt() *time.Time {
+	return s.lateNotifiedAt
+}
+
+// CreatedAt returns the creation timestamp.
+func (s *CreditCardStatement) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// UpdatedAt returns the last update timestamp.
+func (s *CreditCardStatement) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// Covers checks if a transaction dated on the given date belongs to the statement.
+func (s *CreditCardStatement) Covers(date time.Time) bool {
+	date = date.UTC()
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return !day.Before(s.periodStart) && !day.After(s.closingDate)
+}
+
+// AddCharge adds a purchase to the statement total.
+func (s *CreditCardStatement) AddCharge(amount sharedvalueobjects.Money) error {
+	total, err := s.total.Add(amount)
+	if err != nil {
+		return fmt.Errorf("failed to add charge: %w", err)
+	}
+	s.total = total
+	s.chargeCount++
+	return nil
+}
+
+// AddRefund subtracts a refund from the statement total.
+func (s *CreditCardStatement) AddRefund(amount sharedvalueobjects.Money) error {
+	total, err := s.total.Subtract(amount)
+	if err != nil {
+		return fmt.Errorf("failed to add refund: %w", err)
+	}
+	s.total = total
+	s.chargeCount++
+	return nil
+}
+
+// AmountDue returns the part of the total that has not been paid yet (zero if nothing is due).
+func (s *CreditCardStatement) AmountDue() sharedvalueobjects.Money {
+	due, _ := s.total.Subtract(s.paidAmount)
+	if !due.IsPositive() {
+		return sharedvalueobjects.Zero(s.total.Currency())
+	}
+	return due
+}
+
+// MinimumPayment returns the amount still to be paid by the due date to avoid a late payment:
+// MinimumPaymentPercent of the total (rounded up to the cent), minus the payments already made.
+func (s *CreditCardStatement) MinimumPayment() sharedvalueobjects.Money {
+	zero := sharedvalueobjects.Zero(s.total.Currency())
+	if !s.total.IsPositive() {
+		return zero
+	}
+
+	minimum := (s.total.Amount()*MinimumPaymentPercent + 99) / 100
+	remaining := minimum - s.paidAmount.Amount()
+	if remaining <= 0 {
+		return zero
+	}
+	payment, _ := sharedvalueobjects.NewMoney(remaining, s.total.Currency())
+	return payment
+}
+
+// Status returns the status of the statement on the given day.
+func (s *CreditCardStatement) Status(today time.Time) string {
+	if !today.After(s.closingDate) {
+		return StatementStatusOpen
+	}
+	if s.AmountDue().IsZero() {
+		return StatementStatusPaid
+	}
+	return StatementStatusClosed
+}
+
+// IsOverdue checks if the statement is past its due date with an amount still due on the given day.
+// A statement is not overdue once the minimum payment was made.
+func (s *CreditCardStatement) IsOverdue(today time.Time) bool {
+	return s.Status(today) == StatementStatusClosed && today.After(s.dueDate) && s.MinimumPayment().IsPositive()
+}
+
+// Pay records a payment towards the statement.
+func (s *CreditCardStatement) Pay(amount sharedvalueobjects.Money, date time.Time) error {
+	due := s.AmountDue()
+	if due.IsZero() {
+		return errors.New("cannot pay statement: no amount is due")
+	}
+	if !amount.IsPositive() {
+		return errors.New("invalid payment amount: must be greater than zero")
+	}
+	if !amount.Currency().Equals(s.paidAmount.Currency()) {
+		return errors.New("invalid payment amount: must be in the account currency")
+	}
+	if amount.Amount() > due.Amount() {
+		return fmt.Errorf("invalid payment amount: must not exceed the amount due of %s", due.String())
+	}
+
+	paidAmount, err := s.paidAmount.Add(amount)
+	if err != nil {
+		return fmt.Errorf("failed to record payment: %w", err)
+	}
+
+	s.paidAmount = paidAmount
+	s.paidAt = &date
+	s.updatedAt = time.Now()
+
+	return nil
+}
+
+// MarkLateNotified records that the user was notified that the statement is overdue.
+func (s *CreditCardStatement) MarkLateNotified(at time.Time) {
+	s.lateNotifiedAt = &at
+	s.updatedAt = time.Now()
+}
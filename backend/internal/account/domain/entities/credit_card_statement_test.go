@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestCreditCardStatement(t *testing.T) {
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	money := func(cents int64) sharedvalueobjects.Money {
+		amount, _ := sharedvalueobjects.NewMoney(cents, brl)
+		return amount
+	}
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	card, _ := NewAccount(identityvalueobjects.GenerateUserID(), valueobjects.MustAccountName("Cartão"), valueobjects.CreditCardType(), sharedvalueobjects.Zero(brl), sharedvalueobjects.PersonalContext())
+	terms, _ := valueobjects.NewCreditCardTerms(money(500000), 25, 5)
+	_ = card.SetCreditCardTerms(terms)
+
+	statement, err := NewCreditCardStatement(card, date(9, 25))
+	if err != nil {
+		t.Fatalf("NewCreditCardStatement() error = %v", err)
+	}
+	if statement.Month() != "2026-09" || !statement.PeriodStart().Equal(date(8, 26)) || !statement.DueDate().Equal(date(10, 5)) {
+		t.Errorf("unexpected cycle: %s from %s due %s", statement.Month(), statement.PeriodStart().Format("2006-01-02"), statement.DueDate().Format("2006-01-02"))
+	}
+	if !statement.Covers(date(8, 26)) || !statement.Covers(date(9, 25).Add(20*time.Hour)) || statement.Covers(date(9, 26)) {
+		t.Error("Covers() should include the days from the previous closing date to the closing date")
+	}
+
+	_ = statement.AddCharge(money(100000))
+	_ = statement.AddCharge(money(25000))
+	_ = statement.AddRefund(money(5000))
+	if statement.Total().Amount() != 120000 || statement.ChargeCount() != 3 {
+		t.Errorf("Total() = %d with %d charges, want 120000 with 3", statement.Total().Amount(), statement.ChargeCount())
+	}
+	if statement.MinimumPayment().Amount() != 18000 {
+		t.Errorf("MinimumPayment() = %d, want 18000", statement.MinimumPayment().Amount())
+	}
+
+	if status := statement.Status(date(9, 25)); status != StatementStatusOpen {
+		t.Errorf("Status() on the closing day = %s, want OPEN", status)
+	}
+	if status := statement.Status(date(9, 26)); status != StatementStatusClosed || statement.IsOverdue(date(10, 5)) {
+		t.Errorf("Status() after closing = %s, should not be overdue on the due date", status)
+	}
+	if !statement.IsOverdue(date(10, 6)) {
+		t.Error("IsOverdue() should be true after the due date")
+	}
+
+	// Partial payment of the minimum
+	if err := statement.Pay(money(20000), date(10, 6)); err != nil {
+		t.Fatalf("Pay() error = %v", err)
+	}
+	if statement.AmountDue().Amount() != 100000 || statement.MinimumPayment().Amount() != 0 || statement.IsOverdue(date(10, 7)) {
+		t.Errorf("after paying the minimum: due %d, minimum %d", statement.AmountDue().Amount(), statement.MinimumPayment().Amount())
+	}
+
+	if err := statement.Pay(money(100001), date(10, 7)); err == nil {
+		t.Error("Pay() should fail for more than the amount due")
+	}
+	if err := statement.Pay(money(100000), date(10, 7)); err != nil {
+		t.Fatalf("Pay() error = %v", err)
+	}
+	if statement.Status(date(10, 7)) != StatementStatusPaid || !statement.PaidAt().Equal(date(10, 7)) {
+		t.Errorf("Status() = %s, want PAID on 2026-10-07", statement.Status(date(10, 7)))
+	}
+	if err := statement.Pay(money(100), date(10, 8)); err == nil {
+		t.Error("Pay() should fail when nothing is due")
+	}
+
+	bank, _ := NewAccount(identityvalueobjects.GenerateUserID(), valueobjects.MustAccountName("Conta"), valueobjects.BankType(), sharedvalueobjects.Zero(brl), sharedvalueobjects.PersonalContext())
+	if _, err := NewCreditCardStatement(bank, date(9, 25)); err == nil {
+		t.Error("NewCreditCardStatement() should fail for an account without credit card terms")
+	}
+}
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// AccountBalanceAdjusted represents a domain event that occurs when the balance of an account is
+// set manually, e.g. to match the bank. The difference is recorded by a balance adjustment transaction.
+type AccountBalanceAdjusted struct {
+	events.BaseDomainEvent
+	userID          string
+	previousBalance sharedvalueobjects.Money
+	balance         sharedvalueobjects.Money
+}
+
+// NewAccountBalanceAdjusted creates a new AccountBalanceAdjusted event.
+func NewAccountBalanceAdjusted(
+	accountID string,
+	userID string,
+	previousBalance sharedvalueobjects.Money,
+	balance sharedvalueobjects.Money,
+) *AccountBalanceAdjusted {
+	baseEvent := events.NewBaseDomainEvent(
+		"AccountBalanceAdjusted",
+		accountID,
+		"Account",
+	)
+
+	return &AccountBalanceAdjusted{
+		BaseDomainEvent: baseEvent,
+		userID:          userID,
+		previousBalance: previousBalance,
+		balance:         balance,
+	}
+}
+
+// UserID returns the user ID who owns the account.
+func (e *AccountBalanceAdjusted) UserID() string {
+	return e.userID
+}
+
+// PreviousBalance returns the account balance before the adjustment.
+func (e *AccountBalanceAdjusted) PreviousBalance() sharedvalueobjects.Money {
+	return e.previousBalance
+}
+
+// Balance returns the account balance after the adjustment.
+func (e *AccountBalanceAdjusted) Balance() sharedvalueobjects.Money {
+	return e.balance
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// AccountBalanceLimitExceeded represents a domain event that occurs when a debit takes an account
+// in warn-only mode below its balance floor (zero, its overdraft limit or its credit limit).
+type AccountBalanceLimitExceeded struct {
+	events.BaseDomainEvent
+	userID    string
+	name      string
+	limitKind string
+	balance   sharedvalueobjects.Money
+	floor     sharedvalueobjects.Money
+}
+
+// NewAccountBalanceLimitExceeded creates a new AccountBalanceLimitExceeded event.
+func NewAccountBalanceLimitExceeded(
+	accountID string,
+	userID string,
+	name string,
+	limitKind string,
+	balance sharedvalueobjects.Money,
+	floor sharedvalueobjects.Money,
+) *AccountBalanceLimitExceeded {
+	baseEvent := events.NewBaseDomainEvent(
+		"AccountBalanceLimitExceeded",
+		accountID,
+		"Account",
+	)
+
+	return &AccountBalanceLimitExceeded{
+		BaseDomainEvent: baseEvent,
+		userID:          userID,
+		name:            name,
+		limitKind:       limitKind,
+		balance:         balance,
+		floor:           floor,
+	}
+}
+
+// UserID returns the user ID who owns the account.
+func (e *AccountBalanceLimitExceeded) UserID() string {
+	return e.userID
+}
+
+// Name returns the account name.
+func (e *AccountBalanceLimitExceeded) Name() string {
+	return e.name
+}
+
+// LimitKind returns which limit was exceeded (BALANCE, OVERDRAFT or CREDIT_LIMIT).
+func (e *AccountBalanceLimitExceeded) LimitKind() string {
+	return e.limitKind
+}
+
+// Balance returns the account balance after the debit.
+func (e *AccountBalanceLimitExceeded) Balance() sharedvalueobjects.Money {
+	return e.balance
+}
+
+// Floor returns the lowest balance the account was allowed to reach.
+func (e *AccountBalanceLimitExceeded) Floor() sharedvalueobjects.Money {
+	return e.floor
+}
@@ -22,6 +22,10 @@ type AccountRepository interface {
 	// Returns an empty slice if no accounts are found.
 	FindByUserIDAndContext(userID identityvalueobjects.UserID, context sharedvalueobjects.AccountContext) ([]*entities.Account, error)
 
+	// FindByType finds all active accounts of a given type, across users.
+	// Returns an empty slice if no accounts are found.
+	FindByType(accountType valueobjects.AccountType) ([]*entities.Account, error)
+
 	// Save saves or updates an account.
 	// If the account already exists (by ID), it updates it.
 	// If the account doesn't exist, it creates a new one.
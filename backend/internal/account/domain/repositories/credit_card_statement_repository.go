@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"time"
+
+	"gestao-financeira/backend/internal/account/domain/entities"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+)
+
+// CreditCardStatementRepository defines the interface for credit card statement persistence.
+// Only statements with a payment or a late payment notice are persisted.
+type CreditCardStatementRepository interface {
+	// FindByAccountID finds the persisted statements of a credit card account.
+	// Returns an empty slice if no statements are found.
+	FindByAccountID(accountID valueobjects.AccountID) ([]*entities.CreditCardStatement, error)
+
+	// FindByAccountIDAndClosingDate finds the statement of an account closing on the given date.
+	// Returns nil if the statement is not found.
+	FindByAccountIDAndClosingDate(accountID valueobjects.AccountID, closingDate time.Time) (*entities.CreditCardStatement, error)
+
+	// Save saves or updates a statement (identified by its account and closing date).
+	Save(statement *entities.CreditCardStatement) error
+}
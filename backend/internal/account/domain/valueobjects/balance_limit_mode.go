@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BalanceLimitMode represents what happens when a debit would take an account below its balance floor
+// (zero, the overdraft limit of a bank account or the credit limit of a credit card).
+type BalanceLimitMode struct {
+	value string
+}
+
+// Valid balance limit mode values
+const (
+	BalanceLimitEnforce = "ENFORCE" // The debit is rejected
+	BalanceLimitWarn    = "WARN"    // The debit goes through and the user is warned
+)
+
+// NewBalanceLimitMode creates a new BalanceLimitMode value object.
+func NewBalanceLimitMode(value string) (BalanceLimitMode, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	if value != BalanceLimitEnforce && value != BalanceLimitWarn {
+		return BalanceLimitMode{}, fmt.Errorf("invalid balance limit mode: %s. Supported values: ENFORCE, WARN", value)
+	}
+
+	return BalanceLimitMode{value: value}, nil
+}
+
+// EnforceBalanceLimitMode returns the default mode, which rejects debits beyond the balance floor.
+func EnforceBalanceLimitMode() BalanceLimitMode {
+	return BalanceLimitMode{value: BalanceLimitEnforce}
+}
+
+// WarnBalanceLimitMode returns the mode that allows debits beyond the balance floor with a warning.
+func WarnBalanceLimitMode() BalanceLimitMode {
+	return BalanceLimitMode{value: BalanceLimitWarn}
+}
+
+// Value returns the balance limit mode value (ENFORCE if not set).
+func (m BalanceLimitMode) Value() string {
+	if m.value == "" {
+		return BalanceLimitEnforce
+	}
+	return m.value
+}
+
+// String returns the balance limit mode as a string (implements fmt.Stringer).
+func (m BalanceLimitMode) String() string {
+	return m.Value()
+}
+
+// IsWarnOnly checks if debits beyond the balance floor are allowed with a warning.
+func (m BalanceLimitMode) IsWarnOnly() bool {
+	return m.value == BalanceLimitWarn
+}
+
+// Equals checks if two balance limit modes are equal.
+func (m BalanceLimitMode) Equals(other BalanceLimitMode) bool {
+	return m.Value() == other.Value()
+}
@@ -0,0 +1,41 @@
+package valueobjects
+
+import "testing"
+
+func TestNewBalanceLimitMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantError bool
+	}{
+		{"enforce", "ENFORCE", BalanceLimitEnforce, false},
+		{"warn in lowercase", " warn ", BalanceLimitWarn, false},
+		{"invalid mode", "IGNORE", "", true},
+		{"empty mode", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, err := NewBalanceLimitMode(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("NewBalanceLimitMode() error = %v, wantError %v", err, tt.wantError)
+			}
+			if !tt.wantError && mode.Value() != tt.want {
+				t.Errorf("NewBalanceLimitMode() value = %v, want %v", mode.Value(), tt.want)
+			}
+		})
+	}
+}
+
+func TestBalanceLimitMode_IsWarnOnly(t *testing.T) {
+	if EnforceBalanceLimitMode().IsWarnOnly() || (BalanceLimitMode{}).IsWarnOnly() {
+		t.Error("ENFORCE and the zero value should not be warn only")
+	}
+	if !WarnBalanceLimitMode().IsWarnOnly() {
+		t.Error("WARN should be warn only")
+	}
+	if !(BalanceLimitMode{}).Equals(EnforceBalanceLimitMode()) {
+		t.Error("the zero value should equal ENFORCE")
+	}
+}
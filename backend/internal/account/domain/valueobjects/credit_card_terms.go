@@ -0,0 +1,102 @@
+package valueobjects
+
+import (
+	"errors"
+	"time"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// CreditCardTerms represents the credit limit and billing days of a credit card account.
+//
+// Each statement closes on the closing day of a month (the last day of the month when the month
+// is shorter) and covers the purchases dated after the previous closing date up to and including
+// its own closing date. It is due on the due day of the same month if that comes after the closing
+// day, or of the next month otherwise.
+type CreditCardTerms struct {
+	creditLimit sharedvalueobjects.Money
+	closingDay  int
+	dueDay      int
+}
+
+// NewCreditCardTerms creates a new CreditCardTerms value object.
+func NewCreditCardTerms(creditLimit sharedvalueobjects.Money, closingDay, dueDay int) (CreditCardTerms, error) {
+	if !creditLimit.IsPositive() {
+		return CreditCardTerms{}, errors.New("invalid credit limit: must be greater than zero")
+	}
+	if closingDay < 1 || closingDay > 31 {
+		return CreditCardTerms{}, errors.New("invalid closing day: must be between 1 and 31")
+	}
+	if dueDay < 1 || dueDay > 31 {
+		return CreditCardTerms{}, errors.New("invalid due day: must be between 1 and 31")
+	}
+
+	return CreditCardTerms{
+		creditLimit: creditLimit,
+		closingDay:  closingDay,
+		dueDay:      dueDay,
+	}, nil
+}
+
+// CreditLimit returns the credit limit of the card.
+func (t CreditCardTerms) CreditLimit() sharedvalueobjects.Money {
+	return t.creditLimit
+}
+
+// ClosingDay returns the day of the month statements close on.
+func (t CreditCardTerms) ClosingDay() int {
+	return t.closingDay
+}
+
+// DueDay returns the day of the month statements are due on.
+func (t CreditCardTerms) DueDay() int {
+	return t.dueDay
+}
+
+// ClosingDateIn returns the closing date of the statement that closes in the given month.
+func (t CreditCardTerms) ClosingDateIn(year int, month time.Month) time.Time {
+	return dayInMonth(year, month, t.closingDay)
+}
+
+// StatementClosingDate returns the closing date of the statement a purchase made on the given
+// date belongs to.
+func (t CreditCardTerms) StatementClosingDate(date time.Time) time.Time {
+	date = date.UTC()
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	closing := t.ClosingDateIn(day.Year(), day.Month())
+	if day.After(closing) {
+		nextMonth := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+		return t.ClosingDateIn(nextMonth.Year(), nextMonth.Month())
+	}
+	return closing
+}
+
+// PreviousClosingDate returns the closing date of the statement before the one closing on the given date.
+func (t CreditCardTerms) PreviousClosingDate(closingDate time.Time) time.Time {
+	previousMonth := time.Date(closingDate.Year(), closingDate.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+	return t.ClosingDateIn(previousMonth.Year(), previousMonth.Month())
+}
+
+// DueDate returns the due date of the statement closing on the given date.
+func (t CreditCardTerms) DueDate(closingDate time.Time) time.Time {
+	if t.dueDay > t.closingDay {
+		return dayInMonth(closingDate.Year(), closingDate.Month(), t.dueDay)
+	}
+	nextMonth := time.Date(closingDate.Year(), closingDate.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+	return dayInMonth(nextMonth.Year(), nextMonth.Month(), t.dueDay)
+}
+
+// Equals checks if two CreditCardTerms values are equal.
+func (t CreditCardTerms) Equals(other CreditCardTerms) bool {
+	return t.creditLimit.Equals(other.creditLimit) && t.closingDay == other.closingDay && t.dueDay == other.dueDay
+}
+
+// dayInMonth returns the given day of a month, or the last day of the month if it is shorter.
+func dayInMonth(year int, month time.Month, day int) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,90 @@
+package valueobjects
+
+import (
+	"testing"
+	"time"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestNewCreditCardTerms(t *testing.T) {
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	limit, _ := sharedvalueobjects.NewMoney(500000, brl)
+
+	tests := []struct {
+		name       string
+		limit      sharedvalueobjects.Money
+		closingDay int
+		dueDay     int
+		wantError  bool
+	}{
+		{"valid terms", limit, 25, 5, false},
+		{"last day of the month", limit, 31, 10, false},
+		{"zero limit", sharedvalueobjects.Zero(brl), 25, 5, true},
+		{"negative limit", limit.Negate(), 25, 5, true},
+		{"closing day zero", limit, 0, 5, true},
+		{"closing day after 31", limit, 32, 5, true},
+		{"due day zero", limit, 25, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms, err := NewCreditCardTerms(tt.limit, tt.closingDay, tt.dueDay)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("NewCreditCardTerms() error = %v, wantError %v", err, tt.wantError)
+			}
+			if !tt.wantError && (terms.ClosingDay() != tt.closingDay || terms.DueDay() != tt.dueDay || !terms.CreditLimit().Equals(tt.limit)) {
+				t.Errorf("NewCreditCardTerms() = %+v", terms)
+			}
+		})
+	}
+}
+
+func TestCreditCardTerms_StatementDates(t *testing.T) {
+	limit, _ := sharedvalueobjects.NewMoney(500000, sharedvalueobjects.MustCurrency("BRL"))
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("statement of a purchase", func(t *testing.T) {
+		terms, _ := NewCreditCardTerms(limit, 25, 5)
+		tests := []struct {
+			purchase time.Time
+			want     time.Time
+		}{
+			{date(2026, 10, 3), date(2026, 10, 25)},
+			{date(2026, 10, 25).Add(18 * time.Hour), date(2026, 10, 25)}, // on the closing day
+			{date(2026, 10, 26), date(2026, 11, 25)},
+			{date(2026, 12, 30), date(2027, 1, 25)},
+		}
+		for _, tt := range tests {
+			if got := terms.StatementClosingDate(tt.purchase); !got.Equal(tt.want) {
+				t.Errorf("StatementClosingDate(%s) = %s, want %s", tt.purchase.Format("2006-01-02"), got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+			}
+		}
+	})
+
+	t.Run("closing day beyond the end of the month", func(t *testing.T) {
+		terms, _ := NewCreditCardTerms(limit, 31, 10)
+		if got := terms.ClosingDateIn(2026, 2); !got.Equal(date(2026, 2, 28)) {
+			t.Errorf("ClosingDateIn(February) = %s, want 2026-02-28", got.Format("2006-01-02"))
+		}
+		if got := terms.StatementClosingDate(date(2026, 3, 1)); !got.Equal(date(2026, 3, 31)) {
+			t.Errorf("StatementClosingDate(2026-03-01) = %s, want 2026-03-31", got.Format("2006-01-02"))
+		}
+		if got := terms.PreviousClosingDate(date(2026, 3, 31)); !got.Equal(date(2026, 2, 28)) {
+			t.Errorf("PreviousClosingDate(2026-03-31) = %s, want 2026-02-28", got.Format("2006-01-02"))
+		}
+	})
+
+	t.Run("due date", func(t *testing.T) {
+		nextMonth, _ := NewCreditCardTerms(limit, 25, 5)
+		if got := nextMonth.DueDate(date(2026, 12, 25)); !got.Equal(date(2027, 1, 5)) {
+			t.Errorf("DueDate() = %s, want 2027-01-05", got.Format("2006-01-02"))
+		}
+		sameMonth, _ := NewCreditCardTerms(limit, 3, 10)
+		if got := sameMonth.DueDate(date(2026, 10, 3)); !got.Equal(date(2026, 10, 10)) {
+			t.Errorf("DueDate() = %s, want 2026-10-10", got.Format("2006-01-02"))
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"fmt"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountevents "gestao-financeira/backend/internal/account/domain/events"
+	notificationdtos "gestao-financeira/backend/internal/notification/application/dtos"
+	notificationusecases "gestao-financeira/backend/internal/notification/application/usecases"
+	"gestao-financeira/backend/internal/shared/domain/events"
+
+	"github.com/rs/zerolog/log"
+)
+
+// BalanceLimitNotificationHandler handles AccountBalanceLimitExceeded events, raised when a debit
+// takes an account in warn-only mode below its balance floor, and warns the user with a notification.
+type BalanceLimitNotificationHandler struct {
+	createNotificationUseCase *notificationusecases.CreateNotificationUseCase
+}
+
+// NewBalanceLimitNotificationHandler creates a new BalanceLimitNotificationHandler instance.
+func NewBalanceLimitNotificationHandler(createNotificationUseCase *notificationusecases.CreateNotificationUseCase) *BalanceLimitNotificationHandler {
+	return &BalanceLimitNotificationHandler{
+		createNotificationUseCase: createNotificationUseCase,
+	}
+}
+
+// HandleAccountBalanceLimitExceeded creates a WARNING notification for the owner of the account.
+func (h *BalanceLimitNotificationHandler) HandleAccountBalanceLimitExceeded(event events.DomainEvent) error {
+	limitExceeded, ok := event.(*accountevents.AccountBalanceLimitExceeded)
+	if !ok {
+		return fmt.Errorf("expected AccountBalanceLimitExceeded event, got %T", event)
+	}
+
+	name, balance, limit := limitExceeded.Name(), limitExceeded.Balance(), limitExceeded.Floor().Negate()
+	var message string
+	switch limitExceeded.LimitKind() {
+	case accountentities.LimitKindOverdraft:
+		message = fmt.Sprintf("The balance of %s is now %s, beyond its overdraft limit of %s.", name, balance.String(), limit.String())
+	case accountentities.LimitKindCreditLimit:
+		message = fmt.Sprintf("%s now owes %s, beyond its credit limit of %s.", name, balance.Negate().String(), limit.String())
+	default:
+		message = fmt.Sprintf("The balance of %s is now %s, below zero.", name, balance.String())
+	}
+
+	_, err := h.createNotificationUseCase.Execute(notificationdtos.CreateNotificationInput{
+		UserID:  limitExceeded.UserID(),
+		Title:   "Account balance limit exceeded",
+		Message: message,
+		Type:    "WARNING",
+		Metadata: map[string]interface{}{
+			"account_id":    limitExceeded.AggregateID(),
+			"limit_kind":    limitExceeded.LimitKind(),
+			"balance":       balance.Float64(),
+			"balance_floor": limitExceeded.Floor().Float64(),
+			"currency":      balance.Currency().Code(),
+		},
+	})
+	if err != nil {
+		log.Error().Err(err).
+			Str("account_id", limitExceeded.AggregateID()).
+			Msg("Failed to create balance limit notification")
+		return fmt.Errorf("failed to create balance limit notification: %w", err)
+	}
+
+	log.Info().
+		Str("account_id", limitExceeded.AggregateID()).
+		Str("limit_kind", limitExceeded.LimitKind()).
+		Msg("Balance limit exceeded notification created")
+
+	return nil
+}
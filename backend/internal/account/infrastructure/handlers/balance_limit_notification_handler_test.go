@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+
+	"gestao-financeira/backend/internal/account/domain/entities"
+	accountevents "gestao-financeira/backend/internal/account/domain/events"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	notificationusecases "gestao-financeira/backend/internal/notification/application/usecases"
+	notificationpersistence "gestao-financeira/backend/internal/notification/infrastructure/persistence"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestBalanceLimitNotificationHandler_HandleAccountBalanceLimitExceeded(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+	if err := db.AutoMigrate(&notificationpersistence.NotificationModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	notificationRepository := notificationpersistence.NewGormNotificationRepository(db)
+	handler := NewBalanceLimitNotificationHandler(
+		notificationusecases.NewCreateNotificationUseCase(notificationRepository, eventbus.NewEventBus()),
+	)
+
+	// A bank account in warn-only mode debited beyond its 500.00 overdraft limit
+	userID := identityvalueobjects.GenerateUserID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	balance, _ := sharedvalueobjects.NewMoney(10000, brl)
+	overdraftLimit, _ := sharedvalueobjects.NewMoney(50000, brl)
+	account, _ := entities.NewAccount(userID, accountvalueobjects.MustAccountName("Conta Corrente"), accountvalueobjects.BankType(), balance, sharedvalueobjects.PersonalContext())
+	if err := account.SetBalanceLimits(overdraftLimit, accountvalueobjects.WarnBalanceLimitMode()); err != nil {
+		t.Fatalf("failed to set balance limits: %v", err)
+	}
+	account.ClearEvents()
+	debit, _ := sharedvalueobjects.NewMoney(70000, brl)
+	if err := account.Debit(debit); err != nil {
+		t.Fatalf("failed to debit account: %v", err)
+	}
+	domainEvents := account.GetEvents()
+
+	if err := handler.HandleAccountBalanceLimitExceeded(domainEvents[len(domainEvents)-1]); err != nil {
+		t.Fatalf("HandleAccountBalanceLimitExceeded() error = %v", err)
+	}
+
+	notifications, _ := notificationRepository.FindByUserID(userID)
+	if len(notifications) != 1 || notifications[0].Type().Value() != "WARNING" {
+		t.Fatalf("expected a warning for the user, got %d notifications", len(notifications))
+	}
+	message := notifications[0].Message().Value()
+	if !strings.Contains(message, "Conta Corrente") || !strings.Contains(message, "-600.00 BRL") || !strings.Contains(message, "500.00 BRL") {
+		t.Errorf("expected the message to name the account, balance and overdraft limit, got %q", message)
+	}
+
+	// Other events are rejected
+	if err := handler.HandleAccountBalanceLimitExceeded(transactionevents.NewTransactionCreated("tx", account.ID().Value(), "EXPENSE", debit)); err == nil {
+		t.Error("expected an error for an unexpected event type")
+	}
+	if _, ok := domainEvents[len(domainEvents)-1].(*accountevents.AccountBalanceLimitExceeded); !ok {
+		t.Errorf("expected the last event to be AccountBalanceLimitExceeded")
+	}
+}
@@ -38,6 +38,10 @@ func (m *mockAccountRepositoryForBalanceHandler) FindByUserIDAndContext(userID i
 	return nil, nil
 }
 
+func (m *mockAccountRepositoryForBalanceHandler) FindByType(accountType accountvalueobjects.AccountType) ([]*entities.Account, error) {
+	return []*entities.Account{}, nil
+}
+
 func (m *mockAccountRepositoryForBalanceHandler) Save(account *entities.Account) error {
 	if m.saveErr != nil {
 		return m.saveErr
@@ -21,6 +21,15 @@ type cachedAccountData struct {
 	IsActive  bool      `json:"is_active"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	// Credit card terms (nil if not set)
+	CreditLimit *int64 `json:"credit_limit,omitempty"`
+	ClosingDay  *int   `json:"closing_day,omitempty"`
+	DueDay      *int   `json:"due_day,omitempty"`
+
+	// Balance floor policy
+	OverdraftLimit   int64  `json:"overdraft_limit,omitempty"` // Amount in cents
+	BalanceLimitMode string `json:"balance_limit_mode,omitempty"`
 }
 
 // accountToCacheData converts an Account entity to cachedAccountData.
@@ -29,7 +38,7 @@ func accountToCacheData(account *entities.Account) *cachedAccountData {
 		return nil
 	}
 	balance := account.Balance()
-	return &cachedAccountData{
+	data := &cachedAccountData{
 		ID:        account.ID().Value(),
 		UserID:    account.UserID().Value(),
 		Name:      account.Name().Value(),
@@ -40,7 +49,19 @@ func accountToCacheData(account *entities.Account) *cachedAccountData {
 		IsActive:  account.IsActive(),
 		CreatedAt: account.CreatedAt(),
 		UpdatedAt: account.UpdatedAt(),
+
+		OverdraftLimit:   account.OverdraftLimit().Amount(),
+		BalanceLimitMode: account.BalanceLimitMode().Value(),
 	}
+	if terms := account.CreditCardTerms(); terms != nil {
+		creditLimit := terms.CreditLimit().Amount()
+		closingDay := terms.ClosingDay()
+		dueDay := terms.DueDay()
+		data.CreditLimit = &creditLimit
+		data.ClosingDay = &closingDay
+		data.DueDay = &dueDay
+	}
+	return data
 }
 
 // cacheDataToAccount converts cachedAccountData back to Account entity.
@@ -84,7 +105,17 @@ func cacheDataToAccount(data *cachedAccountData) (*entities.Account, error) {
 		return nil, err
 	}
 
-	return entities.AccountFromPersistence(
+	creditCardTerms, err := creditCardTermsFromModel(data.CreditLimit, data.ClosingDay, data.DueDay, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	overdraftLimit, balanceLimitMode, err := balanceLimitsFromModel(data.OverdraftLimit, data.BalanceLimitMode, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.AccountFromPersistenceWithLimits(
 		accountID,
 		userID,
 		accountName,
@@ -94,6 +125,9 @@ func cacheDataToAccount(data *cachedAccountData) (*entities.Account, error) {
 		data.CreatedAt,
 		data.UpdatedAt,
 		data.IsActive,
+		creditCardTerms,
+		overdraftLimit,
+		balanceLimitMode,
 	)
 }
 
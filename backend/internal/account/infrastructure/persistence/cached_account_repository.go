@@ -142,6 +142,11 @@ func (r *CachedAccountRepository) FindByUserIDAndContext(userID identityvalueobj
 	return accounts, nil
 }
 
+// FindByType finds all active accounts of a given type, across users (no caching for cross-user queries).
+func (r *CachedAccountRepository) FindByType(accountType valueobjects.AccountType) ([]*entities.Account, error) {
+	return r.repository.FindByType(accountType)
+}
+
 // Save saves or updates an account and invalidates cache.
 func (r *CachedAccountRepository) Save(account *entities.Account) error {
 	// Save to repository
@@ -46,6 +46,10 @@ func (m *mockAccountRepository) FindByUserIDAndContext(userID identityvalueobjec
 	return filtered, nil
 }
 
+func (m *mockAccountRepository) FindByType(accountType valueobjects.AccountType) ([]*entities.Account, error) {
+	return []*entities.Account{}, nil
+}
+
 func (m *mockAccountRepository) Save(account *entities.Account) error {
 	m.accounts[account.ID().Value()] = account
 	userID := account.UserID().Value()
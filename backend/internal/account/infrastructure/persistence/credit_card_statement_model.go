@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"time"
+)
+
+// CreditCardStatementModel represents the database model for CreditCardStatement entity.
+// This is the persistence model, separate from the domain entity.
+type CreditCardStatementModel struct {
+	AccountID      string     `gorm:"type:uuid;primaryKey"`
+	ClosingDate    time.Time  `gorm:"type:date;primaryKey"`
+	UserID         string     `gorm:"type:uuid;index;not null"`
+	PeriodStart    time.Time  `gorm:"type:date;not null"`
+	DueDate        time.Time  `gorm:"type:date;not null"`
+	PaidAmount     int64      `gorm:"type:bigint;not null;default:0"` // Amount in cents
+	Currency       string     `gorm:"type:varchar(3);not null"`
+	PaidAt         *time.Time `gorm:"type:date"`
+	LateNotifiedAt *time.Time
+	CreatedAt      time.Time `gorm:"not null"`
+	UpdatedAt      time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (CreditCardStatementModel) TableName() string {
+	return "credit_card_statements"
+}
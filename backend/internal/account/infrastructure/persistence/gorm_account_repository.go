@@ -76,6 +76,25 @@ func (r *GormAccountRepository) FindByUserIDAndContext(userID identityvalueobjec
 	return accounts, nil
 }
 
+// FindByType finds all active accounts of a given type, across users.
+func (r *GormAccountRepository) FindByType(accountType valueobjects.AccountType) ([]*entities.Account, error) {
+	var models []AccountModel
+	if err := r.db.Where("type = ? AND is_active = ?", accountType.Value(), true).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find accounts by type: %w", err)
+	}
+
+	accounts := make([]*entities.Account, 0, len(models))
+	for _, model := range models {
+		account, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert account model to domain: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
 // Save saves or updates an account.
 func (r *GormAccountRepository) Save(account *entities.Account) error {
 	model := r.toModel(account)
@@ -237,8 +256,18 @@ func (r *GormAccountRepository) toDomain(model *AccountModel) (*entities.Account
 		return nil, fmt.Errorf("invalid account context: %w", err)
 	}
 
+	creditCardTerms, err := creditCardTermsFromModel(model.CreditLimit, model.ClosingDay, model.DueDay, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	overdraftLimit, balanceLimitMode, err := balanceLimitsFromModel(model.OverdraftLimit, model.BalanceLimitMode, currency)
+	if err != nil {
+		return nil, err
+	}
+
 	// Reconstruct account entity from persisted data
-	return entities.AccountFromPersistence(
+	return entities.AccountFromPersistenceWithLimits(
 		accountID,
 		userID,
 		accountName,
@@ -248,6 +277,9 @@ func (r *GormAccountRepository) toDomain(model *AccountModel) (*entities.Account
 		model.CreatedAt,
 		model.UpdatedAt,
 		model.IsActive,
+		creditCardTerms,
+		overdraftLimit,
+		balanceLimitMode,
 	)
 }
 
@@ -255,7 +287,7 @@ func (r *GormAccountRepository) toDomain(model *AccountModel) (*entities.Account
 func (r *GormAccountRepository) toModel(account *entities.Account) *AccountModel {
 	balance := account.Balance()
 
-	return &AccountModel{
+	model := &AccountModel{
 		ID:        account.ID().Value(),
 		UserID:    account.UserID().Value(),
 		Name:      account.Name().Value(),
@@ -266,5 +298,54 @@ func (r *GormAccountRepository) toModel(account *entities.Account) *AccountModel
 		IsActive:  account.IsActive(),
 		CreatedAt: account.CreatedAt(),
 		UpdatedAt: account.UpdatedAt(),
+
+		OverdraftLimit:   account.OverdraftLimit().Amount(),
+		BalanceLimitMode: account.BalanceLimitMode().Value(),
+	}
+
+	if terms := account.CreditCardTerms(); terms != nil {
+		creditLimit := terms.CreditLimit().Amount()
+		closingDay := terms.ClosingDay()
+		dueDay := terms.DueDay()
+		model.CreditLimit = &creditLimit
+		model.ClosingDay = &closingDay
+		model.DueDay = &dueDay
+	}
+
+	return model
+}
+
+// creditCardTermsFromModel rebuilds the credit card terms of an account from their persisted
+// columns (nil if the account has none).
+func creditCardTermsFromModel(creditLimit *int64, closingDay, dueDay *int, currency sharedvalueobjects.Currency) (*valueobjects.CreditCardTerms, error) {
+	if creditLimit == nil || closingDay == nil || dueDay == nil {
+		return nil, nil
+	}
+
+	limit, err := sharedvalueobjects.NewMoney(*creditLimit, currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credit limit: %w", err)
+	}
+	terms, err := valueobjects.NewCreditCardTerms(limit, *closingDay, *dueDay)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credit card terms: %w", err)
+	}
+	return &terms, nil
+}
+
+// balanceLimitsFromModel rebuilds the overdraft limit and balance limit mode of an account from
+// their persisted columns (an empty mode is ENFORCE).
+func balanceLimitsFromModel(overdraftLimit int64, mode string, currency sharedvalueobjects.Currency) (sharedvalueobjects.Money, valueobjects.BalanceLimitMode, error) {
+	limit, err := sharedvalueobjects.NewMoney(overdraftLimit, currency)
+	if err != nil {
+		return sharedvalueobjects.Money{}, valueobjects.BalanceLimitMode{}, fmt.Errorf("invalid overdraft limit: %w", err)
+	}
+	if mode == "" {
+		return limit, valueobjects.EnforceBalanceLimitMode(), nil
+	}
+	balanceLimitMode, err := valueobjects.NewBalanceLimitMode(mode)
+	if err != nil {
+		return sharedvalueobjects.Money{}, valueobjects.BalanceLimitMode{}, err
 	}
+	return limit, balanceLimitMode, nil
 }
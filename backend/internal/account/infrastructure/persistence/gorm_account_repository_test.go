@@ -254,6 +254,22 @@ func TestGormAccountRepository_Save(t *testing.T) {
 	if updated.Name().Value() != "Conta Atualizada" {
 		t.Errorf("Save() updated name = %v, want 'Conta Atualizada'", updated.Name().Value())
 	}
+
+	// Test balance limits round trip
+	if updated.OverdraftLimit().Amount() != 0 || updated.BalanceLimitMode().IsWarnOnly() {
+		t.Errorf("Save() new account limits = %v %v, want no overdraft and ENFORCE", updated.OverdraftLimit(), updated.BalanceLimitMode())
+	}
+	overdraftLimit, _ := sharedvalueobjects.NewMoney(50000, sharedvalueobjects.MustCurrency("BRL"))
+	if err := account.SetBalanceLimits(overdraftLimit, valueobjects.WarnBalanceLimitMode()); err != nil {
+		t.Fatalf("SetBalanceLimits() error = %v", err)
+	}
+	if err := repo.Save(account); err != nil {
+		t.Fatalf("Save() error on update = %v", err)
+	}
+	updated, _ = repo.FindByID(account.ID())
+	if updated.OverdraftLimit().Amount() != 50000 || !updated.BalanceLimitMode().IsWarnOnly() {
+		t.Errorf("Save() updated limits = %v %v, want 500.00 BRL and WARN", updated.OverdraftLimit(), updated.BalanceLimitMode())
+	}
 }
 
 func TestGormAccountRepository_Delete(t *testing.T) {
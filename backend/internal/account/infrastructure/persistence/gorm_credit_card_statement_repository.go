@@ -0,0 +1,118 @@
+package persistence
+
+import (
+	"fmt"
+	"time"
+
+	"gestao-financeira/backend/internal/account/domain/entities"
+	"gestao-financeira/backend/internal/account/domain/repositories"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormCreditCardStatementRepository implements CreditCardStatementRepository using GORM.
+type GormCreditCardStatementRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCreditCardStatementRepository creates a new GORM credit card statement repository.
+func NewGormCreditCardStatementRepository(db *gorm.DB) repositories.CreditCardStatementRepository {
+	return &GormCreditCardStatementRepository{db: db}
+}
+
+// FindByAccountID finds the persisted statements of a credit card account, most recent first.
+func (r *GormCreditCardStatementRepository) FindByAccountID(accountID valueobjects.AccountID) ([]*entities.CreditCardStatement, error) {
+	var models []CreditCardStatementModel
+	if err := r.db.Where("account_id = ?", accountID.Value()).
+		Order("closing_date DESC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find credit card statements: %w", err)
+	}
+
+	statements := make([]*entities.CreditCardStatement, 0, len(models))
+	for _, model := range models {
+		statement, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert model to domain: %w", err)
+		}
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+// FindByAccountIDAndClosingDate finds the statement of an account closing on the given date.
+func (r *GormCreditCardStatementRepository) FindByAccountIDAndClosingDate(accountID valueobjects.AccountID, closingDate time.Time) (*entities.CreditCardStatement, error) {
+	var model CreditCardStatementModel
+	err := r.db.Where("account_id = ? AND closing_date = ?", accountID.Value(), closingDate).First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find credit card statement: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// Save saves or updates a statement.
+func (r *GormCreditCardStatementRepository) Save(statement *entities.CreditCardStatement) error {
+	model := r.toModel(statement)
+
+	if err := r.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to save credit card statement: %w", err)
+	}
+
+	return nil
+}
+
+// toDomain converts a CreditCardStatementModel to a CreditCardStatement entity.
+func (r *GormCreditCardStatementRepository) toDomain(model *CreditCardStatementModel) (*entities.CreditCardStatement, error) {
+	accountID, err := valueobjects.NewAccountID(model.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	paidAmount, err := sharedvalueobjects.NewMoneyFromString(model.PaidAmount, model.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid paid amount: %w", err)
+	}
+
+	return entities.CreditCardStatementFromPersistence(
+		userID,
+		accountID,
+		model.PeriodStart,
+		model.ClosingDate,
+		model.DueDate,
+		paidAmount,
+		model.PaidAt,
+		model.LateNotifiedAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
+
+// toModel converts a CreditCardStatement entity to a CreditCardStatementModel.
+func (r *GormCreditCardStatementRepository) toModel(statement *entities.CreditCardStatement) *CreditCardStatementModel {
+	paidAmount := statement.PaidAmount()
+	return &CreditCardStatementModel{
+		AccountID:      statement.AccountID().Value(),
+		ClosingDate:    statement.ClosingDate(),
+		UserID:         statement.UserID().Value(),
+		PeriodStart:    statement.PeriodStart(),
+		DueDate:        statement.DueDate(),
+		PaidAmount:     paidAmount.Amount(),
+		Currency:       paidAmount.Currency().Code(),
+		PaidAt:         statement.PaidAt(),
+		LateNotifiedAt: statement.LateNotifiedAt(),
+		CreatedAt:      statement.CreatedAt(),
+		UpdatedAt:      statement.UpdatedAt(),
+	}
+}
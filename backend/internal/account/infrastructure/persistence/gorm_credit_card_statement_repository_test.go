@@ -0,0 +1,93 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"gestao-financeira/backend/internal/account/domain/entities"
+	"gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestGormCreditCardStatementRepository(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&CreditCardStatementModel{}); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+	accountRepo := NewGormAccountRepository(db)
+	repo := NewGormCreditCardStatementRepository(db)
+
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	money := func(cents int64) sharedvalueobjects.Money {
+		amount, _ := sharedvalueobjects.NewMoney(cents, brl)
+		return amount
+	}
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	card, _ := entities.NewAccount(identityvalueobjects.GenerateUserID(), valueobjects.MustAccountName("Cartão"), valueobjects.CreditCardType(), sharedvalueobjects.Zero(brl), sharedvalueobjects.PersonalContext())
+	terms, _ := valueobjects.NewCreditCardTerms(money(500000), 25, 5)
+	if err := card.SetCreditCardTerms(terms); err != nil {
+		t.Fatalf("SetCreditCardTerms() error = %v", err)
+	}
+
+	t.Run("account keeps its credit card terms", func(t *testing.T) {
+		if err := accountRepo.Save(card); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		found, err := accountRepo.FindByID(card.ID())
+		if err != nil || found == nil {
+			t.Fatalf("FindByID() = %v, %v", found, err)
+		}
+		if found.CreditCardTerms() == nil || !found.CreditCardTerms().Equals(terms) {
+			t.Errorf("expected terms %+v, got %+v", terms, found.CreditCardTerms())
+		}
+
+		cards, err := accountRepo.FindByType(valueobjects.CreditCardType())
+		if err != nil || len(cards) != 1 || !cards[0].ID().Equals(card.ID()) {
+			t.Errorf("FindByType() = %v, %v, want the credit card", cards, err)
+		}
+	})
+
+	t.Run("saves and updates statements", func(t *testing.T) {
+		for _, closingDate := range []time.Time{date(8, 25), date(9, 25)} {
+			statement, _ := entities.NewCreditCardStatement(card, closingDate)
+			_ = statement.AddCharge(money(100000))
+			if err := statement.Pay(money(40000), date(10, 1)); err != nil {
+				t.Fatalf("Pay() error = %v", err)
+			}
+			if err := repo.Save(statement); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+		}
+
+		found, err := repo.FindByAccountIDAndClosingDate(card.ID(), date(9, 25))
+		if err != nil || found == nil {
+			t.Fatalf("FindByAccountIDAndClosingDate() = %v, %v", found, err)
+		}
+		if found.PaidAmount().Amount() != 40000 || found.PaidAt() == nil || !found.DueDate().Equal(date(10, 5)) {
+			t.Errorf("unexpected statement: paid %d on %v, due %s", found.PaidAmount().Amount(), found.PaidAt(), found.DueDate())
+		}
+
+		found.MarkLateNotified(time.Now())
+		if err := repo.Save(found); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		statements, err := repo.FindByAccountID(card.ID())
+		if err != nil {
+			t.Fatalf("FindByAccountID() error = %v", err)
+		}
+		if len(statements) != 2 || statements[0].Month() != "2026-09" || statements[0].LateNotifiedAt() == nil {
+			t.Errorf("expected the updated September statement first, got %d statements", len(statements))
+		}
+
+		missing, err := repo.FindByAccountIDAndClosingDate(card.ID(), date(7, 25))
+		if err != nil || missing != nil {
+			t.Errorf("expected no statement, got %v, %v", missing, err)
+		}
+	})
+}
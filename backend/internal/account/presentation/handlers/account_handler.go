@@ -14,21 +14,52 @@ import (
 
 // AccountHandler handles account-related HTTP requests.
 type AccountHandler struct {
-	createAccountUseCase *usecases.CreateAccountUseCase
-	listAccountsUseCase  *usecases.ListAccountsUseCase
-	getAccountUseCase    *usecases.GetAccountUseCase
+	createAccountUseCase       *usecases.CreateAccountUseCase
+	listAccountsUseCase        *usecases.ListAccountsUseCase
+	getAccountUseCase          *usecases.GetAccountUseCase
+	updateAccountUseCase       *usecases.UpdateAccountUseCase
+	archiveAccountUseCase      *usecases.ArchiveAccountUseCase
+	reactivateAccountUseCase   *usecases.ReactivateAccountUseCase
+	deleteAccountUseCase       *usecases.DeleteAccountUseCase
+	updateCreditCardUseCase    *usecases.UpdateCreditCardTermsUseCase
+	updateBalanceLimitsUseCase *usecases.UpdateBalanceLimitsUseCase
+	adjustBalanceUseCase       *usecases.AdjustBalanceUseCase
+	listStatementsUseCase      *usecases.ListStatementsUseCase
+	payStatementUseCase        *usecases.PayStatementUseCase
+}
+
+// AccountHandlerDeps holds the use cases an AccountHandler delegates to. Use cases left nil are
+// not available, e.g. in tests that only exercise some endpoints.
+type AccountHandlerDeps struct {
+	CreateAccountUseCase         *usecases.CreateAccountUseCase
+	ListAccountsUseCase          *usecases.ListAccountsUseCase
+	GetAccountUseCase            *usecases.GetAccountUseCase
+	UpdateAccountUseCase         *usecases.UpdateAccountUseCase
+	ArchiveAccountUseCase        *usecases.ArchiveAccountUseCase
+	ReactivateAccountUseCase     *usecases.ReactivateAccountUseCase
+	DeleteAccountUseCase         *usecases.DeleteAccountUseCase
+	UpdateCreditCardTermsUseCase *usecases.UpdateCreditCardTermsUseCase
+	UpdateBalanceLimitsUseCase   *usecases.UpdateBalanceLimitsUseCase
+	AdjustBalanceUseCase         *usecases.AdjustBalanceUseCase
+	ListStatementsUseCase        *usecases.ListStatementsUseCase
+	PayStatementUseCase          *usecases.PayStatementUseCase
 }
 
 // NewAccountHandler creates a new AccountHandler instance.
-func NewAccountHandler(
-	createAccountUseCase *usecases.CreateAccountUseCase,
-	listAccountsUseCase *usecases.ListAccountsUseCase,
-	getAccountUseCase *usecases.GetAccountUseCase,
-) *AccountHandler {
+func NewAccountHandler(deps AccountHandlerDeps) *AccountHandler {
 	return &AccountHandler{
-		createAccountUseCase: createAccountUseCase,
-		listAccountsUseCase:  listAccountsUseCase,
-		getAccountUseCase:    getAccountUseCase,
+		createAccountUseCase:       deps.CreateAccountUseCase,
+		listAccountsUseCase:        deps.ListAccountsUseCase,
+		getAccountUseCase:          deps.GetAccountUseCase,
+		updateAccountUseCase:       deps.UpdateAccountUseCase,
+		archiveAccountUseCase:      deps.ArchiveAccountUseCase,
+		reactivateAccountUseCase:   deps.ReactivateAccountUseCase,
+		deleteAccountUseCase:       deps.DeleteAccountUseCase,
+		updateCreditCardUseCase:    deps.UpdateCreditCardTermsUseCase,
+		updateBalanceLimitsUseCase: deps.UpdateBalanceLimitsUseCase,
+		adjustBalanceUseCase:       deps.AdjustBalanceUseCase,
+		listStatementsUseCase:      deps.ListStatementsUseCase,
+		payStatementUseCase:        deps.PayStatementUseCase,
 	}
 }
 
@@ -48,8 +79,9 @@ func NewAccountHandler(
 //
 // **Validações**:
 // - Nome da conta é obrigatório e deve ser único para o usuário
-// - Saldo inicial pode ser zero ou positivo
+// - Saldo inicial pode ser zero ou positivo; se positivo, é registrado como uma transação `ADJUSTMENT_IN` ("Opening balance") na data de criação da conta
 // - Moeda deve ser válida (ex: BRL, USD, EUR)
+// - `credit_limit`, `closing_day` e `due_day` são opcionais, só valem para `CREDIT_CARD` e devem ser informados juntos
 //
 // @Tags accounts
 // @Accept json
@@ -86,6 +118,7 @@ func (h *AccountHandler) Create(c *fiber.Ctx) error {
 
 	// Set user ID from context (override any user_id in request body for security)
 	input.UserID = userID
+	input.RequestID = middleware.GetRequestID(c)
 
 	// Validate input
 	if err := validator.Validate(&input); err != nil {
@@ -115,6 +148,7 @@ func (h *AccountHandler) Create(c *fiber.Ctx) error {
 //
 // **Filtros Disponíveis**:
 // - `context`: Filtra por contexto (`PERSONAL` ou `BUSINESS`)
+// - `include_archived`: Inclui contas arquivadas (por padrão são ocultadas, ex: em seletores de conta)
 //
 // **Paginação**:
 // - `page`: Número da página (1-based, padrão: 1)
@@ -130,6 +164,7 @@ func (h *AccountHandler) Create(c *fiber.Ctx) error {
 // @Produce json
 // @Security Bearer
 // @Param context query string false "Filter by context (PERSONAL or BUSINESS)" Enums(PERSONAL, BUSINESS) example(PERSONAL)
+// @Param include_archived query bool false "Include archived accounts (default: false)" example(true)
 // @Param page query string false "Page number (1-based, default: 1)" example(1)
 // @Param limit query string false "Items per page (default: 10, max: 100)" example(20)
 // @Success 200 {object} map[string]interface{} "Accounts retrieved successfully" example({"message":"Accounts retrieved successfully","data":{"accounts":[{"account_id":"550e8400-e29b-41d4-a716-446655440000","name":"Conta Corrente","type":"BANK","balance":1000.00,"currency":"BRL","context":"PERSONAL"}],"count":20,"pagination":{"page":1,"limit":20,"total":45,"total_pages":3,"has_next":true,"has_prev":false}}})
@@ -156,10 +191,11 @@ func (h *AccountHandler) List(c *fiber.Ctx) error {
 
 	// Build input
 	input := dtos.ListAccountsInput{
-		UserID:  userID,
-		Context: context,
-		Page:    page,
-		Limit:   limit,
+		UserID:          userID,
+		Context:         context,
+		Page:            page,
+		Limit:           limit,
+		IncludeArchived: c.QueryBool("include_archived", false),
 	}
 
 	// Execute use case
@@ -238,6 +274,567 @@ func (h *AccountHandler) Get(c *fiber.Ctx) error {
 	})
 }
 
+// Update handles account rename requests.
+// @Summary Rename account
+// @Description Renames an account of the authenticated user.
+//
+// **Regras**:
+// - Contas arquivadas devem ser reativadas antes de serem renomeadas
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param request body dtos.UpdateAccountInput true "New account name" example({"name":"Conta Salário"})
+// @Success 200 {object} map[string]interface{} "Account updated successfully" example({"message":"Account updated successfully","data":{"account_id":"550e8400-e29b-41d4-a716-446655440000","name":"Conta Salário","type":"BANK","balance":1000.00,"currency":"BRL","context":"PERSONAL","is_active":true}})
+// @Success 200 {object} dtos.AccountOutput "Account data with all fields"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid account ID or name" example({"error":"Invalid account name","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - account is archived" example({"error":"cannot update name for inactive account","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id} [put]
+func (h *AccountHandler) Update(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.UpdateAccountInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set account and user IDs from path and context (override any IDs in request body for security)
+	input.AccountID = c.Params("id")
+	input.UserID = userID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.updateAccountUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Account updated successfully",
+		"data":    output,
+	})
+}
+
+// Archive handles account archiving requests.
+// @Summary Archive account
+// @Description Archives an account of the authenticated user.
+//
+// **Contas Arquivadas**:
+// - Não aceitam novas transações
+// - São ocultadas da listagem de contas (use `include_archived=true` para vê-las)
+// - Mantêm o saldo e o histórico de transações, que continua aparecendo nos relatórios
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Success 200 {object} map[string]interface{} "Account archived successfully" example({"message":"Account archived successfully","data":{"account_id":"550e8400-e29b-41d4-a716-446655440000","name":"Conta Corrente","is_active":false}})
+// @Success 200 {object} dtos.AccountOutput "Account data with all fields"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid account ID" example({"error":"invalid account ID","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - account is already archived" example({"error":"cannot archive account: account is already inactive","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id}/archive [post]
+func (h *AccountHandler) Archive(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.ArchiveAccountInput{
+		AccountID: c.Params("id"),
+		UserID:    userID,
+	}
+
+	// Execute use case
+	output, err := h.archiveAccountUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Account archived successfully",
+		"data":    output,
+	})
+}
+
+// Reactivate handles archived account reactivation requests.
+// @Summary Reactivate account
+// @Description Reactivates an archived account of the authenticated user, so it accepts transactions and shows in listings again.
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Success 200 {object} map[string]interface{} "Account reactivated successfully" example({"message":"Account reactivated successfully","data":{"account_id":"550e8400-e29b-41d4-a716-446655440000","name":"Conta Corrente","is_active":true}})
+// @Success 200 {object} dtos.AccountOutput "Account data with all fields"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid account ID" example({"error":"invalid account ID","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - account is already active" example({"error":"cannot reactivate account: account is already active","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id}/reactivate [post]
+func (h *AccountHandler) Reactivate(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.ReactivateAccountInput{
+		AccountID: c.Params("id"),
+		UserID:    userID,
+	}
+
+	// Execute use case
+	output, err := h.reactivateAccountUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Account reactivated successfully",
+		"data":    output,
+	})
+}
+
+// Delete handles account closing requests.
+// @Summary Close account
+// @Description Closes (soft deletes) an account of the authenticated user. Its transactions are kept, so the account history stays visible in reports.
+//
+// **Saldo Remanescente**:
+// Uma conta com saldo diferente de zero só pode ser encerrada informando no corpo da requisição:
+// - `transfer_to_account_id`: transfere o saldo para outra conta ativa do usuário, na mesma moeda
+// - `write_off: true`: baixa o saldo com um ajuste de saldo (ADJUSTMENT_OUT, ou ADJUSTMENT_IN se o saldo for negativo), fora dos relatórios
+//
+// Contas arquivadas com saldo devem ser reativadas antes de serem encerradas.
+// O corpo é opcional para contas com saldo zero.
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param request body dtos.DeleteAccountInput false "How to settle the remaining balance" example({"transfer_to_account_id":"660e8400-e29b-41d4-a716-446655440000","date":"2026-10-17"})
+// @Success 200 {object} map[string]interface{} "Account closed successfully" example({"message":"Account closed successfully","data":{"message":"Account closed successfully","account_id":"550e8400-e29b-41d4-a716-446655440000","closing_transaction_ids":["770e8400-e29b-41d4-a716-446655440000","880e8400-e29b-41d4-a716-446655440000"],"transferred_to_account_id":"660e8400-e29b-41d4-a716-446655440000","closing_balance":150.00,"currency":"BRL"}})
+// @Success 200 {object} dtos.DeleteAccountOutput "Closing result"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid account ID, transfer account or date" example({"error":"invalid account closing: give either a transfer account or a write-off, not both","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account or transfer account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - remaining balance not settled" example({"error":"cannot close account with a non-zero balance (150.00 BRL): transfer the balance to another account or write it off","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id} [delete]
+func (h *AccountHandler) Delete(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse optional request body
+	var input dtos.DeleteAccountInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+	}
+
+	// Set account and user IDs from path and context (override any IDs in request body for security)
+	input.AccountID = c.Params("id")
+	input.UserID = userID
+	input.RequestID = middleware.GetRequestID(c)
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.deleteAccountUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Account closed successfully",
+		"data":    output,
+	})
+}
+
+// UpdateCreditCard handles credit card terms update requests.
+// @Summary Update credit card terms
+// @Description Sets the credit limit, closing day and due day of a credit card account of the authenticated user.
+//
+// **Ciclo da Fatura**:
+// - Compras feitas até o dia de fechamento (inclusive) entram na fatura que fecha naquele mês
+// - O vencimento é no mesmo mês do fechamento se `due_day` for maior que `closing_day`, senão no mês seguinte
+// - Em meses mais curtos, dias 29 a 31 correspondem ao último dia do mês
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param request body dtos.UpdateCreditCardTermsInput true "Credit card terms" example({"credit_limit":5000.00,"closing_day":25,"due_day":5})
+// @Success 200 {object} map[string]interface{} "Credit card updated successfully" example({"message":"Credit card updated successfully","data":{"account_id":"550e8400-e29b-41d4-a716-446655440000","name":"Cartão Visa","type":"CREDIT_CARD","balance":-1150.00,"currency":"BRL","credit_card":{"credit_limit":5000.00,"closing_day":25,"due_day":5,"available_credit":3850.00}}})
+// @Success 200 {object} dtos.AccountOutput "Account data with all fields"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid terms or not a credit card" example({"error":"invalid credit card terms: only credit card accounts have a credit limit, closing day and due day","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id}/credit-card [put]
+func (h *AccountHandler) UpdateCreditCard(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.UpdateCreditCardTermsInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set account and user IDs from path and context (override any IDs in request body for security)
+	input.AccountID = c.Params("id")
+	input.UserID = userID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.updateCreditCardUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Credit card updated successfully",
+		"data":    output,
+	})
+}
+
+// UpdateBalanceLimits handles balance limits update requests.
+// @Summary Update balance limits
+// @Description Sets the overdraft limit of an account of the authenticated user and whether debits beyond its balance floor are rejected or only warned about.
+//
+// **Limite de Saldo por Tipo de Conta**:
+// - `BANK`: o saldo pode ficar negativo até o limite do cheque especial (`overdraft_limit`)
+// - `CREDIT_CARD`: o saldo pode ficar negativo até o limite de crédito do cartão
+// - `WALLET` e `INVESTMENT`: o saldo não pode ficar negativo
+//
+// **Modos**:
+// - `ENFORCE`: débitos além do limite são rejeitados com um DOMAIN_ERROR que traz os detalhes do limite
+// - `WARN`: débitos além do limite são aceitos e uma notificação WARNING é criada
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param request body dtos.UpdateBalanceLimitsInput true "Balance limits" example({"overdraft_limit":1000.00,"mode":"ENFORCE"})
+// @Success 200 {object} map[string]interface{} "Balance limits updated successfully" example({"message":"Balance limits updated successfully","data":{"account_id":"550e8400-e29b-41d4-a716-446655440000","name":"Conta Corrente","type":"BANK","balance":250.00,"currency":"BRL","balance_limits":{"mode":"ENFORCE","overdraft_limit":1000.00,"balance_floor":-1000.00,"limit_kind":"OVERDRAFT"}}})
+// @Success 200 {object} dtos.AccountOutput "Account data with all fields"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid limit or overdraft on an account that is not a bank account" example({"error":"invalid overdraft limit: only bank accounts have an overdraft limit","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id}/balance-limits [put]
+func (h *AccountHandler) UpdateBalanceLimits(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.UpdateBalanceLimitsInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set account and user IDs from path and context (override any IDs in request body for security)
+	input.AccountID = c.Params("id")
+	input.UserID = userID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.updateBalanceLimitsUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Balance limits updated successfully",
+		"data":    output,
+	})
+}
+
+// AdjustBalance handles balance adjustment requests.
+// @Summary Adjust account balance
+// @Description Sets the balance of an account of the authenticated user, e.g. when it drifted from the bank, and records the difference as a balance adjustment transaction.
+//
+// **Ajuste de Saldo**:
+// - O saldo informado substitui o saldo atual; a diferença é registrada como uma transação `ADJUSTMENT_IN` (saldo aumentou) ou `ADJUSTMENT_OUT` (saldo diminuiu)
+// - Ajustes não são receitas nem despesas: ficam fora dos relatórios, das regras e da detecção de duplicatas
+// - O ajuste registra o saldo real, por isso não é limitado pelo cheque especial ou limite de crédito
+// - Assim o saldo da conta é sempre a soma das suas transações, começando pelo ajuste do saldo inicial ("Opening balance")
+// - A transação de ajuste e o saldo são salvos atomicamente
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param request body dtos.AdjustBalanceInput true "New balance" example({"balance":1230.45,"description":"Conferência com o extrato","date":"2026-10-17"})
+// @Success 201 {object} map[string]interface{} "Balance adjusted successfully" example({"message":"Balance adjusted successfully","data":{"account":{"account_id":"550e8400-e29b-41d4-a716-446655440000","name":"Conta Corrente","type":"BANK","balance":1230.45,"currency":"BRL"},"transaction_id":"770e8400-e29b-41d4-a716-446655440000","transaction_type":"ADJUSTMENT_OUT","previous_balance":1250.00,"difference":-19.55,"currency":"BRL"}})
+// @Success 201 {object} dtos.AdjustBalanceOutput "Adjustment result"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid balance or date, or balance unchanged" example({"error":"cannot adjust balance: the account balance is already 1250.00 BRL","error_type":"DOMAIN_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id}/balance-adjustments [post]
+func (h *AccountHandler) AdjustBalance(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.AdjustBalanceInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set account and user IDs from path and context (override any IDs in request body for security)
+	input.AccountID = c.Params("id")
+	input.UserID = userID
+	input.RequestID = middleware.GetRequestID(c)
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.adjustBalanceUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Balance adjusted successfully",
+		"data":    output,
+	})
+}
+
+// ListStatements handles credit card statement listing requests.
+// @Summary List credit card statements
+// @Description Lists the statements (faturas) of a credit card account of the authenticated user, most recent first, with the credit limit and available credit.
+//
+// **Faturas**:
+// - Compras e transferências de saída somam na fatura do ciclo em que foram feitas; receitas (estornos) abatem
+// - Pagamentos (transferências de entrada) não entram em nenhuma fatura
+// - `OPEN`: ainda não fechou; `CLOSED`: fechada com valor em aberto; `PAID`: fechada e quitada
+// - O pagamento mínimo é 15% do total da fatura; `is_overdue` indica fatura vencida sem o pagamento mínimo
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param status query string false "Filter by status" Enums(OPEN, CLOSED, PAID)
+// @Success 200 {object} map[string]interface{} "Statements retrieved successfully" example({"message":"Statements retrieved successfully","data":{"account_id":"550e8400-e29b-41d4-a716-446655440000","currency":"BRL","credit_limit":5000.00,"balance":-1150.00,"available_credit":3850.00,"statements":[{"month":"2026-09","period_start":"2026-08-26","closing_date":"2026-09-25","due_date":"2026-10-05","status":"CLOSED","is_overdue":false,"total":1150.00,"paid_amount":0,"amount_due":1150.00,"minimum_payment":172.50,"currency":"BRL","charge_count":3}],"count":1}})
+// @Success 200 {object} dtos.ListStatementsOutput "Statements of the credit card"
+// @Failure 400 {object} map[string]interface{} "Bad request - not a credit card with terms" example({"error":"invalid account: statements are only available for credit card accounts with a credit limit, closing day and due day","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id}/statements [get]
+func (h *AccountHandler) ListStatements(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.ListStatementsInput{
+		AccountID: c.Params("id"),
+		UserID:    userID,
+		Status:    c.Query("status"),
+	}
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.listStatementsUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Statements retrieved successfully",
+		"data":    output,
+	})
+}
+
+// PayStatement handles credit card statement payment requests.
+// @Summary Pay credit card statement
+// @Description Pays a statement of a credit card account with a transfer from another account of the authenticated user, in the same currency.
+//
+// **Pagamento**:
+// - `month` identifica a fatura pelo mês de fechamento (YYYY-MM)
+// - `amount` é opcional: por padrão paga todo o valor em aberto; um valor menor é um pagamento parcial
+// - A transferência, os saldos e o pagamento da fatura são salvos atomicamente
+//
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Credit card account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param month path string true "Month the statement closes in (YYYY-MM)" example(2026-09)
+// @Param request body dtos.PayStatementInput true "Paying account and amount" example({"from_account_id":"660e8400-e29b-41d4-a716-446655440000","amount":500.00,"date":"2026-10-01"})
+// @Success 200 {object} map[string]interface{} "Statement paid successfully" example({"message":"Statement paid successfully","data":{"statement":{"month":"2026-09","status":"CLOSED","total":1150.00,"paid_amount":500.00,"amount_due":650.00,"minimum_payment":0,"currency":"BRL"},"transaction_ids":["770e8400-e29b-41d4-a716-446655440000","880e8400-e29b-41d4-a716-446655440000"],"paid_amount":500.00,"currency":"BRL"}})
+// @Success 200 {object} dtos.PayStatementOutput "Payment result"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid month, amount or paying account" example({"error":"invalid payment amount: must not exceed the amount due of 650.00 BRL","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account or statement does not exist" example({"error":"statement not found for month 2026-09","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - nothing due or insufficient balance" example({"error":"cannot pay statement: no amount is due","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /accounts/{id}/statements/{month}/pay [post]
+func (h *AccountHandler) PayStatement(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.PayStatementInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set account, month and user IDs from path and context (override any values in request body for security)
+	input.AccountID = c.Params("id")
+	input.Month = c.Params("month")
+	input.UserID = userID
+	input.RequestID = middleware.GetRequestID(c)
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.payStatementUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Statement paid successfully",
+		"data":    output,
+	})
+}
+
 // handleUseCaseError handles errors from use cases and returns appropriate HTTP responses.
 // Uses AppError for consistent error handling instead of string matching.
 func (h *AccountHandler) handleUseCaseError(c *fiber.Ctx, err error) error {
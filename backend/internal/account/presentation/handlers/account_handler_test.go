@@ -13,10 +13,14 @@ import (
 	"gestao-financeira/backend/internal/account/application/dtos"
 	"gestao-financeira/backend/internal/account/application/usecases"
 	"gestao-financeira/backend/internal/account/domain/entities"
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
 	"gestao-financeira/backend/internal/account/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
 )
 
 // mockAccountRepositoryForHandler is a mock implementation of AccountRepository for handler testing.
@@ -59,6 +63,10 @@ func (m *mockAccountRepositoryForHandler) FindByUserIDAndContext(userID identity
 	return result, nil
 }
 
+func (m *mockAccountRepositoryForHandler) FindByType(accountType valueobjects.AccountType) ([]*entities.Account, error) {
+	return []*entities.Account{}, nil
+}
+
 func (m *mockAccountRepositoryForHandler) Save(account *entities.Account) error {
 	if m.saveErr != nil {
 		return m.saveErr
@@ -106,16 +114,69 @@ func (m *mockAccountRepositoryForHandler) FindByUserIDWithPagination(userID iden
 	return filtered[start:end], total, nil
 }
 
+// mockUnitOfWorkForHandler is a mock implementation of UnitOfWork for handler testing.
+// It only provides the repositories used to create an account.
+type mockUnitOfWorkForHandler struct {
+	sharedrepositories.UnitOfWork
+	accountRepository *mockAccountRepositoryForHandler
+}
+
+func (m *mockUnitOfWorkForHandler) Begin() error {
+	return nil
+}
+
+func (m *mockUnitOfWorkForHandler) Commit() error {
+	return nil
+}
+
+func (m *mockUnitOfWorkForHandler) IsInTransaction() bool {
+	return false
+}
+
+func (m *mockUnitOfWorkForHandler) AccountRepository() accountrepositories.AccountRepository {
+	return m.accountRepository
+}
+
+func (m *mockUnitOfWorkForHandler) TransactionRepository() transactionrepositories.TransactionRepository {
+	return &mockTransactionRepositoryForHandler{}
+}
+
+func (m *mockUnitOfWorkForHandler) TransactionRevisionRepository() transactionrepositories.TransactionRevisionRepository {
+	return &mockTransactionRevisionRepositoryForHandler{}
+}
+
+// mockTransactionRepositoryForHandler discards the opening balance transactions of the created accounts.
+type mockTransactionRepositoryForHandler struct {
+	transactionrepositories.TransactionRepository
+}
+
+func (m *mockTransactionRepositoryForHandler) Save(transaction *transactionentities.Transaction) error {
+	return nil
+}
+
+// mockTransactionRevisionRepositoryForHandler discards the edit history of the saved transactions.
+type mockTransactionRevisionRepositoryForHandler struct {
+	transactionrepositories.TransactionRevisionRepository
+}
+
+func (m *mockTransactionRevisionRepositoryForHandler) Save(revision *transactionentities.TransactionRevision) error {
+	return nil
+}
+
 func TestAccountHandler_Create(t *testing.T) {
 	userID := "550e8400-e29b-41d4-a716-446655440000"
 
 	app := fiber.New()
 	mockRepo := newMockAccountRepositoryForHandler()
 	eventBus := eventbus.NewEventBus()
-	createUseCase := usecases.NewCreateAccountUseCase(mockRepo, eventBus)
+	createUseCase := usecases.NewCreateAccountUseCase(&mockUnitOfWorkForHandler{accountRepository: mockRepo}, eventBus)
 	listUseCase := usecases.NewListAccountsUseCase(mockRepo)
 	getUseCase := usecases.NewGetAccountUseCase(mockRepo)
-	handler := NewAccountHandler(createUseCase, listUseCase, getUseCase)
+	handler := NewAccountHandler(AccountHandlerDeps{
+		CreateAccountUseCase: createUseCase,
+		ListAccountsUseCase:  listUseCase,
+		GetAccountUseCase:    getUseCase,
+	})
 
 	app.Post("/accounts", func(c *fiber.Ctx) error {
 		c.Locals("userID", userID)
@@ -202,9 +263,13 @@ func TestAccountHandler_List(t *testing.T) {
 	app := fiber.New()
 	mockRepo := newMockAccountRepositoryForHandler()
 	listUseCase := usecases.NewListAccountsUseCase(mockRepo)
-	createUseCase := usecases.NewCreateAccountUseCase(mockRepo, eventbus.NewEventBus())
+	createUseCase := usecases.NewCreateAccountUseCase(&mockUnitOfWorkForHandler{accountRepository: mockRepo}, eventbus.NewEventBus())
 	getUseCase := usecases.NewGetAccountUseCase(mockRepo)
-	handler := NewAccountHandler(createUseCase, listUseCase, getUseCase)
+	handler := NewAccountHandler(AccountHandlerDeps{
+		CreateAccountUseCase: createUseCase,
+		ListAccountsUseCase:  listUseCase,
+		GetAccountUseCase:    getUseCase,
+	})
 
 	app.Get("/accounts", func(c *fiber.Ctx) error {
 		c.Locals("userID", userID)
@@ -270,9 +335,13 @@ func TestAccountHandler_Get(t *testing.T) {
 	app := fiber.New()
 	mockRepo := newMockAccountRepositoryForHandler()
 	getUseCase := usecases.NewGetAccountUseCase(mockRepo)
-	createUseCase := usecases.NewCreateAccountUseCase(mockRepo, eventbus.NewEventBus())
+	createUseCase := usecases.NewCreateAccountUseCase(&mockUnitOfWorkForHandler{accountRepository: mockRepo}, eventbus.NewEventBus())
 	listUseCase := usecases.NewListAccountsUseCase(mockRepo)
-	handler := NewAccountHandler(createUseCase, listUseCase, getUseCase)
+	handler := NewAccountHandler(AccountHandlerDeps{
+		CreateAccountUseCase: createUseCase,
+		ListAccountsUseCase:  listUseCase,
+		GetAccountUseCase:    getUseCase,
+	})
 
 	app.Get("/accounts/:id", func(c *fiber.Ctx) error {
 		c.Locals("userID", userID)
@@ -25,5 +25,14 @@ func SetupAccountRoutes(router fiber.Router, accountHandler *handlers.AccountHan
 		accounts.Post("/", accountHandler.Create)
 		accounts.Get("/", accountHandler.List)
 		accounts.Get("/:id", accountHandler.Get)
+		accounts.Put("/:id", accountHandler.Update)
+		accounts.Delete("/:id", accountHandler.Delete)
+		accounts.Post("/:id/archive", accountHandler.Archive)
+		accounts.Post("/:id/reactivate", accountHandler.Reactivate)
+		accounts.Put("/:id/credit-card", accountHandler.UpdateCreditCard)
+		accounts.Put("/:id/balance-limits", accountHandler.UpdateBalanceLimits)
+		accounts.Post("/:id/balance-adjustments", accountHandler.AdjustBalance)
+		accounts.Get("/:id/statements", accountHandler.ListStatements)
+		accounts.Post("/:id/statements/:month/pay", accountHandler.PayStatement)
 	}
 }
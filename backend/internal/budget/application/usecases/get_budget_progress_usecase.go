@@ -67,9 +67,7 @@ func (uc *GetBudgetProgressUseCase) Execute(input dtos.GetBudgetProgressInput) (
 		return nil, fmt.Errorf("failed to find transactions: %w", err)
 	}
 
-	// Filter transactions by date range and type
-	// Note: Currently, transactions don't have category_id, so we calculate based on all EXPENSE transactions
-	// TODO: Add category_id to transactions to properly filter by category
+	// Filter transactions by category, date range and type
 	budgetAmount := budget.Amount()
 	currency := budgetAmount.Currency()
 	spentCents := int64(0)
@@ -80,6 +78,13 @@ func (uc *GetBudgetProgressUseCase) Execute(input dtos.GetBudgetProgressInput) (
 			continue
 		}
 
+		// Only count the part of the transaction assigned to the budget category
+		// (the whole amount, or the matching split lines of a split transaction)
+		categoryAmount := transaction.AmountForCategory(budget.CategoryID())
+		if categoryAmount.IsZero() {
+			continue
+		}
+
 		// Only count transactions within the period
 		transactionDate := transaction.Date()
 		if !period.Includes(transactionDate) {
@@ -91,7 +96,7 @@ func (uc *GetBudgetProgressUseCase) Execute(input dtos.GetBudgetProgressInput) (
 			continue
 		}
 
-		spentCents += transaction.Amount().Amount()
+		spentCents += categoryAmount.Amount()
 	}
 
 	spent, err := sharedvalueobjects.NewMoney(spentCents, currency)
@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	"gestao-financeira/backend/internal/budget/application/dtos"
+	"gestao-financeira/backend/internal/budget/domain/entities"
+	"gestao-financeira/backend/internal/budget/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	transactionentities "gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockProgressTransactionRepository is a mock TransactionRepository that only supports FindByUserID.
+type mockProgressTransactionRepository struct {
+	transactionrepositories.TransactionRepository
+	transactions []*transactionentities.Transaction
+}
+
+func (m *mockProgressTransactionRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*transactionentities.Transaction, error) {
+	var result []*transactionentities.Transaction
+	for _, transaction := range m.transactions {
+		if transaction.UserID().Equals(userID) {
+			result = append(result, transaction)
+		}
+	}
+	return result, nil
+}
+
+func newProgressTestTransaction(t *testing.T, userID identityvalueobjects.UserID, txType string, cents int64, date time.Time, categoryID *categoryvalueobjects.CategoryID) *transactionentities.Transaction {
+	amount, _ := sharedvalueobjects.NewMoney(cents, sharedvalueobjects.MustCurrency("BRL"))
+	transaction, err := transactionentities.NewTransactionWithParams(transactionentities.TransactionParams{
+		UserID:      userID,
+		AccountID:   accountvalueobjects.GenerateAccountID(),
+		Type:        transactionvalueobjects.MustTransactionType(txType),
+		Amount:      amount,
+		Description: transactionvalueobjects.MustTransactionDescription("Transação de teste"),
+		Date:        date,
+		CategoryID:  categoryID,
+	})
+	if err != nil {
+		t.Fatalf("failed to create transaction: %v", err)
+	}
+	return transaction
+}
+
+func TestGetBudgetProgressUseCase_Execute_FiltersByCategory(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	categoryID := categoryvalueobjects.GenerateCategoryID()
+	otherCategoryID := categoryvalueobjects.GenerateCategoryID()
+
+	period, _ := valueobjects.NewMonthlyBudgetPeriod(2025, 3)
+	budgetAmount, _ := sharedvalueobjects.NewMoney(100000, sharedvalueobjects.MustCurrency("BRL")) // 1000.00
+	budget, err := entities.NewBudget(userID, categoryID, budgetAmount, period, sharedvalueobjects.PersonalContext())
+	if err != nil {
+		t.Fatalf("failed to create budget: %v", err)
+	}
+
+	budgetRepo := newMockBudgetRepository()
+	_ = budgetRepo.Save(budget)
+
+	inPeriod := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	transactionRepo := &mockProgressTransactionRepository{
+		transactions: []*transactionentities.Transaction{
+			newProgressTestTransaction(t, userID, "EXPENSE", 25000, inPeriod, &categoryID),                                    // counted
+			newProgressTestTransaction(t, userID, "EXPENSE", 15000, inPeriod, &categoryID),                                    // counted
+			newProgressTestTransaction(t, userID, "EXPENSE", 70000, inPeriod, &otherCategoryID),                               // other category
+			newProgressTestTransaction(t, userID, "EXPENSE", 9000, inPeriod, nil),                                             // uncategorized
+			newProgressTestTransaction(t, userID, "INCOME", 50000, inPeriod, &categoryID),                                     // income
+			newProgressTestTransaction(t, userID, "EXPENSE", 30000, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), &categoryID), // outside period
+		},
+	}
+
+	useCase := NewGetBudgetProgressUseCase(budgetRepo, transactionRepo)
+	output, err := useCase.Execute(dtos.GetBudgetProgressInput{
+		BudgetID: budget.ID().Value(),
+		UserID:   userID.Value(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Spent != 400.00 {
+		t.Errorf("Execute() output.Spent = %v, want 400.00", output.Spent)
+	}
+	if output.Remaining != 600.00 {
+		t.Errorf("Execute() output.Remaining = %v, want 600.00", output.Remaining)
+	}
+	if output.PercentageUsed != 40.0 {
+		t.Errorf("Execute() output.PercentageUsed = %v, want 40", output.PercentageUsed)
+	}
+	if output.IsExceeded {
+		t.Error("Execute() output.IsExceeded = true, want false")
+	}
+}
+
+func TestGetBudgetProgressUseCase_Execute_CountsSplitLines(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	categoryID := categoryvalueobjects.GenerateCategoryID()
+	otherCategoryID := categoryvalueobjects.GenerateCategoryID()
+
+	period, _ := valueobjects.NewMonthlyBudgetPeriod(2025, 3)
+	budgetAmount, _ := sharedvalueobjects.NewMoney(100000, sharedvalueobjects.MustCurrency("BRL")) // 1000.00
+	budget, err := entities.NewBudget(userID, categoryID, budgetAmount, period, sharedvalueobjects.PersonalContext())
+	if err != nil {
+		t.Fatalf("failed to create budget: %v", err)
+	}
+
+	budgetRepo := newMockBudgetRepository()
+	_ = budgetRepo.Save(budget)
+
+	// 300.00 split into 120.00 for the budget category and 180.00 for another one
+	inPeriod := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	split := newProgressTestTransaction(t, userID, "EXPENSE", 30000, inPeriod, nil)
+	budgetPart, _ := sharedvalueobjects.NewMoney(12000, sharedvalueobjects.MustCurrency("BRL"))
+	otherPart, _ := sharedvalueobjects.NewMoney(18000, sharedvalueobjects.MustCurrency("BRL"))
+	budgetLine, _ := transactionvalueobjects.NewTransactionSplit(categoryID, budgetPart, "")
+	otherLine, _ := transactionvalueobjects.NewTransactionSplit(otherCategoryID, otherPart, "")
+	if err := split.UpdateSplits([]transactionvalueobjects.TransactionSplit{budgetLine, otherLine}); err != nil {
+		t.Fatalf("failed to split transaction: %v", err)
+	}
+
+	transactionRepo := &mockProgressTransactionRepository{
+		transactions: []*transactionentities.Transaction{
+			split,
+			newProgressTestTransaction(t, userID, "EXPENSE", 5000, inPeriod, &categoryID),
+		},
+	}
+
+	useCase := NewGetBudgetProgressUseCase(budgetRepo, transactionRepo)
+	output, err := useCase.Execute(dtos.GetBudgetProgressInput{
+		BudgetID: budget.ID().Value(),
+		UserID:   userID.Value(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Spent != 170.00 {
+		t.Errorf("Execute() output.Spent = %v, want 170.00", output.Spent)
+	}
+}
@@ -161,6 +161,10 @@ func (m *mockAccountRepository) FindByUserIDAndContext(userID identityvalueobjec
 	return result, nil
 }
 
+func (m *mockAccountRepository) FindByType(accountType accountvalueobjects.AccountType) ([]*accountentities.Account, error) {
+	return []*accountentities.Account{}, nil
+}
+
 func (m *mockAccountRepository) Save(account *accountentities.Account) error {
 	m.accounts[account.ID().Value()] = account
 	return nil
@@ -0,0 +1,10 @@
+package dtos
+
+// CreatePayeeInput represents the input for creating a new payee.
+// Aliases are patterns that identify the payee in bank descriptions besides its name.
+type CreatePayeeInput struct {
+	UserID            string
+	Name              string   `json:"name" validate:"required,max=100,no_sql_injection,no_xss,utf8"`
+	Aliases           []string `json:"aliases,omitempty" validate:"omitempty,max=20,dive,required,max=100,no_sql_injection,no_xss,utf8"`
+	DefaultCategoryID string   `json:"default_category_id,omitempty" validate:"omitempty,uuid"`
+}
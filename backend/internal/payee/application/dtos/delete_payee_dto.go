@@ -0,0 +1,13 @@
+package dtos
+
+// DeletePayeeInput represents the input for deleting a payee.
+type DeletePayeeInput struct {
+	UserID  string
+	PayeeID string
+}
+
+// DeletePayeeOutput represents the output after deleting a payee.
+type DeletePayeeOutput struct {
+	Message string `json:"message"`
+	PayeeID string `json:"payee_id"`
+}
@@ -0,0 +1,22 @@
+package dtos
+
+// ListPayeesInput represents the input for listing payees.
+type ListPayeesInput struct {
+	UserID string
+}
+
+// PayeeOutput represents a payee.
+type PayeeOutput struct {
+	PayeeID           string   `json:"payee_id"`
+	Name              string   `json:"name"`
+	Aliases           []string `json:"aliases"` // Normalized aliases
+	DefaultCategoryID string   `json:"default_category_id,omitempty"`
+	CreatedAt         string   `json:"created_at"`
+	UpdatedAt         string   `json:"updated_at"`
+}
+
+// ListPayeesOutput represents the output for listing payees.
+type ListPayeesOutput struct {
+	Payees []PayeeOutput `json:"payees"`
+	Count  int           `json:"count"`
+}
@@ -0,0 +1,14 @@
+package dtos
+
+// MatchPayeeInput represents the input for finding the payee of a bank description.
+type MatchPayeeInput struct {
+	UserID      string
+	Description string `json:"description" validate:"required,max=500,utf8"`
+}
+
+// MatchPayeeOutput represents the payee matched to a bank description.
+type MatchPayeeOutput struct {
+	Description           string       `json:"description"`
+	NormalizedDescription string       `json:"normalized_description"` // Merchant words the payees are matched against
+	Payee                 *PayeeOutput `json:"payee"`                  // Nil if no payee matches
+}
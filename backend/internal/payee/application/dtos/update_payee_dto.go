@@ -0,0 +1,13 @@
+package dtos
+
+// UpdatePayeeInput represents the input for updating a payee.
+// All fields are optional - only provided fields will be updated.
+// Aliases replaces all aliases of the payee (an empty list removes them) and an empty
+// DefaultCategoryID removes the default category.
+type UpdatePayeeInput struct {
+	UserID            string
+	PayeeID           string
+	Name              *string   `json:"name,omitempty" validate:"omitempty,max=100,no_sql_injection,no_xss,utf8"`
+	Aliases           *[]string `json:"aliases,omitempty" validate:"omitempty,max=20,dive,required,max=100,no_sql_injection,no_xss,utf8"`
+	DefaultCategoryID *string   `json:"default_category_id,omitempty" validate:"omitempty,len=0|uuid"`
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/repositories"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// CreatePayeeUseCase handles payee creation.
+type CreatePayeeUseCase struct {
+	payeeRepository    repositories.PayeeRepository
+	categoryRepository categoryrepositories.CategoryRepository
+	eventBus           *eventbus.EventBus
+}
+
+// NewCreatePayeeUseCase creates a new CreatePayeeUseCase instance.
+func NewCreatePayeeUseCase(
+	payeeRepository repositories.PayeeRepository,
+	categoryRepository categoryrepositories.CategoryRepository,
+	eventBus *eventbus.EventBus,
+) *CreatePayeeUseCase {
+	return &CreatePayeeUseCase{
+		payeeRepository:    payeeRepository,
+		categoryRepository: categoryRepository,
+		eventBus:           eventBus,
+	}
+}
+
+// Execute performs the payee creation.
+// Payee names are unique per user (case-insensitive).
+func (uc *CreatePayeeUseCase) Execute(input dtos.CreatePayeeInput) (*dtos.PayeeOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Create payee name and alias value objects
+	payeeName, err := valueobjects.NewPayeeName(input.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payee name: %w", err)
+	}
+	aliases, err := newPayeeAliases(input.Aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate default category ownership (if provided)
+	defaultCategoryID, err := findUserDefaultCategoryID(uc.categoryRepository, userID, input.DefaultCategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if a payee with the same name already exists for this user
+	existingPayee, err := uc.payeeRepository.FindByUserIDAndName(userID, payeeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if payee exists: %w", err)
+	}
+	if existingPayee != nil {
+		return nil, errors.New("payee with this name already exists")
+	}
+
+	// Create payee entity
+	payee, err := entities.NewPayee(userID, payeeName, aliases, defaultCategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payee: %w", err)
+	}
+
+	// Save payee to repository
+	if err := uc.payeeRepository.Save(payee); err != nil {
+		return nil, fmt.Errorf("failed to save payee: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range payee.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	payee.ClearEvents()
+
+	output := payeeOutput(payee)
+	return &output, nil
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/domain/repositories"
+)
+
+// DeletePayeeUseCase handles payee deletion.
+type DeletePayeeUseCase struct {
+	payeeRepository repositories.PayeeRepository
+}
+
+// NewDeletePayeeUseCase creates a new DeletePayeeUseCase instance.
+func NewDeletePayeeUseCase(payeeRepository repositories.PayeeRepository) *DeletePayeeUseCase {
+	return &DeletePayeeUseCase{
+		payeeRepository: payeeRepository,
+	}
+}
+
+// Execute deletes the payee and detaches it from all transactions.
+// The transactions themselves are not affected.
+func (uc *DeletePayeeUseCase) Execute(input dtos.DeletePayeeInput) (*dtos.DeletePayeeOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	payee, err := findUserPayee(uc.payeeRepository, userID, input.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.payeeRepository.Delete(payee.ID()); err != nil {
+		return nil, fmt.Errorf("failed to delete payee: %w", err)
+	}
+
+	return &dtos.DeletePayeeOutput{
+		Message: "Payee deleted successfully",
+		PayeeID: payee.ID().Value(),
+	}, nil
+}
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/repositories"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// findUserPayee loads a payee and checks that it belongs to the user.
+func findUserPayee(
+	payeeRepository repositories.PayeeRepository,
+	userID identityvalueobjects.UserID,
+	rawPayeeID string,
+) (*entities.Payee, error) {
+	payeeID, err := valueobjects.NewPayeeID(rawPayeeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payee ID: %w", err)
+	}
+
+	payee, err := payeeRepository.FindByID(payeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payee: %w", err)
+	}
+	if payee == nil {
+		return nil, errors.New("payee not found")
+	}
+	if !payee.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("payee does not belong to user")
+	}
+
+	return payee, nil
+}
+
+// findUserDefaultCategoryID validates that the default category of a payee exists and belongs
+// to the user. Returns nil if no category was provided.
+func findUserDefaultCategoryID(
+	categoryRepository categoryrepositories.CategoryRepository,
+	userID identityvalueobjects.UserID,
+	rawCategoryID string,
+) (*categoryvalueobjects.CategoryID, error) {
+	if rawCategoryID == "" {
+		return nil, nil
+	}
+
+	categoryID, err := categoryvalueobjects.NewCategoryID(rawCategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default category ID: %w", err)
+	}
+
+	category, err := categoryRepository.FindByID(categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category: %w", err)
+	}
+	if category == nil {
+		return nil, errors.New("category not found")
+	}
+	if !category.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("category does not belong to user")
+	}
+
+	return &categoryID, nil
+}
+
+// newPayeeAliases validates and normalizes raw aliases.
+func newPayeeAliases(rawAliases []string) ([]valueobjects.PayeeAlias, error) {
+	aliases := make([]valueobjects.PayeeAlias, 0, len(rawAliases))
+	for _, rawAlias := range rawAliases {
+		alias, err := valueobjects.NewPayeeAlias(rawAlias)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payee alias: %w", err)
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases, nil
+}
+
+// payeeOutput converts a payee to its output DTO.
+func payeeOutput(payee *entities.Payee) dtos.PayeeOutput {
+	output := dtos.PayeeOutput{
+		PayeeID:   payee.ID().Value(),
+		Name:      payee.Name().Value(),
+		Aliases:   make([]string, 0, len(payee.Aliases())),
+		CreatedAt: payee.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: payee.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for _, alias := range payee.Aliases() {
+		output.Aliases = append(output.Aliases, alias.Value())
+	}
+	if payee.DefaultCategoryID() != nil {
+		output.DefaultCategoryID = payee.DefaultCategoryID().Value()
+	}
+	return output
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/domain/repositories"
+)
+
+// ListPayeesUseCase handles listing the payees of a user.
+type ListPayeesUseCase struct {
+	payeeRepository repositories.PayeeRepository
+}
+
+// NewListPayeesUseCase creates a new ListPayeesUseCase instance.
+func NewListPayeesUseCase(payeeRepository repositories.PayeeRepository) *ListPayeesUseCase {
+	return &ListPayeesUseCase{
+		payeeRepository: payeeRepository,
+	}
+}
+
+// Execute returns all payees of the user ordered by name.
+func (uc *ListPayeesUseCase) Execute(input dtos.ListPayeesInput) (*dtos.ListPayeesOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	payees, err := uc.payeeRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payees: %w", err)
+	}
+
+	outputs := make([]dtos.PayeeOutput, 0, len(payees))
+	for _, payee := range payees {
+		outputs = append(outputs, payeeOutput(payee))
+	}
+
+	return &dtos.ListPayeesOutput{
+		Payees: outputs,
+		Count:  len(outputs),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/domain/repositories"
+	"gestao-financeira/backend/internal/payee/domain/services"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+)
+
+// MatchPayeeUseCase finds the payee a bank description would be assigned to, the same way
+// new and imported transactions are matched. It lets users check their aliases.
+type MatchPayeeUseCase struct {
+	payeeRepository repositories.PayeeRepository
+}
+
+// NewMatchPayeeUseCase creates a new MatchPayeeUseCase instance.
+func NewMatchPayeeUseCase(payeeRepository repositories.PayeeRepository) *MatchPayeeUseCase {
+	return &MatchPayeeUseCase{
+		payeeRepository: payeeRepository,
+	}
+}
+
+// Execute normalizes the description and matches it against the payees of the user.
+func (uc *MatchPayeeUseCase) Execute(input dtos.MatchPayeeInput) (*dtos.MatchPayeeOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	payees, err := uc.payeeRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payees: %w", err)
+	}
+
+	output := &dtos.MatchPayeeOutput{
+		Description:           input.Description,
+		NormalizedDescription: valueobjects.NormalizeMerchantDescription(input.Description),
+	}
+	if payee := services.NewPayeeMatcher(payees).Match(input.Description); payee != nil {
+		matched := payeeOutput(payee)
+		output.Payee = &matched
+	}
+
+	return output, nil
+}
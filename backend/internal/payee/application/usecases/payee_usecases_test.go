@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+
+	categoryentities "gestao-financeira/backend/internal/category/domain/entities"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// mockPayeeRepository is a mock implementation of PayeeRepository for testing.
+type mockPayeeRepository struct {
+	payees map[string]*entities.Payee
+}
+
+func newMockPayeeRepository() *mockPayeeRepository {
+	return &mockPayeeRepository{
+		payees: make(map[string]*entities.Payee),
+	}
+}
+
+func (m *mockPayeeRepository) FindByID(id valueobjects.PayeeID) (*entities.Payee, error) {
+	return m.payees[id.Value()], nil
+}
+
+func (m *mockPayeeRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Payee, error) {
+	var result []*entities.Payee
+	for _, payee := range m.payees {
+		if payee.UserID().Equals(userID) {
+			result = append(result, payee)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockPayeeRepository) FindByUserIDAndName(userID identityvalueobjects.UserID, name valueobjects.PayeeName) (*entities.Payee, error) {
+	for _, payee := range m.payees {
+		if payee.UserID().Equals(userID) && payee.Name().Equals(name) {
+			return payee, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockPayeeRepository) Save(payee *entities.Payee) error {
+	m.payees[payee.ID().Value()] = payee
+	return nil
+}
+
+func (m *mockPayeeRepository) Delete(id valueobjects.PayeeID) error {
+	delete(m.payees, id.Value())
+	return nil
+}
+
+// mockCategoryRepository is a mock CategoryRepository that only finds categories by ID.
+type mockCategoryRepository struct {
+	categoryrepositories.CategoryRepository
+	categories map[string]*categoryentities.Category
+}
+
+func (m *mockCategoryRepository) FindByID(id categoryvalueobjects.CategoryID) (*categoryentities.Category, error) {
+	return m.categories[id.Value()], nil
+}
+
+// createTestCategory creates a category in the mock repository.
+func createTestCategory(m *mockCategoryRepository, userID identityvalueobjects.UserID, name string) *categoryentities.Category {
+	category, err := categoryentities.NewCategory(userID, categoryvalueobjects.MustCategoryName(name), "")
+	if err != nil {
+		panic(err)
+	}
+	m.categories[category.ID().Value()] = category
+	return category
+}
+
+func TestCreatePayeeUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	payeeRepo := newMockPayeeRepository()
+	categoryRepo := &mockCategoryRepository{categories: make(map[string]*categoryentities.Category)}
+	delivery := createTestCategory(categoryRepo, userID, "Delivery")
+	foreign := createTestCategory(categoryRepo, identityvalueobjects.GenerateUserID(), "Outros")
+
+	useCase := NewCreatePayeeUseCase(payeeRepo, categoryRepo, eventbus.NewEventBus())
+
+	output, err := useCase.Execute(dtos.CreatePayeeInput{
+		UserID:            userID.Value(),
+		Name:              "iFood",
+		Aliases:           []string{"IFD*", "ifd"},
+		DefaultCategoryID: delivery.ID().Value(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.Aliases) != 1 || output.Aliases[0] != "ifd" {
+		t.Errorf("Execute() aliases = %v, want [ifd]", output.Aliases)
+	}
+	if output.DefaultCategoryID != delivery.ID().Value() {
+		t.Errorf("Execute() default category = %s, want %s", output.DefaultCategoryID, delivery.ID().Value())
+	}
+
+	tests := []struct {
+		name     string
+		input    dtos.CreatePayeeInput
+		errorMsg string
+	}{
+		{"duplicate name", dtos.CreatePayeeInput{UserID: userID.Value(), Name: "IFOOD"}, "already exists"},
+		{"alias without merchant words", dtos.CreatePayeeInput{UserID: userID.Value(), Name: "Uber", Aliases: []string{"1234"}}, "invalid payee alias"},
+		{"category of another user", dtos.CreatePayeeInput{UserID: userID.Value(), Name: "Uber", DefaultCategoryID: foreign.ID().Value()}, "does not belong to user"},
+		{"unknown category", dtos.CreatePayeeInput{UserID: userID.Value(), Name: "Uber", DefaultCategoryID: categoryvalueobjects.GenerateCategoryID().Value()}, "category not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := useCase.Execute(tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.errorMsg) {
+				t.Errorf("Execute() error = %v, want error containing %q", err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestUpdatePayeeUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	payeeRepo := newMockPayeeRepository()
+	categoryRepo := &mockCategoryRepository{categories: make(map[string]*categoryentities.Category)}
+	delivery := createTestCategory(categoryRepo, userID, "Delivery")
+
+	createUseCase := NewCreatePayeeUseCase(payeeRepo, categoryRepo, eventbus.NewEventBus())
+	created, _ := createUseCase.Execute(dtos.CreatePayeeInput{UserID: userID.Value(), Name: "iFood", DefaultCategoryID: delivery.ID().Value()})
+	_, _ = createUseCase.Execute(dtos.CreatePayeeInput{UserID: userID.Value(), Name: "Uber"})
+
+	useCase := NewUpdatePayeeUseCase(payeeRepo, categoryRepo, eventbus.NewEventBus())
+
+	aliases := []string{"ifood restaurante"}
+	noCategory := ""
+	output, err := useCase.Execute(dtos.UpdatePayeeInput{UserID: userID.Value(), PayeeID: created.PayeeID, Aliases: &aliases, DefaultCategoryID: &noCategory})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.Aliases) != 1 || output.DefaultCategoryID != "" || output.Name != "iFood" {
+		t.Errorf("Execute() = %+v, want the new alias, no default category and the same name", output)
+	}
+
+	taken := "uber"
+	if _, err := useCase.Execute(dtos.UpdatePayeeInput{UserID: userID.Value(), PayeeID: created.PayeeID, Name: &taken}); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Execute() error = %v, want a name conflict", err)
+	}
+	if _, err := useCase.Execute(dtos.UpdatePayeeInput{UserID: identityvalueobjects.GenerateUserID().Value(), PayeeID: created.PayeeID, Name: &taken}); err == nil || !strings.Contains(err.Error(), "does not belong to user") {
+		t.Errorf("Execute() error = %v, want forbidden for another user", err)
+	}
+}
+
+func TestMatchPayeeUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	payeeRepo := newMockPayeeRepository()
+	categoryRepo := &mockCategoryRepository{categories: make(map[string]*categoryentities.Category)}
+	created, _ := NewCreatePayeeUseCase(payeeRepo, categoryRepo, eventbus.NewEventBus()).Execute(dtos.CreatePayeeInput{UserID: userID.Value(), Name: "iFood"})
+
+	useCase := NewMatchPayeeUseCase(payeeRepo)
+
+	output, err := useCase.Execute(dtos.MatchPayeeInput{UserID: userID.Value(), Description: "PAG*IFOOD 1234 SAO PAULO"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.NormalizedDescription != "ifood sao paulo" {
+		t.Errorf("Execute() normalized description = %q, want %q", output.NormalizedDescription, "ifood sao paulo")
+	}
+	if output.Payee == nil || output.Payee.PayeeID != created.PayeeID {
+		t.Errorf("Execute() payee = %+v, want %s", output.Payee, created.PayeeID)
+	}
+
+	// Payees of other users are never matched
+	output, _ = useCase.Execute(dtos.MatchPayeeInput{UserID: identityvalueobjects.GenerateUserID().Value(), Description: "IFOOD *RESTAURANTE"})
+	if output.Payee != nil {
+		t.Errorf("Execute() payee = %+v, want nil for another user", output.Payee)
+	}
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/domain/repositories"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+)
+
+// UpdatePayeeUseCase handles updating the name, aliases and default category of a payee.
+type UpdatePayeeUseCase struct {
+	payeeRepository    repositories.PayeeRepository
+	categoryRepository categoryrepositories.CategoryRepository
+	eventBus           *eventbus.EventBus
+}
+
+// NewUpdatePayeeUseCase creates a new UpdatePayeeUseCase instance.
+func NewUpdatePayeeUseCase(
+	payeeRepository repositories.PayeeRepository,
+	categoryRepository categoryrepositories.CategoryRepository,
+	eventBus *eventbus.EventBus,
+) *UpdatePayeeUseCase {
+	return &UpdatePayeeUseCase{
+		payeeRepository:    payeeRepository,
+		categoryRepository: categoryRepository,
+		eventBus:           eventBus,
+	}
+}
+
+// Execute updates the provided fields of the payee. Transactions already assigned to the payee
+// keep it; new aliases only affect the transactions matched from now on.
+func (uc *UpdatePayeeUseCase) Execute(input dtos.UpdatePayeeInput) (*dtos.PayeeOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if input.Name == nil && input.Aliases == nil && input.DefaultCategoryID == nil {
+		return nil, errors.New("at least one field must be provided for update")
+	}
+
+	payee, err := findUserPayee(uc.payeeRepository, userID, input.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rename if provided; another payee of the user cannot have the same name
+	if input.Name != nil {
+		payeeName, err := valueobjects.NewPayeeName(*input.Name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payee name: %w", err)
+		}
+		existingPayee, err := uc.payeeRepository.FindByUserIDAndName(userID, payeeName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if payee exists: %w", err)
+		}
+		if existingPayee != nil && !existingPayee.ID().Equals(payee.ID()) {
+			return nil, errors.New("payee with this name already exists")
+		}
+		if err := payee.Rename(payeeName); err != nil {
+			return nil, fmt.Errorf("invalid payee name: %w", err)
+		}
+	}
+
+	// Replace aliases if provided (empty list removes them)
+	if input.Aliases != nil {
+		aliases, err := newPayeeAliases(*input.Aliases)
+		if err != nil {
+			return nil, err
+		}
+		if err := payee.UpdateAliases(aliases); err != nil {
+			return nil, fmt.Errorf("invalid payee aliases: %w", err)
+		}
+	}
+
+	// Update default category if provided (empty string removes it)
+	if input.DefaultCategoryID != nil {
+		defaultCategoryID, err := findUserDefaultCategoryID(uc.categoryRepository, userID, *input.DefaultCategoryID)
+		if err != nil {
+			return nil, err
+		}
+		if err := payee.UpdateDefaultCategory(defaultCategoryID); err != nil {
+			return nil, fmt.Errorf("invalid default category: %w", err)
+		}
+	}
+
+	// Save payee to repository
+	if err := uc.payeeRepository.Save(payee); err != nil {
+		return nil, fmt.Errorf("failed to save payee: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range payee.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	payee.ClearEvents()
+
+	output := payeeOutput(payee)
+	return &output, nil
+}
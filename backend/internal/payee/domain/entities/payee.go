@@ -0,0 +1,263 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+)
+
+// MaxPayeeAliases is the maximum number of aliases of a payee.
+const MaxPayeeAliases = 20
+
+// Payee represents a payee aggregate root in the Payee context.
+// A payee is a merchant or counterparty of the user (e.g. "iFood"). Bank descriptions such as
+// "PAG*IFOOD 1234 SAO PAULO" and "IFOOD *RESTAURANTE" are matched to the payee by its name and
+// aliases, and the payee may suggest a default category for its transactions.
+type Payee struct {
+	id        valueobjects.PayeeID
+	userID    identityvalueobjects.UserID
+	name      valueobjects.PayeeName
+	aliases   []valueobjects.PayeeAlias
+	createdAt time.Time
+	updatedAt time.Time
+
+	// Category given to new transactions of the payee that have no category (nil if none)
+	defaultCategoryID *categoryvalueobjects.CategoryID
+
+	// Domain events
+	events []events.DomainEvent
+}
+
+// NewPayee creates a new Payee aggregate.
+func NewPayee(
+	userID identityvalueobjects.UserID,
+	name valueobjects.PayeeName,
+	aliases []valueobjects.PayeeAlias,
+	defaultCategoryID *categoryvalueobjects.CategoryID,
+) (*Payee, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if name.IsEmpty() {
+		return nil, errors.New("payee name cannot be empty")
+	}
+
+	uniqueAliases, err := uniquePayeeAliases(aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultCategoryID != nil && defaultCategoryID.IsEmpty() {
+		return nil, errors.New("default category ID cannot be empty")
+	}
+
+	now := time.Now()
+
+	payee := &Payee{
+		id:                valueobjects.GeneratePayeeID(),
+		userID:            userID,
+		name:              name,
+		aliases:           uniqueAliases,
+		defaultCategoryID: defaultCategoryID,
+		createdAt:         now,
+		updatedAt:         now,
+		events:            []events.DomainEvent{},
+	}
+
+	// Add domain event
+	payee.addEvent(events.NewBaseDomainEvent(
+		"PayeeCreated",
+		payee.id.Value(),
+		"Payee",
+	))
+
+	return payee, nil
+}
+
+// PayeeFromPersistence reconstructs a Payee aggregate from persisted data.
+// This method does not trigger domain events, as it's used for loading existing data.
+func PayeeFromPersistence(
+	id valueobjects.PayeeID,
+	userID identityvalueobjects.UserID,
+	name valueobjects.PayeeName,
+	aliases []valueobjects.PayeeAlias,
+	defaultCategoryID *categoryvalueobjects.CategoryID,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*Payee, error) {
+	if id.IsEmpty() {
+		return nil, errors.New("payee ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if name.IsEmpty() {
+		return nil, errors.New("payee name cannot be empty")
+	}
+
+	return &Payee{
+		id:                id,
+		userID:            userID,
+		name:              name,
+		aliases:           aliases,
+		defaultCategoryID: defaultCategoryID,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+		events:            []events.DomainEvent{},
+	}, nil
+}
+
+// ID returns the payee ID.
+func (p *Payee) ID() valueobjects.PayeeID {
+	return p.id
+}
+
+// UserID returns the user ID.
+func (p *Payee) UserID() identityvalueobjects.UserID {
+	return p.userID
+}
+
+// Name returns the payee name.
+func (p *Payee) Name() valueobjects.PayeeName {
+	return p.name
+}
+
+// Aliases returns the aliases of the payee.
+func (p *Payee) Aliases() []valueobjects.PayeeAlias {
+	aliases := make([]valueobjects.PayeeAlias, len(p.aliases))
+	copy(aliases, p.aliases)
+	return aliases
+}
+
+// DefaultCategoryID returns the default category of the payee (nil if none).
+func (p *Payee) DefaultCategoryID() *categoryvalueobjects.CategoryID {
+	return p.defaultCategoryID
+}
+
+// CreatedAt returns the creation timestamp.
+func (p *Payee) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// UpdatedAt returns the last update timestamp.
+func (p *Payee) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// MatchLength returns how specific the best match of the payee for a normalized description is,
+// as the length of the longest matching pattern (the normalized name or an alias), or 0 if the
+// payee does not match.
+func (p *Payee) MatchLength(normalizedDescription string) int {
+	best := 0
+	patterns := p.aliases
+	if name, err := valueobjects.NewPayeeAlias(p.name.Value()); err == nil {
+		patterns = append([]valueobjects.PayeeAlias{name}, patterns...)
+	}
+	for _, pattern := range patterns {
+		if pattern.Matches(normalizedDescription) && len(pattern.Value()) > best {
+			best = len(pattern.Value())
+		}
+	}
+	return best
+}
+
+// Rename changes the payee name.
+func (p *Payee) Rename(name valueobjects.PayeeName) error {
+	if name.IsEmpty() {
+		return errors.New("payee name cannot be empty")
+	}
+
+	p.name = name
+	p.updatedAt = time.Now()
+
+	p.addEvent(events.NewBaseDomainEvent(
+		"PayeeRenamed",
+		p.id.Value(),
+		"Payee",
+	))
+
+	return nil
+}
+
+// UpdateAliases replaces the aliases of the payee.
+// Duplicate aliases are ignored. Passing an empty slice removes all aliases.
+func (p *Payee) UpdateAliases(aliases []valueobjects.PayeeAlias) error {
+	uniqueAliases, err := uniquePayeeAliases(aliases)
+	if err != nil {
+		return err
+	}
+
+	p.aliases = uniqueAliases
+	p.updatedAt = time.Now()
+
+	p.addEvent(events.NewBaseDomainEvent(
+		"PayeeAliasesUpdated",
+		p.id.Value(),
+		"Payee",
+	))
+
+	return nil
+}
+
+// UpdateDefaultCategory sets the default category of the payee.
+// Passing nil removes the default category.
+func (p *Payee) UpdateDefaultCategory(categoryID *categoryvalueobjects.CategoryID) error {
+	if categoryID != nil && categoryID.IsEmpty() {
+		return errors.New("default category ID cannot be empty")
+	}
+
+	p.defaultCategoryID = categoryID
+	p.updatedAt = time.Now()
+
+	p.addEvent(events.NewBaseDomainEvent(
+		"PayeeDefaultCategoryUpdated",
+		p.id.Value(),
+		"Payee",
+	))
+
+	return nil
+}
+
+// GetEvents returns all domain events that occurred on this aggregate.
+func (p *Payee) GetEvents() []events.DomainEvent {
+	return p.events
+}
+
+// ClearEvents clears all domain events from this aggregate.
+func (p *Payee) ClearEvents() {
+	p.events = []events.DomainEvent{}
+}
+
+// addEvent adds a domain event to the aggregate.
+func (p *Payee) addEvent(event events.DomainEvent) {
+	p.events = append(p.events, event)
+}
+
+// uniquePayeeAliases validates a list of aliases and removes duplicates, keeping the first occurrence.
+func uniquePayeeAliases(aliases []valueobjects.PayeeAlias) ([]valueobjects.PayeeAlias, error) {
+	unique := make([]valueobjects.PayeeAlias, 0, len(aliases))
+	seen := make(map[string]bool, len(aliases))
+	for _, alias := range aliases {
+		if alias.IsEmpty() {
+			return nil, errors.New("payee alias cannot be empty")
+		}
+		if seen[alias.Value()] {
+			continue
+		}
+		seen[alias.Value()] = true
+		unique = append(unique, alias)
+	}
+
+	if len(unique) > MaxPayeeAliases {
+		return nil, fmt.Errorf("payee cannot have more than %d aliases", MaxPayeeAliases)
+	}
+	return unique, nil
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"testing"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+)
+
+func TestNewPayee(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	name := valueobjects.MustPayeeName("iFood")
+
+	payee, err := NewPayee(userID, name, []valueobjects.PayeeAlias{
+		valueobjects.MustPayeeAlias("IFD"),
+		valueobjects.MustPayeeAlias("ifd"),
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewPayee() error = %v, want nil", err)
+	}
+
+	if payee.ID().IsEmpty() {
+		t.Error("NewPayee() returned payee with empty ID")
+	}
+	if len(payee.Aliases()) != 1 {
+		t.Errorf("NewPayee() aliases = %v, want duplicates removed", payee.Aliases())
+	}
+	if len(payee.GetEvents()) != 1 {
+		t.Errorf("NewPayee() events = %d, want 1 (PayeeCreated)", len(payee.GetEvents()))
+	}
+
+	if _, err := NewPayee(identityvalueobjects.UserID{}, name, nil, nil); err == nil {
+		t.Error("NewPayee() should fail with empty user ID")
+	}
+	if _, err := NewPayee(userID, valueobjects.PayeeName{}, nil, nil); err == nil {
+		t.Error("NewPayee() should fail with empty name")
+	}
+	if _, err := NewPayee(userID, name, []valueobjects.PayeeAlias{{}}, nil); err == nil {
+		t.Error("NewPayee() should fail with an empty alias")
+	}
+}
+
+func TestPayee_MatchLength(t *testing.T) {
+	payee, _ := NewPayee(identityvalueobjects.GenerateUserID(), valueobjects.MustPayeeName("iFood"), []valueobjects.PayeeAlias{
+		valueobjects.MustPayeeAlias("ifood restaurante"),
+	}, nil)
+
+	tests := map[string]int{
+		"ifood sao paulo":   len("ifood"),
+		"ifood restaurante": len("ifood restaurante"),
+		"uber trip":         0,
+	}
+	for description, want := range tests {
+		if got := payee.MatchLength(description); got != want {
+			t.Errorf("MatchLength(%q) = %d, want %d", description, got, want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+)
+
+// PayeeRepository defines the interface for payee persistence operations.
+// This interface belongs to the domain layer and will be implemented in the infrastructure layer.
+type PayeeRepository interface {
+	// FindByID finds a payee by its ID.
+	// Returns nil if the payee is not found.
+	FindByID(id valueobjects.PayeeID) (*entities.Payee, error)
+
+	// FindByUserID finds all payees for a given user with their aliases, ordered by name.
+	// Returns an empty slice if no payees are found.
+	FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Payee, error)
+
+	// FindByUserIDAndName finds a payee by user ID and name (case-insensitive).
+	// Returns nil if the payee is not found.
+	FindByUserIDAndName(userID identityvalueobjects.UserID, name valueobjects.PayeeName) (*entities.Payee, error)
+
+	// Save saves or updates a payee and replaces its aliases.
+	// If the payee already exists (by ID), it updates it.
+	// If the payee doesn't exist, it creates a new one.
+	Save(payee *entities.Payee) error
+
+	// Delete permanently deletes a payee by its ID and detaches it from all transactions.
+	Delete(id valueobjects.PayeeID) error
+}
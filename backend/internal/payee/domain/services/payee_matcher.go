@@ -0,0 +1,42 @@
+package services
+
+import (
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+)
+
+// PayeeMatcher finds the payee of a transaction from its bank description.
+// Descriptions are normalized with NormalizeMerchantDescription and compared with the names and
+// aliases of the payees. When several payees match, the most specific one (longest matching name
+// or alias) wins; ties go to the payee listed first.
+type PayeeMatcher struct {
+	payees []*entities.Payee
+}
+
+// NewPayeeMatcher creates a matcher for the payees of a user.
+func NewPayeeMatcher(payees []*entities.Payee) *PayeeMatcher {
+	return &PayeeMatcher{payees: payees}
+}
+
+// HasPayees checks if there is any payee to match.
+func (m *PayeeMatcher) HasPayees() bool {
+	return len(m.payees) > 0
+}
+
+// Match returns the payee of a description, or nil if no payee matches.
+func (m *PayeeMatcher) Match(description string) *entities.Payee {
+	normalized := valueobjects.NormalizeMerchantDescription(description)
+	if normalized == "" {
+		return nil
+	}
+
+	var best *entities.Payee
+	bestLength := 0
+	for _, payee := range m.payees {
+		if length := payee.MatchLength(normalized); length > bestLength {
+			best = payee
+			bestLength = length
+		}
+	}
+	return best
+}
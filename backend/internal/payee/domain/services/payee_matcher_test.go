@@ -0,0 +1,59 @@
+package services
+
+import (
+	"testing"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+)
+
+func newTestPayee(t *testing.T, name string, aliases ...string) *entities.Payee {
+	values := make([]valueobjects.PayeeAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		values = append(values, valueobjects.MustPayeeAlias(alias))
+	}
+	payee, err := entities.NewPayee(identityvalueobjects.GenerateUserID(), valueobjects.MustPayeeName(name), values, nil)
+	if err != nil {
+		t.Fatalf("NewPayee() error = %v", err)
+	}
+	return payee
+}
+
+func TestPayeeMatcher_Match(t *testing.T) {
+	ifood := newTestPayee(t, "iFood")
+	mercado := newTestPayee(t, "Mercado Livre", "mercadolivre", "meli")
+	padaria := newTestPayee(t, "Padaria", "padaria sao joao")
+	saoJoao := newTestPayee(t, "Padaria do João", "padaria sao joao centro")
+
+	matcher := NewPayeeMatcher([]*entities.Payee{ifood, mercado, padaria, saoJoao})
+
+	tests := []struct {
+		description string
+		want        *entities.Payee
+	}{
+		{"PAG*IFOOD 1234 SAO PAULO", ifood},
+		{"IFOOD *RESTAURANTE", ifood},
+		{"MP *MERCADOLIVRE", mercado},
+		{"COMPRA CARTAO PADARIA SAO JOAO", padaria},
+		{"PADARIA SAO JOAO CENTRO 0001", saoJoao},
+		{"UBER *TRIP", nil},
+		{"1234", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got := matcher.Match(tt.description)
+			if got != tt.want {
+				gotName, wantName := "<nil>", "<nil>"
+				if got != nil {
+					gotName = got.Name().Value()
+				}
+				if tt.want != nil {
+					wantName = tt.want.Name().Value()
+				}
+				t.Errorf("Match(%q) = %s, want %s", tt.description, gotName, wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// MaxPayeeAliasLength is the maximum length for a payee alias.
+const MaxPayeeAliasLength = 100
+
+// paymentProcessorWords are the words that card acquirers, payment processors and banks add
+// around the merchant name on statements ("PAG*IFOOD", "MP *LOJA", "COMPRA CARTAO PADARIA").
+var paymentProcessorWords = map[string]bool{
+	"pag": true, "pg": true, "pgto": true, "pagto": true, "pagseguro": true,
+	"mp": true, "mercpago": true, "mercadopago": true,
+	"paypal": true, "sq": true, "ebanx": true,
+	"compra": true, "cartao": true, "debito": true, "credito": true, "pix": true,
+}
+
+// NormalizeMerchantDescription reduces a bank description to the words that identify the merchant:
+// it lowercases the description, removes accents and punctuation, drops words with digits (store
+// and card numbers, dates) and payment processor words, and collapses spaces. Both
+// "PAG*IFOOD 1234 SAO PAULO" and "IFOOD *RESTAURANTE" start with "ifood" once normalized.
+func NormalizeMerchantDescription(description string) string {
+	words := strings.FieldsFunc(transactionvalueobjects.FoldDescription(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := make([]string, 0, len(words))
+	for _, word := range words {
+		if strings.IndexFunc(word, unicode.IsDigit) >= 0 || paymentProcessorWords[word] {
+			continue
+		}
+		kept = append(kept, word)
+	}
+	return strings.Join(kept, " ")
+}
+
+// PayeeAlias represents a payee alias value object: a pattern that identifies the payee in bank
+// descriptions. Aliases are kept normalized (see NormalizeMerchantDescription) and match a
+// description that contains their words in sequence.
+type PayeeAlias struct {
+	value string
+}
+
+// NewPayeeAlias creates a new PayeeAlias value object from a raw alias, which is normalized.
+func NewPayeeAlias(alias string) (PayeeAlias, error) {
+	if utf8.RuneCountInString(alias) > MaxPayeeAliasLength {
+		return PayeeAlias{}, fmt.Errorf("payee alias is too long (max %d characters)", MaxPayeeAliasLength)
+	}
+
+	normalized := NormalizeMerchantDescription(alias)
+	if normalized == "" {
+		return PayeeAlias{}, fmt.Errorf("payee alias must contain words without digits: %q", alias)
+	}
+
+	return PayeeAlias{value: normalized}, nil
+}
+
+// MustPayeeAlias creates a new PayeeAlias and panics if invalid.
+// Use this only when you are certain the alias is valid (e.g., in tests).
+func MustPayeeAlias(alias string) PayeeAlias {
+	pa, err := NewPayeeAlias(alias)
+	if err != nil {
+		panic(err)
+	}
+	return pa
+}
+
+// Value returns the normalized alias as a string.
+func (pa PayeeAlias) Value() string {
+	return pa.value
+}
+
+// String returns the normalized alias as a string (implements fmt.Stringer).
+func (pa PayeeAlias) String() string {
+	return pa.value
+}
+
+// Equals checks if two PayeeAlias values are equal.
+func (pa PayeeAlias) Equals(other PayeeAlias) bool {
+	return pa.value == other.value
+}
+
+// IsEmpty checks if the alias is empty.
+func (pa PayeeAlias) IsEmpty() bool {
+	return pa.value == ""
+}
+
+// Matches checks if a normalized description contains the words of the alias in sequence.
+// "ifood" matches "ifood sao paulo" but not "ifoodies".
+func (pa PayeeAlias) Matches(normalizedDescription string) bool {
+	if pa.value == "" {
+		return false
+	}
+	return strings.Contains(" "+normalizedDescription+" ", " "+pa.value+" ")
+}
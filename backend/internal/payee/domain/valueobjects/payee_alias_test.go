@@ -0,0 +1,56 @@
+package valueobjects
+
+import "testing"
+
+func TestNormalizeMerchantDescription(t *testing.T) {
+	tests := []struct {
+		description string
+		want        string
+	}{
+		{"PAG*IFOOD 1234 SAO PAULO", "ifood sao paulo"},
+		{"IFOOD *RESTAURANTE", "ifood restaurante"},
+		{"MP *MERCADOLIVRE", "mercadolivre"},
+		{"COMPRA CARTÃO 12/03 PADARIA SÃO JOÃO", "padaria sao joao"},
+		{"UBER *TRIP HELP.UBER.COM", "uber trip help uber com"},
+		{"0012345 26/10", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := NormalizeMerchantDescription(tt.description); got != tt.want {
+				t.Errorf("NormalizeMerchantDescription(%q) = %q, want %q", tt.description, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayeeAlias(t *testing.T) {
+	alias, err := NewPayeeAlias("  iFood ")
+	if err != nil {
+		t.Fatalf("NewPayeeAlias() error = %v", err)
+	}
+	if alias.Value() != "ifood" {
+		t.Errorf("NewPayeeAlias() = %q, want ifood", alias.Value())
+	}
+
+	if _, err := NewPayeeAlias("PAG* 1234"); err == nil {
+		t.Error("NewPayeeAlias() expected an error for an alias without merchant words")
+	}
+
+	matches := map[string]bool{
+		"ifood sao paulo":   true,
+		"restaurante ifood": true,
+		"ifoodies":          false,
+		"":                  false,
+	}
+	for description, want := range matches {
+		if got := alias.Matches(description); got != want {
+			t.Errorf("Matches(%q) = %v, want %v", description, got, want)
+		}
+	}
+
+	multiword := MustPayeeAlias("Padaria São João")
+	if !multiword.Matches("padaria sao joao centro") || multiword.Matches("padaria sao pedro") {
+		t.Error("Matches() must require the words of the alias in sequence")
+	}
+}
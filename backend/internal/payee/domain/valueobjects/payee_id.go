@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// PayeeID represents a payee identifier value object.
+type PayeeID struct {
+	value string
+}
+
+// NewPayeeID creates a new PayeeID from a string.
+func NewPayeeID(id string) (PayeeID, error) {
+	if id == "" {
+		return PayeeID{}, errors.New("payee ID cannot be empty")
+	}
+
+	// Validate UUID format
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return PayeeID{}, errors.New("invalid payee ID format (must be UUID)")
+	}
+
+	return PayeeID{value: id}, nil
+}
+
+// GeneratePayeeID generates a new PayeeID.
+func GeneratePayeeID() PayeeID {
+	return PayeeID{value: uuid.New().String()}
+}
+
+// MustPayeeID creates a new PayeeID and panics if invalid.
+// Use this only when you are certain the ID is valid (e.g., in tests).
+func MustPayeeID(id string) PayeeID {
+	pid, err := NewPayeeID(id)
+	if err != nil {
+		panic(err)
+	}
+	return pid
+}
+
+// Value returns the payee ID as a string.
+func (pid PayeeID) Value() string {
+	return pid.value
+}
+
+// String returns the payee ID as a string (implements fmt.Stringer).
+func (pid PayeeID) String() string {
+	return pid.value
+}
+
+// Equals checks if two PayeeID values are equal.
+func (pid PayeeID) Equals(other PayeeID) bool {
+	return pid.value == other.value
+}
+
+// IsEmpty checks if the payee ID is empty.
+func (pid PayeeID) IsEmpty() bool {
+	return pid.value == ""
+}
@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// PayeeName represents a payee name value object, the name the user gives to a merchant or
+// counterparty (e.g. "iFood" or "Padaria São João").
+type PayeeName struct {
+	value string
+}
+
+// MaxPayeeNameLength is the maximum length for a payee name.
+const MaxPayeeNameLength = 100
+
+// NewPayeeName creates a new PayeeName value object.
+func NewPayeeName(name string) (PayeeName, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return PayeeName{}, errors.New("payee name cannot be empty")
+	}
+
+	if utf8.RuneCountInString(name) > MaxPayeeNameLength {
+		return PayeeName{}, fmt.Errorf("payee name is too long (max %d characters)", MaxPayeeNameLength)
+	}
+
+	return PayeeName{value: name}, nil
+}
+
+// MustPayeeName creates a new PayeeName and panics if invalid.
+// Use this only when you are certain the name is valid (e.g., in tests).
+func MustPayeeName(name string) PayeeName {
+	pn, err := NewPayeeName(name)
+	if err != nil {
+		panic(err)
+	}
+	return pn
+}
+
+// Value returns the payee name as a string.
+func (pn PayeeName) Value() string {
+	return pn.value
+}
+
+// String returns the payee name as a string (implements fmt.Stringer).
+func (pn PayeeName) String() string {
+	return pn.value
+}
+
+// Equals checks if two PayeeName values are equal (case-insensitive).
+func (pn PayeeName) Equals(other PayeeName) bool {
+	return strings.EqualFold(pn.value, other.value)
+}
+
+// IsEmpty checks if the payee name is empty.
+func (pn PayeeName) IsEmpty() bool {
+	return pn.value == ""
+}
@@ -0,0 +1,194 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/repositories"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormPayeeRepository implements PayeeRepository using GORM.
+type GormPayeeRepository struct {
+	db *gorm.DB
+}
+
+// NewGormPayeeRepository creates a new GORM payee repository.
+func NewGormPayeeRepository(db *gorm.DB) repositories.PayeeRepository {
+	return &GormPayeeRepository{db: db}
+}
+
+// FindByID finds a payee by its ID.
+func (r *GormPayeeRepository) FindByID(id valueobjects.PayeeID) (*entities.Payee, error) {
+	var model PayeeModel
+	if err := preloadPayeeAliases(r.db).Where("id = ?", id.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find payee by ID: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByUserID finds all payees for a given user, ordered by name.
+func (r *GormPayeeRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Payee, error) {
+	var models []PayeeModel
+	if err := preloadPayeeAliases(r.db).Where("user_id = ?", userID.Value()).Order("LOWER(name) ASC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find payees by user ID: %w", err)
+	}
+
+	payees := make([]*entities.Payee, 0, len(models))
+	for _, model := range models {
+		payee, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert payee model to domain: %w", err)
+		}
+		payees = append(payees, payee)
+	}
+
+	return payees, nil
+}
+
+// FindByUserIDAndName finds a payee by user ID and name (case-insensitive).
+// Uses index idx_payees_user_name.
+func (r *GormPayeeRepository) FindByUserIDAndName(userID identityvalueobjects.UserID, name valueobjects.PayeeName) (*entities.Payee, error) {
+	var model PayeeModel
+	if err := preloadPayeeAliases(r.db).Where("user_id = ? AND LOWER(name) = LOWER(?)", userID.Value(), name.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find payee by user ID and name: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// Save saves or updates a payee and replaces its aliases.
+func (r *GormPayeeRepository) Save(payee *entities.Payee) error {
+	model := r.toModel(payee)
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(model).Error; err != nil {
+			if isDuplicatePayeeNameError(err) {
+				return errors.New("payee with this name already exists")
+			}
+			return fmt.Errorf("failed to save payee: %w", err)
+		}
+
+		if err := tx.Where("payee_id = ?", model.ID).Delete(&PayeeAliasModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete payee aliases: %w", err)
+		}
+		if len(model.Aliases) > 0 {
+			if err := tx.Create(&model.Aliases).Error; err != nil {
+				return fmt.Errorf("failed to create payee aliases: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Delete permanently deletes a payee and detaches it from all transactions.
+func (r *GormPayeeRepository) Delete(id valueobjects.PayeeID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("UPDATE "+transactionsTable+" SET payee_id = NULL WHERE payee_id = ?", id.Value()).Error; err != nil {
+			return fmt.Errorf("failed to detach payee from transactions: %w", err)
+		}
+		if err := tx.Where("payee_id = ?", id.Value()).Delete(&PayeeAliasModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete payee aliases: %w", err)
+		}
+		if err := tx.Where("id = ?", id.Value()).Delete(&PayeeModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete payee: %w", err)
+		}
+		return nil
+	})
+}
+
+// preloadPayeeAliases loads the aliases of the queried payees.
+func preloadPayeeAliases(db *gorm.DB) *gorm.DB {
+	return db.Preload("Aliases", func(db *gorm.DB) *gorm.DB {
+		return db.Order("alias ASC")
+	})
+}
+
+// isDuplicatePayeeNameError checks if the error is a unique constraint violation on the payee name.
+func isDuplicatePayeeNameError(err error) bool {
+	return strings.Contains(err.Error(), "duplicate key") ||
+		strings.Contains(err.Error(), "unique constraint") ||
+		strings.Contains(err.Error(), "UNIQUE constraint") ||
+		strings.Contains(err.Error(), "idx_payees_user_name")
+}
+
+// toDomain converts a PayeeModel to a Payee domain entity.
+func (r *GormPayeeRepository) toDomain(model *PayeeModel) (*entities.Payee, error) {
+	payeeID, err := valueobjects.NewPayeeID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payee ID: %w", err)
+	}
+
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	payeeName, err := valueobjects.NewPayeeName(model.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payee name: %w", err)
+	}
+
+	aliases := make([]valueobjects.PayeeAlias, 0, len(model.Aliases))
+	for _, aliasModel := range model.Aliases {
+		alias, err := valueobjects.NewPayeeAlias(aliasModel.Alias)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payee alias: %w", err)
+		}
+		aliases = append(aliases, alias)
+	}
+
+	var defaultCategoryID *categoryvalueobjects.CategoryID
+	if model.DefaultCategoryID != nil {
+		categoryID, err := categoryvalueobjects.NewCategoryID(*model.DefaultCategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default category ID: %w", err)
+		}
+		defaultCategoryID = &categoryID
+	}
+
+	return entities.PayeeFromPersistence(payeeID, userID, payeeName, aliases, defaultCategoryID, model.CreatedAt, model.UpdatedAt)
+}
+
+// toModel converts a Payee domain entity to a PayeeModel.
+func (r *GormPayeeRepository) toModel(payee *entities.Payee) *PayeeModel {
+	var defaultCategoryID *string
+	if payee.DefaultCategoryID() != nil {
+		categoryID := payee.DefaultCategoryID().Value()
+		defaultCategoryID = &categoryID
+	}
+
+	aliases := make([]PayeeAliasModel, 0, len(payee.Aliases()))
+	for _, alias := range payee.Aliases() {
+		aliases = append(aliases, PayeeAliasModel{
+			PayeeID:   payee.ID().Value(),
+			Alias:     alias.Value(),
+			CreatedAt: payee.UpdatedAt(),
+		})
+	}
+
+	return &PayeeModel{
+		ID:                payee.ID().Value(),
+		UserID:            payee.UserID().Value(),
+		Name:              payee.Name().Value(),
+		DefaultCategoryID: defaultCategoryID,
+		Aliases:           aliases,
+		CreatedAt:         payee.CreatedAt(),
+		UpdatedAt:         payee.UpdatedAt(),
+	}
+}
@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"testing"
+
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/payee/domain/entities"
+	"gestao-financeira/backend/internal/payee/domain/valueobjects"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupPayeeTestDB creates an in-memory SQLite database for testing.
+// A minimal transactions table is created by hand, since its model lives in the Transaction context.
+func setupPayeeTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	// Auto-migrate the schema
+	if err := db.AutoMigrate(&PayeeModel{}, &PayeeAliasModel{}); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+	if err := db.Exec("CREATE TABLE transactions (id TEXT PRIMARY KEY, payee_id TEXT)").Error; err != nil {
+		t.Fatalf("Failed to create transactions table: %v", err)
+	}
+
+	return db
+}
+
+// createTestPayee creates and saves a test payee entity.
+func createTestPayee(t *testing.T, repo *GormPayeeRepository, userID identityvalueobjects.UserID, name string, aliases ...string) *entities.Payee {
+	values := make([]valueobjects.PayeeAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		values = append(values, valueobjects.MustPayeeAlias(alias))
+	}
+	payee, err := entities.NewPayee(userID, valueobjects.MustPayeeName(name), values, nil)
+	if err != nil {
+		t.Fatalf("Failed to create payee: %v", err)
+	}
+	if err := repo.Save(payee); err != nil {
+		t.Fatalf("Failed to save payee: %v", err)
+	}
+	return payee
+}
+
+func TestGormPayeeRepository_SaveAndFind(t *testing.T) {
+	db := setupPayeeTestDB(t)
+	repo := NewGormPayeeRepository(db).(*GormPayeeRepository)
+	userID := identityvalueobjects.GenerateUserID()
+
+	payee := createTestPayee(t, repo, userID, "iFood", "ifd", "ifood restaurante")
+	createTestPayee(t, repo, userID, "Amazon")
+	createTestPayee(t, repo, identityvalueobjects.GenerateUserID(), "iFood")
+
+	found, err := repo.FindByUserIDAndName(userID, valueobjects.MustPayeeName("IFOOD"))
+	if err != nil {
+		t.Fatalf("FindByUserIDAndName() error = %v", err)
+	}
+	if found == nil || !found.ID().Equals(payee.ID()) {
+		t.Fatalf("FindByUserIDAndName() = %v, want payee %s", found, payee.ID().Value())
+	}
+	if aliases := found.Aliases(); len(aliases) != 2 || aliases[0].Value() != "ifd" {
+		t.Errorf("FindByUserIDAndName() aliases = %v, want [ifd ifood restaurante]", aliases)
+	}
+
+	payees, err := repo.FindByUserID(userID)
+	if err != nil {
+		t.Fatalf("FindByUserID() error = %v", err)
+	}
+	if len(payees) != 2 || payees[0].Name().Value() != "Amazon" {
+		t.Errorf("FindByUserID() = %d payees, want 2 ordered by name", len(payees))
+	}
+
+	// Update replaces the aliases and sets the default category
+	categoryID := categoryvalueobjects.GenerateCategoryID()
+	_ = found.UpdateAliases([]valueobjects.PayeeAlias{valueobjects.MustPayeeAlias("ifood")})
+	_ = found.UpdateDefaultCategory(&categoryID)
+	if err := repo.Save(found); err != nil {
+		t.Fatalf("Save() error on update = %v", err)
+	}
+	updated, _ := repo.FindByID(payee.ID())
+	if aliases := updated.Aliases(); len(aliases) != 1 || aliases[0].Value() != "ifood" {
+		t.Errorf("Save() aliases = %v, want [ifood]", aliases)
+	}
+	if updated.DefaultCategoryID() == nil || !updated.DefaultCategoryID().Equals(categoryID) {
+		t.Errorf("Save() default category = %v, want %s", updated.DefaultCategoryID(), categoryID.Value())
+	}
+}
+
+func TestGormPayeeRepository_Delete(t *testing.T) {
+	db := setupPayeeTestDB(t)
+	repo := NewGormPayeeRepository(db).(*GormPayeeRepository)
+	payee := createTestPayee(t, repo, identityvalueobjects.GenerateUserID(), "iFood", "ifd")
+	db.Exec("INSERT INTO transactions (id, payee_id) VALUES (?, ?)", "tx-1", payee.ID().Value())
+
+	if err := repo.Delete(payee.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if deleted, _ := repo.FindByID(payee.ID()); deleted != nil {
+		t.Error("Delete() payee still exists")
+	}
+	var linked int64
+	db.Table("transactions").Where("payee_id IS NOT NULL").Count(&linked)
+	if linked != 0 {
+		t.Errorf("Delete() left %d transactions linked to the payee", linked)
+	}
+	var aliases int64
+	db.Model(&PayeeAliasModel{}).Count(&aliases)
+	if aliases != 0 {
+		t.Errorf("Delete() left %d aliases", aliases)
+	}
+}
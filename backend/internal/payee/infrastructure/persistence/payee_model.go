@@ -0,0 +1,38 @@
+package persistence
+
+import (
+	"time"
+)
+
+// PayeeModel represents the database model for Payee entity.
+// This is the persistence model, separate from the domain entity.
+type PayeeModel struct {
+	ID                string            `gorm:"type:uuid;primary_key"`
+	UserID            string            `gorm:"type:uuid;index;not null"`
+	Name              string            `gorm:"type:varchar(100);not null"`
+	DefaultCategoryID *string           `gorm:"type:uuid;null"`
+	Aliases           []PayeeAliasModel `gorm:"foreignKey:PayeeID"` // Loaded with Preload, saved by replaceAliases
+	CreatedAt         time.Time         `gorm:"not null"`
+	UpdatedAt         time.Time         `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (PayeeModel) TableName() string {
+	return "payees"
+}
+
+// PayeeAliasModel represents the database model for a normalized alias of a payee.
+type PayeeAliasModel struct {
+	PayeeID   string    `gorm:"type:uuid;primaryKey"`
+	Alias     string    `gorm:"type:varchar(100);primaryKey"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (PayeeAliasModel) TableName() string {
+	return "payee_aliases"
+}
+
+// transactionsTable is the table of the Transaction context that references payees.
+// The payee repository only clears the reference when a payee is deleted.
+const transactionsTable = "transactions"
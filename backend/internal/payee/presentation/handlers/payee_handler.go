@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/payee/application/dtos"
+	"gestao-financeira/backend/internal/payee/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// PayeeHandler handles payee-related HTTP requests.
+type PayeeHandler struct {
+	createPayeeUseCase *usecases.CreatePayeeUseCase
+	listPayeesUseCase  *usecases.ListPayeesUseCase
+	updatePayeeUseCase *usecases.UpdatePayeeUseCase
+	deletePayeeUseCase *usecases.DeletePayeeUseCase
+	matchPayeeUseCase  *usecases.MatchPayeeUseCase
+}
+
+// NewPayeeHandler creates a new PayeeHandler instance.
+func NewPayeeHandler(
+	createPayeeUseCase *usecases.CreatePayeeUseCase,
+	listPayeesUseCase *usecases.ListPayeesUseCase,
+	updatePayeeUseCase *usecases.UpdatePayeeUseCase,
+	deletePayeeUseCase *usecases.DeletePayeeUseCase,
+	matchPayeeUseCase *usecases.MatchPayeeUseCase,
+) *PayeeHandler {
+	return &PayeeHandler{
+		createPayeeUseCase: createPayeeUseCase,
+		listPayeesUseCase:  listPayeesUseCase,
+		updatePayeeUseCase: updatePayeeUseCase,
+		deletePayeeUseCase: deletePayeeUseCase,
+		matchPayeeUseCase:  matchPayeeUseCase,
+	}
+}
+
+// Create handles payee creation requests.
+// @Summary Create a new payee
+// @Description Creates a new payee (merchant or counterparty) for the authenticated user. New and imported transactions whose description matches the name or one of the aliases of the payee are assigned to it automatically, and get its default category when they have none.
+//
+// **Correspondência**: as descrições são normalizadas antes da comparação: letras minúsculas, sem acentos e pontuação, sem palavras com dígitos (números de loja, cartão e datas) e sem palavras de intermediadores de pagamento (`PAG`, `MP`, `PAYPAL`, `COMPRA`, `CARTAO`...). Assim, `PAG*IFOOD 1234 SAO PAULO` e `IFOOD *RESTAURANTE` correspondem ao alias `ifood`. Um alias corresponde quando suas palavras aparecem em sequência na descrição; se vários favorecidos corresponderem, vence o alias mais longo.
+//
+// **Validações**:
+// - Nome é obrigatório, com até 100 caracteres, e deve ser único para o usuário (case-insensitive)
+// - Até 20 aliases, cada um com pelo menos uma palavra sem dígitos
+// - A categoria padrão deve pertencer ao usuário
+//
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreatePayeeInput true "Payee creation data" example({"name":"iFood","aliases":["ifood","ifd"],"default_category_id":"550e8400-e29b-41d4-a716-446655440001"})
+// @Success 201 {object} dtos.PayeeOutput "Payee created successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid name, alias or category" example({"error":"invalid payee alias: payee alias must contain words without digits: \"1234\"","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - category does not belong to user" example({"error":"category does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - category does not exist" example({"error":"category not found","error_type":"NOT_FOUND","code":404})
+// @Failure 409 {object} map[string]interface{} "Conflict - payee with this name already exists" example({"error":"payee with this name already exists","error_type":"CONFLICT","code":409})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /payees [post]
+func (h *PayeeHandler) Create(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.CreatePayeeInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context
+	input.UserID = userID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.createPayeeUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Payee created successfully",
+		"data":    output,
+	})
+}
+
+// List handles payee listing requests.
+// @Summary List payees
+// @Description Lists all payees of the authenticated user ordered by name, with their normalized aliases.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dtos.ListPayeesOutput "Payees retrieved successfully"
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /payees [get]
+func (h *PayeeHandler) List(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Execute use case
+	output, err := h.listPayeesUseCase.Execute(dtos.ListPayeesInput{UserID: userID})
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Payees retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Match handles requests to find the payee of a bank description.
+// @Summary Match a description to a payee
+// @Description Shows the normalized form of a bank description and the payee it would be assigned to, the same way new and imported transactions are matched. Useful to check aliases before importing a statement.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param description query string true "Bank description" example(PAG*IFOOD 1234 SAO PAULO)
+// @Success 200 {object} dtos.MatchPayeeOutput "Description matched (payee is null when no payee matches)"
+// @Failure 400 {object} map[string]interface{} "Bad request - missing description" example({"error":"Validation failed","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /payees/match [get]
+func (h *PayeeHandler) Match(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.MatchPayeeInput{
+		UserID:      userID,
+		Description: c.Query("description"),
+	}
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.matchPayeeUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Description matched successfully",
+		"data":    output,
+	})
+}
+
+// Update handles payee update requests.
+// @Summary Update a payee
+// @Description Updates the name, aliases and/or default category of a payee of the authenticated user. Only provided fields are changed: `aliases` replaces all aliases (an empty list removes them) and an empty `default_category_id` removes the default category. Transactions already assigned to the payee are not changed.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Payee ID" example("550e8400-e29b-41d4-a716-446655440000")
+// @Param request body dtos.UpdatePayeeInput true "Fields to update" example({"aliases":["ifood","ifd","ifood restaurante"]})
+// @Success 200 {object} dtos.PayeeOutput "Payee updated successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid payee ID, name, alias or category" example({"error":"at least one field must be provided for update","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - payee or category does not belong to user" example({"error":"payee does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - payee or category does not exist" example({"error":"payee not found","error_type":"NOT_FOUND","code":404})
+// @Failure 409 {object} map[string]interface{} "Conflict - payee with this name already exists" example({"error":"payee with this name already exists","error_type":"CONFLICT","code":409})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /payees/{id} [put]
+func (h *PayeeHandler) Update(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	payeeID := c.Params("id")
+	if payeeID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Payee ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Parse request body
+	var input dtos.UpdatePayeeInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	input.UserID = userID
+	input.PayeeID = payeeID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.updatePayeeUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Payee updated successfully",
+		"data":    output,
+	})
+}
+
+// Delete handles payee deletion requests.
+// @Summary Delete a payee
+// @Description Deletes a payee of the authenticated user. The payee is removed from every transaction it was assigned to; the transactions themselves are not changed.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Payee ID" example("550e8400-e29b-41d4-a716-446655440000")
+// @Success 200 {object} dtos.DeletePayeeOutput "Payee deleted successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid payee ID" example({"error":"invalid payee ID: invalid payee ID format (must be UUID)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - payee does not belong to user" example({"error":"payee does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - payee does not exist" example({"error":"payee not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /payees/{id} [delete]
+func (h *PayeeHandler) Delete(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	payeeID := c.Params("id")
+	if payeeID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Payee ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Execute use case
+	output, err := h.deletePayeeUseCase.Execute(dtos.DeletePayeeInput{
+		UserID:  userID,
+		PayeeID: payeeID,
+	})
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": output.Message,
+		"data":    output,
+	})
+}
+
+// handleUseCaseError handles errors from use cases and returns appropriate HTTP responses.
+func (h *PayeeHandler) handleUseCaseError(c *fiber.Ctx, err error) error {
+	// Map domain errors to AppError
+	appErr := apperrors.MapDomainError(err)
+
+	// Log error with appropriate level
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound || appErr.Type == apperrors.ErrorTypeConflict {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Str("request_id", middleware.GetRequestID(c)).Msg("Payee operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Str("request_id", middleware.GetRequestID(c)).Msg("Payee operation failed")
+	}
+
+	return appErr
+}
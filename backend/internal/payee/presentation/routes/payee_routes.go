@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"gestao-financeira/backend/internal/identity/domain/repositories"
+	"gestao-financeira/backend/internal/identity/infrastructure/services"
+	"gestao-financeira/backend/internal/payee/presentation/handlers"
+	"gestao-financeira/backend/pkg/cache"
+	"gestao-financeira/backend/pkg/middleware"
+)
+
+// SetupPayeeRoutes configures payee routes.
+func SetupPayeeRoutes(router fiber.Router, payeeHandler *handlers.PayeeHandler, jwtService *services.JWTService, userRepository repositories.UserRepository, cacheService *cache.CacheService) {
+	payees := router.Group("/payees")
+
+	// Apply authentication middleware to all payee routes
+	payees.Use(middleware.AuthMiddleware(middleware.AuthMiddlewareConfig{
+		JWTService:     jwtService,
+		UserRepository: userRepository,
+		CacheService:   cacheService,
+	}))
+
+	{
+		payees.Post("/", payeeHandler.Create)
+		payees.Get("/", payeeHandler.List)
+		payees.Get("/match", payeeHandler.Match)
+		payees.Put("/:id", payeeHandler.Update)
+		payees.Delete("/:id", payeeHandler.Delete)
+	}
+}
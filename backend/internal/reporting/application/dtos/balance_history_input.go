@@ -0,0 +1,13 @@
+package dtos
+
+import "time"
+
+// BalanceHistoryInput represents the input for generating an account balance history.
+type BalanceHistoryInput struct {
+	UserID    string     `json:"user_id" validate:"required,uuid"`
+	AccountID string     `json:"account_id,omitempty" validate:"omitempty,uuid"` // Optional: a single account (default: all accounts)
+	StartDate *time.Time `json:"start_date,omitempty"`                           // Optional: first day of the series (default: 30 days before end_date)
+	EndDate   *time.Time `json:"end_date,omitempty"`                             // Optional: last day of the series (default: today)
+	Currency  string     `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
+	GroupBy   string     `json:"group_by,omitempty" validate:"omitempty,oneof=day week month"` // Optional: one point per period (default: day)
+}
@@ -0,0 +1,41 @@
+package dtos
+
+// BalanceHistoryOutput represents the balance history of the accounts of a user.
+type BalanceHistoryOutput struct {
+	UserID    string `json:"user_id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	GroupBy   string `json:"group_by"`
+
+	// One series per account
+	Accounts []AccountBalanceSeries `json:"accounts"`
+
+	// Sum of the account series, per currency
+	Totals []CurrencyBalanceSeries `json:"totals"`
+}
+
+// AccountBalanceSeries represents the balance series of an account.
+type AccountBalanceSeries struct {
+	AccountID      string         `json:"account_id"`
+	Name           string         `json:"name"`
+	Currency       string         `json:"currency"`
+	IsActive       bool           `json:"is_active"`
+	OpeningBalance float64        `json:"opening_balance"` // Balance before the transactions of start_date
+	ClosingBalance float64        `json:"closing_balance"` // Balance at the end of end_date
+	Points         []BalancePoint `json:"points"`
+}
+
+// CurrencyBalanceSeries represents the total balance series of the accounts in a currency.
+type CurrencyBalanceSeries struct {
+	Currency       string         `json:"currency"`
+	OpeningBalance float64        `json:"opening_balance"`
+	ClosingBalance float64        `json:"closing_balance"`
+	Points         []BalancePoint `json:"points"`
+}
+
+// BalancePoint represents the balance at the end of a period.
+type BalancePoint struct {
+	Period  string  `json:"period"` // Period identifier (day, Monday of the week, or month)
+	Date    string  `json:"date"`   // Last day of the period within the series
+	Balance float64 `json:"balance"`
+}
@@ -19,8 +19,8 @@ type CategoryReportOutput struct {
 
 // CategorySummary represents a summary of transactions for a category.
 type CategorySummary struct {
-	CategoryID   string  `json:"category_id,omitempty"`   // Will be populated when category_id is added to transactions
-	CategoryName string  `json:"category_name,omitempty"` // Will be populated when category_id is added to transactions
+	CategoryID   string  `json:"category_id,omitempty"`   // Empty for uncategorized transactions
+	CategoryName string  `json:"category_name,omitempty"` // "Uncategorized" for transactions without a category
 	Type         string  `json:"type"`                    // INCOME or EXPENSE
 	TotalAmount  float64 `json:"total_amount"`
 	Count        int     `json:"count"`
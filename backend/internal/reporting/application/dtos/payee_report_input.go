@@ -0,0 +1,12 @@
+package dtos
+
+import "time"
+
+// PayeeReportInput represents the input for generating a payee report.
+type PayeeReportInput struct {
+	UserID    string     `json:"user_id" validate:"required,uuid"`
+	StartDate *time.Time `json:"start_date,omitempty"` // Optional: start date filter
+	EndDate   *time.Time `json:"end_date,omitempty"`   // Optional: end date filter
+	Currency  string     `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
+	Limit     int        `json:"limit,omitempty" validate:"omitempty,min=1,max=100"` // Top payees to return (default: 10)
+}
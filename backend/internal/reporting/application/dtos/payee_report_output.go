@@ -0,0 +1,27 @@
+package dtos
+
+// PayeeReportOutput represents the output of a payee report: the payees the user spent the most with.
+type PayeeReportOutput struct {
+	UserID   string `json:"user_id"`
+	Currency string `json:"currency"`
+
+	// Top payees by total expense
+	Payees []PayeeSummary `json:"payees"`
+
+	// Expenses of the period, and the part of them without a payee
+	TotalExpense      float64 `json:"total_expense"`
+	UnassignedExpense float64 `json:"unassigned_expense"`
+
+	// Counts
+	TotalCount      int `json:"total_count"`
+	UnassignedCount int `json:"unassigned_count"`
+}
+
+// PayeeSummary represents a summary of the expenses with a payee.
+type PayeeSummary struct {
+	PayeeID      string  `json:"payee_id"`
+	PayeeName    string  `json:"payee_name"`
+	TotalExpense float64 `json:"total_expense"`
+	ExpenseCount int     `json:"expense_count"`
+	Percentage   float64 `json:"percentage"` // Share of the total expense
+}
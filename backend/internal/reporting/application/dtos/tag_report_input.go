@@ -0,0 +1,11 @@
+package dtos
+
+import "time"
+
+// TagReportInput represents the input for generating a tag report.
+type TagReportInput struct {
+	UserID    string     `json:"user_id" validate:"required,uuid"`
+	StartDate *time.Time `json:"start_date,omitempty"` // Optional: start date filter
+	EndDate   *time.Time `json:"end_date,omitempty"`   // Optional: end date filter
+	Currency  string     `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
+}
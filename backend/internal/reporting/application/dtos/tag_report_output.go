@@ -0,0 +1,29 @@
+package dtos
+
+// TagReportOutput represents the output of a tag report.
+type TagReportOutput struct {
+	UserID   string `json:"user_id"`
+	Currency string `json:"currency"`
+
+	// Summary by tag
+	Tags []TagSummary `json:"tags"`
+
+	// Totals of tagged transactions (each transaction counted once, even with several tags)
+	TotalIncome  float64 `json:"total_income"`
+	TotalExpense float64 `json:"total_expense"`
+	Balance      float64 `json:"balance"`
+
+	// Counts
+	TotalCount int `json:"total_count"`
+}
+
+// TagSummary represents a summary of the transactions with a tag.
+type TagSummary struct {
+	TagID        string  `json:"tag_id"`
+	TagName      string  `json:"tag_name"`
+	TotalIncome  float64 `json:"total_income"`
+	TotalExpense float64 `json:"total_expense"`
+	Balance      float64 `json:"balance"`
+	IncomeCount  int     `json:"income_count"`
+	ExpenseCount int     `json:"expense_count"`
+}
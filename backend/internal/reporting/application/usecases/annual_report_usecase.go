@@ -58,6 +58,11 @@ func (uc *AnnualReportUseCase) Execute(input dtos.AnnualReportInput) (*dtos.Annu
 	var filteredTransactions []transactionData
 
 	for _, tx := range allTransactions {
+		// Transfers between accounts and balance adjustments are neither income nor expense
+		if tx.TransactionType().IsTransfer() || tx.TransactionType().IsAdjustment() {
+			continue
+		}
+
 		txDate := tx.Date()
 
 		// Check if transaction is within the year
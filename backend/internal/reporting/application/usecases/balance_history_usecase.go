@@ -0,0 +1,361 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/application/dtos"
+	"gestao-financeira/backend/internal/reporting/domain/entities"
+	reportingrepositories "gestao-financeira/backend/internal/reporting/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+const (
+	// defaultBalanceHistoryDays is the length of the series when no start date is given.
+	defaultBalanceHistoryDays = 30
+	// maxBalanceHistoryDays is the longest series that can be requested (about 10 years).
+	maxBalanceHistoryDays = 3660
+)
+
+// BalanceHistoryUseCase handles generating the balance history of accounts: the balance at the
+// end of each day, week or month of a period, per account and per currency.
+//
+// The balance before the period is taken from the latest balance snapshot of the account, plus
+// the transactions dated after it. Without a snapshot, it is derived from the current balance
+// minus the transactions dated on or after the start of the period. Month-end balances of the
+// period are saved as snapshots for the next requests.
+type BalanceHistoryUseCase struct {
+	accountRepository     accountrepositories.AccountRepository
+	transactionRepository repositories.TransactionRepository
+	snapshotRepository    reportingrepositories.BalanceSnapshotRepository
+}
+
+// NewBalanceHistoryUseCase creates a new BalanceHistoryUseCase instance.
+// snapshotRepository may be nil, in which case balances are always derived from the current balance.
+func NewBalanceHistoryUseCase(
+	accountRepository accountrepositories.AccountRepository,
+	transactionRepository repositories.TransactionRepository,
+	snapshotRepository reportingrepositories.BalanceSnapshotRepository,
+) *BalanceHistoryUseCase {
+	return &BalanceHistoryUseCase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		snapshotRepository:    snapshotRepository,
+	}
+}
+
+// accountBalanceHistory holds the daily balance changes of an account over the period.
+type accountBalanceHistory struct {
+	snapshot *entities.BalanceSnapshot
+	before   int64         // Net change in cents of the transactions after the snapshot and before the start date
+	changes  map[int]int64 // Net change in cents per day of the period (0 is the start date)
+	after    int64         // Net change in cents of the transactions dated after the end date
+}
+
+// Execute generates the balance history for the specified user.
+func (uc *BalanceHistoryUseCase) Execute(input dtos.BalanceHistoryInput) (*dtos.BalanceHistoryOutput, error) {
+	// Validate user ID
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	groupBy := input.GroupBy
+	if groupBy == "" {
+		groupBy = "day"
+	}
+	if groupBy != "day" && groupBy != "week" && groupBy != "month" {
+		return nil, fmt.Errorf("invalid group_by: must be one of: day, week, month")
+	}
+
+	// Determine the period (defaults to the last 30 days)
+	today := startOfDay(time.Now())
+	endDate := today
+	if input.EndDate != nil {
+		endDate = startOfDay(*input.EndDate)
+	}
+	startDate := endDate.AddDate(0, 0, -(defaultBalanceHistoryDays - 1))
+	if input.StartDate != nil {
+		startDate = startOfDay(*input.StartDate)
+	}
+	if endDate.Before(startDate) {
+		return nil, errors.New("invalid date range: end_date must be on or after start_date")
+	}
+	days := daysBetween(startDate, endDate) + 1
+	if days > maxBalanceHistoryDays {
+		return nil, fmt.Errorf("invalid date range: must not exceed %d days", maxBalanceHistoryDays)
+	}
+
+	accounts, err := uc.findAccounts(userID, input.AccountID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the starting point of each account: the latest snapshot before the period, if any
+	histories := make(map[string]*accountBalanceHistory, len(accounts))
+	queryStart := startDate
+	queryEnd := endDate
+	for _, account := range accounts {
+		history := &accountBalanceHistory{changes: make(map[int]int64)}
+		if uc.snapshotRepository != nil {
+			snapshot, err := uc.snapshotRepository.FindLatestBefore(account.ID(), startDate)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find balance snapshot: %w", err)
+			}
+			if snapshot != nil && snapshot.Balance().Currency().Equals(account.Balance().Currency()) {
+				history.snapshot = snapshot
+			}
+		}
+
+		if history.snapshot != nil {
+			if from := history.snapshot.Date().AddDate(0, 0, 1); from.Before(queryStart) {
+				queryStart = from
+			}
+		} else {
+			// Without a snapshot, every transaction after the period is needed to get back
+			// from the current balance
+			queryEnd = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+		}
+		histories[account.ID().Value()] = history
+	}
+
+	// Get the transactions covering every account
+	if len(accounts) > 0 {
+		transactions, err := uc.transactionRepository.FindByUserIDAndDateRange(userID, queryStart, queryEnd.AddDate(0, 0, 1).Add(-time.Nanosecond))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transactions: %w", err)
+		}
+
+		for _, tx := range transactions {
+			history, ok := histories[tx.AccountID().Value()]
+			if !ok {
+				continue
+			}
+
+			amount := tx.Amount().Amount()
+			if tx.TransactionType().IsDebit() {
+				amount = -amount
+			}
+
+			day := startOfDay(tx.Date())
+			switch {
+			case day.Before(startDate):
+				if history.snapshot != nil && day.After(history.snapshot.Date()) {
+					history.before += amount
+				}
+			case day.After(endDate):
+				history.after += amount
+			default:
+				history.changes[daysBetween(startDate, day)] += amount
+			}
+		}
+	}
+
+	// Build the series of each account
+	output := &dtos.BalanceHistoryOutput{
+		UserID:    input.UserID,
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   endDate.Format("2006-01-02"),
+		GroupBy:   groupBy,
+		Accounts:  []dtos.AccountBalanceSeries{},
+		Totals:    []dtos.CurrencyBalanceSeries{},
+	}
+	totals := make(map[string][]int64) // Balance in cents per day of the period, per currency
+	totalOpenings := make(map[string]int64)
+	var currencies []sharedvalueobjects.Currency
+
+	for _, account := range accounts {
+		history := histories[account.ID().Value()]
+		currency := account.Balance().Currency()
+
+		var opening int64
+		if history.snapshot != nil {
+			opening = history.snapshot.Balance().Amount() + history.before
+		} else {
+			opening = account.Balance().Amount() - history.after
+			for _, change := range history.changes {
+				opening -= change
+			}
+		}
+
+		balances := make([]int64, days)
+		balance := opening
+		for i := range balances {
+			balance += history.changes[i]
+			balances[i] = balance
+
+			// Keep month-end balances of past days as snapshots for the next requests
+			day := startDate.AddDate(0, 0, i)
+			if next := day.AddDate(0, 0, 1); next.Day() == 1 && !next.After(today) {
+				uc.saveSnapshot(account, history.snapshot, day, balance)
+			}
+		}
+
+		output.Accounts = append(output.Accounts, dtos.AccountBalanceSeries{
+			AccountID:      account.ID().Value(),
+			Name:           account.Name().Value(),
+			Currency:       currency.Code(),
+			IsActive:       account.IsActive(),
+			OpeningBalance: centsToFloat64(opening, currency),
+			ClosingBalance: centsToFloat64(balance, currency),
+			Points:         balancePoints(startDate, balances, groupBy, currency),
+		})
+
+		// Add the balances to the total of the currency
+		if _, ok := totals[currency.Code()]; !ok {
+			totals[currency.Code()] = make([]int64, days)
+			currencies = append(currencies, currency)
+		}
+		totalOpenings[currency.Code()] += opening
+		for i, dayBalance := range balances {
+			totals[currency.Code()][i] += dayBalance
+		}
+	}
+
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Code() < currencies[j].Code() })
+	for _, currency := range currencies {
+		balances := totals[currency.Code()]
+		output.Totals = append(output.Totals, dtos.CurrencyBalanceSeries{
+			Currency:       currency.Code(),
+			OpeningBalance: centsToFloat64(totalOpenings[currency.Code()], currency),
+			ClosingBalance: centsToFloat64(balances[days-1], currency),
+			Points:         balancePoints(startDate, balances, groupBy, currency),
+		})
+	}
+
+	return output, nil
+}
+
+// findAccounts returns the accounts the history is generated for: the given account, or every
+// account of the user, optionally limited to a currency.
+func (uc *BalanceHistoryUseCase) findAccounts(
+	userID identityvalueobjects.UserID,
+	accountIDValue string,
+	currency string,
+) ([]*accountentities.Account, error) {
+	var accounts []*accountentities.Account
+	if accountIDValue != "" {
+		accountID, err := accountvalueobjects.NewAccountID(accountIDValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID: %w", err)
+		}
+		account, err := uc.accountRepository.FindByID(accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find account: %w", err)
+		}
+		if account == nil {
+			return nil, fmt.Errorf("account not found: %s", accountIDValue)
+		}
+		if !account.UserID().Equals(userID) {
+			return nil, apperrors.NewForbiddenError("account does not belong to user")
+		}
+		accounts = append(accounts, account)
+	} else {
+		userAccounts, err := uc.accountRepository.FindByUserID(userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find accounts: %w", err)
+		}
+		accounts = userAccounts
+	}
+
+	if currency == "" {
+		return accounts, nil
+	}
+	currencyVO, err := sharedvalueobjects.NewCurrency(currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid currency: %w", err)
+	}
+	var filtered []*accountentities.Account
+	for _, account := range accounts {
+		if account.Balance().Currency().Equals(currencyVO) {
+			filtered = append(filtered, account)
+		}
+	}
+	return filtered, nil
+}
+
+// saveSnapshot saves the balance of an account at the end of a day, unless it is the snapshot the
+// history started from. Saving is best effort: a failure does not fail the report.
+func (uc *BalanceHistoryUseCase) saveSnapshot(
+	account *accountentities.Account,
+	current *entities.BalanceSnapshot,
+	day time.Time,
+	cents int64,
+) {
+	if uc.snapshotRepository == nil || (current != nil && current.Date().Equal(day)) {
+		return
+	}
+
+	balance, err := sharedvalueobjects.NewMoney(cents, account.Balance().Currency())
+	if err != nil {
+		return
+	}
+	snapshot, err := entities.NewBalanceSnapshot(account.UserID(), account.ID(), day, balance)
+	if err != nil {
+		return
+	}
+	if err := uc.snapshotRepository.Save(snapshot); err != nil {
+		// Log error but don't fail the report
+		_ = err
+	}
+}
+
+// balancePoints builds the points of a series from its daily balances: one point at the end of
+// each period, or at the end of the series for the last period.
+func balancePoints(startDate time.Time, balances []int64, groupBy string, currency sharedvalueobjects.Currency) []dtos.BalancePoint {
+	points := []dtos.BalancePoint{}
+	for i, balance := range balances {
+		day := startDate.AddDate(0, 0, i)
+		if i < len(balances)-1 && balancePeriodKey(day.AddDate(0, 0, 1), groupBy) == balancePeriodKey(day, groupBy) {
+			continue
+		}
+		points = append(points, dtos.BalancePoint{
+			Period:  balancePeriodKey(day, groupBy),
+			Date:    day.Format("2006-01-02"),
+			Balance: centsToFloat64(balance, currency),
+		})
+	}
+	return points
+}
+
+// balancePeriodKey generates the period key of a day: the day itself, the Monday of its week,
+// or its month.
+func balancePeriodKey(date time.Time, groupBy string) string {
+	switch groupBy {
+	case "week":
+		weekday := int(date.Weekday())
+		if weekday == 0 {
+			weekday = 7 // Sunday becomes 7
+		}
+		return date.AddDate(0, 0, -(weekday - 1)).Format("2006-01-02")
+	case "month":
+		return date.Format("2006-01")
+	default:
+		return date.Format("2006-01-02")
+	}
+}
+
+// startOfDay returns the start of the UTC day of a date.
+func startOfDay(date time.Time) time.Time {
+	date = date.UTC()
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days from one start of day to another.
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// centsToFloat64 converts an amount in cents to a float in the currency unit.
+func centsToFloat64(cents int64, currency sharedvalueobjects.Currency) float64 {
+	money, _ := sharedvalueobjects.NewMoney(cents, currency)
+	return money.Float64()
+}
@@ -0,0 +1,250 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/application/dtos"
+	reportingentities "gestao-financeira/backend/internal/reporting/domain/entities"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockAccountRepository is a mock AccountRepository that only supports FindByID and FindByUserID.
+type mockAccountRepository struct {
+	accountrepositories.AccountRepository
+	accounts []*accountentities.Account
+}
+
+func (m *mockAccountRepository) FindByID(id accountvalueobjects.AccountID) (*accountentities.Account, error) {
+	for _, account := range m.accounts {
+		if account.ID().Equals(id) {
+			return account, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAccountRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*accountentities.Account, error) {
+	var result []*accountentities.Account
+	for _, account := range m.accounts {
+		if account.UserID().Equals(userID) {
+			result = append(result, account)
+		}
+	}
+	return result, nil
+}
+
+// mockBalanceSnapshotRepository is an in-memory BalanceSnapshotRepository.
+type mockBalanceSnapshotRepository struct {
+	snapshots []*reportingentities.BalanceSnapshot
+	saved     []*reportingentities.BalanceSnapshot
+}
+
+func (m *mockBalanceSnapshotRepository) FindLatestBefore(accountID accountvalueobjects.AccountID, date time.Time) (*reportingentities.BalanceSnapshot, error) {
+	var latest *reportingentities.BalanceSnapshot
+	for _, snapshot := range m.snapshots {
+		if snapshot.AccountID().Equals(accountID) && snapshot.Date().Before(date) &&
+			(latest == nil || snapshot.Date().After(latest.Date())) {
+			latest = snapshot
+		}
+	}
+	return latest, nil
+}
+
+func (m *mockBalanceSnapshotRepository) Save(snapshot *reportingentities.BalanceSnapshot) error {
+	m.saved = append(m.saved, snapshot)
+	return nil
+}
+
+func TestBalanceHistoryUseCase_Execute(t *testing.T) {
+	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	usd := sharedvalueobjects.MustCurrency("USD")
+	day := func(month time.Month, d int) *time.Time {
+		date := time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+
+	newAccount := func(owner identityvalueobjects.UserID, name string, cents int64, currency sharedvalueobjects.Currency) *accountentities.Account {
+		balance, _ := sharedvalueobjects.NewMoney(cents, currency)
+		account, err := accountentities.AccountFromPersistence(
+			accountvalueobjects.GenerateAccountID(),
+			owner,
+			accountvalueobjects.MustAccountName(name),
+			accountvalueobjects.MustAccountType("BANK"),
+			balance,
+			sharedvalueobjects.PersonalContext(),
+			time.Now(), time.Now(), true,
+		)
+		if err != nil {
+			t.Fatalf("Failed to create account: %v", err)
+		}
+		return account
+	}
+	newTransaction := func(account *accountentities.Account, txType string, cents int64, date *time.Time) *entities.Transaction {
+		amount, _ := sharedvalueobjects.NewMoney(cents, account.Balance().Currency())
+		tx, err := entities.NewTransaction(
+			userID,
+			account.ID(),
+			transactionvalueobjects.MustTransactionType(txType),
+			amount,
+			transactionvalueobjects.MustTransactionDescription("Transaction"),
+			*date,
+		)
+		if err != nil {
+			t.Fatalf("Failed to create transaction: %v", err)
+		}
+		return tx
+	}
+
+	// Checking: 1000.00 on Sep 1, +1000.00 on Sep 5, -300.00 on Sep 20, -200.00 on Oct 5 = 1500.00 today
+	checking := newAccount(userID, "Checking", 150000, brl)
+	savings := newAccount(userID, "Savings", 50000, brl)
+	travel := newAccount(userID, "Travel", 20000, usd)
+	mockTransactions := &mockTransactionRepository{
+		transactions: []*entities.Transaction{
+			newTransaction(checking, "INCOME", 100000, day(9, 5)),
+			newTransaction(checking, "EXPENSE", 30000, day(9, 20)),
+			newTransaction(checking, "EXPENSE", 20000, day(10, 5)),
+			newTransaction(savings, "INCOME", 10000, day(9, 10)),
+		},
+	}
+	mockAccounts := &mockAccountRepository{accounts: []*accountentities.Account{
+		checking, savings, travel, newAccount(identityvalueobjects.GenerateUserID(), "Other", 999900, brl),
+	}}
+
+	t.Run("daily series of an account from its current balance", func(t *testing.T) {
+		useCase := NewBalanceHistoryUseCase(mockAccounts, mockTransactions, nil)
+		output, err := useCase.Execute(dtos.BalanceHistoryInput{
+			UserID:    userID.Value(),
+			AccountID: checking.ID().Value(),
+			StartDate: day(9, 1),
+			EndDate:   day(9, 30),
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		if len(output.Accounts) != 1 {
+			t.Fatalf("expected 1 account, got %d", len(output.Accounts))
+		}
+		series := output.Accounts[0]
+		if series.OpeningBalance != 1000.00 || series.ClosingBalance != 1700.00 {
+			t.Errorf("expected opening 1000.00 and closing 1700.00, got %.2f and %.2f", series.OpeningBalance, series.ClosingBalance)
+		}
+		if len(series.Points) != 30 {
+			t.Fatalf("expected 30 daily points, got %d", len(series.Points))
+		}
+		for _, expected := range []struct {
+			index   int
+			date    string
+			balance float64
+		}{{0, "2025-09-01", 1000.00}, {4, "2025-09-05", 2000.00}, {19, "2025-09-20", 1700.00}} {
+			point := series.Points[expected.index]
+			if point.Date != expected.date || point.Period != expected.date || point.Balance != expected.balance {
+				t.Errorf("point %d = %+v, want %.2f on %s", expected.index, point, expected.balance, expected.date)
+			}
+		}
+	})
+
+	t.Run("monthly series with totals per currency", func(t *testing.T) {
+		useCase := NewBalanceHistoryUseCase(mockAccounts, mockTransactions, nil)
+		output, err := useCase.Execute(dtos.BalanceHistoryInput{
+			UserID:    userID.Value(),
+			StartDate: day(8, 15),
+			EndDate:   day(10, 10),
+			GroupBy:   "month",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		if len(output.Accounts) != 3 {
+			t.Fatalf("expected the 3 accounts of the user, got %d", len(output.Accounts))
+		}
+		if len(output.Totals) != 2 || output.Totals[0].Currency != "BRL" || output.Totals[1].Currency != "USD" {
+			t.Fatalf("expected BRL and USD totals, got %+v", output.Totals)
+		}
+
+		brlTotal := output.Totals[0]
+		expected := []dtos.BalancePoint{
+			{Period: "2025-08", Date: "2025-08-31", Balance: 1400.00},
+			{Period: "2025-09", Date: "2025-09-30", Balance: 2200.00},
+			{Period: "2025-10", Date: "2025-10-10", Balance: 2000.00},
+		}
+		if len(brlTotal.Points) != len(expected) {
+			t.Fatalf("expected %d monthly points, got %+v", len(expected), brlTotal.Points)
+		}
+		for i, point := range brlTotal.Points {
+			if point != expected[i] {
+				t.Errorf("point %d = %+v, want %+v", i, point, expected[i])
+			}
+		}
+		if brlTotal.OpeningBalance != 1400.00 || brlTotal.ClosingBalance != 2000.00 {
+			t.Errorf("expected BRL total from 1400.00 to 2000.00, got %.2f to %.2f", brlTotal.OpeningBalance, brlTotal.ClosingBalance)
+		}
+		if output.Totals[1].ClosingBalance != 200.00 {
+			t.Errorf("expected USD total 200.00, got %.2f", output.Totals[1].ClosingBalance)
+		}
+	})
+
+	t.Run("weekly series starts from a snapshot and saves month ends", func(t *testing.T) {
+		// The snapshot balance wins over the current balance
+		snapshotBalance, _ := sharedvalueobjects.NewMoney(500000, brl)
+		snapshot, _ := reportingentities.NewBalanceSnapshot(userID, checking.ID(), *day(8, 31), snapshotBalance)
+		snapshots := &mockBalanceSnapshotRepository{snapshots: []*reportingentities.BalanceSnapshot{snapshot}}
+
+		useCase := NewBalanceHistoryUseCase(mockAccounts, mockTransactions, snapshots)
+		output, err := useCase.Execute(dtos.BalanceHistoryInput{
+			UserID:    userID.Value(),
+			AccountID: checking.ID().Value(),
+			StartDate: day(9, 10),
+			EndDate:   day(10, 1),
+			GroupBy:   "week",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		series := output.Accounts[0]
+		if series.OpeningBalance != 6000.00 || series.ClosingBalance != 5700.00 {
+			t.Errorf("expected opening 6000.00 and closing 5700.00, got %.2f and %.2f", series.OpeningBalance, series.ClosingBalance)
+		}
+		// Weeks start on Monday: Sep 8, 15, 22 and 29
+		if len(series.Points) != 4 || series.Points[0].Period != "2025-09-08" || series.Points[0].Date != "2025-09-14" ||
+			series.Points[3].Period != "2025-09-29" || series.Points[3].Date != "2025-10-01" {
+			t.Errorf("unexpected weekly points %+v", series.Points)
+		}
+
+		if len(snapshots.saved) != 1 || snapshots.saved[0].Date().Format("2006-01-02") != "2025-09-30" ||
+			snapshots.saved[0].Balance().Amount() != 570000 {
+			t.Errorf("expected a 5700.00 snapshot for 2025-09-30, got %+v", snapshots.saved)
+		}
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		useCase := NewBalanceHistoryUseCase(mockAccounts, mockTransactions, nil)
+
+		_, err := useCase.Execute(dtos.BalanceHistoryInput{UserID: userID.Value(), StartDate: day(9, 30), EndDate: day(9, 1)})
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid date range") {
+			t.Errorf("expected an invalid date range error, got %v", err)
+		}
+
+		_, err = useCase.Execute(dtos.BalanceHistoryInput{UserID: userID.Value(), AccountID: mockAccounts.accounts[3].ID().Value()})
+		if err == nil || !strings.Contains(err.Error(), "does not belong to user") {
+			t.Errorf("expected a forbidden error for another user's account, got %v", err)
+		}
+
+		_, err = useCase.Execute(dtos.BalanceHistoryInput{UserID: userID.Value(), AccountID: accountvalueobjects.GenerateAccountID().Value()})
+		if err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+}
@@ -2,31 +2,40 @@ package usecases
 
 import (
 	"fmt"
+	"sort"
 
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	"gestao-financeira/backend/internal/reporting/application/dtos"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
 	"gestao-financeira/backend/internal/transaction/domain/repositories"
 )
 
+// uncategorizedName is the category name reported for transactions without a category.
+const uncategorizedName = "Uncategorized"
+
 // CategoryReportUseCase handles generating category-based financial reports.
-// Note: Currently, transactions don't have category_id. This use case groups by transaction type.
-// When category_id is added to transactions, this will be enhanced to group by category.
+// Transactions are grouped by category and type; uncategorized transactions are grouped by type only.
 type CategoryReportUseCase struct {
 	transactionRepository repositories.TransactionRepository
+	categoryRepository    categoryrepositories.CategoryRepository
 }
 
 // NewCategoryReportUseCase creates a new CategoryReportUseCase instance.
+// categoryRepository is used to resolve category names and may be nil.
 func NewCategoryReportUseCase(
 	transactionRepository repositories.TransactionRepository,
+	categoryRepository categoryrepositories.CategoryRepository,
 ) *CategoryReportUseCase {
 	return &CategoryReportUseCase{
 		transactionRepository: transactionRepository,
+		categoryRepository:    categoryRepository,
 	}
 }
 
 // Execute generates a category report for the specified user.
-// Currently groups by transaction type (INCOME/EXPENSE) since transactions don't have category_id yet.
 func (uc *CategoryReportUseCase) Execute(input dtos.CategoryReportInput) (*dtos.CategoryReportOutput, error) {
 	// Validate user ID
 	userID, err := identityvalueobjects.NewUserID(input.UserID)
@@ -34,6 +43,13 @@ func (uc *CategoryReportUseCase) Execute(input dtos.CategoryReportInput) (*dtos.
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	// Validate category filter if specified
+	if input.CategoryID != "" {
+		if _, err := categoryvalueobjects.NewCategoryID(input.CategoryID); err != nil {
+			return nil, fmt.Errorf("invalid category ID: %w", err)
+		}
+	}
+
 	// Get all transactions for the user
 	allTransactions, err := uc.transactionRepository.FindByUserID(userID)
 	if err != nil {
@@ -42,14 +58,21 @@ func (uc *CategoryReportUseCase) Execute(input dtos.CategoryReportInput) (*dtos.
 
 	// Filter transactions by date range and currency (if specified)
 	type transactionData struct {
-		Type     string
-		Amount   int64
-		Currency string
+		TransactionID string
+		CategoryID    string
+		Type          string
+		Amount        int64
+		Currency      string
 	}
 
 	var filteredTransactions []transactionData
 
 	for _, tx := range allTransactions {
+		// Transfers between accounts and balance adjustments are neither income nor expense
+		if tx.TransactionType().IsTransfer() || tx.TransactionType().IsAdjustment() {
+			continue
+		}
+
 		// Filter by date range if specified
 		if input.StartDate != nil && tx.Date().Before(*input.StartDate) {
 			continue
@@ -66,14 +89,22 @@ func (uc *CategoryReportUseCase) Execute(input dtos.CategoryReportInput) (*dtos.
 			}
 		}
 
+		// Split transactions contribute one entry per split line, each under its own category
 		txType := tx.TransactionType()
-		amount := tx.Amount()
+		for _, line := range categoryLines(tx) {
+			// Filter by category if specified
+			if input.CategoryID != "" && line.categoryID != input.CategoryID {
+				continue
+			}
 
-		filteredTransactions = append(filteredTransactions, transactionData{
-			Type:     txType.Value(),
-			Amount:   amount.Amount(),
-			Currency: amount.Currency().Code(),
-		})
+			filteredTransactions = append(filteredTransactions, transactionData{
+				TransactionID: tx.ID().Value(),
+				CategoryID:    line.categoryID,
+				Type:          txType.Value(),
+				Amount:        line.amount.Amount(),
+				Currency:      line.amount.Currency().Code(),
+			})
+		}
 	}
 
 	// Determine currency
@@ -91,83 +122,94 @@ func (uc *CategoryReportUseCase) Execute(input dtos.CategoryReportInput) (*dtos.
 		return nil, fmt.Errorf("invalid currency: %w", err)
 	}
 
-	// Group by type (INCOME/EXPENSE)
-	// TODO: When category_id is added to transactions, group by category instead
-	typeSummary := make(map[string]struct {
+	// Group by category and type (uncategorized transactions are grouped under an empty category ID)
+	type categoryKey struct {
+		CategoryID string
+		Type       string
+	}
+	categorySummary := make(map[categoryKey]struct {
 		TotalCents int64
 		Count      int
 	})
 
+	var totalIncomeCents int64 = 0
+	var totalExpenseCents int64 = 0
+	// Split lines count once per category, but only once towards the total
+	countedTransactions := make(map[string]bool)
+
 	for _, tx := range filteredTransactions {
 		// Only count transactions with matching currency
 		if tx.Currency != currency {
 			continue
 		}
 
-		summary := typeSummary[tx.Type]
+		key := categoryKey{CategoryID: tx.CategoryID, Type: tx.Type}
+		summary := categorySummary[key]
 		summary.TotalCents += tx.Amount
 		summary.Count++
-		typeSummary[tx.Type] = summary
-	}
+		categorySummary[key] = summary
 
-	// Calculate totals
-	var totalIncomeCents int64 = 0
-	var totalExpenseCents int64 = 0
-	totalCount := 0
-
-	incomeSummary := typeSummary["INCOME"]
-	expenseSummary := typeSummary["EXPENSE"]
-
-	totalIncomeCents = incomeSummary.TotalCents
-	totalExpenseCents = expenseSummary.TotalCents
-	totalCount = incomeSummary.Count + expenseSummary.Count
+		if tx.Type == "INCOME" {
+			totalIncomeCents += tx.Amount
+		} else if tx.Type == "EXPENSE" {
+			totalExpenseCents += tx.Amount
+		}
+		countedTransactions[tx.TransactionID] = true
+	}
+	totalCount := len(countedTransactions)
 
 	// Convert to Money objects
 	totalIncome, _ := sharedvalueobjects.NewMoney(totalIncomeCents, currencyVO)
 	totalExpense, _ := sharedvalueobjects.NewMoney(totalExpenseCents, currencyVO)
 	balance, _ := totalIncome.Subtract(totalExpense)
 
+	// Resolve category names
+	categoryNames, err := uc.findCategoryNames(userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build category breakdown
-	// Note: Currently grouping by type since transactions don't have category_id
-	// When category_id is added, this will be enhanced to show actual categories
-	categoryBreakdown := make([]dtos.CategorySummary, 0)
+	categoryBreakdown := make([]dtos.CategorySummary, 0, len(categorySummary))
+	for key, summary := range categorySummary {
+		amount, _ := sharedvalueobjects.NewMoney(summary.TotalCents, currencyVO)
 
-	// Income summary
-	if incomeSummary.Count > 0 {
-		incomeAmount, _ := sharedvalueobjects.NewMoney(incomeSummary.TotalCents, currencyVO)
+		// Percentage is relative to the total of the same type (income or expense)
+		typeTotalCents := totalExpenseCents
+		if key.Type == "INCOME" {
+			typeTotalCents = totalIncomeCents
+		}
 		percentage := 0.0
-		if totalIncomeCents > 0 {
-			percentage = float64(incomeSummary.TotalCents) / float64(totalIncomeCents) * 100.0
+		if typeTotalCents > 0 {
+			percentage = float64(summary.TotalCents) / float64(typeTotalCents) * 100.0
 		}
 
-		categoryBreakdown = append(categoryBreakdown, dtos.CategorySummary{
-			CategoryID:   "",       // Will be populated when category_id is added
-			CategoryName: "Income", // Placeholder
-			Type:         "INCOME",
-			TotalAmount:  incomeAmount.Float64(),
-			Count:        incomeSummary.Count,
-			Percentage:   percentage,
-		})
-	}
-
-	// Expense summary
-	if expenseSummary.Count > 0 {
-		expenseAmount, _ := sharedvalueobjects.NewMoney(expenseSummary.TotalCents, currencyVO)
-		percentage := 0.0
-		if totalExpenseCents > 0 {
-			percentage = float64(expenseSummary.TotalCents) / float64(totalExpenseCents) * 100.0
+		categoryName := uncategorizedName
+		if key.CategoryID != "" {
+			categoryName = categoryNames[key.CategoryID]
 		}
 
 		categoryBreakdown = append(categoryBreakdown, dtos.CategorySummary{
-			CategoryID:   "",        // Will be populated when category_id is added
-			CategoryName: "Expense", // Placeholder
-			Type:         "EXPENSE",
-			TotalAmount:  expenseAmount.Float64(),
-			Count:        expenseSummary.Count,
+			CategoryID:   key.CategoryID,
+			CategoryName: categoryName,
+			Type:         key.Type,
+			TotalAmount:  amount.Float64(),
+			Count:        summary.Count,
 			Percentage:   percentage,
 		})
 	}
 
+	// Sort by type (INCOME first) and then by total amount (highest first)
+	sort.Slice(categoryBreakdown, func(i, j int) bool {
+		if categoryBreakdown[i].Type != categoryBreakdown[j].Type {
+			return categoryBreakdown[i].Type == "INCOME"
+		}
+		if categoryBreakdown[i].TotalAmount != categoryBreakdown[j].TotalAmount {
+			return categoryBreakdown[i].TotalAmount > categoryBreakdown[j].TotalAmount
+		}
+		return categoryBreakdown[i].CategoryID < categoryBreakdown[j].CategoryID
+	})
+
 	// Build output
 	output := &dtos.CategoryReportOutput{
 		UserID:            input.UserID,
@@ -181,3 +223,46 @@ func (uc *CategoryReportUseCase) Execute(input dtos.CategoryReportInput) (*dtos.
 
 	return output, nil
 }
+
+// categoryLine is the part of a transaction amount that belongs to a single category.
+type categoryLine struct {
+	categoryID string
+	amount     sharedvalueobjects.Money
+}
+
+// categoryLines returns the split lines of a split transaction, or a single line with the whole
+// amount under the transaction category (empty category ID if uncategorized).
+func categoryLines(tx *entities.Transaction) []categoryLine {
+	if tx.HasSplits() {
+		lines := make([]categoryLine, 0, len(tx.Splits()))
+		for _, split := range tx.Splits() {
+			lines = append(lines, categoryLine{categoryID: split.CategoryID().Value(), amount: split.Amount()})
+		}
+		return lines
+	}
+
+	categoryID := ""
+	if tx.CategoryID() != nil {
+		categoryID = tx.CategoryID().Value()
+	}
+	return []categoryLine{{categoryID: categoryID, amount: tx.Amount()}}
+}
+
+// findCategoryNames returns a map of category ID to category name for the user.
+func (uc *CategoryReportUseCase) findCategoryNames(userID identityvalueobjects.UserID) (map[string]string, error) {
+	names := make(map[string]string)
+	if uc.categoryRepository == nil {
+		return names, nil
+	}
+
+	categories, err := uc.categoryRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find categories: %w", err)
+	}
+
+	for _, category := range categories {
+		names[category.ID().Value()] = category.Name().Value()
+	}
+
+	return names, nil
+}
@@ -5,6 +5,9 @@ import (
 	"time"
 
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryentities "gestao-financeira/backend/internal/category/domain/entities"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	"gestao-financeira/backend/internal/reporting/application/dtos"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
@@ -66,7 +69,7 @@ func TestCategoryReportUseCase_Execute(t *testing.T) {
 	}
 
 	// Create use case
-	useCase := NewCategoryReportUseCase(mockRepo)
+	useCase := NewCategoryReportUseCase(mockRepo, nil)
 
 	// Execute
 	input := dtos.CategoryReportInput{
@@ -175,7 +178,7 @@ func TestCategoryReportUseCase_Execute_WithDateFilter(t *testing.T) {
 		transactions: []*entities.Transaction{incomeTx, expenseTx},
 	}
 
-	useCase := NewCategoryReportUseCase(mockRepo)
+	useCase := NewCategoryReportUseCase(mockRepo, nil)
 
 	// Filter only January
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -205,7 +208,7 @@ func TestCategoryReportUseCase_Execute_WithDateFilter(t *testing.T) {
 
 func TestCategoryReportUseCase_Execute_InvalidInput(t *testing.T) {
 	mockRepo := &mockTransactionRepository{transactions: []*entities.Transaction{}}
-	useCase := NewCategoryReportUseCase(mockRepo)
+	useCase := NewCategoryReportUseCase(mockRepo, nil)
 
 	input := dtos.CategoryReportInput{
 		UserID: "invalid",
@@ -216,3 +219,193 @@ func TestCategoryReportUseCase_Execute_InvalidInput(t *testing.T) {
 		t.Errorf("Execute() error = nil, want error")
 	}
 }
+
+// mockCategoryRepository is a mock CategoryRepository that only supports FindByUserID.
+type mockCategoryRepository struct {
+	categoryrepositories.CategoryRepository
+	categories []*categoryentities.Category
+}
+
+func (m *mockCategoryRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*categoryentities.Category, error) {
+	var result []*categoryentities.Category
+	for _, category := range m.categories {
+		if category.UserID().Equals(userID) {
+			result = append(result, category)
+		}
+	}
+	return result, nil
+}
+
+func TestCategoryReportUseCase_Execute_GroupsByCategory(t *testing.T) {
+	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
+	accountID, _ := accountvalueobjects.NewAccountID("123e4567-e89b-12d3-a456-426614174001")
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	food, _ := categoryentities.NewCategory(userID, categoryvalueobjects.MustCategoryName("Alimentação"), "")
+	rent, _ := categoryentities.NewCategory(userID, categoryvalueobjects.MustCategoryName("Moradia"), "")
+	foodID := food.ID()
+	rentID := rent.ID()
+
+	newTx := func(txType string, cents int64, categoryID *categoryvalueobjects.CategoryID) *entities.Transaction {
+		amount, _ := sharedvalueobjects.NewMoney(cents, currency)
+		tx, err := entities.NewTransactionWithParams(entities.TransactionParams{
+			UserID:      userID,
+			AccountID:   accountID,
+			Type:        transactionvalueobjects.MustTransactionType(txType),
+			Amount:      amount,
+			Description: transactionvalueobjects.MustTransactionDescription("Test transaction"),
+			Date:        date,
+			CategoryID:  categoryID,
+		})
+		if err != nil {
+			t.Fatalf("failed to create transaction: %v", err)
+		}
+		return tx
+	}
+
+	mockRepo := &mockTransactionRepository{
+		transactions: []*entities.Transaction{
+			newTx("EXPENSE", 30000, &foodID),  // R$ 300.00
+			newTx("EXPENSE", 10000, &foodID),  // R$ 100.00
+			newTx("EXPENSE", 150000, &rentID), // R$ 1500.00
+			newTx("EXPENSE", 10000, nil),      // R$ 100.00 uncategorized
+			newTx("INCOME", 500000, nil),      // R$ 5000.00 uncategorized
+		},
+	}
+	categoryRepo := &mockCategoryRepository{categories: []*categoryentities.Category{food, rent}}
+
+	useCase := NewCategoryReportUseCase(mockRepo, categoryRepo)
+
+	t.Run("groups by category", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.CategoryReportInput{UserID: userID.Value(), Currency: "BRL"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v, want nil", err)
+		}
+
+		if len(output.CategoryBreakdown) != 4 {
+			t.Fatalf("Execute() output.CategoryBreakdown length = %v, want 4", len(output.CategoryBreakdown))
+		}
+
+		// Income first, then expenses ordered by total amount
+		want := []struct {
+			categoryID   string
+			categoryName string
+			total        float64
+			count        int
+			percentage   float64
+		}{
+			{"", "Uncategorized", 5000.00, 1, 100.0},
+			{rentID.Value(), "Moradia", 1500.00, 1, 75.0},
+			{foodID.Value(), "Alimentação", 400.00, 2, 20.0},
+			{"", "Uncategorized", 100.00, 1, 5.0},
+		}
+		for i, w := range want {
+			got := output.CategoryBreakdown[i]
+			if got.CategoryID != w.categoryID || got.CategoryName != w.categoryName {
+				t.Errorf("CategoryBreakdown[%d] = %v (%v), want %v (%v)", i, got.CategoryID, got.CategoryName, w.categoryID, w.categoryName)
+			}
+			if got.TotalAmount != w.total || got.Count != w.count || got.Percentage != w.percentage {
+				t.Errorf("CategoryBreakdown[%d] = %v/%v/%v, want %v/%v/%v", i, got.TotalAmount, got.Count, got.Percentage, w.total, w.count, w.percentage)
+			}
+		}
+	})
+
+	t.Run("filters by category", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.CategoryReportInput{UserID: userID.Value(), CategoryID: foodID.Value(), Currency: "BRL"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v, want nil", err)
+		}
+
+		if len(output.CategoryBreakdown) != 1 {
+			t.Fatalf("Execute() output.CategoryBreakdown length = %v, want 1", len(output.CategoryBreakdown))
+		}
+		if output.TotalExpense != 400.00 || output.TotalIncome != 0 {
+			t.Errorf("Execute() totals = %v/%v, want 0/400", output.TotalIncome, output.TotalExpense)
+		}
+	})
+}
+
+func TestCategoryReportUseCase_Execute_SplitTransactions(t *testing.T) {
+	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
+	accountID, _ := accountvalueobjects.NewAccountID("123e4567-e89b-12d3-a456-426614174001")
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	food, _ := categoryentities.NewCategory(userID, categoryvalueobjects.MustCategoryName("Alimentação"), "")
+	home, _ := categoryentities.NewCategory(userID, categoryvalueobjects.MustCategoryName("Casa"), "")
+	foodID := food.ID()
+	homeID := home.ID()
+
+	newTx := func(cents int64, categoryID *categoryvalueobjects.CategoryID) *entities.Transaction {
+		amount, _ := sharedvalueobjects.NewMoney(cents, currency)
+		tx, err := entities.NewTransactionWithParams(entities.TransactionParams{
+			UserID:      userID,
+			AccountID:   accountID,
+			Type:        transactionvalueobjects.ExpenseType(),
+			Amount:      amount,
+			Description: transactionvalueobjects.MustTransactionDescription("Test transaction"),
+			Date:        date,
+			CategoryID:  categoryID,
+		})
+		if err != nil {
+			t.Fatalf("failed to create transaction: %v", err)
+		}
+		return tx
+	}
+	newLine := func(categoryID categoryvalueobjects.CategoryID, cents int64) transactionvalueobjects.TransactionSplit {
+		amount, _ := sharedvalueobjects.NewMoney(cents, currency)
+		line, err := transactionvalueobjects.NewTransactionSplit(categoryID, amount, "")
+		if err != nil {
+			t.Fatalf("failed to create split line: %v", err)
+		}
+		return line
+	}
+
+	// R$ 250.00 supermarket purchase: R$ 200.00 food and R$ 50.00 home
+	split := newTx(25000, nil)
+	if err := split.UpdateSplits([]transactionvalueobjects.TransactionSplit{newLine(foodID, 20000), newLine(homeID, 5000)}); err != nil {
+		t.Fatalf("failed to split transaction: %v", err)
+	}
+
+	mockRepo := &mockTransactionRepository{
+		transactions: []*entities.Transaction{
+			split,
+			newTx(10000, &foodID), // R$ 100.00
+		},
+	}
+	categoryRepo := &mockCategoryRepository{categories: []*categoryentities.Category{food, home}}
+
+	useCase := NewCategoryReportUseCase(mockRepo, categoryRepo)
+
+	t.Run("groups split lines by category", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.CategoryReportInput{UserID: userID.Value(), Currency: "BRL"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v, want nil", err)
+		}
+
+		if len(output.CategoryBreakdown) != 2 {
+			t.Fatalf("Execute() output.CategoryBreakdown length = %v, want 2", len(output.CategoryBreakdown))
+		}
+		if got := output.CategoryBreakdown[0]; got.CategoryID != foodID.Value() || got.TotalAmount != 300.00 || got.Count != 2 {
+			t.Errorf("CategoryBreakdown[0] = %v/%v/%v, want %v/300/2", got.CategoryID, got.TotalAmount, got.Count, foodID.Value())
+		}
+		if got := output.CategoryBreakdown[1]; got.CategoryID != homeID.Value() || got.TotalAmount != 50.00 || got.Count != 1 {
+			t.Errorf("CategoryBreakdown[1] = %v/%v/%v, want %v/50/1", got.CategoryID, got.TotalAmount, got.Count, homeID.Value())
+		}
+		if output.TotalExpense != 350.00 || output.TotalCount != 2 {
+			t.Errorf("Execute() totals = %v/%v, want 350/2", output.TotalExpense, output.TotalCount)
+		}
+	})
+
+	t.Run("filters by category of a split line", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.CategoryReportInput{UserID: userID.Value(), CategoryID: homeID.Value(), Currency: "BRL"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v, want nil", err)
+		}
+
+		if output.TotalExpense != 50.00 || output.TotalCount != 1 {
+			t.Errorf("Execute() totals = %v/%v, want 50/1", output.TotalExpense, output.TotalCount)
+		}
+	})
+}
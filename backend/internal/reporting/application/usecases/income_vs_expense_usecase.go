@@ -54,6 +54,11 @@ func (uc *IncomeVsExpenseUseCase) Execute(input dtos.IncomeVsExpenseInput) (*dto
 	var filteredTransactions []transactionData
 
 	for _, tx := range allTransactions {
+		// Transfers between accounts and balance adjustments are neither income nor expense
+		if tx.TransactionType().IsTransfer() || tx.TransactionType().IsAdjustment() {
+			continue
+		}
+
 		// Filter by date range if specified
 		if input.StartDate != nil && tx.Date().Before(*input.StartDate) {
 			continue
@@ -92,6 +92,11 @@ func (uc *MonthlyReportUseCase) Execute(input dtos.MonthlyReportInput) (*dtos.Mo
 
 	filteredTransactions := make([]transactionData, 0, len(transactions))
 	for _, tx := range transactions {
+		// Transfers between accounts and balance adjustments are neither income nor expense
+		if tx.TransactionType().IsTransfer() || tx.TransactionType().IsAdjustment() {
+			continue
+		}
+
 		txType := tx.TransactionType()
 		amount := tx.Amount()
 
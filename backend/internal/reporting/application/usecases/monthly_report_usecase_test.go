@@ -9,6 +9,7 @@ import (
 	"gestao-financeira/backend/internal/reporting/application/dtos"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
@@ -85,13 +86,67 @@ func (m *mockTransactionRepository) FindByUserIDAndDateRangeWithCurrency(userID
 	}
 	return result, nil
 }
+func (m *mockTransactionRepository) FindByImportBatchID(batchID transactionvalueobjects.ImportBatchID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ImportBatchID() != nil && tx.ImportBatchID().Equals(batchID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepository) FindExistingExternalIDs(accountID accountvalueobjects.AccountID, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for _, tx := range m.transactions {
+		if !tx.AccountID().Equals(accountID) || tx.ExternalID() == "" {
+			continue
+		}
+		for _, externalID := range externalIDs {
+			if tx.ExternalID() == externalID {
+				existing[externalID] = true
+			}
+		}
+	}
+	return existing, nil
+}
+func (m *mockTransactionRepository) FindUnreconciledByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.AccountID().Equals(accountID) && !tx.IsReconciled() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepository) FindByParentID(parentID transactionvalueobjects.TransactionID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ParentTransactionID() != nil && tx.ParentTransactionID().Equals(parentID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepository) FindRecurringSeriesByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.UserID().Equals(userID) && tx.IsRecurringSeries() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
 func (m *mockTransactionRepository) FindByUserIDWithPagination(userID identityvalueobjects.UserID, offset, limit int) ([]*entities.Transaction, int64, error) {
 	return nil, 0, nil
 }
-func (m *mockTransactionRepository) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, accountID string, transactionType string, offset, limit int) ([]*entities.Transaction, int64, error) {
+func (m *mockTransactionRepository) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, filter transactionrepositories.TransactionFilter, offset, limit int) ([]*entities.Transaction, int64, error) {
 	return nil, 0, nil
 }
 
+func (m *mockTransactionRepository) FindByUserIDAndFiltersWithKeyset(userID identityvalueobjects.UserID, filter transactionrepositories.TransactionFilter, keyset *transactionrepositories.TransactionKeyset, backward bool, limit int) ([]*entities.Transaction, error) {
+	return nil, nil
+}
+
 func TestMonthlyReportUseCase_Execute(t *testing.T) {
 	// Create test data
 	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
@@ -226,3 +281,109 @@ func TestMonthlyReportUseCase_Execute_InvalidInput(t *testing.T) {
 		})
 	}
 }
+
+func TestMonthlyReportUseCase_Execute_ExcludesTransfers(t *testing.T) {
+	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
+	accountID, _ := accountvalueobjects.NewAccountID("123e4567-e89b-12d3-a456-426614174001")
+	walletID, _ := accountvalueobjects.NewAccountID("123e4567-e89b-12d3-a456-426614174002")
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	incomeAmount, _ := sharedvalueobjects.NewMoney(100000, currency)  // R$ 1000.00
+	transferAmount, _ := sharedvalueobjects.NewMoney(30000, currency) // R$ 300.00
+
+	incomeTx, _ := entities.NewTransaction(
+		userID,
+		accountID,
+		transactionvalueobjects.MustTransactionType("INCOME"),
+		incomeAmount,
+		transactionvalueobjects.MustTransactionDescription("Salary"),
+		date,
+	)
+	outgoing, incoming, err := entities.NewTransfer(
+		userID,
+		accountID,
+		walletID,
+		transferAmount,
+		transferAmount,
+		transactionvalueobjects.MustTransactionDescription("Cash withdrawal"),
+		date,
+	)
+	if err != nil {
+		t.Fatalf("NewTransfer() error = %v", err)
+	}
+
+	mockRepo := &mockTransactionRepository{
+		transactions: []*entities.Transaction{outgoing, incoming, incomeTx},
+	}
+
+	monthly, err := NewMonthlyReportUseCase(mockRepo, nil).Execute(dtos.MonthlyReportInput{
+		UserID: userID.Value(),
+		Year:   2025,
+		Month:  1,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if monthly.TotalIncome != 1000.00 || monthly.TotalExpense != 0 || monthly.TotalCount != 1 {
+		t.Errorf("monthly report counted transfers: income = %v, expense = %v, count = %v",
+			monthly.TotalIncome, monthly.TotalExpense, monthly.TotalCount)
+	}
+
+	incomeVsExpense, err := NewIncomeVsExpenseUseCase(mockRepo).Execute(dtos.IncomeVsExpenseInput{
+		UserID: userID.Value(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if incomeVsExpense.TotalIncome != 1000.00 || incomeVsExpense.TotalExpense != 0 || incomeVsExpense.TotalCount != 1 {
+		t.Errorf("income vs expense report counted transfers: income = %v, expense = %v, count = %v",
+			incomeVsExpense.TotalIncome, incomeVsExpense.TotalExpense, incomeVsExpense.TotalCount)
+	}
+}
+
+func TestMonthlyReportUseCase_Execute_ExcludesBalanceAdjustments(t *testing.T) {
+	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
+	accountID, _ := accountvalueobjects.NewAccountID("123e4567-e89b-12d3-a456-426614174001")
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	expenseAmount, _ := sharedvalueobjects.NewMoney(20000, currency) // R$ 200.00
+	difference, _ := sharedvalueobjects.NewMoney(-4550, currency)    // R$ -45.50
+
+	expenseTx, _ := entities.NewTransaction(
+		userID,
+		accountID,
+		transactionvalueobjects.MustTransactionType("EXPENSE"),
+		expenseAmount,
+		transactionvalueobjects.MustTransactionDescription("Groceries"),
+		date,
+	)
+	adjustment, err := entities.NewBalanceAdjustment(
+		userID,
+		accountID,
+		difference,
+		transactionvalueobjects.MustTransactionDescription("Balance adjustment"),
+		date,
+	)
+	if err != nil {
+		t.Fatalf("NewBalanceAdjustment() error = %v", err)
+	}
+
+	mockRepo := &mockTransactionRepository{
+		transactions: []*entities.Transaction{adjustment, expenseTx},
+	}
+
+	monthly, err := NewMonthlyReportUseCase(mockRepo, nil).Execute(dtos.MonthlyReportInput{
+		UserID: userID.Value(),
+		Year:   2025,
+		Month:  1,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if monthly.TotalIncome != 0 || monthly.TotalExpense != 200.00 || monthly.TotalCount != 1 {
+		t.Errorf("monthly report counted balance adjustments: income = %v, expense = %v, count = %v",
+			monthly.TotalIncome, monthly.TotalExpense, monthly.TotalCount)
+	}
+}
@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"fmt"
+	"sort"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	"gestao-financeira/backend/internal/reporting/application/dtos"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// defaultPayeeReportLimit is the number of payees returned when no limit is given.
+const defaultPayeeReportLimit = 10
+
+// PayeeReportUseCase handles generating the top payees report: the payees the user spent the
+// most with over a period. Only expenses are counted; income and transfers are not included.
+type PayeeReportUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	payeeRepository       payeerepositories.PayeeRepository
+}
+
+// NewPayeeReportUseCase creates a new PayeeReportUseCase instance.
+// payeeRepository is used to resolve payee names and may be nil.
+func NewPayeeReportUseCase(
+	transactionRepository repositories.TransactionRepository,
+	payeeRepository payeerepositories.PayeeRepository,
+) *PayeeReportUseCase {
+	return &PayeeReportUseCase{
+		transactionRepository: transactionRepository,
+		payeeRepository:       payeeRepository,
+	}
+}
+
+// Execute generates a payee report for the specified user.
+func (uc *PayeeReportUseCase) Execute(input dtos.PayeeReportInput) (*dtos.PayeeReportOutput, error) {
+	// Validate user ID
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Determine currency
+	currency := input.Currency
+	if currency == "" {
+		currency = "BRL" // Default
+	}
+	currencyVO, err := sharedvalueobjects.NewCurrency(currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid currency: %w", err)
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultPayeeReportLimit
+	}
+
+	// Get all transactions for the user
+	allTransactions, err := uc.transactionRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	type payeeTotals struct {
+		ExpenseCents int64
+		ExpenseCount int
+	}
+	payeeSummary := make(map[string]payeeTotals)
+
+	var totalExpenseCents int64 = 0
+	var unassignedExpenseCents int64 = 0
+	totalCount := 0
+	unassignedCount := 0
+
+	for _, tx := range allTransactions {
+		if !tx.TransactionType().IsExpense() {
+			continue
+		}
+
+		// Filter by date range if specified
+		if input.StartDate != nil && tx.Date().Before(*input.StartDate) {
+			continue
+		}
+		if input.EndDate != nil && tx.Date().After(*input.EndDate) {
+			continue
+		}
+
+		// Only count transactions with matching currency
+		if !tx.Amount().Currency().Equals(currencyVO) {
+			continue
+		}
+
+		amount := tx.Amount().Amount()
+		totalExpenseCents += amount
+		totalCount++
+
+		if tx.PayeeID() == nil {
+			unassignedExpenseCents += amount
+			unassignedCount++
+			continue
+		}
+
+		summary := payeeSummary[tx.PayeeID().Value()]
+		summary.ExpenseCents += amount
+		summary.ExpenseCount++
+		payeeSummary[tx.PayeeID().Value()] = summary
+	}
+
+	// Resolve payee names
+	payeeNames, err := uc.findPayeeNames(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build payee breakdown
+	payees := make([]dtos.PayeeSummary, 0, len(payeeSummary))
+	for payeeID, summary := range payeeSummary {
+		expense, _ := sharedvalueobjects.NewMoney(summary.ExpenseCents, currencyVO)
+
+		percentage := 0.0
+		if totalExpenseCents > 0 {
+			percentage = float64(summary.ExpenseCents) / float64(totalExpenseCents) * 100.0
+		}
+
+		payees = append(payees, dtos.PayeeSummary{
+			PayeeID:      payeeID,
+			PayeeName:    payeeNames[payeeID],
+			TotalExpense: expense.Float64(),
+			ExpenseCount: summary.ExpenseCount,
+			Percentage:   percentage,
+		})
+	}
+
+	// Sort by total expense (highest first) and then by name, and keep the top payees
+	sort.Slice(payees, func(i, j int) bool {
+		if payees[i].TotalExpense != payees[j].TotalExpense {
+			return payees[i].TotalExpense > payees[j].TotalExpense
+		}
+		return payees[i].PayeeName < payees[j].PayeeName
+	})
+	if len(payees) > limit {
+		payees = payees[:limit]
+	}
+
+	// Convert to Money objects
+	totalExpense, _ := sharedvalueobjects.NewMoney(totalExpenseCents, currencyVO)
+	unassignedExpense, _ := sharedvalueobjects.NewMoney(unassignedExpenseCents, currencyVO)
+
+	// Build output
+	output := &dtos.PayeeReportOutput{
+		UserID:            input.UserID,
+		Currency:          currency,
+		Payees:            payees,
+		TotalExpense:      totalExpense.Float64(),
+		UnassignedExpense: unassignedExpense.Float64(),
+		TotalCount:        totalCount,
+		UnassignedCount:   unassignedCount,
+	}
+
+	return output, nil
+}
+
+// findPayeeNames returns a map of payee ID to payee name for the user.
+func (uc *PayeeReportUseCase) findPayeeNames(userID identityvalueobjects.UserID) (map[string]string, error) {
+	names := make(map[string]string)
+	if uc.payeeRepository == nil {
+		return names, nil
+	}
+
+	payees, err := uc.payeeRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payees: %w", err)
+	}
+
+	for _, payee := range payees {
+		names[payee.ID().Value()] = payee.Name().Value()
+	}
+
+	return names, nil
+}
@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeeentities "gestao-financeira/backend/internal/payee/domain/entities"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/application/dtos"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockPayeeRepository is a mock PayeeRepository that only supports FindByUserID.
+type mockPayeeRepository struct {
+	payeerepositories.PayeeRepository
+	payees []*payeeentities.Payee
+}
+
+func (m *mockPayeeRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*payeeentities.Payee, error) {
+	var result []*payeeentities.Payee
+	for _, payee := range m.payees {
+		if payee.UserID().Equals(userID) {
+			result = append(result, payee)
+		}
+	}
+	return result, nil
+}
+
+func TestPayeeReportUseCase_Execute(t *testing.T) {
+	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
+	accountID, _ := accountvalueobjects.NewAccountID("123e4567-e89b-12d3-a456-426614174001")
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+
+	ifood, _ := payeeentities.NewPayee(userID, payeevalueobjects.MustPayeeName("iFood"), nil, nil)
+	uber, _ := payeeentities.NewPayee(userID, payeevalueobjects.MustPayeeName("Uber"), nil, nil)
+	market, _ := payeeentities.NewPayee(userID, payeevalueobjects.MustPayeeName("Mercado"), nil, nil)
+
+	newWithPayee := func(txType string, cents int64, date time.Time, payee *payeeentities.Payee) *entities.Transaction {
+		amount, _ := sharedvalueobjects.NewMoney(cents, currency)
+		tx, err := entities.NewTransaction(
+			userID,
+			accountID,
+			transactionvalueobjects.MustTransactionType(txType),
+			amount,
+			transactionvalueobjects.MustTransactionDescription("Card purchase"),
+			date,
+		)
+		if err != nil {
+			t.Fatalf("Failed to create transaction: %v", err)
+		}
+		if payee != nil {
+			payeeID := payee.ID()
+			if err := tx.UpdatePayee(&payeeID); err != nil {
+				t.Fatalf("Failed to set payee: %v", err)
+			}
+		}
+		return tx
+	}
+
+	march := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	mockRepo := &mockTransactionRepository{
+		transactions: []*entities.Transaction{
+			newWithPayee("EXPENSE", 4500, march, ifood),
+			newWithPayee("EXPENSE", 5500, march, ifood),
+			newWithPayee("EXPENSE", 3000, march, uber),
+			newWithPayee("EXPENSE", 2000, march, market),
+			newWithPayee("EXPENSE", 5000, march, nil),                                        // no payee
+			newWithPayee("INCOME", 100000, march, ifood),                                     // refunds are not spend
+			newWithPayee("EXPENSE", 9900, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), uber), // out of the period
+		},
+	}
+	payeeRepo := &mockPayeeRepository{payees: []*payeeentities.Payee{ifood, uber, market}}
+
+	startDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	output, err := NewPayeeReportUseCase(mockRepo, payeeRepo).Execute(dtos.PayeeReportInput{
+		UserID:    userID.Value(),
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Limit:     2,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// Top two payees by spend
+	if len(output.Payees) != 2 {
+		t.Fatalf("Execute() payees = %d, want 2", len(output.Payees))
+	}
+	top, second := output.Payees[0], output.Payees[1]
+	if top.PayeeName != "iFood" || top.TotalExpense != 100.00 || top.ExpenseCount != 2 || top.Percentage != 50 {
+		t.Errorf("Execute() top payee = %+v, want iFood with 100.00 expense in 2 transactions (50%%)", top)
+	}
+	if second.PayeeName != "Uber" || second.TotalExpense != 30.00 {
+		t.Errorf("Execute() second payee = %+v, want Uber with 30.00 expense", second)
+	}
+
+	// Totals include the payees left out by the limit and the expenses without a payee
+	if output.TotalExpense != 200.00 || output.TotalCount != 5 {
+		t.Errorf("Execute() totals = %.2f expense in %d transactions, want 200.00 in 5", output.TotalExpense, output.TotalCount)
+	}
+	if output.UnassignedExpense != 50.00 || output.UnassignedCount != 1 {
+		t.Errorf("Execute() unassigned = %.2f in %d transactions, want 50.00 in 1", output.UnassignedExpense, output.UnassignedCount)
+	}
+}
+
+func TestPayeeReportUseCase_Execute_InvalidInput(t *testing.T) {
+	useCase := NewPayeeReportUseCase(&mockTransactionRepository{}, nil)
+
+	if _, err := useCase.Execute(dtos.PayeeReportInput{UserID: "invalid-uuid"}); err == nil {
+		t.Error("Execute() should fail with an invalid user ID")
+	}
+}
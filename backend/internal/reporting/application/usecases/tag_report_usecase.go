@@ -0,0 +1,179 @@
+package usecases
+
+import (
+	"fmt"
+	"sort"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/application/dtos"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// TagReportUseCase handles generating tag-based financial reports.
+// A transaction with several tags is counted under each of its tags, but only once in the totals.
+type TagReportUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	tagRepository         tagrepositories.TagRepository
+}
+
+// NewTagReportUseCase creates a new TagReportUseCase instance.
+// tagRepository is used to resolve tag names and may be nil.
+func NewTagReportUseCase(
+	transactionRepository repositories.TransactionRepository,
+	tagRepository tagrepositories.TagRepository,
+) *TagReportUseCase {
+	return &TagReportUseCase{
+		transactionRepository: transactionRepository,
+		tagRepository:         tagRepository,
+	}
+}
+
+// Execute generates a tag report for the specified user.
+func (uc *TagReportUseCase) Execute(input dtos.TagReportInput) (*dtos.TagReportOutput, error) {
+	// Validate user ID
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Determine currency
+	currency := input.Currency
+	if currency == "" {
+		currency = "BRL" // Default
+	}
+	currencyVO, err := sharedvalueobjects.NewCurrency(currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid currency: %w", err)
+	}
+
+	// Get all transactions for the user
+	allTransactions, err := uc.transactionRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	type tagTotals struct {
+		IncomeCents  int64
+		ExpenseCents int64
+		IncomeCount  int
+		ExpenseCount int
+	}
+	tagSummary := make(map[string]tagTotals)
+
+	var totalIncomeCents int64 = 0
+	var totalExpenseCents int64 = 0
+	totalCount := 0
+
+	for _, tx := range allTransactions {
+		// Only tagged transactions are reported; transfers and balance adjustments are neither
+		// income nor expense
+		if len(tx.TagIDs()) == 0 || tx.TransactionType().IsTransfer() || tx.TransactionType().IsAdjustment() {
+			continue
+		}
+
+		// Filter by date range if specified
+		if input.StartDate != nil && tx.Date().Before(*input.StartDate) {
+			continue
+		}
+		if input.EndDate != nil && tx.Date().After(*input.EndDate) {
+			continue
+		}
+
+		// Only count transactions with matching currency
+		if !tx.Amount().Currency().Equals(currencyVO) {
+			continue
+		}
+
+		amount := tx.Amount().Amount()
+		isIncome := tx.TransactionType().IsIncome()
+		for _, tagID := range tx.TagIDs() {
+			summary := tagSummary[tagID.Value()]
+			if isIncome {
+				summary.IncomeCents += amount
+				summary.IncomeCount++
+			} else {
+				summary.ExpenseCents += amount
+				summary.ExpenseCount++
+			}
+			tagSummary[tagID.Value()] = summary
+		}
+
+		if isIncome {
+			totalIncomeCents += amount
+		} else {
+			totalExpenseCents += amount
+		}
+		totalCount++
+	}
+
+	// Resolve tag names
+	tagNames, err := uc.findTagNames(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build tag breakdown
+	tags := make([]dtos.TagSummary, 0, len(tagSummary))
+	for tagID, summary := range tagSummary {
+		income, _ := sharedvalueobjects.NewMoney(summary.IncomeCents, currencyVO)
+		expense, _ := sharedvalueobjects.NewMoney(summary.ExpenseCents, currencyVO)
+		balance, _ := income.Subtract(expense)
+
+		tags = append(tags, dtos.TagSummary{
+			TagID:        tagID,
+			TagName:      tagNames[tagID],
+			TotalIncome:  income.Float64(),
+			TotalExpense: expense.Float64(),
+			Balance:      balance.Float64(),
+			IncomeCount:  summary.IncomeCount,
+			ExpenseCount: summary.ExpenseCount,
+		})
+	}
+
+	// Sort by total expense (highest first) and then by name
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].TotalExpense != tags[j].TotalExpense {
+			return tags[i].TotalExpense > tags[j].TotalExpense
+		}
+		return tags[i].TagName < tags[j].TagName
+	})
+
+	// Convert to Money objects
+	totalIncome, _ := sharedvalueobjects.NewMoney(totalIncomeCents, currencyVO)
+	totalExpense, _ := sharedvalueobjects.NewMoney(totalExpenseCents, currencyVO)
+	balance, _ := totalIncome.Subtract(totalExpense)
+
+	// Build output
+	output := &dtos.TagReportOutput{
+		UserID:       input.UserID,
+		Currency:     currency,
+		Tags:         tags,
+		TotalIncome:  totalIncome.Float64(),
+		TotalExpense: totalExpense.Float64(),
+		Balance:      balance.Float64(),
+		TotalCount:   totalCount,
+	}
+
+	return output, nil
+}
+
+// findTagNames returns a map of tag ID to tag name for the user.
+func (uc *TagReportUseCase) findTagNames(userID identityvalueobjects.UserID) (map[string]string, error) {
+	names := make(map[string]string)
+	if uc.tagRepository == nil {
+		return names, nil
+	}
+
+	tags, err := uc.tagRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tags: %w", err)
+	}
+
+	for _, tag := range tags {
+		names[tag.ID().Value()] = tag.Name().Value()
+	}
+
+	return names, nil
+}
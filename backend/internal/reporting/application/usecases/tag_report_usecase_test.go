@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/application/dtos"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagentities "gestao-financeira/backend/internal/tag/domain/entities"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockTagRepository is a mock TagRepository that only supports FindByUserID.
+type mockTagRepository struct {
+	tagrepositories.TagRepository
+	tags []*tagentities.Tag
+}
+
+func (m *mockTagRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*tagentities.Tag, error) {
+	var result []*tagentities.Tag
+	for _, tag := range m.tags {
+		if tag.UserID().Equals(userID) {
+			result = append(result, tag)
+		}
+	}
+	return result, nil
+}
+
+func TestTagReportUseCase_Execute(t *testing.T) {
+	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
+	accountID, _ := accountvalueobjects.NewAccountID("123e4567-e89b-12d3-a456-426614174001")
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+
+	trip, _ := tagentities.NewTag(userID, tagvalueobjects.MustTagName("viagem-2026"))
+	reimbursable, _ := tagentities.NewTag(userID, tagvalueobjects.MustTagName("reembolsavel"))
+
+	newTagged := func(txType string, cents int64, date time.Time, tags ...*tagentities.Tag) *entities.Transaction {
+		amount, _ := sharedvalueobjects.NewMoney(cents, currency)
+		tx, err := entities.NewTransaction(
+			userID,
+			accountID,
+			transactionvalueobjects.MustTransactionType(txType),
+			amount,
+			transactionvalueobjects.MustTransactionDescription("Tagged transaction"),
+			date,
+		)
+		if err != nil {
+			t.Fatalf("Failed to create transaction: %v", err)
+		}
+		tagIDs := make([]tagvalueobjects.TagID, 0, len(tags))
+		for _, tag := range tags {
+			tagIDs = append(tagIDs, tag.ID())
+		}
+		if err := tx.UpdateTags(tagIDs); err != nil {
+			t.Fatalf("Failed to tag transaction: %v", err)
+		}
+		return tx
+	}
+
+	march := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	mockRepo := &mockTransactionRepository{
+		transactions: []*entities.Transaction{
+			newTagged("EXPENSE", 120000, march, trip, reimbursable), // R$ 1200.00 hotel
+			newTagged("EXPENSE", 30000, march, trip),                // R$ 300.00 food
+			newTagged("INCOME", 120000, march, reimbursable),        // R$ 1200.00 reimbursement
+			newTagged("EXPENSE", 5000, march),                       // untagged
+			newTagged("EXPENSE", 9900, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), trip),
+		},
+	}
+	tagRepo := &mockTagRepository{tags: []*tagentities.Tag{trip, reimbursable}}
+
+	startDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	output, err := NewTagReportUseCase(mockRepo, tagRepo).Execute(dtos.TagReportInput{
+		UserID:    userID.Value(),
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(output.Tags) != 2 {
+		t.Fatalf("Execute() tags = %d, want 2", len(output.Tags))
+	}
+
+	// Sorted by total expense: the trip first
+	tripSummary, reimbursableSummary := output.Tags[0], output.Tags[1]
+	if tripSummary.TagName != "viagem-2026" || tripSummary.TotalExpense != 1500.00 || tripSummary.ExpenseCount != 2 {
+		t.Errorf("Execute() trip summary = %+v, want 1500.00 expense in 2 transactions", tripSummary)
+	}
+	if reimbursableSummary.TagName != "reembolsavel" || reimbursableSummary.TotalIncome != 1200.00 || reimbursableSummary.Balance != 0 {
+		t.Errorf("Execute() reimbursable summary = %+v, want 1200.00 income and 0 balance", reimbursableSummary)
+	}
+
+	// Totals count each tagged transaction once
+	if output.TotalExpense != 1500.00 || output.TotalIncome != 1200.00 || output.TotalCount != 3 {
+		t.Errorf("Execute() totals = %.2f income, %.2f expense, %d transactions, want 1200.00, 1500.00, 3",
+			output.TotalIncome, output.TotalExpense, output.TotalCount)
+	}
+}
+
+func TestTagReportUseCase_Execute_InvalidInput(t *testing.T) {
+	useCase := NewTagReportUseCase(&mockTransactionRepository{}, nil)
+
+	if _, err := useCase.Execute(dtos.TagReportInput{UserID: "invalid-uuid"}); err == nil {
+		t.Error("Execute() should fail with an invalid user ID")
+	}
+}
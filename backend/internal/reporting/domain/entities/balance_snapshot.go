@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// BalanceSnapshot records the balance of an account at the end of a day.
+// Balance histories start from the latest snapshot before the requested period instead of
+// replaying every transaction since the account was opened.
+type BalanceSnapshot struct {
+	accountID accountvalueobjects.AccountID
+	userID    identityvalueobjects.UserID
+	date      time.Time
+	balance   sharedvalueobjects.Money
+	createdAt time.Time
+}
+
+// NewBalanceSnapshot creates a snapshot of the balance of an account at the end of the given day.
+func NewBalanceSnapshot(
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	date time.Time,
+	balance sharedvalueobjects.Money,
+) (*BalanceSnapshot, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+	if accountID.IsEmpty() {
+		return nil, errors.New("account ID cannot be empty")
+	}
+	if date.IsZero() {
+		return nil, errors.New("snapshot date cannot be empty")
+	}
+
+	return &BalanceSnapshot{
+		accountID: accountID,
+		userID:    userID,
+		date:      time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		balance:   balance,
+		createdAt: time.Now(),
+	}, nil
+}
+
+// BalanceSnapshotFromPersistence recreates a BalanceSnapshot from persisted data.
+func BalanceSnapshotFromPersistence(
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	date time.Time,
+	balance sharedvalueobjects.Money,
+	createdAt time.Time,
+) *BalanceSnapshot {
+	return &BalanceSnapshot{
+		accountID: accountID,
+		userID:    userID,
+		date:      date,
+		balance:   balance,
+		createdAt: createdAt,
+	}
+}
+
+// AccountID returns the account the snapshot belongs to.
+func (s *BalanceSnapshot) AccountID() accountvalueobjects.AccountID {
+	return s.accountID
+}
+
+// UserID returns the owner of the account.
+func (s *BalanceSnapshot) UserID() identityvalueobjects.UserID {
+	return s.userID
+}
+
+// Date returns the day the balance was taken at the end of.
+func (s *BalanceSnapshot) Date() time.Time {
+	return s.date
+}
+
+// Balance returns the balance of the account at the end of the day.
+func (s *BalanceSnapshot) Balance() sharedvalueobjects.Money {
+	return s.balance
+}
+
+// CreatedAt returns when the snapshot was taken.
+func (s *BalanceSnapshot) CreatedAt() time.Time {
+	return s.createdAt
+}
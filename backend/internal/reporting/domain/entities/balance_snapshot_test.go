@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestNewBalanceSnapshot(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	balance, _ := sharedvalueobjects.NewMoneyFromString(150000, "BRL")
+
+	snapshot, err := NewBalanceSnapshot(userID, accountID, time.Date(2026, 9, 30, 18, 45, 0, 0, time.UTC), balance)
+	if err != nil {
+		t.Fatalf("NewBalanceSnapshot() error = %v", err)
+	}
+	if !snapshot.Date().Equal(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("NewBalanceSnapshot() date = %v, want the start of the day", snapshot.Date())
+	}
+	if !snapshot.Balance().Equals(balance) || !snapshot.AccountID().Equals(accountID) {
+		t.Errorf("NewBalanceSnapshot() = %s on %s, want %s on %s", snapshot.Balance().String(), snapshot.AccountID().Value(), balance.String(), accountID.Value())
+	}
+
+	if _, err := NewBalanceSnapshot(userID, accountvalueobjects.AccountID{}, time.Now(), balance); err == nil {
+		t.Error("NewBalanceSnapshot() should fail without an account")
+	}
+	if _, err := NewBalanceSnapshot(userID, accountID, time.Time{}, balance); err == nil {
+		t.Error("NewBalanceSnapshot() should fail without a date")
+	}
+}
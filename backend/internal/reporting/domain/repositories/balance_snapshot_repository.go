@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/domain/entities"
+)
+
+// BalanceSnapshotRepository defines the interface for account balance snapshot persistence.
+type BalanceSnapshotRepository interface {
+	// FindLatestBefore finds the most recent snapshot of an account dated before the given day.
+	// Snapshots outdated by a later change to the transactions they cover (a transaction created,
+	// edited or deleted on or before the snapshot date) are discarded instead of returned.
+	// Returns nil if no valid snapshot is found.
+	FindLatestBefore(accountID accountvalueobjects.AccountID, date time.Time) (*entities.BalanceSnapshot, error)
+
+	// Save saves a snapshot, replacing the snapshot of the account for the same day if any.
+	Save(snapshot *entities.BalanceSnapshot) error
+}
@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"time"
+)
+
+// BalanceSnapshotModel represents the database model for BalanceSnapshot entity.
+// This is the persistence model, separate from the domain entity.
+type BalanceSnapshotModel struct {
+	AccountID    string    `gorm:"type:uuid;primaryKey"`
+	SnapshotDate time.Time `gorm:"type:date;primaryKey"`
+	UserID       string    `gorm:"type:uuid;index;not null"`
+	Balance      int64     `gorm:"type:bigint;not null"` // Balance in cents at the end of the day
+	Currency     string    `gorm:"type:varchar(3);not null"`
+	// Signed sum in cents of the transactions of the account dated on or before the snapshot date
+	// when the snapshot was taken. A different sum today means the snapshot is outdated.
+	TransactionsTotal int64     `gorm:"type:bigint;not null"`
+	CreatedAt         time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (BalanceSnapshotModel) TableName() string {
+	return "account_balance_snapshots"
+}
+
+// transactionsTable is the table of the Transaction context that balance snapshots are checked against.
+const transactionsTable = "transactions"
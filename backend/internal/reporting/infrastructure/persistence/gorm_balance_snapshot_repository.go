@@ -0,0 +1,116 @@
+package persistence
+
+import (
+	"fmt"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/domain/entities"
+	"gestao-financeira/backend/internal/reporting/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormBalanceSnapshotRepository implements BalanceSnapshotRepository using GORM.
+type GormBalanceSnapshotRepository struct {
+	db *gorm.DB
+}
+
+// NewGormBalanceSnapshotRepository creates a new GORM balance snapshot repository.
+func NewGormBalanceSnapshotRepository(db *gorm.DB) repositories.BalanceSnapshotRepository {
+	return &GormBalanceSnapshotRepository{db: db}
+}
+
+// FindLatestBefore finds the most recent valid snapshot of an account dated before the given day.
+// Each candidate is checked against the current sum of the transactions it covers; outdated
+// snapshots are deleted.
+func (r *GormBalanceSnapshotRepository) FindLatestBefore(accountID accountvalueobjects.AccountID, date time.Time) (*entities.BalanceSnapshot, error) {
+	var models []BalanceSnapshotModel
+	if err := r.db.Where("account_id = ? AND snapshot_date < ?", accountID.Value(), date).
+		Order("snapshot_date DESC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find balance snapshots: %w", err)
+	}
+
+	for _, model := range models {
+		total, err := r.transactionsTotal(model.AccountID, model.SnapshotDate)
+		if err != nil {
+			return nil, err
+		}
+		if total == model.TransactionsTotal {
+			return r.toDomain(&model)
+		}
+
+		if err := r.db.Where("account_id = ? AND snapshot_date = ?", model.AccountID, model.SnapshotDate).
+			Delete(&BalanceSnapshotModel{}).Error; err != nil {
+			return nil, fmt.Errorf("failed to delete outdated balance snapshot: %w", err)
+		}
+	}
+
+	return nil, nil
+}
+
+// Save saves a snapshot with the current sum of the transactions it covers.
+func (r *GormBalanceSnapshotRepository) Save(snapshot *entities.BalanceSnapshot) error {
+	model := r.toModel(snapshot)
+
+	total, err := r.transactionsTotal(model.AccountID, model.SnapshotDate)
+	if err != nil {
+		return err
+	}
+	model.TransactionsTotal = total
+
+	if err := r.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to save balance snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// transactionsTotal returns the signed sum in cents of the transactions of an account dated on or
+// before the given day: income, incoming transfers and upward balance adjustments add to the
+// balance, the other types subtract.
+func (r *GormBalanceSnapshotRepository) transactionsTotal(accountID string, date time.Time) (int64, error) {
+	var total int64
+	if err := r.db.Table(transactionsTable).
+		Select("COALESCE(SUM(CASE WHEN type IN ('INCOME', 'TRANSFER_IN', 'ADJUSTMENT_IN') THEN amount ELSE -amount END), 0)").
+		Where("account_id = ? AND date < ? AND deleted_at IS NULL", accountID, date.AddDate(0, 0, 1)).
+		Scan(&total).Error; err != nil {
+		return 0, fmt.Errorf("failed to sum account transactions: %w", err)
+	}
+	return total, nil
+}
+
+// toDomain converts a BalanceSnapshotModel to a BalanceSnapshot entity.
+func (r *GormBalanceSnapshotRepository) toDomain(model *BalanceSnapshotModel) (*entities.BalanceSnapshot, error) {
+	accountID, err := accountvalueobjects.NewAccountID(model.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	balance, err := sharedvalueobjects.NewMoneyFromString(model.Balance, model.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balance: %w", err)
+	}
+
+	return entities.BalanceSnapshotFromPersistence(userID, accountID, model.SnapshotDate, balance, model.CreatedAt), nil
+}
+
+// toModel converts a BalanceSnapshot entity to a BalanceSnapshotModel.
+func (r *GormBalanceSnapshotRepository) toModel(snapshot *entities.BalanceSnapshot) *BalanceSnapshotModel {
+	balance := snapshot.Balance()
+	return &BalanceSnapshotModel{
+		AccountID:    snapshot.AccountID().Value(),
+		SnapshotDate: snapshot.Date(),
+		UserID:       snapshot.UserID().Value(),
+		Balance:      balance.Amount(),
+		Currency:     balance.Currency().Code(),
+		CreatedAt:    snapshot.CreatedAt(),
+	}
+}
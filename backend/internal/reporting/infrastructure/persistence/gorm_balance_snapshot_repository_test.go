@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/reporting/domain/entities"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupBalanceSnapshotTestDB creates an in-memory SQLite database for testing.
+// A minimal transactions table is created by hand, since its model lives in the Transaction context.
+func setupBalanceSnapshotTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&BalanceSnapshotModel{}); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+	if err := db.Exec("CREATE TABLE transactions (id TEXT PRIMARY KEY, account_id TEXT, type TEXT, amount INTEGER, date DATETIME, deleted_at DATETIME)").Error; err != nil {
+		t.Fatalf("Failed to create transactions table: %v", err)
+	}
+
+	return db
+}
+
+// insertTestTransaction inserts a transaction row and returns its ID.
+func insertTestTransaction(t *testing.T, db *gorm.DB, accountID accountvalueobjects.AccountID, transactionType string, amount int64, date time.Time) string {
+	id := uuid.New().String()
+	if err := db.Exec("INSERT INTO transactions (id, account_id, type, amount, date) VALUES (?, ?, ?, ?, ?)",
+		id, accountID.Value(), transactionType, amount, date).Error; err != nil {
+		t.Fatalf("Failed to insert transaction: %v", err)
+	}
+	return id
+}
+
+func TestGormBalanceSnapshotRepository_FindLatestBefore(t *testing.T) {
+	db := setupBalanceSnapshotTestDB(t)
+	repo := NewGormBalanceSnapshotRepository(db)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC) }
+
+	insertTestTransaction(t, db, accountID, "INCOME", 100000, day(8, 5))
+	insertTestTransaction(t, db, accountID, "EXPENSE", 30000, day(9, 10))
+
+	for _, snapshotDay := range []time.Time{day(8, 31), day(9, 30)} {
+		balance, _ := sharedvalueobjects.NewMoney(100000, brl)
+		if snapshotDay.Month() == 9 {
+			balance, _ = sharedvalueobjects.NewMoney(70000, brl)
+		}
+		snapshot, _ := entities.NewBalanceSnapshot(userID, accountID, snapshotDay, balance)
+		if err := repo.Save(snapshot); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	found, err := repo.FindLatestBefore(accountID, day(10, 1))
+	if err != nil {
+		t.Fatalf("FindLatestBefore() error = %v", err)
+	}
+	if found == nil || !found.Date().Equal(day(9, 30)) || found.Balance().Amount() != 70000 {
+		t.Fatalf("FindLatestBefore() = %+v, want the September snapshot", found)
+	}
+
+	found, _ = repo.FindLatestBefore(accountID, day(9, 30))
+	if found == nil || !found.Date().Equal(day(8, 31)) {
+		t.Fatalf("FindLatestBefore() should only return snapshots before the given day, got %+v", found)
+	}
+
+	// A transaction backdated into September outdates the September snapshot only
+	insertTestTransaction(t, db, accountID, "EXPENSE", 5000, day(9, 20).Add(15*time.Hour))
+
+	found, _ = repo.FindLatestBefore(accountID, day(10, 1))
+	if found == nil || !found.Date().Equal(day(8, 31)) {
+		t.Fatalf("FindLatestBefore() should skip the outdated snapshot, got %+v", found)
+	}
+
+	var count int64
+	db.Model(&BalanceSnapshotModel{}).Count(&count)
+	if count != 1 {
+		t.Errorf("expected the outdated snapshot to be deleted, %d snapshots left", count)
+	}
+
+	if found, _ := repo.FindLatestBefore(accountvalueobjects.GenerateAccountID(), day(10, 1)); found != nil {
+		t.Errorf("FindLatestBefore() should return nil for an account without snapshots")
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"gestao-financeira/backend/internal/reporting/application/dtos"
 	"gestao-financeira/backend/internal/reporting/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
 	"gestao-financeira/backend/pkg/middleware"
 	"gestao-financeira/backend/pkg/validator"
 )
@@ -19,6 +20,9 @@ type ReportHandler struct {
 	annualReportUseCase    *usecases.AnnualReportUseCase
 	categoryReportUseCase  *usecases.CategoryReportUseCase
 	incomeVsExpenseUseCase *usecases.IncomeVsExpenseUseCase
+	tagReportUseCase       *usecases.TagReportUseCase
+	payeeReportUseCase     *usecases.PayeeReportUseCase
+	balanceHistoryUseCase  *usecases.BalanceHistoryUseCase
 }
 
 // NewReportHandler creates a new ReportHandler instance.
@@ -27,12 +31,18 @@ func NewReportHandler(
 	annualReportUseCase *usecases.AnnualReportUseCase,
 	categoryReportUseCase *usecases.CategoryReportUseCase,
 	incomeVsExpenseUseCase *usecases.IncomeVsExpenseUseCase,
+	tagReportUseCase *usecases.TagReportUseCase,
+	payeeReportUseCase *usecases.PayeeReportUseCase,
+	balanceHistoryUseCase *usecases.BalanceHistoryUseCase,
 ) *ReportHandler {
 	return &ReportHandler{
 		monthlyReportUseCase:   monthlyReportUseCase,
 		annualReportUseCase:    annualReportUseCase,
 		categoryReportUseCase:  categoryReportUseCase,
 		incomeVsExpenseUseCase: incomeVsExpenseUseCase,
+		tagReportUseCase:       tagReportUseCase,
+		payeeReportUseCase:     payeeReportUseCase,
+		balanceHistoryUseCase:  balanceHistoryUseCase,
 	}
 }
 
@@ -183,7 +193,7 @@ func (h *ReportHandler) GetAnnualReport(c *fiber.Ctx) error {
 
 // GetCategoryReport handles category report requests.
 // @Summary Get category report
-// @Description Generates a category-based financial report for the authenticated user
+// @Description Generates a category-based financial report for the authenticated user. Transactions are grouped by category and type; uncategorized transactions are reported as "Uncategorized".
 // @Tags reports
 // @Accept json
 // @Produce json
@@ -339,6 +349,268 @@ func (h *ReportHandler) GetIncomeVsExpense(c *fiber.Ctx) error {
 	})
 }
 
+// GetTagReport handles tag report requests.
+// @Summary Get tag report
+// @Description Generates a tag-based financial report for the authenticated user with income and expense totals per tag. A transaction with several tags is counted under each tag, but only once in the overall totals. Untagged transactions and transfers are not included.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param start_date query string false "Start date filter (YYYY-MM-DD)"
+// @Param end_date query string false "End date filter (YYYY-MM-DD)"
+// @Param currency query string false "Currency filter (BRL, USD, EUR; default: BRL)"
+// @Success 200 {object} dtos.TagReportOutput "Tag report data"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid input data"
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /reports/tags [get]
+func (h *ReportHandler) GetTagReport(c *fiber.Ctx) error {
+	// Get user ID from context
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse query parameters
+	startDateStr := c.Query("start_date")
+	endDateStr := c.Query("end_date")
+	currency := c.Query("currency")
+
+	// Build input
+	input := dtos.TagReportInput{
+		UserID:   userID,
+		Currency: currency,
+	}
+
+	// Parse dates if provided
+	if startDateStr != "" {
+		startDate, err := time.Parse("2006-01-02", startDateStr)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid start_date format (expected YYYY-MM-DD)",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+		input.StartDate = &startDate
+	}
+
+	if endDateStr != "" {
+		endDate, err := time.Parse("2006-01-02", endDateStr)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid end_date format (expected YYYY-MM-DD)",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+		input.EndDate = &endDate
+	}
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.tagReportUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"data": output,
+	})
+}
+
+// GetPayeeReport handles top payees report requests.
+// @Summary Get payee report
+// @Description Generates the top payees report for the authenticated user: the payees with the highest expense totals over a period. Income and transfers are not included; expenses without a payee are reported in unassigned_expense.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param start_date query string false "Start date filter (YYYY-MM-DD)"
+// @Param end_date query string false "End date filter (YYYY-MM-DD)"
+// @Param currency query string false "Currency filter (BRL, USD, EUR; default: BRL)"
+// @Param limit query int false "Number of payees to return (1-100; default: 10)"
+// @Success 200 {object} dtos.PayeeReportOutput "Payee report data"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid input data"
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /reports/payees [get]
+func (h *ReportHandler) GetPayeeReport(c *fiber.Ctx) error {
+	// Get user ID from context
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse query parameters
+	startDateStr := c.Query("start_date")
+	endDateStr := c.Query("end_date")
+	currency := c.Query("currency")
+	limitStr := c.Query("limit")
+
+	// Build input
+	input := dtos.PayeeReportInput{
+		UserID:   userID,
+		Currency: currency,
+	}
+
+	// Parse dates if provided
+	if startDateStr != "" {
+		startDate, err := time.Parse("2006-01-02", startDateStr)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid start_date format (expected YYYY-MM-DD)",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+		input.StartDate = &startDate
+	}
+
+	if endDateStr != "" {
+		endDate, err := time.Parse("2006-01-02", endDateStr)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid end_date format (expected YYYY-MM-DD)",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+		input.EndDate = &endDate
+	}
+
+	if limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid limit (expected a number)",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+		input.Limit = limit
+	}
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.payeeReportUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"data": output,
+	})
+}
+
+// GetBalanceHistory handles account balance history requests.
+// @Summary Get balance history
+// @Description Returns the balance at the end of each day, week or month of a period, per account and totalled per currency, for balance charts. Balances are derived from the transaction history; month-end balances are kept as snapshots so later requests do not replay older transactions.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param account_id query string false "Account ID (default: all accounts)"
+// @Param start_date query string false "First day of the series (YYYY-MM-DD; default: 30 days before end_date)"
+// @Param end_date query string false "Last day of the series (YYYY-MM-DD; default: today)"
+// @Param currency query string false "Currency filter (BRL, USD, EUR)"
+// @Param group_by query string false "One point per period (day, week, month; default: day)"
+// @Success 200 {object} dtos.BalanceHistoryOutput "Balance history data"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid input data"
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token"
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user"
+// @Failure 404 {object} map[string]interface{} "Account not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /reports/balance-history [get]
+func (h *ReportHandler) GetBalanceHistory(c *fiber.Ctx) error {
+	// Get user ID from context
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse query parameters
+	startDateStr := c.Query("start_date")
+	endDateStr := c.Query("end_date")
+
+	// Build input
+	input := dtos.BalanceHistoryInput{
+		UserID:    userID,
+		AccountID: c.Query("account_id"),
+		Currency:  c.Query("currency"),
+		GroupBy:   c.Query("group_by"),
+	}
+
+	// Parse dates if provided
+	if startDateStr != "" {
+		startDate, err := time.Parse("2006-01-02", startDateStr)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid start_date format (expected YYYY-MM-DD)",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+		input.StartDate = &startDate
+	}
+
+	if endDateStr != "" {
+		endDate, err := time.Parse("2006-01-02", endDateStr)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid end_date format (expected YYYY-MM-DD)",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+		input.EndDate = &endDate
+	}
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.balanceHistoryUseCase.Execute(input)
+	if err != nil {
+		return h.handleBalanceHistoryError(err)
+	}
+
+	// Return success response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"data": output,
+	})
+}
+
+// handleBalanceHistoryError maps errors from BalanceHistoryUseCase to AppError, since an invalid
+// period or an account of another user are client errors rather than report failures.
+func (h *ReportHandler) handleBalanceHistoryError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+
+	// Log error with appropriate level
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound || appErr.Type == apperrors.ErrorTypeForbidden {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Balance history failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Balance history failed")
+	}
+
+	// Return error - the middleware will handle the response formatting
+	return appErr
+}
+
 // handleUseCaseError handles errors from use cases.
 func (h *ReportHandler) handleUseCaseError(c *fiber.Ctx, err error) error {
 	requestID := middleware.GetRequestID(c)
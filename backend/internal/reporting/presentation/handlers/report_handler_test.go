@@ -14,6 +14,7 @@ import (
 	"gestao-financeira/backend/internal/reporting/application/usecases"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 	"time"
 )
@@ -91,13 +92,67 @@ func (m *mockTransactionRepositoryForReports) FindByUserIDAndDateRangeWithCurren
 	}
 	return result, nil
 }
+func (m *mockTransactionRepositoryForReports) FindByImportBatchID(batchID transactionvalueobjects.ImportBatchID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ImportBatchID() != nil && tx.ImportBatchID().Equals(batchID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepositoryForReports) FindExistingExternalIDs(accountID accountvalueobjects.AccountID, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for _, tx := range m.transactions {
+		if !tx.AccountID().Equals(accountID) || tx.ExternalID() == "" {
+			continue
+		}
+		for _, externalID := range externalIDs {
+			if tx.ExternalID() == externalID {
+				existing[externalID] = true
+			}
+		}
+	}
+	return existing, nil
+}
+func (m *mockTransactionRepositoryForReports) FindUnreconciledByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.AccountID().Equals(accountID) && !tx.IsReconciled() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepositoryForReports) FindByParentID(parentID transactionvalueobjects.TransactionID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ParentTransactionID() != nil && tx.ParentTransactionID().Equals(parentID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepositoryForReports) FindRecurringSeriesByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.UserID().Equals(userID) && tx.IsRecurringSeries() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
 func (m *mockTransactionRepositoryForReports) FindByUserIDWithPagination(userID identityvalueobjects.UserID, offset, limit int) ([]*entities.Transaction, int64, error) {
 	return nil, 0, nil
 }
-func (m *mockTransactionRepositoryForReports) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, accountID string, transactionType string, offset, limit int) ([]*entities.Transaction, int64, error) {
+func (m *mockTransactionRepositoryForReports) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, filter transactionrepositories.TransactionFilter, offset, limit int) ([]*entities.Transaction, int64, error) {
 	return nil, 0, nil
 }
 
+func (m *mockTransactionRepositoryForReports) FindByUserIDAndFiltersWithKeyset(userID identityvalueobjects.UserID, filter transactionrepositories.TransactionFilter, keyset *transactionrepositories.TransactionKeyset, backward bool, limit int) ([]*entities.Transaction, error) {
+	return nil, nil
+}
+
 func TestReportHandler_GetMonthlyReport(t *testing.T) {
 	// Create test data
 	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
@@ -132,11 +187,11 @@ func TestReportHandler_GetMonthlyReport(t *testing.T) {
 	// Create use cases
 	monthlyUseCase := usecases.NewMonthlyReportUseCase(mockRepo, nil) // nil cache for tests
 	annualUseCase := usecases.NewAnnualReportUseCase(mockRepo)
-	categoryUseCase := usecases.NewCategoryReportUseCase(mockRepo)
+	categoryUseCase := usecases.NewCategoryReportUseCase(mockRepo, nil)
 	incomeVsExpenseUseCase := usecases.NewIncomeVsExpenseUseCase(mockRepo)
 
 	// Create handler
-	handler := NewReportHandler(monthlyUseCase, annualUseCase, categoryUseCase, incomeVsExpenseUseCase)
+	handler := NewReportHandler(monthlyUseCase, annualUseCase, categoryUseCase, incomeVsExpenseUseCase, nil, nil, nil)
 
 	// Create Fiber app
 	app := fiber.New()
@@ -175,7 +230,7 @@ func TestReportHandler_GetMonthlyReport(t *testing.T) {
 func TestReportHandler_GetMonthlyReport_Unauthorized(t *testing.T) {
 	mockRepo := &mockTransactionRepositoryForReports{transactions: []*entities.Transaction{}}
 	monthlyUseCase := usecases.NewMonthlyReportUseCase(mockRepo, nil) // nil cache for tests
-	handler := NewReportHandler(monthlyUseCase, nil, nil, nil)
+	handler := NewReportHandler(monthlyUseCase, nil, nil, nil, nil, nil, nil)
 
 	app := fiber.New()
 	app.Get("/reports/monthly", handler.GetMonthlyReport)
@@ -190,7 +245,7 @@ func TestReportHandler_GetMonthlyReport_MissingParams(t *testing.T) {
 	userID, _ := identityvalueobjects.NewUserID("123e4567-e89b-12d3-a456-426614174000")
 	mockRepo := &mockTransactionRepositoryForReports{transactions: []*entities.Transaction{}}
 	monthlyUseCase := usecases.NewMonthlyReportUseCase(mockRepo, nil) // nil cache for tests
-	handler := NewReportHandler(monthlyUseCase, nil, nil, nil)
+	handler := NewReportHandler(monthlyUseCase, nil, nil, nil, nil, nil, nil)
 
 	app := fiber.New()
 	app.Use(func(c *fiber.Ctx) error {
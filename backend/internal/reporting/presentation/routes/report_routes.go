@@ -26,5 +26,8 @@ func SetupReportRoutes(router fiber.Router, reportHandler *handlers.ReportHandle
 		reports.Get("/annual", reportHandler.GetAnnualReport)
 		reports.Get("/category", reportHandler.GetCategoryReport)
 		reports.Get("/income-vs-expense", reportHandler.GetIncomeVsExpense)
+		reports.Get("/tags", reportHandler.GetTagReport)
+		reports.Get("/payees", reportHandler.GetPayeeReport)
+		reports.Get("/balance-history", reportHandler.GetBalanceHistory)
 	}
 }
@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"sort"
+	"time"
+)
+
+// Holiday represents a day without banking business.
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// BrazilianHolidays returns the Brazilian national and banking holidays of the year, in date order.
+// Besides the national holidays it includes Carnival (Monday and Tuesday) and Corpus Christi,
+// on which banks do not open, and Black Consciousness Day, national since 2024.
+func BrazilianHolidays(year int) []Holiday {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	easter := EasterSunday(year)
+
+	holidays := []Holiday{
+		{Date: date(time.January, 1), Name: "Confraternização Universal"},
+		{Date: easter.AddDate(0, 0, -48), Name: "Carnaval"},
+		{Date: easter.AddDate(0, 0, -47), Name: "Carnaval"},
+		{Date: easter.AddDate(0, 0, -2), Name: "Sexta-feira Santa"},
+		{Date: date(time.April, 21), Name: "Tiradentes"},
+		{Date: date(time.May, 1), Name: "Dia do Trabalho"},
+		{Date: easter.AddDate(0, 0, 60), Name: "Corpus Christi"},
+		{Date: date(time.September, 7), Name: "Independência do Brasil"},
+		{Date: date(time.October, 12), Name: "Nossa Senhora Aparecida"},
+		{Date: date(time.November, 2), Name: "Finados"},
+		{Date: date(time.November, 15), Name: "Proclamação da República"},
+		{Date: date(time.December, 25), Name: "Natal"},
+	}
+	if year >= 2024 {
+		holidays = append(holidays, Holiday{Date: date(time.November, 20), Name: "Dia Nacional de Zumbi e da Consciência Negra"})
+	}
+
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+// IsBrazilianHoliday checks if the day of date is a Brazilian national or banking holiday.
+func IsBrazilianHoliday(date time.Time) bool {
+	for _, holiday := range BrazilianHolidays(date.Year()) {
+		if holiday.Date.Month() == date.Month() && holiday.Date.Day() == date.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay checks if the day of date is neither a weekend nor a Brazilian holiday.
+func IsBusinessDay(date time.Time) bool {
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		return false
+	}
+	return !IsBrazilianHoliday(date)
+}
+
+// NextBusinessDay returns date itself when it is a business day, or the first business day
+// after it, keeping the time of day.
+func NextBusinessDay(date time.Time) time.Time {
+	for !IsBusinessDay(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// EasterSunday returns the date of Easter Sunday of the year (Gregorian calendar), from which
+// the movable holidays are computed.
+func EasterSunday(year int) time.Time {
+	// Anonymous Gregorian algorithm (Meeus/Jones/Butcher)
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,66 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEasterSunday(t *testing.T) {
+	tests := []struct {
+		year int
+		want string
+	}{
+		{year: 2024, want: "2024-03-31"},
+		{year: 2025, want: "2025-04-20"},
+		{year: 2026, want: "2026-04-05"},
+		{year: 2027, want: "2027-03-28"},
+	}
+
+	for _, tt := range tests {
+		if got := EasterSunday(tt.year).Format("2006-01-02"); got != tt.want {
+			t.Errorf("EasterSunday(%d) = %s, want %s", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestBrazilianHolidays(t *testing.T) {
+	holidays := BrazilianHolidays(2026)
+	if len(holidays) != 13 {
+		t.Fatalf("BrazilianHolidays(2026) returned %d holidays, want 13", len(holidays))
+	}
+
+	for _, day := range []string{"2026-02-16", "2026-02-17", "2026-04-03", "2026-06-04", "2026-11-20"} {
+		date, _ := time.Parse("2006-01-02", day)
+		if !IsBrazilianHoliday(date) {
+			t.Errorf("IsBrazilianHoliday(%s) = false, want true", day)
+		}
+	}
+
+	// Black Consciousness Day is a national holiday since 2024
+	if IsBrazilianHoliday(time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsBrazilianHoliday(2023-11-20) = true, want false")
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	tests := []struct {
+		name string
+		date string
+		want string
+	}{
+		{name: "business day", date: "2026-10-15", want: "2026-10-15"},
+		{name: "saturday", date: "2026-10-17", want: "2026-10-19"},
+		{name: "holiday on monday", date: "2026-10-12", want: "2026-10-13"},
+		{name: "weekend before carnival", date: "2026-02-14", want: "2026-02-18"},
+		{name: "new year after weekend", date: "2027-01-01", want: "2027-01-04"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, _ := time.Parse("2006-01-02", tt.date)
+			if got := NextBusinessDay(date).Format("2006-01-02"); got != tt.want {
+				t.Errorf("NextBusinessDay(%s) = %s, want %s", tt.date, got, tt.want)
+			}
+		})
+	}
+}
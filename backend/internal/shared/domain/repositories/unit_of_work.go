@@ -23,6 +23,16 @@ type UnitOfWork interface {
 	// Returns an error if no transaction is in progress or if rollback fails.
 	Rollback() error
 
+	// SavePoint marks a point within the current transaction that RollbackTo can return to.
+	// Returns an error if no transaction is in progress or if the save point cannot be created.
+	SavePoint(name string) error
+
+	// RollbackTo discards the changes made since the save point, keeping the transaction open.
+	// A failed statement aborts the whole transaction on some databases (e.g. PostgreSQL), so
+	// rolling back to a save point is the way to go on after it.
+	// Returns an error if no transaction is in progress or if the rollback fails.
+	RollbackTo(name string) error
+
 	// TransactionRepository returns a TransactionRepository that operates within the current transaction.
 	// If no transaction is in progress, it returns a repository that operates outside of a transaction.
 	TransactionRepository() transactionrepositories.TransactionRepository
@@ -31,6 +41,22 @@ type UnitOfWork interface {
 	// If no transaction is in progress, it returns a repository that operates outside of a transaction.
 	AccountRepository() accountrepositories.AccountRepository
 
+	// CreditCardStatementRepository returns a CreditCardStatementRepository that operates within the current transaction.
+	// If no transaction is in progress, it returns a repository that operates outside of a transaction.
+	CreditCardStatementRepository() accountrepositories.CreditCardStatementRepository
+
+	// ImportBatchRepository returns an ImportBatchRepository that operates within the current transaction.
+	// If no transaction is in progress, it returns a repository that operates outside of a transaction.
+	ImportBatchRepository() transactionrepositories.ImportBatchRepository
+
+	// ReconciliationRepository returns a ReconciliationRepository that operates within the current transaction.
+	// If no transaction is in progress, it returns a repository that operates outside of a transaction.
+	ReconciliationRepository() transactionrepositories.ReconciliationRepository
+
+	// TransactionRevisionRepository returns a TransactionRevisionRepository that operates within the current transaction.
+	// If no transaction is in progress, it returns a repository that operates outside of a transaction.
+	TransactionRevisionRepository() transactionrepositories.TransactionRevisionRepository
+
 	// IsInTransaction returns true if a transaction is currently in progress.
 	IsInTransaction() bool
 }
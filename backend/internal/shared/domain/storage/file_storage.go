@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrFileNotFound is returned when no file is stored under the requested key.
+var ErrFileNotFound = errors.New("file not found")
+
+// FileStorage stores files (e.g. transaction receipts) outside the database.
+// Keys are slash-separated relative paths such as "attachments/<user>/<transaction>/<id>".
+type FileStorage interface {
+	// Put stores the content under the key, replacing any existing file.
+	Put(key string, content []byte, contentType string) error
+
+	// Get opens the file stored under the key. The caller must close the returned reader.
+	// Returns ErrFileNotFound if there is no such file.
+	Get(key string) (io.ReadCloser, error)
+
+	// Delete removes the file stored under the key.
+	// Deleting a file that does not exist is not an error.
+	Delete(key string) error
+}
@@ -3,6 +3,8 @@ package valueobjects
 import (
 	"errors"
 	"fmt"
+	"math/big"
+	"sort"
 )
 
 // Money represents a monetary value with amount and currency.
@@ -132,6 +134,68 @@ func (m Money) Divide(divisor float64) (Money, error) {
 	}, nil
 }
 
+// Allocate splits the Money into parts proportional to the given ratios without losing cents.
+// Each part gets the floor of its proportional share and the leftover cents are handed out one
+// by one to the parts with the largest remainders (ties go to the earlier part), so the parts
+// always add up exactly to the original amount and the result is deterministic.
+func (m Money) Allocate(ratios []int64) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, errors.New("allocation ratios cannot be empty")
+	}
+
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, errors.New("allocation ratios cannot be negative")
+		}
+		total += ratio
+	}
+	if total == 0 {
+		return nil, errors.New("allocation ratios must not all be zero")
+	}
+
+	amount := m.amount
+	if amount < 0 {
+		amount = -amount
+	}
+
+	// Use big integers so amount * ratio cannot overflow
+	bigAmount := big.NewInt(amount)
+	bigTotal := big.NewInt(total)
+
+	parts := make([]int64, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	var allocated int64
+	for i, ratio := range ratios {
+		share, remainder := new(big.Int).QuoRem(new(big.Int).Mul(bigAmount, big.NewInt(ratio)), bigTotal, new(big.Int))
+		parts[i] = share.Int64()
+		remainders[i] = remainder
+		allocated += parts[i]
+	}
+
+	// Hand out leftover cents by largest remainder, earlier parts first on ties
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for i := int64(0); i < amount-allocated; i++ {
+		parts[order[i]]++
+	}
+
+	result := make([]Money, len(parts))
+	for i, part := range parts {
+		if m.amount < 0 {
+			part = -part
+		}
+		result[i] = Money{amount: part, currency: m.currency}
+	}
+
+	return result, nil
+}
+
 // Negate returns the negative of this Money.
 func (m Money) Negate() Money {
 	return Money{
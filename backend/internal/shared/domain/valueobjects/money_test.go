@@ -315,3 +315,49 @@ func MustMoneyFromFloat(amount float64, currency Currency) Money {
 	money, _ := NewMoneyFromFloat(amount, currency)
 	return money
 }
+
+func TestMoney_Allocate(t *testing.T) {
+	brl := BRLCurrency()
+
+	tests := []struct {
+		name      string
+		cents     int64
+		ratios    []int64
+		wantCents []int64
+		wantErr   bool
+	}{
+		{"equal split with leftover cent", 10000, []int64{1, 1, 1}, []int64{3334, 3333, 3333}, false},
+		{"proportional split", 10000, []int64{5000, 3000, 2000}, []int64{5000, 3000, 2000}, false},
+		{"largest remainder gets the cent", 100, []int64{1, 2}, []int64{33, 67}, false},
+		{"ties go to the earlier part", 5, []int64{1, 1, 1, 1}, []int64{2, 1, 1, 1}, false},
+		{"negative amount", -10000, []int64{1, 1, 1}, []int64{-3334, -3333, -3333}, false},
+		{"zero ratio gets nothing", 101, []int64{1, 0, 1}, []int64{51, 0, 50}, false},
+		{"empty ratios", 100, []int64{}, nil, true},
+		{"all zero ratios", 100, []int64{0, 0}, nil, true},
+		{"negative ratio", 100, []int64{1, -1}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			money, _ := NewMoney(tt.cents, brl)
+			parts, err := money.Allocate(tt.ratios)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Money.Allocate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var sum int64
+			for i, part := range parts {
+				if part.Amount() != tt.wantCents[i] {
+					t.Errorf("Money.Allocate()[%d] = %d, want %d", i, part.Amount(), tt.wantCents[i])
+				}
+				sum += part.Amount()
+			}
+			if sum != tt.cents {
+				t.Errorf("Money.Allocate() parts add up to %d, want %d", sum, tt.cents)
+			}
+		})
+	}
+}
@@ -14,11 +14,15 @@ import (
 
 // GormUnitOfWork implements the UnitOfWork interface using GORM.
 type GormUnitOfWork struct {
-	db                    *gorm.DB
-	tx                    *gorm.DB
-	transactionRepository transactionrepositories.TransactionRepository
-	accountRepository     accountrepositories.AccountRepository
-	inTransaction         bool
+	db                       *gorm.DB
+	tx                       *gorm.DB
+	transactionRepository    transactionrepositories.TransactionRepository
+	accountRepository        accountrepositories.AccountRepository
+	statementRepository      accountrepositories.CreditCardStatementRepository
+	importBatchRepository    transactionrepositories.ImportBatchRepository
+	reconciliationRepository transactionrepositories.ReconciliationRepository
+	revisionRepository       transactionrepositories.TransactionRevisionRepository
+	inTransaction            bool
 }
 
 // NewGormUnitOfWork creates a new GormUnitOfWork instance.
@@ -45,6 +49,10 @@ func (uow *GormUnitOfWork) Begin() error {
 	// Create repositories that use the transaction
 	uow.transactionRepository = transactionpersistence.NewGormTransactionRepository(uow.tx)
 	uow.accountRepository = accountpersistence.NewGormAccountRepository(uow.tx)
+	uow.statementRepository = accountpersistence.NewGormCreditCardStatementRepository(uow.tx)
+	uow.importBatchRepository = transactionpersistence.NewGormImportBatchRepository(uow.tx)
+	uow.reconciliationRepository = transactionpersistence.NewGormReconciliationRepository(uow.tx)
+	uow.revisionRepository = transactionpersistence.NewGormTransactionRevisionRepository(uow.tx)
 
 	return nil
 }
@@ -63,6 +71,10 @@ func (uow *GormUnitOfWork) Commit() error {
 	uow.tx = nil
 	uow.transactionRepository = nil
 	uow.accountRepository = nil
+	uow.statementRepository = nil
+	uow.importBatchRepository = nil
+	uow.reconciliationRepository = nil
+	uow.revisionRepository = nil
 
 	return nil
 }
@@ -81,6 +93,36 @@ func (uow *GormUnitOfWork) Rollback() error {
 	uow.tx = nil
 	uow.transactionRepository = nil
 	uow.accountRepository = nil
+	uow.statementRepository = nil
+	uow.importBatchRepository = nil
+	uow.reconciliationRepository = nil
+	uow.revisionRepository = nil
+
+	return nil
+}
+
+// SavePoint marks a point within the current transaction that RollbackTo can return to.
+func (uow *GormUnitOfWork) SavePoint(name string) error {
+	if !uow.inTransaction {
+		return fmt.Errorf("no transaction in progress")
+	}
+
+	if err := uow.tx.SavePoint(name).Error; err != nil {
+		return fmt.Errorf("failed to create save point: %w", err)
+	}
+
+	return nil
+}
+
+// RollbackTo discards the changes made since the save point, keeping the transaction open.
+func (uow *GormUnitOfWork) RollbackTo(name string) error {
+	if !uow.inTransaction {
+		return fmt.Errorf("no transaction in progress")
+	}
+
+	if err := uow.tx.RollbackTo(name).Error; err != nil {
+		return fmt.Errorf("failed to rollback to save point: %w", err)
+	}
 
 	return nil
 }
@@ -103,6 +145,42 @@ func (uow *GormUnitOfWork) AccountRepository() accountrepositories.AccountReposi
 	return accountpersistence.NewGormAccountRepository(uow.db)
 }
 
+// CreditCardStatementRepository returns a CreditCardStatementRepository that operates within the current transaction.
+func (uow *GormUnitOfWork) CreditCardStatementRepository() accountrepositories.CreditCardStatementRepository {
+	if uow.inTransaction && uow.statementRepository != nil {
+		return uow.statementRepository
+	}
+	// If no transaction, return a repository that uses the main DB connection
+	return accountpersistence.NewGormCreditCardStatementRepository(uow.db)
+}
+
+// ImportBatchRepository returns an ImportBatchRepository that operates within the current transaction.
+func (uow *GormUnitOfWork) ImportBatchRepository() transactionrepositories.ImportBatchRepository {
+	if uow.inTransaction && uow.importBatchRepository != nil {
+		return uow.importBatchRepository
+	}
+	// If no transaction, return a repository that uses the main DB connection
+	return transactionpersistence.NewGormImportBatchRepository(uow.db)
+}
+
+// ReconciliationRepository returns a ReconciliationRepository that operates within the current transaction.
+func (uow *GormUnitOfWork) ReconciliationRepository() transactionrepositories.ReconciliationRepository {
+	if uow.inTransaction && uow.reconciliationRepository != nil {
+		return uow.reconciliationRepository
+	}
+	// If no transaction, return a repository that uses the main DB connection
+	return transactionpersistence.NewGormReconciliationRepository(uow.db)
+}
+
+// TransactionRevisionRepository returns a TransactionRevisionRepository that operates within the current transaction.
+func (uow *GormUnitOfWork) TransactionRevisionRepository() transactionrepositories.TransactionRevisionRepository {
+	if uow.inTransaction && uow.revisionRepository != nil {
+		return uow.revisionRepository
+	}
+	// If no transaction, return a repository that uses the main DB connection
+	return transactionpersistence.NewGormTransactionRevisionRepository(uow.db)
+}
+
 // IsInTransaction returns true if a transaction is currently in progress.
 func (uow *GormUnitOfWork) IsInTransaction() bool {
 	return uow.inTransaction
@@ -143,3 +143,48 @@ func TestGormUnitOfWork_Repositories_WithoutTransaction(t *testing.T) {
 		t.Error("AccountRepository should not be nil even without transaction")
 	}
 }
+
+func TestGormUnitOfWork_RollbackTo(t *testing.T) {
+	db := setupTestDB(t)
+	uow := NewGormUnitOfWork(db).(*GormUnitOfWork)
+	if err := db.Exec("CREATE TABLE items (name TEXT NOT NULL)").Error; err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	// Save points need a transaction in progress
+	if err := uow.SavePoint("item"); err == nil {
+		t.Error("SavePoint() should fail when no transaction in progress")
+	}
+
+	if err := uow.Begin(); err != nil {
+		t.Fatalf("Begin() error = %v, want nil", err)
+	}
+	if err := uow.tx.Exec("INSERT INTO items (name) VALUES ('kept')").Error; err != nil {
+		t.Fatalf("failed to insert: %v", err)
+	}
+	if err := uow.SavePoint("item"); err != nil {
+		t.Fatalf("SavePoint() error = %v, want nil", err)
+	}
+	if err := uow.tx.Exec("INSERT INTO items (name) VALUES ('discarded')").Error; err != nil {
+		t.Fatalf("failed to insert: %v", err)
+	}
+
+	// Rolling back to the save point discards only the changes made after it
+	if err := uow.RollbackTo("item"); err != nil {
+		t.Fatalf("RollbackTo() error = %v, want nil", err)
+	}
+	if !uow.IsInTransaction() {
+		t.Error("UnitOfWork should stay in transaction after RollbackTo()")
+	}
+	if err := uow.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v, want nil", err)
+	}
+
+	var names []string
+	if err := db.Raw("SELECT name FROM items").Scan(&names).Error; err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+	if len(names) != 1 || names[0] != "kept" {
+		t.Errorf("items = %v, want only the item inserted before the save point", names)
+	}
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gestao-financeira/backend/internal/shared/domain/storage"
+)
+
+// testFileStorage runs the FileStorage contract against an implementation.
+func testFileStorage(t *testing.T, fs storage.FileStorage) {
+	t.Helper()
+
+	key := "attachments/user/transaction/receipt"
+	content := []byte("%PDF-1.4 receipt")
+
+	if _, err := fs.Get(key); !errors.Is(err, storage.ErrFileNotFound) {
+		t.Fatalf("Get() missing file error = %v, want ErrFileNotFound", err)
+	}
+
+	if err := fs.Put(key, content, "application/pdf"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	reader, err := fs.Get(key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	got, _ := io.ReadAll(reader)
+	reader.Close()
+	if string(got) != string(content) {
+		t.Errorf("Get() content = %q, want %q", got, content)
+	}
+
+	if err := fs.Delete(key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := fs.Get(key); !errors.Is(err, storage.ErrFileNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want ErrFileNotFound", err)
+	}
+	if err := fs.Delete(key); err != nil {
+		t.Errorf("Delete() missing file error = %v, want nil", err)
+	}
+
+	for _, invalid := range []string{"", "../outside", "attachments/../../outside", "/etc/passwd", "a//b", `a\b`} {
+		if err := fs.Put(invalid, content, "application/pdf"); err == nil {
+			t.Errorf("Put(%q) error = nil, want invalid key error", invalid)
+		}
+	}
+}
+
+func TestLocalFileStorage(t *testing.T) {
+	fs, err := NewLocalFileStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalFileStorage() error = %v", err)
+	}
+
+	testFileStorage(t, fs)
+}
+
+// fakeS3Server is a minimal in-memory stand-in for an S3-compatible server.
+type fakeS3Server struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	t       *testing.T
+}
+
+func (f *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=test-key/20261016/sa-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") {
+		f.t.Errorf("unexpected Authorization header: %s", auth)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	sum := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+		f.t.Errorf("X-Amz-Content-Sha256 does not match the body")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/receipts/") {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/receipts/")
+
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[key] = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		object, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+			return
+		}
+		_, _ = w.Write(object)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3FileStorage(t *testing.T) {
+	server := httptest.NewServer(&fakeS3Server{objects: map[string][]byte{}, t: t})
+	defer server.Close()
+
+	fs, err := NewS3FileStorage(S3Config{
+		Endpoint:        server.URL,
+		Region:          "sa-east-1",
+		Bucket:          "receipts",
+		AccessKeyID:     "test-key",
+		SecretAccessKey: "test-secret",
+	}, server.Client())
+	if err != nil {
+		t.Fatalf("NewS3FileStorage() error = %v", err)
+	}
+	fs.(*S3FileStorage).now = func() time.Time { return time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC) }
+
+	testFileStorage(t, fs)
+}
+
+func TestDeriveSigningKey(t *testing.T) {
+	// Example from the AWS Signature Version 4 documentation
+	key := deriveSigningKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam")
+
+	want := "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d"
+	if got := hex.EncodeToString(key); got != want {
+		t.Errorf("deriveSigningKey() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gestao-financeira/backend/internal/shared/domain/storage"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// LocalFileStorage implements FileStorage on the local filesystem, under a root directory.
+type LocalFileStorage struct {
+	rootDir string
+}
+
+// NewLocalFileStorage creates a new LocalFileStorage that keeps files under rootDir,
+// creating the directory if needed.
+func NewLocalFileStorage(rootDir string) (storage.FileStorage, error) {
+	if strings.TrimSpace(rootDir) == "" {
+		return nil, errors.New("storage directory cannot be empty")
+	}
+
+	absRoot, err := filepath.Abs(rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage directory: %w", err)
+	}
+
+	if err := os.MkdirAll(absRoot, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &LocalFileStorage{rootDir: absRoot}, nil
+}
+
+// Put stores the content under the key. The file is written to a temporary file first and
+// then renamed, so readers never see a partially written file.
+func (s *LocalFileStorage) Put(key string, content []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	tmpName := tmp.Name()
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to store file: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the file stored under the key.
+func (s *LocalFileStorage) Get(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, storage.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete removes the file stored under the key.
+func (s *LocalFileStorage) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+
+	return nil
+}
+
+// path resolves a key to a path under the root directory, rejecting keys that would escape it.
+func (s *LocalFileStorage) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(s.rootDir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.rootDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+
+	return path, nil
+}
+
+// validateKey checks that a storage key is a clean relative path.
+func validateKey(key string) error {
+	if key == "" {
+		return errors.New("storage key cannot be empty")
+	}
+	if err := validator.ValidateNoPathTraversal(key); err != nil {
+		return fmt.Errorf("invalid storage key: %s", key)
+	}
+	if strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || key != filepath.ToSlash(filepath.Clean(key)) {
+		return fmt.Errorf("invalid storage key: %s", key)
+	}
+	return nil
+}
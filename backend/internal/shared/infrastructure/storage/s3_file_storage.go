@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gestao-financeira/backend/internal/shared/domain/storage"
+)
+
+// S3Config holds the settings of an S3-compatible object storage (AWS S3, MinIO, etc.).
+type S3Config struct {
+	Endpoint        string // e.g. https://s3.sa-east-1.amazonaws.com or http://localhost:9000
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3FileStorage implements FileStorage on an S3-compatible object storage.
+// Requests use path-style URLs ({endpoint}/{bucket}/{key}) signed with AWS Signature Version 4,
+// so no SDK is needed and any S3-compatible server can be used.
+type S3FileStorage struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3FileStorage creates a new S3FileStorage.
+func NewS3FileStorage(config S3Config, client *http.Client) (storage.FileStorage, error) {
+	if config.Endpoint == "" || config.Bucket == "" || config.Region == "" {
+		return nil, errors.New("S3 endpoint, region and bucket are required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, errors.New("S3 access key ID and secret access key are required")
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(config.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint: %s", config.Endpoint)
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &S3FileStorage{
+		config:   config,
+		endpoint: endpoint,
+		client:   client,
+		now:      time.Now,
+	}, nil
+}
+
+// Put uploads the content as an object.
+func (s *S3FileStorage) Put(key string, content []byte, contentType string) error {
+	resp, err := s.do(http.MethodPut, key, content, contentType)
+	if err != nil {
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to upload file: %s", responseError(resp))
+	}
+
+	return nil
+}
+
+// Get downloads an object.
+func (s *S3FileStorage) Get(key string) (io.ReadCloser, error) {
+	resp, err := s.do(http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, storage.ErrFileNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("failed to download file: %s", responseError(resp))
+	}
+}
+
+// Delete deletes an object. S3 reports success for objects that do not exist.
+func (s *S3FileStorage) Delete(key string) error {
+	resp, err := s.do(http.MethodDelete, key, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete file: %s", responseError(resp))
+	}
+
+	return nil
+}
+
+// do sends a signed request for an object.
+func (s *S3FileStorage) do(method string, key string, body []byte, contentType string) (*http.Response, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	objectURL := *s.endpoint
+	objectURL.Path = s.endpoint.Path + "/" + s.config.Bucket + "/" + key
+	objectURL.RawPath = ""
+
+	req, err := http.NewRequest(method, objectURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.ContentLength = int64(len(body))
+
+	s.sign(req, body)
+
+	return s.client.Do(req)
+}
+
+// sign adds the AWS Signature Version 4 headers to the request.
+func (s *S3FileStorage) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"", // No query string
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := shortDate + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := deriveSigningKey(s.config.SecretAccessKey, shortDate, s.config.Region, "s3")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+// deriveSigningKey derives the Signature Version 4 signing key for a date, region and service.
+func deriveSigningKey(secretAccessKey, shortDate, region, service string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), shortDate)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// responseError describes a failed S3 response, including the start of the error document.
+func responseError(resp *http.Response) string {
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return strings.TrimSpace(fmt.Sprintf("%s %s", resp.Status, detail))
+}
@@ -0,0 +1,15 @@
+package dtos
+
+// CreateTagInput represents the input for creating a new tag.
+type CreateTagInput struct {
+	UserID string
+	Name   string `json:"name" validate:"required,max=50,no_sql_injection,no_xss,utf8"`
+}
+
+// CreateTagOutput represents the output after creating a tag.
+type CreateTagOutput struct {
+	TagID     string `json:"tag_id"`
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+}
@@ -0,0 +1,13 @@
+package dtos
+
+// DeleteTagInput represents the input for deleting a tag.
+type DeleteTagInput struct {
+	UserID string
+	TagID  string
+}
+
+// DeleteTagOutput represents the output after deleting a tag.
+type DeleteTagOutput struct {
+	Message string `json:"message"`
+	TagID   string `json:"tag_id"`
+}
@@ -0,0 +1,20 @@
+package dtos
+
+// ListTagsInput represents the input for listing tags.
+type ListTagsInput struct {
+	UserID string
+}
+
+// TagOutput represents a single tag in the list.
+type TagOutput struct {
+	TagID     string `json:"tag_id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ListTagsOutput represents the output for listing tags.
+type ListTagsOutput struct {
+	Tags  []TagOutput `json:"tags"`
+	Count int         `json:"count"`
+}
@@ -0,0 +1,17 @@
+package dtos
+
+// MergeTagsInput represents the input for merging a tag into another one.
+// Transactions tagged with the source tag are moved to the target tag and the source tag is deleted.
+type MergeTagsInput struct {
+	UserID      string
+	SourceTagID string
+	TargetTagID string `json:"target_tag_id" validate:"required,uuid"`
+}
+
+// MergeTagsOutput represents the output after merging tags.
+type MergeTagsOutput struct {
+	Message     string `json:"message"`
+	SourceTagID string `json:"source_tag_id"`
+	TargetTagID string `json:"target_tag_id"`
+	TargetName  string `json:"target_name"`
+}
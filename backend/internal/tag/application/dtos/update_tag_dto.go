@@ -0,0 +1,16 @@
+package dtos
+
+// UpdateTagInput represents the input for renaming a tag.
+type UpdateTagInput struct {
+	UserID string
+	TagID  string
+	Name   string `json:"name" validate:"required,max=50,no_sql_injection,no_xss,utf8"`
+}
+
+// UpdateTagOutput represents the output after renaming a tag.
+type UpdateTagOutput struct {
+	TagID     string `json:"tag_id"`
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+	UpdatedAt string `json:"updated_at"`
+}
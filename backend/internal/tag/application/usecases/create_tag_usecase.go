@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/tag/application/dtos"
+	"gestao-financeira/backend/internal/tag/domain/entities"
+	"gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+)
+
+// CreateTagUseCase handles tag creation.
+type CreateTagUseCase struct {
+	tagRepository repositories.TagRepository
+	eventBus      *eventbus.EventBus
+}
+
+// NewCreateTagUseCase creates a new CreateTagUseCase instance.
+func NewCreateTagUseCase(
+	tagRepository repositories.TagRepository,
+	eventBus *eventbus.EventBus,
+) *CreateTagUseCase {
+	return &CreateTagUseCase{
+		tagRepository: tagRepository,
+		eventBus:      eventBus,
+	}
+}
+
+// Execute performs the tag creation.
+// Tag names are unique per user (case-insensitive).
+func (uc *CreateTagUseCase) Execute(input dtos.CreateTagInput) (*dtos.CreateTagOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Create tag name value object
+	tagName, err := valueobjects.NewTagName(input.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag name: %w", err)
+	}
+
+	// Check if a tag with the same name already exists for this user
+	existingTag, err := uc.tagRepository.FindByUserIDAndName(userID, tagName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if tag exists: %w", err)
+	}
+	if existingTag != nil {
+		return nil, errors.New("tag with this name already exists")
+	}
+
+	// Create tag entity
+	tag, err := entities.NewTag(userID, tagName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	// Save tag to repository
+	if err := uc.tagRepository.Save(tag); err != nil {
+		return nil, fmt.Errorf("failed to save tag: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range tag.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			// Log error but don't fail the tag creation
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	tag.ClearEvents()
+
+	return &dtos.CreateTagOutput{
+		TagID:     tag.ID().Value(),
+		UserID:    tag.UserID().Value(),
+		Name:      tag.Name().Value(),
+		CreatedAt: tag.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
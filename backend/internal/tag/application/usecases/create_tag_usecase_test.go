@@ -0,0 +1,169 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/tag/application/dtos"
+	"gestao-financeira/backend/internal/tag/domain/entities"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+)
+
+// mockTagRepository is a mock implementation of TagRepository for testing.
+type mockTagRepository struct {
+	tags   map[string]*entities.Tag
+	merged [][2]string // source and target IDs passed to Merge
+}
+
+func newMockTagRepository() *mockTagRepository {
+	return &mockTagRepository{
+		tags: make(map[string]*entities.Tag),
+	}
+}
+
+func (m *mockTagRepository) FindByID(id valueobjects.TagID) (*entities.Tag, error) {
+	return m.tags[id.Value()], nil
+}
+
+func (m *mockTagRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Tag, error) {
+	var result []*entities.Tag
+	for _, tag := range m.tags {
+		if tag.UserID().Equals(userID) {
+			result = append(result, tag)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTagRepository) FindByUserIDAndName(userID identityvalueobjects.UserID, name valueobjects.TagName) (*entities.Tag, error) {
+	for _, tag := range m.tags {
+		if tag.UserID().Equals(userID) && tag.Name().Equals(name) {
+			return tag, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockTagRepository) Save(tag *entities.Tag) error {
+	m.tags[tag.ID().Value()] = tag
+	return nil
+}
+
+func (m *mockTagRepository) Delete(id valueobjects.TagID) error {
+	delete(m.tags, id.Value())
+	return nil
+}
+
+func (m *mockTagRepository) Merge(sourceID, targetID valueobjects.TagID) error {
+	m.merged = append(m.merged, [2]string{sourceID.Value(), targetID.Value()})
+	delete(m.tags, sourceID.Value())
+	return nil
+}
+
+// createTestTag creates and saves a tag in the mock repository.
+func createTestTag(m *mockTagRepository, userID identityvalueobjects.UserID, name string) *entities.Tag {
+	tag, err := entities.NewTag(userID, valueobjects.MustTagName(name))
+	if err != nil {
+		panic(err)
+	}
+	_ = m.Save(tag)
+	return tag
+}
+
+func TestCreateTagUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+
+	tests := []struct {
+		name      string
+		input     dtos.CreateTagInput
+		wantError bool
+		errorMsg  string
+	}{
+		{
+			name:  "valid tag",
+			input: dtos.CreateTagInput{UserID: userID.Value(), Name: "reimbursable"},
+		},
+		{
+			name:      "duplicate name (case-insensitive)",
+			input:     dtos.CreateTagInput{UserID: userID.Value(), Name: "TRIP-2026"},
+			wantError: true,
+			errorMsg:  "already exists",
+		},
+		{
+			name:  "same name for another user",
+			input: dtos.CreateTagInput{UserID: identityvalueobjects.GenerateUserID().Value(), Name: "trip-2026"},
+		},
+		{
+			name:      "invalid name",
+			input:     dtos.CreateTagInput{UserID: userID.Value(), Name: "trip#2026"},
+			wantError: true,
+			errorMsg:  "invalid tag name",
+		},
+		{
+			name:      "invalid user ID",
+			input:     dtos.CreateTagInput{UserID: "invalid", Name: "trip-2026"},
+			wantError: true,
+			errorMsg:  "invalid user ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockTagRepository()
+			createTestTag(repo, userID, "trip-2026")
+
+			useCase := NewCreateTagUseCase(repo, eventbus.NewEventBus())
+			output, err := useCase.Execute(tt.input)
+
+			if tt.wantError {
+				if err == nil || !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("Execute() error = %v, want error containing %q", err, tt.errorMsg)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Execute() error = %v, want nil", err)
+			}
+			if output.TagID == "" || output.Name != strings.TrimSpace(tt.input.Name) {
+				t.Errorf("Execute() output = %+v", output)
+			}
+			if len(repo.tags) != 2 {
+				t.Errorf("Execute() saved %d tags, want 2", len(repo.tags))
+			}
+		})
+	}
+}
+
+func TestUpdateTagUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	repo := newMockTagRepository()
+	trip := createTestTag(repo, userID, "viagem")
+	createTestTag(repo, userID, "reimbursable")
+	otherUserTag := createTestTag(repo, identityvalueobjects.GenerateUserID(), "trip-2026")
+
+	useCase := NewUpdateTagUseCase(repo, eventbus.NewEventBus())
+
+	output, err := useCase.Execute(dtos.UpdateTagInput{UserID: userID.Value(), TagID: trip.ID().Value(), Name: "trip-2026"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Name != "trip-2026" {
+		t.Errorf("Execute() name = %v, want trip-2026", output.Name)
+	}
+
+	// Changing only the case of the name is allowed
+	if _, err := useCase.Execute(dtos.UpdateTagInput{UserID: userID.Value(), TagID: trip.ID().Value(), Name: "Trip-2026"}); err != nil {
+		t.Errorf("Execute() error = %v, want nil when renaming to the same name", err)
+	}
+
+	if _, err := useCase.Execute(dtos.UpdateTagInput{UserID: userID.Value(), TagID: trip.ID().Value(), Name: "Reimbursable"}); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Execute() error = %v, want conflict with existing tag", err)
+	}
+
+	if _, err := useCase.Execute(dtos.UpdateTagInput{UserID: userID.Value(), TagID: otherUserTag.ID().Value(), Name: "mine"}); err == nil || !strings.Contains(err.Error(), "does not belong to user") {
+		t.Errorf("Execute() error = %v, want forbidden error", err)
+	}
+}
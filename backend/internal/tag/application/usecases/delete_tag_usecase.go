@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/application/dtos"
+	"gestao-financeira/backend/internal/tag/domain/repositories"
+)
+
+// DeleteTagUseCase handles tag deletion.
+type DeleteTagUseCase struct {
+	tagRepository repositories.TagRepository
+}
+
+// NewDeleteTagUseCase creates a new DeleteTagUseCase instance.
+func NewDeleteTagUseCase(tagRepository repositories.TagRepository) *DeleteTagUseCase {
+	return &DeleteTagUseCase{
+		tagRepository: tagRepository,
+	}
+}
+
+// Execute deletes the tag and detaches it from all transactions.
+// The transactions themselves are not affected.
+func (uc *DeleteTagUseCase) Execute(input dtos.DeleteTagInput) (*dtos.DeleteTagOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tag, err := findUserTag(uc.tagRepository, userID, input.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tagRepository.Delete(tag.ID()); err != nil {
+		return nil, fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return &dtos.DeleteTagOutput{
+		Message: "Tag deleted successfully",
+		TagID:   tag.ID().Value(),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/domain/entities"
+	"gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// findUserTag loads a tag and checks that it belongs to the user.
+func findUserTag(
+	tagRepository repositories.TagRepository,
+	userID identityvalueobjects.UserID,
+	rawTagID string,
+) (*entities.Tag, error) {
+	tagID, err := valueobjects.NewTagID(rawTagID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag ID: %w", err)
+	}
+
+	tag, err := tagRepository.FindByID(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+	if tag == nil {
+		return nil, errors.New("tag not found")
+	}
+	if !tag.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("tag does not belong to user")
+	}
+
+	return tag, nil
+}
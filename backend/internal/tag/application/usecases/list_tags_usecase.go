@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/application/dtos"
+	"gestao-financeira/backend/internal/tag/domain/repositories"
+)
+
+// ListTagsUseCase handles listing the tags of a user.
+type ListTagsUseCase struct {
+	tagRepository repositories.TagRepository
+}
+
+// NewListTagsUseCase creates a new ListTagsUseCase instance.
+func NewListTagsUseCase(tagRepository repositories.TagRepository) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		tagRepository: tagRepository,
+	}
+}
+
+// Execute returns all tags of the user ordered by name.
+func (uc *ListTagsUseCase) Execute(input dtos.ListTagsInput) (*dtos.ListTagsOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tags, err := uc.tagRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tags: %w", err)
+	}
+
+	outputs := make([]dtos.TagOutput, 0, len(tags))
+	for _, tag := range tags {
+		outputs = append(outputs, dtos.TagOutput{
+			TagID:     tag.ID().Value(),
+			Name:      tag.Name().Value(),
+			CreatedAt: tag.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt: tag.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	return &dtos.ListTagsOutput{
+		Tags:  outputs,
+		Count: len(outputs),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/application/dtos"
+	"gestao-financeira/backend/internal/tag/domain/repositories"
+)
+
+// MergeTagsUseCase handles merging a tag into another tag of the same user.
+type MergeTagsUseCase struct {
+	tagRepository repositories.TagRepository
+}
+
+// NewMergeTagsUseCase creates a new MergeTagsUseCase instance.
+func NewMergeTagsUseCase(tagRepository repositories.TagRepository) *MergeTagsUseCase {
+	return &MergeTagsUseCase{
+		tagRepository: tagRepository,
+	}
+}
+
+// Execute moves every transaction tagged with the source tag to the target tag
+// and deletes the source tag. Both tags must belong to the user.
+func (uc *MergeTagsUseCase) Execute(input dtos.MergeTagsInput) (*dtos.MergeTagsOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	source, err := findUserTag(uc.tagRepository, userID, input.SourceTagID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := findUserTag(uc.tagRepository, userID, input.TargetTagID)
+	if err != nil {
+		return nil, err
+	}
+	if source.ID().Equals(target.ID()) {
+		return nil, errors.New("source and target tags must be different")
+	}
+
+	if err := uc.tagRepository.Merge(source.ID(), target.ID()); err != nil {
+		return nil, fmt.Errorf("failed to merge tags: %w", err)
+	}
+
+	return &dtos.MergeTagsOutput{
+		Message:     "Tags merged successfully",
+		SourceTagID: source.ID().Value(),
+		TargetTagID: target.ID().Value(),
+		TargetName:  target.Name().Value(),
+	}, nil
+}
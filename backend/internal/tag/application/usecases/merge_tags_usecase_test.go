@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/application/dtos"
+)
+
+func TestMergeTagsUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+
+	t.Run("merges source into target", func(t *testing.T) {
+		repo := newMockTagRepository()
+		source := createTestTag(repo, userID, "viagem")
+		target := createTestTag(repo, userID, "trip-2026")
+
+		output, err := NewMergeTagsUseCase(repo).Execute(dtos.MergeTagsInput{
+			UserID:      userID.Value(),
+			SourceTagID: source.ID().Value(),
+			TargetTagID: target.ID().Value(),
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v, want nil", err)
+		}
+		if output.TargetName != "trip-2026" {
+			t.Errorf("Execute() target name = %v, want trip-2026", output.TargetName)
+		}
+		if len(repo.merged) != 1 || repo.merged[0][0] != source.ID().Value() || repo.merged[0][1] != target.ID().Value() {
+			t.Errorf("Execute() merge calls = %v", repo.merged)
+		}
+	})
+
+	t.Run("rejects merging a tag into itself", func(t *testing.T) {
+		repo := newMockTagRepository()
+		tag := createTestTag(repo, userID, "viagem")
+
+		_, err := NewMergeTagsUseCase(repo).Execute(dtos.MergeTagsInput{
+			UserID:      userID.Value(),
+			SourceTagID: tag.ID().Value(),
+			TargetTagID: tag.ID().Value(),
+		})
+		if err == nil || !strings.Contains(err.Error(), "must be different") {
+			t.Errorf("Execute() error = %v, want error about different tags", err)
+		}
+	})
+
+	t.Run("rejects target of another user", func(t *testing.T) {
+		repo := newMockTagRepository()
+		source := createTestTag(repo, userID, "viagem")
+		target := createTestTag(repo, identityvalueobjects.GenerateUserID(), "trip-2026")
+
+		_, err := NewMergeTagsUseCase(repo).Execute(dtos.MergeTagsInput{
+			UserID:      userID.Value(),
+			SourceTagID: source.ID().Value(),
+			TargetTagID: target.ID().Value(),
+		})
+		if err == nil || !strings.Contains(err.Error(), "does not belong to user") {
+			t.Errorf("Execute() error = %v, want forbidden error", err)
+		}
+		if len(repo.merged) != 0 {
+			t.Error("Execute() should not merge tags of different users")
+		}
+	})
+}
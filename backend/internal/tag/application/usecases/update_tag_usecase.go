@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/tag/application/dtos"
+	"gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+)
+
+// UpdateTagUseCase handles renaming a tag.
+type UpdateTagUseCase struct {
+	tagRepository repositories.TagRepository
+	eventBus      *eventbus.EventBus
+}
+
+// NewUpdateTagUseCase creates a new UpdateTagUseCase instance.
+func NewUpdateTagUseCase(
+	tagRepository repositories.TagRepository,
+	eventBus *eventbus.EventBus,
+) *UpdateTagUseCase {
+	return &UpdateTagUseCase{
+		tagRepository: tagRepository,
+		eventBus:      eventBus,
+	}
+}
+
+// Execute renames the tag. Renaming to the name of another tag of the user is rejected;
+// use MergeTagsUseCase to combine two tags instead.
+func (uc *UpdateTagUseCase) Execute(input dtos.UpdateTagInput) (*dtos.UpdateTagOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tag, err := findUserTag(uc.tagRepository, userID, input.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create tag name value object
+	tagName, err := valueobjects.NewTagName(input.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag name: %w", err)
+	}
+
+	// Check if another tag already uses this name
+	existingTag, err := uc.tagRepository.FindByUserIDAndName(userID, tagName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if tag exists: %w", err)
+	}
+	if existingTag != nil && !existingTag.ID().Equals(tag.ID()) {
+		return nil, errors.New("tag with this name already exists")
+	}
+
+	if err := tag.Rename(tagName); err != nil {
+		return nil, fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	// Save tag to repository
+	if err := uc.tagRepository.Save(tag); err != nil {
+		return nil, fmt.Errorf("failed to save tag: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range tag.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	tag.ClearEvents()
+
+	return &dtos.UpdateTagOutput{
+		TagID:     tag.ID().Value(),
+		UserID:    tag.UserID().Value(),
+		Name:      tag.Name().Value(),
+		UpdatedAt: tag.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+)
+
+// Tag represents a tag aggregate root in the Tag context.
+// Tags are user-scoped labels that can be attached to any number of transactions,
+// independently of their category (e.g. "trip-2026" or "reimbursable").
+type Tag struct {
+	id        valueobjects.TagID
+	userID    identityvalueobjects.UserID
+	name      valueobjects.TagName
+	createdAt time.Time
+	updatedAt time.Time
+
+	// Domain events
+	events []events.DomainEvent
+}
+
+// NewTag creates a new Tag aggregate.
+func NewTag(userID identityvalueobjects.UserID, name valueobjects.TagName) (*Tag, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if name.IsEmpty() {
+		return nil, errors.New("tag name cannot be empty")
+	}
+
+	now := time.Now()
+
+	tag := &Tag{
+		id:        valueobjects.GenerateTagID(),
+		userID:    userID,
+		name:      name,
+		createdAt: now,
+		updatedAt: now,
+		events:    []events.DomainEvent{},
+	}
+
+	// Add domain event
+	tag.addEvent(events.NewBaseDomainEvent(
+		"TagCreated",
+		tag.id.Value(),
+		"Tag",
+	))
+
+	return tag, nil
+}
+
+// TagFromPersistence reconstructs a Tag aggregate from persisted data.
+// This method does not trigger domain events, as it's used for loading existing data.
+func TagFromPersistence(
+	id valueobjects.TagID,
+	userID identityvalueobjects.UserID,
+	name valueobjects.TagName,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*Tag, error) {
+	if id.IsEmpty() {
+		return nil, errors.New("tag ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if name.IsEmpty() {
+		return nil, errors.New("tag name cannot be empty")
+	}
+
+	return &Tag{
+		id:        id,
+		userID:    userID,
+		name:      name,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+		events:    []events.DomainEvent{},
+	}, nil
+}
+
+// ID returns the tag ID.
+func (t *Tag) ID() valueobjects.TagID {
+	return t.id
+}
+
+// UserID returns the user ID.
+func (t *Tag) UserID() identityvalueobjects.UserID {
+	return t.userID
+}
+
+// Name returns the tag name.
+func (t *Tag) Name() valueobjects.TagName {
+	return t.name
+}
+
+// CreatedAt returns the creation timestamp.
+func (t *Tag) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt returns the last update timestamp.
+func (t *Tag) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Rename changes the tag name.
+func (t *Tag) Rename(name valueobjects.TagName) error {
+	if name.IsEmpty() {
+		return errors.New("tag name cannot be empty")
+	}
+
+	t.name = name
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TagRenamed",
+		t.id.Value(),
+		"Tag",
+	))
+
+	return nil
+}
+
+// GetEvents returns all domain events that occurred on this aggregate.
+func (t *Tag) GetEvents() []events.DomainEvent {
+	return t.events
+}
+
+// ClearEvents clears all domain events from this aggregate.
+func (t *Tag) ClearEvents() {
+	t.events = []events.DomainEvent{}
+}
+
+// addEvent adds a domain event to the aggregate.
+func (t *Tag) addEvent(event events.DomainEvent) {
+	t.events = append(t.events, event)
+}
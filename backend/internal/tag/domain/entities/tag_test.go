@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"testing"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+)
+
+func TestNewTag(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	tagName := valueobjects.MustTagName("trip-2026")
+
+	tag, err := NewTag(userID, tagName)
+	if err != nil {
+		t.Fatalf("NewTag() error = %v, want nil", err)
+	}
+
+	if tag.ID().IsEmpty() {
+		t.Error("NewTag() returned tag with empty ID")
+	}
+	if !tag.UserID().Equals(userID) {
+		t.Error("NewTag() returned tag with wrong user ID")
+	}
+	if !tag.Name().Equals(tagName) {
+		t.Error("NewTag() returned tag with wrong name")
+	}
+	if len(tag.GetEvents()) != 1 {
+		t.Errorf("NewTag() events = %d, want 1 (TagCreated)", len(tag.GetEvents()))
+	}
+
+	if _, err := NewTag(identityvalueobjects.UserID{}, tagName); err == nil {
+		t.Error("NewTag() should fail with empty user ID")
+	}
+	if _, err := NewTag(userID, valueobjects.TagName{}); err == nil {
+		t.Error("NewTag() should fail with empty name")
+	}
+}
+
+func TestTag_Rename(t *testing.T) {
+	tag, _ := NewTag(identityvalueobjects.GenerateUserID(), valueobjects.MustTagName("viagem"))
+	tag.ClearEvents()
+
+	newName := valueobjects.MustTagName("trip-2026")
+	if err := tag.Rename(newName); err != nil {
+		t.Fatalf("Tag.Rename() error = %v, want nil", err)
+	}
+	if tag.Name().Value() != "trip-2026" {
+		t.Errorf("Tag.Name() = %v, want trip-2026", tag.Name().Value())
+	}
+	if len(tag.GetEvents()) != 1 || tag.GetEvents()[0].EventType() != "TagRenamed" {
+		t.Error("Tag.Rename() should raise TagRenamed event")
+	}
+
+	if err := tag.Rename(valueobjects.TagName{}); err == nil {
+		t.Error("Tag.Rename() should fail with empty name")
+	}
+}
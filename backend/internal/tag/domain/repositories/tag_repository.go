@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/domain/entities"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+)
+
+// TagRepository defines the interface for tag persistence operations.
+// This interface belongs to the domain layer and will be implemented in the infrastructure layer.
+type TagRepository interface {
+	// FindByID finds a tag by its ID.
+	// Returns nil if the tag is not found.
+	FindByID(id valueobjects.TagID) (*entities.Tag, error)
+
+	// FindByUserID finds all tags for a given user, ordered by name.
+	// Returns an empty slice if no tags are found.
+	FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Tag, error)
+
+	// FindByUserIDAndName finds a tag by user ID and name (case-insensitive).
+	// Returns nil if the tag is not found.
+	FindByUserIDAndName(userID identityvalueobjects.UserID, name valueobjects.TagName) (*entities.Tag, error)
+
+	// Save saves or updates a tag.
+	// If the tag already exists (by ID), it updates it.
+	// If the tag doesn't exist, it creates a new one.
+	Save(tag *entities.Tag) error
+
+	// Delete permanently deletes a tag by its ID and detaches it from all transactions.
+	Delete(id valueobjects.TagID) error
+
+	// Merge moves every transaction tagged with the source tag to the target tag
+	// and deletes the source tag, atomically.
+	Merge(sourceID, targetID valueobjects.TagID) error
+}
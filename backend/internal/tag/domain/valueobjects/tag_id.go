@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// TagID represents a tag identifier value object.
+type TagID struct {
+	value string
+}
+
+// NewTagID creates a new TagID from a string.
+func NewTagID(id string) (TagID, error) {
+	if id == "" {
+		return TagID{}, errors.New("tag ID cannot be empty")
+	}
+
+	// Validate UUID format
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return TagID{}, errors.New("invalid tag ID format (must be UUID)")
+	}
+
+	return TagID{value: id}, nil
+}
+
+// GenerateTagID generates a new TagID.
+func GenerateTagID() TagID {
+	return TagID{value: uuid.New().String()}
+}
+
+// MustTagID creates a new TagID and panics if invalid.
+// Use this only when you are certain the ID is valid (e.g., in tests).
+func MustTagID(id string) TagID {
+	tid, err := NewTagID(id)
+	if err != nil {
+		panic(err)
+	}
+	return tid
+}
+
+// Value returns the tag ID as a string.
+func (tid TagID) Value() string {
+	return tid.value
+}
+
+// String returns the tag ID as a string (implements fmt.Stringer).
+func (tid TagID) String() string {
+	return tid.value
+}
+
+// Equals checks if two TagID values are equal.
+func (tid TagID) Equals(other TagID) bool {
+	return tid.value == other.value
+}
+
+// IsEmpty checks if the tag ID is empty.
+func (tid TagID) IsEmpty() bool {
+	return tid.value == ""
+}
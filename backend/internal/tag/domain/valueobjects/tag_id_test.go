@@ -0,0 +1,96 @@
+package valueobjects
+
+import (
+	"testing"
+)
+
+func TestNewTagID(t *testing.T) {
+	validUUID := "550e8400-e29b-41d4-a716-446655440000"
+	invalidUUID := "invalid-uuid"
+	emptyUUID := ""
+
+	tests := []struct {
+		name      string
+		id        string
+		wantError bool
+	}{
+		{"valid UUID", validUUID, false},
+		{"invalid UUID", invalidUUID, true},
+		{"empty UUID", emptyUUID, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tagID, err := NewTagID(tt.id)
+			if (err != nil) != tt.wantError {
+				t.Errorf("NewTagID() error = %v, wantError %v", err, tt.wantError)
+				return
+			}
+			if !tt.wantError && tagID.IsEmpty() {
+				t.Error("NewTagID() returned empty ID for valid UUID")
+			}
+		})
+	}
+}
+
+func TestGenerateTagID(t *testing.T) {
+	tagID := GenerateTagID()
+
+	if tagID.IsEmpty() {
+		t.Error("GenerateTagID() returned empty ID")
+	}
+
+	// Generate another one and verify they're different
+	tagID2 := GenerateTagID()
+	if tagID.Equals(tagID2) {
+		t.Error("GenerateTagID() should generate unique IDs")
+	}
+}
+
+func TestTagID_Value(t *testing.T) {
+	tagID := GenerateTagID()
+	value := tagID.Value()
+
+	if value == "" {
+		t.Error("TagID.Value() returned empty string")
+	}
+}
+
+func TestTagID_String(t *testing.T) {
+	tagID := GenerateTagID()
+	str := tagID.String()
+
+	if str == "" {
+		t.Error("TagID.String() returned empty string")
+	}
+
+	if str != tagID.Value() {
+		t.Errorf("TagID.String() = %v, want %v", str, tagID.Value())
+	}
+}
+
+func TestTagID_Equals(t *testing.T) {
+	tagID1 := GenerateTagID()
+	tagID2 := GenerateTagID()
+	tagID3 := MustTagID(tagID1.Value())
+
+	if !tagID1.Equals(tagID3) {
+		t.Error("TagID.Equals() = false for equal IDs, want true")
+	}
+
+	if tagID1.Equals(tagID2) {
+		t.Error("TagID.Equals() = true for different IDs, want false")
+	}
+}
+
+func TestTagID_IsEmpty(t *testing.T) {
+	tagID := GenerateTagID()
+	if tagID.IsEmpty() {
+		t.Error("TagID.IsEmpty() = true for generated ID, want false")
+	}
+
+	emptyID := TagID{}
+	if !emptyID.IsEmpty() {
+		t.Error("TagID.IsEmpty() = false for empty ID, want true")
+	}
+}
@@ -0,0 +1,70 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TagName represents a tag name value object.
+// Tags are free-form labels such as "trip-2026" or "reimbursable", so besides letters,
+// numbers and spaces they may contain hyphens and underscores.
+type TagName struct {
+	value string
+}
+
+// MaxTagNameLength is the maximum length for a tag name.
+const MaxTagNameLength = 50
+
+// NewTagName creates a new TagName value object.
+func NewTagName(name string) (TagName, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return TagName{}, errors.New("tag name cannot be empty")
+	}
+
+	if utf8.RuneCountInString(name) > MaxTagNameLength {
+		return TagName{}, fmt.Errorf("tag name is too long (max %d characters)", MaxTagNameLength)
+	}
+
+	for _, char := range name {
+		if !unicode.IsLetter(char) && !unicode.IsNumber(char) && char != ' ' && char != '-' && char != '_' {
+			return TagName{}, errors.New("tag name contains invalid characters")
+		}
+	}
+
+	return TagName{value: name}, nil
+}
+
+// MustTagName creates a new TagName and panics if invalid.
+// Use this only when you are certain the name is valid (e.g., in tests).
+func MustTagName(name string) TagName {
+	tn, err := NewTagName(name)
+	if err != nil {
+		panic(err)
+	}
+	return tn
+}
+
+// Value returns the tag name as a string.
+func (tn TagName) Value() string {
+	return tn.value
+}
+
+// String returns the tag name as a string (implements fmt.Stringer).
+func (tn TagName) String() string {
+	return tn.value
+}
+
+// Equals checks if two TagName values are equal (case-insensitive).
+func (tn TagName) Equals(other TagName) bool {
+	return strings.EqualFold(tn.value, other.value)
+}
+
+// IsEmpty checks if the tag name is empty.
+func (tn TagName) IsEmpty() bool {
+	return tn.value == ""
+}
@@ -0,0 +1,47 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewTagName(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantValue string
+		wantError bool
+	}{
+		{"valid name", "reimbursable", "reimbursable", false},
+		{"valid name with hyphen and numbers", "trip-2026", "trip-2026", false},
+		{"valid name with underscore", "house_renovation", "house_renovation", false},
+		{"valid name with accents", "Reforma da casa", "Reforma da casa", false},
+		{"trims spaces", "  viagem  ", "viagem", false},
+		{"empty name", "", "", true},
+		{"only spaces", "   ", "", true},
+		{"too long", strings.Repeat("a", 51), "", true},
+		{"invalid characters", "trip#2026", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tagName, err := NewTagName(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Errorf("NewTagName() error = %v, wantError %v", err, tt.wantError)
+				return
+			}
+			if !tt.wantError && tagName.Value() != tt.wantValue {
+				t.Errorf("NewTagName() = %v, want %v", tagName.Value(), tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestTagName_Equals(t *testing.T) {
+	if !MustTagName("Trip-2026").Equals(MustTagName("trip-2026")) {
+		t.Error("TagName.Equals() should be case-insensitive")
+	}
+	if MustTagName("trip-2026").Equals(MustTagName("trip-2025")) {
+		t.Error("TagName.Equals() should be false for different names")
+	}
+}
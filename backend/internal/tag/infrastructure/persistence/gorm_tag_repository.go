@@ -0,0 +1,179 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/domain/entities"
+	"gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+
+	"gorm.io/gorm"
+)
+
+// GormTagRepository implements TagRepository using GORM.
+type GormTagRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTagRepository creates a new GORM tag repository.
+func NewGormTagRepository(db *gorm.DB) repositories.TagRepository {
+	return &GormTagRepository{db: db}
+}
+
+// FindByID finds a tag by its ID.
+func (r *GormTagRepository) FindByID(id valueobjects.TagID) (*entities.Tag, error) {
+	var model TagModel
+	if err := r.db.Where("id = ?", id.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find tag by ID: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByUserID finds all tags for a given user, ordered by name.
+func (r *GormTagRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Tag, error) {
+	var models []TagModel
+	if err := r.db.Where("user_id = ?", userID.Value()).Order("LOWER(name) ASC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find tags by user ID: %w", err)
+	}
+
+	tags := make([]*entities.Tag, 0, len(models))
+	for _, model := range models {
+		tag, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert tag model to domain: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+// FindByUserIDAndName finds a tag by user ID and name (case-insensitive).
+// Uses index idx_tags_user_name.
+func (r *GormTagRepository) FindByUserIDAndName(userID identityvalueobjects.UserID, name valueobjects.TagName) (*entities.Tag, error) {
+	var model TagModel
+	if err := r.db.Where("user_id = ? AND LOWER(name) = LOWER(?)", userID.Value(), name.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find tag by user ID and name: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// Save saves or updates a tag.
+func (r *GormTagRepository) Save(tag *entities.Tag) error {
+	model := r.toModel(tag)
+
+	// Check if tag exists
+	var existing TagModel
+	err := r.db.Where("id = ?", model.ID).First(&existing).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Create new tag
+		if err := r.db.Create(model).Error; err != nil {
+			if isDuplicateTagNameError(err) {
+				return errors.New("tag with this name already exists")
+			}
+			return fmt.Errorf("failed to create tag: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to check tag existence: %w", err)
+	} else {
+		// Update existing tag
+		if err := r.db.Model(&TagModel{}).Where("id = ?", model.ID).
+			Updates(map[string]interface{}{
+				"name":       model.Name,
+				"updated_at": model.UpdatedAt,
+			}).Error; err != nil {
+			if isDuplicateTagNameError(err) {
+				return errors.New("tag with this name already exists")
+			}
+			return fmt.Errorf("failed to update tag: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Delete permanently deletes a tag and detaches it from all transactions.
+func (r *GormTagRepository) Delete(id valueobjects.TagID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM "+transactionTagsTable+" WHERE tag_id = ?", id.Value()).Error; err != nil {
+			return fmt.Errorf("failed to detach tag from transactions: %w", err)
+		}
+		if err := tx.Where("id = ?", id.Value()).Delete(&TagModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete tag: %w", err)
+		}
+		return nil
+	})
+}
+
+// Merge moves every transaction tagged with the source tag to the target tag and deletes
+// the source tag. Transactions that already carry both tags keep a single link to the target.
+func (r *GormTagRepository) Merge(sourceID, targetID valueobjects.TagID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(
+			"INSERT INTO "+transactionTagsTable+" (transaction_id, tag_id) "+
+				"SELECT transaction_id, ? FROM "+transactionTagsTable+" WHERE tag_id = ? "+
+				"AND transaction_id NOT IN (SELECT transaction_id FROM "+transactionTagsTable+" WHERE tag_id = ?)",
+			targetID.Value(), sourceID.Value(), targetID.Value(),
+		).Error; err != nil {
+			return fmt.Errorf("failed to move transactions to target tag: %w", err)
+		}
+		if err := tx.Exec("DELETE FROM "+transactionTagsTable+" WHERE tag_id = ?", sourceID.Value()).Error; err != nil {
+			return fmt.Errorf("failed to detach source tag from transactions: %w", err)
+		}
+		if err := tx.Where("id = ?", sourceID.Value()).Delete(&TagModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete source tag: %w", err)
+		}
+		return nil
+	})
+}
+
+// isDuplicateTagNameError checks if the error is a unique constraint violation on the tag name.
+func isDuplicateTagNameError(err error) bool {
+	return strings.Contains(err.Error(), "duplicate key") ||
+		strings.Contains(err.Error(), "unique constraint") ||
+		strings.Contains(err.Error(), "UNIQUE constraint") ||
+		strings.Contains(err.Error(), "idx_tags_user_name")
+}
+
+// toDomain converts a TagModel to a Tag domain entity.
+func (r *GormTagRepository) toDomain(model *TagModel) (*entities.Tag, error) {
+	tagID, err := valueobjects.NewTagID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag ID: %w", err)
+	}
+
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tagName, err := valueobjects.NewTagName(model.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag name: %w", err)
+	}
+
+	return entities.TagFromPersistence(tagID, userID, tagName, model.CreatedAt, model.UpdatedAt)
+}
+
+// toModel converts a Tag domain entity to a TagModel.
+func (r *GormTagRepository) toModel(tag *entities.Tag) *TagModel {
+	return &TagModel{
+		ID:        tag.ID().Value(),
+		UserID:    tag.UserID().Value(),
+		Name:      tag.Name().Value(),
+		CreatedAt: tag.CreatedAt(),
+		UpdatedAt: tag.UpdatedAt(),
+	}
+}
@@ -0,0 +1,136 @@
+package persistence
+
+import (
+	"testing"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/tag/domain/entities"
+	"gestao-financeira/backend/internal/tag/domain/valueobjects"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTagTestDB creates an in-memory SQLite database for testing.
+// The transaction_tags join table is created by hand, since its model lives in the Transaction context.
+func setupTagTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	// Auto-migrate the schema
+	if err := db.AutoMigrate(&TagModel{}); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+	if err := db.Exec("CREATE TABLE transaction_tags (transaction_id TEXT NOT NULL, tag_id TEXT NOT NULL, PRIMARY KEY (transaction_id, tag_id))").Error; err != nil {
+		t.Fatalf("Failed to create transaction_tags table: %v", err)
+	}
+
+	return db
+}
+
+// createTestTag creates and saves a test tag entity.
+func createTestTag(t *testing.T, repo *GormTagRepository, userID identityvalueobjects.UserID, name string) *entities.Tag {
+	tag, err := entities.NewTag(userID, valueobjects.MustTagName(name))
+	if err != nil {
+		t.Fatalf("Failed to create tag: %v", err)
+	}
+	if err := repo.Save(tag); err != nil {
+		t.Fatalf("Failed to save tag: %v", err)
+	}
+	return tag
+}
+
+// tagTransactionIDs returns the IDs of the transactions linked to a tag.
+func tagTransactionIDs(t *testing.T, db *gorm.DB, tagID valueobjects.TagID) []string {
+	var ids []string
+	if err := db.Table("transaction_tags").Where("tag_id = ?", tagID.Value()).Order("transaction_id").Pluck("transaction_id", &ids).Error; err != nil {
+		t.Fatalf("Failed to read transaction_tags: %v", err)
+	}
+	return ids
+}
+
+func TestGormTagRepository_SaveAndFind(t *testing.T) {
+	db := setupTagTestDB(t)
+	repo := NewGormTagRepository(db).(*GormTagRepository)
+	userID := identityvalueobjects.GenerateUserID()
+
+	tag := createTestTag(t, repo, userID, "trip-2026")
+	createTestTag(t, repo, userID, "Reimbursable")
+	createTestTag(t, repo, identityvalueobjects.GenerateUserID(), "trip-2026")
+
+	found, err := repo.FindByUserIDAndName(userID, valueobjects.MustTagName("TRIP-2026"))
+	if err != nil {
+		t.Fatalf("FindByUserIDAndName() error = %v", err)
+	}
+	if found == nil || !found.ID().Equals(tag.ID()) {
+		t.Fatalf("FindByUserIDAndName() = %v, want tag %s", found, tag.ID().Value())
+	}
+
+	tags, err := repo.FindByUserID(userID)
+	if err != nil {
+		t.Fatalf("FindByUserID() error = %v", err)
+	}
+	if len(tags) != 2 || tags[0].Name().Value() != "Reimbursable" {
+		t.Errorf("FindByUserID() = %d tags, want 2 ordered by name", len(tags))
+	}
+
+	// Rename
+	_ = found.Rename(valueobjects.MustTagName("trip-2027"))
+	if err := repo.Save(found); err != nil {
+		t.Fatalf("Save() error on update = %v", err)
+	}
+	renamed, _ := repo.FindByID(tag.ID())
+	if renamed.Name().Value() != "trip-2027" {
+		t.Errorf("Save() name = %v, want trip-2027", renamed.Name().Value())
+	}
+}
+
+func TestGormTagRepository_Delete(t *testing.T) {
+	db := setupTagTestDB(t)
+	repo := NewGormTagRepository(db).(*GormTagRepository)
+	tag := createTestTag(t, repo, identityvalueobjects.GenerateUserID(), "trip-2026")
+	db.Exec("INSERT INTO transaction_tags (transaction_id, tag_id) VALUES (?, ?)", "tx-1", tag.ID().Value())
+
+	if err := repo.Delete(tag.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	deleted, _ := repo.FindByID(tag.ID())
+	if deleted != nil {
+		t.Error("Delete() tag still exists")
+	}
+	if ids := tagTransactionIDs(t, db, tag.ID()); len(ids) != 0 {
+		t.Errorf("Delete() left %d transaction links", len(ids))
+	}
+}
+
+func TestGormTagRepository_Merge(t *testing.T) {
+	db := setupTagTestDB(t)
+	repo := NewGormTagRepository(db).(*GormTagRepository)
+	userID := identityvalueobjects.GenerateUserID()
+	source := createTestTag(t, repo, userID, "viagem")
+	target := createTestTag(t, repo, userID, "trip-2026")
+
+	db.Exec("INSERT INTO transaction_tags (transaction_id, tag_id) VALUES (?, ?), (?, ?), (?, ?)",
+		"tx-1", source.ID().Value(), // only source
+		"tx-2", source.ID().Value(), // both tags
+		"tx-2", target.ID().Value(),
+	)
+
+	if err := repo.Merge(source.ID(), target.ID()); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+
+	if merged, _ := repo.FindByID(source.ID()); merged != nil {
+		t.Error("Merge() source tag still exists")
+	}
+	if ids := tagTransactionIDs(t, db, source.ID()); len(ids) != 0 {
+		t.Errorf("Merge() left %d links to the source tag", len(ids))
+	}
+	ids := tagTransactionIDs(t, db, target.ID())
+	if len(ids) != 2 || ids[0] != "tx-1" || ids[1] != "tx-2" {
+		t.Errorf("Merge() target transactions = %v, want [tx-1 tx-2]", ids)
+	}
+}
@@ -0,0 +1,24 @@
+package persistence
+
+import (
+	"time"
+)
+
+// TagModel represents the database model for Tag entity.
+// This is the persistence model, separate from the domain entity.
+type TagModel struct {
+	ID        string    `gorm:"type:uuid;primary_key"`
+	UserID    string    `gorm:"type:uuid;index;not null"`
+	Name      string    `gorm:"type:varchar(50);not null"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// transactionTagsTable is the join table between transactions and tags.
+// It is owned by the Transaction context; the tag repository only rewrites it on delete and merge.
+const transactionTagsTable = "transaction_tags"
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/tag/application/dtos"
+	"gestao-financeira/backend/internal/tag/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// TagHandler handles tag-related HTTP requests.
+type TagHandler struct {
+	createTagUseCase *usecases.CreateTagUseCase
+	listTagsUseCase  *usecases.ListTagsUseCase
+	updateTagUseCase *usecases.UpdateTagUseCase
+	deleteTagUseCase *usecases.DeleteTagUseCase
+	mergeTagsUseCase *usecases.MergeTagsUseCase
+}
+
+// NewTagHandler creates a new TagHandler instance.
+func NewTagHandler(
+	createTagUseCase *usecases.CreateTagUseCase,
+	listTagsUseCase *usecases.ListTagsUseCase,
+	updateTagUseCase *usecases.UpdateTagUseCase,
+	deleteTagUseCase *usecases.DeleteTagUseCase,
+	mergeTagsUseCase *usecases.MergeTagsUseCase,
+) *TagHandler {
+	return &TagHandler{
+		createTagUseCase: createTagUseCase,
+		listTagsUseCase:  listTagsUseCase,
+		updateTagUseCase: updateTagUseCase,
+		deleteTagUseCase: deleteTagUseCase,
+		mergeTagsUseCase: mergeTagsUseCase,
+	}
+}
+
+// Create handles tag creation requests.
+// @Summary Create a new tag
+// @Description Creates a new tag for the authenticated user. Tags are free-form labels (e.g. "viagem-2026", "reembolsavel") that can be attached to any number of transactions, independently of the category.
+//
+// **Validações**:
+// - Nome é obrigatório, com até 50 caracteres (letras, números, espaço, `-` e `_`)
+// - Nome deve ser único para o usuário (case-insensitive)
+//
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreateTagInput true "Tag creation data" example({"name":"viagem-2026"})
+// @Success 201 {object} dtos.CreateTagOutput "Tag created successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid tag name" example({"error":"invalid tag name: tag name contains invalid characters","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 409 {object} map[string]interface{} "Conflict - tag with this name already exists" example({"error":"tag with this name already exists","error_type":"CONFLICT","code":409})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /tags [post]
+func (h *TagHandler) Create(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.CreateTagInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context
+	input.UserID = userID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.createTagUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Tag created successfully",
+		"data":    output,
+	})
+}
+
+// List handles tag listing requests.
+// @Summary List tags
+// @Description Lists all tags of the authenticated user ordered by name.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dtos.ListTagsOutput "Tags retrieved successfully"
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /tags [get]
+func (h *TagHandler) List(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Execute use case
+	output, err := h.listTagsUseCase.Execute(dtos.ListTagsInput{UserID: userID})
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Tags retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Update handles tag rename requests.
+// @Summary Rename a tag
+// @Description Renames a tag of the authenticated user. Transactions keep the tag, so they show the new name. Renaming to the name of another existing tag is rejected; use the merge endpoint instead.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Tag ID" example("550e8400-e29b-41d4-a716-446655440000")
+// @Param request body dtos.UpdateTagInput true "New tag name" example({"name":"viagem-europa-2026"})
+// @Success 200 {object} dtos.UpdateTagOutput "Tag updated successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid tag ID or name" example({"error":"invalid tag ID: invalid UUID format","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - tag does not belong to user" example({"error":"tag does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - tag does not exist" example({"error":"tag not found","error_type":"NOT_FOUND","code":404})
+// @Failure 409 {object} map[string]interface{} "Conflict - tag with this name already exists" example({"error":"tag with this name already exists","error_type":"CONFLICT","code":409})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /tags/{id} [put]
+func (h *TagHandler) Update(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	tagID := c.Params("id")
+	if tagID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Tag ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Parse request body
+	var input dtos.UpdateTagInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	input.UserID = userID
+	input.TagID = tagID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.updateTagUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Tag updated successfully",
+		"data":    output,
+	})
+}
+
+// Delete handles tag deletion requests.
+// @Summary Delete a tag
+// @Description Deletes a tag of the authenticated user. The tag is removed from every transaction it was attached to; the transactions themselves are not changed.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Tag ID" example("550e8400-e29b-41d4-a716-446655440000")
+// @Success 200 {object} dtos.DeleteTagOutput "Tag deleted successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid tag ID" example({"error":"invalid tag ID: invalid UUID format","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - tag does not belong to user" example({"error":"tag does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - tag does not exist" example({"error":"tag not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /tags/{id} [delete]
+func (h *TagHandler) Delete(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	tagID := c.Params("id")
+	if tagID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Tag ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Execute use case
+	output, err := h.deleteTagUseCase.Execute(dtos.DeleteTagInput{
+		UserID: userID,
+		TagID:  tagID,
+	})
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": output.Message,
+		"data":    output,
+	})
+}
+
+// Merge handles tag merge requests.
+// @Summary Merge a tag into another tag
+// @Description Moves every transaction tagged with the source tag (path) to the target tag and deletes the source tag. Transactions that already have both tags keep a single target tag. Both tags must belong to the authenticated user.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Source tag ID" example("550e8400-e29b-41d4-a716-446655440000")
+// @Param request body dtos.MergeTagsInput true "Target tag" example({"target_tag_id":"550e8400-e29b-41d4-a716-446655440001"})
+// @Success 200 {object} dtos.MergeTagsOutput "Tags merged successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid tag IDs or same source and target" example({"error":"source and target tags must be different","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - tag does not belong to user" example({"error":"tag does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - tag does not exist" example({"error":"tag not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /tags/{id}/merge [post]
+func (h *TagHandler) Merge(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	tagID := c.Params("id")
+	if tagID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Tag ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Parse request body
+	var input dtos.MergeTagsInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	input.UserID = userID
+	input.SourceTagID = tagID
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.mergeTagsUseCase.Execute(input)
+	if err != nil {
+		return h.handleUseCaseError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": output.Message,
+		"data":    output,
+	})
+}
+
+// handleUseCaseError handles errors from use cases and returns appropriate HTTP responses.
+func (h *TagHandler) handleUseCaseError(c *fiber.Ctx, err error) error {
+	// Map domain errors to AppError
+	appErr := apperrors.MapDomainError(err)
+
+	// Log error with appropriate level
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound || appErr.Type == apperrors.ErrorTypeConflict {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Str("request_id", middleware.GetRequestID(c)).Msg("Tag operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Str("request_id", middleware.GetRequestID(c)).Msg("Tag operation failed")
+	}
+
+	return appErr
+}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"gestao-financeira/backend/internal/identity/domain/repositories"
+	"gestao-financeira/backend/internal/identity/infrastructure/services"
+	"gestao-financeira/backend/internal/tag/presentation/handlers"
+	"gestao-financeira/backend/pkg/cache"
+	"gestao-financeira/backend/pkg/middleware"
+)
+
+// SetupTagRoutes configures tag routes.
+func SetupTagRoutes(router fiber.Router, tagHandler *handlers.TagHandler, jwtService *services.JWTService, userRepository repositories.UserRepository, cacheService *cache.CacheService) {
+	tags := router.Group("/tags")
+
+	// Apply authentication middleware to all tag routes
+	tags.Use(middleware.AuthMiddleware(middleware.AuthMiddlewareConfig{
+		JWTService:     jwtService,
+		UserRepository: userRepository,
+		CacheService:   cacheService,
+	}))
+
+	{
+		tags.Post("/", tagHandler.Create)
+		tags.Get("/", tagHandler.List)
+		tags.Put("/:id", tagHandler.Update)
+		tags.Delete("/:id", tagHandler.Delete)
+		tags.Post("/:id/merge", tagHandler.Merge)
+	}
+}
@@ -0,0 +1,62 @@
+package dtos
+
+import "io"
+
+// UploadAttachmentInput represents the input for attaching a file to a transaction.
+type UploadAttachmentInput struct {
+	UserID        string
+	TransactionID string
+	FileName      string
+	Content       []byte
+}
+
+// AttachmentOutput represents a file attached to a transaction.
+type AttachmentOutput struct {
+	AttachmentID  string `json:"attachment_id"`
+	TransactionID string `json:"transaction_id"`
+	FileName      string `json:"file_name"`
+	ContentType   string `json:"content_type"`
+	Size          int64  `json:"size"` // In bytes
+	CreatedAt     string `json:"created_at"`
+}
+
+// ListAttachmentsInput represents the input for listing the attachments of a transaction.
+type ListAttachmentsInput struct {
+	UserID        string
+	TransactionID string
+}
+
+// ListAttachmentsOutput represents the attachments of a transaction, oldest first.
+type ListAttachmentsOutput struct {
+	Attachments []AttachmentOutput `json:"attachments"`
+	Count       int                `json:"count"`
+}
+
+// DownloadAttachmentInput represents the input for downloading an attachment.
+type DownloadAttachmentInput struct {
+	UserID        string
+	TransactionID string
+	AttachmentID  string
+}
+
+// DownloadAttachmentOutput represents an attachment file being downloaded.
+// The caller must close Content.
+type DownloadAttachmentOutput struct {
+	FileName    string
+	ContentType string
+	Size        int64
+	Content     io.ReadCloser
+}
+
+// DeleteAttachmentInput represents the input for deleting an attachment.
+type DeleteAttachmentInput struct {
+	UserID        string
+	TransactionID string
+	AttachmentID  string
+}
+
+// DeleteAttachmentOutput represents the output after deleting an attachment.
+type DeleteAttachmentOutput struct {
+	Message      string `json:"message"`
+	AttachmentID string `json:"attachment_id"`
+}
@@ -0,0 +1,52 @@
+package dtos
+
+// BulkTransactionFilter selects the transactions of a bulk operation. It accepts the same
+// filters as the transaction list; dates use the YYYY-MM-DD format and every bound is inclusive.
+type BulkTransactionFilter struct {
+	AccountID     string   `json:"account_id,omitempty" validate:"omitempty,uuid"`
+	Type          string   `json:"type,omitempty" validate:"omitempty,oneof=INCOME EXPENSE TRANSFER_OUT TRANSFER_IN ADJUSTMENT_IN ADJUSTMENT_OUT"`
+	Status        string   `json:"status,omitempty" validate:"omitempty,oneof=PENDING CLEARED RECONCILED"`
+	PayeeID       string   `json:"payee_id,omitempty" validate:"omitempty,uuid"`
+	TagIDs        []string `json:"tag_ids,omitempty" validate:"omitempty,dive,uuid"`
+	TagMatch      string   `json:"tag_match,omitempty" validate:"omitempty,oneof=any all"`
+	StartDate     string   `json:"start_date,omitempty"`
+	EndDate       string   `json:"end_date,omitempty"`
+	MinAmount     string   `json:"min_amount,omitempty"`
+	MaxAmount     string   `json:"max_amount,omitempty"`
+	Currency      string   `json:"currency,omitempty"`
+	Search        string   `json:"q,omitempty" validate:"omitempty,max=200"`
+	RecurringOnly bool     `json:"recurring,omitempty"`
+}
+
+// BulkTransactionsInput represents the input for applying one operation to many transactions at once.
+// The transactions are given either by ID or by a filter, never both. RESTORE only accepts IDs,
+// since filters do not match deleted transactions.
+type BulkTransactionsInput struct {
+	UserID         string                 `json:"user_id" validate:"required,uuid"`
+	Operation      string                 `json:"operation" validate:"required,oneof=RECATEGORIZE CHANGE_ACCOUNT ADD_TAGS REMOVE_TAGS DELETE RESTORE"`
+	TransactionIDs []string               `json:"transaction_ids,omitempty" validate:"omitempty,max=500,dive,uuid"`
+	Filter         *BulkTransactionFilter `json:"filter,omitempty"`
+	CategoryID     string                 `json:"category_id,omitempty" validate:"omitempty,uuid"`  // RECATEGORIZE (empty removes the category)
+	AccountID      string                 `json:"account_id,omitempty" validate:"omitempty,uuid"`   // CHANGE_ACCOUNT
+	TagIDs         []string               `json:"tag_ids,omitempty" validate:"omitempty,dive,uuid"` // ADD_TAGS and REMOVE_TAGS
+	RequestID      string                 `json:"-"`                                                // Identifies the HTTP request in the edit history
+}
+
+// BulkTransactionResult represents the outcome of a bulk operation for a single transaction.
+type BulkTransactionResult struct {
+	TransactionID string `json:"transaction_id"`
+	Status        string `json:"status"` // UPDATED, UNCHANGED or FAILED
+	Error         string `json:"error,omitempty"`
+}
+
+// BulkTransactionsOutput represents the result of a bulk operation. The operation is atomic:
+// when any transaction fails, Applied is false and none of the changes are kept.
+type BulkTransactionsOutput struct {
+	Operation      string                  `json:"operation"`
+	Applied        bool                    `json:"applied"`
+	Count          int                     `json:"count"`
+	UpdatedCount   int                     `json:"updated_count"`
+	UnchangedCount int                     `json:"unchanged_count"`
+	FailedCount    int                     `json:"failed_count"`
+	Results        []BulkTransactionResult `json:"results"`
+}
@@ -9,17 +9,83 @@ type CreateTransactionInput struct {
 	Currency    string  `json:"currency" validate:"required,oneof=BRL USD EUR"`
 	Description string  `json:"description" validate:"required,min=3,max=500,no_sql_injection,no_xss,utf8"`
 	Date        string  `json:"date" validate:"required"` // ISO 8601 format: YYYY-MM-DD
+	CategoryID  string  `json:"category_id,omitempty" validate:"omitempty,uuid"`
+	// Splits spreads the amount across several categories (at least two lines).
+	// Lines without an amount share whatever is left of the transaction amount.
+	Splits []TransactionSplitInput `json:"splits,omitempty" validate:"omitempty,min=2,dive"`
+	// TagIDs attaches tags of the user to the transaction.
+	TagIDs []string `json:"tag_ids,omitempty" validate:"omitempty,dive,uuid"`
+	// PayeeID sets the payee of the transaction. When empty, the payee is matched from the
+	// description using the names and aliases of the user's payees.
+	PayeeID string `json:"payee_id,omitempty" validate:"omitempty,uuid"`
+	// ExchangeRate converts an amount in a currency other than the account currency into the
+	// account currency (account currency units per unit of Currency). ConvertedAmount, the amount
+	// charged in the account currency, may be given instead. One of them is required when
+	// Currency differs from the account currency; the account balance always moves by the
+	// converted amount.
+	ExchangeRate    *float64 `json:"exchange_rate,omitempty" validate:"omitempty,gt=0"`
+	ConvertedAmount *float64 `json:"converted_amount,omitempty" validate:"omitempty,gt=0"`
+	// IOFRate adds the IOF (tax on foreign purchases) of a foreign currency transaction as a
+	// separate expense linked to it, as a percentage of the converted amount (e.g. 3.5).
+	IOFRate *float64 `json:"iof_rate,omitempty" validate:"omitempty,gt=0,lte=100"`
+	// RequestID identifies the HTTP request in the transaction edit history.
+	RequestID string `json:"-"`
+}
+
+// TransactionSplitInput represents a split line of a transaction.
+type TransactionSplitInput struct {
+	CategoryID string   `json:"category_id" validate:"required,uuid"`
+	Amount     *float64 `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	Memo       string   `json:"memo,omitempty" validate:"omitempty,max=255,no_sql_injection,no_xss,utf8"`
+}
+
+// TransactionSplitOutput represents a split line of a transaction in responses.
+type TransactionSplitOutput struct {
+	CategoryID string  `json:"category_id"`
+	Amount     float64 `json:"amount"`
+	Currency   string  `json:"currency"`
+	Memo       string  `json:"memo,omitempty"`
 }
 
 // CreateTransactionOutput represents the output data after transaction creation.
 type CreateTransactionOutput struct {
+	TransactionID string                   `json:"transaction_id"`
+	UserID        string                   `json:"user_id"`
+	AccountID     string                   `json:"account_id"`
+	Type          string                   `json:"type"`
+	Amount        float64                  `json:"amount"`
+	Currency      string                   `json:"currency"`
+	Description   string                   `json:"description"`
+	Date          string                   `json:"date"`
+	CategoryID    string                   `json:"category_id,omitempty"`
+	Splits        []TransactionSplitOutput `json:"splits,omitempty"`
+	TagIDs        []string                 `json:"tag_ids,omitempty"`
+	PayeeID       string                   `json:"payee_id,omitempty"`
+	CreatedAt     string                   `json:"created_at"`
+	// CurrencyConversion is set when the transaction was made in a foreign currency;
+	// Amount and Currency are then the converted amount in the account currency.
+	CurrencyConversion *CurrencyConversionOutput `json:"currency_conversion,omitempty"`
+	// IOFTransaction is the IOF expense created for the transaction when IOFRate was given.
+	IOFTransaction *FeeTransactionOutput `json:"iof_transaction,omitempty"`
+	// PossibleDuplicateIDs lists existing transactions that look like the same purchase
+	// (see GET /transactions/duplicates). The transaction is created anyway.
+	PossibleDuplicateIDs []string `json:"possible_duplicate_ids,omitempty"`
+	// AppliedRuleIDs lists the auto-categorization rules that changed the transaction.
+	AppliedRuleIDs []string `json:"applied_rule_ids,omitempty"`
+}
+
+// CurrencyConversionOutput represents the original amount and exchange rate of a transaction
+// made in a foreign currency.
+type CurrencyConversionOutput struct {
+	OriginalAmount   float64 `json:"original_amount"`
+	OriginalCurrency string  `json:"original_currency"`
+	ExchangeRate     float64 `json:"exchange_rate"` // Account currency units per unit of the original currency
+}
+
+// FeeTransactionOutput represents a fee charged for a transaction, e.g. the IOF of a foreign purchase.
+type FeeTransactionOutput struct {
 	TransactionID string  `json:"transaction_id"`
-	UserID        string  `json:"user_id"`
-	AccountID     string  `json:"account_id"`
-	Type          string  `json:"type"`
 	Amount        float64 `json:"amount"`
 	Currency      string  `json:"currency"`
 	Description   string  `json:"description"`
-	Date          string  `json:"date"`
-	CreatedAt     string  `json:"created_at"`
 }
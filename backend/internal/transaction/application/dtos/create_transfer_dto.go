@@ -0,0 +1,31 @@
+package dtos
+
+// CreateTransferInput represents the input data for a transfer between two accounts of the same user.
+// DestinationAmount is required when the accounts use different currencies and must be omitted
+// (or equal to Amount) when they share the same currency.
+type CreateTransferInput struct {
+	UserID            string   `json:"user_id" validate:"required,uuid"`
+	FromAccountID     string   `json:"from_account_id" validate:"required,uuid"`
+	ToAccountID       string   `json:"to_account_id" validate:"required,uuid,nefield=FromAccountID"`
+	Amount            float64  `json:"amount" validate:"required,gt=0"`                        // In the source account currency
+	DestinationAmount *float64 `json:"destination_amount,omitempty" validate:"omitempty,gt=0"` // In the destination account currency
+	Description       string   `json:"description" validate:"required,min=3,max=500,no_sql_injection,no_xss,utf8"`
+	Date              string   `json:"date" validate:"required"` // ISO 8601 format: YYYY-MM-DD
+	RequestID         string   `json:"-"`                        // Identifies the HTTP request in the edit history
+}
+
+// CreateTransferOutput represents the output data after transfer creation.
+type CreateTransferOutput struct {
+	OutgoingTransactionID string  `json:"outgoing_transaction_id"`
+	IncomingTransactionID string  `json:"incoming_transaction_id"`
+	UserID                string  `json:"user_id"`
+	FromAccountID         string  `json:"from_account_id"`
+	ToAccountID           string  `json:"to_account_id"`
+	Amount                float64 `json:"amount"`
+	Currency              string  `json:"currency"`
+	DestinationAmount     float64 `json:"destination_amount"`
+	DestinationCurrency   string  `json:"destination_currency"`
+	Description           string  `json:"description"`
+	Date                  string  `json:"date"`
+	CreatedAt             string  `json:"created_at"`
+}
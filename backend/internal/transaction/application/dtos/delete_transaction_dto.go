@@ -3,6 +3,8 @@ package dtos
 // DeleteTransactionInput represents the input for deleting a transaction.
 type DeleteTransactionInput struct {
 	TransactionID string `json:"transaction_id" validate:"required,uuid"`
+	ActorID       string `json:"-"` // User making the change, recorded in the edit history
+	RequestID     string `json:"-"` // HTTP request making the change, recorded in the edit history
 }
 
 // DeleteTransactionOutput represents the output after transaction deletion.
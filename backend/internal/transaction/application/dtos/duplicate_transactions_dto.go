@@ -0,0 +1,41 @@
+package dtos
+
+// ListDuplicateTransactionsInput represents the input for listing likely duplicate transactions.
+// Dates use the YYYY-MM-DD format; the default range is the last 90 days.
+type ListDuplicateTransactionsInput struct {
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	AccountID string `json:"account_id,omitempty" validate:"omitempty,uuid"`
+	StartDate string `json:"start_date,omitempty"` // Query parameter
+	EndDate   string `json:"end_date,omitempty"`   // Query parameter
+}
+
+// DuplicatePairOutput represents two transactions that likely are the same purchase.
+type DuplicatePairOutput struct {
+	Original   *TransactionOutput `json:"original"`   // Created first
+	Duplicate  *TransactionOutput `json:"duplicate"`  // Created later
+	Similarity float64            `json:"similarity"` // Description similarity, from 0 to 1
+	DaysApart  int                `json:"days_apart"`
+}
+
+// ListDuplicateTransactionsOutput represents the output for listing likely duplicate transactions.
+type ListDuplicateTransactionsOutput struct {
+	Pairs     []DuplicatePairOutput `json:"pairs"`
+	Count     int                   `json:"count"`
+	StartDate string                `json:"start_date"`
+	EndDate   string                `json:"end_date"`
+}
+
+// MergeDuplicateTransactionsInput represents the input for merging a duplicate into the transaction that is kept.
+type MergeDuplicateTransactionsInput struct {
+	UserID                 string `json:"user_id" validate:"required,uuid"`
+	KeepTransactionID      string `json:"keep_transaction_id" validate:"required,uuid"`
+	DuplicateTransactionID string `json:"duplicate_transaction_id" validate:"required,uuid"`
+}
+
+// MergeDuplicateTransactionsOutput represents the output after two duplicate transactions were merged.
+type MergeDuplicateTransactionsOutput struct {
+	Transaction          *TransactionOutput `json:"transaction"` // The transaction that was kept
+	RemovedTransactionID string             `json:"removed_transaction_id"`
+	AccountBalance       float64            `json:"account_balance"`
+	Currency             string             `json:"currency"`
+}
@@ -0,0 +1,21 @@
+package dtos
+
+import "io"
+
+// ExportTransactionsInput represents the input for exporting transactions.
+// The filters and sorting are the same as the list endpoint; pagination is ignored,
+// since every matching transaction is exported.
+type ExportTransactionsInput struct {
+	ListTransactionsInput
+	Format string `json:"format,omitempty" validate:"omitempty,oneof=csv xlsx json"` // Default: csv
+	Locale string `json:"locale,omitempty" validate:"omitempty,oneof=pt-BR en"`      // CSV number/date formatting and headers. Default: pt-BR
+}
+
+// ExportTransactionsOutput represents a transaction export being downloaded.
+// Content is produced while it is read; the caller must close it, which also
+// stops the export if it has not finished.
+type ExportTransactionsOutput struct {
+	FileName    string
+	ContentType string
+	Content     io.ReadCloser
+}
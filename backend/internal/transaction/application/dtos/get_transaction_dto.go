@@ -8,14 +8,25 @@ type GetTransactionInput struct {
 // GetTransactionOutput represents the output for getting a single transaction.
 // Uses the same structure as TransactionOutput from list_transactions_dto.go
 type GetTransactionOutput struct {
-	TransactionID string  `json:"transaction_id"`
-	UserID        string  `json:"user_id"`
-	AccountID     string  `json:"account_id"`
-	Type          string  `json:"type"`
-	Amount        float64 `json:"amount"`
-	Currency      string  `json:"currency"`
-	Description   string  `json:"description"`
-	Date          string  `json:"date"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
+	TransactionID       string                        `json:"transaction_id"`
+	UserID              string                        `json:"user_id"`
+	AccountID           string                        `json:"account_id"`
+	Type                string                        `json:"type"`
+	Amount              float64                       `json:"amount"`
+	Currency            string                        `json:"currency"`
+	Description         string                        `json:"description"`
+	Date                string                        `json:"date"`
+	CategoryID          string                        `json:"category_id,omitempty"`
+	LinkedTransactionID string                        `json:"linked_transaction_id,omitempty"` // Counterpart leg (transfers only)
+	Splits              []TransactionSplitOutput      `json:"splits,omitempty"`
+	TagIDs              []string                      `json:"tag_ids,omitempty"`
+	PayeeID             string                        `json:"payee_id,omitempty"`
+	ImportBatchID       string                        `json:"import_batch_id,omitempty"`        // Statement import the transaction came from
+	Status              string                        `json:"status"`                           // PENDING, CLEARED or RECONCILED
+	ReconciliationID    string                        `json:"reconciliation_id,omitempty"`      // Reconciliation that locked the transaction
+	Installment         *TransactionInstallmentOutput `json:"installment,omitempty"`            // Set when the transaction is an installment of a purchase
+	CurrencyConversion  *CurrencyConversionOutput     `json:"currency_conversion,omitempty"`    // Set when the transaction was made in a foreign currency
+	FeeForTransactionID string                        `json:"fee_for_transaction_id,omitempty"` // Purchase the fee (e.g. IOF) was charged for
+	CreatedAt           string                        `json:"created_at"`
+	UpdatedAt           string                        `json:"updated_at"`
 }
@@ -0,0 +1,170 @@
+package dtos
+
+// CSVImportInput represents the input for previewing or importing a CSV bank statement.
+// It is sent as multipart/form-data together with the statement file; the handler fills
+// UserID, FileName and Content. Columns are given by header name or 1-based position, and
+// the amount comes either from AmountColumn (signed) or from DebitColumn and CreditColumn.
+type CSVImportInput struct {
+	UserID            string `json:"user_id" form:"-" validate:"required,uuid"`
+	AccountID         string `json:"account_id" form:"account_id" validate:"required,uuid"`
+	FileName          string `json:"-" form:"-"`
+	Content           []byte `json:"-" form:"-"`
+	Delimiter         string `json:"delimiter,omitempty" form:"delimiter"`   // ",", ";", "tab" or "|" (default ",")
+	HasHeader         *bool  `json:"has_header,omitempty" form:"has_header"` // Default true
+	DateColumn        string `json:"date_column" form:"date_column" validate:"required"`
+	DateFormat        string `json:"date_format" form:"date_format" validate:"required"` // e.g. DD/MM/YYYY, YYYY-MM-DD
+	AmountColumn      string `json:"amount_column,omitempty" form:"amount_column"`
+	DebitColumn       string `json:"debit_column,omitempty" form:"debit_column"`
+	CreditColumn      string `json:"credit_column,omitempty" form:"credit_column"`
+	DescriptionColumn string `json:"description_column" form:"description_column" validate:"required"`
+	DecimalSeparator  string `json:"decimal_separator,omitempty" form:"decimal_separator"` // "," or "." (default ".")
+	SkipInvalidRows   bool   `json:"skip_invalid_rows,omitempty" form:"skip_invalid_rows"` // Import only: ignore rows with errors
+}
+
+// StatementImportInput represents the input for previewing or importing an OFX or QIF bank statement.
+// It is sent as multipart/form-data together with the statement file; the handler fills
+// UserID, FileName and Content.
+type StatementImportInput struct {
+	UserID           string `json:"user_id" form:"-" validate:"required,uuid"`
+	AccountID        string `json:"account_id" form:"account_id" validate:"required,uuid"`
+	FileName         string `json:"-" form:"-"`
+	Content          []byte `json:"-" form:"-"`
+	Format           string `json:"format,omitempty" form:"format"`                       // OFX or QIF (default: from the file extension)
+	DateFormat       string `json:"date_format,omitempty" form:"date_format"`             // QIF only (default DD/MM/YYYY)
+	SkipInvalidRows  bool   `json:"skip_invalid_rows,omitempty" form:"skip_invalid_rows"` // Import only: ignore entries with errors
+	ReconcileBalance bool   `json:"reconcile_balance,omitempty" form:"reconcile_balance"` // Import only: fail if the balance does not match the statement
+}
+
+// ImportRowOutput represents a statement line that will be (or was) imported as a transaction.
+type ImportRowOutput struct {
+	Line        int      `json:"line"`
+	ExternalID  string   `json:"external_id,omitempty"` // Bank identifier of the entry (OFX FITID)
+	Date        string   `json:"date"`
+	Type        string   `json:"type"` // INCOME or EXPENSE
+	Amount      float64  `json:"amount"`
+	Currency    string   `json:"currency"`
+	Description string   `json:"description"` // After the auto-categorization rules ran
+	CategoryID  string   `json:"category_id,omitempty"`
+	TagIDs      []string `json:"tag_ids,omitempty"`
+	PayeeID     string   `json:"payee_id,omitempty"` // Matched from the description
+	// AppliedRuleIDs lists the auto-categorization rules that matched the row.
+	AppliedRuleIDs []string `json:"applied_rule_ids,omitempty"`
+	// PossibleDuplicateOf is an existing transaction that looks like the same purchase
+	// (e.g. entered manually before the statement was imported).
+	PossibleDuplicateOf string `json:"possible_duplicate_of,omitempty"`
+}
+
+// ImportRowErrorOutput represents a statement line that could not be parsed.
+type ImportRowErrorOutput struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// PreviewCSVImportOutput represents the dry-run result of a CSV import.
+// Nothing is saved; the totals only include the valid rows.
+type PreviewCSVImportOutput struct {
+	AccountID    string                 `json:"account_id"`
+	Currency     string                 `json:"currency"`
+	Rows         []ImportRowOutput      `json:"rows"`
+	Errors       []ImportRowErrorOutput `json:"errors"`
+	ValidCount   int                    `json:"valid_count"`
+	ErrorCount   int                    `json:"error_count"`
+	TotalIncome  float64                `json:"total_income"`
+	TotalExpense float64                `json:"total_expense"`
+	NetAmount    float64                `json:"net_amount"`
+}
+
+// ImportCSVOutput represents the output after a CSV statement was imported.
+type ImportCSVOutput struct {
+	BatchID            string                 `json:"batch_id"`
+	AccountID          string                 `json:"account_id"`
+	TransactionCount   int                    `json:"transaction_count"`
+	SkippedRows        []ImportRowErrorOutput `json:"skipped_rows,omitempty"`
+	PossibleDuplicates int                    `json:"possible_duplicates"` // Imported rows that look like existing transactions
+	Currency           string                 `json:"currency"`
+	NetAmount          float64                `json:"net_amount"`
+	AccountBalance     float64                `json:"account_balance"`
+	CreatedAt          string                 `json:"created_at"`
+}
+
+// BalanceReconciliationOutput compares the account balance with the closing balance reported
+// in the statement (OFX LEDGERBAL).
+type BalanceReconciliationOutput struct {
+	StatementBalance float64 `json:"statement_balance"`
+	StatementDate    string  `json:"statement_date"`
+	AccountBalance   float64 `json:"account_balance"` // Balance after the import
+	Difference       float64 `json:"difference"`      // Account balance minus statement balance
+	Reconciled       bool    `json:"reconciled"`
+}
+
+// PreviewStatementImportOutput represents the dry-run result of an OFX or QIF import.
+// Entries already imported into the account (same external ID) are listed as duplicates
+// and left out of the totals.
+type PreviewStatementImportOutput struct {
+	AccountID      string                       `json:"account_id"`
+	Format         string                       `json:"format"`
+	Currency       string                       `json:"currency"`
+	Rows           []ImportRowOutput            `json:"rows"`
+	Duplicates     []ImportRowOutput            `json:"duplicates"`
+	Errors         []ImportRowErrorOutput       `json:"errors"`
+	ValidCount     int                          `json:"valid_count"`
+	DuplicateCount int                          `json:"duplicate_count"`
+	ErrorCount     int                          `json:"error_count"`
+	TotalIncome    float64                      `json:"total_income"`
+	TotalExpense   float64                      `json:"total_expense"`
+	NetAmount      float64                      `json:"net_amount"`
+	Reconciliation *BalanceReconciliationOutput `json:"reconciliation,omitempty"`
+}
+
+// ImportStatementOutput represents the output after an OFX or QIF statement was imported.
+type ImportStatementOutput struct {
+	BatchID            string                       `json:"batch_id"`
+	AccountID          string                       `json:"account_id"`
+	Format             string                       `json:"format"`
+	TransactionCount   int                          `json:"transaction_count"`
+	DuplicateCount     int                          `json:"duplicate_count"`
+	SkippedRows        []ImportRowErrorOutput       `json:"skipped_rows,omitempty"`
+	PossibleDuplicates int                          `json:"possible_duplicates"` // Imported entries that look like existing transactions
+	Currency           string                       `json:"currency"`
+	NetAmount          float64                      `json:"net_amount"`
+	AccountBalance     float64                      `json:"account_balance"`
+	Reconciliation     *BalanceReconciliationOutput `json:"reconciliation,omitempty"`
+	CreatedAt          string                       `json:"created_at"`
+}
+
+// ImportBatchOutput represents a statement import batch.
+type ImportBatchOutput struct {
+	BatchID          string `json:"batch_id"`
+	AccountID        string `json:"account_id"`
+	Source           string `json:"source"`
+	FileName         string `json:"file_name"`
+	Status           string `json:"status"` // COMMITTED or ROLLED_BACK
+	TransactionCount int    `json:"transaction_count"`
+	CreatedAt        string `json:"created_at"`
+	RolledBackAt     string `json:"rolled_back_at,omitempty"`
+}
+
+// ListImportBatchesInput represents the input for listing the import batches of a user.
+type ListImportBatchesInput struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
+
+// ListImportBatchesOutput represents the output for listing import batches.
+type ListImportBatchesOutput struct {
+	Batches []ImportBatchOutput `json:"batches"`
+	Count   int                 `json:"count"`
+}
+
+// RollbackImportBatchInput represents the input for rolling back an import batch.
+type RollbackImportBatchInput struct {
+	UserID  string `json:"user_id" validate:"required,uuid"`
+	BatchID string `json:"batch_id" validate:"required,uuid"`
+}
+
+// RollbackImportBatchOutput represents the output after an import batch was rolled back.
+type RollbackImportBatchOutput struct {
+	BatchID             string `json:"batch_id"`
+	Status              string `json:"status"`
+	RemovedTransactions int    `json:"removed_transactions"`
+	RolledBackAt        string `json:"rolled_back_at"`
+}
@@ -0,0 +1,91 @@
+package dtos
+
+// CreateInstallmentPurchaseInput represents the input for creating a purchase paid in monthly
+// installments ("parcelamento") on a credit card.
+type CreateInstallmentPurchaseInput struct {
+	UserID       string   `json:"user_id" validate:"required,uuid"`
+	AccountID    string   `json:"account_id" validate:"required,uuid"`
+	Amount       float64  `json:"amount" validate:"required,gt=0"` // Purchase price (before interest)
+	Currency     string   `json:"currency" validate:"required,oneof=BRL USD EUR"`
+	Description  string   `json:"description" validate:"required,min=3,max=500,no_sql_injection,no_xss,utf8"`
+	FirstDate    string   `json:"first_date" validate:"required"` // Date of the first installment (YYYY-MM-DD)
+	Installments int      `json:"installments" validate:"required,min=2,max=48"`
+	InterestRate float64  `json:"interest_rate,omitempty" validate:"omitempty,gte=0,lte=30"` // Monthly interest rate in percent (0 = "sem juros")
+	CategoryID   string   `json:"category_id,omitempty" validate:"omitempty,uuid"`
+	TagIDs       []string `json:"tag_ids,omitempty" validate:"omitempty,dive,uuid"`
+}
+
+// GetInstallmentPurchaseInput represents the input for getting an installment purchase.
+// TransactionID may be the ID of any of its installments.
+type GetInstallmentPurchaseInput struct {
+	UserID        string `json:"user_id" validate:"required,uuid"`
+	TransactionID string `json:"transaction_id" validate:"required,uuid"`
+}
+
+// CancelInstallmentPurchaseInput represents the input for canceling the installments of a
+// purchase that are not due yet.
+type CancelInstallmentPurchaseInput struct {
+	UserID        string `json:"user_id" validate:"required,uuid"`
+	TransactionID string `json:"transaction_id" validate:"required,uuid"`
+	Date          string `json:"date,omitempty"` // Installments dated after this date are canceled (YYYY-MM-DD, default today)
+}
+
+// PrepayInstallmentsInput represents the input for paying the remaining installments of a
+// purchase in advance ("antecipação de parcelas").
+type PrepayInstallmentsInput struct {
+	UserID        string   `json:"user_id" validate:"required,uuid"`
+	TransactionID string   `json:"transaction_id" validate:"required,uuid"`
+	Date          string   `json:"date,omitempty"`                             // Payment date; installments dated after it are prepaid (YYYY-MM-DD, default today)
+	Amount        *float64 `json:"amount,omitempty" validate:"omitempty,gt=0"` // Amount actually paid, when the card issuer gives a discount (default: remaining total)
+}
+
+// TransactionInstallmentOutput represents the position of a transaction within an installment purchase.
+type TransactionInstallmentOutput struct {
+	PurchaseID string `json:"purchase_id"` // ID of the first installment
+	Number     int    `json:"number"`
+	Count      int    `json:"count"`
+	Label      string `json:"label"` // e.g. "3/10"
+}
+
+// InstallmentOutput represents an installment of a purchase.
+type InstallmentOutput struct {
+	TransactionID string  `json:"transaction_id"`
+	Number        int     `json:"number"`
+	Label         string  `json:"label"` // e.g. "3/10"
+	Amount        float64 `json:"amount"`
+	Date          string  `json:"date"`
+	Status        string  `json:"status"`
+}
+
+// InstallmentPurchaseOutput represents a purchase paid in installments.
+// Canceled installments are no longer listed, so the list may be shorter than InstallmentCount.
+type InstallmentPurchaseOutput struct {
+	PurchaseID       string              `json:"purchase_id"` // ID of the first installment
+	AccountID        string              `json:"account_id"`
+	Description      string              `json:"description"`
+	Currency         string              `json:"currency"`
+	InstallmentCount int                 `json:"installment_count"`
+	TotalAmount      float64             `json:"total_amount"`     // Sum of the installments listed
+	RemainingCount   int                 `json:"remaining_count"`  // Installments dated after today
+	RemainingAmount  float64             `json:"remaining_amount"` // Sum of the installments dated after today
+	Installments     []InstallmentOutput `json:"installments"`
+
+	// Set on creation only
+	Price          *float64 `json:"price,omitempty"`           // Purchase price before interest
+	InterestAmount *float64 `json:"interest_amount,omitempty"` // Total interest over the price
+}
+
+// CancelInstallmentPurchaseOutput represents the result of canceling the remaining installments of a purchase.
+type CancelInstallmentPurchaseOutput struct {
+	Purchase       InstallmentPurchaseOutput `json:"purchase"`
+	CanceledCount  int                       `json:"canceled_count"`
+	CanceledAmount float64                   `json:"canceled_amount"` // Credited back to the account
+}
+
+// PrepayInstallmentsOutput represents the result of prepaying the remaining installments of a purchase.
+type PrepayInstallmentsOutput struct {
+	Purchase       InstallmentPurchaseOutput `json:"purchase"`
+	PrepaidCount   int                       `json:"prepaid_count"`
+	PrepaidAmount  float64                   `json:"prepaid_amount"`  // Amount paid for the prepaid installments
+	DiscountAmount float64                   `json:"discount_amount"` // Credited back to the account
+}
@@ -6,23 +6,55 @@ import "gestao-financeira/backend/pkg/pagination"
 type ListTransactionsInput struct {
 	UserID    string `json:"user_id" validate:"required,uuid"`
 	AccountID string `json:"account_id,omitempty" validate:"omitempty,uuid"`
-	Type      string `json:"type,omitempty" validate:"omitempty,oneof=INCOME EXPENSE"`
-	Page      string `json:"page,omitempty"`  // Query parameter
-	Limit     string `json:"limit,omitempty"` // Query parameter
+	Type      string `json:"type,omitempty" validate:"omitempty,oneof=INCOME EXPENSE TRANSFER_OUT TRANSFER_IN ADJUSTMENT_IN ADJUSTMENT_OUT"`
+	Status    string `json:"status,omitempty" validate:"omitempty,oneof=PENDING CLEARED RECONCILED"`
+	PayeeID   string `json:"payee_id,omitempty" validate:"omitempty,uuid"`
+	// TagIDs filters by tags; TagMatch "any" (default) returns transactions with at least
+	// one of the tags and "all" returns transactions with every tag.
+	TagIDs   []string `json:"tag_ids,omitempty" validate:"omitempty,dive,uuid"`
+	TagMatch string   `json:"tag_match,omitempty" validate:"omitempty,oneof=any all"`
+	// Dates use the YYYY-MM-DD format and amounts are decimal values; every bound is inclusive.
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+	MinAmount string `json:"min_amount,omitempty"`
+	MaxAmount string `json:"max_amount,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	// Search is a full-text query on the description that ignores accents and word variations.
+	Search        string `json:"q,omitempty" validate:"omitempty,max=200"`
+	RecurringOnly bool   `json:"recurring,omitempty"`                                                  // Recurring transactions and their occurrences
+	SortBy        string `json:"sort_by,omitempty" validate:"omitempty,oneof=date amount description"` // Default: date
+	SortOrder     string `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`             // Default: desc
+	Page          string `json:"page,omitempty"`                                                       // Query parameter
+	Limit         string `json:"limit,omitempty"`                                                      // Query parameter
+	// Pagination "cursor" lists the first page with cursor (keyset) pagination instead of offsets;
+	// Cursor continues from the next_cursor or prev_cursor of a previous page.
+	Pagination string `json:"pagination,omitempty" validate:"omitempty,oneof=offset cursor"`
+	Cursor     string `json:"cursor,omitempty" validate:"omitempty,max=1024"`
 }
 
 // TransactionOutput represents a single transaction in the list.
 type TransactionOutput struct {
-	TransactionID string  `json:"transaction_id"`
-	UserID        string  `json:"user_id"`
-	AccountID     string  `json:"account_id"`
-	Type          string  `json:"type"`
-	Amount        float64 `json:"amount"`
-	Currency      string  `json:"currency"`
-	Description   string  `json:"description"`
-	Date          string  `json:"date"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
+	TransactionID       string                        `json:"transaction_id"`
+	UserID              string                        `json:"user_id"`
+	AccountID           string                        `json:"account_id"`
+	Type                string                        `json:"type"`
+	Amount              float64                       `json:"amount"`
+	Currency            string                        `json:"currency"`
+	Description         string                        `json:"description"`
+	Date                string                        `json:"date"`
+	CategoryID          string                        `json:"category_id,omitempty"`
+	LinkedTransactionID string                        `json:"linked_transaction_id,omitempty"` // Counterpart leg (transfers only)
+	Splits              []TransactionSplitOutput      `json:"splits,omitempty"`
+	TagIDs              []string                      `json:"tag_ids,omitempty"`
+	PayeeID             string                        `json:"payee_id,omitempty"`
+	ImportBatchID       string                        `json:"import_batch_id,omitempty"`        // Statement import the transaction came from
+	Status              string                        `json:"status"`                           // PENDING, CLEARED or RECONCILED
+	ReconciliationID    string                        `json:"reconciliation_id,omitempty"`      // Reconciliation that locked the transaction
+	Installment         *TransactionInstallmentOutput `json:"installment,omitempty"`            // Set when the transaction is an installment of a purchase
+	CurrencyConversion  *CurrencyConversionOutput     `json:"currency_conversion,omitempty"`    // Set when the transaction was made in a foreign currency
+	FeeForTransactionID string                        `json:"fee_for_transaction_id,omitempty"` // Purchase the fee (e.g. IOF) was charged for
+	CreatedAt           string                        `json:"created_at"`
+	UpdatedAt           string                        `json:"updated_at"`
 }
 
 // ListTransactionsOutput represents the output for listing transactions.
@@ -30,4 +62,5 @@ type ListTransactionsOutput struct {
 	Transactions []*TransactionOutput         `json:"transactions"`
 	Count        int                          `json:"count"`
 	Pagination   *pagination.PaginationResult `json:"pagination,omitempty"`
+	Cursor       *pagination.CursorResult     `json:"cursor_pagination,omitempty"` // Set instead of Pagination with cursor pagination
 }
@@ -7,6 +7,7 @@ type PermanentDeleteTransactionInput struct {
 
 // PermanentDeleteTransactionOutput represents the output after permanent transaction deletion.
 type PermanentDeleteTransactionOutput struct {
-	Message       string `json:"message"`
-	TransactionID string `json:"transaction_id"`
+	Message            string `json:"message"`
+	TransactionID      string `json:"transaction_id"`
+	DeletedAttachments int    `json:"deleted_attachments"` // Attachment files removed with the transaction
 }
@@ -0,0 +1,77 @@
+package dtos
+
+// StartReconciliationInput represents the input for starting the reconciliation of an account
+// against a bank statement.
+type StartReconciliationInput struct {
+	UserID           string  `json:"user_id" validate:"required,uuid"`
+	AccountID        string  `json:"account_id" validate:"required,uuid"`
+	StatementDate    string  `json:"statement_date" validate:"required"` // End date of the statement (YYYY-MM-DD)
+	StatementBalance float64 `json:"statement_balance"`                  // Closing balance of the statement, in the account currency
+}
+
+// GetReconciliationInput represents the input for getting a reconciliation.
+type GetReconciliationInput struct {
+	UserID           string `json:"user_id" validate:"required,uuid"`
+	ReconciliationID string `json:"reconciliation_id" validate:"required,uuid"`
+}
+
+// ListReconciliationsInput represents the input for listing the reconciliation history of an account.
+type ListReconciliationsInput struct {
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	AccountID string `json:"account_id" validate:"required,uuid"`
+}
+
+// CompleteReconciliationInput represents the input for completing a reconciliation.
+type CompleteReconciliationInput struct {
+	UserID           string `json:"user_id" validate:"required,uuid"`
+	ReconciliationID string `json:"reconciliation_id" validate:"required,uuid"`
+}
+
+// CancelReconciliationInput represents the input for canceling a reconciliation.
+type CancelReconciliationInput struct {
+	UserID           string `json:"user_id" validate:"required,uuid"`
+	ReconciliationID string `json:"reconciliation_id" validate:"required,uuid"`
+}
+
+// ReconciliationTransactionOutput represents an unreconciled transaction dated up to the statement date.
+type ReconciliationTransactionOutput struct {
+	TransactionID string  `json:"transaction_id"`
+	Type          string  `json:"type"`
+	Amount        float64 `json:"amount"`
+	Description   string  `json:"description"`
+	Date          string  `json:"date"`
+	Status        string  `json:"status"` // PENDING or CLEARED
+}
+
+// ReconciliationOutput represents an account reconciliation session.
+// Balances are reported while the session is in progress and after it was completed;
+// the transactions are only listed while it is in progress.
+type ReconciliationOutput struct {
+	ReconciliationID string  `json:"reconciliation_id"`
+	AccountID        string  `json:"account_id"`
+	StatementDate    string  `json:"statement_date"`
+	StatementBalance float64 `json:"statement_balance"`
+	Currency         string  `json:"currency"`
+	Status           string  `json:"status"` // IN_PROGRESS, COMPLETED or CANCELED
+
+	// ReconciledBalance is the account balance counting only reconciled transactions, and
+	// ClearedBalance adds the cleared transactions up to the statement date to it. The session
+	// can be completed when Difference (statement balance minus cleared balance) is zero.
+	ReconciledBalance *float64 `json:"reconciled_balance,omitempty"`
+	ClearedBalance    *float64 `json:"cleared_balance,omitempty"`
+	Difference        *float64 `json:"difference,omitempty"`
+	ClearedCount      int      `json:"cleared_count"`    // Cleared transactions up to the statement date
+	ReconciledCount   int      `json:"reconciled_count"` // Transactions reconciled on completion
+
+	Transactions []ReconciliationTransactionOutput `json:"transactions,omitempty"`
+
+	CreatedAt   string `json:"created_at"`
+	CompletedAt string `json:"completed_at,omitempty"`
+	CanceledAt  string `json:"canceled_at,omitempty"`
+}
+
+// ListReconciliationsOutput represents the reconciliation history of an account, latest statement first.
+type ListReconciliationsOutput struct {
+	Reconciliations []ReconciliationOutput `json:"reconciliations"`
+	Count           int                    `json:"count"`
+}
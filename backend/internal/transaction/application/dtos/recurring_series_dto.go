@@ -0,0 +1,115 @@
+package dtos
+
+// ListRecurringSeriesInput represents the input for listing the recurring series of a user.
+type ListRecurringSeriesInput struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+	Status string `json:"status,omitempty" validate:"omitempty,oneof=ACTIVE PAUSED ENDED"` // Optional status filter
+}
+
+// ListRecurringSeriesOutput represents the output of listing recurring series.
+type ListRecurringSeriesOutput struct {
+	Series []RecurringSeriesOutput `json:"series"`
+	Count  int                     `json:"count"`
+}
+
+// PreviewRecurringSeriesInput represents the input for previewing the next occurrences of a recurring series.
+type PreviewRecurringSeriesInput struct {
+	UserID   string `json:"user_id" validate:"required,uuid"`
+	SeriesID string `json:"series_id" validate:"required,uuid"`
+	Count    int    `json:"count,omitempty" validate:"omitempty,min=1,max=100"` // Number of occurrences (default 12)
+}
+
+// PreviewRecurringSeriesOutput represents the next occurrences of a recurring series.
+type PreviewRecurringSeriesOutput struct {
+	Series      RecurringSeriesOutput       `json:"series"`
+	Occurrences []RecurringOccurrenceOutput `json:"occurrences"`
+}
+
+// PauseRecurringSeriesInput represents the input for pausing a recurring series.
+type PauseRecurringSeriesInput struct {
+	UserID   string `json:"user_id" validate:"required,uuid"`
+	SeriesID string `json:"series_id" validate:"required,uuid"`
+}
+
+// ResumeRecurringSeriesInput represents the input for resuming a paused recurring series.
+type ResumeRecurringSeriesInput struct {
+	UserID   string `json:"user_id" validate:"required,uuid"`
+	SeriesID string `json:"series_id" validate:"required,uuid"`
+}
+
+// SkipRecurringOccurrenceInput represents the input for leaving an occurrence out of a recurring series.
+type SkipRecurringOccurrenceInput struct {
+	UserID   string `json:"user_id" validate:"required,uuid"`
+	SeriesID string `json:"series_id" validate:"required,uuid"`
+	Date     string `json:"date" validate:"required"` // Occurrence date (YYYY-MM-DD)
+}
+
+// SkipRecurringOccurrenceOutput represents the result of skipping an occurrence.
+type SkipRecurringOccurrenceOutput struct {
+	Series               RecurringSeriesOutput `json:"series"`
+	DeletedTransactionID string                `json:"deleted_transaction_id,omitempty"` // Set when the occurrence had already been created
+}
+
+// ChangeRecurringAmountInput represents the input for changing the amount of a recurring series
+// for an occurrence and the following ones.
+type ChangeRecurringAmountInput struct {
+	UserID   string  `json:"user_id" validate:"required,uuid"`
+	SeriesID string  `json:"series_id" validate:"required,uuid"`
+	FromDate string  `json:"from_date" validate:"required"` // First occurrence with the new amount (YYYY-MM-DD)
+	Amount   float64 `json:"amount" validate:"required,gt=0"`
+}
+
+// ChangeRecurringAmountOutput represents the result of changing the amount of a recurring series.
+type ChangeRecurringAmountOutput struct {
+	Series       RecurringSeriesOutput `json:"series"`
+	UpdatedCount int                   `json:"updated_count"` // Occurrences already created that were updated
+}
+
+// EndRecurringSeriesInput represents the input for ending a recurring series at a date.
+type EndRecurringSeriesInput struct {
+	UserID   string `json:"user_id" validate:"required,uuid"`
+	SeriesID string `json:"series_id" validate:"required,uuid"`
+	EndDate  string `json:"end_date" validate:"required"` // Last day of the series (YYYY-MM-DD)
+}
+
+// UpdateRecurringScheduleInput represents the input for changing the schedule rules of a recurring series.
+type UpdateRecurringScheduleInput struct {
+	UserID             string `json:"user_id" validate:"required,uuid"`
+	SeriesID           string `json:"series_id" validate:"required,uuid"`
+	Anchor             string `json:"anchor,omitempty" validate:"omitempty,oneof=DAY_OF_MONTH LAST_DAY_OF_MONTH NTH_WEEKDAY LAST_WEEKDAY"` // Monthly and yearly series only (default DAY_OF_MONTH)
+	ShiftToBusinessDay bool   `json:"shift_to_business_day"`                                                                               // Move occurrences on weekends and Brazilian holidays to the next business day
+}
+
+// RecurringSeriesOutput represents a recurring series.
+type RecurringSeriesOutput struct {
+	SeriesID           string                         `json:"series_id"` // ID of the transaction that starts the series
+	AccountID          string                         `json:"account_id"`
+	CategoryID         string                         `json:"category_id,omitempty"`
+	Type               string                         `json:"type"`
+	Description        string                         `json:"description"`
+	Amount             float64                        `json:"amount"` // Amount of the next occurrence
+	Currency           string                         `json:"currency"`
+	Frequency          string                         `json:"frequency"`
+	Anchor             string                         `json:"anchor,omitempty"` // Monthly and yearly series only
+	ShiftToBusinessDay bool                           `json:"shift_to_business_day"`
+	StartDate          string                         `json:"start_date"`
+	EndDate            string                         `json:"end_date,omitempty"`
+	Status             string                         `json:"status"`              // ACTIVE, PAUSED or ENDED
+	PausedAt           string                         `json:"paused_at,omitempty"` // RFC 3339
+	NextOccurrence     string                         `json:"next_occurrence,omitempty"`
+	GeneratedUntil     string                         `json:"generated_until,omitempty"` // Last day up to which occurrences were created
+	SkippedDates       []string                       `json:"skipped_dates"`
+	AmountChanges      []RecurrenceAmountChangeOutput `json:"amount_changes"`
+}
+
+// RecurrenceAmountChangeOutput represents an amount change of a recurring series.
+type RecurrenceAmountChangeOutput struct {
+	EffectiveDate string  `json:"effective_date"`
+	Amount        float64 `json:"amount"`
+}
+
+// RecurringOccurrenceOutput represents a future occurrence of a recurring series.
+type RecurringOccurrenceOutput struct {
+	Date   string  `json:"date"`
+	Amount float64 `json:"amount"`
+}
@@ -3,6 +3,8 @@ package dtos
 // RestoreTransactionInput represents the input for restoring a soft-deleted transaction.
 type RestoreTransactionInput struct {
 	TransactionID string `json:"transaction_id" validate:"required,uuid"`
+	ActorID       string `json:"-"` // User making the change, recorded in the edit history
+	RequestID     string `json:"-"` // HTTP request making the change, recorded in the edit history
 }
 
 // RestoreTransactionOutput represents the output after transaction restoration.
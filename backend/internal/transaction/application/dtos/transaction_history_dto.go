@@ -0,0 +1,59 @@
+package dtos
+
+// GetTransactionHistoryInput represents the input for retrieving the edit history of a transaction.
+// The history of deleted transactions is kept, so it can still be retrieved after a deletion.
+type GetTransactionHistoryInput struct {
+	UserID        string `json:"user_id" validate:"required,uuid"`
+	TransactionID string `json:"transaction_id" validate:"required,uuid"`
+}
+
+// TransactionSnapshotOutput represents the state of a transaction at one point of its history.
+type TransactionSnapshotOutput struct {
+	AccountID   string   `json:"account_id"`
+	Type        string   `json:"type"`
+	Amount      float64  `json:"amount"`
+	Currency    string   `json:"currency"`
+	Description string   `json:"description"`
+	Date        string   `json:"date"`
+	CategoryID  string   `json:"category_id,omitempty"`
+	TagIDs      []string `json:"tag_ids"`
+	PayeeID     string   `json:"payee_id,omitempty"`
+	Status      string   `json:"status"`
+}
+
+// TransactionRevisionOutput represents one change in the edit history of a transaction.
+type TransactionRevisionOutput struct {
+	RevisionID         string                     `json:"revision_id"`
+	TransactionID      string                     `json:"transaction_id"`
+	Action             string                     `json:"action"`                         // CREATE, UPDATE, DELETE, RESTORE or REVERT
+	ActorID            string                     `json:"actor_id,omitempty"`             // Empty for changes made by the system
+	RequestID          string                     `json:"request_id,omitempty"`           // X-Request-ID of the change
+	Before             *TransactionSnapshotOutput `json:"before,omitempty"`               // Empty for CREATE and RESTORE
+	After              *TransactionSnapshotOutput `json:"after,omitempty"`                // Empty for DELETE
+	ChangedFields      []string                   `json:"changed_fields,omitempty"`       // UPDATE and REVERT only
+	RevertedRevisionID string                     `json:"reverted_revision_id,omitempty"` // REVERT only
+	CreatedAt          string                     `json:"created_at"`
+}
+
+// GetTransactionHistoryOutput represents the edit history of a transaction, oldest change first.
+type GetTransactionHistoryOutput struct {
+	TransactionID string                       `json:"transaction_id"`
+	Revisions     []*TransactionRevisionOutput `json:"revisions"`
+	Count         int                          `json:"count"`
+}
+
+// RevertTransactionInput represents the input for bringing a transaction back to the state
+// after one of its revisions.
+type RevertTransactionInput struct {
+	UserID        string `json:"user_id" validate:"required,uuid"`
+	TransactionID string `json:"transaction_id" validate:"required,uuid"`
+	RevisionID    string `json:"revision_id" validate:"required,uuid"`
+	RequestID     string `json:"-"` // Identifies the HTTP request in the edit history
+}
+
+// RevertTransactionOutput represents the result of a revert: the transaction as it is now and
+// the REVERT revision that recorded the change.
+type RevertTransactionOutput struct {
+	Transaction *TransactionOutput         `json:"transaction"`
+	Revision    *TransactionRevisionOutput `json:"revision"`
+}
@@ -0,0 +1,125 @@
+package dtos
+
+// RuleConditionsInput represents the conditions of a transaction rule. Every condition that is
+// set must hold; at least one is required.
+type RuleConditionsInput struct {
+	DescriptionMatch   string   `json:"description_match,omitempty" validate:"omitempty,oneof=CONTAINS STARTS_WITH REGEX"` // Default: CONTAINS
+	DescriptionPattern string   `json:"description_pattern,omitempty" validate:"omitempty,max=255,utf8"`
+	MinAmount          *float64 `json:"min_amount,omitempty" validate:"omitempty,gte=0"` // Inclusive
+	MaxAmount          *float64 `json:"max_amount,omitempty" validate:"omitempty,gt=0"`  // Inclusive
+	AccountID          string   `json:"account_id,omitempty" validate:"omitempty,uuid"`
+	Type               string   `json:"type,omitempty" validate:"omitempty,oneof=INCOME EXPENSE"`
+}
+
+// RuleActionsInput represents what a transaction rule changes; at least one action is required.
+type RuleActionsInput struct {
+	CategoryID  string   `json:"category_id,omitempty" validate:"omitempty,uuid"`
+	TagIDs      []string `json:"tag_ids,omitempty" validate:"omitempty,dive,uuid"`
+	Description string   `json:"description,omitempty" validate:"omitempty,min=3,max=500,no_sql_injection,no_xss,utf8"` // Replaces the original description
+}
+
+// CreateTransactionRuleInput represents the input for creating a transaction rule.
+type CreateTransactionRuleInput struct {
+	UserID     string
+	Name       string              `json:"name" validate:"required,max=100,no_sql_injection,no_xss,utf8"`
+	Priority   int                 `json:"priority" validate:"gte=0"` // Lower runs first
+	Conditions RuleConditionsInput `json:"conditions"`
+	Actions    RuleActionsInput    `json:"actions"`
+}
+
+// UpdateTransactionRuleInput represents the input for replacing the definition of a transaction rule.
+type UpdateTransactionRuleInput struct {
+	UserID     string
+	RuleID     string
+	Name       string              `json:"name" validate:"required,max=100,no_sql_injection,no_xss,utf8"`
+	Priority   int                 `json:"priority" validate:"gte=0"`
+	Enabled    *bool               `json:"enabled,omitempty"` // Keeps the current value when omitted
+	Conditions RuleConditionsInput `json:"conditions"`
+	Actions    RuleActionsInput    `json:"actions"`
+}
+
+// DeleteTransactionRuleInput represents the input for deleting a transaction rule.
+type DeleteTransactionRuleInput struct {
+	UserID string
+	RuleID string
+}
+
+// DeleteTransactionRuleOutput represents the output after deleting a transaction rule.
+type DeleteTransactionRuleOutput struct {
+	Message string `json:"message"`
+	RuleID  string `json:"rule_id"`
+}
+
+// ListTransactionRulesInput represents the input for listing transaction rules.
+type ListTransactionRulesInput struct {
+	UserID string
+}
+
+// RuleConditionsOutput represents the conditions of a transaction rule.
+type RuleConditionsOutput struct {
+	DescriptionMatch   string   `json:"description_match,omitempty"`
+	DescriptionPattern string   `json:"description_pattern,omitempty"`
+	MinAmount          *float64 `json:"min_amount,omitempty"`
+	MaxAmount          *float64 `json:"max_amount,omitempty"`
+	AccountID          string   `json:"account_id,omitempty"`
+	Type               string   `json:"type,omitempty"`
+}
+
+// RuleActionsOutput represents the actions of a transaction rule.
+type RuleActionsOutput struct {
+	CategoryID  string   `json:"category_id,omitempty"`
+	TagIDs      []string `json:"tag_ids,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// TransactionRuleOutput represents a transaction rule.
+type TransactionRuleOutput struct {
+	RuleID     string               `json:"rule_id"`
+	Name       string               `json:"name"`
+	Priority   int                  `json:"priority"`
+	Enabled    bool                 `json:"enabled"`
+	Conditions RuleConditionsOutput `json:"conditions"`
+	Actions    RuleActionsOutput    `json:"actions"`
+	CreatedAt  string               `json:"created_at"`
+	UpdatedAt  string               `json:"updated_at"`
+}
+
+// ListTransactionRulesOutput represents the output for listing transaction rules, in the order they run.
+type ListTransactionRulesOutput struct {
+	Rules []TransactionRuleOutput `json:"rules"`
+	Count int                     `json:"count"`
+}
+
+// ApplyTransactionRulesInput represents the input for running rules over existing transactions.
+// Dates use the YYYY-MM-DD format; without dates every transaction of the user is checked.
+type ApplyTransactionRulesInput struct {
+	UserID            string
+	RuleIDs           []string `json:"rule_ids,omitempty" validate:"omitempty,dive,uuid"` // Default: all enabled rules
+	AccountID         string   `json:"account_id,omitempty" validate:"omitempty,uuid"`
+	StartDate         string   `json:"start_date,omitempty"`
+	EndDate           string   `json:"end_date,omitempty"`
+	OverwriteCategory bool     `json:"overwrite_category"` // Also replace categories that are already set
+	DryRun            bool     `json:"-"`                  // Set by the preview endpoint
+}
+
+// RuleChangeOutput represents what the rules change (or would change) on a transaction.
+type RuleChangeOutput struct {
+	TransactionID      string   `json:"transaction_id"`
+	Date               string   `json:"date"`
+	Amount             float64  `json:"amount"`
+	Currency           string   `json:"currency"`
+	Description        string   `json:"description"` // Before the change
+	RuleIDs            []string `json:"rule_ids"`
+	PreviousCategoryID string   `json:"previous_category_id,omitempty"`
+	CategoryID         string   `json:"category_id,omitempty"` // New category, empty when unchanged
+	AddedTagIDs        []string `json:"added_tag_ids,omitempty"`
+	NewDescription     string   `json:"new_description,omitempty"` // Empty when unchanged
+}
+
+// ApplyTransactionRulesOutput represents the output after running rules over existing transactions.
+type ApplyTransactionRulesOutput struct {
+	DryRun       bool               `json:"dry_run"`
+	CheckedCount int                `json:"checked_count"`
+	ChangedCount int                `json:"changed_count"`
+	Changes      []RuleChangeOutput `json:"changes"`
+}
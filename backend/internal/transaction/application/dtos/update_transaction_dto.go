@@ -2,24 +2,48 @@ package dtos
 
 // UpdateTransactionInput represents the input data for transaction update.
 // All fields are optional - only provided fields will be updated.
+// An empty CategoryID removes the category from the transaction and
+// an empty Splits list turns a split transaction back into a regular one.
+// TagIDs replaces all tags of the transaction; an empty list removes them.
+// An empty PayeeID removes the payee; a new payee does not change the category.
+// Reconciled transactions only accept changes to their type, amount, currency or date when
+// Status sets them back to PENDING or CLEARED in the same request.
 type UpdateTransactionInput struct {
-	TransactionID string   `json:"transaction_id" validate:"required,uuid"`
-	Type          *string  `json:"type,omitempty" validate:"omitempty,oneof=INCOME EXPENSE"`
-	Amount        *float64 `json:"amount,omitempty" validate:"omitempty,gt=0"`
-	Currency      *string  `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
-	Description   *string  `json:"description,omitempty" validate:"omitempty,min=3,max=500"`
-	Date          *string  `json:"date,omitempty" validate:"omitempty"` // ISO 8601 format: YYYY-MM-DD
+	TransactionID string                   `json:"transaction_id" validate:"required,uuid"`
+	Type          *string                  `json:"type,omitempty" validate:"omitempty,oneof=INCOME EXPENSE"`
+	Amount        *float64                 `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	Currency      *string                  `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
+	Description   *string                  `json:"description,omitempty" validate:"omitempty,min=3,max=500"`
+	Date          *string                  `json:"date,omitempty" validate:"omitempty"` // ISO 8601 format: YYYY-MM-DD
+	CategoryID    *string                  `json:"category_id,omitempty" validate:"omitempty,len=0|uuid"`
+	Splits        *[]TransactionSplitInput `json:"splits,omitempty" validate:"omitempty,dive"`
+	TagIDs        *[]string                `json:"tag_ids,omitempty" validate:"omitempty,dive,uuid"`
+	PayeeID       *string                  `json:"payee_id,omitempty" validate:"omitempty,len=0|uuid"`
+	Status        *string                  `json:"status,omitempty" validate:"omitempty,oneof=PENDING CLEARED"`
+	ActorID       string                   `json:"-"` // User making the change, recorded in the edit history
+	RequestID     string                   `json:"-"` // HTTP request making the change, recorded in the edit history
 }
 
 // UpdateTransactionOutput represents the output data after transaction update.
 type UpdateTransactionOutput struct {
-	TransactionID string  `json:"transaction_id"`
-	UserID        string  `json:"user_id"`
-	AccountID     string  `json:"account_id"`
-	Type          string  `json:"type"`
-	Amount        float64 `json:"amount"`
-	Currency      string  `json:"currency"`
-	Description   string  `json:"description"`
-	Date          string  `json:"date"`
-	UpdatedAt     string  `json:"updated_at"`
+	TransactionID       string                        `json:"transaction_id"`
+	UserID              string                        `json:"user_id"`
+	AccountID           string                        `json:"account_id"`
+	Type                string                        `json:"type"`
+	Amount              float64                       `json:"amount"`
+	Currency            string                        `json:"currency"`
+	Description         string                        `json:"description"`
+	Date                string                        `json:"date"`
+	CategoryID          string                        `json:"category_id,omitempty"`
+	LinkedTransactionID string                        `json:"linked_transaction_id,omitempty"` // Counterpart leg (transfers only)
+	Splits              []TransactionSplitOutput      `json:"splits,omitempty"`
+	TagIDs              []string                      `json:"tag_ids,omitempty"`
+	PayeeID             string                        `json:"payee_id,omitempty"`
+	ImportBatchID       string                        `json:"import_batch_id,omitempty"`        // Statement import the transaction came from
+	Status              string                        `json:"status"`                           // PENDING, CLEARED or RECONCILED
+	ReconciliationID    string                        `json:"reconciliation_id,omitempty"`      // Reconciliation that locked the transaction
+	Installment         *TransactionInstallmentOutput `json:"installment,omitempty"`            // Set when the transaction is an installment of a purchase
+	CurrencyConversion  *CurrencyConversionOutput     `json:"currency_conversion,omitempty"`    // Set when the transaction was made in a foreign currency
+	FeeForTransactionID string                        `json:"fee_for_transaction_id,omitempty"` // Purchase the fee (e.g. IOF) was charged for
+	UpdatedAt           string                        `json:"updated_at"`
 }
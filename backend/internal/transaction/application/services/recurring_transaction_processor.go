@@ -27,7 +27,9 @@ func NewRecurringTransactionProcessor(
 	}
 }
 
-// ProcessRecurringTransactions processes all active recurring transactions and generates new instances if needed.
+// ProcessRecurringTransactions processes all active recurring transactions and generates their
+// due instances, catching up on every occurrence missed since the last run (e.g. when the
+// processor did not run for a few weeks). Paused series are left untouched.
 // Returns the number of transactions created and any errors encountered.
 func (p *RecurringTransactionProcessor) ProcessRecurringTransactions() (int, error) {
 	// Find all active recurring transactions
@@ -40,34 +42,58 @@ func (p *RecurringTransactionProcessor) ProcessRecurringTransactions() (int, err
 	now := time.Now()
 
 	for _, recurringTx := range recurringTransactions {
-		// Check if we need to create a new instance
-		shouldCreate, nextDate, err := p.shouldCreateNextInstance(recurringTx, now)
+		created, err := p.processSeries(recurringTx, now)
+		createdCount += created
 		if err != nil {
-			// Log error but continue processing other transactions
+			// Log error but continue processing other transactions; the occurrences not
+			// generated are caught up on the next run
 			continue
 		}
+	}
+
+	return createdCount, nil
+}
+
+// processSeries generates the instances of a recurring transaction due up to now that were not
+// generated yet, then records how far the series was generated so that deleted instances are
+// not generated again. Returns the number of instances created.
+func (p *RecurringTransactionProcessor) processSeries(recurringTx *entities.Transaction, now time.Time) (int, error) {
+	if !recurringTx.IsRecurring() {
+		return 0, fmt.Errorf("transaction is not recurring")
+	}
+
+	if recurringTx.RecurrenceFrequency() == nil {
+		return 0, fmt.Errorf("recurrence frequency is nil")
+	}
+
+	// Paused series don't generate occurrences until they are resumed
+	if recurringTx.IsRecurrencePaused() {
+		return 0, nil
+	}
 
-		if !shouldCreate {
+	createdCount := 0
+	parentID := recurringTx.ID()
+	for _, date := range recurringTx.DueOccurrences(now) {
+		// Instances may already exist, e.g. when a previous run failed after creating them
+		exists, err := p.instanceExistsForDate(parentID, date)
+		if err != nil {
+			return createdCount, err
+		}
+		if exists {
 			continue
 		}
 
-		// Create new transaction instance
-		parentID := recurringTx.ID()
-		newTransaction, err := p.createNextInstance(recurringTx, nextDate, &parentID)
+		newTransaction, err := p.createNextInstance(recurringTx, date, &parentID)
 		if err != nil {
-			// Log error but continue processing other transactions
-			continue
+			return createdCount, err
 		}
 
-		// Save the new transaction
 		if err := p.transactionRepository.Save(newTransaction); err != nil {
-			// Log error but continue processing other transactions
-			continue
+			return createdCount, fmt.Errorf("failed to save transaction instance: %w", err)
 		}
 
 		// Publish domain events
-		domainEvents := newTransaction.GetEvents()
-		for _, event := range domainEvents {
+		for _, event := range newTransaction.GetEvents() {
 			if err := p.eventBus.Publish(event); err != nil {
 				// Log error but don't fail
 				_ = err
@@ -78,95 +104,12 @@ func (p *RecurringTransactionProcessor) ProcessRecurringTransactions() (int, err
 		createdCount++
 	}
 
-	return createdCount, nil
-}
-
-// shouldCreateNextInstance determines if a new instance should be created for a recurring transaction.
-// Returns (shouldCreate, nextDate, error).
-func (p *RecurringTransactionProcessor) shouldCreateNextInstance(
-	recurringTx *entities.Transaction,
-	now time.Time,
-) (bool, time.Time, error) {
-	if !recurringTx.IsRecurring() {
-		return false, time.Time{}, fmt.Errorf("transaction is not recurring")
-	}
-
-	frequency := recurringTx.RecurrenceFrequency()
-	if frequency == nil {
-		return false, time.Time{}, fmt.Errorf("recurrence frequency is nil")
-	}
-
-	// Calculate next execution date based on frequency
-	// Start from the original transaction date and keep adding periods until we reach or pass today
-	nextDate := p.calculateNextDate(recurringTx.Date(), frequency, now)
-
-	// Check if next date is in the future (not yet time to create)
-	// We compare dates only (not time) to allow creation on the same day
-	nextDateOnly := time.Date(nextDate.Year(), nextDate.Month(), nextDate.Day(), 0, 0, 0, 0, nextDate.Location())
-	nowOnly := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-
-	if nextDateOnly.After(nowOnly) {
-		return false, time.Time{}, nil // Not yet time to create
-	}
-
-	// Check if we've passed the end date
-	endDate := recurringTx.RecurrenceEndDate()
-	if endDate != nil && !endDate.IsZero() && nextDate.After(*endDate) {
-		return false, time.Time{}, nil // Past end date
-	}
-
-	// Check if an instance for this date already exists
-	// We'll check by looking for transactions with the same parent and date
-	// This is a simple check - in production, you might want a more robust approach
-	exists, err := p.instanceExistsForDate(recurringTx.ID(), nextDate)
-	if err != nil {
-		return false, time.Time{}, fmt.Errorf("failed to check if instance exists: %w", err)
+	recurringTx.MarkOccurrencesGenerated(now)
+	if err := p.transactionRepository.Save(recurringTx); err != nil {
+		return createdCount, fmt.Errorf("failed to save recurring transaction: %w", err)
 	}
 
-	if exists {
-		return false, time.Time{}, nil // Instance already exists
-	}
-
-	return true, nextDate, nil
-}
-
-// calculateNextDate calculates the next execution date based on the frequency.
-// It starts from the original transaction date and keeps adding periods until we reach or pass today.
-func (p *RecurringTransactionProcessor) calculateNextDate(
-	originalDate time.Time,
-	frequency *transactionvalueobjects.RecurrenceFrequency,
-	now time.Time,
-) time.Time {
-	// Start from the original transaction date
-	nextDate := originalDate
-	nowOnly := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-
-	// Keep adding periods until we reach or pass today
-	for {
-		nextDateOnly := time.Date(nextDate.Year(), nextDate.Month(), nextDate.Day(), 0, 0, 0, 0, nextDate.Location())
-
-		// If we've reached or passed today, this is the next date to create
-		if !nextDateOnly.Before(nowOnly) {
-			break
-		}
-
-		// Add one period
-		switch {
-		case frequency.IsDaily():
-			nextDate = nextDate.AddDate(0, 0, 1)
-		case frequency.IsWeekly():
-			nextDate = nextDate.AddDate(0, 0, 7)
-		case frequency.IsMonthly():
-			nextDate = nextDate.AddDate(0, 1, 0)
-		case frequency.IsYearly():
-			nextDate = nextDate.AddDate(1, 0, 0)
-		default:
-			// Should not happen, but return originalDate + 1 day as fallback
-			nextDate = nextDate.AddDate(0, 0, 1)
-		}
-	}
-
-	return nextDate
+	return createdCount, nil
 }
 
 // instanceExistsForDate checks if an instance already exists for a given parent and date.
@@ -187,12 +130,13 @@ func (p *RecurringTransactionProcessor) createNextInstance(
 	nextDate time.Time,
 	parentID *transactionvalueobjects.TransactionID,
 ) (*entities.Transaction, error) {
-	// Create new transaction with same properties but new date and parent reference
+	// Create new transaction with same properties but new date and parent reference,
+	// using the amount of the series for that date ("this and future occurrences" changes)
 	newTransaction, err := entities.NewTransactionWithRecurrence(
 		recurringTx.UserID(),
 		recurringTx.AccountID(),
 		recurringTx.TransactionType(),
-		recurringTx.Amount(),
+		recurringTx.RecurrenceAmountOn(nextDate),
 		recurringTx.Description(),
 		nextDate,
 		false, // This instance is not recurring
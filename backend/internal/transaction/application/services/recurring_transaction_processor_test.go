@@ -9,6 +9,7 @@ import (
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
@@ -162,6 +163,56 @@ func (m *mockTransactionRepository) FindByUserIDAndDateRangeWithCurrency(userID
 	}
 	return result, nil
 }
+func (m *mockTransactionRepository) FindByImportBatchID(batchID transactionvalueobjects.ImportBatchID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ImportBatchID() != nil && tx.ImportBatchID().Equals(batchID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepository) FindExistingExternalIDs(accountID accountvalueobjects.AccountID, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for _, tx := range m.transactions {
+		if !tx.AccountID().Equals(accountID) || tx.ExternalID() == "" {
+			continue
+		}
+		for _, externalID := range externalIDs {
+			if tx.ExternalID() == externalID {
+				existing[externalID] = true
+			}
+		}
+	}
+	return existing, nil
+}
+func (m *mockTransactionRepository) FindUnreconciledByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.AccountID().Equals(accountID) && !tx.IsReconciled() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepository) FindByParentID(parentID transactionvalueobjects.TransactionID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ParentTransactionID() != nil && tx.ParentTransactionID().Equals(parentID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepository) FindRecurringSeriesByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.UserID().Equals(userID) && tx.IsRecurringSeries() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
 func (m *mockTransactionRepository) FindByUserIDWithPagination(userID identityvalueobjects.UserID, offset, limit int) ([]*entities.Transaction, int64, error) {
 	all, _ := m.FindByUserID(userID)
 	total := int64(len(all))
@@ -175,14 +226,11 @@ func (m *mockTransactionRepository) FindByUserIDWithPagination(userID identityva
 	}
 	return all[start:end], total, nil
 }
-func (m *mockTransactionRepository) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, accountID string, transactionType string, offset, limit int) ([]*entities.Transaction, int64, error) {
+func (m *mockTransactionRepository) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, filter repositories.TransactionFilter, offset, limit int) ([]*entities.Transaction, int64, error) {
 	all, _ := m.FindByUserID(userID)
 	var filtered []*entities.Transaction
 	for _, tx := range all {
-		if accountID != "" && tx.AccountID().Value() != accountID {
-			continue
-		}
-		if transactionType != "" && tx.TransactionType().Value() != transactionType {
+		if !filter.Matches(tx) {
 			continue
 		}
 		filtered = append(filtered, tx)
@@ -199,6 +247,10 @@ func (m *mockTransactionRepository) FindByUserIDAndFiltersWithPagination(userID
 	return filtered[start:end], total, nil
 }
 
+func (m *mockTransactionRepository) FindByUserIDAndFiltersWithKeyset(userID identityvalueobjects.UserID, filter repositories.TransactionFilter, keyset *repositories.TransactionKeyset, backward bool, limit int) ([]*entities.Transaction, error) {
+	return nil, nil
+}
+
 func TestRecurringTransactionProcessor_ProcessRecurringTransactions(t *testing.T) {
 	eventBus := eventbus.NewEventBus()
 	repository := newMockTransactionRepository()
@@ -333,3 +385,133 @@ func TestRecurringTransactionProcessor_ProcessRecurringTransactions_AlreadyProce
 		t.Logf("Note: Created %d instances (may be expected if multiple periods passed)", createdCount)
 	}
 }
+
+func TestRecurringTransactionProcessor_ProcessRecurringTransactions_ManagedSeries(t *testing.T) {
+	newSeries := func(t *testing.T, repository *mockTransactionRepository) *entities.Transaction {
+		t.Helper()
+
+		amount, _ := sharedvalueobjects.NewMoney(10000, sharedvalueobjects.MustCurrency("BRL"))
+		description, _ := transactionvalueobjects.NewTransactionDescription("Diarista")
+		recurrenceFrequency := transactionvalueobjects.WeeklyFrequency()
+		recurringTx, err := entities.NewTransactionWithRecurrence(
+			identityvalueobjects.GenerateUserID(),
+			accountvalueobjects.GenerateAccountID(),
+			transactionvalueobjects.ExpenseType(),
+			amount,
+			description,
+			time.Now().AddDate(0, 0, -7), // Next occurrence is due today
+			true,
+			&recurrenceFrequency,
+			nil,
+			nil,
+		)
+		if err != nil {
+			t.Fatalf("Failed to create recurring transaction: %v", err)
+		}
+		_ = repository.Save(recurringTx)
+		return recurringTx
+	}
+
+	t.Run("paused series", func(t *testing.T) {
+		repository := newMockTransactionRepository()
+		recurringTx := newSeries(t, repository)
+		_ = recurringTx.PauseRecurrence()
+
+		createdCount, err := NewRecurringTransactionProcessor(repository, eventbus.NewEventBus()).ProcessRecurringTransactions()
+		if err != nil {
+			t.Fatalf("ProcessRecurringTransactions() error = %v, want nil", err)
+		}
+		if createdCount != 0 {
+			t.Errorf("ProcessRecurringTransactions() createdCount = %v, want 0 for a paused series", createdCount)
+		}
+	})
+
+	t.Run("skipped occurrence", func(t *testing.T) {
+		repository := newMockTransactionRepository()
+		recurringTx := newSeries(t, repository)
+		if err := recurringTx.SkipOccurrence(recurringTx.OccurrenceDate(1)); err != nil {
+			t.Fatalf("SkipOccurrence() error = %v", err)
+		}
+
+		createdCount, err := NewRecurringTransactionProcessor(repository, eventbus.NewEventBus()).ProcessRecurringTransactions()
+		if err != nil {
+			t.Fatalf("ProcessRecurringTransactions() error = %v, want nil", err)
+		}
+		if createdCount != 0 {
+			t.Errorf("ProcessRecurringTransactions() createdCount = %v, want 0 for a skipped occurrence", createdCount)
+		}
+	})
+
+	t.Run("changed amount", func(t *testing.T) {
+		repository := newMockTransactionRepository()
+		recurringTx := newSeries(t, repository)
+		newAmount, _ := sharedvalueobjects.NewMoney(12990, sharedvalueobjects.MustCurrency("BRL"))
+		if err := recurringTx.ChangeRecurrenceAmount(recurringTx.OccurrenceDate(1), newAmount); err != nil {
+			t.Fatalf("ChangeRecurrenceAmount() error = %v", err)
+		}
+
+		if _, err := NewRecurringTransactionProcessor(repository, eventbus.NewEventBus()).ProcessRecurringTransactions(); err != nil {
+			t.Fatalf("ProcessRecurringTransactions() error = %v, want nil", err)
+		}
+		instance, _ := repository.FindByParentIDAndDate(recurringTx.ID(), recurringTx.OccurrenceDate(1))
+		if instance == nil {
+			t.Fatal("ProcessRecurringTransactions() expected the occurrence due today to be created")
+		}
+		if got := instance.Amount().Amount(); got != 12990 {
+			t.Errorf("instance amount = %d, want 12990", got)
+		}
+	})
+}
+
+func TestRecurringTransactionProcessor_ProcessRecurringTransactions_CatchUp(t *testing.T) {
+	repository := newMockTransactionRepository()
+	amount, _ := sharedvalueobjects.NewMoney(10000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Diarista")
+	recurrenceFrequency := transactionvalueobjects.WeeklyFrequency()
+	recurringTx, err := entities.NewTransactionWithRecurrence(
+		identityvalueobjects.GenerateUserID(),
+		accountvalueobjects.GenerateAccountID(),
+		transactionvalueobjects.ExpenseType(),
+		amount,
+		description,
+		time.Now().AddDate(0, 0, -21), // Three occurrences were missed
+		true,
+		&recurrenceFrequency,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create recurring transaction: %v", err)
+	}
+	_ = repository.Save(recurringTx)
+
+	processor := NewRecurringTransactionProcessor(repository, eventbus.NewEventBus())
+
+	createdCount, err := processor.ProcessRecurringTransactions()
+	if err != nil {
+		t.Fatalf("ProcessRecurringTransactions() error = %v, want nil", err)
+	}
+	if createdCount != 3 {
+		t.Fatalf("ProcessRecurringTransactions() createdCount = %v, want 3", createdCount)
+	}
+	for n := 1; n <= 3; n++ {
+		if instance, _ := repository.FindByParentIDAndDate(recurringTx.ID(), recurringTx.OccurrenceDate(n)); instance == nil {
+			t.Errorf("ProcessRecurringTransactions() expected occurrence %d to be created", n)
+		}
+	}
+	if recurringTx.RecurrenceGeneratedUntil() == nil {
+		t.Error("ProcessRecurringTransactions() expected the series to be marked as generated")
+	}
+
+	// Running again creates nothing, even after an occurrence is deleted
+	instance, _ := repository.FindByParentIDAndDate(recurringTx.ID(), recurringTx.OccurrenceDate(2))
+	_ = repository.Delete(instance.ID())
+
+	createdCount, err = processor.ProcessRecurringTransactions()
+	if err != nil {
+		t.Fatalf("ProcessRecurringTransactions() error = %v, want nil", err)
+	}
+	if createdCount != 0 {
+		t.Errorf("ProcessRecurringTransactions() createdCount = %v, want 0 on the second run", createdCount)
+	}
+}
@@ -0,0 +1,261 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/domain/services"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// ApplyTransactionRulesUseCase runs auto-categorization rules over the existing transactions of a
+// user, either for real or as a dry run that only reports what would change.
+type ApplyTransactionRulesUseCase struct {
+	unitOfWork     sharedrepositories.UnitOfWork
+	ruleRepository repositories.TransactionRuleRepository
+	eventBus       *eventbus.EventBus
+}
+
+// NewApplyTransactionRulesUseCase creates a new ApplyTransactionRulesUseCase instance.
+func NewApplyTransactionRulesUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	ruleRepository repositories.TransactionRuleRepository,
+	eventBus *eventbus.EventBus,
+) *ApplyTransactionRulesUseCase {
+	return &ApplyTransactionRulesUseCase{
+		unitOfWork:     unitOfWork,
+		ruleRepository: ruleRepository,
+		eventBus:       eventBus,
+	}
+}
+
+// Execute runs the selected rules (all enabled rules by default; explicitly selected rules run
+// even when disabled) over the transactions of the user, optionally restricted to an account and
+// a period. Changes are saved atomically unless the input is a dry run.
+func (uc *ApplyTransactionRulesUseCase) Execute(input dtos.ApplyTransactionRulesInput) (*dtos.ApplyTransactionRulesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	var accountID *accountvalueobjects.AccountID
+	if input.AccountID != "" {
+		id, err := accountvalueobjects.NewAccountID(input.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID: %w", err)
+		}
+		accountID = &id
+	}
+
+	startDate, endDate, err := ruleApplicationPeriod(input.StartDate, input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := uc.selectRules(userID, input.RuleIDs)
+	if err != nil {
+		return nil, err
+	}
+	engine := services.NewRuleEngine(rules)
+
+	output := &dtos.ApplyTransactionRulesOutput{
+		DryRun:  input.DryRun,
+		Changes: []dtos.RuleChangeOutput{},
+	}
+	if !engine.HasRules() {
+		return output, nil
+	}
+
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+
+	if !input.DryRun {
+		// Begin transaction to ensure atomicity
+		if err := uc.unitOfWork.Begin(); err != nil {
+			return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		}
+
+		// Ensure rollback on error
+		defer func() {
+			if uc.unitOfWork.IsInTransaction() {
+				if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+					_ = rollbackErr
+				}
+			}
+		}()
+	}
+
+	transactions, err := transactionRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	changed := make([]*entities.Transaction, 0)
+	for _, transaction := range transactions {
+		if accountID != nil && !transaction.AccountID().Equals(*accountID) {
+			continue
+		}
+		if startDate != nil && transaction.Date().Before(*startDate) {
+			continue
+		}
+		if endDate != nil && transaction.Date().After(*endDate) {
+			continue
+		}
+		output.CheckedCount++
+
+		var change services.RuleChange
+		if input.DryRun {
+			change = engine.Plan(transaction, input.OverwriteCategory)
+		} else {
+			change, err = engine.Apply(transaction, input.OverwriteCategory)
+			if err != nil {
+				return nil, fmt.Errorf("failed to apply rules to transaction %s: %w", transaction.ID().Value(), err)
+			}
+		}
+		if !change.HasChanges() {
+			continue
+		}
+
+		output.Changes = append(output.Changes, ruleChangeOutput(transaction, change))
+		changed = append(changed, transaction)
+	}
+	output.ChangedCount = len(output.Changes)
+
+	if input.DryRun {
+		return output, nil
+	}
+
+	for _, transaction := range changed {
+		if err := transactionRepository.Save(transaction); err != nil {
+			return nil, fmt.Errorf("failed to save transaction: %w", err)
+		}
+	}
+
+	// Commit transaction
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, transaction := range changed {
+		for _, event := range transaction.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		transaction.ClearEvents()
+	}
+
+	return output, nil
+}
+
+// selectRules returns the rules to run: the given ones, or all enabled rules of the user.
+func (uc *ApplyTransactionRulesUseCase) selectRules(
+	userID identityvalueobjects.UserID,
+	rawRuleIDs []string,
+) ([]*entities.TransactionRule, error) {
+	if len(rawRuleIDs) == 0 {
+		return findEnabledRules(uc.ruleRepository, userID)
+	}
+
+	rules := make([]*entities.TransactionRule, 0, len(rawRuleIDs))
+	seen := make(map[string]bool, len(rawRuleIDs))
+	for _, rawRuleID := range rawRuleIDs {
+		rule, err := findUserRule(uc.ruleRepository, userID, rawRuleID)
+		if err != nil {
+			return nil, err
+		}
+		if seen[rule.ID().Value()] {
+			continue
+		}
+		seen[rule.ID().Value()] = true
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// findEnabledRules returns the enabled rules of the user.
+func findEnabledRules(
+	ruleRepository repositories.TransactionRuleRepository,
+	userID identityvalueobjects.UserID,
+) ([]*entities.TransactionRule, error) {
+	rules, err := ruleRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find rules: %w", err)
+	}
+
+	enabled := make([]*entities.TransactionRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.IsEnabled() {
+			enabled = append(enabled, rule)
+		}
+	}
+	return enabled, nil
+}
+
+// ruleApplicationPeriod parses the optional period the rules run over. Both ends are inclusive.
+func ruleApplicationPeriod(rawStartDate, rawEndDate string) (*time.Time, *time.Time, error) {
+	var startDate, endDate *time.Time
+	if rawStartDate != "" {
+		parsed, err := time.Parse("2006-01-02", rawStartDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid start date format (expected YYYY-MM-DD): %w", err)
+		}
+		startDate = &parsed
+	}
+	if rawEndDate != "" {
+		parsed, err := time.Parse("2006-01-02", rawEndDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid end date format (expected YYYY-MM-DD): %w", err)
+		}
+		endDate = &parsed
+	}
+
+	if startDate != nil && endDate != nil && startDate.After(*endDate) {
+		return nil, nil, errors.New("invalid period: start date must be before end date")
+	}
+
+	return startDate, endDate, nil
+}
+
+// ruleChangeOutput converts what the rules changed on a transaction to its output DTO.
+func ruleChangeOutput(transaction *entities.Transaction, change services.RuleChange) dtos.RuleChangeOutput {
+	amount := transaction.Amount()
+	output := dtos.RuleChangeOutput{
+		TransactionID: transaction.ID().Value(),
+		Date:          transaction.Date().Format("2006-01-02"),
+		Amount:        amount.Float64(),
+		Currency:      amount.Currency().Code(),
+		Description:   change.PreviousDescription,
+		RuleIDs:       ruleIDValues(change.RuleIDs),
+	}
+	if change.PreviousCategoryID != nil {
+		output.PreviousCategoryID = change.PreviousCategoryID.Value()
+	}
+	if change.CategoryID != nil {
+		output.CategoryID = change.CategoryID.Value()
+	}
+	for _, tagID := range change.AddedTagIDs {
+		output.AddedTagIDs = append(output.AddedTagIDs, tagID.Value())
+	}
+	if change.Description != nil {
+		output.NewDescription = change.Description.Value()
+	}
+	return output
+}
+
+// ruleIDValues converts rule IDs to their string values.
+func ruleIDValues(ruleIDs []transactionvalueobjects.RuleID) []string {
+	values := make([]string, 0, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		values = append(values, ruleID.Value())
+	}
+	return values
+}
@@ -0,0 +1,219 @@
+package usecases
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+)
+
+var testPDFContent = []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\n%%EOF")
+
+func TestUploadAttachmentUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	txRepo := newMockTransactionRepository()
+	transaction := saveTestExpense(t, txRepo, userID, accountID, 15000, "Consulta médica", time.Now())
+
+	attachmentRepo := newMockAttachmentRepository()
+	fileStorage := newMockFileStorage()
+	useCase := NewUploadAttachmentUseCase(txRepo, attachmentRepo, fileStorage, eventbus.NewEventBus())
+
+	output, err := useCase.Execute(dtos.UploadAttachmentInput{
+		UserID:        userID.Value(),
+		TransactionID: transaction.ID().Value(),
+		FileName:      `C:\Users\ana\recibo consulta.pdf`,
+		Content:       testPDFContent,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.FileName != "recibo consulta.pdf" || output.ContentType != "application/pdf" || output.Size != int64(len(testPDFContent)) {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	if len(attachmentRepo.attachments) != 1 || len(fileStorage.files) != 1 {
+		t.Fatalf("expected 1 attachment and 1 stored file, got %d and %d", len(attachmentRepo.attachments), len(fileStorage.files))
+	}
+
+	tests := []struct {
+		name     string
+		input    dtos.UploadAttachmentInput
+		errorMsg string
+	}{
+		{
+			name:     "content is not an image or PDF",
+			input:    dtos.UploadAttachmentInput{FileName: "recibo.pdf", Content: []byte("MZ\x90\x00 not really a pdf")},
+			errorMsg: "invalid attachment file type",
+		},
+		{
+			name:     "empty file",
+			input:    dtos.UploadAttachmentInput{FileName: "recibo.pdf"},
+			errorMsg: "invalid attachment",
+		},
+		{
+			name:     "file too large",
+			input:    dtos.UploadAttachmentInput{FileName: "recibo.pdf", Content: make([]byte, entities.MaxAttachmentSize+1)},
+			errorMsg: "must be at most 8 MB",
+		},
+		{
+			name:     "file name with script",
+			input:    dtos.UploadAttachmentInput{FileName: "<script>alert(1)</script>.pdf", Content: testPDFContent},
+			errorMsg: "invalid attachment file name",
+		},
+		{
+			name:     "file name with path traversal",
+			input:    dtos.UploadAttachmentInput{FileName: "../../etc/passwd", Content: testPDFContent},
+			errorMsg: "invalid attachment file name",
+		},
+		{
+			name:     "transaction of another user",
+			input:    dtos.UploadAttachmentInput{UserID: identityvalueobjects.GenerateUserID().Value(), FileName: "recibo.pdf", Content: testPDFContent},
+			errorMsg: "does not belong to user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.input.UserID == "" {
+				tt.input.UserID = userID.Value()
+			}
+			tt.input.TransactionID = transaction.ID().Value()
+
+			_, err := useCase.Execute(tt.input)
+			if err == nil || !contains(err.Error(), tt.errorMsg) {
+				t.Errorf("expected error containing %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+
+	if len(attachmentRepo.attachments) != 1 || len(fileStorage.files) != 1 {
+		t.Errorf("rejected uploads should not be stored, got %d attachments and %d files", len(attachmentRepo.attachments), len(fileStorage.files))
+	}
+}
+
+func TestAttachmentUseCases_ListDownloadAndDelete(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	otherUserID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	txRepo := newMockTransactionRepository()
+	transaction := saveTestExpense(t, txRepo, userID, accountID, 15000, "Consulta médica", time.Now())
+
+	attachmentRepo := newMockAttachmentRepository()
+	fileStorage := newMockFileStorage()
+	uploaded, err := NewUploadAttachmentUseCase(txRepo, attachmentRepo, fileStorage, eventbus.NewEventBus()).Execute(dtos.UploadAttachmentInput{
+		UserID:        userID.Value(),
+		TransactionID: transaction.ID().Value(),
+		FileName:      "recibo.pdf",
+		Content:       testPDFContent,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	list, err := NewListAttachmentsUseCase(txRepo, attachmentRepo).Execute(dtos.ListAttachmentsInput{
+		UserID:        userID.Value(),
+		TransactionID: transaction.ID().Value(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.Count != 1 || list.Attachments[0].AttachmentID != uploaded.AttachmentID {
+		t.Errorf("expected the uploaded attachment to be listed, got %+v", list)
+	}
+
+	download := NewDownloadAttachmentUseCase(attachmentRepo, fileStorage)
+	file, err := download.Execute(dtos.DownloadAttachmentInput{
+		UserID:        userID.Value(),
+		TransactionID: transaction.ID().Value(),
+		AttachmentID:  uploaded.AttachmentID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content, _ := io.ReadAll(file.Content)
+	file.Content.Close()
+	if string(content) != string(testPDFContent) || file.ContentType != "application/pdf" || file.FileName != "recibo.pdf" {
+		t.Errorf("unexpected download: %s %s %q", file.FileName, file.ContentType, content)
+	}
+
+	_, err = download.Execute(dtos.DownloadAttachmentInput{
+		UserID:        otherUserID.Value(),
+		TransactionID: transaction.ID().Value(),
+		AttachmentID:  uploaded.AttachmentID,
+	})
+	if err == nil || !contains(err.Error(), "does not belong to user") {
+		t.Errorf("expected forbidden error for another user, got %v", err)
+	}
+
+	_, err = download.Execute(dtos.DownloadAttachmentInput{
+		UserID:        userID.Value(),
+		TransactionID: identityvalueobjects.GenerateUserID().Value(),
+		AttachmentID:  uploaded.AttachmentID,
+	})
+	if err == nil || !contains(err.Error(), "attachment not found") {
+		t.Errorf("expected not found error for another transaction, got %v", err)
+	}
+
+	deleteUseCase := NewDeleteAttachmentUseCase(attachmentRepo, fileStorage)
+	_, err = deleteUseCase.Execute(dtos.DeleteAttachmentInput{
+		UserID:        otherUserID.Value(),
+		TransactionID: transaction.ID().Value(),
+		AttachmentID:  uploaded.AttachmentID,
+	})
+	if err == nil || !contains(err.Error(), "does not belong to user") {
+		t.Errorf("expected forbidden error for another user, got %v", err)
+	}
+
+	if _, err := deleteUseCase.Execute(dtos.DeleteAttachmentInput{
+		UserID:        userID.Value(),
+		TransactionID: transaction.ID().Value(),
+		AttachmentID:  uploaded.AttachmentID,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(attachmentRepo.attachments) != 0 || len(fileStorage.files) != 0 {
+		t.Errorf("expected attachment and file to be deleted, got %d and %d", len(attachmentRepo.attachments), len(fileStorage.files))
+	}
+}
+
+func TestPermanentDeleteTransactionUseCase_DeletesAttachments(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	txRepo := newMockPermanentDeleteTransactionRepository()
+	transaction := saveTestExpense(t, txRepo.mockTransactionRepository, userID, accountID, 15000, "Consulta médica", time.Now())
+	other := saveTestExpense(t, txRepo.mockTransactionRepository, userID, accountID, 5000, "Farmácia", time.Now())
+
+	attachmentRepo := newMockAttachmentRepository()
+	fileStorage := newMockFileStorage()
+	upload := NewUploadAttachmentUseCase(txRepo, attachmentRepo, fileStorage, eventbus.NewEventBus())
+	for _, transactionID := range []string{transaction.ID().Value(), transaction.ID().Value(), other.ID().Value()} {
+		if _, err := upload.Execute(dtos.UploadAttachmentInput{
+			UserID:        userID.Value(),
+			TransactionID: transactionID,
+			FileName:      "recibo.pdf",
+			Content:       testPDFContent,
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	output, err := NewPermanentDeleteTransactionUseCase(txRepo, attachmentRepo, fileStorage).Execute(dtos.PermanentDeleteTransactionInput{
+		TransactionID: transaction.ID().Value(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.DeletedAttachments != 2 {
+		t.Errorf("expected 2 deleted attachments, got %d", output.DeletedAttachments)
+	}
+	if len(attachmentRepo.attachments) != 1 || len(fileStorage.files) != 1 {
+		t.Errorf("expected only the other transaction's attachment to remain, got %d attachments and %d files", len(attachmentRepo.attachments), len(fileStorage.files))
+	}
+}
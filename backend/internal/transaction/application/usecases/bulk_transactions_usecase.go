@@ -0,0 +1,605 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// MaxBulkTransactions is the maximum number of transactions a bulk operation may change.
+const MaxBulkTransactions = 500
+
+// bulkSavePoint is the save point each transaction of a bulk operation runs in.
+const bulkSavePoint = "bulk_transaction"
+
+// Bulk operations.
+const (
+	BulkRecategorize  = "RECATEGORIZE"
+	BulkChangeAccount = "CHANGE_ACCOUNT"
+	BulkAddTags       = "ADD_TAGS"
+	BulkRemoveTags    = "REMOVE_TAGS"
+	BulkDelete        = "DELETE"
+	BulkRestore       = "RESTORE"
+)
+
+// Outcomes of a bulk operation for a single transaction.
+const (
+	bulkResultUpdated   = "UPDATED"
+	bulkResultUnchanged = "UNCHANGED"
+	bulkResultFailed    = "FAILED"
+)
+
+// BulkTransactionsUseCase applies one operation (recategorize, change account, add or remove
+// tags, delete or restore) to many transactions at once, e.g. to clean up after an import.
+// It runs atomically using UnitOfWork: when any transaction fails, nothing is changed, and
+// the result of every transaction is reported either way. Each transaction runs in a save
+// point, so a failed one is rolled back on its own and the rest still run on a usable
+// database transaction (a failed statement aborts the whole transaction in PostgreSQL).
+type BulkTransactionsUseCase struct {
+	unitOfWork         sharedrepositories.UnitOfWork
+	categoryRepository categoryrepositories.CategoryRepository
+	tagRepository      tagrepositories.TagRepository
+	eventBus           *eventbus.EventBus
+}
+
+// NewBulkTransactionsUseCase creates a new BulkTransactionsUseCase instance.
+func NewBulkTransactionsUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	categoryRepository categoryrepositories.CategoryRepository,
+	tagRepository tagrepositories.TagRepository,
+	eventBus *eventbus.EventBus,
+) *BulkTransactionsUseCase {
+	return &BulkTransactionsUseCase{
+		unitOfWork:         unitOfWork,
+		categoryRepository: categoryRepository,
+		tagRepository:      tagRepository,
+		eventBus:           eventBus,
+	}
+}
+
+// bulkOperation holds the parsed arguments of a bulk operation while it runs.
+type bulkOperation struct {
+	userID     identityvalueobjects.UserID
+	requestID  string
+	name       string
+	categoryID *categoryvalueobjects.CategoryID
+	accountID  accountvalueobjects.AccountID
+	tagIDs     []tagvalueobjects.TagID
+
+	// done holds the transactions already changed, so the counterpart leg of a transfer
+	// that was deleted or restored along with its pair is not processed twice
+	done map[string]bool
+	// doneIDs lists the keys of done in the order they were added, so the ones added by a
+	// transaction that failed can be dropped along with its changes
+	doneIDs []string
+	// events are published once the whole operation is committed
+	events []events.DomainEvent
+}
+
+// markDone records that the transaction was changed by the operation.
+func (op *bulkOperation) markDone(transactionID transactionvalueobjects.TransactionID) {
+	op.done[transactionID.Value()] = true
+	op.doneIDs = append(op.doneIDs, transactionID.Value())
+}
+
+// discardSince drops the events and changed transactions recorded after the given counts,
+// once the changes of a failed transaction were rolled back.
+func (op *bulkOperation) discardSince(eventCount, doneCount int) {
+	op.events = op.events[:eventCount]
+	for _, transactionID := range op.doneIDs[doneCount:] {
+		delete(op.done, transactionID)
+	}
+	op.doneIDs = op.doneIDs[:doneCount]
+}
+
+// Execute applies the operation to the selected transactions.
+func (uc *BulkTransactionsUseCase) Execute(input dtos.BulkTransactionsInput) (*dtos.BulkTransactionsOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	op := &bulkOperation{userID: userID, requestID: input.RequestID, name: input.Operation, done: make(map[string]bool)}
+	if err := uc.parseOperation(op, input); err != nil {
+		return nil, err
+	}
+
+	transactionIDs, err := parseBulkSelection(input)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	if op.name == BulkChangeAccount {
+		if _, err := findUserAccount(accountRepository, userID, op.accountID, "destination"); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Filter != nil {
+		filter, err := transactionFilterFromInput(listInputFromBulkFilter(*input.Filter))
+		if err != nil {
+			return nil, err
+		}
+		matches, _, err := transactionRepository.FindByUserIDAndFiltersWithPagination(userID, filter, 0, MaxBulkTransactions+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transactions: %w", err)
+		}
+		if len(matches) > MaxBulkTransactions {
+			return nil, fmt.Errorf("invalid filter: more than %d transactions match, narrow it down", MaxBulkTransactions)
+		}
+		for _, transaction := range matches {
+			transactionIDs = append(transactionIDs, transaction.ID())
+		}
+	}
+
+	output := &dtos.BulkTransactionsOutput{
+		Operation: op.name,
+		Count:     len(transactionIDs),
+		Results:   make([]dtos.BulkTransactionResult, 0, len(transactionIDs)),
+	}
+	for _, transactionID := range transactionIDs {
+		result := dtos.BulkTransactionResult{TransactionID: transactionID.Value(), Status: bulkResultUnchanged}
+		if !op.done[transactionID.Value()] {
+			if err := uc.unitOfWork.SavePoint(bulkSavePoint); err != nil {
+				return nil, fmt.Errorf("failed to create save point: %w", err)
+			}
+			eventCount, doneCount := len(op.events), len(op.doneIDs)
+
+			changed, err := uc.apply(op, transactionID)
+			switch {
+			case err != nil:
+				// Discard the partial changes of the failed transaction only
+				if err := uc.unitOfWork.RollbackTo(bulkSavePoint); err != nil {
+					return nil, fmt.Errorf("failed to rollback to save point: %w", err)
+				}
+				op.discardSince(eventCount, doneCount)
+				result.Status = bulkResultFailed
+				result.Error = err.Error()
+			case changed:
+				result.Status = bulkResultUpdated
+			}
+			op.done[transactionID.Value()] = true
+		} else {
+			result.Status = bulkResultUpdated // Changed along with its transfer counterpart
+		}
+
+		switch result.Status {
+		case bulkResultUpdated:
+			output.UpdatedCount++
+		case bulkResultUnchanged:
+			output.UnchangedCount++
+		case bulkResultFailed:
+			output.FailedCount++
+		}
+		output.Results = append(output.Results, result)
+	}
+
+	// Nothing is kept unless every transaction succeeded (the deferred rollback undoes the rest)
+	if output.FailedCount > 0 {
+		return output, nil
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	output.Applied = true
+
+	// Publish domain events (after successful commit)
+	for _, event := range op.events {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+
+	return output, nil
+}
+
+// parseOperation checks the arguments the operation needs and loads them into op.
+func (uc *BulkTransactionsUseCase) parseOperation(op *bulkOperation, input dtos.BulkTransactionsInput) error {
+	var err error
+	switch op.name {
+	case BulkRecategorize:
+		op.categoryID, err = findUserCategoryID(uc.categoryRepository, op.userID, input.CategoryID)
+		return err
+	case BulkChangeAccount:
+		if input.AccountID == "" {
+			return errors.New("invalid bulk operation: account ID is required to change the account")
+		}
+		op.accountID, err = accountvalueobjects.NewAccountID(input.AccountID)
+		if err != nil {
+			return fmt.Errorf("invalid account ID: %w", err)
+		}
+		return nil
+	case BulkAddTags, BulkRemoveTags:
+		if len(input.TagIDs) == 0 {
+			return fmt.Errorf("invalid bulk operation: tag IDs are required for %s", op.name)
+		}
+		op.tagIDs, err = findUserTagIDs(uc.tagRepository, op.userID, input.TagIDs)
+		return err
+	case BulkDelete, BulkRestore:
+		return nil
+	default:
+		return fmt.Errorf("invalid bulk operation: %s", op.name)
+	}
+}
+
+// parseBulkSelection parses the transaction IDs of a bulk operation, ignoring repeated ones.
+// Transactions selected by filter are loaded later, within the operation.
+func parseBulkSelection(input dtos.BulkTransactionsInput) ([]transactionvalueobjects.TransactionID, error) {
+	if len(input.TransactionIDs) > 0 && input.Filter != nil {
+		return nil, errors.New("invalid bulk selection: give either transaction IDs or a filter, not both")
+	}
+	if len(input.TransactionIDs) == 0 && input.Filter == nil {
+		return nil, errors.New("invalid bulk selection: transaction IDs or a filter must be provided")
+	}
+	if input.Filter != nil && input.Operation == BulkRestore {
+		return nil, errors.New("invalid bulk selection: deleted transactions can only be restored by ID")
+	}
+	if len(input.TransactionIDs) > MaxBulkTransactions {
+		return nil, fmt.Errorf("invalid bulk selection: at most %d transactions per request", MaxBulkTransactions)
+	}
+
+	transactionIDs := make([]transactionvalueobjects.TransactionID, 0, len(input.TransactionIDs))
+	seen := make(map[string]bool, len(input.TransactionIDs))
+	for _, rawID := range input.TransactionIDs {
+		transactionID, err := transactionvalueobjects.NewTransactionID(rawID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction ID: %w", err)
+		}
+		if seen[transactionID.Value()] {
+			continue
+		}
+		seen[transactionID.Value()] = true
+		transactionIDs = append(transactionIDs, transactionID)
+	}
+	return transactionIDs, nil
+}
+
+// listInputFromBulkFilter converts a bulk filter to the list input the transaction filter is built from.
+func listInputFromBulkFilter(filter dtos.BulkTransactionFilter) dtos.ListTransactionsInput {
+	return dtos.ListTransactionsInput{
+		AccountID:     filter.AccountID,
+		Type:          filter.Type,
+		Status:        filter.Status,
+		PayeeID:       filter.PayeeID,
+		TagIDs:        filter.TagIDs,
+		TagMatch:      filter.TagMatch,
+		StartDate:     filter.StartDate,
+		EndDate:       filter.EndDate,
+		MinAmount:     filter.MinAmount,
+		MaxAmount:     filter.MaxAmount,
+		Currency:      filter.Currency,
+		Search:        filter.Search,
+		RecurringOnly: filter.RecurringOnly,
+	}
+}
+
+// apply runs the operation on one transaction, within the open UnitOfWork transaction.
+// It reports whether the transaction was changed.
+func (uc *BulkTransactionsUseCase) apply(op *bulkOperation, transactionID transactionvalueobjects.TransactionID) (bool, error) {
+	if op.name == BulkRestore {
+		return uc.restore(op, transactionID)
+	}
+
+	transaction, err := findUserTransaction(uc.unitOfWork.TransactionRepository(), op.userID, transactionID)
+	if err != nil {
+		return false, err
+	}
+
+	before := entities.SnapshotOf(transaction)
+	var changed bool
+	switch op.name {
+	case BulkRecategorize:
+		changed, err = uc.recategorize(op, transaction)
+	case BulkChangeAccount:
+		changed, err = uc.changeAccount(op, transaction)
+	case BulkAddTags, BulkRemoveTags:
+		changed, err = uc.changeTags(op, transaction)
+	default:
+		return uc.delete(op, transaction)
+	}
+	if err != nil || !changed {
+		return changed, err
+	}
+
+	return true, recordTransactionRevision(uc.unitOfWork.TransactionRevisionRepository(), transaction, entities.RevisionActionUpdate, before, entities.SnapshotOf(transaction), &op.userID, op.requestID)
+}
+
+// recategorize assigns the transaction to the category, turning a split transaction back into a regular one.
+func (uc *BulkTransactionsUseCase) recategorize(op *bulkOperation, transaction *entities.Transaction) (bool, error) {
+	current := transaction.CategoryID()
+	sameCategory := (current == nil && op.categoryID == nil) ||
+		(current != nil && op.categoryID != nil && current.Equals(*op.categoryID))
+	if sameCategory && !transaction.HasSplits() {
+		return false, nil
+	}
+
+	if transaction.HasSplits() {
+		if err := transaction.UpdateSplits(nil); err != nil {
+			return false, fmt.Errorf("failed to update transaction split lines: %w", err)
+		}
+	}
+	if err := transaction.UpdateCategory(op.categoryID); err != nil {
+		return false, fmt.Errorf("failed to update transaction category: %w", err)
+	}
+
+	return true, uc.save(op, transaction)
+}
+
+// changeAccount moves the transaction to the destination account, moving its effect on the
+// balance along with it.
+func (uc *BulkTransactionsUseCase) changeAccount(op *bulkOperation, transaction *entities.Transaction) (bool, error) {
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	oldAccountID := transaction.AccountID()
+	if oldAccountID.Equals(op.accountID) {
+		return false, nil
+	}
+
+	destination, err := findUserAccount(accountRepository, op.userID, op.accountID, "destination")
+	if err != nil {
+		return false, err
+	}
+	amount := transaction.Amount()
+	if !destination.Balance().Currency().Equals(amount.Currency()) {
+		return false, fmt.Errorf("invalid account: the destination account uses %s and the transaction uses %s",
+			destination.Balance().Currency().Code(), amount.Currency().Code())
+	}
+
+	if err := transaction.MoveToAccount(op.accountID); err != nil {
+		return false, err
+	}
+
+	if err := reverseAccountBalance(accountRepository, oldAccountID, transaction.TransactionType(), amount); err != nil {
+		return false, err
+	}
+	if err := applyBalanceEffect(destination, transaction.TransactionType(), amount); err != nil {
+		return false, fmt.Errorf("failed to apply transaction to the destination account: %w", err)
+	}
+	if err := accountRepository.Save(destination); err != nil {
+		return false, fmt.Errorf("failed to save updated account: %w", err)
+	}
+
+	// The balances were moved above, so subscribers only see the TransactionAccountChanged event
+	// raised by MoveToAccount (TransactionDeleted/TransactionCreated would move them again)
+	return true, uc.save(op, transaction)
+}
+
+// changeTags adds the tags to the transaction or removes them from it.
+func (uc *BulkTransactionsUseCase) changeTags(op *bulkOperation, transaction *entities.Transaction) (bool, error) {
+	current := transaction.TagIDs()
+	has := func(tags []tagvalueobjects.TagID, tagID tagvalueobjects.TagID) bool {
+		for _, tag := range tags {
+			if tag.Equals(tagID) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var tagIDs []tagvalueobjects.TagID
+	if op.name == BulkAddTags {
+		tagIDs = current
+		for _, tagID := range op.tagIDs {
+			if !has(tagIDs, tagID) {
+				tagIDs = append(tagIDs, tagID)
+			}
+		}
+	} else {
+		tagIDs = make([]tagvalueobjects.TagID, 0, len(current))
+		for _, tagID := range current {
+			if !has(op.tagIDs, tagID) {
+				tagIDs = append(tagIDs, tagID)
+			}
+		}
+	}
+	if len(tagIDs) == len(current) {
+		return false, nil
+	}
+
+	if err := transaction.UpdateTags(tagIDs); err != nil {
+		return false, fmt.Errorf("invalid tags: %w", err)
+	}
+
+	return true, uc.save(op, transaction)
+}
+
+// delete soft-deletes the transaction and reverses its effect on the account balance.
+// Deleting one leg of a transfer deletes the whole transfer.
+func (uc *BulkTransactionsUseCase) delete(op *bulkOperation, transaction *entities.Transaction) (bool, error) {
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Reconciled transactions match a bank statement and must be unlocked deliberately first
+	if transaction.IsReconciled() {
+		return false, errors.New("reconciled transaction cannot be deleted: set its status back to CLEARED first")
+	}
+
+	legs := []*entities.Transaction{transaction}
+	if transaction.IsTransfer() {
+		linked, err := findLinkedTransaction(transactionRepository, transaction)
+		if err != nil {
+			return false, err
+		}
+		if linked.IsReconciled() {
+			return false, errors.New("linked transfer transaction is reconciled and cannot be deleted: set its status back to CLEARED first")
+		}
+		legs = append(legs, linked)
+	}
+
+	for _, leg := range legs {
+		if err := reverseAccountBalance(accountRepository, leg.AccountID(), leg.TransactionType(), leg.Amount()); err != nil {
+			return false, err
+		}
+		if err := transactionRepository.Delete(leg.ID()); err != nil {
+			return false, fmt.Errorf("failed to delete transaction: %w", err)
+		}
+		if err := recordTransactionRevision(uc.unitOfWork.TransactionRevisionRepository(), leg, entities.RevisionActionDelete, entities.SnapshotOf(leg), nil, &op.userID, op.requestID); err != nil {
+			return false, err
+		}
+		op.markDone(leg.ID())
+	}
+
+	if transaction.IsTransfer() {
+		outgoing, incoming := transferLegs(legs[0], legs[1])
+		op.events = append(op.events, transactionevents.NewTransferDeleted(
+			outgoing.ID().Value(),
+			incoming.ID().Value(),
+			outgoing.AccountID().Value(),
+			incoming.AccountID().Value(),
+			outgoing.Amount(),
+			incoming.Amount(),
+		))
+	} else {
+		op.events = append(op.events, transactionevents.NewTransactionDeleted(
+			transaction.ID().Value(),
+			transaction.AccountID().Value(),
+			transaction.TransactionType().Value(),
+			transaction.Amount(),
+		))
+	}
+
+	return true, nil
+}
+
+// restore restores a soft-deleted transaction and applies it to the account balance again.
+// Transfers were deleted as a pair, so the counterpart leg is restored as well.
+func (uc *BulkTransactionsUseCase) restore(op *bulkOperation, transactionID transactionvalueobjects.TransactionID) (bool, error) {
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	existing, err := transactionRepository.FindByID(transactionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if existing != nil {
+		if !existing.UserID().Equals(op.userID) {
+			return false, fmt.Errorf("transaction not found: %s", transactionID.Value())
+		}
+		return false, nil // Not deleted
+	}
+
+	// Restoring needs the concrete repository, like the single restore endpoint
+	repo, ok := transactionRepository.(interface {
+		Restore(transactionvalueobjects.TransactionID) error
+	})
+	if !ok {
+		return false, errors.New("unable to restore transaction: repository does not support restore operation")
+	}
+
+	restoreLeg := func(id transactionvalueobjects.TransactionID) (*entities.Transaction, error) {
+		if err := repo.Restore(id); err != nil {
+			return nil, fmt.Errorf("failed to restore transaction: %w", err)
+		}
+		// Transactions of other users are reported as missing; the rollback undoes the restore
+		transaction, err := transactionRepository.FindByID(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transaction: %w", err)
+		}
+		if transaction == nil || !transaction.UserID().Equals(op.userID) {
+			return nil, fmt.Errorf("transaction not found: %s", id.Value())
+		}
+
+		account, err := findUserAccount(accountRepository, op.userID, transaction.AccountID(), "transaction")
+		if err != nil {
+			return nil, err
+		}
+		if err := applyBalanceEffect(account, transaction.TransactionType(), transaction.Amount()); err != nil {
+			return nil, fmt.Errorf("failed to apply restored transaction: %w", err)
+		}
+		if err := accountRepository.Save(account); err != nil {
+			return nil, fmt.Errorf("failed to save updated account: %w", err)
+		}
+		if err := recordTransactionRevision(uc.unitOfWork.TransactionRevisionRepository(), transaction, entities.RevisionActionRestore, nil, entities.SnapshotOf(transaction), &op.userID, op.requestID); err != nil {
+			return nil, err
+		}
+		op.markDone(id)
+		return transaction, nil
+	}
+
+	transaction, err := restoreLeg(transactionID)
+	if err != nil {
+		return false, err
+	}
+
+	if !transaction.IsTransfer() {
+		op.events = append(op.events, transactionevents.NewTransactionCreated(
+			transaction.ID().Value(),
+			transaction.AccountID().Value(),
+			transaction.TransactionType().Value(),
+			transaction.Amount(),
+		))
+		return true, nil
+	}
+
+	linked, err := transactionRepository.FindByID(*transaction.LinkedTransactionID())
+	if err != nil {
+		return false, fmt.Errorf("failed to find linked transaction: %w", err)
+	}
+	if linked == nil {
+		if linked, err = restoreLeg(*transaction.LinkedTransactionID()); err != nil {
+			return false, err
+		}
+	}
+
+	outgoing, incoming := transferLegs(transaction, linked)
+	op.events = append(op.events, transactionevents.NewTransferCreated(
+		outgoing.ID().Value(),
+		incoming.ID().Value(),
+		outgoing.AccountID().Value(),
+		incoming.AccountID().Value(),
+		outgoing.Amount(),
+		incoming.Amount(),
+	))
+
+	return true, nil
+}
+
+// save saves a changed transaction and queues its domain events.
+func (uc *BulkTransactionsUseCase) save(op *bulkOperation, transaction *entities.Transaction) error {
+	if err := uc.unitOfWork.TransactionRepository().Save(transaction); err != nil {
+		return fmt.Errorf("failed to save transaction: %w", err)
+	}
+	op.events = append(op.events, transaction.GetEvents()...)
+	transaction.ClearEvents()
+	return nil
+}
+
+// transferLegs orders the two legs of a transfer as outgoing and incoming.
+func transferLegs(leg, linked *entities.Transaction) (*entities.Transaction, *entities.Transaction) {
+	if leg.TransactionType().Value() == transactionvalueobjects.TransferIn {
+		return linked, leg
+	}
+	return leg, linked
+}
@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// setupBulkTest creates a user with two BRL accounts: a checking account with R$ 1.000,00
+// and a savings account with R$ 500,00.
+func setupBulkTest(t *testing.T) (*mockUnitOfWork, *mockTransactionRepository, *mockAccountRepository, identityvalueobjects.UserID, accountvalueobjects.AccountID, accountvalueobjects.AccountID) {
+	t.Helper()
+
+	userID := identityvalueobjects.GenerateUserID()
+	checkingID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, accRepo := setupImportTest(t, userID, checkingID, 100000)
+
+	savingsID := accountvalueobjects.GenerateAccountID()
+	balance, _ := sharedvalueobjects.NewMoney(50000, sharedvalueobjects.MustCurrency("BRL"))
+	savings, err := createTestAccountWithID(userID, savingsID, balance)
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+	_ = accRepo.Save(savings)
+
+	return uow, txRepo, accRepo, userID, checkingID, savingsID
+}
+
+func bulkTestBalance(accRepo *mockAccountRepository, accountID accountvalueobjects.AccountID) int64 {
+	account, _ := accRepo.FindByID(accountID)
+	return account.Balance().Amount()
+}
+
+func TestBulkTransactionsUseCase_ChangeAccount(t *testing.T) {
+	uow, txRepo, accRepo, userID, checkingID, savingsID := setupBulkTest(t)
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	bakery := saveTestExpense(t, txRepo, userID, checkingID, 1000, "Padaria", date)
+	pharmacy := saveTestExpense(t, txRepo, userID, checkingID, 2000, "Farmácia", date)
+	saveTestExpense(t, txRepo, userID, checkingID, 30000, "Mercado", date)
+	rent := saveTestExpense(t, txRepo, userID, savingsID, 5000, "Condomínio", date)
+
+	published := make(map[string]int)
+	eventBus := eventbus.NewEventBus()
+	for _, eventType := range []string{"TransactionAccountChanged", "TransactionCreated", "TransactionDeleted"} {
+		eventBus.Subscribe(eventType, func(event events.DomainEvent) error {
+			published[event.EventType()]++
+			return nil
+		})
+	}
+
+	output, err := NewBulkTransactionsUseCase(uow, nil, nil, eventBus).Execute(dtos.BulkTransactionsInput{
+		UserID:    userID.Value(),
+		Operation: BulkChangeAccount,
+		Filter:    &dtos.BulkTransactionFilter{MaxAmount: "50.00"},
+		AccountID: savingsID.Value(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if !output.Applied || output.Count != 3 || output.UpdatedCount != 2 || output.UnchangedCount != 1 {
+		t.Fatalf("Execute() = %+v, want 2 updated and 1 unchanged", output)
+	}
+	for _, transaction := range []*entities.Transaction{bakery, pharmacy, rent} {
+		if moved, _ := txRepo.FindByID(transaction.ID()); !moved.AccountID().Equals(savingsID) {
+			t.Errorf("transaction %s is in account %s, want the savings account", transaction.Description().Value(), moved.AccountID().Value())
+		}
+	}
+	if got := bulkTestBalance(accRepo, checkingID); got != 103000 {
+		t.Errorf("checking balance = %d, want 103000", got)
+	}
+	if got := bulkTestBalance(accRepo, savingsID); got != 47000 {
+		t.Errorf("savings balance = %d, want 47000", got)
+	}
+
+	// Balance subscribers must not apply the move a second time
+	if published["TransactionAccountChanged"] != 2 || published["TransactionCreated"] != 0 || published["TransactionDeleted"] != 0 {
+		t.Errorf("published events = %v, want only 2 TransactionAccountChanged", published)
+	}
+}
+
+func TestBulkTransactionsUseCase_DeleteAndRestore(t *testing.T) {
+	uow, txRepo, accRepo, userID, checkingID, savingsID := setupBulkTest(t)
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	bakery := saveTestExpense(t, txRepo, userID, checkingID, 1000, "Padaria", date)
+
+	amount, _ := sharedvalueobjects.NewMoney(20000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Reserva")
+	outgoing, incoming, err := entities.NewTransfer(userID, checkingID, savingsID, amount, amount, description, date)
+	if err != nil {
+		t.Fatalf("failed to create transfer: %v", err)
+	}
+	_ = txRepo.Save(outgoing)
+	_ = txRepo.Save(incoming)
+
+	useCase := NewBulkTransactionsUseCase(uow, nil, nil, eventbus.NewEventBus())
+	deleted, err := useCase.Execute(dtos.BulkTransactionsInput{
+		UserID:         userID.Value(),
+		Operation:      BulkDelete,
+		TransactionIDs: []string{bakery.ID().Value(), incoming.ID().Value(), outgoing.ID().Value()},
+	})
+	if err != nil {
+		t.Fatalf("Execute(DELETE) error = %v", err)
+	}
+	if !deleted.Applied || deleted.UpdatedCount != 3 {
+		t.Fatalf("Execute(DELETE) = %+v, want 3 updated", deleted)
+	}
+	if len(txRepo.transactions) != 0 {
+		t.Errorf("Execute(DELETE) left %d transactions", len(txRepo.transactions))
+	}
+	// Deleting reverses the expense and the transfer
+	if got := bulkTestBalance(accRepo, checkingID); got != 121000 {
+		t.Errorf("checking balance after delete = %d, want 121000", got)
+	}
+	if got := bulkTestBalance(accRepo, savingsID); got != 30000 {
+		t.Errorf("savings balance after delete = %d, want 30000", got)
+	}
+
+	// Restoring one leg of the transfer restores both
+	restored, err := useCase.Execute(dtos.BulkTransactionsInput{
+		UserID:         userID.Value(),
+		Operation:      BulkRestore,
+		TransactionIDs: []string{bakery.ID().Value(), incoming.ID().Value()},
+	})
+	if err != nil {
+		t.Fatalf("Execute(RESTORE) error = %v", err)
+	}
+	if !restored.Applied || restored.UpdatedCount != 2 {
+		t.Fatalf("Execute(RESTORE) = %+v, want 2 updated", restored)
+	}
+	if len(txRepo.transactions) != 3 {
+		t.Errorf("Execute(RESTORE) restored %d transactions, want 3", len(txRepo.transactions))
+	}
+	if got := bulkTestBalance(accRepo, checkingID); got != 100000 {
+		t.Errorf("checking balance after restore = %d, want 100000", got)
+	}
+	if got := bulkTestBalance(accRepo, savingsID); got != 50000 {
+		t.Errorf("savings balance after restore = %d, want 50000", got)
+	}
+}
+
+func TestBulkTransactionsUseCase_Failures(t *testing.T) {
+	uow, txRepo, _, userID, checkingID, _ := setupBulkTest(t)
+	bakery := saveTestExpense(t, txRepo, userID, checkingID, 1000, "Padaria", time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC))
+	useCase := NewBulkTransactionsUseCase(uow, nil, nil, eventbus.NewEventBus())
+
+	t.Run("any failed transaction keeps everything unchanged", func(t *testing.T) {
+		missingID := transactionvalueobjects.GenerateTransactionID().Value()
+		output, err := useCase.Execute(dtos.BulkTransactionsInput{
+			UserID:         userID.Value(),
+			Operation:      BulkDelete,
+			TransactionIDs: []string{bakery.ID().Value(), missingID},
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if output.Applied || output.FailedCount != 1 {
+			t.Fatalf("Execute() = %+v, want not applied with 1 failure", output)
+		}
+		if result := output.Results[1]; result.TransactionID != missingID || result.Status != bulkResultFailed || result.Error == "" {
+			t.Errorf("Execute() result = %+v, want the missing transaction to fail", result)
+		}
+		// Each transaction runs in a save point and only the failed one is rolled back to it
+		if len(uow.savePoints) != 2 || len(uow.rollbacksTo) != 1 {
+			t.Errorf("Execute() save points = %v, rollbacks = %v, want 2 save points and 1 rollback", uow.savePoints, uow.rollbacksTo)
+		}
+		if uow.IsInTransaction() {
+			t.Error("Execute() expected the transaction to be rolled back")
+		}
+	})
+
+	invalid := map[string]dtos.BulkTransactionsInput{
+		"both IDs and filter": {
+			UserID:         userID.Value(),
+			Operation:      BulkDelete,
+			TransactionIDs: []string{bakery.ID().Value()},
+			Filter:         &dtos.BulkTransactionFilter{},
+		},
+		"no selection":      {UserID: userID.Value(), Operation: BulkDelete},
+		"restore by filter": {UserID: userID.Value(), Operation: BulkRestore, Filter: &dtos.BulkTransactionFilter{}},
+		"account not given": {UserID: userID.Value(), Operation: BulkChangeAccount, TransactionIDs: []string{bakery.ID().Value()}},
+		"tags not given":    {UserID: userID.Value(), Operation: BulkAddTags, TransactionIDs: []string{bakery.ID().Value()}},
+		"unknown operation": {UserID: userID.Value(), Operation: "ARCHIVE", TransactionIDs: []string{bakery.ID().Value()}},
+		"unknown destination account": {
+			UserID:         userID.Value(),
+			Operation:      BulkChangeAccount,
+			TransactionIDs: []string{bakery.ID().Value()},
+			AccountID:      accountvalueobjects.GenerateAccountID().Value(),
+		},
+	}
+	for name, input := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := useCase.Execute(input); err == nil {
+				t.Error("Execute() expected error")
+			}
+		})
+	}
+}
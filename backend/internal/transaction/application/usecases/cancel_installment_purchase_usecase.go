@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
+)
+
+// CancelInstallmentPurchaseUseCase cancels the installments of a purchase that are not due yet,
+// e.g. when the purchase is returned. The canceled installments are deleted (soft delete) and
+// their amount is credited back to the card, atomically using UnitOfWork. Installments already
+// due are kept, since they were billed.
+type CancelInstallmentPurchaseUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewCancelInstallmentPurchaseUseCase creates a new CancelInstallmentPurchaseUseCase instance.
+func NewCancelInstallmentPurchaseUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *CancelInstallmentPurchaseUseCase {
+	return &CancelInstallmentPurchaseUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute cancels the installments dated after the given date (default today).
+func (uc *CancelInstallmentPurchaseUseCase) Execute(input dtos.CancelInstallmentPurchaseInput) (*dtos.CancelInstallmentPurchaseOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	cutoff, err := parseInstallmentCutoffDate(input.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get repositories from UnitOfWork
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	installments, err := findInstallmentPurchase(transactionRepository, userID, input.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	canceled, err := installmentsDueAfter(installments, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	var canceledCents int64
+	canceledIDs := make(map[string]bool, len(canceled))
+	domainEvents := make([]events.DomainEvent, 0, len(canceled)+1)
+	for _, installment := range canceled {
+		if err := reverseAccountBalance(accountRepository, installment.AccountID(), installment.TransactionType(), installment.Amount()); err != nil {
+			return nil, err
+		}
+		if err := transactionRepository.Delete(installment.ID()); err != nil {
+			return nil, fmt.Errorf("failed to delete installment: %w", err)
+		}
+
+		canceledCents += installment.Amount().Amount()
+		canceledIDs[installment.ID().Value()] = true
+		domainEvents = append(domainEvents, transactionevents.NewTransactionDeleted(
+			installment.ID().Value(),
+			installment.AccountID().Value(),
+			installment.TransactionType().Value(),
+			installment.Amount(),
+		))
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	purchaseID := installments[0].InstallmentPurchaseID().Value()
+	domainEvents = append(domainEvents, events.NewBaseDomainEvent("InstallmentPurchaseCanceled", purchaseID, "Transaction"))
+
+	// Publish domain events (after successful commit)
+	for _, event := range domainEvents {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+
+	var kept []*entities.Transaction
+	for _, installment := range installments {
+		if !canceledIDs[installment.ID().Value()] {
+			kept = append(kept, installment)
+		}
+	}
+	output := &dtos.CancelInstallmentPurchaseOutput{
+		CanceledCount:  len(canceled),
+		CanceledAmount: float64(canceledCents) / 100,
+	}
+	if len(kept) > 0 {
+		output.Purchase = installmentPurchaseOutput(kept, time.Now())
+	} else {
+		output.Purchase = dtos.InstallmentPurchaseOutput{
+			PurchaseID:       purchaseID,
+			AccountID:        installments[0].AccountID().Value(),
+			Description:      installments[0].Description().Value(),
+			Currency:         installments[0].Amount().Currency().Code(),
+			InstallmentCount: installments[0].Installment().Count(),
+			Installments:     []dtos.InstallmentOutput{},
+		}
+	}
+
+	return output, nil
+}
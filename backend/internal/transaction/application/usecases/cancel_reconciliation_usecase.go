@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// CancelReconciliationUseCase abandons a reconciliation in progress.
+// Transactions keep their status, so cleared transactions stay cleared for the next attempt.
+type CancelReconciliationUseCase struct {
+	reconciliationRepository repositories.ReconciliationRepository
+	eventBus                 *eventbus.EventBus
+}
+
+// NewCancelReconciliationUseCase creates a new CancelReconciliationUseCase instance.
+func NewCancelReconciliationUseCase(
+	reconciliationRepository repositories.ReconciliationRepository,
+	eventBus *eventbus.EventBus,
+) *CancelReconciliationUseCase {
+	return &CancelReconciliationUseCase{
+		reconciliationRepository: reconciliationRepository,
+		eventBus:                 eventBus,
+	}
+}
+
+// Execute cancels the reconciliation.
+func (uc *CancelReconciliationUseCase) Execute(input dtos.CancelReconciliationInput) (*dtos.ReconciliationOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	reconciliation, err := findUserReconciliation(uc.reconciliationRepository, userID, input.ReconciliationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := reconciliation.Cancel(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.reconciliationRepository.Save(reconciliation); err != nil {
+		return nil, fmt.Errorf("failed to save reconciliation: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range reconciliation.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	reconciliation.ClearEvents()
+
+	output := reconciliationOutput(reconciliation, nil)
+	return &output, nil
+}
@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+)
+
+// ChangeRecurringAmountUseCase handles changing the amount of a recurring series from an
+// occurrence on ("this and future occurrences"), e.g. a rent adjustment. Occurrences already
+// created on or after that date are updated too, along with the account balance, atomically
+// using UnitOfWork. Earlier occurrences keep their amount.
+type ChangeRecurringAmountUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewChangeRecurringAmountUseCase creates a new ChangeRecurringAmountUseCase instance.
+func NewChangeRecurringAmountUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *ChangeRecurringAmountUseCase {
+	return &ChangeRecurringAmountUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute changes the amount of the series from the given occurrence date on.
+func (uc *ChangeRecurringAmountUseCase) Execute(input dtos.ChangeRecurringAmountInput) (*dtos.ChangeRecurringAmountOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	fromDate, err := parseOccurrenceDate("from date", input.FromDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get repositories from UnitOfWork
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	series, err := findUserRecurringSeries(transactionRepository, userID, input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert float to cents, in the currency of the series
+	amount, err := sharedvalueobjects.NewMoney(int64(math.Round(input.Amount*100)), series.Amount().Currency())
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	if err := series.ChangeRecurrenceAmount(fromDate, amount); err != nil {
+		return nil, err
+	}
+
+	occurrences, err := transactionRepository.FindByParentID(series.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find occurrences: %w", err)
+	}
+
+	// The series itself is the first occurrence
+	affected := []*entities.Transaction{series}
+	affected = append(affected, occurrences...)
+
+	updatedCount := 0
+	from := fromDate.Format("2006-01-02")
+	for _, occurrence := range affected {
+		if occurrence.Date().Format("2006-01-02") < from || occurrence.Amount().Equals(amount) {
+			continue
+		}
+		if occurrence.IsReconciled() {
+			return nil, fmt.Errorf("reconciled transaction cannot be changed: the occurrence on %s was reconciled", occurrence.Date().Format("2006-01-02"))
+		}
+
+		oldAmount := occurrence.Amount()
+		if err := occurrence.UpdateAmount(amount); err != nil {
+			return nil, fmt.Errorf("failed to update occurrence amount: %w", err)
+		}
+		if _, err := updateAccountBalance(
+			accountRepository,
+			occurrence.AccountID(),
+			occurrence.TransactionType(), oldAmount,
+			occurrence.TransactionType(), amount,
+		); err != nil {
+			return nil, err
+		}
+		if occurrence != series {
+			if err := transactionRepository.Save(occurrence); err != nil {
+				return nil, fmt.Errorf("failed to save occurrence: %w", err)
+			}
+		}
+		updatedCount++
+	}
+
+	if err := transactionRepository.Save(series); err != nil {
+		return nil, fmt.Errorf("failed to save recurring series: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, transaction := range affected {
+		for _, event := range transaction.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		transaction.ClearEvents()
+	}
+
+	return &dtos.ChangeRecurringAmountOutput{
+		Series:       recurringSeriesOutput(series, time.Now()),
+		UpdatedCount: updatedCount,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	shareddomainevents "gestao-financeira/backend/internal/shared/domain/events"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+)
+
+// CompleteReconciliationUseCase completes a reconciliation whose cleared balance matches the
+// statement balance. The cleared transactions up to the statement date are marked as reconciled,
+// which locks them against changes, and the session is closed in one UnitOfWork.
+type CompleteReconciliationUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewCompleteReconciliationUseCase creates a new CompleteReconciliationUseCase instance.
+func NewCompleteReconciliationUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *CompleteReconciliationUseCase {
+	return &CompleteReconciliationUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute completes the reconciliation. It fails while there is a difference between the
+// statement balance and the cleared balance.
+func (uc *CompleteReconciliationUseCase) Execute(input dtos.CompleteReconciliationInput) (*dtos.ReconciliationOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	reconciliationRepository := uc.unitOfWork.ReconciliationRepository()
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+
+	reconciliation, err := findUserReconciliation(reconciliationRepository, userID, input.ReconciliationID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := findUserAccount(uc.unitOfWork.AccountRepository(), userID, reconciliation.AccountID(), "reconciled")
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := summarizeReconciliation(transactionRepository, account, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fails unless the session is in progress and the difference is zero
+	if err := reconciliation.Complete(summary.clearedBalance, len(summary.cleared)); err != nil {
+		return nil, err
+	}
+
+	domainEvents := reconciliation.GetEvents()
+	for _, transaction := range summary.cleared {
+		if err := transaction.Reconcile(reconciliation.ID()); err != nil {
+			return nil, fmt.Errorf("failed to reconcile transaction %s: %w", transaction.ID().Value(), err)
+		}
+		if err := transactionRepository.Save(transaction); err != nil {
+			return nil, fmt.Errorf("failed to save transaction: %w", err)
+		}
+		domainEvents = append(domainEvents, transaction.GetEvents()...)
+		transaction.ClearEvents()
+	}
+
+	if err := reconciliationRepository.Save(reconciliation); err != nil {
+		return nil, fmt.Errorf("failed to save reconciliation: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	publishReconciliationEvents(uc.eventBus, domainEvents)
+	reconciliation.ClearEvents()
+
+	output := reconciliationOutput(reconciliation, nil)
+	return &output, nil
+}
+
+// publishReconciliationEvents publishes the given domain events, ignoring publish errors.
+func publishReconciliationEvents(eventBus *eventbus.EventBus, domainEvents []shareddomainevents.DomainEvent) {
+	for _, event := range domainEvents {
+		if err := eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/services"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// CreateInstallmentPurchaseUseCase handles creating a purchase paid in monthly installments
+// ("parcelamento") on a credit card. All installments are created and debited from the card
+// at once, atomically using UnitOfWork, since the whole purchase takes up the card limit.
+type CreateInstallmentPurchaseUseCase struct {
+	unitOfWork         sharedrepositories.UnitOfWork
+	categoryRepository categoryrepositories.CategoryRepository
+	tagRepository      tagrepositories.TagRepository
+	eventBus           *eventbus.EventBus
+}
+
+// NewCreateInstallmentPurchaseUseCase creates a new CreateInstallmentPurchaseUseCase instance.
+func NewCreateInstallmentPurchaseUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	categoryRepository categoryrepositories.CategoryRepository,
+	tagRepository tagrepositories.TagRepository,
+	eventBus *eventbus.EventBus,
+) *CreateInstallmentPurchaseUseCase {
+	return &CreateInstallmentPurchaseUseCase{
+		unitOfWork:         unitOfWork,
+		categoryRepository: categoryRepository,
+		tagRepository:      tagRepository,
+		eventBus:           eventBus,
+	}
+}
+
+// Execute creates the installments of the purchase.
+func (uc *CreateInstallmentPurchaseUseCase) Execute(input dtos.CreateInstallmentPurchaseInput) (*dtos.InstallmentPurchaseOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	currency, err := sharedvalueobjects.NewCurrency(input.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid currency: %w", err)
+	}
+
+	// Convert float to cents
+	price, err := sharedvalueobjects.NewMoney(int64(math.Round(input.Amount*100)), currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	description, err := transactionvalueobjects.NewTransactionDescription(input.Description)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction description: %w", err)
+	}
+
+	firstDate, err := time.Parse("2006-01-02", input.FirstDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid first installment date format: expected YYYY-MM-DD, got %s", input.FirstDate)
+	}
+
+	amounts, err := services.CalculateInstallmentAmounts(price, input.Installments, input.InterestRate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid installments: %w", err)
+	}
+
+	categoryID, err := findUserCategoryID(uc.categoryRepository, userID, input.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs, err := findUserTagIDs(uc.tagRepository, userID, input.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	installments, err := entities.NewInstallmentPurchase(userID, accountID, amounts, description, firstDate, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create installment purchase: %w", err)
+	}
+	if len(tagIDs) > 0 {
+		for _, installment := range installments {
+			if err := installment.UpdateTags(tagIDs); err != nil {
+				return nil, fmt.Errorf("invalid tags: %w", err)
+			}
+		}
+	}
+
+	total := sharedvalueobjects.Zero(currency)
+	for _, amount := range amounts {
+		total, _ = total.Add(amount)
+	}
+
+	// Get repositories from UnitOfWork
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				// Log rollback error but don't fail the function
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	account, err := findUserAccount(accountRepository, userID, accountID, "credit card")
+	if err != nil {
+		return nil, err
+	}
+	if !account.AccountType().IsCreditCard() {
+		return nil, errors.New("invalid account: installment purchases must be made on a credit card account")
+	}
+
+	for _, installment := range installments {
+		if err := transactionRepository.Save(installment); err != nil {
+			return nil, fmt.Errorf("failed to save installment: %w", err)
+		}
+	}
+
+	if err := account.Debit(total); err != nil {
+		return nil, fmt.Errorf("failed to debit account: %w", err)
+	}
+	if err := accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, installment := range installments {
+		for _, event := range installment.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		installment.ClearEvents()
+	}
+
+	output := installmentPurchaseOutput(installments, time.Now())
+	priceValue := price.Float64()
+	interest := float64(total.Amount()-price.Amount()) / 100
+	output.Price = &priceValue
+	output.InterestAmount = &interest
+
+	return &output, nil
+}
+
+// installmentPurchaseOutput converts the installments of a purchase, ordered by number, to the
+// purchase output DTO. Installments dated after today count as remaining.
+func installmentPurchaseOutput(installments []*entities.Transaction, now time.Time) dtos.InstallmentPurchaseOutput {
+	first := installments[0]
+	today := now.Format("2006-01-02")
+
+	output := dtos.InstallmentPurchaseOutput{
+		PurchaseID:       first.InstallmentPurchaseID().Value(),
+		AccountID:        first.AccountID().Value(),
+		Description:      first.Description().Value(),
+		Currency:         first.Amount().Currency().Code(),
+		InstallmentCount: first.Installment().Count(),
+		Installments:     make([]dtos.InstallmentOutput, 0, len(installments)),
+	}
+
+	var totalCents, remainingCents int64
+	for _, installment := range installments {
+		totalCents += installment.Amount().Amount()
+		if installment.Date().Format("2006-01-02") > today {
+			output.RemainingCount++
+			remainingCents += installment.Amount().Amount()
+		}
+
+		output.Installments = append(output.Installments, dtos.InstallmentOutput{
+			TransactionID: installment.ID().Value(),
+			Number:        installment.Installment().Number(),
+			Label:         installment.Installment().String(),
+			Amount:        installment.Amount().Float64(),
+			Date:          installment.Date().Format("2006-01-02"),
+			Status:        installment.Status().Value(),
+		})
+	}
+	output.TotalAmount = float64(totalCents) / 100
+	output.RemainingAmount = float64(remainingCents) / 100
+
+	return output
+}
+
+// transactionInstallmentOutput returns the installment metadata of a transaction (nil unless it is an installment).
+func transactionInstallmentOutput(transaction *entities.Transaction) *dtos.TransactionInstallmentOutput {
+	if !transaction.IsInstallment() {
+		return nil
+	}
+
+	installment := transaction.Installment()
+	return &dtos.TransactionInstallmentOutput{
+		PurchaseID: transaction.InstallmentPurchaseID().Value(),
+		Number:     installment.Number(),
+		Count:      installment.Count(),
+		Label:      installment.String(),
+	}
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"fmt"
+
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// CreateTransactionRuleUseCase handles the creation of auto-categorization rules.
+type CreateTransactionRuleUseCase struct {
+	ruleRepository repositories.TransactionRuleRepository
+	dependencies   ruleDependencies
+	eventBus       *eventbus.EventBus
+}
+
+// NewCreateTransactionRuleUseCase creates a new CreateTransactionRuleUseCase instance.
+func NewCreateTransactionRuleUseCase(
+	ruleRepository repositories.TransactionRuleRepository,
+	accountRepository accountrepositories.AccountRepository,
+	categoryRepository categoryrepositories.CategoryRepository,
+	tagRepository tagrepositories.TagRepository,
+	eventBus *eventbus.EventBus,
+) *CreateTransactionRuleUseCase {
+	return &CreateTransactionRuleUseCase{
+		ruleRepository: ruleRepository,
+		dependencies: ruleDependencies{
+			accountRepository:  accountRepository,
+			categoryRepository: categoryRepository,
+			tagRepository:      tagRepository,
+		},
+		eventBus: eventBus,
+	}
+}
+
+// Execute creates an enabled rule. The account, category and tags referenced by the rule
+// must belong to the user.
+func (uc *CreateTransactionRuleUseCase) Execute(input dtos.CreateTransactionRuleInput) (*dtos.TransactionRuleOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	conditions, err := uc.dependencies.buildRuleConditions(userID, input.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := uc.dependencies.buildRuleActions(userID, input.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create rule entity
+	rule, err := entities.NewTransactionRule(userID, input.Name, input.Priority, conditions, actions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule: %w", err)
+	}
+
+	// Save rule to repository
+	if err := uc.ruleRepository.Save(rule); err != nil {
+		return nil, fmt.Errorf("failed to save rule: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range rule.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	rule.ClearEvents()
+
+	output := transactionRuleOutput(rule)
+	return &output, nil
+}
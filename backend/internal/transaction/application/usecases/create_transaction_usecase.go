@@ -1,34 +1,58 @@
 package usecases
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
 	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/domain/services"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
 )
 
 // CreateTransactionUseCase handles transaction creation.
 // It uses UnitOfWork to ensure atomicity when creating a transaction and updating account balance.
 type CreateTransactionUseCase struct {
-	unitOfWork sharedrepositories.UnitOfWork
-	eventBus   *eventbus.EventBus
+	unitOfWork         sharedrepositories.UnitOfWork
+	categoryRepository categoryrepositories.CategoryRepository
+	tagRepository      tagrepositories.TagRepository
+	ruleRepository     repositories.TransactionRuleRepository
+	payeeRepository    payeerepositories.PayeeRepository
+	eventBus           *eventbus.EventBus
 }
 
 // NewCreateTransactionUseCase creates a new CreateTransactionUseCase instance.
+// ruleRepository may be nil, in which case no auto-categorization rules run, and
+// payeeRepository may be nil, in which case no payee is matched from the description.
 func NewCreateTransactionUseCase(
 	unitOfWork sharedrepositories.UnitOfWork,
+	categoryRepository categoryrepositories.CategoryRepository,
+	tagRepository tagrepositories.TagRepository,
+	ruleRepository repositories.TransactionRuleRepository,
+	payeeRepository payeerepositories.PayeeRepository,
 	eventBus *eventbus.EventBus,
 ) *CreateTransactionUseCase {
 	return &CreateTransactionUseCase{
-		unitOfWork: unitOfWork,
-		eventBus:   eventBus,
+		unitOfWork:         unitOfWork,
+		categoryRepository: categoryRepository,
+		tagRepository:      tagRepository,
+		ruleRepository:     ruleRepository,
+		payeeRepository:    payeeRepository,
+		eventBus:           eventBus,
 	}
 }
 
@@ -79,15 +103,94 @@ func (uc *CreateTransactionUseCase) Execute(input dtos.CreateTransactionInput) (
 		return nil, fmt.Errorf("invalid date format: expected YYYY-MM-DD, got %s", input.Date)
 	}
 
+	// Validate category ownership (if provided)
+	categoryID, err := findUserCategoryID(uc.categoryRepository, userID, input.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build split lines (if provided)
+	if len(input.Splits) > 0 && categoryID != nil {
+		return nil, errors.New("category ID must be empty when split lines are provided")
+	}
+	splits, err := buildTransactionSplits(uc.categoryRepository, userID, input.Splits, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate tag ownership (if provided)
+	tagIDs, err := findUserTagIDs(uc.tagRepository, userID, input.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate payee ownership (if provided)
+	payee, err := findUserPayee(uc.payeeRepository, userID, input.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create transaction entity
-	transaction, err := entities.NewTransaction(userID, accountID, transactionType, amount, description, date)
+	transaction, err := entities.NewTransactionWithParams(entities.TransactionParams{
+		UserID:      userID,
+		AccountID:   accountID,
+		Type:        transactionType,
+		Amount:      amount,
+		Description: description,
+		Date:        date,
+		CategoryID:  categoryID,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
+	if len(splits) > 0 {
+		if err := transaction.UpdateSplits(splits); err != nil {
+			return nil, fmt.Errorf("invalid split lines: %w", err)
+		}
+	}
+	if len(tagIDs) > 0 {
+		if err := transaction.UpdateTags(tagIDs); err != nil {
+			return nil, fmt.Errorf("invalid tags: %w", err)
+		}
+	}
+
+	// Run the enabled auto-categorization rules; a category given explicitly is kept
+	var appliedRuleIDs []string
+	if uc.ruleRepository != nil {
+		rules, err := findEnabledRules(uc.ruleRepository, userID)
+		if err != nil {
+			return nil, err
+		}
+		change, err := services.NewRuleEngine(rules).Apply(transaction, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply rules: %w", err)
+		}
+		if change.HasChanges() {
+			appliedRuleIDs = ruleIDValues(change.RuleIDs)
+		}
+	}
+
+	// Match the payee from the description (after the rules ran) unless one was given; the default
+	// category of the payee only applies when neither the input nor the rules categorized the transaction
+	if payee == nil {
+		matcher, err := findPayeeMatcher(uc.payeeRepository, userID)
+		if err != nil {
+			return nil, err
+		}
+		if matcher != nil {
+			payee = matcher.Match(transaction.Description().Value())
+		}
+	}
+	if payee != nil {
+		if err := assignPayee(transaction, payee); err != nil {
+			return nil, err
+		}
+	}
 
 	// Get repositories from UnitOfWork
 	transactionRepository := uc.unitOfWork.TransactionRepository()
 	accountRepository := uc.unitOfWork.AccountRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
 
 	// Begin transaction to ensure atomicity
 	if err := uc.unitOfWork.Begin(); err != nil {
@@ -104,12 +207,7 @@ func (uc *CreateTransactionUseCase) Execute(input dtos.CreateTransactionInput) (
 		}
 	}()
 
-	// Save transaction to repository (within transaction)
-	if err := transactionRepository.Save(transaction); err != nil {
-		return nil, fmt.Errorf("failed to save transaction: %w", err)
-	}
-
-	// Find account and update balance (within transaction)
+	// Find account (within transaction)
 	account, err := accountRepository.FindByID(accountID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find account: %w", err)
@@ -118,13 +216,63 @@ func (uc *CreateTransactionUseCase) Execute(input dtos.CreateTransactionInput) (
 		return nil, fmt.Errorf("account not found: %s", accountID.Value())
 	}
 
+	// Convert a foreign currency amount into the account currency; the balance always moves by the converted amount
+	conversion, err := buildCurrencyConversion(amount, account.Balance().Currency(), input.ExchangeRate, input.ConvertedAmount)
+	if err != nil {
+		return nil, err
+	}
+	if conversion != nil {
+		if err := transaction.ConvertCurrency(*conversion); err != nil {
+			return nil, fmt.Errorf("invalid currency conversion: %w", err)
+		}
+	}
+
+	// Add the IOF of a foreign purchase as a separate expense linked to it
+	var iofTransaction *entities.Transaction
+	if input.IOFRate != nil {
+		iofTransaction, err = newIOFTransaction(transaction, *input.IOFRate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Flag transactions that look like the same purchase; the lookup is advisory and never blocks creation
+	var possibleDuplicateIDs []string
+	fingerprints := []services.TransactionFingerprint{services.FingerprintOf(transaction)}
+	if matches, err := findPossibleDuplicates(transactionRepository, userID, fingerprints); err == nil {
+		for _, match := range matches[0] {
+			possibleDuplicateIDs = append(possibleDuplicateIDs, match.TransactionID)
+		}
+	}
+
+	// Save transaction to repository (within transaction)
+	if err := transactionRepository.Save(transaction); err != nil {
+		return nil, fmt.Errorf("failed to save transaction: %w", err)
+	}
+	if err := recordTransactionRevision(revisionRepository, transaction, entities.RevisionActionCreate, nil, entities.SnapshotOf(transaction), &userID, input.RequestID); err != nil {
+		return nil, err
+	}
+
 	// Update account balance based on transaction type
 	if transactionType.Value() == "INCOME" {
-		if err := account.Credit(amount); err != nil {
+		if err := account.Credit(transaction.Amount()); err != nil {
 			return nil, fmt.Errorf("failed to credit account: %w", err)
 		}
 	} else if transactionType.Value() == "EXPENSE" {
-		if err := account.Debit(amount); err != nil {
+		if err := account.Debit(transaction.Amount()); err != nil {
+			return nil, fmt.Errorf("failed to debit account: %w", err)
+		}
+	}
+
+	// Save the IOF expense and debit it (within transaction)
+	if iofTransaction != nil {
+		if err := transactionRepository.Save(iofTransaction); err != nil {
+			return nil, fmt.Errorf("failed to save IOF transaction: %w", err)
+		}
+		if err := recordTransactionRevision(revisionRepository, iofTransaction, entities.RevisionActionCreate, nil, entities.SnapshotOf(iofTransaction), &userID, input.RequestID); err != nil {
+			return nil, err
+		}
+		if err := account.Debit(iofTransaction.Amount()); err != nil {
 			return nil, fmt.Errorf("failed to debit account: %w", err)
 		}
 	}
@@ -141,16 +289,22 @@ func (uc *CreateTransactionUseCase) Execute(input dtos.CreateTransactionInput) (
 
 	// Publish domain events (after successful commit)
 	// Events are published outside the transaction to avoid blocking the commit
-	domainEvents := transaction.GetEvents()
-	for _, event := range domainEvents {
-		if err := uc.eventBus.Publish(event); err != nil {
-			// Log error but don't fail the transaction creation
-			// In production, you might want to handle this differently
-			// (e.g., store events in an outbox pattern)
-			_ = err // Ignore for now, but should be logged
+	created := []*entities.Transaction{transaction}
+	if iofTransaction != nil {
+		created = append(created, iofTransaction)
+	}
+	for _, tx := range created {
+		for _, event := range tx.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				// Log error but don't fail the transaction creation
+				// In production, you might want to handle this differently
+				// (e.g., store events in an outbox pattern)
+				_ = err // Ignore for now, but should be logged
+			}
 		}
+		tx.ClearEvents()
 	}
-	transaction.ClearEvents()
+	publishAccountEvents(uc.eventBus, account)
 
 	// Build output
 	transactionAmount := transaction.Amount()
@@ -163,8 +317,264 @@ func (uc *CreateTransactionUseCase) Execute(input dtos.CreateTransactionInput) (
 		Currency:      transactionAmount.Currency().Code(),
 		Description:   transaction.Description().Value(),
 		Date:          transaction.Date().Format("2006-01-02"),
+		CategoryID:    categoryIDValue(transaction),
+		Splits:        splitOutputs(transaction),
+		TagIDs:        tagIDValues(transaction),
+		PayeeID:       payeeIDValue(transaction),
 		CreatedAt:     transaction.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+
+		CurrencyConversion:   currencyConversionOutput(transaction),
+		PossibleDuplicateIDs: possibleDuplicateIDs,
+		AppliedRuleIDs:       appliedRuleIDs,
+	}
+	if iofTransaction != nil {
+		iofAmount := iofTransaction.Amount()
+		output.IOFTransaction = &dtos.FeeTransactionOutput{
+			TransactionID: iofTransaction.ID().Value(),
+			Amount:        iofAmount.Float64(),
+			Currency:      iofAmount.Currency().Code(),
+			Description:   iofTransaction.Description().Value(),
+		}
 	}
 
 	return output, nil
 }
+
+// recordTransactionRevision saves an immutable record of a change to a transaction in its
+// edit history (within the caller's transaction). actorID is nil for changes made by the system.
+func recordTransactionRevision(
+	revisionRepository repositories.TransactionRevisionRepository,
+	transaction *entities.Transaction,
+	action string,
+	before *entities.TransactionSnapshot,
+	after *entities.TransactionSnapshot,
+	actorID *identityvalueobjects.UserID,
+	requestID string,
+) error {
+	revision, err := entities.NewTransactionRevision(transaction.ID(), transaction.UserID(), action, before, after, actorID, requestID)
+	if err != nil {
+		return fmt.Errorf("failed to record transaction revision: %w", err)
+	}
+	if err := revisionRepository.Save(revision); err != nil {
+		return fmt.Errorf("failed to record transaction revision: %w", err)
+	}
+	return nil
+}
+
+// optionalActorID parses the ID of the user making a change.
+// Returns nil if no user was given, i.e. the change is made by the system.
+func optionalActorID(rawActorID string) (*identityvalueobjects.UserID, error) {
+	if rawActorID == "" {
+		return nil, nil
+	}
+	actorID, err := identityvalueobjects.NewUserID(rawActorID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid actor ID: %w", err)
+	}
+	return &actorID, nil
+}
+
+// findUserCategoryID validates that the given category exists and belongs to the user.
+// Returns nil if no category was provided.
+func findUserCategoryID(
+	categoryRepository categoryrepositories.CategoryRepository,
+	userID identityvalueobjects.UserID,
+	rawCategoryID string,
+) (*categoryvalueobjects.CategoryID, error) {
+	if rawCategoryID == "" {
+		return nil, nil
+	}
+
+	categoryID, err := categoryvalueobjects.NewCategoryID(rawCategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid category ID: %w", err)
+	}
+
+	if categoryRepository == nil {
+		return nil, errors.New("unable to validate category: category repository not configured")
+	}
+
+	category, err := categoryRepository.FindByID(categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category: %w", err)
+	}
+	if category == nil {
+		return nil, errors.New("category not found")
+	}
+	if !category.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("category does not belong to user")
+	}
+
+	return &categoryID, nil
+}
+
+// findUserTagIDs validates that the given tags exist and belong to the user.
+// Returns nil if no tags were provided.
+func findUserTagIDs(
+	tagRepository tagrepositories.TagRepository,
+	userID identityvalueobjects.UserID,
+	rawTagIDs []string,
+) ([]tagvalueobjects.TagID, error) {
+	if len(rawTagIDs) == 0 {
+		return nil, nil
+	}
+
+	if tagRepository == nil {
+		return nil, errors.New("unable to validate tags: tag repository not configured")
+	}
+
+	tagIDs := make([]tagvalueobjects.TagID, 0, len(rawTagIDs))
+	for _, rawTagID := range rawTagIDs {
+		tagID, err := tagvalueobjects.NewTagID(rawTagID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag ID: %w", err)
+		}
+
+		tag, err := tagRepository.FindByID(tagID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find tag: %w", err)
+		}
+		if tag == nil {
+			return nil, fmt.Errorf("tag not found: %s", rawTagID)
+		}
+		if !tag.UserID().Equals(userID) {
+			return nil, apperrors.NewForbiddenError("tag does not belong to user")
+		}
+
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	return tagIDs, nil
+}
+
+// buildTransactionSplits validates the split line inputs and builds the split lines of a transaction.
+// Each split category must belong to the user. Lines without an amount share whatever is left
+// of the total equally; leftover cents go to the first of those lines.
+func buildTransactionSplits(
+	categoryRepository categoryrepositories.CategoryRepository,
+	userID identityvalueobjects.UserID,
+	inputs []dtos.TransactionSplitInput,
+	total sharedvalueobjects.Money,
+) ([]transactionvalueobjects.TransactionSplit, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	amounts := make([]sharedvalueobjects.Money, len(inputs))
+	remaining := total
+	var open []int
+	for i, input := range inputs {
+		if input.Amount == nil {
+			open = append(open, i)
+			continue
+		}
+
+		// Convert float to cents
+		amount, err := sharedvalueobjects.NewMoney(int64(math.Round(*input.Amount*100)), total.Currency())
+		if err != nil {
+			return nil, fmt.Errorf("invalid split amount: %w", err)
+		}
+		amounts[i] = amount
+		remaining, _ = remaining.Subtract(amount)
+	}
+
+	if len(open) > 0 {
+		if !remaining.IsPositive() {
+			return nil, errors.New("invalid split lines: no amount left for the split lines without an amount")
+		}
+
+		ratios := make([]int64, len(open))
+		for i := range ratios {
+			ratios[i] = 1
+		}
+		parts, err := remaining.Allocate(ratios)
+		if err != nil {
+			return nil, fmt.Errorf("invalid split lines: %w", err)
+		}
+		for i, index := range open {
+			amounts[index] = parts[i]
+		}
+	}
+
+	splits := make([]transactionvalueobjects.TransactionSplit, len(inputs))
+	for i, input := range inputs {
+		categoryID, err := findUserCategoryID(categoryRepository, userID, input.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		if categoryID == nil {
+			return nil, errors.New("split category ID cannot be empty")
+		}
+
+		splits[i], err = transactionvalueobjects.NewTransactionSplit(*categoryID, amounts[i], input.Memo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid split line: %w", err)
+		}
+	}
+
+	return splits, nil
+}
+
+// splitOutputs converts the split lines of a transaction to output DTOs (nil if not split).
+func splitOutputs(transaction *entities.Transaction) []dtos.TransactionSplitOutput {
+	if !transaction.HasSplits() {
+		return nil
+	}
+
+	outputs := make([]dtos.TransactionSplitOutput, 0, len(transaction.Splits()))
+	for _, split := range transaction.Splits() {
+		outputs = append(outputs, dtos.TransactionSplitOutput{
+			CategoryID: split.CategoryID().Value(),
+			Amount:     split.Amount().Float64(),
+			Currency:   split.Amount().Currency().Code(),
+			Memo:       split.Memo(),
+		})
+	}
+	return outputs
+}
+
+// categoryIDValue returns the transaction category ID as a string (empty if uncategorized).
+func categoryIDValue(transaction *entities.Transaction) string {
+	if transaction.CategoryID() == nil {
+		return ""
+	}
+	return transaction.CategoryID().Value()
+}
+
+// linkedTransactionIDValue returns the counterpart leg ID of a transfer as a string (empty if not a transfer).
+func linkedTransactionIDValue(transaction *entities.Transaction) string {
+	if transaction.LinkedTransactionID() == nil {
+		return ""
+	}
+	return transaction.LinkedTransactionID().Value()
+}
+
+// importBatchIDValue returns the import batch ID of a transaction as a string (empty if entered manually).
+func importBatchIDValue(transaction *entities.Transaction) string {
+	if transaction.ImportBatchID() == nil {
+		return ""
+	}
+	return transaction.ImportBatchID().Value()
+}
+
+// reconciliationIDValue returns the reconciliation that locked a transaction as a string (empty unless reconciled).
+func reconciliationIDValue(transaction *entities.Transaction) string {
+	if transaction.ReconciliationID() == nil {
+		return ""
+	}
+	return transaction.ReconciliationID().Value()
+}
+
+// tagIDValues returns the tag IDs of a transaction as strings (nil if untagged).
+func tagIDValues(transaction *entities.Transaction) []string {
+	tagIDs := transaction.TagIDs()
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		values = append(values, tagID.Value())
+	}
+	return values
+}
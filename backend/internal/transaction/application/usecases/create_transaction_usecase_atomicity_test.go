@@ -68,6 +68,10 @@ func (m *mockAccountRepositoryWithError) FindByUserIDAndContext(userID identityv
 	return nil, m.err
 }
 
+func (m *mockAccountRepositoryWithError) FindByType(accountType accountvalueobjects.AccountType) ([]*accountentities.Account, error) {
+	return []*accountentities.Account{}, nil
+}
+
 func (m *mockAccountRepositoryWithError) Save(account *accountentities.Account) error {
 	return m.err
 }
@@ -200,7 +204,7 @@ func TestCreateTransactionUseCase_Atomicity(t *testing.T) {
 			mockUOW := newMockUnitOfWorkWithErrors(mockTransactionRepo, mockAccountRepo)
 			tt.setupMock(mockTransactionRepo, mockAccountRepo, mockUOW)
 
-			useCase := NewCreateTransactionUseCase(mockUOW, eventBus)
+			useCase := NewCreateTransactionUseCase(mockUOW, nil, nil, nil, nil, eventBus)
 			output, err := useCase.Execute(tt.input)
 
 			if tt.wantError {
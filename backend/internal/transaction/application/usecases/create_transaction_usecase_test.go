@@ -2,27 +2,35 @@ package usecases
 
 import (
 	"errors"
+	"sort"
 	"testing"
 	"time"
 
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountevents "gestao-financeira/backend/internal/account/domain/events"
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
 )
 
 // mockTransactionRepository is a mock implementation of TransactionRepository for testing.
 type mockTransactionRepository struct {
 	transactions map[string]*entities.Transaction
+	deleted      map[string]*entities.Transaction // Soft-deleted transactions
 	saveErr      error
 }
 
 func newMockTransactionRepository() *mockTransactionRepository {
 	return &mockTransactionRepository{
 		transactions: make(map[string]*entities.Transaction),
+		deleted:      make(map[string]*entities.Transaction),
 	}
 }
 
@@ -83,10 +91,23 @@ func (m *mockTransactionRepository) Save(transaction *entities.Transaction) erro
 }
 
 func (m *mockTransactionRepository) Delete(id transactionvalueobjects.TransactionID) error {
+	if transaction, exists := m.transactions[id.Value()]; exists {
+		m.deleted[id.Value()] = transaction
+	}
 	delete(m.transactions, id.Value())
 	return nil
 }
 
+func (m *mockTransactionRepository) Restore(id transactionvalueobjects.TransactionID) error {
+	transaction, exists := m.deleted[id.Value()]
+	if !exists {
+		return errors.New("transaction not found")
+	}
+	m.transactions[id.Value()] = transaction
+	delete(m.deleted, id.Value())
+	return nil
+}
+
 func (m *mockTransactionRepository) Exists(id transactionvalueobjects.TransactionID) (bool, error) {
 	_, exists := m.transactions[id.Value()]
 	return exists, nil
@@ -122,36 +143,71 @@ func (m *mockTransactionRepository) FindActiveRecurringTransactions() ([]*entiti
 }
 
 func (m *mockTransactionRepository) FindByParentIDAndDate(parentID transactionvalueobjects.TransactionID, date time.Time) (*entities.Transaction, error) {
-	// Simple mock: return nil (not found)
+	day := date.Format("2006-01-02")
+	for _, tx := range m.transactions {
+		if tx.ParentTransactionID() != nil && tx.ParentTransactionID().Equals(parentID) && tx.Date().Format("2006-01-02") == day {
+			return tx, nil
+		}
+	}
 	return nil, nil
 }
 
 func (m *mockTransactionRepository) FindByUserIDAndFiltersWithPagination(
 	userID identityvalueobjects.UserID,
-	accountID string,
-	transactionType string,
+	filter repositories.TransactionFilter,
 	offset, limit int,
 ) ([]*entities.Transaction, int64, error) {
-	var result []*entities.Transaction
-	var total int64
-
+	var filtered []*entities.Transaction
 	for _, transaction := range m.transactions {
-		if transaction.UserID().Value() != userID.Value() {
+		if transaction.UserID().Value() != userID.Value() || !filter.Matches(transaction) {
 			continue
 		}
-		if accountID != "" && transaction.AccountID().Value() != accountID {
-			continue
-		}
-		if transactionType != "" && transaction.TransactionType().Value() != transactionType {
+		filtered = append(filtered, transaction)
+	}
+	filter.Sort(filtered)
+
+	total := int64(len(filtered))
+	if offset > len(filtered) {
+		return []*entities.Transaction{}, total, nil
+	}
+	end := offset + limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	return filtered[offset:end], total, nil
+}
+func (m *mockTransactionRepository) FindByUserIDAndFiltersWithKeyset(
+	userID identityvalueobjects.UserID,
+	filter repositories.TransactionFilter,
+	keyset *repositories.TransactionKeyset,
+	backward bool,
+	limit int,
+) ([]*entities.Transaction, error) {
+	var filtered []*entities.Transaction
+	for _, transaction := range m.transactions {
+		if transaction.UserID().Value() != userID.Value() || !filter.Matches(transaction) {
 			continue
 		}
-		total++
-		if len(result) < limit && len(result) >= offset {
-			result = append(result, transaction)
+		if keyset != nil {
+			cmp := filter.CompareKeysets(filter.KeysetOf(transaction), *keyset)
+			if (!backward && cmp <= 0) || (backward && cmp >= 0) {
+				continue
+			}
 		}
+		filtered = append(filtered, transaction)
 	}
 
-	return result, total, nil
+	sort.Slice(filtered, func(i, j int) bool {
+		cmp := filter.CompareKeysets(filter.KeysetOf(filtered[i]), filter.KeysetOf(filtered[j]))
+		if backward {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+	if len(filtered) > limit {
+		filtered = filtered[:limit]
+	}
+	return filtered, nil
 }
 func (m *mockTransactionRepository) FindByUserIDAndDateRange(userID identityvalueobjects.UserID, startDate, endDate time.Time) ([]*entities.Transaction, error) {
 	var result []*entities.Transaction
@@ -177,6 +233,58 @@ func (m *mockTransactionRepository) FindByUserIDAndDateRangeWithCurrency(userID
 	}
 	return result, nil
 }
+func (m *mockTransactionRepository) FindByImportBatchID(batchID transactionvalueobjects.ImportBatchID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ImportBatchID() != nil && tx.ImportBatchID().Equals(batchID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepository) FindExistingExternalIDs(accountID accountvalueobjects.AccountID, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for _, tx := range m.transactions {
+		if !tx.AccountID().Equals(accountID) || tx.ExternalID() == "" {
+			continue
+		}
+		for _, externalID := range externalIDs {
+			if tx.ExternalID() == externalID {
+				existing[externalID] = true
+			}
+		}
+	}
+	return existing, nil
+}
+func (m *mockTransactionRepository) FindUnreconciledByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.AccountID().Equals(accountID) && !tx.IsReconciled() {
+			result = append(result, tx)
+		}
+	}
+	sort.Slice(result, func(i, k int) bool { return result[i].Date().Before(result[k].Date()) })
+	return result, nil
+}
+func (m *mockTransactionRepository) FindByParentID(parentID transactionvalueobjects.TransactionID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ParentTransactionID() != nil && tx.ParentTransactionID().Equals(parentID) {
+			result = append(result, tx)
+		}
+	}
+	sort.Slice(result, func(i, k int) bool { return result[i].Date().Before(result[k].Date()) })
+	return result, nil
+}
+func (m *mockTransactionRepository) FindRecurringSeriesByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.UserID().Equals(userID) && tx.IsRecurringSeries() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
 func (m *mockTransactionRepository) FindByUserIDWithPagination(userID identityvalueobjects.UserID, offset, limit int) ([]*entities.Transaction, int64, error) {
 	all, _ := m.FindByUserID(userID)
 	total := int64(len(all))
@@ -362,7 +470,7 @@ func TestCreateTransactionUseCase_Execute(t *testing.T) {
 			}
 
 			mockUOW := newMockUnitOfWork(mockTransactionRepo, mockAccountRepo)
-			useCase := NewCreateTransactionUseCase(mockUOW, eventBus)
+			useCase := NewCreateTransactionUseCase(mockUOW, nil, nil, nil, nil, eventBus)
 			output, err := useCase.Execute(tt.input)
 
 			if (err != nil) != tt.wantError {
@@ -406,6 +514,89 @@ func TestCreateTransactionUseCase_Execute(t *testing.T) {
 	}
 }
 
+func TestCreateTransactionUseCase_Execute_WithCategory(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	otherUserID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	date := time.Now().Format("2006-01-02")
+
+	categoryRepo := newMockCategoryRepository()
+	ownCategory := createTestCategory(categoryRepo, userID, "Alimentação")
+	otherCategory := createTestCategory(categoryRepo, otherUserID, "Transporte")
+
+	tests := []struct {
+		name       string
+		categoryID string
+		wantError  bool
+		errorMsg   string
+	}{
+		{
+			name:       "category owned by user",
+			categoryID: ownCategory.ID().Value(),
+			wantError:  false,
+		},
+		{
+			name:       "category owned by another user",
+			categoryID: otherCategory.ID().Value(),
+			wantError:  true,
+			errorMsg:   "category does not belong to user",
+		},
+		{
+			name:       "category not found",
+			categoryID: "123e4567-e89b-12d3-a456-426614174999",
+			wantError:  true,
+			errorMsg:   "category not found",
+		},
+		{
+			name:       "invalid category ID",
+			categoryID: "invalid-uuid",
+			wantError:  true,
+			errorMsg:   "invalid category ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTransactionRepo := newMockTransactionRepository()
+			mockAccountRepo := newMockAccountRepository()
+			initialBalance, _ := sharedvalueobjects.NewMoney(100000, sharedvalueobjects.MustCurrency("BRL"))
+			account, _ := createTestAccountWithID(userID, accountID, initialBalance)
+			_ = mockAccountRepo.Save(account)
+
+			mockUOW := newMockUnitOfWork(mockTransactionRepo, mockAccountRepo)
+			useCase := NewCreateTransactionUseCase(mockUOW, categoryRepo, nil, nil, nil, eventbus.NewEventBus())
+			output, err := useCase.Execute(dtos.CreateTransactionInput{
+				UserID:      userID.Value(),
+				AccountID:   accountID.Value(),
+				Type:        "EXPENSE",
+				Amount:      42.90,
+				Currency:    "BRL",
+				Description: "Almoço",
+				Date:        date,
+				CategoryID:  tt.categoryID,
+			})
+
+			if (err != nil) != tt.wantError {
+				t.Fatalf("CreateTransactionUseCase.Execute() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wantError {
+				if !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("CreateTransactionUseCase.Execute() error = %v, want error containing %v", err, tt.errorMsg)
+				}
+				if len(mockTransactionRepo.transactions) != 0 {
+					t.Errorf("CreateTransactionUseCase.Execute() saved %d transactions, want 0", len(mockTransactionRepo.transactions))
+				}
+				return
+			}
+
+			if output.CategoryID != tt.categoryID {
+				t.Errorf("CreateTransactionUseCase.Execute() output.CategoryID = %v, want %v", output.CategoryID, tt.categoryID)
+			}
+		})
+	}
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
@@ -422,3 +613,153 @@ func containsSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestCreateTransactionUseCase_Execute_WithSplits(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	date := time.Now().Format("2006-01-02")
+
+	categoryRepo := newMockCategoryRepository()
+	food := createTestCategory(categoryRepo, userID, "Alimentação")
+	home := createTestCategory(categoryRepo, userID, "Casa")
+
+	mockTransactionRepo := newMockTransactionRepository()
+	mockAccountRepo := newMockAccountRepository()
+	initialBalance, _ := sharedvalueobjects.NewMoney(100000, sharedvalueobjects.MustCurrency("BRL"))
+	account, _ := createTestAccountWithID(userID, accountID, initialBalance)
+	_ = mockAccountRepo.Save(account)
+
+	useCase := NewCreateTransactionUseCase(newMockUnitOfWork(mockTransactionRepo, mockAccountRepo), categoryRepo, nil, nil, nil, eventbus.NewEventBus())
+	output, err := useCase.Execute(dtos.CreateTransactionInput{
+		UserID:      userID.Value(),
+		AccountID:   accountID.Value(),
+		Type:        "EXPENSE",
+		Amount:      150.00,
+		Currency:    "BRL",
+		Description: "Compra no mercado",
+		Date:        date,
+		Splits: []dtos.TransactionSplitInput{
+			{CategoryID: food.ID().Value(), Amount: floatPtr(120.00), Memo: "Comida"},
+			{CategoryID: home.ID().Value()},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateTransactionUseCase.Execute() error = %v, want nil", err)
+	}
+
+	if len(output.Splits) != 2 {
+		t.Fatalf("CreateTransactionUseCase.Execute() split lines = %d, want 2", len(output.Splits))
+	}
+	if output.Splits[0].Amount != 120.00 || output.Splits[0].Memo != "Comida" || output.Splits[1].Amount != 30.00 {
+		t.Errorf("CreateTransactionUseCase.Execute() split lines = %+v, want 120.00 (Comida) and 30.00", output.Splits)
+	}
+
+	// The account is debited by the full amount
+	account, _ = mockAccountRepo.FindByID(accountID)
+	if account.Balance().Amount() != 85000 {
+		t.Errorf("expected balance 85000, got %d", account.Balance().Amount())
+	}
+
+	// Split amounts are rounded to the cent, not truncated (0.29 * 100 is 28.999...)
+	output, err = useCase.Execute(dtos.CreateTransactionInput{
+		UserID:      userID.Value(),
+		AccountID:   accountID.Value(),
+		Type:        "EXPENSE",
+		Amount:      150.00,
+		Currency:    "BRL",
+		Description: "Compra no mercado",
+		Date:        date,
+		Splits: []dtos.TransactionSplitInput{
+			{CategoryID: food.ID().Value(), Amount: floatPtr(0.29)},
+			{CategoryID: home.ID().Value()},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateTransactionUseCase.Execute() error = %v, want nil", err)
+	}
+	if output.Splits[0].Amount != 0.29 || output.Splits[1].Amount != 149.71 {
+		t.Errorf("CreateTransactionUseCase.Execute() split lines = %+v, want 0.29 and 149.71", output.Splits)
+	}
+}
+
+func TestCreateTransactionUseCase_Execute_BalanceLimits(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	date := time.Now().Format("2006-01-02")
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	overdraftLimit, _ := sharedvalueobjects.NewMoney(50000, brl) // 500.00 BRL
+
+	// A bank account with 100.00 BRL and a 500.00 BRL overdraft limit
+	setup := func(mode accountvalueobjects.BalanceLimitMode) (*CreateTransactionUseCase, *accountentities.Account, *[]events.DomainEvent) {
+		mockAccountRepo := newMockAccountRepository()
+		initialBalance, _ := sharedvalueobjects.NewMoney(10000, brl)
+		account, _ := createTestAccountWithID(userID, accountvalueobjects.GenerateAccountID(), initialBalance)
+		if err := account.SetBalanceLimits(overdraftLimit, mode); err != nil {
+			t.Fatalf("failed to set balance limits: %v", err)
+		}
+		account.ClearEvents()
+		_ = mockAccountRepo.Save(account)
+
+		eventBus := eventbus.NewEventBus()
+		var published []events.DomainEvent
+		eventBus.Subscribe("AccountBalanceLimitExceeded", func(event events.DomainEvent) error {
+			published = append(published, event)
+			return nil
+		})
+		useCase := NewCreateTransactionUseCase(newMockUnitOfWork(newMockTransactionRepository(), mockAccountRepo), nil, nil, nil, nil, eventBus)
+		return useCase, account, &published
+	}
+	expense := func(account *accountentities.Account, amount float64) dtos.CreateTransactionInput {
+		return dtos.CreateTransactionInput{
+			UserID:      userID.Value(),
+			AccountID:   account.ID().Value(),
+			Type:        "EXPENSE",
+			Amount:      amount,
+			Currency:    "BRL",
+			Description: "Aluguel do mês",
+			Date:        date,
+		}
+	}
+
+	t.Run("expense within the overdraft limit", func(t *testing.T) {
+		useCase, account, published := setup(accountvalueobjects.EnforceBalanceLimitMode())
+		if _, err := useCase.Execute(expense(account, 600.00)); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if account.Balance().Amount() != -50000 || len(*published) != 0 {
+			t.Errorf("expected balance -50000 and no warning, got %d and %d events", account.Balance().Amount(), len(*published))
+		}
+	})
+
+	t.Run("expense beyond the overdraft limit is rejected", func(t *testing.T) {
+		useCase, account, _ := setup(accountvalueobjects.EnforceBalanceLimitMode())
+		_, err := useCase.Execute(expense(account, 600.01))
+
+		var limitErr *accountentities.BalanceLimitError
+		if !errors.As(err, &limitErr) || limitErr.LimitKind != accountentities.LimitKindOverdraft {
+			t.Fatalf("Execute() error = %v, want an overdraft limit error", err)
+		}
+		appErr := apperrors.MapDomainError(err)
+		if appErr.Type != apperrors.ErrorTypeDomain || appErr.Details["limit_kind"] != "OVERDRAFT" || appErr.Details["balance"] != -500.01 {
+			t.Errorf("MapDomainError() = %+v, want a domain error with the limit details", appErr)
+		}
+		if account.Balance().Amount() != 10000 {
+			t.Errorf("expected the balance to stay at 10000, got %d", account.Balance().Amount())
+		}
+	})
+
+	t.Run("warn only mode creates the expense and publishes a warning", func(t *testing.T) {
+		useCase, account, published := setup(accountvalueobjects.WarnBalanceLimitMode())
+		if _, err := useCase.Execute(expense(account, 700.00)); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if account.Balance().Amount() != -60000 {
+			t.Errorf("expected balance -60000, got %d", account.Balance().Amount())
+		}
+		if len(*published) != 1 {
+			t.Fatalf("expected 1 AccountBalanceLimitExceeded event, got %d", len(*published))
+		}
+		if event := (*published)[0].(*accountevents.AccountBalanceLimitExceeded); event.UserID() != userID.Value() || event.Floor().Amount() != -50000 {
+			t.Errorf("unexpected event %+v", event)
+		}
+	})
+}
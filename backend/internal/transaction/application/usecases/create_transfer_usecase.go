@@ -0,0 +1,238 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// CreateTransferUseCase handles transfers between two accounts of the same user.
+// It uses UnitOfWork to ensure both legs are saved and both balances are updated atomically.
+type CreateTransferUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewCreateTransferUseCase creates a new CreateTransferUseCase instance.
+func NewCreateTransferUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *CreateTransferUseCase {
+	return &CreateTransferUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute performs the transfer.
+// It debits the source account, credits the destination account, saves both linked legs
+// and publishes the TransferCreated event after a successful commit.
+func (uc *CreateTransferUseCase) Execute(input dtos.CreateTransferInput) (*dtos.CreateTransferOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Create account ID value objects
+	fromAccountID, err := accountvalueobjects.NewAccountID(input.FromAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source account ID: %w", err)
+	}
+	toAccountID, err := accountvalueobjects.NewAccountID(input.ToAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination account ID: %w", err)
+	}
+	if fromAccountID.Equals(toAccountID) {
+		return nil, errors.New("transfer source and destination accounts must be different")
+	}
+
+	// Create transaction description value object
+	description, err := transactionvalueobjects.NewTransactionDescription(input.Description)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction description: %w", err)
+	}
+
+	// Parse transfer date
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format: expected YYYY-MM-DD, got %s", input.Date)
+	}
+
+	// Get repositories from UnitOfWork
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				// Log rollback error but don't fail the function
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	// Find both accounts (within transaction)
+	fromAccount, err := findUserAccount(accountRepository, userID, fromAccountID, "source")
+	if err != nil {
+		return nil, err
+	}
+	toAccount, err := findUserAccount(accountRepository, userID, toAccountID, "destination")
+	if err != nil {
+		return nil, err
+	}
+
+	// Build amounts in each account currency
+	amount, destinationAmount, err := transferAmounts(fromAccount, toAccount, input.Amount, input.DestinationAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create both legs of the transfer
+	outgoing, incoming, err := entities.NewTransfer(userID, fromAccountID, toAccountID, amount, destinationAmount, description, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transfer: %w", err)
+	}
+
+	// Move money between accounts
+	if err := fromAccount.Debit(amount); err != nil {
+		return nil, fmt.Errorf("failed to debit source account: %w", err)
+	}
+	if err := toAccount.Credit(destinationAmount); err != nil {
+		return nil, fmt.Errorf("failed to credit destination account: %w", err)
+	}
+
+	// Save both legs (within transaction)
+	if err := transactionRepository.Save(outgoing); err != nil {
+		return nil, fmt.Errorf("failed to save transfer: %w", err)
+	}
+	if err := transactionRepository.Save(incoming); err != nil {
+		return nil, fmt.Errorf("failed to save transfer: %w", err)
+	}
+	for _, leg := range []*entities.Transaction{outgoing, incoming} {
+		if err := recordTransactionRevision(revisionRepository, leg, entities.RevisionActionCreate, nil, entities.SnapshotOf(leg), &userID, input.RequestID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := accountRepository.Save(fromAccount); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+	if err := accountRepository.Save(toAccount); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, leg := range []*entities.Transaction{outgoing, incoming} {
+		for _, event := range leg.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				// Log error but don't fail the transfer
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		leg.ClearEvents()
+	}
+
+	output := &dtos.CreateTransferOutput{
+		OutgoingTransactionID: outgoing.ID().Value(),
+		IncomingTransactionID: incoming.ID().Value(),
+		UserID:                userID.Value(),
+		FromAccountID:         fromAccountID.Value(),
+		ToAccountID:           toAccountID.Value(),
+		Amount:                amount.Float64(),
+		Currency:              amount.Currency().Code(),
+		DestinationAmount:     destinationAmount.Float64(),
+		DestinationCurrency:   destinationAmount.Currency().Code(),
+		Description:           outgoing.Description().Value(),
+		Date:                  outgoing.Date().Format("2006-01-02"),
+		CreatedAt:             outgoing.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	return output, nil
+}
+
+// findUserAccount loads an account and checks that it belongs to the user.
+// The role ("source" or "destination") is used in error messages.
+func findUserAccount(
+	accountRepository accountrepositories.AccountRepository,
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	role string,
+) (*accountentities.Account, error) {
+	account, err := accountRepository.FindByID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s account: %w", role, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("%s account not found: %s", role, accountID.Value())
+	}
+	if !account.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError(fmt.Sprintf("%s account does not belong to user", role))
+	}
+	return account, nil
+}
+
+// transferAmounts builds the amount debited from the source account and the amount credited
+// to the destination account. When the accounts use different currencies the destination
+// amount must be given explicitly, since no exchange rate is assumed.
+func transferAmounts(
+	fromAccount *accountentities.Account,
+	toAccount *accountentities.Account,
+	rawAmount float64,
+	rawDestinationAmount *float64,
+) (sharedvalueobjects.Money, sharedvalueobjects.Money, error) {
+	fromCurrency := fromAccount.Balance().Currency()
+	toCurrency := toAccount.Balance().Currency()
+
+	// Convert float to cents
+	amount, err := sharedvalueobjects.NewMoney(int64(math.Round(rawAmount*100)), fromCurrency)
+	if err != nil {
+		return sharedvalueobjects.Money{}, sharedvalueobjects.Money{}, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	if fromCurrency.Equals(toCurrency) {
+		if rawDestinationAmount != nil && int64(math.Round(*rawDestinationAmount*100)) != amount.Amount() {
+			return sharedvalueobjects.Money{}, sharedvalueobjects.Money{}, errors.New("destination amount must be equal to amount when both accounts use the same currency")
+		}
+		return amount, amount, nil
+	}
+
+	if rawDestinationAmount == nil {
+		return sharedvalueobjects.Money{}, sharedvalueobjects.Money{}, fmt.Errorf(
+			"destination amount must be provided when transferring between different currencies (%s to %s)",
+			fromCurrency.Code(), toCurrency.Code(),
+		)
+	}
+
+	destinationAmount, err := sharedvalueobjects.NewMoney(int64(math.Round(*rawDestinationAmount*100)), toCurrency)
+	if err != nil {
+		return sharedvalueobjects.Money{}, sharedvalueobjects.Money{}, fmt.Errorf("invalid destination amount: %w", err)
+	}
+
+	return amount, destinationAmount, nil
+}
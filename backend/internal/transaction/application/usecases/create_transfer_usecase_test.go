@@ -0,0 +1,259 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// createTestTransfer creates both legs of a transfer, applies them to the accounts and saves everything in the mocks.
+func createTestTransfer(
+	t *testing.T,
+	txRepo *mockTransactionRepository,
+	accRepo *mockAccountRepository,
+	userID identityvalueobjects.UserID,
+	fromAccountID accountvalueobjects.AccountID,
+	toAccountID accountvalueobjects.AccountID,
+	amount sharedvalueobjects.Money,
+	destinationAmount sharedvalueobjects.Money,
+) (*entities.Transaction, *entities.Transaction) {
+	t.Helper()
+
+	description, _ := transactionvalueobjects.NewTransactionDescription("Transferência")
+	outgoing, incoming, err := entities.NewTransfer(userID, fromAccountID, toAccountID, amount, destinationAmount, description, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create transfer: %v", err)
+	}
+	_ = txRepo.Save(outgoing)
+	_ = txRepo.Save(incoming)
+
+	fromAccount, _ := accRepo.FindByID(fromAccountID)
+	toAccount, _ := accRepo.FindByID(toAccountID)
+	if err := fromAccount.Debit(amount); err != nil {
+		t.Fatalf("failed to debit source account: %v", err)
+	}
+	if err := toAccount.Credit(destinationAmount); err != nil {
+		t.Fatalf("failed to credit destination account: %v", err)
+	}
+
+	return outgoing, incoming
+}
+
+func TestCreateTransferUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	otherUserID := identityvalueobjects.GenerateUserID()
+	fromAccountID := accountvalueobjects.GenerateAccountID()
+	toAccountID := accountvalueobjects.GenerateAccountID()
+	usdAccountID := accountvalueobjects.GenerateAccountID()
+	otherAccountID := accountvalueobjects.GenerateAccountID()
+
+	brl, _ := sharedvalueobjects.NewCurrency("BRL")
+	usd, _ := sharedvalueobjects.NewCurrency("USD")
+	brlBalance, _ := sharedvalueobjects.NewMoney(100000, brl) // 1000.00 BRL
+	usdBalance, _ := sharedvalueobjects.NewMoney(0, usd)
+
+	setupAccounts := func(accRepo *mockAccountRepository) {
+		from, _ := createTestAccountWithID(userID, fromAccountID, brlBalance)
+		to, _ := createTestAccountWithID(userID, toAccountID, brlBalance)
+		usdAccount, _ := createTestAccountWithID(userID, usdAccountID, usdBalance)
+		other, _ := createTestAccountWithID(otherUserID, otherAccountID, brlBalance)
+		_ = accRepo.Save(from)
+		_ = accRepo.Save(to)
+		_ = accRepo.Save(usdAccount)
+		_ = accRepo.Save(other)
+	}
+
+	tests := []struct {
+		name      string
+		input     dtos.CreateTransferInput
+		wantError bool
+		errorMsg  string
+		validate  func(*testing.T, *dtos.CreateTransferOutput, *mockTransactionRepository, *mockAccountRepository)
+	}{
+		{
+			name: "same currency transfer",
+			input: dtos.CreateTransferInput{
+				UserID:        userID.Value(),
+				FromAccountID: fromAccountID.Value(),
+				ToAccountID:   toAccountID.Value(),
+				Amount:        250.00,
+				Description:   "Pagamento da fatura",
+				Date:          "2026-01-10",
+			},
+			validate: func(t *testing.T, output *dtos.CreateTransferOutput, txRepo *mockTransactionRepository, accRepo *mockAccountRepository) {
+				from, _ := accRepo.FindByID(fromAccountID)
+				to, _ := accRepo.FindByID(toAccountID)
+				if from.Balance().Amount() != 75000 {
+					t.Errorf("expected source balance 75000, got %d", from.Balance().Amount())
+				}
+				if to.Balance().Amount() != 125000 {
+					t.Errorf("expected destination balance 125000, got %d", to.Balance().Amount())
+				}
+
+				outgoingID, _ := transactionvalueobjects.NewTransactionID(output.OutgoingTransactionID)
+				outgoing, _ := txRepo.FindByID(outgoingID)
+				if outgoing == nil {
+					t.Fatal("outgoing leg was not saved")
+				}
+				if outgoing.TransactionType().Value() != transactionvalueobjects.TransferOut {
+					t.Errorf("expected outgoing leg type TRANSFER_OUT, got %s", outgoing.TransactionType().Value())
+				}
+				if outgoing.LinkedTransactionID() == nil || outgoing.LinkedTransactionID().Value() != output.IncomingTransactionID {
+					t.Errorf("expected outgoing leg to be linked to %s", output.IncomingTransactionID)
+				}
+				if output.DestinationAmount != 250.00 || output.DestinationCurrency != "BRL" {
+					t.Errorf("expected destination 250.00 BRL, got %.2f %s", output.DestinationAmount, output.DestinationCurrency)
+				}
+			},
+		},
+		{
+			name: "different currencies with destination amount",
+			input: dtos.CreateTransferInput{
+				UserID:            userID.Value(),
+				FromAccountID:     fromAccountID.Value(),
+				ToAccountID:       usdAccountID.Value(),
+				Amount:            550.00,
+				DestinationAmount: floatPtr(100.00),
+				Description:       "Compra de dólares",
+				Date:              "2026-01-10",
+			},
+			validate: func(t *testing.T, output *dtos.CreateTransferOutput, txRepo *mockTransactionRepository, accRepo *mockAccountRepository) {
+				usdAccount, _ := accRepo.FindByID(usdAccountID)
+				if usdAccount.Balance().Amount() != 10000 {
+					t.Errorf("expected USD balance 10000, got %d", usdAccount.Balance().Amount())
+				}
+				if output.Currency != "BRL" || output.DestinationCurrency != "USD" {
+					t.Errorf("expected BRL -> USD, got %s -> %s", output.Currency, output.DestinationCurrency)
+				}
+			},
+		},
+		{
+			name: "amounts are rounded to the cent",
+			input: dtos.CreateTransferInput{
+				UserID:            userID.Value(),
+				FromAccountID:     fromAccountID.Value(),
+				ToAccountID:       usdAccountID.Value(),
+				Amount:            0.29,
+				DestinationAmount: floatPtr(0.57),
+				Description:       "Compra de dólares",
+				Date:              "2026-01-10",
+			},
+			validate: func(t *testing.T, output *dtos.CreateTransferOutput, txRepo *mockTransactionRepository, accRepo *mockAccountRepository) {
+				// 0.29 * 100 and 0.57 * 100 are just below 29 and 57
+				from, _ := accRepo.FindByID(fromAccountID)
+				usdAccount, _ := accRepo.FindByID(usdAccountID)
+				if from.Balance().Amount() != 99971 || usdAccount.Balance().Amount() != 57 {
+					t.Errorf("expected balances 99971 and 57, got %d and %d", from.Balance().Amount(), usdAccount.Balance().Amount())
+				}
+			},
+		},
+		{
+			name: "different currencies without destination amount",
+			input: dtos.CreateTransferInput{
+				UserID:        userID.Value(),
+				FromAccountID: fromAccountID.Value(),
+				ToAccountID:   usdAccountID.Value(),
+				Amount:        550.00,
+				Description:   "Compra de dólares",
+				Date:          "2026-01-10",
+			},
+			wantError: true,
+			errorMsg:  "destination amount must be provided",
+		},
+		{
+			name: "same currency with different destination amount",
+			input: dtos.CreateTransferInput{
+				UserID:            userID.Value(),
+				FromAccountID:     fromAccountID.Value(),
+				ToAccountID:       toAccountID.Value(),
+				Amount:            100.00,
+				DestinationAmount: floatPtr(90.00),
+				Description:       "Transferência",
+				Date:              "2026-01-10",
+			},
+			wantError: true,
+			errorMsg:  "destination amount must be equal to amount",
+		},
+		{
+			name: "same source and destination account",
+			input: dtos.CreateTransferInput{
+				UserID:        userID.Value(),
+				FromAccountID: fromAccountID.Value(),
+				ToAccountID:   fromAccountID.Value(),
+				Amount:        100.00,
+				Description:   "Transferência",
+				Date:          "2026-01-10",
+			},
+			wantError: true,
+			errorMsg:  "must be different",
+		},
+		{
+			name: "destination account of another user",
+			input: dtos.CreateTransferInput{
+				UserID:        userID.Value(),
+				FromAccountID: fromAccountID.Value(),
+				ToAccountID:   otherAccountID.Value(),
+				Amount:        100.00,
+				Description:   "Transferência",
+				Date:          "2026-01-10",
+			},
+			wantError: true,
+			errorMsg:  "destination account does not belong to user",
+		},
+		{
+			name: "insufficient balance",
+			input: dtos.CreateTransferInput{
+				UserID:        userID.Value(),
+				FromAccountID: fromAccountID.Value(),
+				ToAccountID:   toAccountID.Value(),
+				Amount:        5000.00,
+				Description:   "Transferência",
+				Date:          "2026-01-10",
+			},
+			wantError: true,
+			errorMsg:  "insufficient balance",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txRepo := newMockTransactionRepository()
+			accRepo := newMockAccountRepository()
+			setupAccounts(accRepo)
+			uow := newMockUnitOfWork(txRepo, accRepo)
+
+			useCase := NewCreateTransferUseCase(uow, eventbus.NewEventBus())
+			output, err := useCase.Execute(tt.input)
+
+			if tt.wantError {
+				if err == nil {
+					t.Fatalf("expected error containing %q but got nil", tt.errorMsg)
+				}
+				if !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+				if len(txRepo.transactions) != 0 {
+					t.Errorf("expected no transactions to be saved, got %d", len(txRepo.transactions))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(txRepo.transactions) != 2 {
+				t.Errorf("expected 2 transactions to be saved, got %d", len(txRepo.transactions))
+			}
+			if tt.validate != nil {
+				tt.validate(t, output, txRepo, accRepo)
+			}
+		})
+	}
+}
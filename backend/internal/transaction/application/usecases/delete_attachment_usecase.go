@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/storage"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// DeleteAttachmentUseCase handles removing files attached to transactions.
+type DeleteAttachmentUseCase struct {
+	attachmentRepository repositories.AttachmentRepository
+	fileStorage          storage.FileStorage
+}
+
+// NewDeleteAttachmentUseCase creates a new DeleteAttachmentUseCase instance.
+func NewDeleteAttachmentUseCase(
+	attachmentRepository repositories.AttachmentRepository,
+	fileStorage storage.FileStorage,
+) *DeleteAttachmentUseCase {
+	return &DeleteAttachmentUseCase{
+		attachmentRepository: attachmentRepository,
+		fileStorage:          fileStorage,
+	}
+}
+
+// Execute deletes the attachment record and its file.
+func (uc *DeleteAttachmentUseCase) Execute(input dtos.DeleteAttachmentInput) (*dtos.DeleteAttachmentOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	attachment, err := findUserAttachment(uc.attachmentRepository, userID, input.TransactionID, input.AttachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The file goes first: if deleting the record failed afterwards, the attachment would still be
+	// listed and could be deleted again, while the other order could leave an unreachable file
+	if err := uc.fileStorage.Delete(attachment.StorageKey()); err != nil {
+		return nil, fmt.Errorf("failed to delete attachment file: %w", err)
+	}
+
+	if err := uc.attachmentRepository.Delete(attachment.ID()); err != nil {
+		return nil, fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return &dtos.DeleteAttachmentOutput{
+		Message:      "Attachment deleted successfully",
+		AttachmentID: attachment.ID().Value(),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// DeleteTransactionRuleUseCase handles the deletion of auto-categorization rules.
+type DeleteTransactionRuleUseCase struct {
+	ruleRepository repositories.TransactionRuleRepository
+}
+
+// NewDeleteTransactionRuleUseCase creates a new DeleteTransactionRuleUseCase instance.
+func NewDeleteTransactionRuleUseCase(ruleRepository repositories.TransactionRuleRepository) *DeleteTransactionRuleUseCase {
+	return &DeleteTransactionRuleUseCase{
+		ruleRepository: ruleRepository,
+	}
+}
+
+// Execute deletes the rule. Transactions already changed by the rule are not affected.
+func (uc *DeleteTransactionRuleUseCase) Execute(input dtos.DeleteTransactionRuleInput) (*dtos.DeleteTransactionRuleOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rule, err := findUserRule(uc.ruleRepository, userID, input.RuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepository.Delete(rule.ID()); err != nil {
+		return nil, fmt.Errorf("failed to delete rule: %w", err)
+	}
+
+	return &dtos.DeleteTransactionRuleOutput{
+		Message: "Rule deleted successfully",
+		RuleID:  rule.ID().Value(),
+	}, nil
+}
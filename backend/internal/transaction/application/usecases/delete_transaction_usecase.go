@@ -3,10 +3,16 @@ package usecases
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
 	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
@@ -36,6 +42,7 @@ func (uc *DeleteTransactionUseCase) Execute(input dtos.DeleteTransactionInput) (
 	// Get repositories from UnitOfWork
 	transactionRepository := uc.unitOfWork.TransactionRepository()
 	accountRepository := uc.unitOfWork.AccountRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
 
 	// Create transaction ID value object
 	transactionID, err := transactionvalueobjects.NewTransactionID(input.TransactionID)
@@ -43,6 +50,11 @@ func (uc *DeleteTransactionUseCase) Execute(input dtos.DeleteTransactionInput) (
 		return nil, fmt.Errorf("invalid transaction ID: %w", err)
 	}
 
+	actorID, err := optionalActorID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Begin transaction to ensure atomicity
 	if err := uc.unitOfWork.Begin(); err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
@@ -68,44 +80,33 @@ func (uc *DeleteTransactionUseCase) Execute(input dtos.DeleteTransactionInput) (
 		return nil, errors.New("transaction not found")
 	}
 
+	// Reconciled transactions match a bank statement and must be unlocked deliberately first
+	if transaction.IsReconciled() {
+		return nil, errors.New("reconciled transaction cannot be deleted: set its status back to CLEARED first")
+	}
+
+	// Deleting one leg of a transfer deletes the whole transfer
+	if transaction.IsTransfer() {
+		return uc.deleteTransfer(transaction, actorID, input.RequestID)
+	}
+
 	// Store transaction details before deletion (needed for balance reversal and TransactionDeleted event)
 	accountID := transaction.AccountID()
-	transactionType := transaction.TransactionType().Value()
+	transactionType := transaction.TransactionType()
 	amount := transaction.Amount()
 
 	// Find account and reverse transaction effect (within transaction)
-	account, err := accountRepository.FindByID(accountID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find account: %w", err)
-	}
-	if account == nil {
-		return nil, fmt.Errorf("account not found: %s", accountID.Value())
-	}
-
-	// Reverse the transaction effect
-	// INCOME: reverse credit (debit)
-	// EXPENSE: reverse debit (credit)
-	if transactionType == "INCOME" {
-		// Reverse: debit (subtract) what was previously credited
-		if err := account.Debit(amount); err != nil {
-			return nil, fmt.Errorf("failed to reverse income transaction: %w", err)
-		}
-	} else if transactionType == "EXPENSE" {
-		// Reverse: credit (add) what was previously debited
-		if err := account.Credit(amount); err != nil {
-			return nil, fmt.Errorf("failed to reverse expense transaction: %w", err)
-		}
-	}
-
-	// Save updated account (within transaction)
-	if err := accountRepository.Save(account); err != nil {
-		return nil, fmt.Errorf("failed to save updated account: %w", err)
+	if err := reverseAccountBalance(accountRepository, accountID, transactionType, amount); err != nil {
+		return nil, err
 	}
 
 	// Delete transaction (soft delete, within transaction)
 	if err := transactionRepository.Delete(transactionID); err != nil {
 		return nil, fmt.Errorf("failed to delete transaction: %w", err)
 	}
+	if err := recordTransactionRevision(revisionRepository, transaction, entities.RevisionActionDelete, entities.SnapshotOf(transaction), nil, actorID, input.RequestID); err != nil {
+		return nil, err
+	}
 
 	// Commit transaction (all operations succeed)
 	if err := uc.unitOfWork.Commit(); err != nil {
@@ -116,7 +117,7 @@ func (uc *DeleteTransactionUseCase) Execute(input dtos.DeleteTransactionInput) (
 	deleteEvent := transactionevents.NewTransactionDeleted(
 		transactionID.Value(),
 		accountID.Value(),
-		transactionType,
+		transactionType.Value(),
 		amount,
 	)
 	if err := uc.eventBus.Publish(deleteEvent); err != nil {
@@ -132,3 +133,94 @@ func (uc *DeleteTransactionUseCase) Execute(input dtos.DeleteTransactionInput) (
 
 	return output, nil
 }
+
+// deleteTransfer deletes both legs of a transfer and reverses their effect on both accounts.
+// It must be called inside an open UnitOfWork transaction, which it commits.
+func (uc *DeleteTransactionUseCase) deleteTransfer(
+	transaction *entities.Transaction,
+	actorID *identityvalueobjects.UserID,
+	requestID string,
+) (*dtos.DeleteTransactionOutput, error) {
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	linked, err := findLinkedTransaction(transactionRepository, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if linked.IsReconciled() {
+		return nil, errors.New("linked transfer transaction is reconciled and cannot be deleted: set its status back to CLEARED first")
+	}
+
+	outgoing, incoming := transaction, linked
+	if transaction.TransactionType().Value() == transactionvalueobjects.TransferIn {
+		outgoing, incoming = linked, transaction
+	}
+
+	// Reverse both legs and delete them (soft delete, within transaction)
+	for _, leg := range []*entities.Transaction{outgoing, incoming} {
+		if err := reverseAccountBalance(accountRepository, leg.AccountID(), leg.TransactionType(), leg.Amount()); err != nil {
+			return nil, err
+		}
+		if err := transactionRepository.Delete(leg.ID()); err != nil {
+			return nil, fmt.Errorf("failed to delete transaction: %w", err)
+		}
+		if err := recordTransactionRevision(uc.unitOfWork.TransactionRevisionRepository(), leg, entities.RevisionActionDelete, entities.SnapshotOf(leg), nil, actorID, requestID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish TransferDeleted event for other subscribers (after successful commit)
+	deleteEvent := transactionevents.NewTransferDeleted(
+		outgoing.ID().Value(),
+		incoming.ID().Value(),
+		outgoing.AccountID().Value(),
+		incoming.AccountID().Value(),
+		outgoing.Amount(),
+		incoming.Amount(),
+	)
+	if err := uc.eventBus.Publish(deleteEvent); err != nil {
+		_ = err // Ignore for now, but should be logged
+	}
+
+	return &dtos.DeleteTransactionOutput{
+		Message:       "Transfer deleted successfully",
+		TransactionID: transaction.ID().Value(),
+	}, nil
+}
+
+// reverseAccountBalance reverses the effect of a transaction on the account balance
+// and saves the account (within the caller's transaction).
+func reverseAccountBalance(
+	accountRepository accountrepositories.AccountRepository,
+	accountID accountvalueobjects.AccountID,
+	transactionType transactionvalueobjects.TransactionType,
+	amount sharedvalueobjects.Money,
+) error {
+	account, err := accountRepository.FindByID(accountID)
+	if err != nil {
+		return fmt.Errorf("failed to find account: %w", err)
+	}
+	if account == nil {
+		return fmt.Errorf("account not found: %s", accountID.Value())
+	}
+
+	// INCOME/TRANSFER_IN: reverse credit (debit)
+	// EXPENSE/TRANSFER_OUT: reverse debit (credit)
+	if err := reverseBalanceEffect(account, transactionType, amount); err != nil {
+		return fmt.Errorf("failed to reverse %s transaction: %w", strings.ToLower(transactionType.Value()), err)
+	}
+
+	// Save updated account (within transaction)
+	if err := accountRepository.Save(account); err != nil {
+		return fmt.Errorf("failed to save updated account: %w", err)
+	}
+
+	return nil
+}
@@ -234,3 +234,42 @@ func TestDeleteTransactionUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteTransactionUseCase_Execute_Transfer(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	fromAccountID := accountvalueobjects.GenerateAccountID()
+	toAccountID := accountvalueobjects.GenerateAccountID()
+
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+	initialBalance, _ := sharedvalueobjects.NewMoney(100000, currency) // 1000.00 BRL
+	amount, _ := sharedvalueobjects.NewMoney(30000, currency)          // 300.00 BRL
+
+	mockTxRepo := newMockTransactionRepository()
+	mockAccRepo := newMockAccountRepository()
+	from, _ := createTestAccountWithID(userID, fromAccountID, initialBalance)
+	to, _ := createTestAccountWithID(userID, toAccountID, initialBalance)
+	_ = mockAccRepo.Save(from)
+	_ = mockAccRepo.Save(to)
+	_, incoming := createTestTransfer(t, mockTxRepo, mockAccRepo, userID, fromAccountID, toAccountID, amount, amount)
+
+	// Deleting the incoming leg removes the whole transfer
+	useCase := NewDeleteTransactionUseCase(newMockUnitOfWork(mockTxRepo, mockAccRepo), eventbus.NewEventBus())
+	output, err := useCase.Execute(dtos.DeleteTransactionInput{TransactionID: incoming.ID().Value()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Message != "Transfer deleted successfully" {
+		t.Errorf("expected message 'Transfer deleted successfully', got %s", output.Message)
+	}
+	if len(mockTxRepo.transactions) != 0 {
+		t.Errorf("expected both legs to be deleted, %d left", len(mockTxRepo.transactions))
+	}
+
+	// Both balances are back to their initial value
+	for _, accountID := range []accountvalueobjects.AccountID{fromAccountID, toAccountID} {
+		account, _ := mockAccRepo.FindByID(accountID)
+		if account.Balance().Amount() != 100000 {
+			t.Errorf("expected balance 100000 for account %s, got %d", accountID.Value(), account.Balance().Amount())
+		}
+	}
+}
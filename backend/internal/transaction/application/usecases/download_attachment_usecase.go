@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/storage"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// DownloadAttachmentUseCase handles downloading files attached to transactions.
+type DownloadAttachmentUseCase struct {
+	attachmentRepository repositories.AttachmentRepository
+	fileStorage          storage.FileStorage
+}
+
+// NewDownloadAttachmentUseCase creates a new DownloadAttachmentUseCase instance.
+func NewDownloadAttachmentUseCase(
+	attachmentRepository repositories.AttachmentRepository,
+	fileStorage storage.FileStorage,
+) *DownloadAttachmentUseCase {
+	return &DownloadAttachmentUseCase{
+		attachmentRepository: attachmentRepository,
+		fileStorage:          fileStorage,
+	}
+}
+
+// Execute opens the attachment file. Only the owner of the attachment can download it.
+func (uc *DownloadAttachmentUseCase) Execute(input dtos.DownloadAttachmentInput) (*dtos.DownloadAttachmentOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	attachment, err := findUserAttachment(uc.attachmentRepository, userID, input.TransactionID, input.AttachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := uc.fileStorage.Get(attachment.StorageKey())
+	if err != nil {
+		if errors.Is(err, storage.ErrFileNotFound) {
+			return nil, errors.New("attachment file not found")
+		}
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	return &dtos.DownloadAttachmentOutput{
+		FileName:    attachment.FileName(),
+		ContentType: attachment.ContentType(),
+		Size:        attachment.Size(),
+		Content:     content,
+	}, nil
+}
+
+// findUserAttachment loads an attachment of a transaction and checks that it belongs to the user.
+func findUserAttachment(
+	attachmentRepository repositories.AttachmentRepository,
+	userID identityvalueobjects.UserID,
+	rawTransactionID string,
+	rawAttachmentID string,
+) (*entities.Attachment, error) {
+	transactionID, err := transactionvalueobjects.NewTransactionID(rawTransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	attachmentID, err := transactionvalueobjects.NewAttachmentID(rawAttachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attachment ID: %w", err)
+	}
+
+	attachment, err := attachmentRepository.FindByID(attachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attachment: %w", err)
+	}
+	if attachment == nil || !attachment.TransactionID().Equals(transactionID) {
+		return nil, errors.New("attachment not found")
+	}
+	if !attachment.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("attachment does not belong to user")
+	}
+
+	return attachment, nil
+}
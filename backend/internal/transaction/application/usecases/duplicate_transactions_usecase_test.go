@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// saveTestExpense saves an expense of the account in the repository (without touching the balance).
+func saveTestExpense(
+	t *testing.T,
+	txRepo *mockTransactionRepository,
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	cents int64,
+	description string,
+	date time.Time,
+) *entities.Transaction {
+	t.Helper()
+
+	brl, _ := sharedvalueobjects.NewCurrency("BRL")
+	amount, _ := sharedvalueobjects.NewMoney(cents, brl)
+	transactionDescription, _ := transactionvalueobjects.NewTransactionDescription(description)
+	transaction, err := entities.NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, transactionDescription, date)
+	if err != nil {
+		t.Fatalf("failed to create transaction: %v", err)
+	}
+	transaction.ClearEvents()
+	_ = txRepo.Save(transaction)
+	return transaction
+}
+
+func TestListDuplicateTransactionsUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	_, txRepo, _ := setupImportTest(t, userID, accountID, 100000)
+
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	original := saveTestExpense(t, txRepo, userID, accountID, 4590, "Padaria Pão Quente", date)
+	duplicate := saveTestExpense(t, txRepo, userID, accountID, 4590, "COMPRA CARTAO 4411 PADARIA PAO QUENTE", date.AddDate(0, 0, 2))
+	saveTestExpense(t, txRepo, userID, accountID, 4590, "Farmácia", date)
+	saveTestExpense(t, txRepo, userID, accountID, 12000, "Padaria Pão Quente", date)
+
+	output, err := NewListDuplicateTransactionsUseCase(txRepo).Execute(dtos.ListDuplicateTransactionsInput{
+		UserID:    userID.Value(),
+		StartDate: "2026-10-01",
+		EndDate:   "2026-10-31",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Count != 1 {
+		t.Fatalf("expected 1 duplicate pair, got %d", output.Count)
+	}
+	pair := output.Pairs[0]
+	ids := map[string]bool{pair.Original.TransactionID: true, pair.Duplicate.TransactionID: true}
+	if !ids[original.ID().Value()] || !ids[duplicate.ID().Value()] {
+		t.Errorf("expected the bakery transactions to be paired, got %s and %s", pair.Original.TransactionID, pair.Duplicate.TransactionID)
+	}
+	if pair.DaysApart != 2 {
+		t.Errorf("expected 2 days apart, got %d", pair.DaysApart)
+	}
+
+	t.Run("rejects periods longer than a year", func(t *testing.T) {
+		_, err := NewListDuplicateTransactionsUseCase(txRepo).Execute(dtos.ListDuplicateTransactionsInput{
+			UserID:    userID.Value(),
+			StartDate: "2024-01-01",
+			EndDate:   "2026-10-31",
+		})
+		if err == nil {
+			t.Fatal("expected error for a period longer than a year")
+		}
+	})
+}
+
+func TestMergeDuplicateTransactionsUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("deletes the duplicate and restores the balance", func(t *testing.T) {
+		// Balance already reflects both expenses: 1000.00 - 45.90 - 45.90
+		uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 90820)
+		kept := saveTestExpense(t, txRepo, userID, accountID, 4590, "Padaria Pão Quente", date)
+		duplicate := saveTestExpense(t, txRepo, userID, accountID, 4590, "PADARIA PAO QUENTE", date.AddDate(0, 0, 1))
+		if err := duplicate.AssignExternalID("20261006001"); err != nil {
+			t.Fatalf("failed to assign external ID: %v", err)
+		}
+
+		output, err := NewMergeDuplicateTransactionsUseCase(uow, eventbus.NewEventBus()).Execute(dtos.MergeDuplicateTransactionsInput{
+			UserID:                 userID.Value(),
+			KeepTransactionID:      kept.ID().Value(),
+			DuplicateTransactionID: duplicate.ID().Value(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, exists := txRepo.transactions[duplicate.ID().Value()]; exists {
+			t.Error("expected duplicate to be deleted")
+		}
+		if output.RemovedTransactionID != duplicate.ID().Value() || output.Transaction.TransactionID != kept.ID().Value() {
+			t.Errorf("unexpected output: %+v", output)
+		}
+		if kept.ExternalID() != "20261006001" {
+			t.Errorf("expected kept transaction to take the external ID, got %q", kept.ExternalID())
+		}
+
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 95410 || output.AccountBalance != 954.10 {
+			t.Errorf("expected balance 954.10, got %d (output %.2f)", account.Balance().Amount(), output.AccountBalance)
+		}
+	})
+
+	t.Run("rejects transactions of another user", func(t *testing.T) {
+		uow, txRepo, _ := setupImportTest(t, userID, accountID, 100000)
+		kept := saveTestExpense(t, txRepo, userID, accountID, 4590, "Padaria", date)
+		other := saveTestExpense(t, txRepo, identityvalueobjects.GenerateUserID(), accountID, 4590, "Padaria", date)
+
+		_, err := NewMergeDuplicateTransactionsUseCase(uow, eventbus.NewEventBus()).Execute(dtos.MergeDuplicateTransactionsInput{
+			UserID:                 userID.Value(),
+			KeepTransactionID:      kept.ID().Value(),
+			DuplicateTransactionID: other.ID().Value(),
+		})
+
+		var appErr *apperrors.AppError
+		if !errors.As(err, &appErr) || appErr.Type != apperrors.ErrorTypeForbidden {
+			t.Fatalf("expected forbidden error, got %v", err)
+		}
+		if len(txRepo.transactions) != 2 {
+			t.Errorf("expected no transaction to be deleted, got %d left", len(txRepo.transactions))
+		}
+	})
+
+	t.Run("rejects merging a transaction with itself", func(t *testing.T) {
+		uow, txRepo, _ := setupImportTest(t, userID, accountID, 100000)
+		kept := saveTestExpense(t, txRepo, userID, accountID, 4590, "Padaria", date)
+
+		_, err := NewMergeDuplicateTransactionsUseCase(uow, eventbus.NewEventBus()).Execute(dtos.MergeDuplicateTransactionsInput{
+			UserID:                 userID.Value(),
+			KeepTransactionID:      kept.ID().Value(),
+			DuplicateTransactionID: kept.ID().Value(),
+		})
+		if err == nil {
+			t.Fatal("expected error when merging a transaction with itself")
+		}
+	})
+}
+
+func TestCreateTransactionUseCase_Execute_FlagsPossibleDuplicates(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, _ := setupImportTest(t, userID, accountID, 100000)
+
+	existing := saveTestExpense(t, txRepo, userID, accountID, 4590, "Padaria Pão Quente", time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC))
+
+	output, err := NewCreateTransactionUseCase(uow, nil, nil, nil, nil, eventbus.NewEventBus()).Execute(dtos.CreateTransactionInput{
+		UserID:      userID.Value(),
+		AccountID:   accountID.Value(),
+		Type:        "EXPENSE",
+		Amount:      45.90,
+		Currency:    "BRL",
+		Description: "padaria pao quente",
+		Date:        "2026-10-06",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(output.PossibleDuplicateIDs) != 1 || output.PossibleDuplicateIDs[0] != existing.ID().Value() {
+		t.Errorf("expected possible duplicate %s, got %v", existing.ID().Value(), output.PossibleDuplicateIDs)
+	}
+	if len(txRepo.transactions) != 2 {
+		t.Errorf("expected the transaction to be created anyway, got %d transactions", len(txRepo.transactions))
+	}
+}
@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// EndRecurringSeriesUseCase handles ending a recurring series at a date.
+// Occurrences already created after the end date are kept.
+type EndRecurringSeriesUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	eventBus              *eventbus.EventBus
+}
+
+// NewEndRecurringSeriesUseCase creates a new EndRecurringSeriesUseCase instance.
+func NewEndRecurringSeriesUseCase(
+	transactionRepository repositories.TransactionRepository,
+	eventBus *eventbus.EventBus,
+) *EndRecurringSeriesUseCase {
+	return &EndRecurringSeriesUseCase{
+		transactionRepository: transactionRepository,
+		eventBus:              eventBus,
+	}
+}
+
+// Execute sets the end date of the series.
+func (uc *EndRecurringSeriesUseCase) Execute(input dtos.EndRecurringSeriesInput) (*dtos.RecurringSeriesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	endDate, err := parseOccurrenceDate("end date", input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := findUserRecurringSeries(uc.transactionRepository, userID, input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := series.EndRecurrence(endDate); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepository.Save(series); err != nil {
+		return nil, fmt.Errorf("failed to save recurring series: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range series.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	series.ClearEvents()
+
+	output := recurringSeriesOutput(series, time.Now())
+	return &output, nil
+}
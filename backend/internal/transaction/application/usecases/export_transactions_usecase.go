@@ -0,0 +1,239 @@
+package usecases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/infrastructure/exporters"
+)
+
+// exportBatchSize is the number of transactions read from the database at a time during an export.
+const exportBatchSize = 500
+
+// ExportTransactionsUseCase handles exporting the transactions of a user as CSV, XLSX or JSON.
+type ExportTransactionsUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	accountRepository     accountrepositories.AccountRepository
+	categoryRepository    categoryrepositories.CategoryRepository
+}
+
+// NewExportTransactionsUseCase creates a new ExportTransactionsUseCase instance.
+func NewExportTransactionsUseCase(
+	transactionRepository repositories.TransactionRepository,
+	accountRepository accountrepositories.AccountRepository,
+	categoryRepository categoryrepositories.CategoryRepository,
+) *ExportTransactionsUseCase {
+	return &ExportTransactionsUseCase{
+		transactionRepository: transactionRepository,
+		accountRepository:     accountRepository,
+		categoryRepository:    categoryRepository,
+	}
+}
+
+// Execute validates the filters, format and locale and starts the export.
+// The file is written while Content is read, fetching the transactions in keyset-paginated
+// batches of exportBatchSize, so large exports are never loaded in memory at once. An error while
+// exporting ends the content with that error.
+func (uc *ExportTransactionsUseCase) Execute(input dtos.ExportTransactionsInput) (*dtos.ExportTransactionsOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	filter, err := transactionFilterFromInput(input.ListTransactionsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := exporters.ParseFormat(input.Format)
+	if err != nil {
+		return nil, err
+	}
+	locale, err := exporters.ParseLocale(input.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(uc.export(writer, userID, filter, format, locale))
+	}()
+
+	return &dtos.ExportTransactionsOutput{
+		FileName:    fmt.Sprintf("transactions-%s.%s", time.Now().Format("2006-01-02"), format),
+		ContentType: format.ContentType(),
+		Content:     reader,
+	}, nil
+}
+
+// export writes every transaction matching the filter to w.
+func (uc *ExportTransactionsUseCase) export(
+	w io.Writer,
+	userID identityvalueobjects.UserID,
+	filter repositories.TransactionFilter,
+	format exporters.Format,
+	locale exporters.Locale,
+) error {
+	writer, err := exporters.NewWriter(w, format, locale)
+	if err != nil {
+		return err
+	}
+
+	// Each batch continues after the last transaction of the previous one (keyset pagination),
+	// so transactions created or deleted meanwhile never shift the batches and no count is needed
+	names := newExportNames(uc.accountRepository, uc.categoryRepository)
+	var keyset *repositories.TransactionKeyset
+	for {
+		batch, err := uc.transactionRepository.FindByUserIDAndFiltersWithKeyset(userID, filter, keyset, false, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to find transactions: %w", err)
+		}
+
+		for _, transaction := range batch {
+			row, err := exportRow(transaction, names)
+			if err != nil {
+				return err
+			}
+			if err := writer.Write(row); err != nil {
+				return fmt.Errorf("failed to write export: %w", err)
+			}
+		}
+
+		if len(batch) < exportBatchSize {
+			break
+		}
+		last := filter.KeysetOf(batch[len(batch)-1])
+		keyset = &last
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	return nil
+}
+
+// exportRow converts a transaction to an export row.
+func exportRow(transaction *entities.Transaction, names *exportNames) (exporters.Row, error) {
+	amount := transaction.Amount()
+	row := exporters.Row{
+		TransactionID: transaction.ID().Value(),
+		Date:          transaction.Date(),
+		Description:   transaction.Description().Value(),
+		Type:          transaction.TransactionType().Value(),
+		Amount:        amount.Amount(),
+		Currency:      amount.Currency().Code(),
+		Status:        transaction.Status().Value(),
+		AccountID:     transaction.AccountID().Value(),
+		CategoryID:    categoryIDValue(transaction),
+		Recurring:     transaction.IsRecurring(),
+	}
+
+	accountName, err := names.account(transaction.AccountID())
+	if err != nil {
+		return row, err
+	}
+	row.AccountName = accountName
+
+	// Split transactions list the categories of their splits
+	categoryIDs := make([]categoryvalueobjects.CategoryID, 0, 1)
+	if transaction.CategoryID() != nil {
+		categoryIDs = append(categoryIDs, *transaction.CategoryID())
+	}
+	for _, split := range transaction.Splits() {
+		categoryIDs = append(categoryIDs, split.CategoryID())
+	}
+	categoryNames := make([]string, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		name, err := names.category(categoryID)
+		if err != nil {
+			return row, err
+		}
+		if name != "" {
+			categoryNames = append(categoryNames, name)
+		}
+	}
+	row.CategoryName = strings.Join(categoryNames, ", ")
+
+	if frequency := transaction.RecurrenceFrequency(); frequency != nil {
+		row.RecurrenceFrequency = frequency.Value()
+	}
+	row.RecurrenceEndDate = transaction.RecurrenceEndDate()
+	if parentID := transaction.ParentTransactionID(); parentID != nil {
+		row.ParentTransactionID = parentID.Value()
+	}
+	if installment := transaction.Installment(); installment != nil {
+		row.InstallmentNumber = installment.Number()
+		row.InstallmentCount = installment.Count()
+	}
+
+	return row, nil
+}
+
+// exportNames resolves account and category names during an export, loading each one once.
+type exportNames struct {
+	accountRepository  accountrepositories.AccountRepository
+	categoryRepository categoryrepositories.CategoryRepository
+	accounts           map[string]string
+	categories         map[string]string
+}
+
+func newExportNames(
+	accountRepository accountrepositories.AccountRepository,
+	categoryRepository categoryrepositories.CategoryRepository,
+) *exportNames {
+	return &exportNames{
+		accountRepository:  accountRepository,
+		categoryRepository: categoryRepository,
+		accounts:           make(map[string]string),
+		categories:         make(map[string]string),
+	}
+}
+
+// account returns the name of an account (empty if it no longer exists).
+func (n *exportNames) account(accountID accountvalueobjects.AccountID) (string, error) {
+	if name, ok := n.accounts[accountID.Value()]; ok {
+		return name, nil
+	}
+
+	account, err := n.accountRepository.FindByID(accountID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find account: %w", err)
+	}
+	name := ""
+	if account != nil {
+		name = account.Name().Value()
+	}
+	n.accounts[accountID.Value()] = name
+	return name, nil
+}
+
+// category returns the name of a category (empty if it no longer exists).
+func (n *exportNames) category(categoryID categoryvalueobjects.CategoryID) (string, error) {
+	if n.categoryRepository == nil {
+		return "", nil
+	}
+	if name, ok := n.categories[categoryID.Value()]; ok {
+		return name, nil
+	}
+
+	category, err := n.categoryRepository.FindByID(categoryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find category: %w", err)
+	}
+	name := ""
+	if category != nil {
+		name = category.Name().Value()
+	}
+	n.categories[categoryID.Value()] = name
+	return name, nil
+}
@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// keysetOnlyTransactionRepository fails offset pagination, so exports must read with the keyset.
+type keysetOnlyTransactionRepository struct {
+	*mockTransactionRepository
+}
+
+func (m *keysetOnlyTransactionRepository) FindByUserIDAndFiltersWithPagination(
+	userID identityvalueobjects.UserID,
+	filter repositories.TransactionFilter,
+	offset, limit int,
+) ([]*entities.Transaction, int64, error) {
+	return nil, 0, errors.New("offset pagination is not expected during an export")
+}
+
+func readExport(t *testing.T, output *dtos.ExportTransactionsOutput) string {
+	t.Helper()
+	defer output.Content.Close()
+
+	content, err := io.ReadAll(output.Content)
+	if err != nil {
+		t.Fatalf("reading export error = %v", err)
+	}
+	return string(content)
+}
+
+func TestExportTransactionsUseCase_Execute(t *testing.T) {
+	_, txRepo, accRepo, userID, checkingID, savingsID := setupBulkTest(t)
+	categoryRepo := newMockCategoryRepository()
+	category := createTestCategory(categoryRepo, userID, "Alimentação")
+
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	groceries := saveTestExpense(t, txRepo, userID, checkingID, 123456, "Mercado", date)
+	categoryID := category.ID()
+	_ = groceries.UpdateCategory(&categoryID)
+	_ = txRepo.Save(groceries)
+	saveTestExpense(t, txRepo, userID, savingsID, 1000, "Padaria", date.AddDate(0, 0, 1))
+
+	// More transactions than a batch, so the export reads several pages
+	for i := 0; i < exportBatchSize; i++ {
+		saveTestExpense(t, txRepo, userID, checkingID, 500, "Café", date.AddDate(0, 0, -1))
+	}
+
+	useCase := NewExportTransactionsUseCase(&keysetOnlyTransactionRepository{txRepo}, accRepo, categoryRepo)
+
+	t.Run("csv pt-BR is the default", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.ExportTransactionsInput{ListTransactionsInput: dtos.ListTransactionsInput{UserID: userID.Value()}})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !strings.HasSuffix(output.FileName, ".csv") || !strings.HasPrefix(output.ContentType, "text/csv") {
+			t.Errorf("Execute() file = %s %s, want a CSV file", output.FileName, output.ContentType)
+		}
+
+		lines := strings.Split(strings.TrimSuffix(readExport(t, output), "\n"), "\n")
+		if len(lines) != exportBatchSize+3 {
+			t.Fatalf("export has %d lines, want %d", len(lines), exportBatchSize+3)
+		}
+		// Newest first, as in the list endpoint
+		if !strings.HasPrefix(lines[1], "06/10/2026;Padaria;EXPENSE;10,00;BRL;") {
+			t.Errorf("first row = %s", lines[1])
+		}
+		if !strings.HasPrefix(lines[2], "05/10/2026;Mercado;EXPENSE;1.234,56;BRL;PENDING;Test Account;Alimentação;Não;") {
+			t.Errorf("second row = %s", lines[2])
+		}
+	})
+
+	t.Run("batches neither skip nor repeat transactions", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.ExportTransactionsInput{
+			ListTransactionsInput: dtos.ListTransactionsInput{UserID: userID.Value()},
+			Format:                "json",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		var rows []map[string]any
+		if err := json.Unmarshal([]byte(readExport(t, output)), &rows); err != nil {
+			t.Fatalf("export is not valid JSON: %v", err)
+		}
+		ids := make(map[any]bool, len(rows))
+		for _, row := range rows {
+			ids[row["transaction_id"]] = true
+		}
+		if len(rows) != exportBatchSize+2 || len(ids) != len(rows) {
+			t.Errorf("export has %d rows with %d distinct transactions, want %d", len(rows), len(ids), exportBatchSize+2)
+		}
+	})
+
+	t.Run("json honors the list filters", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.ExportTransactionsInput{
+			ListTransactionsInput: dtos.ListTransactionsInput{UserID: userID.Value(), MinAmount: "10.00", SortOrder: "asc"},
+			Format:                "json",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		var rows []map[string]any
+		if err := json.Unmarshal([]byte(readExport(t, output)), &rows); err != nil {
+			t.Fatalf("export is not valid JSON: %v", err)
+		}
+		if len(rows) != 2 {
+			t.Fatalf("export has %d rows, want 2", len(rows))
+		}
+		if rows[0]["description"] != "Mercado" || rows[0]["amount"] != 1234.56 || rows[0]["category_name"] != "Alimentação" || rows[0]["account_name"] != "Test Account" {
+			t.Errorf("first row = %v", rows[0])
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		inputs := map[string]dtos.ExportTransactionsInput{
+			"format": {ListTransactionsInput: dtos.ListTransactionsInput{UserID: userID.Value()}, Format: "pdf"},
+			"locale": {ListTransactionsInput: dtos.ListTransactionsInput{UserID: userID.Value()}, Locale: "fr"},
+			"filter": {ListTransactionsInput: dtos.ListTransactionsInput{UserID: userID.Value(), StartDate: "05/10/2026"}},
+			"user":   {ListTransactionsInput: dtos.ListTransactionsInput{UserID: "invalid"}},
+		}
+		for name, input := range inputs {
+			if _, err := useCase.Execute(input); err == nil || !strings.Contains(err.Error(), "invalid") {
+				t.Errorf("Execute() with invalid %s error = %v, want an invalid input error", name, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// GetInstallmentPurchaseUseCase handles retrieving a purchase paid in installments.
+type GetInstallmentPurchaseUseCase struct {
+	transactionRepository repositories.TransactionRepository
+}
+
+// NewGetInstallmentPurchaseUseCase creates a new GetInstallmentPurchaseUseCase instance.
+func NewGetInstallmentPurchaseUseCase(transactionRepository repositories.TransactionRepository) *GetInstallmentPurchaseUseCase {
+	return &GetInstallmentPurchaseUseCase{
+		transactionRepository: transactionRepository,
+	}
+}
+
+// Execute returns the purchase the given installment belongs to, with all of its installments.
+func (uc *GetInstallmentPurchaseUseCase) Execute(input dtos.GetInstallmentPurchaseInput) (*dtos.InstallmentPurchaseOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	installments, err := findInstallmentPurchase(uc.transactionRepository, userID, input.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := installmentPurchaseOutput(installments, time.Now())
+	return &output, nil
+}
+
+// findInstallmentPurchase loads the installments of the purchase the given transaction belongs to,
+// ordered by number, and checks that they belong to the user. Deleted installments are not returned.
+func findInstallmentPurchase(
+	transactionRepository repositories.TransactionRepository,
+	userID identityvalueobjects.UserID,
+	rawTransactionID string,
+) ([]*entities.Transaction, error) {
+	transactionID, err := transactionvalueobjects.NewTransactionID(rawTransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	transaction, err := findUserTransaction(transactionRepository, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if !transaction.IsInstallment() {
+		return nil, errors.New("invalid installment purchase: transaction is not an installment")
+	}
+
+	purchaseID := *transaction.InstallmentPurchaseID()
+	installments, err := transactionRepository.FindByParentID(purchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find installments: %w", err)
+	}
+
+	// The first installment is the parent of the others (it may have been deleted)
+	first, err := transactionRepository.FindByID(purchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find installments: %w", err)
+	}
+	if first != nil {
+		installments = append(installments, first)
+	}
+
+	sort.Slice(installments, func(i, j int) bool {
+		return installments[i].Installment().Number() < installments[j].Installment().Number()
+	})
+	return installments, nil
+}
+
+// parseInstallmentCutoffDate parses the date up to which installments are considered due
+// (YYYY-MM-DD), defaulting to today.
+func parseInstallmentCutoffDate(rawDate string) (time.Time, error) {
+	if rawDate == "" {
+		today := time.Now()
+		return time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+
+	date, err := time.Parse("2006-01-02", rawDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format: expected YYYY-MM-DD, got %s", rawDate)
+	}
+	return date, nil
+}
+
+// installmentsDueAfter returns the installments dated after the cutoff date. Reconciled
+// installments already matched a card statement and make the operation fail.
+func installmentsDueAfter(installments []*entities.Transaction, cutoff time.Time) ([]*entities.Transaction, error) {
+	cutoffDay := cutoff.Format("2006-01-02")
+
+	var remaining []*entities.Transaction
+	for _, installment := range installments {
+		if installment.Date().Format("2006-01-02") <= cutoffDay {
+			continue
+		}
+		if installment.IsReconciled() {
+			return nil, fmt.Errorf("installment %s is reconciled and cannot be changed: set its status back to CLEARED first", installment.Installment().String())
+		}
+		remaining = append(remaining, installment)
+	}
+
+	if len(remaining) == 0 {
+		return nil, fmt.Errorf("installment purchase cannot be changed: it has no installments due after %s", cutoffDay)
+	}
+	return remaining, nil
+}
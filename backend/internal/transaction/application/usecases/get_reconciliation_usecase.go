@@ -0,0 +1,201 @@
+package usecases
+
+import (
+	"fmt"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// GetReconciliationUseCase handles retrieving an account reconciliation.
+type GetReconciliationUseCase struct {
+	reconciliationRepository repositories.ReconciliationRepository
+	accountRepository        accountrepositories.AccountRepository
+	transactionRepository    repositories.TransactionRepository
+}
+
+// NewGetReconciliationUseCase creates a new GetReconciliationUseCase instance.
+func NewGetReconciliationUseCase(
+	reconciliationRepository repositories.ReconciliationRepository,
+	accountRepository accountrepositories.AccountRepository,
+	transactionRepository repositories.TransactionRepository,
+) *GetReconciliationUseCase {
+	return &GetReconciliationUseCase{
+		reconciliationRepository: reconciliationRepository,
+		accountRepository:        accountRepository,
+		transactionRepository:    transactionRepository,
+	}
+}
+
+// Execute returns the reconciliation. While it is in progress, the balances and the list of
+// unreconciled transactions up to the statement date reflect the current transaction statuses.
+func (uc *GetReconciliationUseCase) Execute(input dtos.GetReconciliationInput) (*dtos.ReconciliationOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	reconciliation, err := findUserReconciliation(uc.reconciliationRepository, userID, input.ReconciliationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary *reconciliationSummary
+	if reconciliation.IsInProgress() {
+		account, err := findUserAccount(uc.accountRepository, userID, reconciliation.AccountID(), "reconciled")
+		if err != nil {
+			return nil, err
+		}
+		summary, err = summarizeReconciliation(uc.transactionRepository, account, reconciliation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	output := reconciliationOutput(reconciliation, summary)
+	return &output, nil
+}
+
+// reconciliationSummary holds the figures of a reconciliation in progress.
+type reconciliationSummary struct {
+	reconciledBalance sharedvalueobjects.Money // Account balance counting only reconciled transactions
+	clearedBalance    sharedvalueobjects.Money // Reconciled balance plus cleared transactions up to the statement date
+	transactions      []*entities.Transaction  // Unreconciled transactions up to the statement date, oldest first
+	cleared           []*entities.Transaction  // The cleared ones among them
+}
+
+// summarizeReconciliation computes the balances of a reconciliation from the current account
+// balance and the account's unreconciled transactions. Transactions dated after the statement
+// date do not count towards the cleared balance, since they belong to a later statement.
+func summarizeReconciliation(
+	transactionRepository repositories.TransactionRepository,
+	account *accountentities.Account,
+	reconciliation *entities.Reconciliation,
+) (*reconciliationSummary, error) {
+	unreconciled, err := transactionRepository.FindUnreconciledByAccountID(reconciliation.AccountID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unreconciled transactions: %w", err)
+	}
+
+	statementDay := reconciliation.StatementDate().Format("2006-01-02")
+	summary := &reconciliationSummary{reconciledBalance: account.Balance()}
+
+	var clearedTotal int64
+	for _, transaction := range unreconciled {
+		// Undo every unreconciled transaction to get the balance that was already reconciled
+		signed := transaction.Amount().Amount()
+		if transaction.TransactionType().IsDebit() {
+			signed = -signed
+		}
+		if !transaction.Amount().Currency().Equals(account.Balance().Currency()) {
+			return nil, fmt.Errorf("failed to compute reconciliation balance: transaction %s is not in the account currency", transaction.ID().Value())
+		}
+		reconciled, err := sharedvalueobjects.NewMoney(summary.reconciledBalance.Amount()-signed, account.Balance().Currency())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute reconciliation balance: %w", err)
+		}
+		summary.reconciledBalance = reconciled
+
+		if transaction.Date().Format("2006-01-02") > statementDay {
+			continue
+		}
+		summary.transactions = append(summary.transactions, transaction)
+		if transaction.Status().IsCleared() {
+			summary.cleared = append(summary.cleared, transaction)
+			clearedTotal += signed
+		}
+	}
+
+	cleared, err := sharedvalueobjects.NewMoney(summary.reconciledBalance.Amount()+clearedTotal, account.Balance().Currency())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute reconciliation balance: %w", err)
+	}
+	summary.clearedBalance = cleared
+
+	return summary, nil
+}
+
+// findUserReconciliation loads a reconciliation and checks that it belongs to the user.
+func findUserReconciliation(
+	reconciliationRepository repositories.ReconciliationRepository,
+	userID identityvalueobjects.UserID,
+	rawReconciliationID string,
+) (*entities.Reconciliation, error) {
+	reconciliationID, err := transactionvalueobjects.NewReconciliationID(rawReconciliationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reconciliation ID: %w", err)
+	}
+
+	reconciliation, err := reconciliationRepository.FindByID(reconciliationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reconciliation: %w", err)
+	}
+	if reconciliation == nil {
+		return nil, fmt.Errorf("reconciliation not found: %s", reconciliationID.Value())
+	}
+	if !reconciliation.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("reconciliation does not belong to user")
+	}
+	return reconciliation, nil
+}
+
+// reconciliationOutput converts a reconciliation to its output DTO. The summary is only used
+// for reconciliations in progress; completed ones balance by definition.
+func reconciliationOutput(reconciliation *entities.Reconciliation, summary *reconciliationSummary) dtos.ReconciliationOutput {
+	statementBalance := reconciliation.StatementBalance()
+	output := dtos.ReconciliationOutput{
+		ReconciliationID: reconciliation.ID().Value(),
+		AccountID:        reconciliation.AccountID().Value(),
+		StatementDate:    reconciliation.StatementDate().Format("2006-01-02"),
+		StatementBalance: statementBalance.Float64(),
+		Currency:         statementBalance.Currency().Code(),
+		Status:           reconciliation.Status(),
+		ReconciledCount:  reconciliation.ReconciledCount(),
+		CreatedAt:        reconciliation.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	switch {
+	case reconciliation.IsInProgress() && summary != nil:
+		reconciledBalance := summary.reconciledBalance.Float64()
+		clearedBalance := summary.clearedBalance.Float64()
+		difference := float64(statementBalance.Amount()-summary.clearedBalance.Amount()) / 100
+		output.ReconciledBalance = &reconciledBalance
+		output.ClearedBalance = &clearedBalance
+		output.Difference = &difference
+		output.ClearedCount = len(summary.cleared)
+
+		output.Transactions = make([]dtos.ReconciliationTransactionOutput, 0, len(summary.transactions))
+		for _, transaction := range summary.transactions {
+			output.Transactions = append(output.Transactions, dtos.ReconciliationTransactionOutput{
+				TransactionID: transaction.ID().Value(),
+				Type:          transaction.TransactionType().Value(),
+				Amount:        transaction.Amount().Float64(),
+				Description:   transaction.Description().Value(),
+				Date:          transaction.Date().Format("2006-01-02"),
+				Status:        transaction.Status().Value(),
+			})
+		}
+	case reconciliation.IsCompleted():
+		balance := statementBalance.Float64()
+		difference := 0.0
+		output.ReconciledBalance = &balance
+		output.ClearedBalance = &balance
+		output.Difference = &difference
+	}
+
+	if reconciliation.CompletedAt() != nil {
+		output.CompletedAt = reconciliation.CompletedAt().Format("2006-01-02T15:04:05Z07:00")
+	}
+	if reconciliation.CanceledAt() != nil {
+		output.CanceledAt = reconciliation.CanceledAt().Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output
+}
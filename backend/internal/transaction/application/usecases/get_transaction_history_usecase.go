@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// GetTransactionHistoryUseCase handles retrieving the edit history of a transaction.
+type GetTransactionHistoryUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	revisionRepository    repositories.TransactionRevisionRepository
+}
+
+// NewGetTransactionHistoryUseCase creates a new GetTransactionHistoryUseCase instance.
+func NewGetTransactionHistoryUseCase(
+	transactionRepository repositories.TransactionRepository,
+	revisionRepository repositories.TransactionRevisionRepository,
+) *GetTransactionHistoryUseCase {
+	return &GetTransactionHistoryUseCase{
+		transactionRepository: transactionRepository,
+		revisionRepository:    revisionRepository,
+	}
+}
+
+// Execute returns the revisions of the transaction, oldest first. Deleted transactions keep
+// their history, so ownership is checked against the revisions when there are any.
+func (uc *GetTransactionHistoryUseCase) Execute(input dtos.GetTransactionHistoryInput) (*dtos.GetTransactionHistoryOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	transactionID, err := transactionvalueobjects.NewTransactionID(input.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	revisions, err := uc.revisionRepository.FindByTransactionID(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction history: %w", err)
+	}
+
+	if len(revisions) > 0 {
+		if !revisions[0].UserID().Equals(userID) {
+			return nil, apperrors.NewForbiddenError("transaction does not belong to user")
+		}
+	} else if _, err := findUserTransaction(uc.transactionRepository, userID, transactionID); err != nil {
+		// Transactions created before the history existed have no revisions yet
+		return nil, err
+	}
+
+	output := &dtos.GetTransactionHistoryOutput{
+		TransactionID: transactionID.Value(),
+		Revisions:     make([]*dtos.TransactionRevisionOutput, 0, len(revisions)),
+		Count:         len(revisions),
+	}
+	for _, revision := range revisions {
+		output.Revisions = append(output.Revisions, transactionRevisionOutput(revision))
+	}
+
+	return output, nil
+}
+
+// transactionRevisionOutput converts a transaction revision to its output DTO.
+func transactionRevisionOutput(revision *entities.TransactionRevision) *dtos.TransactionRevisionOutput {
+	output := &dtos.TransactionRevisionOutput{
+		RevisionID:    revision.ID().Value(),
+		TransactionID: revision.TransactionID().Value(),
+		Action:        revision.Action(),
+		RequestID:     revision.RequestID(),
+		Before:        transactionSnapshotOutput(revision.Before()),
+		After:         transactionSnapshotOutput(revision.After()),
+		ChangedFields: revision.ChangedFields(),
+		CreatedAt:     revision.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if revision.ActorID() != nil {
+		output.ActorID = revision.ActorID().Value()
+	}
+	if revision.RevertedRevisionID() != nil {
+		output.RevertedRevisionID = revision.RevertedRevisionID().Value()
+	}
+	return output
+}
+
+// transactionSnapshotOutput converts a transaction snapshot to its output DTO.
+func transactionSnapshotOutput(snapshot *entities.TransactionSnapshot) *dtos.TransactionSnapshotOutput {
+	if snapshot == nil {
+		return nil
+	}
+	return &dtos.TransactionSnapshotOutput{
+		AccountID:   snapshot.AccountID,
+		Type:        snapshot.Type,
+		Amount:      float64(snapshot.Amount) / 100.0,
+		Currency:    snapshot.Currency,
+		Description: snapshot.Description,
+		Date:        snapshot.Date.Format("2006-01-02"),
+		CategoryID:  snapshot.CategoryID,
+		TagIDs:      snapshot.TagIDs,
+		PayeeID:     snapshot.PayeeID,
+		Status:      snapshot.Status,
+	}
+}
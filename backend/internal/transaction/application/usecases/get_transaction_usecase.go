@@ -47,16 +47,27 @@ func (uc *GetTransactionUseCase) Execute(input dtos.GetTransactionInput) (*dtos.
 	// Convert to output DTO
 	amount := transaction.Amount()
 	output := &dtos.GetTransactionOutput{
-		TransactionID: transaction.ID().Value(),
-		UserID:        transaction.UserID().Value(),
-		AccountID:     transaction.AccountID().Value(),
-		Type:          transaction.TransactionType().Value(),
-		Amount:        amount.Float64(),
-		Currency:      amount.Currency().Code(),
-		Description:   transaction.Description().Value(),
-		Date:          transaction.Date().Format("2006-01-02"),
-		CreatedAt:     transaction.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:     transaction.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		TransactionID:       transaction.ID().Value(),
+		UserID:              transaction.UserID().Value(),
+		AccountID:           transaction.AccountID().Value(),
+		Type:                transaction.TransactionType().Value(),
+		Amount:              amount.Float64(),
+		Currency:            amount.Currency().Code(),
+		Description:         transaction.Description().Value(),
+		Date:                transaction.Date().Format("2006-01-02"),
+		CategoryID:          categoryIDValue(transaction),
+		LinkedTransactionID: linkedTransactionIDValue(transaction),
+		Splits:              splitOutputs(transaction),
+		TagIDs:              tagIDValues(transaction),
+		PayeeID:             payeeIDValue(transaction),
+		ImportBatchID:       importBatchIDValue(transaction),
+		Status:              transaction.Status().Value(),
+		ReconciliationID:    reconciliationIDValue(transaction),
+		Installment:         transactionInstallmentOutput(transaction),
+		CurrencyConversion:  currencyConversionOutput(transaction),
+		FeeForTransactionID: feeForTransactionIDValue(transaction),
+		CreatedAt:           transaction.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           transaction.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}
 
 	return output, nil
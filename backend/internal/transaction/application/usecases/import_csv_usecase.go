@@ -0,0 +1,222 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// ImportCSVUseCase imports a CSV bank statement into an account.
+// All rows are saved in a single UnitOfWork together with one balance update for the
+// net amount of the statement, and are recorded in an import batch so they can be rolled back.
+type ImportCSVUseCase struct {
+	unitOfWork      sharedrepositories.UnitOfWork
+	ruleRepository  repositories.TransactionRuleRepository
+	payeeRepository payeerepositories.PayeeRepository
+	eventBus        *eventbus.EventBus
+}
+
+// NewImportCSVUseCase creates a new ImportCSVUseCase instance.
+// ruleRepository may be nil, in which case no auto-categorization rules run, and
+// payeeRepository may be nil, in which case no payees are matched.
+func NewImportCSVUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	ruleRepository repositories.TransactionRuleRepository,
+	payeeRepository payeerepositories.PayeeRepository,
+	eventBus *eventbus.EventBus,
+) *ImportCSVUseCase {
+	return &ImportCSVUseCase{
+		unitOfWork:      unitOfWork,
+		ruleRepository:  ruleRepository,
+		payeeRepository: payeeRepository,
+		eventBus:        eventBus,
+	}
+}
+
+// Execute parses the statement and imports its rows.
+// Rows with errors make the whole import fail unless SkipInvalidRows is set.
+func (uc *ImportCSVUseCase) Execute(input dtos.CSVImportInput) (*dtos.ImportCSVOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	account, err := findUserAccount(uc.unitOfWork.AccountRepository(), userID, accountID, "target")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := parseCSVStatement(input, account.Balance().Currency())
+	if err != nil {
+		return nil, err
+	}
+	if len(rowErrors) > 0 && !input.SkipInvalidRows {
+		return nil, fmt.Errorf("invalid statement: %d row(s) have errors; fix them or set skip_invalid_rows (first error on line %d: %s)", len(rowErrors), rowErrors[0].Line, rowErrors[0].Message)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("invalid statement: no valid rows to import")
+	}
+	if err := applyRulesToRows(uc.ruleRepository, userID, accountID, rows); err != nil {
+		return nil, err
+	}
+	if err := applyPayeesToRows(uc.payeeRepository, userID, rows); err != nil {
+		return nil, err
+	}
+	possibleDuplicates := flagPossibleDuplicates(uc.unitOfWork.TransactionRepository(), userID, accountID, rows)
+
+	batch, netAmount, err := importStatementRows(uc.unitOfWork, account, entities.ImportSourceCSV, input.FileName, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, event := range batch.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	batch.ClearEvents()
+
+	output := &dtos.ImportCSVOutput{
+		BatchID:            batch.ID().Value(),
+		AccountID:          accountID.Value(),
+		TransactionCount:   batch.TransactionCount(),
+		PossibleDuplicates: possibleDuplicates,
+		Currency:           netAmount.Currency().Code(),
+		NetAmount:          netAmount.Float64(),
+		AccountBalance:     account.Balance().Float64(),
+		CreatedAt:          batch.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if len(rowErrors) > 0 {
+		output.SkippedRows = rowErrors
+	}
+
+	return output, nil
+}
+
+// importStatementRows creates the import batch and one transaction per row, and applies the net
+// amount of the rows to the account balance with a single credit or debit. It must be called inside
+// an open UnitOfWork transaction. Returns the batch and the signed net amount.
+//
+// The transactions' TransactionCreated events are dropped on purpose: the balance is updated here
+// once for the whole batch, and publishing them would apply every row to the balance again.
+func importStatementRows(
+	unitOfWork sharedrepositories.UnitOfWork,
+	account *accountentities.Account,
+	source string,
+	fileName string,
+	rows []importedRow,
+) (*entities.ImportBatch, sharedvalueobjects.Money, error) {
+	currency := account.Balance().Currency()
+	netAmount := sharedvalueobjects.Zero(currency)
+
+	batch, err := entities.NewImportBatch(account.UserID(), account.ID(), source, fileName, len(rows))
+	if err != nil {
+		return nil, netAmount, fmt.Errorf("failed to create import batch: %w", err)
+	}
+
+	// Save the batch first: imported transactions reference it
+	if err := unitOfWork.ImportBatchRepository().Save(batch); err != nil {
+		return nil, netAmount, fmt.Errorf("failed to save import batch: %w", err)
+	}
+
+	transactionRepository := unitOfWork.TransactionRepository()
+	for _, row := range rows {
+		transaction, err := entities.NewTransaction(account.UserID(), account.ID(), row.transactionType, row.amount, row.description, row.date)
+		if err != nil {
+			return nil, netAmount, fmt.Errorf("failed to create transaction for line %d: %w", row.line, err)
+		}
+		if err := transaction.AttachToImportBatch(batch.ID()); err != nil {
+			return nil, netAmount, fmt.Errorf("failed to create transaction for line %d: %w", row.line, err)
+		}
+		// Statement entries have already cleared the bank
+		if err := transaction.UpdateStatus(transactionvalueobjects.ClearedStatus()); err != nil {
+			return nil, netAmount, fmt.Errorf("failed to create transaction for line %d: %w", row.line, err)
+		}
+		if row.externalID != "" {
+			if err := transaction.AssignExternalID(row.externalID); err != nil {
+				return nil, netAmount, fmt.Errorf("failed to create transaction for line %d: %w", row.line, err)
+			}
+		}
+		if row.categoryID != nil {
+			if err := transaction.UpdateCategory(row.categoryID); err != nil {
+				return nil, netAmount, fmt.Errorf("failed to create transaction for line %d: %w", row.line, err)
+			}
+		}
+		if len(row.tagIDs) > 0 {
+			if err := transaction.UpdateTags(row.tagIDs); err != nil {
+				return nil, netAmount, fmt.Errorf("failed to create transaction for line %d: %w", row.line, err)
+			}
+		}
+		if row.payeeID != nil {
+			if err := transaction.UpdatePayee(row.payeeID); err != nil {
+				return nil, netAmount, fmt.Errorf("failed to create transaction for line %d: %w", row.line, err)
+			}
+		}
+		transaction.ClearEvents()
+
+		if err := transactionRepository.Save(transaction); err != nil {
+			return nil, netAmount, fmt.Errorf("failed to save transaction for line %d: %w", row.line, err)
+		}
+
+		if row.transactionType.IsCredit() {
+			netAmount, err = netAmount.Add(row.amount)
+		} else {
+			netAmount, err = netAmount.Subtract(row.amount)
+		}
+		if err != nil {
+			return nil, netAmount, fmt.Errorf("failed to compute statement total: %w", err)
+		}
+	}
+
+	// Apply the whole statement to the account balance at once
+	if netAmount.IsPositive() {
+		if err := account.Credit(netAmount); err != nil {
+			return nil, netAmount, fmt.Errorf("failed to credit account: %w", err)
+		}
+	} else if netAmount.IsNegative() {
+		if err := account.Debit(netAmount.Negate()); err != nil {
+			return nil, netAmount, fmt.Errorf("failed to debit account: %w", err)
+		}
+	}
+	if err := unitOfWork.AccountRepository().Save(account); err != nil {
+		return nil, netAmount, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	return batch, netAmount, nil
+}
@@ -0,0 +1,220 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// testStatement is a semicolon separated statement with Brazilian number and date formats.
+// Line 4 has an invalid amount.
+const testStatement = "Data;Histórico;Valor\n" +
+	"01/10/2026;Salário;3.000,00\n" +
+	"02/10/2026;Supermercado;-450,25\n" +
+	"03/10/2026;Farmácia;abc\n" +
+	"04/10/2026;Conta de luz;-149,75\n"
+
+// testCSVImportInput builds an import input for testStatement.
+func testCSVImportInput(userID identityvalueobjects.UserID, accountID accountvalueobjects.AccountID) dtos.CSVImportInput {
+	return dtos.CSVImportInput{
+		UserID:            userID.Value(),
+		AccountID:         accountID.Value(),
+		FileName:          "extrato.csv",
+		Content:           []byte(testStatement),
+		Delimiter:         ";",
+		DateColumn:        "Data",
+		DateFormat:        "DD/MM/YYYY",
+		AmountColumn:      "Valor",
+		DescriptionColumn: "Histórico",
+		DecimalSeparator:  ",",
+	}
+}
+
+// setupImportTest creates a UnitOfWork mock with one BRL account holding the given balance.
+func setupImportTest(t *testing.T, userID identityvalueobjects.UserID, accountID accountvalueobjects.AccountID, balanceCents int64) (*mockUnitOfWork, *mockTransactionRepository, *mockAccountRepository) {
+	t.Helper()
+
+	brl, _ := sharedvalueobjects.NewCurrency("BRL")
+	balance, _ := sharedvalueobjects.NewMoney(balanceCents, brl)
+	account, err := createTestAccountWithID(userID, accountID, balance)
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	txRepo := newMockTransactionRepository()
+	accRepo := newMockAccountRepository()
+	_ = accRepo.Save(account)
+
+	return newMockUnitOfWork(txRepo, accRepo), txRepo, accRepo
+}
+
+func TestPreviewCSVImportUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, _ := setupImportTest(t, userID, accountID, 0)
+
+	output, err := NewPreviewCSVImportUseCase(uow, nil, nil).Execute(testCSVImportInput(userID, accountID))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.ValidCount != 3 || output.ErrorCount != 1 {
+		t.Fatalf("expected 3 valid rows and 1 error, got %d and %d", output.ValidCount, output.ErrorCount)
+	}
+	if output.Errors[0].Line != 4 {
+		t.Errorf("expected error on line 4, got %d", output.Errors[0].Line)
+	}
+	if output.Rows[1].Type != transactionvalueobjects.Expense || output.Rows[1].Amount != 450.25 {
+		t.Errorf("expected EXPENSE 450.25 on second row, got %s %.2f", output.Rows[1].Type, output.Rows[1].Amount)
+	}
+	if output.TotalIncome != 3000.00 || output.TotalExpense != 600.00 || output.NetAmount != 2400.00 {
+		t.Errorf("unexpected totals: income %.2f, expense %.2f, net %.2f", output.TotalIncome, output.TotalExpense, output.NetAmount)
+	}
+	if output.Currency != "BRL" {
+		t.Errorf("expected currency BRL, got %s", output.Currency)
+	}
+	if len(txRepo.transactions) != 0 {
+		t.Errorf("expected preview not to save transactions, got %d", len(txRepo.transactions))
+	}
+}
+
+func TestPreviewCSVImportUseCase_Execute_AccountOfAnotherUser(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, _, _ := setupImportTest(t, identityvalueobjects.GenerateUserID(), accountID, 0)
+
+	_, err := NewPreviewCSVImportUseCase(uow, nil, nil).Execute(testCSVImportInput(userID, accountID))
+	if err == nil || !strings.Contains(err.Error(), "does not belong to user") {
+		t.Fatalf("expected ownership error, got %v", err)
+	}
+}
+
+func TestImportCSVUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	t.Run("rows with errors are rejected by default", func(t *testing.T) {
+		uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 10000)
+
+		_, err := NewImportCSVUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(testCSVImportInput(userID, accountID))
+		if err == nil || !strings.Contains(err.Error(), "line 4") {
+			t.Fatalf("expected error mentioning line 4, got %v", err)
+		}
+		if len(txRepo.transactions) != 0 {
+			t.Errorf("expected no transactions to be saved, got %d", len(txRepo.transactions))
+		}
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 10000 {
+			t.Errorf("expected balance to stay 10000, got %d", account.Balance().Amount())
+		}
+	})
+
+	t.Run("skip invalid rows", func(t *testing.T) {
+		uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 10000)
+		input := testCSVImportInput(userID, accountID)
+		input.SkipInvalidRows = true
+
+		output, err := NewImportCSVUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if output.TransactionCount != 3 || len(txRepo.transactions) != 3 {
+			t.Fatalf("expected 3 imported transactions, got %d (saved %d)", output.TransactionCount, len(txRepo.transactions))
+		}
+		if len(output.SkippedRows) != 1 || output.SkippedRows[0].Line != 4 {
+			t.Errorf("expected line 4 to be skipped, got %+v", output.SkippedRows)
+		}
+
+		// 100.00 + 3000.00 - 450.25 - 149.75
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 250000 {
+			t.Errorf("expected balance 250000, got %d", account.Balance().Amount())
+		}
+		if output.NetAmount != 2400.00 || output.AccountBalance != 2500.00 {
+			t.Errorf("expected net 2400.00 and balance 2500.00, got %.2f and %.2f", output.NetAmount, output.AccountBalance)
+		}
+
+		batchID, _ := transactionvalueobjects.NewImportBatchID(output.BatchID)
+		batch, _ := uow.importBatchRepository.FindByID(batchID)
+		if batch == nil || batch.Source() != entities.ImportSourceCSV || batch.FileName() != "extrato.csv" {
+			t.Fatalf("expected CSV import batch for extrato.csv, got %+v", batch)
+		}
+		imported, _ := txRepo.FindByImportBatchID(batchID)
+		if len(imported) != 3 {
+			t.Errorf("expected 3 transactions in the batch, got %d", len(imported))
+		}
+	})
+
+	t.Run("insufficient balance for the statement", func(t *testing.T) {
+		uow, _, accRepo := setupImportTest(t, userID, accountID, 0)
+		input := testCSVImportInput(userID, accountID)
+		input.Content = []byte("Data;Histórico;Valor\n01/10/2026;Aluguel;-1.500,00\n")
+
+		_, err := NewImportCSVUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(input)
+		if err == nil || !strings.Contains(err.Error(), "insufficient balance") {
+			t.Fatalf("expected insufficient balance error, got %v", err)
+		}
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 0 {
+			t.Errorf("expected balance to stay 0, got %d", account.Balance().Amount())
+		}
+	})
+}
+
+func TestRollbackImportBatchUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 10000)
+
+	input := testCSVImportInput(userID, accountID)
+	input.SkipInvalidRows = true
+	imported, err := NewImportCSVUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(input)
+	if err != nil {
+		t.Fatalf("failed to import statement: %v", err)
+	}
+
+	useCase := NewRollbackImportBatchUseCase(uow, eventbus.NewEventBus())
+
+	t.Run("another user", func(t *testing.T) {
+		_, err := useCase.Execute(dtos.RollbackImportBatchInput{
+			UserID:  identityvalueobjects.GenerateUserID().Value(),
+			BatchID: imported.BatchID,
+		})
+		if err == nil || !strings.Contains(err.Error(), "does not belong to user") {
+			t.Fatalf("expected ownership error, got %v", err)
+		}
+	})
+
+	t.Run("rolls back the whole batch", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.RollbackImportBatchInput{UserID: userID.Value(), BatchID: imported.BatchID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if output.RemovedTransactions != 3 || output.Status != entities.ImportBatchStatusRolledBack {
+			t.Errorf("expected 3 removed transactions and ROLLED_BACK, got %d and %s", output.RemovedTransactions, output.Status)
+		}
+		if len(txRepo.transactions) != 0 {
+			t.Errorf("expected all imported transactions to be deleted, got %d", len(txRepo.transactions))
+		}
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 10000 {
+			t.Errorf("expected balance back to 10000, got %d", account.Balance().Amount())
+		}
+	})
+
+	t.Run("cannot roll back twice", func(t *testing.T) {
+		_, err := useCase.Execute(dtos.RollbackImportBatchInput{UserID: userID.Value(), BatchID: imported.BatchID})
+		if err == nil || !strings.Contains(err.Error(), "twice") {
+			t.Fatalf("expected rolled back twice error, got %v", err)
+		}
+	})
+}
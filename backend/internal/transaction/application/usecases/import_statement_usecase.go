@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// ImportStatementUseCase imports an OFX or QIF bank statement into an account.
+// Entries whose bank identifier (FITID) was already imported into the account are skipped, so the
+// same statement, or overlapping statements, can be imported more than once. The new entries are
+// saved like a CSV import: in a single UnitOfWork, with one balance update and an import batch.
+type ImportStatementUseCase struct {
+	unitOfWork      sharedrepositories.UnitOfWork
+	ruleRepository  repositories.TransactionRuleRepository
+	payeeRepository payeerepositories.PayeeRepository
+	eventBus        *eventbus.EventBus
+}
+
+// NewImportStatementUseCase creates a new ImportStatementUseCase instance.
+// ruleRepository may be nil, in which case no auto-categorization rules run, and
+// payeeRepository may be nil, in which case no payees are matched.
+func NewImportStatementUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	ruleRepository repositories.TransactionRuleRepository,
+	payeeRepository payeerepositories.PayeeRepository,
+	eventBus *eventbus.EventBus,
+) *ImportStatementUseCase {
+	return &ImportStatementUseCase{
+		unitOfWork:      unitOfWork,
+		ruleRepository:  ruleRepository,
+		payeeRepository: payeeRepository,
+		eventBus:        eventBus,
+	}
+}
+
+// Execute parses the statement and imports its new entries.
+// Entries with errors make the whole import fail unless SkipInvalidRows is set. With
+// ReconcileBalance set, the import also fails when the account balance after the import
+// differs from the statement ledger balance.
+func (uc *ImportStatementUseCase) Execute(input dtos.StatementImportInput) (*dtos.ImportStatementOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	account, err := findUserAccount(uc.unitOfWork.AccountRepository(), userID, accountID, "target")
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := parseStatementImport(input, account, uc.unitOfWork.TransactionRepository())
+	if err != nil {
+		return nil, err
+	}
+	if len(statement.rowErrors) > 0 && !input.SkipInvalidRows {
+		first := statement.rowErrors[0]
+		return nil, fmt.Errorf("invalid statement: %d entry(ies) have errors; fix them or set skip_invalid_rows (first error on line %d: %s)", len(statement.rowErrors), first.Line, first.Message)
+	}
+	if input.ReconcileBalance && statement.ledgerBalance == nil {
+		return nil, errors.New("invalid statement: reconcile_balance requires a statement with a ledger balance (OFX LEDGERBAL)")
+	}
+	if len(statement.rows) == 0 {
+		if len(statement.duplicates) > 0 {
+			return nil, fmt.Errorf("duplicate statement: all %d entries were already imported into the account", len(statement.duplicates))
+		}
+		return nil, errors.New("invalid statement: no valid entries to import")
+	}
+
+	// Reconcile before saving anything: the balance after the import is known up front
+	reconciliation := statement.reconciliation(account.Balance().Amount() + statement.netCents())
+	if input.ReconcileBalance && !reconciliation.Reconciled {
+		return nil, fmt.Errorf("failed to reconcile statement: account balance after import would be %.2f but the statement balance on %s is %.2f",
+			reconciliation.AccountBalance, reconciliation.StatementDate, reconciliation.StatementBalance)
+	}
+
+	if err := applyRulesToRows(uc.ruleRepository, userID, accountID, statement.rows); err != nil {
+		return nil, err
+	}
+	if err := applyPayeesToRows(uc.payeeRepository, userID, statement.rows); err != nil {
+		return nil, err
+	}
+	possibleDuplicates := flagPossibleDuplicates(uc.unitOfWork.TransactionRepository(), userID, accountID, statement.rows)
+
+	batch, netAmount, err := importStatementRows(uc.unitOfWork, account, statement.format, input.FileName, statement.rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, event := range batch.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	batch.ClearEvents()
+
+	output := &dtos.ImportStatementOutput{
+		BatchID:            batch.ID().Value(),
+		AccountID:          accountID.Value(),
+		Format:             statement.format,
+		TransactionCount:   batch.TransactionCount(),
+		DuplicateCount:     len(statement.duplicates),
+		PossibleDuplicates: possibleDuplicates,
+		Currency:           netAmount.Currency().Code(),
+		NetAmount:          netAmount.Float64(),
+		AccountBalance:     account.Balance().Float64(),
+		Reconciliation:     reconciliation,
+		CreatedAt:          batch.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if len(statement.rowErrors) > 0 {
+		output.SkippedRows = statement.rowErrors
+	}
+
+	return output, nil
+}
@@ -0,0 +1,185 @@
+package usecases
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+)
+
+// testOFXStatementInput builds an import input for the OFX 1.x fixture of the importers package.
+// The fixture has 3 valid entries (net 2400.00), one entry with an invalid date and a ledger
+// balance of 2500.00.
+func testOFXStatementInput(t *testing.T, userID identityvalueobjects.UserID, accountID accountvalueobjects.AccountID) dtos.StatementImportInput {
+	t.Helper()
+
+	content, err := os.ReadFile("../../infrastructure/importers/testdata/statement_sgml.ofx")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	return dtos.StatementImportInput{
+		UserID:          userID.Value(),
+		AccountID:       accountID.Value(),
+		FileName:        "extrato.ofx",
+		Content:         content,
+		SkipInvalidRows: true,
+	}
+}
+
+func TestPreviewStatementImportUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, _ := setupImportTest(t, userID, accountID, 10000)
+
+	output, err := NewPreviewStatementImportUseCase(uow, nil, nil).Execute(testOFXStatementInput(t, userID, accountID))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Format != entities.ImportSourceOFX {
+		t.Errorf("expected format OFX, got %s", output.Format)
+	}
+	if output.ValidCount != 3 || output.ErrorCount != 1 || output.DuplicateCount != 0 {
+		t.Fatalf("expected 3 valid, 1 error and 0 duplicates, got %d, %d and %d", output.ValidCount, output.ErrorCount, output.DuplicateCount)
+	}
+	if output.Rows[0].ExternalID != "20261001001" {
+		t.Errorf("expected FITID 20261001001 on first row, got %s", output.Rows[0].ExternalID)
+	}
+	if output.NetAmount != 2400.00 {
+		t.Errorf("expected net 2400.00, got %.2f", output.NetAmount)
+	}
+	if output.Reconciliation == nil || !output.Reconciliation.Reconciled || output.Reconciliation.AccountBalance != 2500.00 {
+		t.Errorf("expected reconciled balance 2500.00, got %+v", output.Reconciliation)
+	}
+	if len(txRepo.transactions) != 0 {
+		t.Errorf("expected preview not to save transactions, got %d", len(txRepo.transactions))
+	}
+}
+
+func TestImportStatementUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	t.Run("imports new entries and keeps their FITID", func(t *testing.T) {
+		uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 10000)
+		input := testOFXStatementInput(t, userID, accountID)
+		input.ReconcileBalance = true
+
+		output, err := NewImportStatementUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if output.TransactionCount != 3 || len(txRepo.transactions) != 3 {
+			t.Fatalf("expected 3 imported transactions, got %d (saved %d)", output.TransactionCount, len(txRepo.transactions))
+		}
+		if output.Reconciliation == nil || !output.Reconciliation.Reconciled {
+			t.Errorf("expected statement to be reconciled, got %+v", output.Reconciliation)
+		}
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 250000 {
+			t.Errorf("expected balance 250000, got %d", account.Balance().Amount())
+		}
+		for _, tx := range txRepo.transactions {
+			if tx.ExternalID() == "" {
+				t.Errorf("expected transaction %s to keep its FITID", tx.ID().Value())
+			}
+		}
+
+		// Importing the same statement again finds only duplicates
+		_, err = NewImportStatementUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(input)
+		if err == nil || !strings.Contains(err.Error(), "already imported") {
+			t.Fatalf("expected duplicate statement error, got %v", err)
+		}
+		if len(txRepo.transactions) != 3 {
+			t.Errorf("expected no new transactions, got %d", len(txRepo.transactions))
+		}
+	})
+
+	t.Run("balance does not reconcile", func(t *testing.T) {
+		uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 0)
+		input := testOFXStatementInput(t, userID, accountID)
+		input.ReconcileBalance = true
+
+		_, err := NewImportStatementUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(input)
+		if err == nil || !strings.Contains(err.Error(), "failed to reconcile") {
+			t.Fatalf("expected reconciliation error, got %v", err)
+		}
+		if len(txRepo.transactions) != 0 {
+			t.Errorf("expected no transactions to be saved, got %d", len(txRepo.transactions))
+		}
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 0 {
+			t.Errorf("expected balance to stay 0, got %d", account.Balance().Amount())
+		}
+	})
+
+	t.Run("rolled back entries can be imported again", func(t *testing.T) {
+		uow, txRepo, _ := setupImportTest(t, userID, accountID, 10000)
+		useCase := NewImportStatementUseCase(uow, nil, nil, eventbus.NewEventBus())
+
+		first, err := useCase.Execute(testOFXStatementInput(t, userID, accountID))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := NewRollbackImportBatchUseCase(uow, eventbus.NewEventBus()).Execute(dtos.RollbackImportBatchInput{
+			UserID:  userID.Value(),
+			BatchID: first.BatchID,
+		}); err != nil {
+			t.Fatalf("failed to roll back import: %v", err)
+		}
+
+		second, err := useCase.Execute(testOFXStatementInput(t, userID, accountID))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if second.TransactionCount != 3 || second.DuplicateCount != 0 || len(txRepo.transactions) != 3 {
+			t.Errorf("expected 3 transactions imported again, got %d (duplicates %d)", second.TransactionCount, second.DuplicateCount)
+		}
+	})
+
+	t.Run("currency must match the account", func(t *testing.T) {
+		uow, _, _ := setupImportTest(t, userID, accountID, 10000)
+		input := testOFXStatementInput(t, userID, accountID)
+		input.Content = []byte(strings.Replace(string(input.Content), "<CURDEF>BRL", "<CURDEF>USD", 1))
+
+		_, err := NewImportStatementUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(input)
+		if err == nil || !strings.Contains(err.Error(), "does not match account currency") {
+			t.Fatalf("expected currency error, got %v", err)
+		}
+	})
+}
+
+func TestStatementFormat(t *testing.T) {
+	tests := []struct {
+		format   string
+		fileName string
+		want     string
+		wantErr  bool
+	}{
+		{format: "", fileName: "extrato.OFX", want: entities.ImportSourceOFX},
+		{format: "", fileName: "quicken.qif", want: entities.ImportSourceQIF},
+		{format: "qif", fileName: "extrato.txt", want: entities.ImportSourceQIF},
+		{format: "", fileName: "extrato", wantErr: true},
+		{format: "CSV", fileName: "extrato.csv", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := statementFormat(tt.format, tt.fileName)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("statementFormat(%q, %q) expected error", tt.format, tt.fileName)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("statementFormat(%q, %q) = %q, %v; want %q", tt.format, tt.fileName, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+)
+
+// installmentTestSetup holds a credit card account with 5000.00 available.
+type installmentTestSetup struct {
+	uow       *mockUnitOfWork
+	txRepo    *mockTransactionRepository
+	accRepo   *mockAccountRepository
+	userID    identityvalueobjects.UserID
+	accountID accountvalueobjects.AccountID
+}
+
+func setupInstallmentTest(t *testing.T) *installmentTestSetup {
+	t.Helper()
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	name, _ := accountvalueobjects.NewAccountName("Cartão Nubank")
+	balance, _ := sharedvalueobjects.NewMoney(500000, sharedvalueobjects.MustCurrency("BRL"))
+	account, err := accountentities.AccountFromPersistence(accountID, userID, name, accountvalueobjects.CreditCardType(), balance, sharedvalueobjects.PersonalContext(), time.Now(), time.Now(), true)
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	txRepo := newMockTransactionRepository()
+	accRepo := newMockAccountRepository()
+	_ = accRepo.Save(account)
+
+	return &installmentTestSetup{
+		uow:       newMockUnitOfWork(txRepo, accRepo),
+		txRepo:    txRepo,
+		accRepo:   accRepo,
+		userID:    userID,
+		accountID: accountID,
+	}
+}
+
+// createPurchase creates an installment purchase on the test card.
+func (s *installmentTestSetup) createPurchase(t *testing.T, amount float64, installments int, firstDate string) *dtos.InstallmentPurchaseOutput {
+	t.Helper()
+
+	output, err := NewCreateInstallmentPurchaseUseCase(s.uow, nil, nil, eventbus.NewEventBus()).Execute(dtos.CreateInstallmentPurchaseInput{
+		UserID:       s.userID.Value(),
+		AccountID:    s.accountID.Value(),
+		Amount:       amount,
+		Currency:     "BRL",
+		Description:  "Notebook",
+		FirstDate:    firstDate,
+		Installments: installments,
+	})
+	if err != nil {
+		t.Fatalf("failed to create installment purchase: %v", err)
+	}
+	return output
+}
+
+// balanceCents returns the current balance of the test card in cents.
+func (s *installmentTestSetup) balanceCents(t *testing.T) int64 {
+	t.Helper()
+
+	account, _ := s.accRepo.FindByID(s.accountID)
+	return account.Balance().Amount()
+}
+
+func TestCreateInstallmentPurchaseUseCase_Execute(t *testing.T) {
+	s := setupInstallmentTest(t)
+
+	output := s.createPurchase(t, 100, 3, "2026-01-31")
+
+	if output.InstallmentCount != 3 || len(output.Installments) != 3 {
+		t.Fatalf("expected 3 installments, got %d (%d listed)", output.InstallmentCount, len(output.Installments))
+	}
+	wantAmounts := []float64{33.34, 33.33, 33.33}
+	wantDates := []string{"2026-01-31", "2026-02-28", "2026-03-31"}
+	for i, installment := range output.Installments {
+		if installment.Amount != wantAmounts[i] || installment.Date != wantDates[i] {
+			t.Errorf("installment %s = %.2f on %s, want %.2f on %s", installment.Label, installment.Amount, installment.Date, wantAmounts[i], wantDates[i])
+		}
+	}
+	if output.PurchaseID != output.Installments[0].TransactionID {
+		t.Errorf("expected the purchase to be identified by its first installment")
+	}
+	if output.TotalAmount != 100 || output.InterestAmount == nil || *output.InterestAmount != 0 {
+		t.Errorf("expected total 100.00 without interest, got %.2f (interest %v)", output.TotalAmount, output.InterestAmount)
+	}
+	if got := s.balanceCents(t); got != 490000 {
+		t.Errorf("expected the whole purchase to be debited, balance = %d", got)
+	}
+
+	// Individual installments expose their position
+	transaction, _ := NewGetTransactionUseCase(s.txRepo).Execute(dtos.GetTransactionInput{TransactionID: output.Installments[1].TransactionID})
+	if transaction.Installment == nil || transaction.Installment.Label != "2/3" || transaction.Installment.PurchaseID != output.PurchaseID {
+		t.Errorf("expected the transaction to be installment 2/3 of the purchase, got %+v", transaction.Installment)
+	}
+}
+
+func TestCreateInstallmentPurchaseUseCase_Execute_WithInterest(t *testing.T) {
+	s := setupInstallmentTest(t)
+
+	output, err := NewCreateInstallmentPurchaseUseCase(s.uow, nil, nil, eventbus.NewEventBus()).Execute(dtos.CreateInstallmentPurchaseInput{
+		UserID:       s.userID.Value(),
+		AccountID:    s.accountID.Value(),
+		Amount:       1000,
+		Currency:     "BRL",
+		Description:  "Geladeira",
+		FirstDate:    "2026-10-10",
+		Installments: 10,
+		InterestRate: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Installments[0].Amount != 111.33 || output.Installments[9].Amount != 111.30 || output.TotalAmount != 1113.27 {
+		t.Errorf("expected 9 installments of 111.33 and a last one of 111.30, got %.2f and %.2f (total %.2f)",
+			output.Installments[0].Amount, output.Installments[9].Amount, output.TotalAmount)
+	}
+	if output.Price == nil || *output.Price != 1000 || output.InterestAmount == nil || *output.InterestAmount != 113.27 {
+		t.Errorf("expected price 1000.00 and interest 113.27, got %v and %v", output.Price, output.InterestAmount)
+	}
+}
+
+func TestCreateInstallmentPurchaseUseCase_Execute_RequiresCreditCard(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, _ := setupImportTest(t, userID, accountID, 500000)
+
+	_, err := NewCreateInstallmentPurchaseUseCase(uow, nil, nil, eventbus.NewEventBus()).Execute(dtos.CreateInstallmentPurchaseInput{
+		UserID:       userID.Value(),
+		AccountID:    accountID.Value(),
+		Amount:       100,
+		Currency:     "BRL",
+		Description:  "Notebook",
+		FirstDate:    "2026-10-10",
+		Installments: 3,
+	})
+	if err == nil || !strings.Contains(err.Error(), "credit card") {
+		t.Fatalf("expected credit card error, got %v", err)
+	}
+	if len(txRepo.transactions) != 0 {
+		t.Errorf("expected no installments to be saved, got %d", len(txRepo.transactions))
+	}
+}
+
+func TestGetInstallmentPurchaseUseCase_Execute(t *testing.T) {
+	s := setupInstallmentTest(t)
+	created := s.createPurchase(t, 100, 3, "2026-01-31")
+
+	// Any installment identifies the purchase
+	output, err := NewGetInstallmentPurchaseUseCase(s.txRepo).Execute(dtos.GetInstallmentPurchaseInput{
+		UserID:        s.userID.Value(),
+		TransactionID: created.Installments[2].TransactionID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.PurchaseID != created.PurchaseID || len(output.Installments) != 3 || output.Installments[2].Label != "3/3" {
+		t.Errorf("expected the purchase with 3 installments, got %+v", output)
+	}
+
+	other := saveTestExpense(t, s.txRepo, s.userID, s.accountID, 1000, "Padaria", time.Now())
+	_, err = NewGetInstallmentPurchaseUseCase(s.txRepo).Execute(dtos.GetInstallmentPurchaseInput{
+		UserID:        s.userID.Value(),
+		TransactionID: other.ID().Value(),
+	})
+	if err == nil || !strings.Contains(err.Error(), "not an installment") {
+		t.Errorf("expected not an installment error, got %v", err)
+	}
+
+	_, err = NewGetInstallmentPurchaseUseCase(s.txRepo).Execute(dtos.GetInstallmentPurchaseInput{
+		UserID:        identityvalueobjects.GenerateUserID().Value(),
+		TransactionID: created.PurchaseID,
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not belong to user") {
+		t.Errorf("expected ownership error, got %v", err)
+	}
+}
+
+func TestCancelInstallmentPurchaseUseCase_Execute(t *testing.T) {
+	s := setupInstallmentTest(t)
+	created := s.createPurchase(t, 100, 3, "2026-01-31")
+
+	output, err := NewCancelInstallmentPurchaseUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.CancelInstallmentPurchaseInput{
+		UserID:        s.userID.Value(),
+		TransactionID: created.PurchaseID,
+		Date:          "2026-02-15",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.CanceledCount != 2 || output.CanceledAmount != 66.66 {
+		t.Errorf("expected 2 installments (66.66) canceled, got %d (%.2f)", output.CanceledCount, output.CanceledAmount)
+	}
+	if len(output.Purchase.Installments) != 1 || output.Purchase.Installments[0].Label != "1/3" {
+		t.Errorf("expected only the first installment to be kept, got %+v", output.Purchase.Installments)
+	}
+	if len(s.txRepo.transactions) != 1 {
+		t.Errorf("expected the canceled installments to be deleted, %d left", len(s.txRepo.transactions))
+	}
+	if got := s.balanceCents(t); got != 500000-3334 {
+		t.Errorf("expected the canceled installments to be credited back, balance = %d", got)
+	}
+
+	// Nothing left to cancel
+	_, err = NewCancelInstallmentPurchaseUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.CancelInstallmentPurchaseInput{
+		UserID:        s.userID.Value(),
+		TransactionID: created.PurchaseID,
+		Date:          "2026-02-15",
+	})
+	if err == nil || !strings.Contains(err.Error(), "no installments due after") {
+		t.Errorf("expected no installments error, got %v", err)
+	}
+}
+
+func TestPrepayInstallmentsUseCase_Execute(t *testing.T) {
+	s := setupInstallmentTest(t)
+	created := s.createPurchase(t, 1000, 10, "2026-01-31")
+
+	// Installments 3/10 (2026-03-31) to 10/10 are prepaid with a 40.00 discount
+	amount := 760.0
+	output, err := NewPrepayInstallmentsUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.PrepayInstallmentsInput{
+		UserID:        s.userID.Value(),
+		TransactionID: created.PurchaseID,
+		Date:          "2026-03-15",
+		Amount:        &amount,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.PrepaidCount != 8 || output.PrepaidAmount != 760 || output.DiscountAmount != 40 {
+		t.Errorf("expected 8 installments prepaid for 760.00 (40.00 discount), got %d for %.2f (%.2f)", output.PrepaidCount, output.PrepaidAmount, output.DiscountAmount)
+	}
+	for _, installment := range output.Purchase.Installments[2:] {
+		if installment.Date != "2026-03-15" || installment.Amount != 95 {
+			t.Errorf("expected installment %s to be 95.00 on 2026-03-15, got %.2f on %s", installment.Label, installment.Amount, installment.Date)
+		}
+	}
+	if output.Purchase.Installments[1].Date != "2026-02-28" || output.Purchase.Installments[1].Amount != 100 {
+		t.Errorf("expected installment 2/10 to be unchanged, got %+v", output.Purchase.Installments[1])
+	}
+	if got := s.balanceCents(t); got != 500000-100000+4000 {
+		t.Errorf("expected the discount to be credited back, balance = %d", got)
+	}
+
+	// Paying more than what is left is rejected
+	tooMuch := 2000.0
+	_, err = NewPrepayInstallmentsUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.PrepayInstallmentsInput{
+		UserID:        s.userID.Value(),
+		TransactionID: created.PurchaseID,
+		Date:          "2026-01-31",
+		Amount:        &tooMuch,
+	})
+	if err == nil || !strings.Contains(err.Error(), "must be at most the remaining total") {
+		t.Errorf("expected remaining total error, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// ListAttachmentsUseCase handles listing the files attached to a transaction.
+type ListAttachmentsUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	attachmentRepository  repositories.AttachmentRepository
+}
+
+// NewListAttachmentsUseCase creates a new ListAttachmentsUseCase instance.
+func NewListAttachmentsUseCase(
+	transactionRepository repositories.TransactionRepository,
+	attachmentRepository repositories.AttachmentRepository,
+) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		transactionRepository: transactionRepository,
+		attachmentRepository:  attachmentRepository,
+	}
+}
+
+// Execute lists the attachments of a transaction of the user, oldest first.
+func (uc *ListAttachmentsUseCase) Execute(input dtos.ListAttachmentsInput) (*dtos.ListAttachmentsOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	transactionID, err := transactionvalueobjects.NewTransactionID(input.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	if _, err := findUserTransaction(uc.transactionRepository, userID, transactionID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := uc.attachmentRepository.FindByTransactionID(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attachments: %w", err)
+	}
+
+	outputs := make([]dtos.AttachmentOutput, 0, len(attachments))
+	for _, attachment := range attachments {
+		outputs = append(outputs, attachmentOutput(attachment))
+	}
+
+	return &dtos.ListAttachmentsOutput{
+		Attachments: outputs,
+		Count:       len(outputs),
+	}, nil
+}
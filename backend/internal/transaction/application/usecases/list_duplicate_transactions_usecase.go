@@ -0,0 +1,187 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/domain/services"
+)
+
+const (
+	// defaultDuplicateReviewDays is the period reviewed for duplicates when no dates are given.
+	defaultDuplicateReviewDays = 90
+
+	// maxDuplicateReviewDays limits the period reviewed at once, since every pair is compared.
+	maxDuplicateReviewDays = 366
+)
+
+// ListDuplicateTransactionsUseCase lists pairs of transactions that likely are the same purchase
+// entered twice, so the user can review them and merge the ones that really are duplicates.
+type ListDuplicateTransactionsUseCase struct {
+	transactionRepository repositories.TransactionRepository
+}
+
+// NewListDuplicateTransactionsUseCase creates a new ListDuplicateTransactionsUseCase instance.
+func NewListDuplicateTransactionsUseCase(
+	transactionRepository repositories.TransactionRepository,
+) *ListDuplicateTransactionsUseCase {
+	return &ListDuplicateTransactionsUseCase{
+		transactionRepository: transactionRepository,
+	}
+}
+
+// Execute finds the likely duplicate pairs of the user in the period, optionally for one account.
+func (uc *ListDuplicateTransactionsUseCase) Execute(input dtos.ListDuplicateTransactionsInput) (*dtos.ListDuplicateTransactionsOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	var accountID *accountvalueobjects.AccountID
+	if input.AccountID != "" {
+		id, err := accountvalueobjects.NewAccountID(input.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID: %w", err)
+		}
+		accountID = &id
+	}
+
+	startDate, endDate, err := duplicateReviewPeriod(input.StartDate, input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.transactionRepository.FindByUserIDAndDateRange(userID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	if accountID != nil {
+		filtered := make([]*entities.Transaction, 0, len(transactions))
+		for _, transaction := range transactions {
+			if transaction.AccountID().Equals(*accountID) {
+				filtered = append(filtered, transaction)
+			}
+		}
+		transactions = filtered
+	}
+
+	pairs := services.NewDuplicateDetector().FindPairs(transactions)
+
+	output := &dtos.ListDuplicateTransactionsOutput{
+		Pairs:     make([]dtos.DuplicatePairOutput, 0, len(pairs)),
+		Count:     len(pairs),
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   endDate.Format("2006-01-02"),
+	}
+	for _, pair := range pairs {
+		output.Pairs = append(output.Pairs, dtos.DuplicatePairOutput{
+			Original:   transactionOutput(pair.Original),
+			Duplicate:  transactionOutput(pair.Duplicate),
+			Similarity: pair.Similarity,
+			DaysApart:  pair.DaysApart,
+		})
+	}
+
+	return output, nil
+}
+
+// duplicateReviewPeriod parses the period to review, defaulting to the last 90 days.
+func duplicateReviewPeriod(rawStartDate, rawEndDate string) (time.Time, time.Time, error) {
+	endDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if rawEndDate != "" {
+		parsed, err := time.Parse("2006-01-02", rawEndDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end date format (expected YYYY-MM-DD): %w", err)
+		}
+		endDate = parsed
+	}
+
+	startDate := endDate.AddDate(0, 0, -defaultDuplicateReviewDays)
+	if rawStartDate != "" {
+		parsed, err := time.Parse("2006-01-02", rawStartDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start date format (expected YYYY-MM-DD): %w", err)
+		}
+		startDate = parsed
+	}
+
+	if startDate.After(endDate) {
+		return time.Time{}, time.Time{}, errors.New("invalid period: start date must be before end date")
+	}
+	if endDate.Sub(startDate) > maxDuplicateReviewDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid period: must be at most %d days", maxDuplicateReviewDays)
+	}
+
+	return startDate, endDate, nil
+}
+
+// findPossibleDuplicates returns, for each fingerprint, the saved transactions of the user that
+// likely are the same purchase. The transactions around all fingerprints are loaded at once.
+func findPossibleDuplicates(
+	transactionRepository repositories.TransactionRepository,
+	userID identityvalueobjects.UserID,
+	fingerprints []services.TransactionFingerprint,
+) ([][]services.DuplicateMatch, error) {
+	matches := make([][]services.DuplicateMatch, len(fingerprints))
+	if len(fingerprints) == 0 {
+		return matches, nil
+	}
+
+	detector := services.NewDuplicateDetector()
+	startDate, endDate := fingerprints[0].Date, fingerprints[0].Date
+	for _, fingerprint := range fingerprints[1:] {
+		if fingerprint.Date.Before(startDate) {
+			startDate = fingerprint.Date
+		}
+		if fingerprint.Date.After(endDate) {
+			endDate = fingerprint.Date
+		}
+	}
+	window := detector.DateWindowDays()
+
+	existing, err := transactionRepository.FindByUserIDAndDateRange(userID, startDate.AddDate(0, 0, -window), endDate.AddDate(0, 0, window))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	for i, fingerprint := range fingerprints {
+		matches[i] = detector.FindMatches(fingerprint, existing)
+	}
+	return matches, nil
+}
+
+// flagPossibleDuplicates marks the statement rows that look like transactions already saved in
+// the account (e.g. entered manually before the import) and returns how many were marked.
+// The check is advisory: when the lookup fails the rows are simply left unmarked.
+func flagPossibleDuplicates(
+	transactionRepository repositories.TransactionRepository,
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	rows []importedRow,
+) int {
+	fingerprints := make([]services.TransactionFingerprint, len(rows))
+	for i, row := range rows {
+		fingerprints[i] = services.NewTransactionFingerprint(accountID, row.transactionType, row.amount, row.date, row.description.Value())
+	}
+
+	matches, err := findPossibleDuplicates(transactionRepository, userID, fingerprints)
+	if err != nil {
+		return 0
+	}
+
+	flagged := 0
+	for i := range rows {
+		if len(matches[i]) > 0 {
+			rows[i].possibleDuplicateOf = matches[i][0].TransactionID
+			flagged++
+		}
+	}
+	return flagged
+}
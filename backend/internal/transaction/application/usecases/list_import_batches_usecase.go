@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// ListImportBatchesUseCase handles listing the statement import batches of a user.
+type ListImportBatchesUseCase struct {
+	importBatchRepository repositories.ImportBatchRepository
+}
+
+// NewListImportBatchesUseCase creates a new ListImportBatchesUseCase instance.
+func NewListImportBatchesUseCase(importBatchRepository repositories.ImportBatchRepository) *ListImportBatchesUseCase {
+	return &ListImportBatchesUseCase{
+		importBatchRepository: importBatchRepository,
+	}
+}
+
+// Execute lists the import batches of the user, newest first.
+func (uc *ListImportBatchesUseCase) Execute(input dtos.ListImportBatchesInput) (*dtos.ListImportBatchesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	batches, err := uc.importBatchRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import batches: %w", err)
+	}
+
+	outputs := make([]dtos.ImportBatchOutput, 0, len(batches))
+	for _, batch := range batches {
+		outputs = append(outputs, importBatchOutput(batch))
+	}
+
+	return &dtos.ListImportBatchesOutput{
+		Batches: outputs,
+		Count:   len(outputs),
+	}, nil
+}
+
+// importBatchOutput converts an import batch to its output DTO.
+func importBatchOutput(batch *entities.ImportBatch) dtos.ImportBatchOutput {
+	output := dtos.ImportBatchOutput{
+		BatchID:          batch.ID().Value(),
+		AccountID:        batch.AccountID().Value(),
+		Source:           batch.Source(),
+		FileName:         batch.FileName(),
+		Status:           batch.Status(),
+		TransactionCount: batch.TransactionCount(),
+		CreatedAt:        batch.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if batch.RolledBackAt() != nil {
+		output.RolledBackAt = batch.RolledBackAt().Format("2006-01-02T15:04:05Z07:00")
+	}
+	return output
+}
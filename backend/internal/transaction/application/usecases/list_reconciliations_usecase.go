@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"fmt"
+
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// ListReconciliationsUseCase handles listing the reconciliation history of an account.
+type ListReconciliationsUseCase struct {
+	reconciliationRepository repositories.ReconciliationRepository
+	accountRepository        accountrepositories.AccountRepository
+}
+
+// NewListReconciliationsUseCase creates a new ListReconciliationsUseCase instance.
+func NewListReconciliationsUseCase(
+	reconciliationRepository repositories.ReconciliationRepository,
+	accountRepository accountrepositories.AccountRepository,
+) *ListReconciliationsUseCase {
+	return &ListReconciliationsUseCase{
+		reconciliationRepository: reconciliationRepository,
+		accountRepository:        accountRepository,
+	}
+}
+
+// Execute lists the reconciliations of the account, latest statement first.
+// Transactions are not listed; get a single reconciliation for them.
+func (uc *ListReconciliationsUseCase) Execute(input dtos.ListReconciliationsInput) (*dtos.ListReconciliationsOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	if _, err := findUserAccount(uc.accountRepository, userID, accountID, "reconciled"); err != nil {
+		return nil, err
+	}
+
+	reconciliations, err := uc.reconciliationRepository.FindByAccountID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reconciliations: %w", err)
+	}
+
+	outputs := make([]dtos.ReconciliationOutput, 0, len(reconciliations))
+	for _, reconciliation := range reconciliations {
+		outputs = append(outputs, reconciliationOutput(reconciliation, nil))
+	}
+
+	return &dtos.ListReconciliationsOutput{
+		Reconciliations: outputs,
+		Count:           len(outputs),
+	}, nil
+}
@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// Statuses of a recurring series, derived from its pause and end date.
+const (
+	recurringSeriesActive = "ACTIVE"
+	recurringSeriesPaused = "PAUSED"
+	recurringSeriesEnded  = "ENDED"
+)
+
+// ListRecurringSeriesUseCase handles listing the recurring series of a user with their next occurrence.
+type ListRecurringSeriesUseCase struct {
+	transactionRepository repositories.TransactionRepository
+}
+
+// NewListRecurringSeriesUseCase creates a new ListRecurringSeriesUseCase instance.
+func NewListRecurringSeriesUseCase(transactionRepository repositories.TransactionRepository) *ListRecurringSeriesUseCase {
+	return &ListRecurringSeriesUseCase{
+		transactionRepository: transactionRepository,
+	}
+}
+
+// Execute lists the recurring series of the user, optionally filtered by status.
+func (uc *ListRecurringSeriesUseCase) Execute(input dtos.ListRecurringSeriesInput) (*dtos.ListRecurringSeriesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	seriesList, err := uc.transactionRepository.FindRecurringSeriesByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recurring series: %w", err)
+	}
+
+	now := time.Now()
+	output := &dtos.ListRecurringSeriesOutput{
+		Series: make([]dtos.RecurringSeriesOutput, 0, len(seriesList)),
+	}
+	for _, series := range seriesList {
+		seriesOutput := recurringSeriesOutput(series, now)
+		if input.Status != "" && seriesOutput.Status != input.Status {
+			continue
+		}
+		output.Series = append(output.Series, seriesOutput)
+	}
+	output.Count = len(output.Series)
+
+	return output, nil
+}
+
+// findUserRecurringSeries loads a recurring series of the user. The ID may be the one of the
+// transaction that starts the series or of any occurrence generated from it.
+func findUserRecurringSeries(
+	transactionRepository repositories.TransactionRepository,
+	userID identityvalueobjects.UserID,
+	rawSeriesID string,
+) (*entities.Transaction, error) {
+	seriesID, err := transactionvalueobjects.NewTransactionID(rawSeriesID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurring series ID: %w", err)
+	}
+
+	series, err := transactionRepository.FindByID(seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recurring series: %w", err)
+	}
+	if series != nil && !series.IsRecurringSeries() && series.ParentTransactionID() != nil && !series.IsInstallment() {
+		series, err = transactionRepository.FindByID(*series.ParentTransactionID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find recurring series: %w", err)
+		}
+	}
+	if series == nil {
+		return nil, fmt.Errorf("recurring series not found: %s", seriesID.Value())
+	}
+	if !series.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("recurring series does not belong to user")
+	}
+	if !series.IsRecurringSeries() {
+		return nil, fmt.Errorf("invalid recurring series: transaction %s is not recurring", seriesID.Value())
+	}
+
+	return series, nil
+}
+
+// recurringSeriesOutput converts a recurring series to its output DTO as of now.
+func recurringSeriesOutput(series *entities.Transaction, now time.Time) dtos.RecurringSeriesOutput {
+	output := dtos.RecurringSeriesOutput{
+		SeriesID:           series.ID().Value(),
+		AccountID:          series.AccountID().Value(),
+		Type:               series.TransactionType().Value(),
+		Description:        series.Description().Value(),
+		Currency:           series.Amount().Currency().Code(),
+		Frequency:          series.RecurrenceFrequency().Value(),
+		ShiftToBusinessDay: series.ShiftsToBusinessDay(),
+		StartDate:          series.Date().Format("2006-01-02"),
+		Status:             recurringSeriesActive,
+		SkippedDates:       make([]string, 0, len(series.SkippedOccurrences())),
+		AmountChanges:      make([]dtos.RecurrenceAmountChangeOutput, 0, len(series.RecurrenceAmountChanges())),
+	}
+
+	if series.CategoryID() != nil {
+		output.CategoryID = series.CategoryID().Value()
+	}
+	if series.RecurrenceEndDate() != nil {
+		output.EndDate = series.RecurrenceEndDate().Format("2006-01-02")
+	}
+	if series.RecurrenceAnchor() != nil {
+		output.Anchor = series.RecurrenceAnchor().Value()
+	} else if series.RecurrenceFrequency().IsMonthly() || series.RecurrenceFrequency().IsYearly() {
+		output.Anchor = transactionvalueobjects.DayOfMonthAnchor
+	}
+	if series.RecurrenceGeneratedUntil() != nil {
+		output.GeneratedUntil = series.RecurrenceGeneratedUntil().Format("2006-01-02")
+	}
+
+	amount := series.RecurrenceAmountOn(now)
+	if next := series.NextOccurrences(now, 1); len(next) > 0 {
+		output.NextOccurrence = next[0].Format("2006-01-02")
+		amount = series.RecurrenceAmountOn(next[0])
+	} else {
+		output.Status = recurringSeriesEnded
+	}
+	output.Amount = amount.Float64()
+
+	if series.IsRecurrencePaused() {
+		output.PausedAt = series.RecurrencePausedAt().Format(time.RFC3339)
+		if output.Status != recurringSeriesEnded {
+			output.Status = recurringSeriesPaused
+		}
+	}
+
+	for _, date := range series.SkippedOccurrences() {
+		output.SkippedDates = append(output.SkippedDates, date.Format("2006-01-02"))
+	}
+	for _, change := range series.RecurrenceAmountChanges() {
+		output.AmountChanges = append(output.AmountChanges, dtos.RecurrenceAmountChangeOutput{
+			EffectiveDate: change.EffectiveDate().Format("2006-01-02"),
+			Amount:        change.Amount().Float64(),
+		})
+	}
+
+	return output
+}
+
+// parseOccurrenceDate parses an occurrence date of a recurring series (YYYY-MM-DD).
+func parseOccurrenceDate(field, rawDate string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", rawDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s format: expected YYYY-MM-DD, got %s", field, rawDate)
+	}
+	return date, nil
+}
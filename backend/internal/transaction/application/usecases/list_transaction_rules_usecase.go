@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// ListTransactionRulesUseCase handles listing the auto-categorization rules of a user.
+type ListTransactionRulesUseCase struct {
+	ruleRepository repositories.TransactionRuleRepository
+}
+
+// NewListTransactionRulesUseCase creates a new ListTransactionRulesUseCase instance.
+func NewListTransactionRulesUseCase(ruleRepository repositories.TransactionRuleRepository) *ListTransactionRulesUseCase {
+	return &ListTransactionRulesUseCase{
+		ruleRepository: ruleRepository,
+	}
+}
+
+// Execute lists the rules of the user in the order they run.
+func (uc *ListTransactionRulesUseCase) Execute(input dtos.ListTransactionRulesInput) (*dtos.ListTransactionRulesOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rules, err := uc.ruleRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find rules: %w", err)
+	}
+
+	output := &dtos.ListTransactionRulesOutput{
+		Rules: make([]dtos.TransactionRuleOutput, 0, len(rules)),
+		Count: len(rules),
+	}
+	for _, rule := range rules {
+		output.Rules = append(output.Rules, transactionRuleOutput(rule))
+	}
+
+	return output, nil
+}
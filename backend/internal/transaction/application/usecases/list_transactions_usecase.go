@@ -1,10 +1,18 @@
 package usecases
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
 	"gestao-financeira/backend/internal/transaction/domain/repositories"
@@ -15,20 +23,26 @@ import (
 // ListTransactionsUseCase handles listing transactions for a user.
 type ListTransactionsUseCase struct {
 	transactionRepository repositories.TransactionRepository
+	cursorSigner          *pagination.CursorSigner
 }
 
 // NewListTransactionsUseCase creates a new ListTransactionsUseCase instance.
+// The cursor signer signs and verifies the cursors of cursor pagination.
 func NewListTransactionsUseCase(
 	transactionRepository repositories.TransactionRepository,
+	cursorSigner *pagination.CursorSigner,
 ) *ListTransactionsUseCase {
 	return &ListTransactionsUseCase{
 		transactionRepository: transactionRepository,
+		cursorSigner:          cursorSigner,
 	}
 }
 
 // Execute performs the transaction listing.
 // It validates the input, retrieves transactions from the repository,
-// and returns them as DTOs. Supports filtering by account ID and/or type, and pagination.
+// and returns them as DTOs. Supports filtering by account ID, type, tags, period, amount range,
+// currency, description search and recurrence, configurable sorting and pagination
+// by page (offset) or by cursor (keyset).
 func (uc *ListTransactionsUseCase) Execute(input dtos.ListTransactionsInput) (*dtos.ListTransactionsOutput, error) {
 	// Create user ID value object
 	userID, err := identityvalueobjects.NewUserID(input.UserID)
@@ -36,6 +50,22 @@ func (uc *ListTransactionsUseCase) Execute(input dtos.ListTransactionsInput) (*d
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	filter, err := transactionFilterFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.Pagination {
+	case "", "offset":
+	case "cursor":
+		return uc.listWithCursor(userID, filter, input)
+	default:
+		return nil, fmt.Errorf("invalid pagination: must be offset or cursor, got %s", input.Pagination)
+	}
+	if input.Cursor != "" {
+		return uc.listWithCursor(userID, filter, input)
+	}
+
 	// Parse pagination parameters
 	paginationParams := pagination.ParsePaginationParams(input.Page, input.Limit)
 	usePagination := input.Page != "" || input.Limit != ""
@@ -48,8 +78,7 @@ func (uc *ListTransactionsUseCase) Execute(input dtos.ListTransactionsInput) (*d
 		// Use paginated query
 		domainTransactions, total, err = uc.transactionRepository.FindByUserIDAndFiltersWithPagination(
 			userID,
-			input.AccountID,
-			input.Type,
+			filter,
 			paginationParams.CalculateOffset(),
 			paginationParams.Limit,
 		)
@@ -58,58 +87,26 @@ func (uc *ListTransactionsUseCase) Execute(input dtos.ListTransactionsInput) (*d
 		}
 	} else {
 		// Use non-paginated query (backward compatibility)
-		if input.AccountID != "" && input.Type != "" {
-			accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid account ID: %w", err)
-			}
-
-			transactionType, err := transactionvalueobjects.NewTransactionType(input.Type)
-			if err != nil {
-				return nil, fmt.Errorf("invalid transaction type: %w", err)
-			}
-
-			// Find by user ID, account ID, and filter by type manually
-			allTransactions, err := uc.transactionRepository.FindByUserIDAndAccountID(userID, accountID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to find transactions: %w", err)
-			}
-
-			// Filter by type
-			for _, tx := range allTransactions {
-				if tx.TransactionType().Value() == transactionType.Value() {
-					domainTransactions = append(domainTransactions, tx)
-				}
-			}
-		} else if input.AccountID != "" {
-			// If only account ID is provided
-			accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid account ID: %w", err)
-			}
-
-			domainTransactions, err = uc.transactionRepository.FindByUserIDAndAccountID(userID, accountID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to find transactions: %w", err)
-			}
-		} else if input.Type != "" {
-			// If only type is provided
-			transactionType, err := transactionvalueobjects.NewTransactionType(input.Type)
-			if err != nil {
-				return nil, fmt.Errorf("invalid transaction type: %w", err)
-			}
-
-			domainTransactions, err = uc.transactionRepository.FindByUserIDAndType(userID, transactionType)
-			if err != nil {
-				return nil, fmt.Errorf("failed to find transactions: %w", err)
-			}
+		if filter.AccountID != nil {
+			domainTransactions, err = uc.transactionRepository.FindByUserIDAndAccountID(userID, *filter.AccountID)
+		} else if filter.Type != nil {
+			domainTransactions, err = uc.transactionRepository.FindByUserIDAndType(userID, *filter.Type)
 		} else {
-			// Find all transactions for the user
 			domainTransactions, err = uc.transactionRepository.FindByUserID(userID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to find transactions: %w", err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transactions: %w", err)
+		}
+
+		// Apply the remaining criteria and the requested order in memory
+		filtered := make([]*entities.Transaction, 0, len(domainTransactions))
+		for _, tx := range domainTransactions {
+			if filter.Matches(tx) {
+				filtered = append(filtered, tx)
 			}
 		}
+		filter.Sort(filtered)
+		domainTransactions = filtered
 		total = int64(len(domainTransactions))
 	}
 
@@ -129,24 +126,267 @@ func (uc *ListTransactionsUseCase) Execute(input dtos.ListTransactionsInput) (*d
 	return output, nil
 }
 
+// listWithCursor lists a page of transactions with keyset pagination. The position of the page
+// comes from the cursor, so transactions created or deleted meanwhile never shift the following
+// pages; no total is computed.
+func (uc *ListTransactionsUseCase) listWithCursor(
+	userID identityvalueobjects.UserID,
+	filter repositories.TransactionFilter,
+	input dtos.ListTransactionsInput,
+) (*dtos.ListTransactionsOutput, error) {
+	if input.Page != "" {
+		return nil, errors.New("invalid pagination: page cannot be used with cursor pagination")
+	}
+
+	scope := transactionCursorScope(filter)
+	var cursor *pagination.Cursor
+	var keyset *repositories.TransactionKeyset
+	if input.Cursor != "" {
+		decoded, err := uc.cursorSigner.Decode(input.Cursor, scope)
+		if err != nil {
+			return nil, err
+		}
+		keyset, err = transactionKeysetFromCursor(decoded.Key)
+		if err != nil {
+			return nil, err
+		}
+		cursor = &decoded
+	}
+
+	limit := pagination.ParsePaginationParams("", input.Limit).Limit
+	backward := cursor != nil && cursor.Backward
+
+	// One more than the page is fetched to know whether another page follows
+	domainTransactions, err := uc.transactionRepository.FindByUserIDAndFiltersWithKeyset(userID, filter, keyset, backward, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	page, result := pagination.BuildCursorPage(uc.cursorSigner, scope, domainTransactions, limit, cursor,
+		func(transaction *entities.Transaction) []string {
+			return transactionCursorKey(filter.KeysetOf(transaction))
+		})
+
+	transactions := uc.toTransactionOutputs(page)
+	return &dtos.ListTransactionsOutput{
+		Transactions: transactions,
+		Count:        len(transactions),
+		Cursor:       &result,
+	}, nil
+}
+
+// transactionCursorScope identifies the sort order a transaction cursor is valid for.
+func transactionCursorScope(filter repositories.TransactionFilter) string {
+	direction := "desc"
+	if filter.SortAscending {
+		direction = "asc"
+	}
+	return "transactions:" + string(filter.SortBy) + ":" + direction
+}
+
+// transactionCursorKey encodes a keyset as the key of a cursor.
+func transactionCursorKey(keyset repositories.TransactionKeyset) []string {
+	return []string{
+		strconv.FormatInt(keyset.Amount, 10),
+		keyset.Description,
+		keyset.Date.Format(time.RFC3339Nano),
+		keyset.CreatedAt.Format(time.RFC3339Nano),
+		keyset.ID.Value(),
+	}
+}
+
+// transactionKeysetFromCursor decodes the key of a cursor built by transactionCursorKey.
+func transactionKeysetFromCursor(key []string) (*repositories.TransactionKeyset, error) {
+	invalid := errors.New("invalid cursor: malformed position")
+	if len(key) != 5 {
+		return nil, invalid
+	}
+
+	amount, err := strconv.ParseInt(key[0], 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	date, err := time.Parse(time.RFC3339Nano, key[2])
+	if err != nil {
+		return nil, invalid
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, key[3])
+	if err != nil {
+		return nil, invalid
+	}
+	id, err := transactionvalueobjects.NewTransactionID(key[4])
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &repositories.TransactionKeyset{
+		Amount:      amount,
+		Description: key[1],
+		Date:        date,
+		CreatedAt:   createdAt,
+		ID:          id,
+	}, nil
+}
+
+// transactionFilterFromInput validates the list filters and converts them to a repository filter.
+func transactionFilterFromInput(input dtos.ListTransactionsInput) (repositories.TransactionFilter, error) {
+	var filter repositories.TransactionFilter
+
+	if input.AccountID != "" {
+		accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+		if err != nil {
+			return filter, fmt.Errorf("invalid account ID: %w", err)
+		}
+		filter.AccountID = &accountID
+	}
+
+	if input.Type != "" {
+		transactionType, err := transactionvalueobjects.NewTransactionType(input.Type)
+		if err != nil {
+			return filter, fmt.Errorf("invalid transaction type: %w", err)
+		}
+		filter.Type = &transactionType
+	}
+
+	if input.Status != "" {
+		status, err := transactionvalueobjects.NewTransactionStatus(input.Status)
+		if err != nil {
+			return filter, err
+		}
+		filter.Status = &status
+	}
+
+	if input.PayeeID != "" {
+		payeeID, err := payeevalueobjects.NewPayeeID(input.PayeeID)
+		if err != nil {
+			return filter, fmt.Errorf("invalid payee ID: %w", err)
+		}
+		filter.PayeeID = &payeeID
+	}
+
+	for _, rawTagID := range input.TagIDs {
+		tagID, err := tagvalueobjects.NewTagID(rawTagID)
+		if err != nil {
+			return filter, fmt.Errorf("invalid tag ID: %w", err)
+		}
+		filter.TagIDs = append(filter.TagIDs, tagID)
+	}
+	if input.TagMatch != "" && input.TagMatch != "any" && input.TagMatch != "all" {
+		return filter, fmt.Errorf("invalid tag match: must be any or all, got %s", input.TagMatch)
+	}
+	filter.MatchAllTags = input.TagMatch == "all"
+
+	if input.StartDate != "" {
+		startDate, err := time.Parse("2006-01-02", input.StartDate)
+		if err != nil {
+			return filter, fmt.Errorf("invalid start date format (expected YYYY-MM-DD): %w", err)
+		}
+		filter.StartDate = &startDate
+	}
+	if input.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", input.EndDate)
+		if err != nil {
+			return filter, fmt.Errorf("invalid end date format (expected YYYY-MM-DD): %w", err)
+		}
+		filter.EndDate = &endDate
+	}
+	if filter.StartDate != nil && filter.EndDate != nil && filter.EndDate.Before(*filter.StartDate) {
+		return filter, errors.New("invalid period: end date must be on or after start date")
+	}
+
+	minAmount, err := parseAmountFilter("minimum amount", input.MinAmount)
+	if err != nil {
+		return filter, err
+	}
+	maxAmount, err := parseAmountFilter("maximum amount", input.MaxAmount)
+	if err != nil {
+		return filter, err
+	}
+	if minAmount != nil && maxAmount != nil && *minAmount > *maxAmount {
+		return filter, errors.New("invalid amount range: minimum amount must be less than or equal to the maximum amount")
+	}
+	filter.MinAmount = minAmount
+	filter.MaxAmount = maxAmount
+
+	if input.Currency != "" {
+		currency, err := sharedvalueobjects.NewCurrency(input.Currency)
+		if err != nil {
+			return filter, err
+		}
+		filter.Currency = &currency
+	}
+
+	filter.Search = strings.TrimSpace(input.Search)
+	filter.RecurringOnly = input.RecurringOnly
+
+	switch sortBy := repositories.TransactionSortField(input.SortBy); sortBy {
+	case "":
+		filter.SortBy = repositories.SortByDate
+	case repositories.SortByDate, repositories.SortByAmount, repositories.SortByDescription:
+		filter.SortBy = sortBy
+	default:
+		return filter, fmt.Errorf("invalid sort field: must be date, amount or description, got %s", input.SortBy)
+	}
+
+	switch input.SortOrder {
+	case "", "desc":
+	case "asc":
+		filter.SortAscending = true
+	default:
+		return filter, fmt.Errorf("invalid sort order: must be asc or desc, got %s", input.SortOrder)
+	}
+
+	return filter, nil
+}
+
+// parseAmountFilter parses an optional decimal amount filter into cents.
+func parseAmountFilter(name string, raw string) (*int64, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	amount, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil || amount < 0 || math.IsInf(amount, 0) || math.IsNaN(amount) {
+		return nil, fmt.Errorf("invalid %s: must be a number greater than or equal to zero, got %s", name, raw)
+	}
+
+	cents := int64(math.Round(amount * 100))
+	return &cents, nil
+}
+
 // toTransactionOutputs converts domain transactions to DTOs.
 func (uc *ListTransactionsUseCase) toTransactionOutputs(domainTransactions []*entities.Transaction) []*dtos.TransactionOutput {
 	outputs := make([]*dtos.TransactionOutput, 0, len(domainTransactions))
 	for _, transaction := range domainTransactions {
-		amount := transaction.Amount()
-		output := &dtos.TransactionOutput{
-			TransactionID: transaction.ID().Value(),
-			UserID:        transaction.UserID().Value(),
-			AccountID:     transaction.AccountID().Value(),
-			Type:          transaction.TransactionType().Value(),
-			Amount:        amount.Float64(),
-			Currency:      amount.Currency().Code(),
-			Description:   transaction.Description().Value(),
-			Date:          transaction.Date().Format("2006-01-02"),
-			CreatedAt:     transaction.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:     transaction.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
-		}
-		outputs = append(outputs, output)
+		outputs = append(outputs, transactionOutput(transaction))
 	}
 	return outputs
 }
+
+// transactionOutput converts a domain transaction to its list output DTO.
+func transactionOutput(transaction *entities.Transaction) *dtos.TransactionOutput {
+	amount := transaction.Amount()
+	return &dtos.TransactionOutput{
+		TransactionID:       transaction.ID().Value(),
+		UserID:              transaction.UserID().Value(),
+		AccountID:           transaction.AccountID().Value(),
+		Type:                transaction.TransactionType().Value(),
+		Amount:              amount.Float64(),
+		Currency:            amount.Currency().Code(),
+		Description:         transaction.Description().Value(),
+		Date:                transaction.Date().Format("2006-01-02"),
+		CategoryID:          categoryIDValue(transaction),
+		LinkedTransactionID: linkedTransactionIDValue(transaction),
+		Splits:              splitOutputs(transaction),
+		TagIDs:              tagIDValues(transaction),
+		PayeeID:             payeeIDValue(transaction),
+		ImportBatchID:       importBatchIDValue(transaction),
+		Status:              transaction.Status().Value(),
+		ReconciliationID:    reconciliationIDValue(transaction),
+		Installment:         transactionInstallmentOutput(transaction),
+		CurrencyConversion:  currencyConversionOutput(transaction),
+		FeeForTransactionID: feeForTransactionIDValue(transaction),
+		CreatedAt:           transaction.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           transaction.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"gestao-financeira/backend/internal/transaction/application/dtos"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	"gestao-financeira/backend/pkg/pagination"
 )
 
 // Helper function to create test transaction
@@ -248,7 +250,7 @@ func TestListTransactionsUseCase_Execute(t *testing.T) {
 			mockRepo := newMockListTransactionRepository()
 			tt.setupMock(mockRepo)
 
-			useCase := NewListTransactionsUseCase(mockRepo)
+			useCase := NewListTransactionsUseCase(mockRepo, pagination.NewCursorSigner("test-secret"))
 			output, err := useCase.Execute(tt.input)
 
 			if (err != nil) != tt.wantError {
@@ -282,3 +284,214 @@ func TestListTransactionsUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestListTransactionsUseCase_Execute_Filters(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	mockRepo := newMockListTransactionRepository()
+	january, _ := createTestTransaction(userID, accountID, "EXPENSE", 50.00, "BRL", "Padaria do bairro", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC))
+	february, _ := createTestTransaction(userID, accountID, "EXPENSE", 250.00, "BRL", "Supermercado São João", time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC))
+	march, _ := createTestTransaction(userID, accountID, "EXPENSE", 120.00, "USD", "Supermercado online", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	_ = mockRepo.Save(january)
+	_ = mockRepo.Save(february)
+	_ = mockRepo.Save(march)
+
+	useCase := NewListTransactionsUseCase(mockRepo, pagination.NewCursorSigner("test-secret"))
+
+	tests := []struct {
+		name     string
+		input    dtos.ListTransactionsInput
+		wantIDs  []string
+		errorMsg string
+	}{
+		{
+			name:    "date range",
+			input:   dtos.ListTransactionsInput{StartDate: "2026-02-01", EndDate: "2026-03-10"},
+			wantIDs: []string{march.ID().Value(), february.ID().Value()},
+		},
+		{
+			name:    "amount range",
+			input:   dtos.ListTransactionsInput{MinAmount: "100", MaxAmount: "200.00"},
+			wantIDs: []string{march.ID().Value()},
+		},
+		{
+			name:    "currency",
+			input:   dtos.ListTransactionsInput{Currency: "brl"},
+			wantIDs: []string{february.ID().Value(), january.ID().Value()},
+		},
+		{
+			name:    "search ignores case and accents",
+			input:   dtos.ListTransactionsInput{Search: "supermercado sao"},
+			wantIDs: []string{february.ID().Value()},
+		},
+		{
+			name:    "sort by amount ascending with pagination",
+			input:   dtos.ListTransactionsInput{SortBy: "amount", SortOrder: "asc", Page: "1", Limit: "2"},
+			wantIDs: []string{january.ID().Value(), march.ID().Value()},
+		},
+		{
+			name:    "sort by description",
+			input:   dtos.ListTransactionsInput{SortBy: "description", SortOrder: "asc"},
+			wantIDs: []string{january.ID().Value(), march.ID().Value(), february.ID().Value()},
+		},
+		{
+			name:     "invalid date",
+			input:    dtos.ListTransactionsInput{StartDate: "10/02/2026"},
+			errorMsg: "invalid start date",
+		},
+		{
+			name:     "end date before start date",
+			input:    dtos.ListTransactionsInput{StartDate: "2026-03-01", EndDate: "2026-02-01"},
+			errorMsg: "invalid period",
+		},
+		{
+			name:     "invalid amount",
+			input:    dtos.ListTransactionsInput{MinAmount: "-10"},
+			errorMsg: "invalid minimum amount",
+		},
+		{
+			name:     "inverted amount range",
+			input:    dtos.ListTransactionsInput{MinAmount: "200", MaxAmount: "100"},
+			errorMsg: "invalid amount range",
+		},
+		{
+			name:     "invalid sort field",
+			input:    dtos.ListTransactionsInput{SortBy: "category"},
+			errorMsg: "invalid sort field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.UserID = userID.Value()
+			output, err := useCase.Execute(tt.input)
+
+			if tt.errorMsg != "" {
+				if err == nil || !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("ListTransactionsUseCase.Execute() error = %v, want error containing %v", err, tt.errorMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListTransactionsUseCase.Execute() unexpected error = %v", err)
+			}
+
+			if len(output.Transactions) != len(tt.wantIDs) {
+				t.Fatalf("ListTransactionsUseCase.Execute() returned %d transactions, want %d", len(output.Transactions), len(tt.wantIDs))
+			}
+			for i, wantID := range tt.wantIDs {
+				if output.Transactions[i].TransactionID != wantID {
+					t.Errorf("ListTransactionsUseCase.Execute() transaction %d = %s (%s), want %s", i, output.Transactions[i].Description, output.Transactions[i].TransactionID, wantID)
+				}
+			}
+		})
+	}
+}
+
+func TestListTransactionsUseCase_Execute_Cursor(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	mockRepo := newMockListTransactionRepository()
+	save := func(day int, description string) *entities.Transaction {
+		transaction, _ := createTestTransaction(userID, accountID, "EXPENSE", float64(day*10), "BRL", description, time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC))
+		_ = mockRepo.Save(transaction)
+		return transaction
+	}
+	first := save(1, "Padaria")
+	second := save(2, "Farmácia")
+	third := save(3, "Mercado")
+	fourth := save(4, "Posto")
+	fifth := save(5, "Cinema")
+
+	useCase := NewListTransactionsUseCase(mockRepo, pagination.NewCursorSigner("test-secret"))
+	ids := func(output *dtos.ListTransactionsOutput) []string {
+		values := make([]string, 0, len(output.Transactions))
+		for _, transaction := range output.Transactions {
+			values = append(values, transaction.TransactionID)
+		}
+		return values
+	}
+	list := func(input dtos.ListTransactionsInput) *dtos.ListTransactionsOutput {
+		t.Helper()
+		input.UserID = userID.Value()
+		output, err := useCase.Execute(input)
+		if err != nil {
+			t.Fatalf("ListTransactionsUseCase.Execute() unexpected error = %v", err)
+		}
+		if output.Cursor == nil || output.Pagination != nil {
+			t.Fatalf("ListTransactionsUseCase.Execute() cursor = %v, pagination = %v, want only cursor metadata", output.Cursor, output.Pagination)
+		}
+		return output
+	}
+
+	page := list(dtos.ListTransactionsInput{Pagination: "cursor", Limit: "2"})
+	if got := ids(page); !slices.Equal(got, []string{fifth.ID().Value(), fourth.ID().Value()}) {
+		t.Fatalf("first page = %v", got)
+	}
+	if !page.Cursor.HasNext || page.Cursor.HasPrev || page.Cursor.PrevCursor != "" {
+		t.Errorf("first page cursor = %+v, want only a next cursor", page.Cursor)
+	}
+
+	// A transaction added while scrolling shows up on neither of the following pages
+	save(6, "Restaurante")
+
+	page = list(dtos.ListTransactionsInput{Cursor: page.Cursor.NextCursor, Limit: "2"})
+	if got := ids(page); !slices.Equal(got, []string{third.ID().Value(), second.ID().Value()}) {
+		t.Fatalf("second page = %v", got)
+	}
+	secondPage := page.Cursor
+
+	page = list(dtos.ListTransactionsInput{Cursor: secondPage.NextCursor, Limit: "2"})
+	if got := ids(page); !slices.Equal(got, []string{first.ID().Value()}) {
+		t.Fatalf("last page = %v", got)
+	}
+	if page.Cursor.HasNext || page.Cursor.NextCursor != "" || !page.Cursor.HasPrev {
+		t.Errorf("last page cursor = %+v, want only a previous cursor", page.Cursor)
+	}
+
+	page = list(dtos.ListTransactionsInput{Cursor: secondPage.PrevCursor, Limit: "2"})
+	if got := ids(page); !slices.Equal(got, []string{fifth.ID().Value(), fourth.ID().Value()}) {
+		t.Errorf("previous page of the second page = %v", got)
+	}
+	if !page.Cursor.HasPrev {
+		t.Errorf("previous page cursor = %+v, want a previous cursor to the new transaction", page.Cursor)
+	}
+
+	errorTests := []struct {
+		name     string
+		input    dtos.ListTransactionsInput
+		errorMsg string
+	}{
+		{
+			name:     "tampered cursor",
+			input:    dtos.ListTransactionsInput{Cursor: "x" + secondPage.NextCursor},
+			errorMsg: "invalid cursor",
+		},
+		{
+			name:     "cursor of another sort order",
+			input:    dtos.ListTransactionsInput{Cursor: secondPage.NextCursor, SortBy: "amount"},
+			errorMsg: "invalid cursor",
+		},
+		{
+			name:     "cursor with page",
+			input:    dtos.ListTransactionsInput{Cursor: secondPage.NextCursor, Page: "2"},
+			errorMsg: "invalid",
+		},
+		{
+			name:     "unknown pagination",
+			input:    dtos.ListTransactionsInput{Pagination: "seek"},
+			errorMsg: "invalid pagination",
+		},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.UserID = userID.Value()
+			_, err := useCase.Execute(tt.input)
+			if err == nil || !contains(err.Error(), tt.errorMsg) {
+				t.Errorf("ListTransactionsUseCase.Execute() error = %v, want error containing %v", err, tt.errorMsg)
+			}
+		})
+	}
+}
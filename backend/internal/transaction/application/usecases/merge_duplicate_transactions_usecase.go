@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// MergeDuplicateTransactionsUseCase merges a duplicate transaction into the one that is kept.
+// The duplicate is soft-deleted and its effect on the account balance is reversed, so the
+// purchase is counted once; the kept transaction absorbs its category, tags and external ID.
+type MergeDuplicateTransactionsUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewMergeDuplicateTransactionsUseCase creates a new MergeDuplicateTransactionsUseCase instance.
+func NewMergeDuplicateTransactionsUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *MergeDuplicateTransactionsUseCase {
+	return &MergeDuplicateTransactionsUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute merges the duplicate into the kept transaction atomically.
+func (uc *MergeDuplicateTransactionsUseCase) Execute(input dtos.MergeDuplicateTransactionsInput) (*dtos.MergeDuplicateTransactionsOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	keepID, err := transactionvalueobjects.NewTransactionID(input.KeepTransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	duplicateID, err := transactionvalueobjects.NewTransactionID(input.DuplicateTransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duplicate transaction ID: %w", err)
+	}
+
+	if keepID.Equals(duplicateID) {
+		return nil, errors.New("invalid merge: the kept and the duplicate transaction must be different")
+	}
+
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	kept, err := findUserTransaction(transactionRepository, userID, keepID)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate, err := findUserTransaction(transactionRepository, userID, duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := kept.MergeDuplicate(duplicate); err != nil {
+		return nil, fmt.Errorf("invalid merge: %w", err)
+	}
+
+	// Undo the duplicate's effect on the account balance (within transaction)
+	account, err := findUserAccount(accountRepository, userID, duplicate.AccountID(), "transaction")
+	if err != nil {
+		return nil, err
+	}
+	if err := reverseBalanceEffect(account, duplicate.TransactionType(), duplicate.Amount()); err != nil {
+		return nil, fmt.Errorf("failed to reverse duplicate transaction: %w", err)
+	}
+	if err := accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save updated account: %w", err)
+	}
+
+	// Delete the duplicate before saving the kept transaction, which may take over its external ID
+	if err := transactionRepository.Delete(duplicateID); err != nil {
+		return nil, fmt.Errorf("failed to delete duplicate transaction: %w", err)
+	}
+	if err := transactionRepository.Save(kept); err != nil {
+		return nil, fmt.Errorf("failed to save transaction: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, event := range kept.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	kept.ClearEvents()
+
+	return &dtos.MergeDuplicateTransactionsOutput{
+		Transaction:          transactionOutput(kept),
+		RemovedTransactionID: duplicateID.Value(),
+		AccountBalance:       account.Balance().Float64(),
+		Currency:             account.Balance().Currency().Code(),
+	}, nil
+}
+
+// findUserTransaction loads a transaction and checks that it belongs to the user.
+func findUserTransaction(
+	transactionRepository repositories.TransactionRepository,
+	userID identityvalueobjects.UserID,
+	transactionID transactionvalueobjects.TransactionID,
+) (*entities.Transaction, error) {
+	transaction, err := transactionRepository.FindByID(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if transaction == nil {
+		return nil, fmt.Errorf("transaction not found: %s", transactionID.Value())
+	}
+	if !transaction.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("transaction does not belong to user")
+	}
+	return transaction, nil
+}
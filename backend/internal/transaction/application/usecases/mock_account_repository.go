@@ -54,6 +54,10 @@ func (m *mockAccountRepository) FindByUserIDAndContext(userID identityvalueobjec
 	return result, nil
 }
 
+func (m *mockAccountRepository) FindByType(accountType valueobjects.AccountType) ([]*entities.Account, error) {
+	return []*entities.Account{}, nil
+}
+
 func (m *mockAccountRepository) Save(account *entities.Account) error {
 	if m.saveErr != nil {
 		return m.saveErr
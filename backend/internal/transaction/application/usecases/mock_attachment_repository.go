@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"gestao-financeira/backend/internal/shared/domain/storage"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockAttachmentRepository is a mock implementation of AttachmentRepository for testing.
+type mockAttachmentRepository struct {
+	attachments map[string]*entities.Attachment
+	saveErr     error
+}
+
+func newMockAttachmentRepository() *mockAttachmentRepository {
+	return &mockAttachmentRepository{
+		attachments: make(map[string]*entities.Attachment),
+	}
+}
+
+func (m *mockAttachmentRepository) FindByID(id valueobjects.AttachmentID) (*entities.Attachment, error) {
+	attachment, exists := m.attachments[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return attachment, nil
+}
+
+func (m *mockAttachmentRepository) FindByTransactionID(transactionID valueobjects.TransactionID) ([]*entities.Attachment, error) {
+	var result []*entities.Attachment
+	for _, attachment := range m.attachments {
+		if attachment.TransactionID().Equals(transactionID) {
+			result = append(result, attachment)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt().Before(result[j].CreatedAt())
+	})
+	return result, nil
+}
+
+func (m *mockAttachmentRepository) Save(attachment *entities.Attachment) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.attachments[attachment.ID().Value()] = attachment
+	return nil
+}
+
+func (m *mockAttachmentRepository) Delete(id valueobjects.AttachmentID) error {
+	delete(m.attachments, id.Value())
+	return nil
+}
+
+// mockFileStorage is an in-memory implementation of FileStorage for testing.
+type mockFileStorage struct {
+	files map[string][]byte
+}
+
+func newMockFileStorage() *mockFileStorage {
+	return &mockFileStorage{
+		files: make(map[string][]byte),
+	}
+}
+
+func (m *mockFileStorage) Put(key string, content []byte, contentType string) error {
+	m.files[key] = append([]byte(nil), content...)
+	return nil
+}
+
+func (m *mockFileStorage) Get(key string) (io.ReadCloser, error) {
+	content, exists := m.files[key]
+	if !exists {
+		return nil, storage.ErrFileNotFound
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (m *mockFileStorage) Delete(key string) error {
+	delete(m.files, key)
+	return nil
+}
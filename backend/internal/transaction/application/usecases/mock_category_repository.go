@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"gestao-financeira/backend/internal/category/domain/entities"
+	"gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+)
+
+// mockCategoryRepository is a mock implementation of CategoryRepository for testing.
+type mockCategoryRepository struct {
+	categories map[string]*entities.Category
+	findErr    error
+}
+
+func newMockCategoryRepository() *mockCategoryRepository {
+	return &mockCategoryRepository{
+		categories: make(map[string]*entities.Category),
+	}
+}
+
+func (m *mockCategoryRepository) FindByID(id valueobjects.CategoryID) (*entities.Category, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	category, exists := m.categories[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return category, nil
+}
+
+func (m *mockCategoryRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Category, error) {
+	var result []*entities.Category
+	for _, category := range m.categories {
+		if category.UserID().Equals(userID) {
+			result = append(result, category)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockCategoryRepository) FindByUserIDAndActive(userID identityvalueobjects.UserID, isActive bool) ([]*entities.Category, error) {
+	var result []*entities.Category
+	for _, category := range m.categories {
+		if category.UserID().Equals(userID) && category.IsActive() == isActive {
+			result = append(result, category)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockCategoryRepository) Save(category *entities.Category) error {
+	m.categories[category.ID().Value()] = category
+	return nil
+}
+
+func (m *mockCategoryRepository) Delete(id valueobjects.CategoryID) error {
+	delete(m.categories, id.Value())
+	return nil
+}
+
+func (m *mockCategoryRepository) Exists(id valueobjects.CategoryID) (bool, error) {
+	_, exists := m.categories[id.Value()]
+	return exists, nil
+}
+
+func (m *mockCategoryRepository) Count(userID identityvalueobjects.UserID) (int64, error) {
+	categories, _ := m.FindByUserID(userID)
+	return int64(len(categories)), nil
+}
+
+func (m *mockCategoryRepository) FindByUserIDAndSlug(userID identityvalueobjects.UserID, slug valueobjects.CategorySlug) (*entities.Category, error) {
+	for _, category := range m.categories {
+		if category.UserID().Equals(userID) && category.Slug().Value() == slug.Value() {
+			return category, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockCategoryRepository) FindByUserIDWithPagination(userID identityvalueobjects.UserID, isActive *bool, offset, limit int) ([]*entities.Category, int64, error) {
+	categories, _ := m.FindByUserID(userID)
+	return categories, int64(len(categories)), nil
+}
+
+// createTestCategory creates a category for the given user and stores it in the mock repository.
+func createTestCategory(m *mockCategoryRepository, userID identityvalueobjects.UserID, name string) *entities.Category {
+	category, err := entities.NewCategory(userID, valueobjects.MustCategoryName(name), "")
+	if err != nil {
+		panic(err)
+	}
+	_ = m.Save(category)
+	return category
+}
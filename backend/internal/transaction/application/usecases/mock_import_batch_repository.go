@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockImportBatchRepository is a mock implementation of ImportBatchRepository for testing.
+type mockImportBatchRepository struct {
+	batches map[string]*entities.ImportBatch
+	saveErr error
+}
+
+func newMockImportBatchRepository() *mockImportBatchRepository {
+	return &mockImportBatchRepository{
+		batches: make(map[string]*entities.ImportBatch),
+	}
+}
+
+func (m *mockImportBatchRepository) FindByID(id valueobjects.ImportBatchID) (*entities.ImportBatch, error) {
+	batch, exists := m.batches[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return batch, nil
+}
+
+func (m *mockImportBatchRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.ImportBatch, error) {
+	var result []*entities.ImportBatch
+	for _, batch := range m.batches {
+		if batch.UserID().Equals(userID) {
+			result = append(result, batch)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockImportBatchRepository) Save(batch *entities.ImportBatch) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.batches[batch.ID().Value()] = batch
+	return nil
+}
@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"sort"
+
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeeentities "gestao-financeira/backend/internal/payee/domain/entities"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+)
+
+// mockPayeeRepository is a mock implementation of PayeeRepository for testing.
+type mockPayeeRepository struct {
+	payees map[string]*payeeentities.Payee
+}
+
+func newMockPayeeRepository() *mockPayeeRepository {
+	return &mockPayeeRepository{
+		payees: make(map[string]*payeeentities.Payee),
+	}
+}
+
+func (m *mockPayeeRepository) FindByID(id payeevalueobjects.PayeeID) (*payeeentities.Payee, error) {
+	payee, exists := m.payees[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return payee, nil
+}
+
+func (m *mockPayeeRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*payeeentities.Payee, error) {
+	var result []*payeeentities.Payee
+	for _, payee := range m.payees {
+		if payee.UserID().Equals(userID) {
+			result = append(result, payee)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name().Value() < result[j].Name().Value()
+	})
+	return result, nil
+}
+
+func (m *mockPayeeRepository) FindByUserIDAndName(userID identityvalueobjects.UserID, name payeevalueobjects.PayeeName) (*payeeentities.Payee, error) {
+	for _, payee := range m.payees {
+		if payee.UserID().Equals(userID) && payee.Name().Equals(name) {
+			return payee, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockPayeeRepository) Save(payee *payeeentities.Payee) error {
+	m.payees[payee.ID().Value()] = payee
+	return nil
+}
+
+func (m *mockPayeeRepository) Delete(id payeevalueobjects.PayeeID) error {
+	delete(m.payees, id.Value())
+	return nil
+}
+
+// createTestPayee creates a payee with the given aliases and default category and saves it in the mock repository.
+func createTestPayee(m *mockPayeeRepository, userID identityvalueobjects.UserID, name string, defaultCategoryID *categoryvalueobjects.CategoryID, aliases ...string) *payeeentities.Payee {
+	payeeAliases := make([]payeevalueobjects.PayeeAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		payeeAliases = append(payeeAliases, payeevalueobjects.MustPayeeAlias(alias))
+	}
+	payee, err := payeeentities.NewPayee(userID, payeevalueobjects.MustPayeeName(name), payeeAliases, defaultCategoryID)
+	if err != nil {
+		panic(err)
+	}
+	_ = m.Save(payee)
+	return payee
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"sort"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockReconciliationRepository is a mock implementation of ReconciliationRepository for testing.
+type mockReconciliationRepository struct {
+	reconciliations map[string]*entities.Reconciliation
+	saveErr         error
+}
+
+func newMockReconciliationRepository() *mockReconciliationRepository {
+	return &mockReconciliationRepository{
+		reconciliations: make(map[string]*entities.Reconciliation),
+	}
+}
+
+func (m *mockReconciliationRepository) FindByID(id valueobjects.ReconciliationID) (*entities.Reconciliation, error) {
+	reconciliation, exists := m.reconciliations[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return reconciliation, nil
+}
+
+func (m *mockReconciliationRepository) FindByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Reconciliation, error) {
+	var result []*entities.Reconciliation
+	for _, reconciliation := range m.reconciliations {
+		if reconciliation.AccountID().Equals(accountID) {
+			result = append(result, reconciliation)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].StatementDate().Equal(result[j].StatementDate()) {
+			return result[i].StatementDate().After(result[j].StatementDate())
+		}
+		return result[i].CreatedAt().After(result[j].CreatedAt())
+	})
+	return result, nil
+}
+
+func (m *mockReconciliationRepository) Save(reconciliation *entities.Reconciliation) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.reconciliations[reconciliation.ID().Value()] = reconciliation
+	return nil
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"fmt"
+
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockTransactionRevisionRepository is a mock implementation of TransactionRevisionRepository for testing.
+// Revisions are kept in the order they were saved.
+type mockTransactionRevisionRepository struct {
+	revisions []*entities.TransactionRevision
+	saveErr   error
+}
+
+func newMockTransactionRevisionRepository() *mockTransactionRevisionRepository {
+	return &mockTransactionRevisionRepository{}
+}
+
+func (m *mockTransactionRevisionRepository) FindByID(id valueobjects.TransactionRevisionID) (*entities.TransactionRevision, error) {
+	for _, revision := range m.revisions {
+		if revision.ID().Equals(id) {
+			return revision, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockTransactionRevisionRepository) FindByTransactionID(transactionID valueobjects.TransactionID) ([]*entities.TransactionRevision, error) {
+	var result []*entities.TransactionRevision
+	for _, revision := range m.revisions {
+		if revision.TransactionID().Equals(transactionID) {
+			result = append(result, revision)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTransactionRevisionRepository) Save(revision *entities.TransactionRevision) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	for _, existing := range m.revisions {
+		if existing.ID().Equals(revision.ID()) {
+			return fmt.Errorf("revision already exists: %s", revision.ID().Value())
+		}
+	}
+	m.revisions = append(m.revisions, revision)
+	return nil
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"sort"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// mockTransactionRuleRepository is a mock implementation of TransactionRuleRepository for testing.
+type mockTransactionRuleRepository struct {
+	rules map[string]*entities.TransactionRule
+}
+
+func newMockTransactionRuleRepository() *mockTransactionRuleRepository {
+	return &mockTransactionRuleRepository{
+		rules: make(map[string]*entities.TransactionRule),
+	}
+}
+
+func (m *mockTransactionRuleRepository) FindByID(id valueobjects.RuleID) (*entities.TransactionRule, error) {
+	rule, exists := m.rules[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return rule, nil
+}
+
+func (m *mockTransactionRuleRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.TransactionRule, error) {
+	var result []*entities.TransactionRule
+	for _, rule := range m.rules {
+		if rule.UserID().Equals(userID) {
+			result = append(result, rule)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Priority() != result[j].Priority() {
+			return result[i].Priority() < result[j].Priority()
+		}
+		return result[i].CreatedAt().Before(result[j].CreatedAt())
+	})
+	return result, nil
+}
+
+func (m *mockTransactionRuleRepository) Save(rule *entities.TransactionRule) error {
+	m.rules[rule.ID().Value()] = rule
+	return nil
+}
+
+func (m *mockTransactionRuleRepository) Delete(id valueobjects.RuleID) error {
+	delete(m.rules, id.Value())
+	return nil
+}
@@ -9,12 +9,17 @@ import (
 
 // mockUnitOfWork is a mock implementation of UnitOfWork for testing.
 type mockUnitOfWork struct {
-	transactionRepository transactionrepositories.TransactionRepository
-	accountRepository     accountrepositories.AccountRepository
-	inTransaction         bool
-	beginErr              error
-	commitErr             error
-	rollbackErr           error
+	transactionRepository    transactionrepositories.TransactionRepository
+	accountRepository        accountrepositories.AccountRepository
+	importBatchRepository    transactionrepositories.ImportBatchRepository
+	reconciliationRepository transactionrepositories.ReconciliationRepository
+	revisionRepository       *mockTransactionRevisionRepository
+	inTransaction            bool
+	beginErr                 error
+	commitErr                error
+	rollbackErr              error
+	savePoints               []string
+	rollbacksTo              []string
 }
 
 // newMockUnitOfWork creates a new mock UnitOfWork instance.
@@ -23,9 +28,12 @@ func newMockUnitOfWork(
 	accountRepository accountrepositories.AccountRepository,
 ) *mockUnitOfWork {
 	return &mockUnitOfWork{
-		transactionRepository: transactionRepository,
-		accountRepository:     accountRepository,
-		inTransaction:         false,
+		transactionRepository:    transactionRepository,
+		accountRepository:        accountRepository,
+		importBatchRepository:    newMockImportBatchRepository(),
+		reconciliationRepository: newMockReconciliationRepository(),
+		revisionRepository:       newMockTransactionRevisionRepository(),
+		inTransaction:            false,
 	}
 }
 
@@ -65,6 +73,24 @@ func (m *mockUnitOfWork) Rollback() error {
 	return nil
 }
 
+// SavePoint marks a save point; the in-memory repositories are not rolled back to it.
+func (m *mockUnitOfWork) SavePoint(name string) error {
+	if !m.inTransaction {
+		return fmt.Errorf("no transaction in progress")
+	}
+	m.savePoints = append(m.savePoints, name)
+	return nil
+}
+
+// RollbackTo records the rollback to the save point.
+func (m *mockUnitOfWork) RollbackTo(name string) error {
+	if !m.inTransaction {
+		return fmt.Errorf("no transaction in progress")
+	}
+	m.rollbacksTo = append(m.rollbacksTo, name)
+	return nil
+}
+
 // TransactionRepository returns a TransactionRepository.
 func (m *mockUnitOfWork) TransactionRepository() transactionrepositories.TransactionRepository {
 	return m.transactionRepository
@@ -75,6 +101,26 @@ func (m *mockUnitOfWork) AccountRepository() accountrepositories.AccountReposito
 	return m.accountRepository
 }
 
+// CreditCardStatementRepository returns a CreditCardStatementRepository.
+func (m *mockUnitOfWork) CreditCardStatementRepository() accountrepositories.CreditCardStatementRepository {
+	return nil
+}
+
+// ImportBatchRepository returns an ImportBatchRepository.
+func (m *mockUnitOfWork) ImportBatchRepository() transactionrepositories.ImportBatchRepository {
+	return m.importBatchRepository
+}
+
+// ReconciliationRepository returns a ReconciliationRepository.
+func (m *mockUnitOfWork) ReconciliationRepository() transactionrepositories.ReconciliationRepository {
+	return m.reconciliationRepository
+}
+
+// TransactionRevisionRepository returns a TransactionRevisionRepository.
+func (m *mockUnitOfWork) TransactionRevisionRepository() transactionrepositories.TransactionRevisionRepository {
+	return m.revisionRepository
+}
+
 // IsInTransaction returns true if a transaction is currently in progress.
 func (m *mockUnitOfWork) IsInTransaction() bool {
 	return m.inTransaction
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// PauseRecurringSeriesUseCase handles pausing a recurring series.
+// While paused, occurrences falling due are not created.
+type PauseRecurringSeriesUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	eventBus              *eventbus.EventBus
+}
+
+// NewPauseRecurringSeriesUseCase creates a new PauseRecurringSeriesUseCase instance.
+func NewPauseRecurringSeriesUseCase(
+	transactionRepository repositories.TransactionRepository,
+	eventBus *eventbus.EventBus,
+) *PauseRecurringSeriesUseCase {
+	return &PauseRecurringSeriesUseCase{
+		transactionRepository: transactionRepository,
+		eventBus:              eventBus,
+	}
+}
+
+// Execute pauses the series.
+func (uc *PauseRecurringSeriesUseCase) Execute(input dtos.PauseRecurringSeriesInput) (*dtos.RecurringSeriesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	series, err := findUserRecurringSeries(uc.transactionRepository, userID, input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := series.PauseRecurrence(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepository.Save(series); err != nil {
+		return nil, fmt.Errorf("failed to save recurring series: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range series.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	series.ClearEvents()
+
+	output := recurringSeriesOutput(series, time.Now())
+	return &output, nil
+}
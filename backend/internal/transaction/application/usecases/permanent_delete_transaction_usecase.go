@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 
+	"gestao-financeira/backend/internal/shared/domain/storage"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
 	"gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
@@ -13,14 +15,21 @@ import (
 // This should only be used by administrators.
 type PermanentDeleteTransactionUseCase struct {
 	transactionRepository repositories.TransactionRepository
+	attachmentRepository  repositories.AttachmentRepository
+	fileStorage           storage.FileStorage
 }
 
 // NewPermanentDeleteTransactionUseCase creates a new PermanentDeleteTransactionUseCase instance.
+// The attachments of deleted transactions are removed when attachmentRepository and fileStorage are given.
 func NewPermanentDeleteTransactionUseCase(
 	transactionRepository repositories.TransactionRepository,
+	attachmentRepository repositories.AttachmentRepository,
+	fileStorage storage.FileStorage,
 ) *PermanentDeleteTransactionUseCase {
 	return &PermanentDeleteTransactionUseCase{
 		transactionRepository: transactionRepository,
+		attachmentRepository:  attachmentRepository,
+		fileStorage:           fileStorage,
 	}
 }
 
@@ -50,6 +59,20 @@ func (uc *PermanentDeleteTransactionUseCase) Execute(input dtos.PermanentDeleteT
 		}
 		// If restore succeeded, we need to delete it again first
 		// Actually, let's just proceed with permanent delete
+		transaction, err = uc.transactionRepository.FindByID(transactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transaction: %w", err)
+		}
+	}
+
+	// Collect the attachments before the transactions (and, through the foreign key, their records) are gone
+	attachmentTransactionIDs := []transactionvalueobjects.TransactionID{transactionID}
+	if transaction != nil && transaction.LinkedTransactionID() != nil {
+		attachmentTransactionIDs = append(attachmentTransactionIDs, *transaction.LinkedTransactionID())
+	}
+	attachments, err := uc.findAttachments(attachmentTransactionIDs)
+	if err != nil {
+		return nil, err
 	}
 
 	// Try to permanently delete
@@ -64,10 +87,60 @@ func (uc *PermanentDeleteTransactionUseCase) Execute(input dtos.PermanentDeleteT
 		return nil, fmt.Errorf("failed to permanently delete transaction: %w", err)
 	}
 
+	// Transfers are removed as a pair, so the counterpart leg is deleted as well
+	if transaction != nil && transaction.LinkedTransactionID() != nil {
+		if err := repo.PermanentDelete(*transaction.LinkedTransactionID()); err != nil {
+			return nil, fmt.Errorf("failed to permanently delete linked transaction: %w", err)
+		}
+	}
+
+	deletedAttachments, err := uc.deleteAttachments(attachments)
+	if err != nil {
+		return nil, err
+	}
+
 	output := &dtos.PermanentDeleteTransactionOutput{
-		Message:       "Transaction permanently deleted successfully",
-		TransactionID: transactionID.Value(),
+		Message:            "Transaction permanently deleted successfully",
+		TransactionID:      transactionID.Value(),
+		DeletedAttachments: deletedAttachments,
 	}
 
 	return output, nil
 }
+
+// findAttachments returns the attachments of the given transactions.
+func (uc *PermanentDeleteTransactionUseCase) findAttachments(
+	transactionIDs []transactionvalueobjects.TransactionID,
+) ([]*entities.Attachment, error) {
+	if uc.attachmentRepository == nil || uc.fileStorage == nil {
+		return nil, nil
+	}
+
+	var attachments []*entities.Attachment
+	for _, transactionID := range transactionIDs {
+		found, err := uc.attachmentRepository.FindByTransactionID(transactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find attachments: %w", err)
+		}
+		attachments = append(attachments, found...)
+	}
+	return attachments, nil
+}
+
+// deleteAttachments removes the records and files of attachments of deleted transactions.
+// A file that cannot be removed does not fail the operation, since the transaction is already
+// gone and an orphaned file affects no data; it returns how many files were removed.
+func (uc *PermanentDeleteTransactionUseCase) deleteAttachments(attachments []*entities.Attachment) (int, error) {
+	deleted := 0
+	for _, attachment := range attachments {
+		if err := uc.attachmentRepository.Delete(attachment.ID()); err != nil {
+			return deleted, fmt.Errorf("failed to delete attachment: %w", err)
+		}
+		if err := uc.fileStorage.Delete(attachment.StorageKey()); err != nil {
+			_ = err // Ignore for now, but should be logged
+			continue
+		}
+		deleted++
+	}
+	return deleted, nil
+}
@@ -9,6 +9,7 @@ import (
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
@@ -43,11 +44,10 @@ func (m *mockPermanentDeleteTransactionRepository) FindByParentIDAndDate(parentI
 
 func (m *mockPermanentDeleteTransactionRepository) FindByUserIDAndFiltersWithPagination(
 	userID identityvalueobjects.UserID,
-	accountID string,
-	transactionType string,
+	filter repositories.TransactionFilter,
 	offset, limit int,
 ) ([]*entities.Transaction, int64, error) {
-	return m.mockTransactionRepository.FindByUserIDAndFiltersWithPagination(userID, accountID, transactionType, offset, limit)
+	return m.mockTransactionRepository.FindByUserIDAndFiltersWithPagination(userID, filter, offset, limit)
 }
 
 func TestPermanentDeleteTransactionUseCase_Execute(t *testing.T) {
@@ -144,7 +144,7 @@ func TestPermanentDeleteTransactionUseCase_Execute(t *testing.T) {
 				tt.input.TransactionID = transactionID
 			}
 
-			useCase := NewPermanentDeleteTransactionUseCase(mockRepo)
+			useCase := NewPermanentDeleteTransactionUseCase(mockRepo, nil, nil)
 			output, err := useCase.Execute(tt.input)
 
 			if tt.wantError {
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// PrepayInstallmentsUseCase pays the remaining installments of a purchase in advance
+// ("antecipação de parcelas"). The installments dated after the payment date are moved to it,
+// keeping their "3/10" numbering; when the card issuer gives a discount for paying early, the
+// paid amount is spread across them and the discount is credited back to the card, atomically
+// using UnitOfWork.
+type PrepayInstallmentsUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewPrepayInstallmentsUseCase creates a new PrepayInstallmentsUseCase instance.
+func NewPrepayInstallmentsUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *PrepayInstallmentsUseCase {
+	return &PrepayInstallmentsUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute prepays the installments dated after the payment date (default today).
+func (uc *PrepayInstallmentsUseCase) Execute(input dtos.PrepayInstallmentsInput) (*dtos.PrepayInstallmentsOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	paymentDate, err := parseInstallmentCutoffDate(input.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get repositories from UnitOfWork
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	installments, err := findInstallmentPurchase(transactionRepository, userID, input.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	prepaid, err := installmentsDueAfter(installments, paymentDate)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := prepaid[0].Amount().Currency()
+	remaining := sharedvalueobjects.Zero(currency)
+	ratios := make([]int64, len(prepaid))
+	for i, installment := range prepaid {
+		remaining, _ = remaining.Add(installment.Amount())
+		ratios[i] = installment.Amount().Amount()
+	}
+
+	// Amount actually paid; defaults to the remaining total (no discount)
+	paid := remaining
+	if input.Amount != nil {
+		paid, err = sharedvalueobjects.NewMoney(int64(math.Round(*input.Amount*100)), currency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prepayment amount: %w", err)
+		}
+		if paid.Amount() > remaining.Amount() {
+			return nil, fmt.Errorf("invalid prepayment amount: must be at most the remaining total %s", remaining.String())
+		}
+		if paid.Amount() < int64(len(prepaid)) {
+			return nil, fmt.Errorf("invalid prepayment amount: must be at least one cent per installment")
+		}
+	}
+
+	amounts, err := paid.Allocate(ratios)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prepayment amount: %w", err)
+	}
+
+	var domainEvents []events.DomainEvent
+	for i, installment := range prepaid {
+		if err := installment.UpdateDate(paymentDate); err != nil {
+			return nil, fmt.Errorf("failed to prepay installment %s: %w", installment.Installment().String(), err)
+		}
+		if !amounts[i].Equals(installment.Amount()) {
+			if err := installment.UpdateAmount(amounts[i]); err != nil {
+				return nil, fmt.Errorf("failed to prepay installment %s: %w", installment.Installment().String(), err)
+			}
+		}
+		if err := transactionRepository.Save(installment); err != nil {
+			return nil, fmt.Errorf("failed to save installment: %w", err)
+		}
+		domainEvents = append(domainEvents, installment.GetEvents()...)
+		installment.ClearEvents()
+	}
+
+	// The discount is no longer owed: reverse it as if it were an expense
+	discount, _ := remaining.Subtract(paid)
+	if discount.IsPositive() {
+		if err := reverseAccountBalance(accountRepository, prepaid[0].AccountID(), transactionvalueobjects.ExpenseType(), discount); err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	domainEvents = append(domainEvents, events.NewBaseDomainEvent("InstallmentsPrepaid", installments[0].InstallmentPurchaseID().Value(), "Transaction"))
+
+	// Publish domain events (after successful commit)
+	for _, event := range domainEvents {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+
+	return &dtos.PrepayInstallmentsOutput{
+		Purchase:       installmentPurchaseOutput(installments, time.Now()),
+		PrepaidCount:   len(prepaid),
+		PrepaidAmount:  paid.Float64(),
+		DiscountAmount: discount.Float64(),
+	}, nil
+}
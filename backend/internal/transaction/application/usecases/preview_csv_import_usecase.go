@@ -0,0 +1,318 @@
+package usecases
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/domain/services"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/infrastructure/importers"
+)
+
+// PreviewCSVImportUseCase parses a CSV bank statement with the given column mapping
+// and returns what would be imported, without saving anything (dry run).
+type PreviewCSVImportUseCase struct {
+	unitOfWork      sharedrepositories.UnitOfWork
+	ruleRepository  repositories.TransactionRuleRepository
+	payeeRepository payeerepositories.PayeeRepository
+}
+
+// NewPreviewCSVImportUseCase creates a new PreviewCSVImportUseCase instance.
+// ruleRepository may be nil, in which case no auto-categorization rules run, and
+// payeeRepository may be nil, in which case no payees are matched.
+func NewPreviewCSVImportUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	ruleRepository repositories.TransactionRuleRepository,
+	payeeRepository payeerepositories.PayeeRepository,
+) *PreviewCSVImportUseCase {
+	return &PreviewCSVImportUseCase{
+		unitOfWork:      unitOfWork,
+		ruleRepository:  ruleRepository,
+		payeeRepository: payeeRepository,
+	}
+}
+
+// Execute parses the statement and reports the valid rows, the per-row errors and the totals.
+func (uc *PreviewCSVImportUseCase) Execute(input dtos.CSVImportInput) (*dtos.PreviewCSVImportOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	account, err := findUserAccount(uc.unitOfWork.AccountRepository(), userID, accountID, "target")
+	if err != nil {
+		return nil, err
+	}
+
+	currency := account.Balance().Currency()
+	rows, rowErrors, err := parseCSVStatement(input, currency)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyRulesToRows(uc.ruleRepository, userID, accountID, rows); err != nil {
+		return nil, err
+	}
+	if err := applyPayeesToRows(uc.payeeRepository, userID, rows); err != nil {
+		return nil, err
+	}
+	flagPossibleDuplicates(uc.unitOfWork.TransactionRepository(), userID, accountID, rows)
+
+	output := &dtos.PreviewCSVImportOutput{
+		AccountID:  accountID.Value(),
+		Currency:   currency.Code(),
+		Rows:       make([]dtos.ImportRowOutput, 0, len(rows)),
+		Errors:     rowErrors,
+		ValidCount: len(rows),
+		ErrorCount: len(rowErrors),
+	}
+
+	var income, expense int64
+	for _, row := range rows {
+		if row.transactionType.IsCredit() {
+			income += row.amount.Amount()
+		} else {
+			expense += row.amount.Amount()
+		}
+		output.Rows = append(output.Rows, row.toOutput())
+	}
+	output.TotalIncome = float64(income) / 100
+	output.TotalExpense = float64(expense) / 100
+	output.NetAmount = float64(income-expense) / 100
+
+	return output, nil
+}
+
+// importedRow is a statement line converted to domain values, ready to become a transaction.
+type importedRow struct {
+	line            int
+	externalID      string // Bank identifier of the entry (empty for CSV)
+	date            time.Time
+	transactionType transactionvalueobjects.TransactionType
+	amount          sharedvalueobjects.Money
+	description     transactionvalueobjects.TransactionDescription
+
+	// Set by the auto-categorization rules
+	categoryID     *categoryvalueobjects.CategoryID
+	tagIDs         []tagvalueobjects.TagID
+	appliedRuleIDs []transactionvalueobjects.RuleID
+
+	// Matched from the description by the payees of the user
+	payeeID *payeevalueobjects.PayeeID
+
+	possibleDuplicateOf string // Saved transaction that looks like the same purchase
+}
+
+// toOutput converts the row to its output DTO.
+func (r importedRow) toOutput() dtos.ImportRowOutput {
+	output := dtos.ImportRowOutput{
+		Line:        r.line,
+		ExternalID:  r.externalID,
+		Date:        r.date.Format("2006-01-02"),
+		Type:        r.transactionType.Value(),
+		Amount:      r.amount.Float64(),
+		Currency:    r.amount.Currency().Code(),
+		Description: r.description.Value(),
+
+		PossibleDuplicateOf: r.possibleDuplicateOf,
+	}
+	if r.categoryID != nil {
+		output.CategoryID = r.categoryID.Value()
+	}
+	for _, tagID := range r.tagIDs {
+		output.TagIDs = append(output.TagIDs, tagID.Value())
+	}
+	if len(r.appliedRuleIDs) > 0 {
+		output.AppliedRuleIDs = ruleIDValues(r.appliedRuleIDs)
+	}
+	if r.payeeID != nil {
+		output.PayeeID = r.payeeID.Value()
+	}
+	return output
+}
+
+// applyRulesToRows runs the enabled auto-categorization rules of the user on the statement rows,
+// setting their category and tags and replacing their description. Does nothing when
+// ruleRepository is nil.
+func applyRulesToRows(
+	ruleRepository repositories.TransactionRuleRepository,
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	rows []importedRow,
+) error {
+	if ruleRepository == nil {
+		return nil
+	}
+
+	rules, err := findEnabledRules(ruleRepository, userID)
+	if err != nil {
+		return err
+	}
+	engine := services.NewRuleEngine(rules)
+	if !engine.HasRules() {
+		return nil
+	}
+
+	for i := range rows {
+		outcome := engine.Evaluate(accountID, rows[i].transactionType, rows[i].amount, rows[i].description.Value())
+		if !outcome.Matched() {
+			continue
+		}
+		rows[i].appliedRuleIDs = outcome.RuleIDs
+		rows[i].categoryID = outcome.CategoryID
+		rows[i].tagIDs = outcome.TagIDs
+		if outcome.Description != nil {
+			rows[i].description = *outcome.Description
+		}
+	}
+	return nil
+}
+
+// applyPayeesToRows matches the statement rows to the payees of the user by their description.
+// Rows the rules left without a category get the default category of their payee. Does nothing
+// when payeeRepository is nil.
+func applyPayeesToRows(
+	payeeRepository payeerepositories.PayeeRepository,
+	userID identityvalueobjects.UserID,
+	rows []importedRow,
+) error {
+	matcher, err := findPayeeMatcher(payeeRepository, userID)
+	if err != nil || matcher == nil {
+		return err
+	}
+
+	for i := range rows {
+		payee := matcher.Match(rows[i].description.Value())
+		if payee == nil {
+			continue
+		}
+		payeeID := payee.ID()
+		rows[i].payeeID = &payeeID
+		if rows[i].categoryID == nil {
+			rows[i].categoryID = payee.DefaultCategoryID()
+		}
+	}
+	return nil
+}
+
+// parseCSVStatement parses a CSV statement and converts its lines to domain values in the
+// account currency. Negative amounts become expenses and positive amounts become income.
+// Lines that fail parsing or domain validation are returned as row errors, in line order.
+func parseCSVStatement(
+	input dtos.CSVImportInput,
+	currency sharedvalueobjects.Currency,
+) ([]importedRow, []dtos.ImportRowErrorOutput, error) {
+	if len(input.Content) == 0 {
+		return nil, nil, errors.New("invalid statement: file is empty")
+	}
+
+	mapping, err := csvMappingFromInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := importers.ParseCSV(bytes.NewReader(input.Content), mapping)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rowErrors := make([]dtos.ImportRowErrorOutput, 0, len(result.Errors))
+	for _, rowErr := range result.Errors {
+		rowErrors = append(rowErrors, dtos.ImportRowErrorOutput{Line: rowErr.Line, Message: rowErr.Message})
+	}
+
+	rows := make([]importedRow, 0, len(result.Rows))
+	for _, parsed := range result.Rows {
+		row, err := importedRowFromParsed(parsed, currency)
+		if err != nil {
+			rowErrors = append(rowErrors, dtos.ImportRowErrorOutput{Line: parsed.Line, Message: err.Error()})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	sort.SliceStable(rowErrors, func(i, j int) bool {
+		return rowErrors[i].Line < rowErrors[j].Line
+	})
+
+	return rows, rowErrors, nil
+}
+
+// importedRowFromParsed validates a parsed statement line against the domain rules.
+func importedRowFromParsed(parsed importers.ParsedRow, currency sharedvalueobjects.Currency) (importedRow, error) {
+	transactionType := transactionvalueobjects.MustTransactionType(transactionvalueobjects.Income)
+	cents := parsed.Amount
+	if cents < 0 {
+		transactionType = transactionvalueobjects.MustTransactionType(transactionvalueobjects.Expense)
+		cents = -cents
+	}
+
+	amount, err := sharedvalueobjects.NewMoney(cents, currency)
+	if err != nil {
+		return importedRow{}, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	description, err := transactionvalueobjects.NewTransactionDescription(parsed.Description)
+	if err != nil {
+		return importedRow{}, fmt.Errorf("invalid description: %w", err)
+	}
+
+	return importedRow{
+		line:            parsed.Line,
+		date:            parsed.Date,
+		transactionType: transactionType,
+		amount:          amount,
+		description:     description,
+	}, nil
+}
+
+// csvMappingFromInput builds the parser column mapping from the import input.
+func csvMappingFromInput(input dtos.CSVImportInput) (importers.CSVMapping, error) {
+	var delimiter rune
+	switch input.Delimiter {
+	case "", ",":
+		delimiter = ','
+	case ";":
+		delimiter = ';'
+	case "|":
+		delimiter = '|'
+	case "tab", "\t":
+		delimiter = '\t'
+	default:
+		return importers.CSVMapping{}, fmt.Errorf("invalid delimiter: %s. Supported values: \",\", \";\", \"|\", \"tab\"", input.Delimiter)
+	}
+
+	hasHeader := true
+	if input.HasHeader != nil {
+		hasHeader = *input.HasHeader
+	}
+
+	return importers.CSVMapping{
+		Delimiter:         delimiter,
+		HasHeader:         hasHeader,
+		DateColumn:        input.DateColumn,
+		DateFormat:        input.DateFormat,
+		AmountColumn:      input.AmountColumn,
+		DebitColumn:       input.DebitColumn,
+		CreditColumn:      input.CreditColumn,
+		DescriptionColumn: input.DescriptionColumn,
+		DecimalSeparator:  input.DecimalSeparator,
+	}, nil
+}
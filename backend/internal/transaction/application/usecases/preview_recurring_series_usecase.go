@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// DefaultRecurringPreviewCount is the number of occurrences previewed when none is given.
+const DefaultRecurringPreviewCount = 12
+
+// PreviewRecurringSeriesUseCase handles previewing the next occurrences of a recurring series.
+type PreviewRecurringSeriesUseCase struct {
+	transactionRepository repositories.TransactionRepository
+}
+
+// NewPreviewRecurringSeriesUseCase creates a new PreviewRecurringSeriesUseCase instance.
+func NewPreviewRecurringSeriesUseCase(transactionRepository repositories.TransactionRepository) *PreviewRecurringSeriesUseCase {
+	return &PreviewRecurringSeriesUseCase{
+		transactionRepository: transactionRepository,
+	}
+}
+
+// Execute returns the next occurrences of the series from today on, with their amounts, leaving
+// out skipped dates. Occurrences are listed even while the series is paused.
+func (uc *PreviewRecurringSeriesUseCase) Execute(input dtos.PreviewRecurringSeriesInput) (*dtos.PreviewRecurringSeriesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	series, err := findUserRecurringSeries(uc.transactionRepository, userID, input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	count := input.Count
+	if count <= 0 {
+		count = DefaultRecurringPreviewCount
+	}
+
+	now := time.Now()
+	dates := series.NextOccurrences(now, count)
+	output := &dtos.PreviewRecurringSeriesOutput{
+		Series:      recurringSeriesOutput(series, now),
+		Occurrences: make([]dtos.RecurringOccurrenceOutput, 0, len(dates)),
+	}
+	for _, date := range dates {
+		output.Occurrences = append(output.Occurrences, dtos.RecurringOccurrenceOutput{
+			Date:   date.Format("2006-01-02"),
+			Amount: series.RecurrenceAmountOn(date).Float64(),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,241 @@
+package usecases
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/infrastructure/importers"
+)
+
+// PreviewStatementImportUseCase parses an OFX or QIF bank statement and returns what would be
+// imported, which entries were already imported before, and how the resulting balance compares
+// with the statement balance, without saving anything (dry run).
+type PreviewStatementImportUseCase struct {
+	unitOfWork      sharedrepositories.UnitOfWork
+	ruleRepository  repositories.TransactionRuleRepository
+	payeeRepository payeerepositories.PayeeRepository
+}
+
+// NewPreviewStatementImportUseCase creates a new PreviewStatementImportUseCase instance.
+// ruleRepository may be nil, in which case no auto-categorization rules run, and
+// payeeRepository may be nil, in which case no payees are matched.
+func NewPreviewStatementImportUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	ruleRepository repositories.TransactionRuleRepository,
+	payeeRepository payeerepositories.PayeeRepository,
+) *PreviewStatementImportUseCase {
+	return &PreviewStatementImportUseCase{
+		unitOfWork:      unitOfWork,
+		ruleRepository:  ruleRepository,
+		payeeRepository: payeeRepository,
+	}
+}
+
+// Execute parses the statement and reports the new entries, the duplicates, the per-entry errors and the totals.
+func (uc *PreviewStatementImportUseCase) Execute(input dtos.StatementImportInput) (*dtos.PreviewStatementImportOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	account, err := findUserAccount(uc.unitOfWork.AccountRepository(), userID, accountID, "target")
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := parseStatementImport(input, account, uc.unitOfWork.TransactionRepository())
+	if err != nil {
+		return nil, err
+	}
+	if err := applyRulesToRows(uc.ruleRepository, userID, accountID, statement.rows); err != nil {
+		return nil, err
+	}
+	if err := applyPayeesToRows(uc.payeeRepository, userID, statement.rows); err != nil {
+		return nil, err
+	}
+	flagPossibleDuplicates(uc.unitOfWork.TransactionRepository(), userID, accountID, statement.rows)
+
+	output := &dtos.PreviewStatementImportOutput{
+		AccountID:      accountID.Value(),
+		Format:         statement.format,
+		Currency:       account.Balance().Currency().Code(),
+		Rows:           make([]dtos.ImportRowOutput, 0, len(statement.rows)),
+		Duplicates:     make([]dtos.ImportRowOutput, 0, len(statement.duplicates)),
+		Errors:         statement.rowErrors,
+		ValidCount:     len(statement.rows),
+		DuplicateCount: len(statement.duplicates),
+		ErrorCount:     len(statement.rowErrors),
+	}
+
+	var income, expense int64
+	for _, row := range statement.rows {
+		if row.transactionType.IsCredit() {
+			income += row.amount.Amount()
+		} else {
+			expense += row.amount.Amount()
+		}
+		output.Rows = append(output.Rows, row.toOutput())
+	}
+	for _, row := range statement.duplicates {
+		output.Duplicates = append(output.Duplicates, row.toOutput())
+	}
+	output.TotalIncome = float64(income) / 100
+	output.TotalExpense = float64(expense) / 100
+	output.NetAmount = float64(income-expense) / 100
+	output.Reconciliation = statement.reconciliation(account.Balance().Amount() + statement.netCents())
+
+	return output, nil
+}
+
+// statementImport is an OFX or QIF statement converted to domain values for an account.
+type statementImport struct {
+	format        string
+	rows          []importedRow // Entries not imported into the account yet
+	duplicates    []importedRow // Entries already imported (or repeated within the file)
+	rowErrors     []dtos.ImportRowErrorOutput
+	ledgerBalance *importers.StatementBalance
+}
+
+// parseStatementImport parses an OFX or QIF statement for the account and separates the entries
+// whose external ID is already used by a transaction of the account. The statement currency,
+// when given, must match the account currency since no exchange rate is assumed.
+func parseStatementImport(
+	input dtos.StatementImportInput,
+	account *accountentities.Account,
+	transactionRepository repositories.TransactionRepository,
+) (*statementImport, error) {
+	if len(input.Content) == 0 {
+		return nil, errors.New("invalid statement: file is empty")
+	}
+
+	format, err := statementFormat(input.Format, input.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *importers.StatementParseResult
+	if format == entities.ImportSourceOFX {
+		result, err = importers.ParseOFX(bytes.NewReader(input.Content))
+	} else {
+		result, err = importers.ParseQIF(bytes.NewReader(input.Content), input.DateFormat)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	currency := account.Balance().Currency()
+	if result.Currency != "" && result.Currency != currency.Code() {
+		return nil, fmt.Errorf("invalid statement: currency %s does not match account currency %s", result.Currency, currency.Code())
+	}
+
+	statement := &statementImport{
+		format:        format,
+		rowErrors:     make([]dtos.ImportRowErrorOutput, 0, len(result.Errors)),
+		ledgerBalance: result.LedgerBalance,
+	}
+	for _, rowErr := range result.Errors {
+		statement.rowErrors = append(statement.rowErrors, dtos.ImportRowErrorOutput{Line: rowErr.Line, Message: rowErr.Message})
+	}
+
+	externalIDs := make([]string, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		externalIDs = append(externalIDs, entry.ExternalID)
+	}
+	existing, err := transactionRepository.FindExistingExternalIDs(account.ID(), externalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for already imported entries: %w", err)
+	}
+
+	seen := make(map[string]bool, len(result.Entries))
+	for _, entry := range result.Entries {
+		row, err := importedRowFromParsed(importers.ParsedRow{
+			Line:        entry.Line,
+			Date:        entry.Date,
+			Amount:      entry.Amount,
+			Description: entry.Description,
+		}, currency)
+		if err != nil {
+			statement.rowErrors = append(statement.rowErrors, dtos.ImportRowErrorOutput{Line: entry.Line, Message: err.Error()})
+			continue
+		}
+		row.externalID = entry.ExternalID
+
+		if existing[entry.ExternalID] || seen[entry.ExternalID] {
+			statement.duplicates = append(statement.duplicates, row)
+			continue
+		}
+		seen[entry.ExternalID] = true
+		statement.rows = append(statement.rows, row)
+	}
+
+	sort.SliceStable(statement.rowErrors, func(i, j int) bool {
+		return statement.rowErrors[i].Line < statement.rowErrors[j].Line
+	})
+
+	return statement, nil
+}
+
+// netCents returns the signed total of the new entries in cents.
+func (s *statementImport) netCents() int64 {
+	var net int64
+	for _, row := range s.rows {
+		if row.transactionType.IsCredit() {
+			net += row.amount.Amount()
+		} else {
+			net -= row.amount.Amount()
+		}
+	}
+	return net
+}
+
+// reconciliation compares the given account balance (in cents) with the statement ledger balance.
+// Returns nil when the statement has no ledger balance (QIF, or OFX without LEDGERBAL).
+func (s *statementImport) reconciliation(accountBalance int64) *dtos.BalanceReconciliationOutput {
+	if s.ledgerBalance == nil {
+		return nil
+	}
+
+	difference := accountBalance - s.ledgerBalance.Amount
+	return &dtos.BalanceReconciliationOutput{
+		StatementBalance: float64(s.ledgerBalance.Amount) / 100,
+		StatementDate:    s.ledgerBalance.Date.Format("2006-01-02"),
+		AccountBalance:   float64(accountBalance) / 100,
+		Difference:       float64(difference) / 100,
+		Reconciled:       difference == 0,
+	}
+}
+
+// statementFormat returns the import source for an OFX or QIF statement, taken from the
+// requested format or, when none is given, from the file extension.
+func statementFormat(format, fileName string) (string, error) {
+	format = strings.ToUpper(strings.TrimSpace(format))
+	if format == "" {
+		format = strings.ToUpper(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	}
+
+	switch format {
+	case entities.ImportSourceOFX, entities.ImportSourceQIF:
+		return format, nil
+	case "":
+		return "", errors.New("invalid statement format: format is required when the file has no .ofx or .qif extension")
+	default:
+		return "", fmt.Errorf("invalid statement format: %s. Supported values: OFX, QIF", format)
+	}
+}
@@ -0,0 +1,334 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// reconciliationTestSetup holds an account with three expenses: two up to the 2026-09-30
+// statement and one after it. Before any of them the balance was 1000.00.
+type reconciliationTestSetup struct {
+	uow       *mockUnitOfWork
+	txRepo    *mockTransactionRepository
+	accRepo   *mockAccountRepository
+	userID    identityvalueobjects.UserID
+	accountID accountvalueobjects.AccountID
+	bakery    *entities.Transaction // 45.90 on 2026-09-10
+	market    *entities.Transaction // 120.00 on 2026-09-28
+	pharmacy  *entities.Transaction // 20.00 on 2026-10-02
+}
+
+func setupReconciliationTest(t *testing.T) *reconciliationTestSetup {
+	t.Helper()
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 100000-4590-12000-2000)
+
+	return &reconciliationTestSetup{
+		uow:       uow,
+		txRepo:    txRepo,
+		accRepo:   accRepo,
+		userID:    userID,
+		accountID: accountID,
+		bakery:    saveTestExpense(t, txRepo, userID, accountID, 4590, "Padaria", time.Date(2026, 9, 10, 0, 0, 0, 0, time.UTC)),
+		market:    saveTestExpense(t, txRepo, userID, accountID, 12000, "Mercado", time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC)),
+		pharmacy:  saveTestExpense(t, txRepo, userID, accountID, 2000, "Farmácia", time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+// startReconciliation starts a reconciliation of the test account against a statement.
+func (s *reconciliationTestSetup) startReconciliation(t *testing.T, statementDate string, statementBalance float64) *dtos.ReconciliationOutput {
+	t.Helper()
+
+	output, err := NewStartReconciliationUseCase(s.uow.ReconciliationRepository(), s.accRepo, s.txRepo, eventbus.NewEventBus()).Execute(dtos.StartReconciliationInput{
+		UserID:           s.userID.Value(),
+		AccountID:        s.accountID.Value(),
+		StatementDate:    statementDate,
+		StatementBalance: statementBalance,
+	})
+	if err != nil {
+		t.Fatalf("failed to start reconciliation: %v", err)
+	}
+	return output
+}
+
+// clear marks the transaction as seen on the bank statement.
+func (s *reconciliationTestSetup) clear(t *testing.T, transaction *entities.Transaction) {
+	t.Helper()
+
+	cleared := transactionvalueobjects.Cleared
+	_, err := NewUpdateTransactionUseCase(s.uow, nil, nil, nil, eventbus.NewEventBus()).Execute(dtos.UpdateTransactionInput{
+		TransactionID: transaction.ID().Value(),
+		Status:        &cleared,
+	})
+	if err != nil {
+		t.Fatalf("failed to clear transaction: %v", err)
+	}
+}
+
+func TestStartReconciliationUseCase_Execute(t *testing.T) {
+	s := setupReconciliationTest(t)
+	s.clear(t, s.bakery)
+
+	output := s.startReconciliation(t, "2026-09-30", 954.10)
+
+	if output.Status != entities.ReconciliationStatusInProgress {
+		t.Errorf("expected status IN_PROGRESS, got %s", output.Status)
+	}
+	if *output.ReconciledBalance != 1000.00 {
+		t.Errorf("expected reconciled balance 1000.00, got %.2f", *output.ReconciledBalance)
+	}
+	if *output.ClearedBalance != 954.10 || *output.Difference != 0 {
+		t.Errorf("expected cleared balance 954.10 and no difference, got %.2f and %.2f", *output.ClearedBalance, *output.Difference)
+	}
+	if output.ClearedCount != 1 {
+		t.Errorf("expected 1 cleared transaction, got %d", output.ClearedCount)
+	}
+	// The pharmacy expense is dated after the statement
+	if len(output.Transactions) != 2 || output.Transactions[0].TransactionID != s.bakery.ID().Value() {
+		t.Errorf("expected the bakery and market transactions, got %+v", output.Transactions)
+	}
+
+	t.Run("rejects a second reconciliation in progress", func(t *testing.T) {
+		_, err := NewStartReconciliationUseCase(s.uow.ReconciliationRepository(), s.accRepo, s.txRepo, eventbus.NewEventBus()).Execute(dtos.StartReconciliationInput{
+			UserID:           s.userID.Value(),
+			AccountID:        s.accountID.Value(),
+			StatementDate:    "2026-10-31",
+			StatementBalance: 814.10,
+		})
+		if err == nil || !strings.Contains(err.Error(), "in progress") {
+			t.Fatalf("expected in progress error, got %v", err)
+		}
+	})
+
+	t.Run("rejects accounts of other users", func(t *testing.T) {
+		_, err := NewStartReconciliationUseCase(s.uow.ReconciliationRepository(), s.accRepo, s.txRepo, eventbus.NewEventBus()).Execute(dtos.StartReconciliationInput{
+			UserID:           identityvalueobjects.GenerateUserID().Value(),
+			AccountID:        s.accountID.Value(),
+			StatementDate:    "2026-09-30",
+			StatementBalance: 954.10,
+		})
+		if apperrors.MapDomainError(err).Code != 403 {
+			t.Fatalf("expected forbidden error, got %v", err)
+		}
+	})
+}
+
+func TestGetReconciliationUseCase_Execute(t *testing.T) {
+	s := setupReconciliationTest(t)
+	started := s.startReconciliation(t, "2026-09-30", 834.10)
+
+	if *started.Difference != -165.90 {
+		t.Fatalf("expected difference -165.90 before clearing, got %.2f", *started.Difference)
+	}
+
+	s.clear(t, s.bakery)
+	s.clear(t, s.market)
+	// Cleared after the statement date: must not count
+	s.clear(t, s.pharmacy)
+
+	output, err := NewGetReconciliationUseCase(s.uow.ReconciliationRepository(), s.accRepo, s.txRepo).Execute(dtos.GetReconciliationInput{
+		UserID:           s.userID.Value(),
+		ReconciliationID: started.ReconciliationID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if *output.ClearedBalance != 834.10 || *output.Difference != 0 {
+		t.Errorf("expected cleared balance 834.10 and no difference, got %.2f and %.2f", *output.ClearedBalance, *output.Difference)
+	}
+	if output.ClearedCount != 2 {
+		t.Errorf("expected 2 cleared transactions, got %d", output.ClearedCount)
+	}
+
+	t.Run("rejects unknown reconciliations", func(t *testing.T) {
+		_, err := NewGetReconciliationUseCase(s.uow.ReconciliationRepository(), s.accRepo, s.txRepo).Execute(dtos.GetReconciliationInput{
+			UserID:           s.userID.Value(),
+			ReconciliationID: transactionvalueobjects.GenerateReconciliationID().Value(),
+		})
+		if err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func TestCompleteReconciliationUseCase_Execute(t *testing.T) {
+	t.Run("fails while there is a difference", func(t *testing.T) {
+		s := setupReconciliationTest(t)
+		started := s.startReconciliation(t, "2026-09-30", 954.10)
+
+		_, err := NewCompleteReconciliationUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.CompleteReconciliationInput{
+			UserID:           s.userID.Value(),
+			ReconciliationID: started.ReconciliationID,
+		})
+		if err == nil || !strings.Contains(err.Error(), "differs from statement balance") {
+			t.Fatalf("expected difference error, got %v", err)
+		}
+		if s.bakery.Status().IsReconciled() {
+			t.Error("expected no transaction to be reconciled")
+		}
+	})
+
+	t.Run("reconciles the cleared transactions up to the statement date", func(t *testing.T) {
+		s := setupReconciliationTest(t)
+		s.clear(t, s.bakery)
+		s.clear(t, s.pharmacy)
+		started := s.startReconciliation(t, "2026-09-30", 954.10)
+
+		output, err := NewCompleteReconciliationUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.CompleteReconciliationInput{
+			UserID:           s.userID.Value(),
+			ReconciliationID: started.ReconciliationID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if output.Status != entities.ReconciliationStatusCompleted || output.ReconciledCount != 1 || output.CompletedAt == "" {
+			t.Errorf("unexpected output: %+v", output)
+		}
+		if !s.bakery.Status().IsReconciled() || s.bakery.ReconciliationID().Value() != started.ReconciliationID {
+			t.Errorf("expected bakery expense to be reconciled, got %s", s.bakery.Status().Value())
+		}
+		if !s.market.Status().IsPending() || !s.pharmacy.Status().IsCleared() {
+			t.Errorf("expected market pending and pharmacy cleared, got %s and %s", s.market.Status().Value(), s.pharmacy.Status().Value())
+		}
+
+		// The next statement cannot be older than the reconciled one
+		_, err = NewStartReconciliationUseCase(s.uow.ReconciliationRepository(), s.accRepo, s.txRepo, eventbus.NewEventBus()).Execute(dtos.StartReconciliationInput{
+			UserID:           s.userID.Value(),
+			AccountID:        s.accountID.Value(),
+			StatementDate:    "2026-09-15",
+			StatementBalance: 954.10,
+		})
+		if err == nil || !strings.Contains(err.Error(), "last reconciled statement") {
+			t.Fatalf("expected statement date error, got %v", err)
+		}
+
+		// The reconciled balance now starts from the reconciled transactions
+		next := s.startReconciliation(t, "2026-10-31", 814.10)
+		if *next.ReconciledBalance != 954.10 || *next.ClearedBalance != 934.10 {
+			t.Errorf("expected reconciled balance 954.10 and cleared balance 934.10, got %.2f and %.2f", *next.ReconciledBalance, *next.ClearedBalance)
+		}
+	})
+}
+
+func TestReconciledTransactions_AreLocked(t *testing.T) {
+	s := setupReconciliationTest(t)
+	s.clear(t, s.bakery)
+	started := s.startReconciliation(t, "2026-09-30", 954.10)
+	if _, err := NewCompleteReconciliationUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.CompleteReconciliationInput{
+		UserID:           s.userID.Value(),
+		ReconciliationID: started.ReconciliationID,
+	}); err != nil {
+		t.Fatalf("failed to complete reconciliation: %v", err)
+	}
+
+	updateUseCase := NewUpdateTransactionUseCase(s.uow, nil, nil, nil, eventbus.NewEventBus())
+
+	amount := 50.0
+	if _, err := updateUseCase.Execute(dtos.UpdateTransactionInput{
+		TransactionID: s.bakery.ID().Value(),
+		Amount:        &amount,
+	}); err == nil || !strings.Contains(err.Error(), "reconciled transaction cannot be changed") {
+		t.Fatalf("expected reconciled update error, got %v", err)
+	}
+
+	if _, err := NewDeleteTransactionUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.DeleteTransactionInput{
+		TransactionID: s.bakery.ID().Value(),
+	}); err == nil || !strings.Contains(err.Error(), "reconciled transaction cannot be deleted") {
+		t.Fatalf("expected reconciled delete error, got %v", err)
+	}
+
+	reconciled := transactionvalueobjects.Reconciled
+	if _, err := updateUseCase.Execute(dtos.UpdateTransactionInput{
+		TransactionID: s.market.ID().Value(),
+		Status:        &reconciled,
+	}); err == nil {
+		t.Fatal("expected error when marking a transaction as reconciled directly")
+	}
+
+	// Unlocking and editing in the same request is refused
+	cleared := transactionvalueobjects.Cleared
+	if _, err := updateUseCase.Execute(dtos.UpdateTransactionInput{
+		TransactionID: s.bakery.ID().Value(),
+		Status:        &cleared,
+		Amount:        &amount,
+	}); err == nil || !strings.Contains(err.Error(), "reconciled transaction cannot be changed") {
+		t.Fatalf("expected reconciled update error, got %v", err)
+	}
+	if !s.bakery.Status().IsReconciled() {
+		t.Fatalf("expected bakery expense to stay reconciled, got %s", s.bakery.Status().Value())
+	}
+
+	// Setting the status back to CLEARED unlocks it for the next request
+	if _, err := updateUseCase.Execute(dtos.UpdateTransactionInput{
+		TransactionID: s.bakery.ID().Value(),
+		Status:        &cleared,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := updateUseCase.Execute(dtos.UpdateTransactionInput{
+		TransactionID: s.bakery.ID().Value(),
+		Amount:        &amount,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Status != transactionvalueobjects.Cleared || output.ReconciliationID != "" || output.Amount != 50.0 {
+		t.Errorf("unexpected output: status %s, reconciliation %q, amount %.2f", output.Status, output.ReconciliationID, output.Amount)
+	}
+}
+
+func TestCancelReconciliationUseCase_Execute(t *testing.T) {
+	s := setupReconciliationTest(t)
+	s.clear(t, s.bakery)
+	started := s.startReconciliation(t, "2026-09-30", 900.00)
+
+	useCase := NewCancelReconciliationUseCase(s.uow.ReconciliationRepository(), eventbus.NewEventBus())
+	output, err := useCase.Execute(dtos.CancelReconciliationInput{
+		UserID:           s.userID.Value(),
+		ReconciliationID: started.ReconciliationID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Status != entities.ReconciliationStatusCanceled || output.CanceledAt == "" {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	if !s.bakery.Status().IsCleared() {
+		t.Errorf("expected bakery expense to stay cleared, got %s", s.bakery.Status().Value())
+	}
+
+	if _, err := useCase.Execute(dtos.CancelReconciliationInput{
+		UserID:           s.userID.Value(),
+		ReconciliationID: started.ReconciliationID,
+	}); err == nil {
+		t.Fatal("expected error when canceling twice")
+	}
+
+	// A new reconciliation can be started once the previous one is canceled
+	s.startReconciliation(t, "2026-09-30", 954.10)
+
+	history, err := NewListReconciliationsUseCase(s.uow.ReconciliationRepository(), s.accRepo).Execute(dtos.ListReconciliationsInput{
+		UserID:    s.userID.Value(),
+		AccountID: s.accountID.Value(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if history.Count != 2 {
+		t.Errorf("expected 2 reconciliations, got %d", history.Count)
+	}
+}
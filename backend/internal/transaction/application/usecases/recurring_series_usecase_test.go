@@ -0,0 +1,279 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// recurringSeriesTestSetup holds a monthly rent series started three months ago on day 5, with
+// the two occurrences since then already created.
+type recurringSeriesTestSetup struct {
+	uow         *mockUnitOfWork
+	txRepo      *mockTransactionRepository
+	accRepo     *mockAccountRepository
+	userID      identityvalueobjects.UserID
+	accountID   accountvalueobjects.AccountID
+	series      *entities.Transaction
+	occurrences []*entities.Transaction
+}
+
+func setupRecurringSeriesTest(t *testing.T) *recurringSeriesTestSetup {
+	t.Helper()
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 1000000)
+
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month()-3, 5, 0, 0, 0, 0, time.UTC)
+	amount, _ := sharedvalueobjects.NewMoney(150000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Aluguel")
+	frequency := transactionvalueobjects.MonthlyFrequency()
+
+	series, err := entities.NewTransactionWithRecurrence(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, start, true, &frequency, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create recurring series: %v", err)
+	}
+	series.ClearEvents()
+	_ = txRepo.Save(series)
+
+	s := &recurringSeriesTestSetup{uow: uow, txRepo: txRepo, accRepo: accRepo, userID: userID, accountID: accountID, series: series}
+	parentID := series.ID()
+	for i := 1; i <= 2; i++ {
+		occurrence, err := entities.NewTransactionWithRecurrence(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, series.OccurrenceDate(i), false, nil, nil, &parentID)
+		if err != nil {
+			t.Fatalf("failed to create occurrence: %v", err)
+		}
+		occurrence.ClearEvents()
+		_ = txRepo.Save(occurrence)
+		s.occurrences = append(s.occurrences, occurrence)
+	}
+
+	return s
+}
+
+// balanceCents returns the current balance of the test account in cents.
+func (s *recurringSeriesTestSetup) balanceCents() int64 {
+	account, _ := s.accRepo.FindByID(s.accountID)
+	return account.Balance().Amount()
+}
+
+func TestListRecurringSeriesUseCase_Execute(t *testing.T) {
+	s := setupRecurringSeriesTest(t)
+	seriesID := s.series.ID().Value()
+
+	output, err := NewListRecurringSeriesUseCase(s.txRepo).Execute(dtos.ListRecurringSeriesInput{UserID: s.userID.Value()})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Count != 1 || output.Series[0].SeriesID != seriesID {
+		t.Fatalf("Execute() returned %d series, want the rent series only", output.Count)
+	}
+	series := output.Series[0]
+	if series.Status != "ACTIVE" || series.Frequency != "MONTHLY" || series.Amount != 1500 {
+		t.Errorf("Execute() series = %+v", series)
+	}
+	if series.NextOccurrence < time.Now().UTC().Format("2006-01-02") {
+		t.Errorf("NextOccurrence = %s, want today or later", series.NextOccurrence)
+	}
+
+	paused, _ := NewListRecurringSeriesUseCase(s.txRepo).Execute(dtos.ListRecurringSeriesInput{UserID: s.userID.Value(), Status: "PAUSED"})
+	if paused.Count != 0 {
+		t.Errorf("Execute() with status PAUSED returned %d series, want 0", paused.Count)
+	}
+}
+
+func TestPreviewRecurringSeriesUseCase_Execute(t *testing.T) {
+	s := setupRecurringSeriesTest(t)
+
+	// The ID of an occurrence resolves to its series
+	output, err := NewPreviewRecurringSeriesUseCase(s.txRepo).Execute(dtos.PreviewRecurringSeriesInput{
+		UserID:   s.userID.Value(),
+		SeriesID: s.occurrences[0].ID().Value(),
+		Count:    3,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Series.SeriesID != s.series.ID().Value() {
+		t.Errorf("Series.SeriesID = %s, want %s", output.Series.SeriesID, s.series.ID().Value())
+	}
+	if len(output.Occurrences) != 3 {
+		t.Fatalf("Execute() returned %d occurrences, want 3", len(output.Occurrences))
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+	for i, occurrence := range output.Occurrences {
+		if occurrence.Date < today || occurrence.Date[8:] != "05" || occurrence.Amount != 1500 {
+			t.Errorf("Occurrences[%d] = %+v", i, occurrence)
+		}
+	}
+
+	_, err = NewPreviewRecurringSeriesUseCase(s.txRepo).Execute(dtos.PreviewRecurringSeriesInput{
+		UserID:   identityvalueobjects.GenerateUserID().Value(),
+		SeriesID: s.series.ID().Value(),
+	})
+	if err == nil {
+		t.Error("Execute() expected error for a series of another user")
+	}
+}
+
+func TestSkipRecurringOccurrenceUseCase_Execute(t *testing.T) {
+	s := setupRecurringSeriesTest(t)
+	useCase := NewSkipRecurringOccurrenceUseCase(s.uow, eventbus.NewEventBus())
+	created := s.occurrences[0]
+	date := created.Date().Format("2006-01-02")
+	balanceBefore := s.balanceCents()
+
+	output, err := useCase.Execute(dtos.SkipRecurringOccurrenceInput{
+		UserID:   s.userID.Value(),
+		SeriesID: s.series.ID().Value(),
+		Date:     date,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// The occurrence already created is deleted and its expense reversed
+	if output.DeletedTransactionID != created.ID().Value() {
+		t.Errorf("DeletedTransactionID = %s, want %s", output.DeletedTransactionID, created.ID().Value())
+	}
+	if existing, _ := s.txRepo.FindByID(created.ID()); existing != nil {
+		t.Error("Execute() expected the occurrence to be deleted")
+	}
+	if got := s.balanceCents(); got != balanceBefore+150000 {
+		t.Errorf("balance = %d, want %d", got, balanceBefore+150000)
+	}
+	if len(output.Series.SkippedDates) != 1 || output.Series.SkippedDates[0] != date {
+		t.Errorf("SkippedDates = %v, want [%s]", output.Series.SkippedDates, date)
+	}
+
+	_, err = useCase.Execute(dtos.SkipRecurringOccurrenceInput{
+		UserID:   s.userID.Value(),
+		SeriesID: s.series.ID().Value(),
+		Date:     date,
+	})
+	if err == nil {
+		t.Error("Execute() expected error for an occurrence already skipped")
+	}
+}
+
+func TestChangeRecurringAmountUseCase_Execute(t *testing.T) {
+	s := setupRecurringSeriesTest(t)
+	from := s.occurrences[1]
+	balanceBefore := s.balanceCents()
+
+	output, err := NewChangeRecurringAmountUseCase(s.uow, eventbus.NewEventBus()).Execute(dtos.ChangeRecurringAmountInput{
+		UserID:   s.userID.Value(),
+		SeriesID: s.series.ID().Value(),
+		FromDate: from.Date().Format("2006-01-02"),
+		Amount:   1650,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// Only the occurrence created on or after the date is updated
+	if output.UpdatedCount != 1 {
+		t.Errorf("UpdatedCount = %d, want 1", output.UpdatedCount)
+	}
+	if got := from.Amount().Amount(); got != 165000 {
+		t.Errorf("occurrence amount = %d, want 165000", got)
+	}
+	if got := s.occurrences[0].Amount().Amount(); got != 150000 {
+		t.Errorf("earlier occurrence amount = %d, want 150000", got)
+	}
+	if got := s.series.Amount().Amount(); got != 150000 {
+		t.Errorf("series amount = %d, want 150000", got)
+	}
+	if got := s.balanceCents(); got != balanceBefore-15000 {
+		t.Errorf("balance = %d, want %d", got, balanceBefore-15000)
+	}
+	if output.Series.Amount != 1650 || len(output.Series.AmountChanges) != 1 {
+		t.Errorf("Series = %+v, want the next occurrence at 1650 with one amount change", output.Series)
+	}
+}
+
+func TestPauseResumeAndEndRecurringSeriesUseCases(t *testing.T) {
+	s := setupRecurringSeriesTest(t)
+	eventBus := eventbus.NewEventBus()
+	seriesID := s.series.ID().Value()
+
+	paused, err := NewPauseRecurringSeriesUseCase(s.txRepo, eventBus).Execute(dtos.PauseRecurringSeriesInput{UserID: s.userID.Value(), SeriesID: seriesID})
+	if err != nil {
+		t.Fatalf("Pause Execute() error = %v", err)
+	}
+	if paused.Status != "PAUSED" || paused.PausedAt == "" {
+		t.Errorf("Pause Execute() status = %s, paused at %q", paused.Status, paused.PausedAt)
+	}
+	if _, err := NewPauseRecurringSeriesUseCase(s.txRepo, eventBus).Execute(dtos.PauseRecurringSeriesInput{UserID: s.userID.Value(), SeriesID: seriesID}); err == nil {
+		t.Error("Pause Execute() expected error for a paused series")
+	}
+
+	resumed, err := NewResumeRecurringSeriesUseCase(s.txRepo, eventBus).Execute(dtos.ResumeRecurringSeriesInput{UserID: s.userID.Value(), SeriesID: seriesID})
+	if err != nil {
+		t.Fatalf("Resume Execute() error = %v", err)
+	}
+	if resumed.Status != "ACTIVE" {
+		t.Errorf("Resume Execute() status = %s, want ACTIVE", resumed.Status)
+	}
+
+	endUseCase := NewEndRecurringSeriesUseCase(s.txRepo, eventBus)
+	if _, err := endUseCase.Execute(dtos.EndRecurringSeriesInput{
+		UserID:   s.userID.Value(),
+		SeriesID: seriesID,
+		EndDate:  s.series.Date().AddDate(0, 0, -1).Format("2006-01-02"),
+	}); err == nil {
+		t.Error("End Execute() expected error before the first occurrence")
+	}
+
+	endDate := s.occurrences[1].Date().Format("2006-01-02")
+	ended, err := endUseCase.Execute(dtos.EndRecurringSeriesInput{UserID: s.userID.Value(), SeriesID: seriesID, EndDate: endDate})
+	if err != nil {
+		t.Fatalf("End Execute() error = %v", err)
+	}
+	if ended.Status != "ENDED" || ended.EndDate != endDate || ended.NextOccurrence != "" {
+		t.Errorf("End Execute() = %+v, want ENDED on %s", ended, endDate)
+	}
+	if _, err := s.txRepo.FindByID(s.occurrences[1].ID()); err != nil {
+		t.Errorf("End Execute() expected existing occurrences to be kept: %v", err)
+	}
+}
+
+func TestUpdateRecurringScheduleUseCase_Execute(t *testing.T) {
+	s := setupRecurringSeriesTest(t)
+	useCase := NewUpdateRecurringScheduleUseCase(s.txRepo, eventbus.NewEventBus())
+
+	// The rent is due on day 5, which is not the last day of any month
+	if _, err := useCase.Execute(dtos.UpdateRecurringScheduleInput{
+		UserID:   s.userID.Value(),
+		SeriesID: s.series.ID().Value(),
+		Anchor:   transactionvalueobjects.LastDayOfMonthAnchor,
+	}); err == nil {
+		t.Error("Execute() expected error for an anchor not matching the first occurrence")
+	}
+
+	output, err := useCase.Execute(dtos.UpdateRecurringScheduleInput{
+		UserID:             s.userID.Value(),
+		SeriesID:           s.occurrences[0].ID().Value(), // Resolved to its series
+		Anchor:             transactionvalueobjects.NthWeekdayAnchor,
+		ShiftToBusinessDay: true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Anchor != transactionvalueobjects.NthWeekdayAnchor || !output.ShiftToBusinessDay {
+		t.Errorf("Execute() anchor = %s, shift = %v", output.Anchor, output.ShiftToBusinessDay)
+	}
+	saved, _ := s.txRepo.FindByID(s.series.ID())
+	if saved.RecurrenceAnchor() == nil || !saved.ShiftsToBusinessDay() {
+		t.Error("Execute() expected the schedule rules to be saved")
+	}
+}
@@ -3,57 +3,129 @@ package usecases
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
-	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
 // RestoreTransactionUseCase handles transaction restoration from soft delete.
+// It uses UnitOfWork to ensure atomicity when restoring a transaction and applying it to the account balance again.
 type RestoreTransactionUseCase struct {
-	transactionRepository repositories.TransactionRepository
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
 }
 
 // NewRestoreTransactionUseCase creates a new RestoreTransactionUseCase instance.
 func NewRestoreTransactionUseCase(
-	transactionRepository repositories.TransactionRepository,
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
 ) *RestoreTransactionUseCase {
 	return &RestoreTransactionUseCase{
-		transactionRepository: transactionRepository,
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
 	}
 }
 
 // Execute performs the transaction restoration.
+// Transfers are deleted as a pair, so the counterpart leg is restored as well.
 func (uc *RestoreTransactionUseCase) Execute(input dtos.RestoreTransactionInput) (*dtos.RestoreTransactionOutput, error) {
+	// Get repositories from UnitOfWork
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+
 	// Create transaction ID value object
 	transactionID, err := transactionvalueobjects.NewTransactionID(input.TransactionID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid transaction ID: %w", err)
 	}
 
-	// Check if transaction exists (including soft-deleted)
-	// We need to check if it's soft-deleted first
-	transaction, err := uc.transactionRepository.FindByID(transactionID)
+	actorID, err := optionalActorID(input.ActorID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find transaction: %w", err)
+		return nil, err
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	// If transaction exists and is not deleted, return error
-	if transaction != nil {
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				// Log rollback error but don't fail the function
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	// Soft-deleted transactions are not found, so an existing transaction is not deleted
+	existing, err := transactionRepository.FindByID(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if existing != nil {
 		return nil, errors.New("transaction is not deleted")
 	}
 
-	// Try to restore (repository should handle checking if it's soft-deleted)
-	// We need to cast to the concrete type to access Restore method
-	repo, ok := uc.transactionRepository.(interface {
+	// Restoring needs the concrete repository
+	repo, ok := transactionRepository.(interface {
 		Restore(transactionvalueobjects.TransactionID) error
 	})
 	if !ok {
 		return nil, errors.New("repository does not support restore operation")
 	}
 
-	if err := repo.Restore(transactionID); err != nil {
-		return nil, fmt.Errorf("failed to restore transaction: %w", err)
+	transaction, err := uc.restoreLeg(repo, transactionID, actorID, input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	var restoreEvent events.DomainEvent
+	if transaction.IsTransfer() {
+		linked, err := transactionRepository.FindByID(*transaction.LinkedTransactionID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find linked transaction: %w", err)
+		}
+		if linked == nil {
+			if linked, err = uc.restoreLeg(repo, *transaction.LinkedTransactionID(), actorID, input.RequestID); err != nil {
+				return nil, err
+			}
+		}
+
+		outgoing, incoming := transferLegs(transaction, linked)
+		restoreEvent = transactionevents.NewTransferCreated(
+			outgoing.ID().Value(),
+			incoming.ID().Value(),
+			outgoing.AccountID().Value(),
+			incoming.AccountID().Value(),
+			outgoing.Amount(),
+			incoming.Amount(),
+		)
+	} else {
+		restoreEvent = transactionevents.NewTransactionCreated(
+			transaction.ID().Value(),
+			transaction.AccountID().Value(),
+			transaction.TransactionType().Value(),
+			transaction.Amount(),
+		)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish the restore event for other subscribers (after successful commit)
+	if err := uc.eventBus.Publish(restoreEvent); err != nil {
+		_ = err // Ignore for now, but should be logged
 	}
 
 	output := &dtos.RestoreTransactionOutput{
@@ -63,3 +135,57 @@ func (uc *RestoreTransactionUseCase) Execute(input dtos.RestoreTransactionInput)
 
 	return output, nil
 }
+
+// restoreLeg restores one soft-deleted transaction, applies it to the account balance again
+// and records the restore in its edit history (within the caller's transaction).
+func (uc *RestoreTransactionUseCase) restoreLeg(
+	repo interface {
+		Restore(transactionvalueobjects.TransactionID) error
+	},
+	transactionID transactionvalueobjects.TransactionID,
+	actorID *identityvalueobjects.UserID,
+	requestID string,
+) (*entities.Transaction, error) {
+	if err := repo.Restore(transactionID); err != nil {
+		return nil, fmt.Errorf("failed to restore transaction: %w", err)
+	}
+
+	transaction, err := uc.unitOfWork.TransactionRepository().FindByID(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if transaction == nil {
+		return nil, errors.New("transaction not found")
+	}
+
+	if err := applyAccountBalance(uc.unitOfWork.AccountRepository(), transaction); err != nil {
+		return nil, err
+	}
+	if err := recordTransactionRevision(uc.unitOfWork.TransactionRevisionRepository(), transaction, entities.RevisionActionRestore, nil, entities.SnapshotOf(transaction), actorID, requestID); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// applyAccountBalance applies the effect of a transaction to its account balance
+// and saves the account (within the caller's transaction).
+func applyAccountBalance(accountRepository accountrepositories.AccountRepository, transaction *entities.Transaction) error {
+	account, err := accountRepository.FindByID(transaction.AccountID())
+	if err != nil {
+		return fmt.Errorf("failed to find account: %w", err)
+	}
+	if account == nil {
+		return fmt.Errorf("account not found: %s", transaction.AccountID().Value())
+	}
+
+	if err := applyBalanceEffect(account, transaction.TransactionType(), transaction.Amount()); err != nil {
+		return fmt.Errorf("failed to apply restored %s transaction: %w", strings.ToLower(transaction.TransactionType().Value()), err)
+	}
+
+	if err := accountRepository.Save(account); err != nil {
+		return fmt.Errorf("failed to save updated account: %w", err)
+	}
+
+	return nil
+}
@@ -5,8 +5,8 @@ import (
 	"testing"
 	"time"
 
-	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
-	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
@@ -15,137 +15,62 @@ import (
 // mockRestoreTransactionRepository is a mock implementation for restore tests
 type mockRestoreTransactionRepository struct {
 	*mockTransactionRepository
-	restoreErr  error
-	softDeleted map[string]bool // Track soft-deleted transactions
-}
-
-func newMockRestoreTransactionRepository() *mockRestoreTransactionRepository {
-	return &mockRestoreTransactionRepository{
-		mockTransactionRepository: newMockTransactionRepository(),
-		softDeleted:               make(map[string]bool),
-	}
-}
-
-func (m *mockRestoreTransactionRepository) FindByID(id transactionvalueobjects.TransactionID) (*entities.Transaction, error) {
-	// If soft-deleted, return nil (simulating GORM behavior)
-	if m.softDeleted[id.Value()] {
-		return nil, nil
-	}
-	return m.mockTransactionRepository.FindByID(id)
+	restoreErr error
 }
 
 func (m *mockRestoreTransactionRepository) Restore(id transactionvalueobjects.TransactionID) error {
 	if m.restoreErr != nil {
 		return m.restoreErr
 	}
-	if !m.softDeleted[id.Value()] {
-		return errors.New("transaction is not deleted")
-	}
-	delete(m.softDeleted, id.Value())
-	return nil
-}
-
-func (m *mockRestoreTransactionRepository) FindActiveRecurringTransactions() ([]*entities.Transaction, error) {
-	return m.mockTransactionRepository.FindActiveRecurringTransactions()
-}
-
-func (m *mockRestoreTransactionRepository) FindByParentIDAndDate(parentID transactionvalueobjects.TransactionID, date time.Time) (*entities.Transaction, error) {
-	return m.mockTransactionRepository.FindByParentIDAndDate(parentID, date)
-}
-
-func (m *mockRestoreTransactionRepository) FindByUserIDAndFiltersWithPagination(
-	userID identityvalueobjects.UserID,
-	accountID string,
-	transactionType string,
-	offset, limit int,
-) ([]*entities.Transaction, int64, error) {
-	return m.mockTransactionRepository.FindByUserIDAndFiltersWithPagination(userID, accountID, transactionType, offset, limit)
+	return m.mockTransactionRepository.Restore(id)
 }
 
 func TestRestoreTransactionUseCase_Execute(t *testing.T) {
-	userID := identityvalueobjects.GenerateUserID()
-	accountID := accountvalueobjects.GenerateAccountID()
-	date := time.Now()
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name      string
-		input     dtos.RestoreTransactionInput
-		setupMock func(*mockRestoreTransactionRepository)
+		setup     func(*mockRestoreTransactionRepository, *entities.Transaction) string
 		wantError bool
 		errorMsg  string
-		validate  func(*testing.T, *dtos.RestoreTransactionOutput)
 	}{
 		{
 			name: "restore soft-deleted transaction",
-			input: dtos.RestoreTransactionInput{
-				TransactionID: "",
-			},
-			setupMock: func(m *mockRestoreTransactionRepository) {
-				transaction, _ := createTestTransaction(userID, accountID, "INCOME", 100.00, "BRL", "Salário", date)
-				_ = m.Save(transaction)
-				// Simulate soft delete
-				m.softDeleted[transaction.ID().Value()] = true
+			setup: func(m *mockRestoreTransactionRepository, transaction *entities.Transaction) string {
 				_ = m.Delete(transaction.ID())
-			},
-			wantError: false,
-			validate: func(t *testing.T, output *dtos.RestoreTransactionOutput) {
-				if output == nil {
-					t.Errorf("expected output but got nil")
-					return
-				}
-				if output.TransactionID == "" {
-					t.Errorf("expected transaction ID to be set")
-				}
-				if output.Message != "Transaction restored successfully" {
-					t.Errorf("expected message 'Transaction restored successfully', got %s", output.Message)
-				}
+				return transaction.ID().Value()
 			},
 		},
 		{
 			name: "transaction not found",
-			input: dtos.RestoreTransactionInput{
-				TransactionID: transactionvalueobjects.GenerateTransactionID().Value(),
-			},
-			setupMock: func(m *mockRestoreTransactionRepository) {
-				// No transactions in repository
+			setup: func(m *mockRestoreTransactionRepository, transaction *entities.Transaction) string {
+				return transactionvalueobjects.GenerateTransactionID().Value()
 			},
 			wantError: true,
 			errorMsg:  "failed to restore transaction",
 		},
 		{
 			name: "transaction is not deleted",
-			input: dtos.RestoreTransactionInput{
-				TransactionID: "",
-			},
-			setupMock: func(m *mockRestoreTransactionRepository) {
-				transaction, _ := createTestTransaction(userID, accountID, "INCOME", 100.00, "BRL", "Salário", date)
-				_ = m.Save(transaction)
-				// Transaction is not soft-deleted
+			setup: func(m *mockRestoreTransactionRepository, transaction *entities.Transaction) string {
+				return transaction.ID().Value()
 			},
 			wantError: true,
 			errorMsg:  "transaction is not deleted",
 		},
 		{
 			name: "invalid transaction ID",
-			input: dtos.RestoreTransactionInput{
-				TransactionID: "invalid-uuid",
-			},
-			setupMock: func(m *mockRestoreTransactionRepository) {
-				// No setup needed
+			setup: func(m *mockRestoreTransactionRepository, transaction *entities.Transaction) string {
+				return "invalid-uuid"
 			},
 			wantError: true,
 			errorMsg:  "invalid transaction ID",
 		},
 		{
 			name: "repository restore error",
-			input: dtos.RestoreTransactionInput{
-				TransactionID: "",
-			},
-			setupMock: func(m *mockRestoreTransactionRepository) {
-				transaction, _ := createTestTransaction(userID, accountID, "INCOME", 100.00, "BRL", "Salário", date)
-				_ = m.Save(transaction)
-				m.softDeleted[transaction.ID().Value()] = true
+			setup: func(m *mockRestoreTransactionRepository, transaction *entities.Transaction) string {
+				_ = m.Delete(transaction.ID())
 				m.restoreErr = errors.New("database error")
+				return transaction.ID().Value()
 			},
 			wantError: true,
 			errorMsg:  "failed to restore transaction",
@@ -154,28 +79,20 @@ func TestRestoreTransactionUseCase_Execute(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo := newMockRestoreTransactionRepository()
-			tt.setupMock(mockRepo)
-
-			// For test cases that need TransactionID, set it after creating the transaction
-			if tt.input.TransactionID == "" {
-				var transactionID string
-				for id := range mockRepo.transactions {
-					transactionID = id
-					break
-				}
-				if transactionID == "" {
-					// If no transaction in repo, create one
-					transaction, _ := createTestTransaction(userID, accountID, "INCOME", 100.00, "BRL", "Salário", date)
-					_ = mockRepo.Save(transaction)
-					transactionID = transaction.ID().Value()
-				}
-				tt.input.TransactionID = transactionID
+			// The deleted expense was already reversed, so the account holds R$ 1.000,00 without it
+			uow, txRepo, accRepo, userID, checkingID, _ := setupBulkTest(t)
+			mockRepo := &mockRestoreTransactionRepository{mockTransactionRepository: txRepo}
+			uow.transactionRepository = mockRepo
+			bakery := saveTestExpense(t, txRepo, userID, checkingID, 1000, "Padaria", date)
+			transactionID := tt.setup(mockRepo, bakery)
+
+			useCase := NewRestoreTransactionUseCase(uow, eventbus.NewEventBus())
+			output, err := useCase.Execute(dtos.RestoreTransactionInput{TransactionID: transactionID})
+
+			if uow.IsInTransaction() {
+				t.Error("Execute() left the transaction open")
 			}
 
-			useCase := NewRestoreTransactionUseCase(mockRepo)
-			output, err := useCase.Execute(tt.input)
-
 			if tt.wantError {
 				if err == nil {
 					t.Errorf("expected error but got none")
@@ -191,18 +108,57 @@ func TestRestoreTransactionUseCase_Execute(t *testing.T) {
 			}
 
 			if err != nil {
-				t.Errorf("unexpected error: %v", err)
-				return
+				t.Fatalf("unexpected error: %v", err)
 			}
-
-			if output == nil {
-				t.Errorf("expected output but got nil")
-				return
+			if output.TransactionID != transactionID || output.Message != "Transaction restored successfully" {
+				t.Errorf("Execute() = %+v, want the restored transaction", output)
 			}
-
-			if tt.validate != nil {
-				tt.validate(t, output)
+			if restored, _ := txRepo.FindByID(bakery.ID()); restored == nil {
+				t.Error("Execute() did not restore the transaction")
+			}
+			// Restoring applies the expense to the balance again, like the bulk restore
+			if got := bulkTestBalance(accRepo, checkingID); got != 99000 {
+				t.Errorf("checking balance = %d, want 99000", got)
+			}
+			if len(uow.revisionRepository.revisions) != 1 || uow.revisionRepository.revisions[0].Action() != entities.RevisionActionRestore {
+				t.Errorf("Execute() recorded revisions %v, want one restore", uow.revisionRepository.revisions)
 			}
 		})
 	}
 }
+
+func TestRestoreTransactionUseCase_Transfer(t *testing.T) {
+	uow, txRepo, accRepo, userID, checkingID, savingsID := setupBulkTest(t)
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	amount, _ := sharedvalueobjects.NewMoney(20000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Reserva")
+	outgoing, incoming, err := entities.NewTransfer(userID, checkingID, savingsID, amount, amount, description, date)
+	if err != nil {
+		t.Fatalf("failed to create transfer: %v", err)
+	}
+	_ = txRepo.Save(outgoing)
+	_ = txRepo.Save(incoming)
+	_ = txRepo.Delete(outgoing.ID())
+	_ = txRepo.Delete(incoming.ID())
+
+	// Restoring one leg of the transfer restores both and applies them to both accounts
+	if _, err := NewRestoreTransactionUseCase(uow, eventbus.NewEventBus()).Execute(dtos.RestoreTransactionInput{
+		TransactionID: incoming.ID().Value(),
+	}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(txRepo.transactions) != 2 {
+		t.Errorf("Execute() restored %d transactions, want 2", len(txRepo.transactions))
+	}
+	if got := bulkTestBalance(accRepo, checkingID); got != 80000 {
+		t.Errorf("checking balance = %d, want 80000", got)
+	}
+	if got := bulkTestBalance(accRepo, savingsID); got != 70000 {
+		t.Errorf("savings balance = %d, want 70000", got)
+	}
+	if len(uow.revisionRepository.revisions) != 2 {
+		t.Errorf("Execute() recorded %d revisions, want 2", len(uow.revisionRepository.revisions))
+	}
+}
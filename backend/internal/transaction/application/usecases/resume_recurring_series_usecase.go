@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// ResumeRecurringSeriesUseCase handles resuming a paused recurring series.
+// Occurrences that fell due while it was paused are not created retroactively.
+type ResumeRecurringSeriesUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	eventBus              *eventbus.EventBus
+}
+
+// NewResumeRecurringSeriesUseCase creates a new ResumeRecurringSeriesUseCase instance.
+func NewResumeRecurringSeriesUseCase(
+	transactionRepository repositories.TransactionRepository,
+	eventBus *eventbus.EventBus,
+) *ResumeRecurringSeriesUseCase {
+	return &ResumeRecurringSeriesUseCase{
+		transactionRepository: transactionRepository,
+		eventBus:              eventBus,
+	}
+}
+
+// Execute resumes the series.
+func (uc *ResumeRecurringSeriesUseCase) Execute(input dtos.ResumeRecurringSeriesInput) (*dtos.RecurringSeriesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	series, err := findUserRecurringSeries(uc.transactionRepository, userID, input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := series.ResumeRecurrence(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepository.Save(series); err != nil {
+		return nil, fmt.Errorf("failed to save recurring series: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range series.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	series.ClearEvents()
+
+	output := recurringSeriesOutput(series, time.Now())
+	return &output, nil
+}
@@ -0,0 +1,230 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// RevertTransactionUseCase brings a transaction back to the state after one of its revisions.
+// It uses UnitOfWork to ensure atomicity when updating the transaction, the account balances
+// and the edit history; the revert itself is recorded as a new REVERT revision.
+type RevertTransactionUseCase struct {
+	unitOfWork         sharedrepositories.UnitOfWork
+	categoryRepository categoryrepositories.CategoryRepository
+	tagRepository      tagrepositories.TagRepository
+	payeeRepository    payeerepositories.PayeeRepository
+	eventBus           *eventbus.EventBus
+}
+
+// NewRevertTransactionUseCase creates a new RevertTransactionUseCase instance.
+// payeeRepository may be nil, in which case reverts that bring back a payee fail.
+func NewRevertTransactionUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	categoryRepository categoryrepositories.CategoryRepository,
+	tagRepository tagrepositories.TagRepository,
+	payeeRepository payeerepositories.PayeeRepository,
+	eventBus *eventbus.EventBus,
+) *RevertTransactionUseCase {
+	return &RevertTransactionUseCase{
+		unitOfWork:         unitOfWork,
+		categoryRepository: categoryRepository,
+		tagRepository:      tagRepository,
+		payeeRepository:    payeeRepository,
+		eventBus:           eventBus,
+	}
+}
+
+// Execute reverts the transaction. The balance delta between the current state and the
+// reverted one is applied to the accounts, including when the revert moves the transaction
+// back to another account.
+func (uc *RevertTransactionUseCase) Execute(input dtos.RevertTransactionInput) (*dtos.RevertTransactionOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	transactionID, err := transactionvalueobjects.NewTransactionID(input.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	revisionID, err := transactionvalueobjects.NewTransactionRevisionID(input.RevisionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid revision ID: %w", err)
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
+
+	// Deleted transactions are not found here: they are brought back with restore
+	transaction, err := findUserTransaction(transactionRepository, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := revisionRepository.FindByID(revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+	if revision == nil || !revision.TransactionID().Equals(transactionID) {
+		return nil, fmt.Errorf("revision not found: %s", revisionID.Value())
+	}
+	if revision.After() == nil {
+		return nil, errors.New("invalid revision: a deletion has no state to revert to")
+	}
+	target := *revision.After()
+
+	before := entities.SnapshotOf(transaction)
+	changed := before.ChangedFields(target)
+	if len(changed) == 0 || (len(changed) == 1 && changed[0] == "status") {
+		return nil, errors.New("invalid revert: the transaction already matches the revision")
+	}
+
+	// The category, tags and payee of the revision may have been deleted since
+	if slices.Contains(changed, "category_id") {
+		if _, err := findUserCategoryID(uc.categoryRepository, userID, target.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+	if slices.Contains(changed, "tag_ids") {
+		if _, err := findUserTagIDs(uc.tagRepository, userID, target.TagIDs); err != nil {
+			return nil, err
+		}
+	}
+	if slices.Contains(changed, "payee_id") {
+		if _, err := findUserPayee(uc.payeeRepository, userID, target.PayeeID); err != nil {
+			return nil, err
+		}
+	}
+
+	oldAccountID := transaction.AccountID()
+	oldType := transaction.TransactionType()
+	oldAmount := transaction.Amount()
+
+	if err := transaction.RevertTo(target); err != nil {
+		return nil, err
+	}
+
+	newType := transaction.TransactionType()
+	newAmount := transaction.Amount()
+	accountChanged := !transaction.AccountID().Equals(oldAccountID)
+
+	// Apply the balance delta: on the same account, or moved from one account to the other
+	var balanceEvents []events.DomainEvent
+	if accountChanged {
+		if err := uc.moveBalance(userID, oldAccountID, transaction.AccountID(), oldType, oldAmount, newType, newAmount); err != nil {
+			return nil, err
+		}
+		balanceEvents = append(balanceEvents,
+			transactionevents.NewTransactionDeleted(transaction.ID().Value(), oldAccountID.Value(), oldType.Value(), oldAmount),
+			transactionevents.NewTransactionCreated(transaction.ID().Value(), transaction.AccountID().Value(), newType.Value(), newAmount),
+		)
+	} else if !oldType.Equals(newType) || !oldAmount.Equals(newAmount) {
+		if _, err := updateAccountBalance(accountRepository, oldAccountID, oldType, oldAmount, newType, newAmount); err != nil {
+			return nil, err
+		}
+		balanceEvents = append(balanceEvents, transactionevents.NewTransactionUpdated(
+			transaction.ID().Value(),
+			transaction.AccountID().Value(),
+			oldType.Value(),
+			oldAmount,
+			newType.Value(),
+			newAmount,
+		))
+	}
+
+	if err := transactionRepository.Save(transaction); err != nil {
+		return nil, fmt.Errorf("failed to save transaction: %w", err)
+	}
+
+	revert, err := entities.NewTransactionRevertRevision(transaction.ID(), transaction.UserID(), before, entities.SnapshotOf(transaction), &userID, input.RequestID, revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record transaction revision: %w", err)
+	}
+	if err := revisionRepository.Save(revert); err != nil {
+		return nil, fmt.Errorf("failed to record transaction revision: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, event := range append(transaction.GetEvents(), balanceEvents...) {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	transaction.ClearEvents()
+
+	return &dtos.RevertTransactionOutput{
+		Transaction: transactionOutput(transaction),
+		Revision:    transactionRevisionOutput(revert),
+	}, nil
+}
+
+// moveBalance reverses the old transaction values on the account the transaction leaves and
+// applies the new ones to the account it goes back to (within the open transaction).
+func (uc *RevertTransactionUseCase) moveBalance(
+	userID identityvalueobjects.UserID,
+	oldAccountID accountvalueobjects.AccountID,
+	newAccountID accountvalueobjects.AccountID,
+	oldType transactionvalueobjects.TransactionType,
+	oldAmount sharedvalueobjects.Money,
+	newType transactionvalueobjects.TransactionType,
+	newAmount sharedvalueobjects.Money,
+) error {
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	destination, err := findUserAccount(accountRepository, userID, newAccountID, "transaction")
+	if err != nil {
+		return err
+	}
+	if !destination.Balance().Currency().Equals(newAmount.Currency()) {
+		return fmt.Errorf("invalid revision: the account uses %s and the revision uses %s",
+			destination.Balance().Currency().Code(), newAmount.Currency().Code())
+	}
+
+	if err := reverseAccountBalance(accountRepository, oldAccountID, oldType, oldAmount); err != nil {
+		return err
+	}
+	if err := applyBalanceEffect(destination, newType, newAmount); err != nil {
+		return fmt.Errorf("failed to apply transaction to the account: %w", err)
+	}
+	if err := accountRepository.Save(destination); err != nil {
+		return fmt.Errorf("failed to save updated account: %w", err)
+	}
+
+	return nil
+}
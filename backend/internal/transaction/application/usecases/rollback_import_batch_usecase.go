@@ -0,0 +1,174 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// RollbackImportBatchUseCase removes every transaction created by a statement import.
+// Transactions are soft deleted and their net effect is reversed with a single balance
+// update per account, in one UnitOfWork.
+type RollbackImportBatchUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewRollbackImportBatchUseCase creates a new RollbackImportBatchUseCase instance.
+func NewRollbackImportBatchUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *RollbackImportBatchUseCase {
+	return &RollbackImportBatchUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute rolls back the import batch.
+// Transactions of the batch that were already deleted individually are not touched again.
+func (uc *RollbackImportBatchUseCase) Execute(input dtos.RollbackImportBatchInput) (*dtos.RollbackImportBatchOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	batchID, err := transactionvalueobjects.NewImportBatchID(input.BatchID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid import batch ID: %w", err)
+	}
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	batchRepository := uc.unitOfWork.ImportBatchRepository()
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	batch, err := batchRepository.FindByID(batchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import batch: %w", err)
+	}
+	if batch == nil {
+		return nil, errors.New("import batch not found")
+	}
+	if !batch.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("import batch does not belong to user")
+	}
+	if batch.IsRolledBack() {
+		return nil, errors.New("import batch cannot be rolled back twice")
+	}
+
+	transactions, err := transactionRepository.FindByImportBatchID(batchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import batch transactions: %w", err)
+	}
+
+	// Reconciled transactions are locked, so a batch that was already reconciled stays
+	for _, transaction := range transactions {
+		if transaction.IsReconciled() {
+			return nil, errors.New("import batch cannot be rolled back: some of its transactions are reconciled")
+		}
+	}
+
+	// Net effect of the remaining transactions on each account, in the order accounts are seen
+	netByAccount := make(map[string]sharedvalueobjects.Money)
+	var accountOrder []accountvalueobjects.AccountID
+	for _, transaction := range transactions {
+		key := transaction.AccountID().Value()
+		net, seen := netByAccount[key]
+		if !seen {
+			net = sharedvalueobjects.Zero(transaction.Amount().Currency())
+			accountOrder = append(accountOrder, transaction.AccountID())
+		}
+
+		if transaction.TransactionType().IsCredit() {
+			net, err = net.Add(transaction.Amount())
+		} else {
+			net, err = net.Subtract(transaction.Amount())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute import batch total: %w", err)
+		}
+		netByAccount[key] = net
+
+		if err := transactionRepository.Delete(transaction.ID()); err != nil {
+			return nil, fmt.Errorf("failed to delete transaction: %w", err)
+		}
+	}
+
+	// Reverse the net effect with a single balance update per account
+	for _, accountID := range accountOrder {
+		net := netByAccount[accountID.Value()]
+		if net.IsZero() {
+			continue
+		}
+
+		account, err := accountRepository.FindByID(accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find account: %w", err)
+		}
+		if account == nil {
+			return nil, fmt.Errorf("account not found: %s", accountID.Value())
+		}
+
+		if net.IsPositive() {
+			err = account.Debit(net)
+		} else {
+			err = account.Credit(net.Negate())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to reverse import batch balance: %w", err)
+		}
+
+		if err := accountRepository.Save(account); err != nil {
+			return nil, fmt.Errorf("failed to save updated account: %w", err)
+		}
+	}
+
+	if err := batch.RollBack(); err != nil {
+		return nil, err
+	}
+	if err := batchRepository.Save(batch); err != nil {
+		return nil, fmt.Errorf("failed to save import batch: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, event := range batch.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	batch.ClearEvents()
+
+	return &dtos.RollbackImportBatchOutput{
+		BatchID:             batch.ID().Value(),
+		Status:              batch.Status(),
+		RemovedTransactions: len(transactions),
+		RolledBackAt:        batch.RolledBackAt().Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
+)
+
+// SkipRecurringOccurrenceUseCase handles leaving a single occurrence out of a recurring series
+// (e.g. a month without the gym fee). When the occurrence was already created, it is deleted
+// (soft delete) and its effect on the account balance is reversed, atomically using UnitOfWork.
+type SkipRecurringOccurrenceUseCase struct {
+	unitOfWork sharedrepositories.UnitOfWork
+	eventBus   *eventbus.EventBus
+}
+
+// NewSkipRecurringOccurrenceUseCase creates a new SkipRecurringOccurrenceUseCase instance.
+func NewSkipRecurringOccurrenceUseCase(
+	unitOfWork sharedrepositories.UnitOfWork,
+	eventBus *eventbus.EventBus,
+) *SkipRecurringOccurrenceUseCase {
+	return &SkipRecurringOccurrenceUseCase{
+		unitOfWork: unitOfWork,
+		eventBus:   eventBus,
+	}
+}
+
+// Execute skips the occurrence of the series on the given date.
+func (uc *SkipRecurringOccurrenceUseCase) Execute(input dtos.SkipRecurringOccurrenceInput) (*dtos.SkipRecurringOccurrenceOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	date, err := parseOccurrenceDate("occurrence date", input.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get repositories from UnitOfWork
+	transactionRepository := uc.unitOfWork.TransactionRepository()
+	accountRepository := uc.unitOfWork.AccountRepository()
+
+	// Begin transaction to ensure atomicity
+	if err := uc.unitOfWork.Begin(); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure rollback on error
+	defer func() {
+		if uc.unitOfWork.IsInTransaction() {
+			if rollbackErr := uc.unitOfWork.Rollback(); rollbackErr != nil {
+				_ = rollbackErr
+			}
+		}
+	}()
+
+	series, err := findUserRecurringSeries(transactionRepository, userID, input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := series.SkipOccurrence(date); err != nil {
+		return nil, err
+	}
+
+	occurrence, err := transactionRepository.FindByParentIDAndDate(series.ID(), date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find occurrence: %w", err)
+	}
+
+	output := &dtos.SkipRecurringOccurrenceOutput{}
+	domainEvents := series.GetEvents()
+	if occurrence != nil {
+		if occurrence.IsReconciled() {
+			return nil, fmt.Errorf("reconciled transaction cannot be deleted: the occurrence on %s was reconciled", input.Date)
+		}
+		if err := reverseAccountBalance(accountRepository, occurrence.AccountID(), occurrence.TransactionType(), occurrence.Amount()); err != nil {
+			return nil, err
+		}
+		if err := transactionRepository.Delete(occurrence.ID()); err != nil {
+			return nil, fmt.Errorf("failed to delete occurrence: %w", err)
+		}
+
+		output.DeletedTransactionID = occurrence.ID().Value()
+		domainEvents = append(domainEvents, events.DomainEvent(transactionevents.NewTransactionDeleted(
+			occurrence.ID().Value(),
+			occurrence.AccountID().Value(),
+			occurrence.TransactionType().Value(),
+			occurrence.Amount(),
+		)))
+	}
+
+	if err := transactionRepository.Save(series); err != nil {
+		return nil, fmt.Errorf("failed to save recurring series: %w", err)
+	}
+
+	// Commit transaction (all operations succeed)
+	if err := uc.unitOfWork.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Publish domain events (after successful commit)
+	for _, event := range domainEvents {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	series.ClearEvents()
+
+	output.Series = recurringSeriesOutput(series, time.Now())
+	return output, nil
+}
@@ -0,0 +1,109 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// StartReconciliationUseCase starts the reconciliation of an account against a bank statement.
+// An account has at most one reconciliation in progress, and statements are reconciled in order.
+type StartReconciliationUseCase struct {
+	reconciliationRepository repositories.ReconciliationRepository
+	accountRepository        accountrepositories.AccountRepository
+	transactionRepository    repositories.TransactionRepository
+	eventBus                 *eventbus.EventBus
+}
+
+// NewStartReconciliationUseCase creates a new StartReconciliationUseCase instance.
+func NewStartReconciliationUseCase(
+	reconciliationRepository repositories.ReconciliationRepository,
+	accountRepository accountrepositories.AccountRepository,
+	transactionRepository repositories.TransactionRepository,
+	eventBus *eventbus.EventBus,
+) *StartReconciliationUseCase {
+	return &StartReconciliationUseCase{
+		reconciliationRepository: reconciliationRepository,
+		accountRepository:        accountRepository,
+		transactionRepository:    transactionRepository,
+		eventBus:                 eventBus,
+	}
+}
+
+// Execute starts the reconciliation and returns it with the unreconciled transactions up to
+// the statement date and the current difference.
+func (uc *StartReconciliationUseCase) Execute(input dtos.StartReconciliationInput) (*dtos.ReconciliationOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	statementDate, err := time.Parse("2006-01-02", input.StatementDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statement date format: expected YYYY-MM-DD, got %s", input.StatementDate)
+	}
+
+	account, err := findUserAccount(uc.accountRepository, userID, accountID, "reconciled")
+	if err != nil {
+		return nil, err
+	}
+
+	// The statement balance is in the account currency; it may be negative (overdraft)
+	statementBalance, err := sharedvalueobjects.NewMoney(int64(math.Round(input.StatementBalance*100)), account.Balance().Currency())
+	if err != nil {
+		return nil, fmt.Errorf("invalid statement balance: %w", err)
+	}
+
+	history, err := uc.reconciliationRepository.FindByAccountID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reconciliations: %w", err)
+	}
+	for _, previous := range history {
+		if previous.IsInProgress() {
+			return nil, errors.New("reconciliation cannot be started: the account already has a reconciliation in progress")
+		}
+		if previous.IsCompleted() && statementDate.Before(previous.StatementDate()) {
+			return nil, fmt.Errorf("invalid statement date: must be on or after the last reconciled statement (%s)", previous.StatementDate().Format("2006-01-02"))
+		}
+	}
+
+	reconciliation, err := entities.NewReconciliation(userID, accountID, statementDate, statementBalance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reconciliation: %w", err)
+	}
+
+	summary, err := summarizeReconciliation(uc.transactionRepository, account, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.reconciliationRepository.Save(reconciliation); err != nil {
+		return nil, fmt.Errorf("failed to save reconciliation: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range reconciliation.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	reconciliation.ClearEvents()
+
+	output := reconciliationOutput(reconciliation, summary)
+	return &output, nil
+}
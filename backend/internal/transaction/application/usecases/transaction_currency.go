@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// iofDescriptionPrefix is prepended to the description of a purchase to describe its IOF expense.
+const iofDescriptionPrefix = "IOF - "
+
+// buildCurrencyConversion builds the conversion of an amount into the account currency.
+// Returns nil if the amount is already in the account currency. A foreign currency amount needs
+// either the exchange rate or the converted amount (as charged on the card statement).
+func buildCurrencyConversion(
+	amount sharedvalueobjects.Money,
+	accountCurrency sharedvalueobjects.Currency,
+	exchangeRate *float64,
+	convertedAmount *float64,
+) (*transactionvalueobjects.CurrencyConversion, error) {
+	if amount.Currency().Equals(accountCurrency) {
+		if exchangeRate != nil || convertedAmount != nil {
+			return nil, fmt.Errorf("invalid currency conversion: exchange rate and converted amount must be empty for a transaction in the account currency %s", accountCurrency.Code())
+		}
+		return nil, nil
+	}
+
+	var conversion transactionvalueobjects.CurrencyConversion
+	var err error
+	switch {
+	case exchangeRate != nil && convertedAmount != nil:
+		return nil, errors.New("invalid currency conversion: give either the exchange rate or the converted amount, not both")
+	case exchangeRate != nil:
+		conversion, err = transactionvalueobjects.NewCurrencyConversion(amount, *exchangeRate, accountCurrency)
+	case convertedAmount != nil:
+		// Convert float to cents
+		converted, _ := sharedvalueobjects.NewMoney(int64(math.Round(*convertedAmount*100)), accountCurrency)
+		conversion, err = transactionvalueobjects.NewCurrencyConversionFromAmounts(amount, converted)
+	default:
+		return nil, fmt.Errorf("invalid currency conversion: exchange rate or converted amount is required for a %s transaction on a %s account",
+			amount.Currency().Code(), accountCurrency.Code())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid currency conversion: %w", err)
+	}
+
+	return &conversion, nil
+}
+
+// newIOFTransaction creates the IOF expense of a foreign currency purchase: a percentage of the
+// converted amount, on the same account and date, in the category of the purchase, linked to it
+// as a fee.
+func newIOFTransaction(purchase *entities.Transaction, iofRate float64) (*entities.Transaction, error) {
+	if !purchase.IsForeignCurrency() || !purchase.TransactionType().IsExpense() {
+		return nil, errors.New("invalid IOF rate: IOF only applies to expenses in a foreign currency")
+	}
+
+	converted := purchase.Amount()
+	amount, _ := sharedvalueobjects.NewMoney(int64(math.Round(float64(converted.Amount())*iofRate/100)), converted.Currency())
+	if !amount.IsPositive() {
+		return nil, errors.New("invalid IOF rate: IOF amount must be greater than zero")
+	}
+
+	description, err := transactionvalueobjects.NewTransactionDescription(iofDescriptionPrefix + purchase.Description().Value())
+	if err != nil {
+		description = transactionvalueobjects.MustTransactionDescription("IOF")
+	}
+
+	fee, err := entities.NewTransactionWithParams(entities.TransactionParams{
+		UserID:      purchase.UserID(),
+		AccountID:   purchase.AccountID(),
+		Type:        purchase.TransactionType(),
+		Amount:      amount,
+		Description: description,
+		Date:        purchase.Date(),
+		CategoryID:  purchase.CategoryID(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IOF transaction: %w", err)
+	}
+	if err := fee.MarkAsFeeFor(purchase.ID()); err != nil {
+		return nil, fmt.Errorf("failed to create IOF transaction: %w", err)
+	}
+
+	return fee, nil
+}
+
+// currencyConversionOutput converts the currency conversion of a transaction to an output DTO
+// (nil if the transaction was made in the account currency).
+func currencyConversionOutput(transaction *entities.Transaction) *dtos.CurrencyConversionOutput {
+	conversion := transaction.CurrencyConversion()
+	if conversion == nil {
+		return nil
+	}
+
+	return &dtos.CurrencyConversionOutput{
+		OriginalAmount:   conversion.OriginalAmount().Float64(),
+		OriginalCurrency: conversion.OriginalAmount().Currency().Code(),
+		ExchangeRate:     conversion.ExchangeRate(),
+	}
+}
+
+// feeForTransactionIDValue returns the purchase a fee was charged for as a string (empty unless the transaction is a fee).
+func feeForTransactionIDValue(transaction *entities.Transaction) string {
+	if transaction.FeeForTransactionID() == nil {
+		return ""
+	}
+	return transaction.FeeForTransactionID().Value()
+}
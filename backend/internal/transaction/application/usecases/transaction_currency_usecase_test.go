@@ -0,0 +1,156 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestCreateTransactionUseCase_Execute_ForeignCurrency(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	input := func() dtos.CreateTransactionInput {
+		return dtos.CreateTransactionInput{
+			UserID:      userID.Value(),
+			AccountID:   accountID.Value(),
+			Type:        "EXPENSE",
+			Amount:      100.00,
+			Currency:    "USD",
+			Description: "Annual subscription",
+			Date:        "2026-10-15",
+		}
+	}
+	rate := func(value float64) *float64 { return &value }
+
+	t.Run("converts with the exchange rate", func(t *testing.T) {
+		uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 100000)
+		in := input()
+		in.ExchangeRate = rate(5.4321)
+
+		output, err := NewCreateTransactionUseCase(uow, nil, nil, nil, nil, eventbus.NewEventBus()).Execute(in)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if output.Amount != 543.21 || output.Currency != "BRL" {
+			t.Errorf("expected 543.21 BRL, got %.2f %s", output.Amount, output.Currency)
+		}
+		if output.CurrencyConversion == nil || output.CurrencyConversion.OriginalAmount != 100.00 ||
+			output.CurrencyConversion.OriginalCurrency != "USD" || output.CurrencyConversion.ExchangeRate != 5.4321 {
+			t.Errorf("expected the original 100.00 USD at 5.4321, got %+v", output.CurrencyConversion)
+		}
+
+		// The balance moves in the account currency
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 45679 {
+			t.Errorf("expected balance 45679, got %d", account.Balance().Amount())
+		}
+
+		transactionID, _ := transactionvalueobjects.NewTransactionID(output.TransactionID)
+		saved, _ := txRepo.FindByID(transactionID)
+		if saved == nil || !saved.IsForeignCurrency() || saved.CurrencyConversion().OriginalAmount().Amount() != 10000 {
+			t.Errorf("expected the saved transaction to keep the original amount")
+		}
+	})
+
+	t.Run("converts with the amount charged on the statement", func(t *testing.T) {
+		uow, _, accRepo := setupImportTest(t, userID, accountID, 100000)
+		in := input()
+		in.Amount = 20.00
+		in.ConvertedAmount = rate(109.50)
+
+		output, err := NewCreateTransactionUseCase(uow, nil, nil, nil, nil, eventbus.NewEventBus()).Execute(in)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Amount != 109.50 || output.CurrencyConversion == nil || output.CurrencyConversion.ExchangeRate != 5.475 {
+			t.Errorf("expected 109.50 BRL at 5.475, got %.2f %+v", output.Amount, output.CurrencyConversion)
+		}
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 89050 {
+			t.Errorf("expected balance 89050, got %d", account.Balance().Amount())
+		}
+	})
+
+	t.Run("adds the IOF as a linked expense", func(t *testing.T) {
+		uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 100000)
+		in := input()
+		in.ExchangeRate = rate(5.4321)
+		in.IOFRate = rate(3.5)
+
+		output, err := NewCreateTransactionUseCase(uow, nil, nil, nil, nil, eventbus.NewEventBus()).Execute(in)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.IOFTransaction == nil || output.IOFTransaction.Amount != 19.01 || output.IOFTransaction.Currency != "BRL" {
+			t.Fatalf("expected an IOF expense of 19.01 BRL, got %+v", output.IOFTransaction)
+		}
+		if output.IOFTransaction.Description != "IOF - Annual subscription" {
+			t.Errorf("unexpected IOF description %q", output.IOFTransaction.Description)
+		}
+
+		feeID, _ := transactionvalueobjects.NewTransactionID(output.IOFTransaction.TransactionID)
+		fee, _ := txRepo.FindByID(feeID)
+		if fee == nil || !fee.IsFee() || fee.FeeForTransactionID().Value() != output.TransactionID {
+			t.Errorf("expected the IOF expense to be linked to the purchase")
+		}
+
+		// 1000.00 - 543.21 - 19.01
+		account, _ := accRepo.FindByID(accountID)
+		if account.Balance().Amount() != 43778 {
+			t.Errorf("expected balance 43778, got %d", account.Balance().Amount())
+		}
+	})
+
+	t.Run("rejects invalid conversions", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(in *dtos.CreateTransactionInput)
+		}{
+			{"missing exchange rate", func(in *dtos.CreateTransactionInput) {}},
+			{"both exchange rate and converted amount", func(in *dtos.CreateTransactionInput) {
+				in.ExchangeRate = rate(5.43)
+				in.ConvertedAmount = rate(543.00)
+			}},
+			{"exchange rate in the account currency", func(in *dtos.CreateTransactionInput) {
+				in.Currency = "BRL"
+				in.ExchangeRate = rate(5.43)
+			}},
+			{"IOF in the account currency", func(in *dtos.CreateTransactionInput) {
+				in.Currency = "BRL"
+				in.IOFRate = rate(3.5)
+			}},
+			{"IOF on income", func(in *dtos.CreateTransactionInput) {
+				in.Type = "INCOME"
+				in.ExchangeRate = rate(5.43)
+				in.IOFRate = rate(3.5)
+			}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 100000)
+				in := input()
+				tt.modify(&in)
+
+				_, err := NewCreateTransactionUseCase(uow, nil, nil, nil, nil, eventbus.NewEventBus()).Execute(in)
+				if err == nil || !strings.HasPrefix(err.Error(), "invalid") {
+					t.Fatalf("expected a validation error, got %v", err)
+				}
+				if len(txRepo.transactions) != 0 {
+					t.Errorf("expected no transactions to be saved, got %d", len(txRepo.transactions))
+				}
+				account, _ := accRepo.FindByID(accountID)
+				if account.Balance().Amount() != 100000 {
+					t.Errorf("expected balance to stay 100000, got %d", account.Balance().Amount())
+				}
+			})
+		}
+	})
+}
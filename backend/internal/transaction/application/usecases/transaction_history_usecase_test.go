@@ -0,0 +1,194 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestTransactionHistory_RecordAndRevert(t *testing.T) {
+	uow, txRepo, accRepo, userID, checkingID, savingsID := setupBulkTest(t)
+	eventBus := eventbus.NewEventBus()
+
+	created, err := NewCreateTransactionUseCase(uow, nil, nil, nil, nil, eventBus).Execute(dtos.CreateTransactionInput{
+		UserID:      userID.Value(),
+		AccountID:   checkingID.Value(),
+		Type:        "EXPENSE",
+		Amount:      100,
+		Currency:    "BRL",
+		Description: "Mercado",
+		Date:        "2026-10-05",
+		RequestID:   "req-create",
+	})
+	if err != nil {
+		t.Fatalf("CreateTransaction error = %v", err)
+	}
+
+	amount := 250.0
+	if _, err := NewUpdateTransactionUseCase(uow, nil, nil, nil, eventBus).Execute(dtos.UpdateTransactionInput{
+		TransactionID: created.TransactionID,
+		Amount:        &amount,
+		ActorID:       userID.Value(),
+		RequestID:     "req-update",
+	}); err != nil {
+		t.Fatalf("UpdateTransaction error = %v", err)
+	}
+
+	if output, err := NewBulkTransactionsUseCase(uow, nil, nil, eventBus).Execute(dtos.BulkTransactionsInput{
+		UserID:         userID.Value(),
+		Operation:      BulkChangeAccount,
+		TransactionIDs: []string{created.TransactionID},
+		AccountID:      savingsID.Value(),
+		RequestID:      "req-bulk",
+	}); err != nil || !output.Applied {
+		t.Fatalf("BulkTransactions = %+v, %v", output, err)
+	}
+	if got := bulkTestBalance(accRepo, savingsID); got != 25000 {
+		t.Fatalf("savings balance = %d, want 25000", got)
+	}
+
+	historyUseCase := NewGetTransactionHistoryUseCase(txRepo, uow.revisionRepository)
+	history, err := historyUseCase.Execute(dtos.GetTransactionHistoryInput{UserID: userID.Value(), TransactionID: created.TransactionID})
+	if err != nil {
+		t.Fatalf("GetTransactionHistory error = %v", err)
+	}
+	if history.Count != 3 {
+		t.Fatalf("GetTransactionHistory count = %d, want 3", history.Count)
+	}
+	wantHistory := []struct {
+		action    string
+		requestID string
+		changed   []string
+	}{
+		{entities.RevisionActionCreate, "req-create", nil},
+		{entities.RevisionActionUpdate, "req-update", []string{"amount"}},
+		{entities.RevisionActionUpdate, "req-bulk", []string{"account_id"}},
+	}
+	for i, want := range wantHistory {
+		revision := history.Revisions[i]
+		if revision.Action != want.action || revision.RequestID != want.requestID || revision.ActorID != userID.Value() {
+			t.Errorf("revision %d = %s %q by %q, want %s %q", i, revision.Action, revision.RequestID, revision.ActorID, want.action, want.requestID)
+		}
+		if len(revision.ChangedFields) != len(want.changed) || (len(want.changed) > 0 && revision.ChangedFields[0] != want.changed[0]) {
+			t.Errorf("revision %d changed fields = %v, want %v", i, revision.ChangedFields, want.changed)
+		}
+	}
+
+	// Reverting to the creation moves the transaction back to checking with the original amount
+	revertUseCase := NewRevertTransactionUseCase(uow, nil, nil, nil, eventBus)
+	reverted, err := revertUseCase.Execute(dtos.RevertTransactionInput{
+		UserID:        userID.Value(),
+		TransactionID: created.TransactionID,
+		RevisionID:    history.Revisions[0].RevisionID,
+		RequestID:     "req-revert",
+	})
+	if err != nil {
+		t.Fatalf("RevertTransaction error = %v", err)
+	}
+	if reverted.Transaction.AccountID != checkingID.Value() || reverted.Transaction.Amount != 100 {
+		t.Errorf("RevertTransaction transaction = %s %.2f, want checking 100.00", reverted.Transaction.AccountID, reverted.Transaction.Amount)
+	}
+	if reverted.Revision.Action != entities.RevisionActionRevert || reverted.Revision.RevertedRevisionID != history.Revisions[0].RevisionID {
+		t.Errorf("RevertTransaction revision = %+v", reverted.Revision)
+	}
+	if got := bulkTestBalance(accRepo, checkingID); got != 90000 {
+		t.Errorf("checking balance after revert = %d, want 90000", got)
+	}
+	if got := bulkTestBalance(accRepo, savingsID); got != 50000 {
+		t.Errorf("savings balance after revert = %d, want 50000", got)
+	}
+
+	// Reverting to the state it is already in changes nothing
+	if _, err := revertUseCase.Execute(dtos.RevertTransactionInput{
+		UserID:        userID.Value(),
+		TransactionID: created.TransactionID,
+		RevisionID:    history.Revisions[0].RevisionID,
+	}); err == nil {
+		t.Error("RevertTransaction to the current state expected error")
+	}
+
+	// Reverting to the update on the same account applies the amount difference
+	if _, err := revertUseCase.Execute(dtos.RevertTransactionInput{
+		UserID:        userID.Value(),
+		TransactionID: created.TransactionID,
+		RevisionID:    history.Revisions[1].RevisionID,
+	}); err != nil {
+		t.Fatalf("RevertTransaction error = %v", err)
+	}
+	if got := bulkTestBalance(accRepo, checkingID); got != 75000 {
+		t.Errorf("checking balance after second revert = %d, want 75000", got)
+	}
+
+	// Deletions are recorded and the history outlives the transaction
+	if _, err := NewDeleteTransactionUseCase(uow, eventBus).Execute(dtos.DeleteTransactionInput{
+		TransactionID: created.TransactionID,
+		ActorID:       userID.Value(),
+		RequestID:     "req-delete",
+	}); err != nil {
+		t.Fatalf("DeleteTransaction error = %v", err)
+	}
+	history, err = historyUseCase.Execute(dtos.GetTransactionHistoryInput{UserID: userID.Value(), TransactionID: created.TransactionID})
+	if err != nil {
+		t.Fatalf("GetTransactionHistory error = %v", err)
+	}
+	if history.Count != 6 {
+		t.Fatalf("GetTransactionHistory count = %d, want 6", history.Count)
+	}
+	deleted := history.Revisions[5]
+	if deleted.Action != entities.RevisionActionDelete || deleted.Before == nil || deleted.After != nil || deleted.Before.Amount != 250 {
+		t.Errorf("delete revision = %+v", deleted)
+	}
+
+	otherUser := identityvalueobjects.GenerateUserID()
+	if _, err := historyUseCase.Execute(dtos.GetTransactionHistoryInput{UserID: otherUser.Value(), TransactionID: created.TransactionID}); err == nil {
+		t.Error("GetTransactionHistory of another user expected error")
+	}
+	if _, err := revertUseCase.Execute(dtos.RevertTransactionInput{
+		UserID:        userID.Value(),
+		TransactionID: created.TransactionID,
+		RevisionID:    history.Revisions[0].RevisionID,
+	}); err == nil {
+		t.Error("RevertTransaction of a deleted transaction expected error")
+	}
+}
+
+func TestRevertTransactionUseCase_Failures(t *testing.T) {
+	uow, txRepo, _, userID, checkingID, _ := setupBulkTest(t)
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	transaction := saveTestExpense(t, txRepo, userID, checkingID, 1000, "Padaria", date)
+	other := saveTestExpense(t, txRepo, userID, checkingID, 2000, "Farmácia", date)
+
+	otherRevision, err := entities.NewTransactionRevision(other.ID(), userID, entities.RevisionActionCreate, nil, entities.SnapshotOf(other), nil, "")
+	if err != nil {
+		t.Fatalf("NewTransactionRevision() error = %v", err)
+	}
+	deletion, err := entities.NewTransactionRevision(transaction.ID(), userID, entities.RevisionActionDelete, entities.SnapshotOf(transaction), nil, nil, "")
+	if err != nil {
+		t.Fatalf("NewTransactionRevision() error = %v", err)
+	}
+	_ = uow.revisionRepository.Save(otherRevision)
+	_ = uow.revisionRepository.Save(deletion)
+
+	useCase := NewRevertTransactionUseCase(uow, nil, nil, nil, eventbus.NewEventBus())
+	tests := map[string]dtos.RevertTransactionInput{
+		"revision of another transaction": {UserID: userID.Value(), TransactionID: transaction.ID().Value(), RevisionID: otherRevision.ID().Value()},
+		"unknown revision":                {UserID: userID.Value(), TransactionID: transaction.ID().Value(), RevisionID: transactionvalueobjects.GenerateTransactionRevisionID().Value()},
+		"deletion revision":               {UserID: userID.Value(), TransactionID: transaction.ID().Value(), RevisionID: deletion.ID().Value()},
+		"transaction of another user":     {UserID: identityvalueobjects.GenerateUserID().Value(), TransactionID: transaction.ID().Value(), RevisionID: deletion.ID().Value()},
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := useCase.Execute(input); err == nil {
+				t.Error("Execute() expected error")
+			}
+			if uow.IsInTransaction() {
+				t.Error("Execute() expected the transaction to be rolled back")
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeeentities "gestao-financeira/backend/internal/payee/domain/entities"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	payeeservices "gestao-financeira/backend/internal/payee/domain/services"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// findUserPayee validates that the given payee exists and belongs to the user.
+// Returns nil if no payee was provided.
+func findUserPayee(
+	payeeRepository payeerepositories.PayeeRepository,
+	userID identityvalueobjects.UserID,
+	rawPayeeID string,
+) (*payeeentities.Payee, error) {
+	if rawPayeeID == "" {
+		return nil, nil
+	}
+
+	payeeID, err := payeevalueobjects.NewPayeeID(rawPayeeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payee ID: %w", err)
+	}
+
+	if payeeRepository == nil {
+		return nil, errors.New("unable to validate payee: payee repository not configured")
+	}
+
+	payee, err := payeeRepository.FindByID(payeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payee: %w", err)
+	}
+	if payee == nil {
+		return nil, errors.New("payee not found")
+	}
+	if !payee.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("payee does not belong to user")
+	}
+
+	return payee, nil
+}
+
+// findPayeeMatcher returns a matcher for the payees of the user, or nil when payeeRepository
+// is nil or the user has no payees.
+func findPayeeMatcher(
+	payeeRepository payeerepositories.PayeeRepository,
+	userID identityvalueobjects.UserID,
+) (*payeeservices.PayeeMatcher, error) {
+	if payeeRepository == nil {
+		return nil, nil
+	}
+
+	payees, err := payeeRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payees: %w", err)
+	}
+	matcher := payeeservices.NewPayeeMatcher(payees)
+	if !matcher.HasPayees() {
+		return nil, nil
+	}
+	return matcher, nil
+}
+
+// assignPayee sets the payee of a new transaction. A transaction left without a category and
+// split lines gets the default category of the payee.
+func assignPayee(transaction *entities.Transaction, payee *payeeentities.Payee) error {
+	payeeID := payee.ID()
+	if err := transaction.UpdatePayee(&payeeID); err != nil {
+		return fmt.Errorf("invalid payee: %w", err)
+	}
+
+	if payee.DefaultCategoryID() != nil && transaction.CategoryID() == nil && !transaction.HasSplits() {
+		categoryID := *payee.DefaultCategoryID()
+		if err := transaction.UpdateCategory(&categoryID); err != nil {
+			return fmt.Errorf("invalid payee default category: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// payeeIDValue returns the payee ID of a transaction as a string (empty if none).
+func payeeIDValue(transaction *entities.Transaction) string {
+	if transaction.PayeeID() == nil {
+		return ""
+	}
+	return transaction.PayeeID().Value()
+}
@@ -0,0 +1,141 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+func TestCreateTransactionUseCase_Execute_MatchesPayee(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, _, _ := setupImportTest(t, userID, accountID, 100000)
+	categoryRepo := newMockCategoryRepository()
+	food := createTestCategory(categoryRepo, userID, "Alimentação")
+	transport := createTestCategory(categoryRepo, userID, "Transporte")
+	payeeRepo := newMockPayeeRepository()
+	foodID := food.ID()
+	ifood := createTestPayee(payeeRepo, userID, "iFood", &foodID)
+	uber := createTestPayee(payeeRepo, userID, "Uber", nil, "uber trip")
+	otherPayee := createTestPayee(payeeRepo, identityvalueobjects.GenerateUserID(), "Padaria", nil)
+	useCase := NewCreateTransactionUseCase(uow, categoryRepo, nil, nil, payeeRepo, eventbus.NewEventBus())
+
+	input := func(description string) dtos.CreateTransactionInput {
+		return dtos.CreateTransactionInput{
+			UserID:      userID.Value(),
+			AccountID:   accountID.Value(),
+			Type:        "EXPENSE",
+			Amount:      42.50,
+			Currency:    "BRL",
+			Description: description,
+			Date:        "2026-10-05",
+		}
+	}
+
+	t.Run("matches payee and applies its default category", func(t *testing.T) {
+		output, err := useCase.Execute(input("PAG*IFOOD 1234 SAO PAULO"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PayeeID != ifood.ID().Value() || output.CategoryID != food.ID().Value() {
+			t.Errorf("expected payee %s with category %s, got %q %q", ifood.ID().Value(), food.ID().Value(), output.PayeeID, output.CategoryID)
+		}
+	})
+
+	t.Run("keeps an explicit category", func(t *testing.T) {
+		in := input("IFOOD *RESTAURANTE")
+		in.CategoryID = transport.ID().Value()
+		output, err := useCase.Execute(in)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PayeeID != ifood.ID().Value() || output.CategoryID != transport.ID().Value() {
+			t.Errorf("expected payee with the explicit category, got %q %q", output.PayeeID, output.CategoryID)
+		}
+	})
+
+	t.Run("matches an alias", func(t *testing.T) {
+		output, err := useCase.Execute(input("UBER *TRIP 5678"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PayeeID != uber.ID().Value() || output.CategoryID != "" {
+			t.Errorf("expected payee %s without category, got %q %q", uber.ID().Value(), output.PayeeID, output.CategoryID)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		output, err := useCase.Execute(input("Padaria do bairro"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PayeeID != "" {
+			t.Errorf("expected no payee (payees of other users are not matched), got %q", output.PayeeID)
+		}
+	})
+
+	t.Run("explicit payee wins over the description", func(t *testing.T) {
+		in := input("PAG*IFOOD 1234")
+		in.PayeeID = uber.ID().Value()
+		output, err := useCase.Execute(in)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PayeeID != uber.ID().Value() {
+			t.Errorf("expected explicit payee %s, got %q", uber.ID().Value(), output.PayeeID)
+		}
+	})
+
+	t.Run("payee of another user", func(t *testing.T) {
+		in := input("Padaria")
+		in.PayeeID = otherPayee.ID().Value()
+		_, err := useCase.Execute(in)
+		var appErr *apperrors.AppError
+		if !errors.As(err, &appErr) || appErr.Type != apperrors.ErrorTypeForbidden {
+			t.Errorf("expected forbidden error, got %v", err)
+		}
+	})
+}
+
+func TestImportCSVUseCase_Execute_MatchesPayees(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, _ := setupImportTest(t, userID, accountID, 100000)
+	categoryRepo := newMockCategoryRepository()
+	groceries := createTestCategory(categoryRepo, userID, "Mercado")
+	payeeRepo := newMockPayeeRepository()
+	groceriesID := groceries.ID()
+	market := createTestPayee(payeeRepo, userID, "Supermercado", &groceriesID)
+
+	input := testCSVImportInput(userID, accountID)
+	input.SkipInvalidRows = true
+
+	preview, err := NewPreviewCSVImportUseCase(uow, nil, payeeRepo).Execute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if preview.Rows[1].PayeeID != market.ID().Value() || preview.Rows[1].CategoryID != groceries.ID().Value() {
+		t.Errorf("expected preview to show the payee and its category on the supermarket row, got %+v", preview.Rows[1])
+	}
+	if preview.Rows[0].PayeeID != "" {
+		t.Errorf("expected other rows to have no payee, got %+v", preview.Rows[0])
+	}
+
+	if _, err := NewImportCSVUseCase(uow, nil, payeeRepo, eventbus.NewEventBus()).Execute(input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	withPayee := 0
+	for _, transaction := range txRepo.transactions {
+		if transaction.PayeeID() != nil && transaction.PayeeID().Equals(market.ID()) {
+			withPayee++
+		}
+	}
+	if withPayee != 1 {
+		t.Errorf("expected 1 imported transaction with the payee, got %d", withPayee)
+	}
+}
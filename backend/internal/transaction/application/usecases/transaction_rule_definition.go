@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+// ruleDependencies holds the repositories used to validate the accounts, categories and tags
+// referenced by a transaction rule.
+type ruleDependencies struct {
+	accountRepository  accountrepositories.AccountRepository
+	categoryRepository categoryrepositories.CategoryRepository
+	tagRepository      tagrepositories.TagRepository
+}
+
+// buildRuleConditions validates the condition inputs of a rule. The account, when given, must belong to the user.
+func (d ruleDependencies) buildRuleConditions(
+	userID identityvalueobjects.UserID,
+	input dtos.RuleConditionsInput,
+) (transactionvalueobjects.RuleConditions, error) {
+	var accountID *accountvalueobjects.AccountID
+	if input.AccountID != "" {
+		id, err := accountvalueobjects.NewAccountID(input.AccountID)
+		if err != nil {
+			return transactionvalueobjects.RuleConditions{}, fmt.Errorf("invalid account ID: %w", err)
+		}
+		if _, err := findUserAccount(d.accountRepository, userID, id, "rule"); err != nil {
+			return transactionvalueobjects.RuleConditions{}, err
+		}
+		accountID = &id
+	}
+
+	var transactionType *transactionvalueobjects.TransactionType
+	if input.Type != "" {
+		parsed, err := transactionvalueobjects.NewTransactionType(input.Type)
+		if err != nil {
+			return transactionvalueobjects.RuleConditions{}, fmt.Errorf("invalid transaction type: %w", err)
+		}
+		transactionType = &parsed
+	}
+
+	conditions, err := transactionvalueobjects.NewRuleConditions(
+		input.DescriptionMatch,
+		input.DescriptionPattern,
+		amountInCents(input.MinAmount),
+		amountInCents(input.MaxAmount),
+		accountID,
+		transactionType,
+	)
+	if err != nil {
+		return transactionvalueobjects.RuleConditions{}, fmt.Errorf("invalid rule conditions: %w", err)
+	}
+
+	return conditions, nil
+}
+
+// buildRuleActions validates the action inputs of a rule. The category and tags must belong to the user.
+func (d ruleDependencies) buildRuleActions(
+	userID identityvalueobjects.UserID,
+	input dtos.RuleActionsInput,
+) (transactionvalueobjects.RuleActions, error) {
+	categoryID, err := findUserCategoryID(d.categoryRepository, userID, input.CategoryID)
+	if err != nil {
+		return transactionvalueobjects.RuleActions{}, err
+	}
+
+	tagIDs, err := findUserTagIDs(d.tagRepository, userID, input.TagIDs)
+	if err != nil {
+		return transactionvalueobjects.RuleActions{}, err
+	}
+
+	var description *transactionvalueobjects.TransactionDescription
+	if input.Description != "" {
+		parsed, err := transactionvalueobjects.NewTransactionDescription(input.Description)
+		if err != nil {
+			return transactionvalueobjects.RuleActions{}, fmt.Errorf("invalid rule description: %w", err)
+		}
+		description = &parsed
+	}
+
+	actions, err := transactionvalueobjects.NewRuleActions(categoryID, tagIDs, description)
+	if err != nil {
+		return transactionvalueobjects.RuleActions{}, fmt.Errorf("invalid rule actions: %w", err)
+	}
+
+	return actions, nil
+}
+
+// findUserRule loads a transaction rule and checks that it belongs to the user.
+func findUserRule(
+	ruleRepository repositories.TransactionRuleRepository,
+	userID identityvalueobjects.UserID,
+	rawRuleID string,
+) (*entities.TransactionRule, error) {
+	ruleID, err := transactionvalueobjects.NewRuleID(rawRuleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule ID: %w", err)
+	}
+
+	rule, err := ruleRepository.FindByID(ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find rule: %w", err)
+	}
+	if rule == nil {
+		return nil, errors.New("rule not found")
+	}
+	if !rule.UserID().Equals(userID) {
+		return nil, apperrors.NewForbiddenError("rule does not belong to user")
+	}
+
+	return rule, nil
+}
+
+// transactionRuleOutput converts a transaction rule to its output DTO.
+func transactionRuleOutput(rule *entities.TransactionRule) dtos.TransactionRuleOutput {
+	conditions := rule.Conditions()
+	actions := rule.Actions()
+
+	output := dtos.TransactionRuleOutput{
+		RuleID:   rule.ID().Value(),
+		Name:     rule.Name(),
+		Priority: rule.Priority(),
+		Enabled:  rule.IsEnabled(),
+		Conditions: dtos.RuleConditionsOutput{
+			DescriptionMatch:   conditions.DescriptionMatch(),
+			DescriptionPattern: conditions.DescriptionPattern(),
+			MinAmount:          amountFromCents(conditions.MinAmount()),
+			MaxAmount:          amountFromCents(conditions.MaxAmount()),
+		},
+		CreatedAt: rule.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: rule.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if conditions.AccountID() != nil {
+		output.Conditions.AccountID = conditions.AccountID().Value()
+	}
+	if conditions.TransactionType() != nil {
+		output.Conditions.Type = conditions.TransactionType().Value()
+	}
+
+	if actions.CategoryID() != nil {
+		output.Actions.CategoryID = actions.CategoryID().Value()
+	}
+	for _, tagID := range actions.TagIDs() {
+		output.Actions.TagIDs = append(output.Actions.TagIDs, tagID.Value())
+	}
+	if actions.Description() != nil {
+		output.Actions.Description = actions.Description().Value()
+	}
+
+	return output
+}
+
+// amountInCents converts an optional amount to cents, rounding to the nearest cent.
+func amountInCents(amount *float64) *int64 {
+	if amount == nil {
+		return nil
+	}
+	cents := int64(math.Round(*amount * 100))
+	return &cents
+}
+
+// amountFromCents converts an optional amount in cents to its decimal value.
+func amountFromCents(cents *int64) *float64 {
+	if cents == nil {
+		return nil
+	}
+	amount := float64(*cents) / 100
+	return &amount
+}
@@ -0,0 +1,243 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	apperrors "gestao-financeira/backend/pkg/errors"
+)
+
+func TestCreateTransactionRuleUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	otherUserID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	_, _, accRepo := setupImportTest(t, userID, accountID, 0)
+	categoryRepo := newMockCategoryRepository()
+	food := createTestCategory(categoryRepo, userID, "Alimentação")
+	otherCategory := createTestCategory(categoryRepo, otherUserID, "Transporte")
+	ruleRepo := newMockTransactionRuleRepository()
+	useCase := NewCreateTransactionRuleUseCase(ruleRepo, accRepo, categoryRepo, nil, eventbus.NewEventBus())
+
+	minAmount := 10.0
+	t.Run("creates rule", func(t *testing.T) {
+		output, err := useCase.Execute(dtos.CreateTransactionRuleInput{
+			UserID:   userID.Value(),
+			Name:     "Padaria",
+			Priority: 10,
+			Conditions: dtos.RuleConditionsInput{
+				DescriptionPattern: "padaria",
+				MinAmount:          &minAmount,
+				AccountID:          accountID.Value(),
+			},
+			Actions: dtos.RuleActionsInput{CategoryID: food.ID().Value(), Description: "Padaria"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !output.Enabled || output.Conditions.DescriptionMatch != "CONTAINS" || output.Actions.CategoryID != food.ID().Value() {
+			t.Errorf("unexpected rule output: %+v", output)
+		}
+		if output.Conditions.MinAmount == nil || *output.Conditions.MinAmount != 10 {
+			t.Errorf("expected minimum amount 10, got %v", output.Conditions.MinAmount)
+		}
+		if len(ruleRepo.rules) != 1 {
+			t.Errorf("expected rule to be saved, got %d rules", len(ruleRepo.rules))
+		}
+	})
+
+	t.Run("rejects category of another user", func(t *testing.T) {
+		_, err := useCase.Execute(dtos.CreateTransactionRuleInput{
+			UserID:     userID.Value(),
+			Name:       "Uber",
+			Conditions: dtos.RuleConditionsInput{DescriptionPattern: "uber"},
+			Actions:    dtos.RuleActionsInput{CategoryID: otherCategory.ID().Value()},
+		})
+		var appErr *apperrors.AppError
+		if !errors.As(err, &appErr) || appErr.Type != apperrors.ErrorTypeForbidden {
+			t.Errorf("expected forbidden error, got %v", err)
+		}
+	})
+
+	t.Run("rejects rule without conditions", func(t *testing.T) {
+		_, err := useCase.Execute(dtos.CreateTransactionRuleInput{
+			UserID:  userID.Value(),
+			Name:    "Tudo",
+			Actions: dtos.RuleActionsInput{CategoryID: food.ID().Value()},
+		})
+		if err == nil || !contains(err.Error(), "rule must have at least one condition") {
+			t.Errorf("expected missing condition error, got %v", err)
+		}
+	})
+}
+
+func TestApplyTransactionRulesUseCase_Execute(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 100000)
+	categoryRepo := newMockCategoryRepository()
+	food := createTestCategory(categoryRepo, userID, "Alimentação")
+	ruleRepo := newMockTransactionRuleRepository()
+
+	rule, err := NewCreateTransactionRuleUseCase(ruleRepo, accRepo, categoryRepo, nil, eventbus.NewEventBus()).Execute(dtos.CreateTransactionRuleInput{
+		UserID:     userID.Value(),
+		Name:       "Padaria",
+		Conditions: dtos.RuleConditionsInput{DescriptionMatch: "STARTS_WITH", DescriptionPattern: "padaria"},
+		Actions:    dtos.RuleActionsInput{CategoryID: food.ID().Value(), Description: "Padaria Pão Quente"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	matching := saveTestExpense(t, txRepo, userID, accountID, 1250, "PADARIA PAO QUENTE 1234", date)
+	saveTestExpense(t, txRepo, userID, accountID, 1250, "COMPRA PADARIA", date)
+	saveTestExpense(t, txRepo, userID, accountID, 1250, "Padaria antiga", date.AddDate(-1, 0, 0))
+
+	useCase := NewApplyTransactionRulesUseCase(uow, ruleRepo, eventbus.NewEventBus())
+	input := dtos.ApplyTransactionRulesInput{UserID: userID.Value(), StartDate: "2026-10-01"}
+
+	t.Run("dry run reports without saving", func(t *testing.T) {
+		dryRun := input
+		dryRun.DryRun = true
+
+		output, err := useCase.Execute(dryRun)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.CheckedCount != 2 || output.ChangedCount != 1 {
+			t.Fatalf("expected 2 checked and 1 changed transaction, got %d and %d", output.CheckedCount, output.ChangedCount)
+		}
+		change := output.Changes[0]
+		if change.TransactionID != matching.ID().Value() || change.CategoryID != food.ID().Value() || change.NewDescription != "Padaria Pão Quente" {
+			t.Errorf("unexpected change: %+v", change)
+		}
+		if len(change.RuleIDs) != 1 || change.RuleIDs[0] != rule.RuleID {
+			t.Errorf("expected change by rule %s, got %v", rule.RuleID, change.RuleIDs)
+		}
+		if matching.HasCategory() {
+			t.Error("expected dry run not to change the transaction")
+		}
+	})
+
+	t.Run("apply saves the changes", func(t *testing.T) {
+		output, err := useCase.Execute(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.DryRun || output.ChangedCount != 1 {
+			t.Fatalf("expected 1 changed transaction, got %d", output.ChangedCount)
+		}
+		saved := txRepo.transactions[matching.ID().Value()]
+		if saved.CategoryID() == nil || !saved.CategoryID().Equals(food.ID()) || saved.Description().Value() != "Padaria Pão Quente" {
+			t.Errorf("expected category and description to be saved, got %v %q", saved.CategoryID(), saved.Description().Value())
+		}
+
+		again, err := useCase.Execute(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if again.ChangedCount != 0 {
+			t.Errorf("expected nothing to change on the second run, got %d", again.ChangedCount)
+		}
+	})
+
+	t.Run("rule of another user", func(t *testing.T) {
+		_, err := useCase.Execute(dtos.ApplyTransactionRulesInput{
+			UserID:  identityvalueobjects.GenerateUserID().Value(),
+			RuleIDs: []string{rule.RuleID},
+		})
+		var appErr *apperrors.AppError
+		if !errors.As(err, &appErr) || appErr.Type != apperrors.ErrorTypeForbidden {
+			t.Errorf("expected forbidden error, got %v", err)
+		}
+	})
+}
+
+func TestCreateTransactionUseCase_Execute_AppliesRules(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, _, accRepo := setupImportTest(t, userID, accountID, 100000)
+	categoryRepo := newMockCategoryRepository()
+	transport := createTestCategory(categoryRepo, userID, "Transporte")
+	ruleRepo := newMockTransactionRuleRepository()
+
+	rule, err := NewCreateTransactionRuleUseCase(ruleRepo, accRepo, categoryRepo, nil, eventbus.NewEventBus()).Execute(dtos.CreateTransactionRuleInput{
+		UserID:     userID.Value(),
+		Name:       "Uber",
+		Conditions: dtos.RuleConditionsInput{DescriptionMatch: "REGEX", DescriptionPattern: `^uber\s*\*`, Type: "EXPENSE"},
+		Actions:    dtos.RuleActionsInput{CategoryID: transport.ID().Value(), Description: "Uber"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+
+	output, err := NewCreateTransactionUseCase(uow, categoryRepo, nil, ruleRepo, nil, eventbus.NewEventBus()).Execute(dtos.CreateTransactionInput{
+		UserID:      userID.Value(),
+		AccountID:   accountID.Value(),
+		Type:        "EXPENSE",
+		Amount:      23.90,
+		Currency:    "BRL",
+		Description: "UBER *TRIP 12345",
+		Date:        "2026-10-05",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.CategoryID != transport.ID().Value() || output.Description != "Uber" {
+		t.Errorf("expected rule to set category and description, got %q %q", output.CategoryID, output.Description)
+	}
+	if len(output.AppliedRuleIDs) != 1 || output.AppliedRuleIDs[0] != rule.RuleID {
+		t.Errorf("expected applied rule %s, got %v", rule.RuleID, output.AppliedRuleIDs)
+	}
+}
+
+func TestImportCSVUseCase_Execute_AppliesRules(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	uow, txRepo, accRepo := setupImportTest(t, userID, accountID, 100000)
+	categoryRepo := newMockCategoryRepository()
+	bills := createTestCategory(categoryRepo, userID, "Contas")
+	ruleRepo := newMockTransactionRuleRepository()
+
+	maxAmount := 200.0
+	if _, err := NewCreateTransactionRuleUseCase(ruleRepo, accRepo, categoryRepo, nil, eventbus.NewEventBus()).Execute(dtos.CreateTransactionRuleInput{
+		UserID:     userID.Value(),
+		Name:       "Luz",
+		Conditions: dtos.RuleConditionsInput{DescriptionPattern: "luz", MaxAmount: &maxAmount},
+		Actions:    dtos.RuleActionsInput{CategoryID: bills.ID().Value()},
+	}); err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+
+	input := testCSVImportInput(userID, accountID)
+	input.SkipInvalidRows = true
+
+	preview, err := NewPreviewCSVImportUseCase(uow, ruleRepo, nil).Execute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if preview.Rows[2].CategoryID != bills.ID().Value() || len(preview.Rows[2].AppliedRuleIDs) != 1 {
+		t.Errorf("expected preview to show the rule category on the electricity bill, got %+v", preview.Rows[2])
+	}
+	if preview.Rows[1].CategoryID != "" {
+		t.Errorf("expected other rows to stay uncategorized, got %+v", preview.Rows[1])
+	}
+
+	if _, err := NewImportCSVUseCase(uow, ruleRepo, nil, eventbus.NewEventBus()).Execute(input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	categorized := 0
+	for _, transaction := range txRepo.transactions {
+		if transaction.CategoryID() != nil && transaction.CategoryID().Equals(bills.ID()) {
+			categorized++
+		}
+	}
+	if categorized != 1 {
+		t.Errorf("expected 1 imported transaction in the rule category, got %d", categorized)
+	}
+}
@@ -41,6 +41,12 @@ func setupIntegrationTestDB(t *testing.T) *gorm.DB {
 	err = db.AutoMigrate(
 		&accountpersistence.AccountModel{},
 		&transactionpersistence.TransactionModel{},
+		&transactionpersistence.TransactionSplitModel{},
+		&transactionpersistence.TransactionTagModel{},
+		&transactionpersistence.ImportBatchModel{},
+		&transactionpersistence.TransactionRecurrenceSkipModel{},
+		&transactionpersistence.TransactionRecurrenceAmountModel{},
+		&transactionpersistence.TransactionRevisionModel{},
 	)
 	if err != nil {
 		t.Fatalf("Failed to migrate database: %v", err)
@@ -94,7 +100,7 @@ func TestTransactionUseCases_Integration_Atomicity(t *testing.T) {
 		account := createTestAccountInDB(t, db, userID, initialBalance)
 
 		// Create use case
-		createUseCase := NewCreateTransactionUseCase(unitOfWork, eventBus)
+		createUseCase := NewCreateTransactionUseCase(unitOfWork, nil, nil, nil, nil, eventBus)
 
 		// Create transaction
 		input := dtos.CreateTransactionInput{
@@ -157,7 +163,7 @@ func TestTransactionUseCases_Integration_Atomicity(t *testing.T) {
 		account := createTestAccountInDB(t, db, userID, initialBalance)
 
 		// Create initial transaction
-		createUseCase := NewCreateTransactionUseCase(unitOfWork, eventBus)
+		createUseCase := NewCreateTransactionUseCase(unitOfWork, nil, nil, nil, nil, eventBus)
 		createInput := dtos.CreateTransactionInput{
 			UserID:      userID.Value(),
 			AccountID:   account.ID().Value(),
@@ -173,7 +179,7 @@ func TestTransactionUseCases_Integration_Atomicity(t *testing.T) {
 		}
 
 		// Update transaction (change type from INCOME to EXPENSE and amount)
-		updateUseCase := NewUpdateTransactionUseCase(unitOfWork, eventBus)
+		updateUseCase := NewUpdateTransactionUseCase(unitOfWork, nil, nil, nil, eventBus)
 		newType := "EXPENSE"
 		newAmount := 200.00
 		updateInput := dtos.UpdateTransactionInput{
@@ -234,7 +240,7 @@ func TestTransactionUseCases_Integration_Atomicity(t *testing.T) {
 		account := createTestAccountInDB(t, db, userID, initialBalance)
 
 		// Create transaction
-		createUseCase := NewCreateTransactionUseCase(unitOfWork, eventBus)
+		createUseCase := NewCreateTransactionUseCase(unitOfWork, nil, nil, nil, nil, eventBus)
 		createInput := dtos.CreateTransactionInput{
 			UserID:      userID.Value(),
 			AccountID:   account.ID().Value(),
@@ -312,7 +318,7 @@ func TestTransactionUseCases_Integration_Atomicity(t *testing.T) {
 		_ = accRepo.Delete(account.ID())
 
 		// Try to create transaction with deleted account
-		createUseCase := NewCreateTransactionUseCase(unitOfWork, eventBus)
+		createUseCase := NewCreateTransactionUseCase(unitOfWork, nil, nil, nil, nil, eventBus)
 		input := dtos.CreateTransactionInput{
 			UserID:      userID.Value(),
 			AccountID:   account.ID().Value(),
@@ -365,7 +371,7 @@ func TestTransactionUseCases_Integration_Atomicity(t *testing.T) {
 
 		// Create account and transaction
 		account := createTestAccountInDB(t, db, userID, initialBalance)
-		createUseCase := NewCreateTransactionUseCase(unitOfWork, eventBus)
+		createUseCase := NewCreateTransactionUseCase(unitOfWork, nil, nil, nil, nil, eventBus)
 		createInput := dtos.CreateTransactionInput{
 			UserID:      userID.Value(),
 			AccountID:   account.ID().Value(),
@@ -385,7 +391,7 @@ func TestTransactionUseCases_Integration_Atomicity(t *testing.T) {
 		_ = accRepo.Delete(account.ID())
 
 		// Try to update transaction with deleted account
-		updateUseCase := NewUpdateTransactionUseCase(unitOfWork, eventBus)
+		updateUseCase := NewUpdateTransactionUseCase(unitOfWork, nil, nil, nil, eventBus)
 		newType := "EXPENSE"
 		newAmount := 200.00
 		updateInput := dtos.UpdateTransactionInput{
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// UpdateRecurringScheduleUseCase handles changing the schedule rules of a recurring series: the
+// day of the month of its occurrences and whether they move to the next business day.
+// Occurrences already created keep their dates.
+type UpdateRecurringScheduleUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	eventBus              *eventbus.EventBus
+}
+
+// NewUpdateRecurringScheduleUseCase creates a new UpdateRecurringScheduleUseCase instance.
+func NewUpdateRecurringScheduleUseCase(
+	transactionRepository repositories.TransactionRepository,
+	eventBus *eventbus.EventBus,
+) *UpdateRecurringScheduleUseCase {
+	return &UpdateRecurringScheduleUseCase{
+		transactionRepository: transactionRepository,
+		eventBus:              eventBus,
+	}
+}
+
+// Execute sets the schedule rules of the series.
+func (uc *UpdateRecurringScheduleUseCase) Execute(input dtos.UpdateRecurringScheduleInput) (*dtos.RecurringSeriesOutput, error) {
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	var anchor *transactionvalueobjects.RecurrenceAnchor
+	if input.Anchor != "" {
+		parsed, err := transactionvalueobjects.NewRecurrenceAnchor(input.Anchor)
+		if err != nil {
+			return nil, err
+		}
+		anchor = &parsed
+	}
+
+	series, err := findUserRecurringSeries(uc.transactionRepository, userID, input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := series.UpdateRecurrenceSchedule(anchor, input.ShiftToBusinessDay); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepository.Save(series); err != nil {
+		return nil, fmt.Errorf("failed to save recurring series: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range series.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	series.ClearEvents()
+
+	output := recurringSeriesOutput(series, time.Now())
+	return &output, nil
+}
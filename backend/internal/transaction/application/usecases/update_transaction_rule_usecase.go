@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"fmt"
+
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+)
+
+// UpdateTransactionRuleUseCase handles replacing the definition of an auto-categorization rule.
+type UpdateTransactionRuleUseCase struct {
+	ruleRepository repositories.TransactionRuleRepository
+	dependencies   ruleDependencies
+	eventBus       *eventbus.EventBus
+}
+
+// NewUpdateTransactionRuleUseCase creates a new UpdateTransactionRuleUseCase instance.
+func NewUpdateTransactionRuleUseCase(
+	ruleRepository repositories.TransactionRuleRepository,
+	accountRepository accountrepositories.AccountRepository,
+	categoryRepository categoryrepositories.CategoryRepository,
+	tagRepository tagrepositories.TagRepository,
+	eventBus *eventbus.EventBus,
+) *UpdateTransactionRuleUseCase {
+	return &UpdateTransactionRuleUseCase{
+		ruleRepository: ruleRepository,
+		dependencies: ruleDependencies{
+			accountRepository:  accountRepository,
+			categoryRepository: categoryRepository,
+			tagRepository:      tagRepository,
+		},
+		eventBus: eventBus,
+	}
+}
+
+// Execute replaces the name, priority, conditions and actions of the rule.
+// The enabled flag keeps its current value when it is not given.
+// Transactions already changed by the rule are not affected.
+func (uc *UpdateTransactionRuleUseCase) Execute(input dtos.UpdateTransactionRuleInput) (*dtos.TransactionRuleOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rule, err := findUserRule(uc.ruleRepository, userID, input.RuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, err := uc.dependencies.buildRuleConditions(userID, input.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := uc.dependencies.buildRuleActions(userID, input.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := rule.IsEnabled()
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	if err := rule.Update(input.Name, input.Priority, enabled, conditions, actions); err != nil {
+		return nil, fmt.Errorf("invalid rule: %w", err)
+	}
+
+	// Save rule to repository
+	if err := uc.ruleRepository.Save(rule); err != nil {
+		return nil, fmt.Errorf("failed to save rule: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range rule.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	rule.ClearEvents()
+
+	output := transactionRuleOutput(rule)
+	return &output, nil
+}
@@ -3,31 +3,51 @@ package usecases
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
+	accountrepositories "gestao-financeira/backend/internal/account/domain/repositories"
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryrepositories "gestao-financeira/backend/internal/category/domain/repositories"
+	payeerepositories "gestao-financeira/backend/internal/payee/domain/repositories"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
 	sharedrepositories "gestao-financeira/backend/internal/shared/domain/repositories"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	tagrepositories "gestao-financeira/backend/internal/tag/domain/repositories"
 	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
 	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
+	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
 // UpdateTransactionUseCase handles transaction updates.
 // It uses UnitOfWork to ensure atomicity when updating a transaction and updating account balance.
 type UpdateTransactionUseCase struct {
-	unitOfWork sharedrepositories.UnitOfWork
-	eventBus   *eventbus.EventBus
+	unitOfWork         sharedrepositories.UnitOfWork
+	categoryRepository categoryrepositories.CategoryRepository
+	tagRepository      tagrepositories.TagRepository
+	payeeRepository    payeerepositories.PayeeRepository
+	eventBus           *eventbus.EventBus
 }
 
 // NewUpdateTransactionUseCase creates a new UpdateTransactionUseCase instance.
+// payeeRepository may be nil, in which case updates that set a payee fail.
 func NewUpdateTransactionUseCase(
 	unitOfWork sharedrepositories.UnitOfWork,
+	categoryRepository categoryrepositories.CategoryRepository,
+	tagRepository tagrepositories.TagRepository,
+	payeeRepository payeerepositories.PayeeRepository,
 	eventBus *eventbus.EventBus,
 ) *UpdateTransactionUseCase {
 	return &UpdateTransactionUseCase{
-		unitOfWork: unitOfWork,
-		eventBus:   eventBus,
+		unitOfWork:         unitOfWork,
+		categoryRepository: categoryRepository,
+		tagRepository:      tagRepository,
+		payeeRepository:    payeeRepository,
+		eventBus:           eventBus,
 	}
 }
 
@@ -38,6 +58,7 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 	// Get repositories from UnitOfWork
 	transactionRepository := uc.unitOfWork.TransactionRepository()
 	accountRepository := uc.unitOfWork.AccountRepository()
+	revisionRepository := uc.unitOfWork.TransactionRevisionRepository()
 
 	// Create transaction ID value object
 	transactionID, err := transactionvalueobjects.NewTransactionID(input.TransactionID)
@@ -45,6 +66,11 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 		return nil, fmt.Errorf("invalid transaction ID: %w", err)
 	}
 
+	actorID, err := optionalActorID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Begin transaction to ensure atomicity
 	if err := uc.unitOfWork.Begin(); err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
@@ -71,8 +97,30 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 		return nil, errors.New("transaction not found")
 	}
 
+	// Keep the state before the update for the edit history
+	before := entities.SnapshotOf(transaction)
+
+	// Reconciled transactions match a bank statement, so whatever affects the balance is locked.
+	// The lock is checked on the stored status: unlocking (setting the status back to pending or
+	// cleared) takes its own request, so a single request cannot unlock and edit the transaction.
+	balanceFieldsChanged := input.Type != nil || input.Amount != nil || input.Currency != nil || input.Date != nil
+	if balanceFieldsChanged && transaction.IsReconciled() {
+		return nil, errors.New("reconciled transaction cannot be changed: set its status back to CLEARED first to edit its type, amount or date")
+	}
+
+	// Update status if provided
+	if input.Status != nil {
+		status, err := transactionvalueobjects.NewTransactionStatus(*input.Status)
+		if err != nil {
+			return nil, err
+		}
+		if err := transaction.UpdateStatus(status); err != nil {
+			return nil, err
+		}
+	}
+
 	// Store old values BEFORE any updates (needed for balance reversal and TransactionUpdated event)
-	oldType := transaction.TransactionType().Value()
+	oldType := transaction.TransactionType()
 	oldAmount := transaction.Amount()
 	accountID := transaction.AccountID()
 
@@ -87,6 +135,14 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 		}
 	}
 
+	// New split lines replace the current ones, so drop the current ones before any amount
+	// change (otherwise they would be rescaled to the new amount first)
+	if input.Splits != nil && transaction.HasSplits() {
+		if err := transaction.UpdateSplits(nil); err != nil {
+			return nil, fmt.Errorf("failed to update transaction split lines: %w", err)
+		}
+	}
+
 	// Update amount if provided
 	if input.Amount != nil {
 		// Get currency - use existing if not provided, otherwise use new currency
@@ -135,59 +191,136 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 		}
 	}
 
+	// Update category if provided (empty string removes the category)
+	if input.CategoryID != nil {
+		categoryID, err := findUserCategoryID(uc.categoryRepository, transaction.UserID(), *input.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		if err := transaction.UpdateCategory(categoryID); err != nil {
+			return nil, fmt.Errorf("failed to update transaction category: %w", err)
+		}
+	}
+
+	// Update split lines if provided (empty list removes the split). A transaction is either
+	// assigned to a single category or split across categories, never both.
+	if input.Splits != nil {
+		if len(*input.Splits) > 0 && input.CategoryID != nil && *input.CategoryID != "" {
+			return nil, errors.New("category ID must be empty when split lines are provided")
+		}
+		splits, err := buildTransactionSplits(uc.categoryRepository, transaction.UserID(), *input.Splits, transaction.Amount())
+		if err != nil {
+			return nil, err
+		}
+		if len(splits) > 0 && transaction.HasCategory() {
+			if err := transaction.UpdateCategory(nil); err != nil {
+				return nil, fmt.Errorf("failed to update transaction category: %w", err)
+			}
+		}
+		if err := transaction.UpdateSplits(splits); err != nil {
+			return nil, fmt.Errorf("invalid split lines: %w", err)
+		}
+	} else if transaction.HasCategory() && transaction.HasSplits() {
+		// Assigning a single category turns a split transaction back into a regular one
+		if err := transaction.UpdateSplits(nil); err != nil {
+			return nil, fmt.Errorf("failed to update transaction split lines: %w", err)
+		}
+	}
+
+	// Replace tags if provided (empty list removes all tags)
+	if input.TagIDs != nil {
+		tagIDs, err := findUserTagIDs(uc.tagRepository, transaction.UserID(), *input.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+		if err := transaction.UpdateTags(tagIDs); err != nil {
+			return nil, fmt.Errorf("invalid tags: %w", err)
+		}
+	}
+
+	// Update payee if provided (empty string removes the payee)
+	if input.PayeeID != nil {
+		payee, err := findUserPayee(uc.payeeRepository, transaction.UserID(), *input.PayeeID)
+		if err != nil {
+			return nil, err
+		}
+		var payeeID *payeevalueobjects.PayeeID
+		if payee != nil {
+			id := payee.ID()
+			payeeID = &id
+		}
+		if err := transaction.UpdatePayee(payeeID); err != nil {
+			return nil, fmt.Errorf("failed to update transaction payee: %w", err)
+		}
+	}
+
 	// Check if at least one field was provided for update
-	if input.Type == nil && input.Amount == nil && input.Description == nil && input.Date == nil {
+	if input.Type == nil && input.Amount == nil && input.Description == nil && input.Date == nil && input.CategoryID == nil && input.Splits == nil && input.TagIDs == nil && input.PayeeID == nil && input.Status == nil {
 		return nil, errors.New("at least one field must be provided for update")
 	}
 
 	// Get new values after update
-	newType := transaction.TransactionType().Value()
+	newType := transaction.TransactionType()
 	newAmount := transaction.Amount()
 
 	// Check if amount or type changed (these affect account balance)
 	amountChanged := !oldAmount.Equals(newAmount)
-	typeChanged := oldType != newType
+	typeChanged := !oldType.Equals(newType)
 
 	// If amount or type changed, update account balance atomically
+	var account *accountentities.Account
 	if amountChanged || typeChanged {
-		// Find account (within transaction)
-		account, err := accountRepository.FindByID(accountID)
+		account, err = updateAccountBalance(accountRepository, accountID, oldType, oldAmount, newType, newAmount)
 		if err != nil {
-			return nil, fmt.Errorf("failed to find account: %w", err)
+			return nil, err
 		}
-		if account == nil {
-			return nil, fmt.Errorf("account not found: %s", accountID.Value())
+	}
+
+	// Keep the counterpart leg of a transfer consistent (description, date and, for
+	// same-currency transfers, amount). Cross-currency legs keep their own amount.
+	var linked *entities.Transaction
+	var linkedAccount *accountentities.Account
+	var linkedOldAmount sharedvalueobjects.Money
+	if transaction.IsTransfer() {
+		linked, err = findLinkedTransaction(transactionRepository, transaction)
+		if err != nil {
+			return nil, err
+		}
+		linkedOldAmount = linked.Amount()
+		linkedBefore := entities.SnapshotOf(linked)
+
+		// The counterpart leg moves with this one, so it must not be locked either
+		if linked.IsReconciled() && (input.Date != nil || (amountChanged && linkedOldAmount.Currency().Equals(newAmount.Currency()))) {
+			return nil, errors.New("linked transfer transaction is reconciled and cannot be changed: set its status back to CLEARED first")
 		}
 
-		// Reverse old transaction effect
-		if oldType == "INCOME" {
-			// Reverse: debit (subtract) what was previously credited
-			if err := account.Debit(oldAmount); err != nil {
-				return nil, fmt.Errorf("failed to reverse old income transaction: %w", err)
+		if input.Description != nil {
+			if err := linked.UpdateDescription(transaction.Description()); err != nil {
+				return nil, fmt.Errorf("failed to update linked transaction description: %w", err)
 			}
-		} else if oldType == "EXPENSE" {
-			// Reverse: credit (add) what was previously debited
-			if err := account.Credit(oldAmount); err != nil {
-				return nil, fmt.Errorf("failed to reverse old expense transaction: %w", err)
+		}
+		if input.Date != nil {
+			if err := linked.UpdateDate(transaction.Date()); err != nil {
+				return nil, fmt.Errorf("failed to update linked transaction date: %w", err)
 			}
 		}
-
-		// Apply new transaction effect
-		if newType == "INCOME" {
-			// Apply: credit (add) new income
-			if err := account.Credit(newAmount); err != nil {
-				return nil, fmt.Errorf("failed to apply new income transaction: %w", err)
+		if amountChanged && linkedOldAmount.Currency().Equals(newAmount.Currency()) {
+			if err := linked.UpdateAmount(newAmount); err != nil {
+				return nil, fmt.Errorf("failed to update linked transaction amount: %w", err)
 			}
-		} else if newType == "EXPENSE" {
-			// Apply: debit (subtract) new expense
-			if err := account.Debit(newAmount); err != nil {
-				return nil, fmt.Errorf("failed to apply new expense transaction: %w", err)
+			linkedAccount, err = updateAccountBalance(accountRepository, linked.AccountID(), linked.TransactionType(), linkedOldAmount, linked.TransactionType(), newAmount)
+			if err != nil {
+				return nil, err
 			}
 		}
 
-		// Save updated account (within transaction)
-		if err := accountRepository.Save(account); err != nil {
-			return nil, fmt.Errorf("failed to save updated account: %w", err)
+		if err := transactionRepository.Save(linked); err != nil {
+			return nil, fmt.Errorf("failed to save linked transaction: %w", err)
+		}
+		if linkedAfter := entities.SnapshotOf(linked); len(linkedBefore.ChangedFields(*linkedAfter)) > 0 {
+			if err := recordTransactionRevision(revisionRepository, linked, entities.RevisionActionUpdate, linkedBefore, linkedAfter, actorID, input.RequestID); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -195,6 +328,11 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 	if err := transactionRepository.Save(transaction); err != nil {
 		return nil, fmt.Errorf("failed to save transaction: %w", err)
 	}
+	if after := entities.SnapshotOf(transaction); len(before.ChangedFields(*after)) > 0 {
+		if err := recordTransactionRevision(revisionRepository, transaction, entities.RevisionActionUpdate, before, after, actorID, input.RequestID); err != nil {
+			return nil, err
+		}
+	}
 
 	// Commit transaction (all operations succeed)
 	if err := uc.unitOfWork.Commit(); err != nil {
@@ -214,14 +352,42 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 	}
 	transaction.ClearEvents()
 
-	// If amount or type changed, publish TransactionUpdated event for other subscribers
-	if amountChanged || typeChanged {
+	if linked != nil {
+		// Transfers publish TransferUpdated instead of TransactionUpdated
+		for _, event := range linked.GetEvents() {
+			if err := uc.eventBus.Publish(event); err != nil {
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		linked.ClearEvents()
+
+		outgoing, incoming := transaction, linked
+		oldOutgoingAmount, oldIncomingAmount := oldAmount, linkedOldAmount
+		if transaction.TransactionType().Value() == transactionvalueobjects.TransferIn {
+			outgoing, incoming = linked, transaction
+			oldOutgoingAmount, oldIncomingAmount = linkedOldAmount, oldAmount
+		}
+		transferEvent := transactionevents.NewTransferUpdated(
+			outgoing.ID().Value(),
+			incoming.ID().Value(),
+			outgoing.AccountID().Value(),
+			incoming.AccountID().Value(),
+			oldOutgoingAmount,
+			outgoing.Amount(),
+			oldIncomingAmount,
+			incoming.Amount(),
+		)
+		if err := uc.eventBus.Publish(transferEvent); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	} else if amountChanged || typeChanged {
+		// If amount or type changed, publish TransactionUpdated event for other subscribers
 		updateEvent := transactionevents.NewTransactionUpdated(
 			transaction.ID().Value(),
 			transaction.AccountID().Value(),
-			oldType,
+			oldType.Value(),
 			oldAmount,
-			newType,
+			newType.Value(),
 			newAmount,
 		)
 		if err := uc.eventBus.Publish(updateEvent); err != nil {
@@ -230,19 +396,141 @@ func (uc *UpdateTransactionUseCase) Execute(input dtos.UpdateTransactionInput) (
 		}
 	}
 
+	publishAccountEvents(uc.eventBus, account, linkedAccount)
+
 	// Build output
 	amount := transaction.Amount()
 	output := &dtos.UpdateTransactionOutput{
-		TransactionID: transaction.ID().Value(),
-		UserID:        transaction.UserID().Value(),
-		AccountID:     transaction.AccountID().Value(),
-		Type:          transaction.TransactionType().Value(),
-		Amount:        amount.Float64(),
-		Currency:      amount.Currency().Code(),
-		Description:   transaction.Description().Value(),
-		Date:          transaction.Date().Format("2006-01-02"),
-		UpdatedAt:     transaction.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		TransactionID:       transaction.ID().Value(),
+		UserID:              transaction.UserID().Value(),
+		AccountID:           transaction.AccountID().Value(),
+		Type:                transaction.TransactionType().Value(),
+		Amount:              amount.Float64(),
+		Currency:            amount.Currency().Code(),
+		Description:         transaction.Description().Value(),
+		Date:                transaction.Date().Format("2006-01-02"),
+		CategoryID:          categoryIDValue(transaction),
+		LinkedTransactionID: linkedTransactionIDValue(transaction),
+		Splits:              splitOutputs(transaction),
+		TagIDs:              tagIDValues(transaction),
+		PayeeID:             payeeIDValue(transaction),
+		ImportBatchID:       importBatchIDValue(transaction),
+		Status:              transaction.Status().Value(),
+		ReconciliationID:    reconciliationIDValue(transaction),
+		Installment:         transactionInstallmentOutput(transaction),
+		CurrencyConversion:  currencyConversionOutput(transaction),
+		FeeForTransactionID: feeForTransactionIDValue(transaction),
+		UpdatedAt:           transaction.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}
 
 	return output, nil
 }
+
+// updateAccountBalance moves the account balance by the difference between the effect of the old
+// and the new transaction values, saving the account (within the caller's transaction). Only the
+// net difference is applied, so an edit is checked against the balance floor of the account once,
+// from its current balance. Returns the account so the caller can publish its events.
+func updateAccountBalance(
+	accountRepository accountrepositories.AccountRepository,
+	accountID accountvalueobjects.AccountID,
+	oldType transactionvalueobjects.TransactionType,
+	oldAmount sharedvalueobjects.Money,
+	newType transactionvalueobjects.TransactionType,
+	newAmount sharedvalueobjects.Money,
+) (*accountentities.Account, error) {
+	account, err := accountRepository.FindByID(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("account not found: %s", accountID.Value())
+	}
+
+	delta, err := balanceEffect(newType, newAmount).Subtract(balanceEffect(oldType, oldAmount))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update account balance: %w", err)
+	}
+	if delta.IsPositive() {
+		err = account.Credit(delta)
+	} else if delta.IsNegative() {
+		err = account.Debit(delta.Negate())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply new %s transaction: %w", strings.ToLower(newType.Value()), err)
+	}
+
+	// Save updated account (within transaction)
+	if err := accountRepository.Save(account); err != nil {
+		return nil, fmt.Errorf("failed to save updated account: %w", err)
+	}
+
+	return account, nil
+}
+
+// balanceEffect returns how a transaction of the given type and amount moves the account balance.
+func balanceEffect(transactionType transactionvalueobjects.TransactionType, amount sharedvalueobjects.Money) sharedvalueobjects.Money {
+	if transactionType.IsCredit() {
+		return amount
+	}
+	if transactionType.IsDebit() {
+		return amount.Negate()
+	}
+	return sharedvalueobjects.Zero(amount.Currency())
+}
+
+// publishAccountEvents publishes and clears the domain events of the accounts whose balance changed
+// (balance updates and, for accounts in warn-only mode, exceeded balance limits).
+func publishAccountEvents(eventBus *eventbus.EventBus, accounts ...*accountentities.Account) {
+	for _, account := range accounts {
+		if account == nil {
+			continue
+		}
+		for _, event := range account.GetEvents() {
+			if err := eventBus.Publish(event); err != nil {
+				// Log error but don't fail the operation
+				_ = err // Ignore for now, but should be logged
+			}
+		}
+		account.ClearEvents()
+	}
+}
+
+// applyBalanceEffect credits or debits the account according to the transaction type.
+func applyBalanceEffect(account *accountentities.Account, transactionType transactionvalueobjects.TransactionType, amount sharedvalueobjects.Money) error {
+	if transactionType.IsCredit() {
+		return account.Credit(amount)
+	}
+	if transactionType.IsDebit() {
+		return account.Debit(amount)
+	}
+	return nil
+}
+
+// reverseBalanceEffect undoes applyBalanceEffect for the same transaction type and amount.
+func reverseBalanceEffect(account *accountentities.Account, transactionType transactionvalueobjects.TransactionType, amount sharedvalueobjects.Money) error {
+	if transactionType.IsCredit() {
+		return account.Debit(amount)
+	}
+	if transactionType.IsDebit() {
+		return account.Credit(amount)
+	}
+	return nil
+}
+
+// findLinkedTransaction loads the counterpart leg of a transfer.
+func findLinkedTransaction(
+	transactionRepository transactionrepositories.TransactionRepository,
+	transaction *entities.Transaction,
+) (*entities.Transaction, error) {
+	if transaction.LinkedTransactionID() == nil {
+		return nil, errors.New("linked transfer transaction not found")
+	}
+	linked, err := transactionRepository.FindByID(*transaction.LinkedTransactionID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find linked transaction: %w", err)
+	}
+	if linked == nil {
+		return nil, errors.New("linked transfer transaction not found")
+	}
+	return linked, nil
+}
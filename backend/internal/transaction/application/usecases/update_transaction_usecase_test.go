@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	accountentities "gestao-financeira/backend/internal/account/domain/entities"
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
@@ -316,7 +318,7 @@ func TestUpdateTransactionUseCase_Execute(t *testing.T) {
 				}
 			}
 
-			useCase := NewUpdateTransactionUseCase(mockUow, eventBus)
+			useCase := NewUpdateTransactionUseCase(mockUow, nil, nil, nil, eventBus)
 			output, err := useCase.Execute(tt.input)
 
 			if tt.wantError {
@@ -358,3 +360,367 @@ func stringPtr(s string) *string {
 func floatPtr(f float64) *float64 {
 	return &f
 }
+
+func TestUpdateTransactionUseCase_Execute_Category(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	otherUserID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	initialBalance, _ := sharedvalueobjects.NewMoney(100000, sharedvalueobjects.MustCurrency("BRL"))
+
+	categoryRepo := newMockCategoryRepository()
+	ownCategory := createTestCategory(categoryRepo, userID, "Moradia")
+	otherCategory := createTestCategory(categoryRepo, otherUserID, "Lazer")
+
+	tests := []struct {
+		name           string
+		categoryID     string
+		wantError      bool
+		errorMsg       string
+		wantCategoryID string
+	}{
+		{
+			name:           "assign category owned by user",
+			categoryID:     ownCategory.ID().Value(),
+			wantCategoryID: ownCategory.ID().Value(),
+		},
+		{
+			name:           "remove category with empty string",
+			categoryID:     "",
+			wantCategoryID: "",
+		},
+		{
+			name:       "assign category owned by another user",
+			categoryID: otherCategory.ID().Value(),
+			wantError:  true,
+			errorMsg:   "category does not belong to user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxRepo := newMockTransactionRepository()
+			mockAccRepo := newMockAccountRepository()
+			account, _ := createTestAccountWithID(userID, accountID, initialBalance)
+			_ = mockAccRepo.Save(account)
+			transaction, _ := createTestTransaction(userID, accountID, "EXPENSE", 80.00, "BRL", "Aluguel", time.Now())
+			existingCategoryID := categoryvalueobjects.MustCategoryID(ownCategory.ID().Value())
+			_ = transaction.UpdateCategory(&existingCategoryID)
+			_ = mockTxRepo.Save(transaction)
+
+			useCase := NewUpdateTransactionUseCase(newMockUnitOfWork(mockTxRepo, mockAccRepo), categoryRepo, nil, nil, eventbus.NewEventBus())
+			output, err := useCase.Execute(dtos.UpdateTransactionInput{
+				TransactionID: transaction.ID().Value(),
+				CategoryID:    stringPtr(tt.categoryID),
+			})
+
+			if tt.wantError {
+				if err == nil || !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errorMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.CategoryID != tt.wantCategoryID {
+				t.Errorf("expected category ID %q, got %q", tt.wantCategoryID, output.CategoryID)
+			}
+
+			// Category changes must not affect the account balance
+			account, _ = mockAccRepo.FindByID(accountID)
+			if account.Balance().Amount() != initialBalance.Amount() {
+				t.Errorf("expected balance %d, got %d", initialBalance.Amount(), account.Balance().Amount())
+			}
+		})
+	}
+}
+
+func TestUpdateTransactionUseCase_Execute_Transfer(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	fromAccountID := accountvalueobjects.GenerateAccountID()
+	toAccountID := accountvalueobjects.GenerateAccountID()
+
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+	initialBalance, _ := sharedvalueobjects.NewMoney(100000, currency) // 1000.00 BRL
+	amount, _ := sharedvalueobjects.NewMoney(30000, currency)          // 300.00 BRL
+
+	setup := func() (*mockTransactionRepository, *mockAccountRepository, *UpdateTransactionUseCase, string, string) {
+		txRepo := newMockTransactionRepository()
+		accRepo := newMockAccountRepository()
+		from, _ := createTestAccountWithID(userID, fromAccountID, initialBalance)
+		to, _ := createTestAccountWithID(userID, toAccountID, initialBalance)
+		_ = accRepo.Save(from)
+		_ = accRepo.Save(to)
+		outgoing, incoming := createTestTransfer(t, txRepo, accRepo, userID, fromAccountID, toAccountID, amount, amount)
+		useCase := NewUpdateTransactionUseCase(newMockUnitOfWork(txRepo, accRepo), nil, nil, nil, eventbus.NewEventBus())
+		return txRepo, accRepo, useCase, outgoing.ID().Value(), incoming.ID().Value()
+	}
+
+	t.Run("amount, description and date are mirrored to the other leg", func(t *testing.T) {
+		txRepo, accRepo, useCase, outgoingID, incomingID := setup()
+
+		_, err := useCase.Execute(dtos.UpdateTransactionInput{
+			TransactionID: outgoingID,
+			Amount:        floatPtr(400.00),
+			Description:   stringPtr("Pagamento do cartão"),
+			Date:          stringPtr("2026-02-01"),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		incoming := txRepo.transactions[incomingID]
+		if incoming.Amount().Amount() != 40000 {
+			t.Errorf("expected incoming amount 40000, got %d", incoming.Amount().Amount())
+		}
+		if incoming.Description().Value() != "Pagamento do cartão" {
+			t.Errorf("expected incoming description to be mirrored, got %s", incoming.Description().Value())
+		}
+		if incoming.Date().Format("2006-01-02") != "2026-02-01" {
+			t.Errorf("expected incoming date 2026-02-01, got %s", incoming.Date().Format("2006-01-02"))
+		}
+
+		from, _ := accRepo.FindByID(fromAccountID)
+		to, _ := accRepo.FindByID(toAccountID)
+		if from.Balance().Amount() != 60000 {
+			t.Errorf("expected source balance 60000, got %d", from.Balance().Amount())
+		}
+		if to.Balance().Amount() != 140000 {
+			t.Errorf("expected destination balance 140000, got %d", to.Balance().Amount())
+		}
+	})
+
+	t.Run("type of a transfer leg cannot be changed", func(t *testing.T) {
+		_, _, useCase, outgoingID, _ := setup()
+
+		_, err := useCase.Execute(dtos.UpdateTransactionInput{
+			TransactionID: outgoingID,
+			Type:          stringPtr("EXPENSE"),
+		})
+		if err == nil || !contains(err.Error(), "cannot change the type of a transfer") {
+			t.Errorf("expected transfer type change error, got %v", err)
+		}
+	})
+}
+
+func TestUpdateTransactionUseCase_Execute_Splits(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	otherUserID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	initialBalance, _ := sharedvalueobjects.NewMoney(100000, sharedvalueobjects.MustCurrency("BRL"))
+
+	categoryRepo := newMockCategoryRepository()
+	food := createTestCategory(categoryRepo, userID, "Alimentação")
+	home := createTestCategory(categoryRepo, userID, "Casa")
+	pets := createTestCategory(categoryRepo, userID, "Pets")
+	otherCategory := createTestCategory(categoryRepo, otherUserID, "Lazer")
+
+	tests := []struct {
+		name       string
+		input      dtos.UpdateTransactionInput
+		wantError  bool
+		errorMsg   string
+		wantSplits []float64
+		wantAmount float64
+	}{
+		{
+			name: "split with explicit amounts",
+			input: dtos.UpdateTransactionInput{
+				Splits: &[]dtos.TransactionSplitInput{
+					{CategoryID: food.ID().Value(), Amount: floatPtr(70.00), Memo: "Comida"},
+					{CategoryID: home.ID().Value(), Amount: floatPtr(30.00)},
+				},
+			},
+			wantSplits: []float64{70.00, 30.00},
+			wantAmount: 100.00,
+		},
+		{
+			name: "lines without amount share the remainder",
+			input: dtos.UpdateTransactionInput{
+				Splits: &[]dtos.TransactionSplitInput{
+					{CategoryID: food.ID().Value()},
+					{CategoryID: home.ID().Value()},
+					{CategoryID: pets.ID().Value()},
+				},
+			},
+			wantSplits: []float64{33.34, 33.33, 33.33},
+			wantAmount: 100.00,
+		},
+		{
+			name: "new amount and split lines together",
+			input: dtos.UpdateTransactionInput{
+				Amount: floatPtr(50.00),
+				Splits: &[]dtos.TransactionSplitInput{
+					{CategoryID: food.ID().Value(), Amount: floatPtr(20.00)},
+					{CategoryID: home.ID().Value()},
+				},
+			},
+			wantSplits: []float64{20.00, 30.00},
+			wantAmount: 50.00,
+		},
+		{
+			name: "lines that do not add up to the amount",
+			input: dtos.UpdateTransactionInput{
+				Splits: &[]dtos.TransactionSplitInput{
+					{CategoryID: food.ID().Value(), Amount: floatPtr(70.00)},
+					{CategoryID: home.ID().Value(), Amount: floatPtr(20.00)},
+				},
+			},
+			wantError: true,
+			errorMsg:  "split lines must add up to the transaction amount",
+		},
+		{
+			name: "category owned by another user",
+			input: dtos.UpdateTransactionInput{
+				Splits: &[]dtos.TransactionSplitInput{
+					{CategoryID: food.ID().Value(), Amount: floatPtr(70.00)},
+					{CategoryID: otherCategory.ID().Value()},
+				},
+			},
+			wantError: true,
+			errorMsg:  "category does not belong to user",
+		},
+		{
+			name: "category and split lines together",
+			input: dtos.UpdateTransactionInput{
+				CategoryID: stringPtr(food.ID().Value()),
+				Splits: &[]dtos.TransactionSplitInput{
+					{CategoryID: food.ID().Value()},
+					{CategoryID: home.ID().Value()},
+				},
+			},
+			wantError: true,
+			errorMsg:  "category ID must be empty when split lines are provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxRepo := newMockTransactionRepository()
+			mockAccRepo := newMockAccountRepository()
+			account, _ := createTestAccountWithID(userID, accountID, initialBalance)
+			_ = mockAccRepo.Save(account)
+			transaction, _ := createTestTransaction(userID, accountID, "EXPENSE", 100.00, "BRL", "Supermercado", time.Now())
+			_ = mockTxRepo.Save(transaction)
+
+			input := tt.input
+			input.TransactionID = transaction.ID().Value()
+			useCase := NewUpdateTransactionUseCase(newMockUnitOfWork(mockTxRepo, mockAccRepo), categoryRepo, nil, nil, eventbus.NewEventBus())
+			output, err := useCase.Execute(input)
+
+			if tt.wantError {
+				if err == nil || !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errorMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.Amount != tt.wantAmount {
+				t.Errorf("expected amount %.2f, got %.2f", tt.wantAmount, output.Amount)
+			}
+			if len(output.Splits) != len(tt.wantSplits) {
+				t.Fatalf("expected %d split lines, got %d", len(tt.wantSplits), len(output.Splits))
+			}
+			for i, want := range tt.wantSplits {
+				if output.Splits[i].Amount != want {
+					t.Errorf("expected split line %d amount %.2f, got %.2f", i, want, output.Splits[i].Amount)
+				}
+			}
+		})
+	}
+
+	t.Run("amount change rescales existing split lines", func(t *testing.T) {
+		mockTxRepo := newMockTransactionRepository()
+		mockAccRepo := newMockAccountRepository()
+		account, _ := createTestAccountWithID(userID, accountID, initialBalance)
+		_ = mockAccRepo.Save(account)
+		transaction, _ := createTestTransaction(userID, accountID, "EXPENSE", 100.00, "BRL", "Supermercado", time.Now())
+		_ = mockTxRepo.Save(transaction)
+
+		useCase := NewUpdateTransactionUseCase(newMockUnitOfWork(mockTxRepo, mockAccRepo), categoryRepo, nil, nil, eventbus.NewEventBus())
+		_, err := useCase.Execute(dtos.UpdateTransactionInput{
+			TransactionID: transaction.ID().Value(),
+			Splits: &[]dtos.TransactionSplitInput{
+				{CategoryID: food.ID().Value(), Amount: floatPtr(50.00)},
+				{CategoryID: home.ID().Value(), Amount: floatPtr(50.00)},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		output, err := useCase.Execute(dtos.UpdateTransactionInput{
+			TransactionID: transaction.ID().Value(),
+			Amount:        floatPtr(0.05),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.Splits) != 2 || output.Splits[0].Amount != 0.03 || output.Splits[1].Amount != 0.02 {
+			t.Errorf("expected split lines 0.03/0.02, got %+v", output.Splits)
+		}
+
+		// An empty list removes the split
+		output, err = useCase.Execute(dtos.UpdateTransactionInput{
+			TransactionID: transaction.ID().Value(),
+			Splits:        &[]dtos.TransactionSplitInput{},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.Splits) != 0 {
+			t.Errorf("expected no split lines, got %d", len(output.Splits))
+		}
+	})
+}
+
+func TestUpdateTransactionUseCase_Execute_BalanceLimits(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+
+	// A bank account without overdraft holding 50.00 BRL, after a 100.00 BRL income and a 50.00 BRL expense
+	setup := func() (*UpdateTransactionUseCase, *mockAccountRepository, string, string) {
+		mockTransactionRepo := newMockTransactionRepository()
+		mockAccountRepo := newMockAccountRepository()
+		balance, _ := sharedvalueobjects.NewMoney(5000, brl)
+		account, _ := createTestAccountWithID(userID, accountID, balance)
+		_ = mockAccountRepo.Save(account)
+		income, _ := createTestTransaction(userID, accountID, "INCOME", 100.00, "BRL", "Salário", time.Now())
+		expense, _ := createTestTransaction(userID, accountID, "EXPENSE", 50.00, "BRL", "Mercado", time.Now())
+		_ = mockTransactionRepo.Save(income)
+		_ = mockTransactionRepo.Save(expense)
+
+		useCase := NewUpdateTransactionUseCase(newMockUnitOfWork(mockTransactionRepo, mockAccountRepo), nil, nil, nil, eventbus.NewEventBus())
+		return useCase, mockAccountRepo, income.ID().Value(), expense.ID().Value()
+	}
+
+	t.Run("lowering an income only checks the net difference", func(t *testing.T) {
+		useCase, mockAccountRepo, incomeID, _ := setup()
+		if _, err := useCase.Execute(dtos.UpdateTransactionInput{TransactionID: incomeID, Amount: floatPtr(90.00)}); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		account, _ := mockAccountRepo.FindByID(accountID)
+		if account.Balance().Amount() != 4000 {
+			t.Errorf("expected balance 4000, got %d", account.Balance().Amount())
+		}
+	})
+
+	t.Run("raising an expense beyond the balance is rejected", func(t *testing.T) {
+		useCase, mockAccountRepo, _, expenseID := setup()
+		_, err := useCase.Execute(dtos.UpdateTransactionInput{TransactionID: expenseID, Amount: floatPtr(100.01)})
+
+		var limitErr *accountentities.BalanceLimitError
+		if !errors.As(err, &limitErr) || limitErr.LimitKind != accountentities.LimitKindBalance || limitErr.Balance.Amount() != -1 {
+			t.Fatalf("Execute() error = %v, want an insufficient balance error", err)
+		}
+		account, _ := mockAccountRepo.FindByID(accountID)
+		if account.Balance().Amount() != 5000 {
+			t.Errorf("expected the balance to stay at 5000, got %d", account.Balance().Amount())
+		}
+	})
+}
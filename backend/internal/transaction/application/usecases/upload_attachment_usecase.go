@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/storage"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// UploadAttachmentUseCase handles attaching receipts and documents to transactions.
+type UploadAttachmentUseCase struct {
+	transactionRepository repositories.TransactionRepository
+	attachmentRepository  repositories.AttachmentRepository
+	fileStorage           storage.FileStorage
+	eventBus              *eventbus.EventBus
+}
+
+// NewUploadAttachmentUseCase creates a new UploadAttachmentUseCase instance.
+func NewUploadAttachmentUseCase(
+	transactionRepository repositories.TransactionRepository,
+	attachmentRepository repositories.AttachmentRepository,
+	fileStorage storage.FileStorage,
+	eventBus *eventbus.EventBus,
+) *UploadAttachmentUseCase {
+	return &UploadAttachmentUseCase{
+		transactionRepository: transactionRepository,
+		attachmentRepository:  attachmentRepository,
+		fileStorage:           fileStorage,
+		eventBus:              eventBus,
+	}
+}
+
+// Execute stores the file and records it as an attachment of the transaction.
+// The file type is detected from the content, so a renamed executable is rejected even if its
+// name ends in .pdf.
+func (uc *UploadAttachmentUseCase) Execute(input dtos.UploadAttachmentInput) (*dtos.AttachmentOutput, error) {
+	// Create user ID value object
+	userID, err := identityvalueobjects.NewUserID(input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	transactionID, err := transactionvalueobjects.NewTransactionID(input.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	if err := validateAttachmentFileName(input.FileName); err != nil {
+		return nil, err
+	}
+
+	if len(input.Content) > entities.MaxAttachmentSize {
+		return nil, fmt.Errorf("invalid attachment: file must be at most %d MB", entities.MaxAttachmentSize/(1024*1024))
+	}
+
+	transaction, err := findUserTransaction(uc.transactionRepository, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := entities.NewAttachment(
+		transaction.ID(),
+		userID,
+		input.FileName,
+		http.DetectContentType(input.Content),
+		int64(len(input.Content)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attachment: %w", err)
+	}
+
+	if err := uc.fileStorage.Put(attachment.StorageKey(), input.Content, attachment.ContentType()); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	if err := uc.attachmentRepository.Save(attachment); err != nil {
+		// Do not keep a file nobody can reach
+		_ = uc.fileStorage.Delete(attachment.StorageKey())
+		return nil, fmt.Errorf("failed to save attachment: %w", err)
+	}
+
+	// Publish domain events
+	for _, event := range attachment.GetEvents() {
+		if err := uc.eventBus.Publish(event); err != nil {
+			_ = err // Ignore for now, but should be logged
+		}
+	}
+	attachment.ClearEvents()
+
+	output := attachmentOutput(attachment)
+	return &output, nil
+}
+
+// validateAttachmentFileName checks the uploaded file name with the security validators.
+func validateAttachmentFileName(fileName string) error {
+	if err := validator.ValidateUTF8(fileName); err != nil {
+		return errors.New("invalid attachment file name: must be valid UTF-8")
+	}
+	if err := validator.ValidateNoPathTraversal(fileName); err != nil {
+		return errors.New("invalid attachment file name: must not contain a path")
+	}
+	if err := validator.ValidateNoXSS(fileName); err != nil {
+		return errors.New("invalid attachment file name: contains forbidden characters")
+	}
+	return nil
+}
+
+// attachmentOutput converts an attachment to its output DTO.
+func attachmentOutput(attachment *entities.Attachment) dtos.AttachmentOutput {
+	return dtos.AttachmentOutput{
+		AttachmentID:  attachment.ID().Value(),
+		TransactionID: attachment.TransactionID().Value(),
+		FileName:      attachment.FileName(),
+		ContentType:   attachment.ContentType(),
+		Size:          attachment.Size(),
+		CreatedAt:     attachment.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
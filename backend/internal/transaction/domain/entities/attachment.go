@@ -0,0 +1,208 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// MaxAttachmentSize is the maximum size of an attachment file in bytes (8 MB).
+// It is kept below the default request body limit (API_BODY_LIMIT, 10 MB) so a file of the
+// maximum size still fits in the request together with the multipart overhead.
+const MaxAttachmentSize = 8 * 1024 * 1024
+
+// MaxAttachmentFileNameLength is the maximum number of characters of an attachment file name.
+const MaxAttachmentFileNameLength = 255
+
+// allowedAttachmentContentTypes lists the file types accepted as receipts and documents.
+var allowedAttachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// IsAllowedAttachmentContentType checks if files of the given MIME type can be attached.
+func IsAllowedAttachmentContentType(contentType string) bool {
+	return allowedAttachmentContentTypes[contentType]
+}
+
+// Attachment represents a receipt or document (image or PDF) attached to a transaction.
+// The file itself is kept in a file storage under StorageKey; the aggregate holds its metadata.
+type Attachment struct {
+	id            transactionvalueobjects.AttachmentID
+	transactionID transactionvalueobjects.TransactionID
+	userID        identityvalueobjects.UserID
+	fileName      string
+	contentType   string
+	size          int64
+	storageKey    string
+	createdAt     time.Time
+
+	// Domain events
+	events []events.DomainEvent
+}
+
+// NewAttachment creates a new Attachment aggregate.
+// contentType must be the MIME type detected from the file content.
+func NewAttachment(
+	transactionID transactionvalueobjects.TransactionID,
+	userID identityvalueobjects.UserID,
+	fileName string,
+	contentType string,
+	size int64,
+) (*Attachment, error) {
+	if transactionID.IsEmpty() {
+		return nil, errors.New("transaction ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	fileName = filepath.Base(strings.ReplaceAll(strings.TrimSpace(fileName), "\\", "/"))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return nil, errors.New("attachment file name cannot be empty")
+	}
+	if utf8.RuneCountInString(fileName) > MaxAttachmentFileNameLength {
+		return nil, fmt.Errorf("attachment file name must be at most %d characters", MaxAttachmentFileNameLength)
+	}
+
+	if !IsAllowedAttachmentContentType(contentType) {
+		return nil, fmt.Errorf("invalid attachment file type: %s. Supported types: JPEG, PNG, GIF, WebP and PDF", contentType)
+	}
+
+	if size <= 0 {
+		return nil, errors.New("attachment file cannot be empty")
+	}
+	if size > MaxAttachmentSize {
+		return nil, fmt.Errorf("attachment file must be at most %d MB", MaxAttachmentSize/(1024*1024))
+	}
+
+	id := transactionvalueobjects.GenerateAttachmentID()
+
+	attachment := &Attachment{
+		id:            id,
+		transactionID: transactionID,
+		userID:        userID,
+		fileName:      fileName,
+		contentType:   contentType,
+		size:          size,
+		storageKey:    fmt.Sprintf("attachments/%s/%s/%s", userID.Value(), transactionID.Value(), id.Value()),
+		createdAt:     time.Now(),
+		events:        []events.DomainEvent{},
+	}
+
+	// Add domain event
+	attachment.addEvent(events.NewBaseDomainEvent(
+		"TransactionAttachmentAdded",
+		attachment.id.Value(),
+		"Attachment",
+	))
+
+	return attachment, nil
+}
+
+// AttachmentFromPersistence reconstructs an Attachment aggregate from persisted data.
+// This method does not trigger domain events, as it's used for loading existing data.
+func AttachmentFromPersistence(
+	id transactionvalueobjects.AttachmentID,
+	transactionID transactionvalueobjects.TransactionID,
+	userID identityvalueobjects.UserID,
+	fileName string,
+	contentType string,
+	size int64,
+	storageKey string,
+	createdAt time.Time,
+) (*Attachment, error) {
+	if id.IsEmpty() {
+		return nil, errors.New("attachment ID cannot be empty")
+	}
+
+	if transactionID.IsEmpty() {
+		return nil, errors.New("transaction ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if storageKey == "" {
+		return nil, errors.New("attachment storage key cannot be empty")
+	}
+
+	return &Attachment{
+		id:            id,
+		transactionID: transactionID,
+		userID:        userID,
+		fileName:      fileName,
+		contentType:   contentType,
+		size:          size,
+		storageKey:    storageKey,
+		createdAt:     createdAt,
+		events:        []events.DomainEvent{},
+	}, nil
+}
+
+// ID returns the attachment ID.
+func (a *Attachment) ID() transactionvalueobjects.AttachmentID {
+	return a.id
+}
+
+// TransactionID returns the ID of the transaction the file is attached to.
+func (a *Attachment) TransactionID() transactionvalueobjects.TransactionID {
+	return a.transactionID
+}
+
+// UserID returns the user ID.
+func (a *Attachment) UserID() identityvalueobjects.UserID {
+	return a.userID
+}
+
+// FileName returns the original file name, without directories.
+func (a *Attachment) FileName() string {
+	return a.fileName
+}
+
+// ContentType returns the MIME type of the file.
+func (a *Attachment) ContentType() string {
+	return a.contentType
+}
+
+// Size returns the file size in bytes.
+func (a *Attachment) Size() int64 {
+	return a.size
+}
+
+// StorageKey returns the key of the file in the file storage.
+func (a *Attachment) StorageKey() string {
+	return a.storageKey
+}
+
+// CreatedAt returns the upload timestamp.
+func (a *Attachment) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// GetEvents returns all domain events that occurred on this aggregate.
+func (a *Attachment) GetEvents() []events.DomainEvent {
+	return a.events
+}
+
+// ClearEvents clears all domain events from this aggregate.
+func (a *Attachment) ClearEvents() {
+	a.events = []events.DomainEvent{}
+}
+
+// addEvent adds a domain event to the aggregate.
+func (a *Attachment) addEvent(event events.DomainEvent) {
+	a.events = append(a.events, event)
+}
@@ -0,0 +1,240 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// Supported statement import sources
+const (
+	ImportSourceCSV = "CSV" // Comma (or semicolon/tab) separated bank statement
+	ImportSourceOFX = "OFX" // Open Financial Exchange statement (OFX 1.x SGML or 2.x XML)
+	ImportSourceQIF = "QIF" // Quicken Interchange Format statement
+)
+
+// Import batch status values
+const (
+	ImportBatchStatusCommitted  = "COMMITTED"   // Transactions were created
+	ImportBatchStatusRolledBack = "ROLLED_BACK" // Transactions were removed again
+)
+
+// ImportBatch represents a statement import aggregate root in the Transaction context.
+// Every transaction created by an import references its batch, so the whole batch can be
+// rolled back at once if the file turns out to be wrong.
+type ImportBatch struct {
+	id               transactionvalueobjects.ImportBatchID
+	userID           identityvalueobjects.UserID
+	accountID        accountvalueobjects.AccountID
+	source           string
+	fileName         string
+	status           string
+	transactionCount int
+	createdAt        time.Time
+	updatedAt        time.Time
+	rolledBackAt     *time.Time
+
+	// Domain events
+	events []events.DomainEvent
+}
+
+// NewImportBatch creates a new ImportBatch aggregate for the given account.
+func NewImportBatch(
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	source string,
+	fileName string,
+	transactionCount int,
+) (*ImportBatch, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if accountID.IsEmpty() {
+		return nil, errors.New("account ID cannot be empty")
+	}
+
+	source = strings.ToUpper(strings.TrimSpace(source))
+	if !isValidImportSource(source) {
+		return nil, errors.New("invalid import source: " + source)
+	}
+
+	if transactionCount <= 0 {
+		return nil, errors.New("import batch must be created with at least one transaction")
+	}
+
+	now := time.Now()
+
+	batch := &ImportBatch{
+		id:               transactionvalueobjects.GenerateImportBatchID(),
+		userID:           userID,
+		accountID:        accountID,
+		source:           source,
+		fileName:         strings.TrimSpace(fileName),
+		status:           ImportBatchStatusCommitted,
+		transactionCount: transactionCount,
+		createdAt:        now,
+		updatedAt:        now,
+		events:           []events.DomainEvent{},
+	}
+
+	// Add domain event
+	batch.addEvent(events.NewBaseDomainEvent(
+		"ImportBatchCreated",
+		batch.id.Value(),
+		"ImportBatch",
+	))
+
+	return batch, nil
+}
+
+// ImportBatchFromPersistence reconstructs an ImportBatch aggregate from persisted data.
+// This method does not trigger domain events, as it's used for loading existing data.
+func ImportBatchFromPersistence(
+	id transactionvalueobjects.ImportBatchID,
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	source string,
+	fileName string,
+	status string,
+	transactionCount int,
+	createdAt time.Time,
+	updatedAt time.Time,
+	rolledBackAt *time.Time,
+) (*ImportBatch, error) {
+	if id.IsEmpty() {
+		return nil, errors.New("import batch ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if accountID.IsEmpty() {
+		return nil, errors.New("account ID cannot be empty")
+	}
+
+	if status != ImportBatchStatusCommitted && status != ImportBatchStatusRolledBack {
+		return nil, errors.New("invalid import batch status: " + status)
+	}
+
+	return &ImportBatch{
+		id:               id,
+		userID:           userID,
+		accountID:        accountID,
+		source:           source,
+		fileName:         fileName,
+		status:           status,
+		transactionCount: transactionCount,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+		rolledBackAt:     rolledBackAt,
+		events:           []events.DomainEvent{},
+	}, nil
+}
+
+// ID returns the import batch ID.
+func (b *ImportBatch) ID() transactionvalueobjects.ImportBatchID {
+	return b.id
+}
+
+// UserID returns the user ID who owns the import batch.
+func (b *ImportBatch) UserID() identityvalueobjects.UserID {
+	return b.userID
+}
+
+// AccountID returns the account the statement was imported into.
+func (b *ImportBatch) AccountID() accountvalueobjects.AccountID {
+	return b.accountID
+}
+
+// Source returns the statement format (e.g. CSV).
+func (b *ImportBatch) Source() string {
+	return b.source
+}
+
+// FileName returns the name of the uploaded statement file.
+func (b *ImportBatch) FileName() string {
+	return b.fileName
+}
+
+// Status returns the import batch status (COMMITTED or ROLLED_BACK).
+func (b *ImportBatch) Status() string {
+	return b.status
+}
+
+// TransactionCount returns the number of transactions created by the import.
+func (b *ImportBatch) TransactionCount() int {
+	return b.transactionCount
+}
+
+// CreatedAt returns when the import batch was created.
+func (b *ImportBatch) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+// UpdatedAt returns when the import batch was last updated.
+func (b *ImportBatch) UpdatedAt() time.Time {
+	return b.updatedAt
+}
+
+// RolledBackAt returns when the import batch was rolled back (nil if still committed).
+func (b *ImportBatch) RolledBackAt() *time.Time {
+	return b.rolledBackAt
+}
+
+// IsRolledBack returns true if the import batch was rolled back.
+func (b *ImportBatch) IsRolledBack() bool {
+	return b.status == ImportBatchStatusRolledBack
+}
+
+// RollBack marks the import batch as rolled back.
+// Removing the batch transactions is up to the caller.
+func (b *ImportBatch) RollBack() error {
+	if b.IsRolledBack() {
+		return errors.New("import batch cannot be rolled back twice")
+	}
+
+	now := time.Now()
+	b.status = ImportBatchStatusRolledBack
+	b.rolledBackAt = &now
+	b.updatedAt = now
+
+	b.addEvent(events.NewBaseDomainEvent(
+		"ImportBatchRolledBack",
+		b.id.Value(),
+		"ImportBatch",
+	))
+
+	return nil
+}
+
+// GetEvents returns all domain events that occurred on this aggregate.
+func (b *ImportBatch) GetEvents() []events.DomainEvent {
+	return b.events
+}
+
+// ClearEvents clears all domain events from this aggregate.
+func (b *ImportBatch) ClearEvents() {
+	b.events = []events.DomainEvent{}
+}
+
+// addEvent adds a domain event to the aggregate.
+func (b *ImportBatch) addEvent(event events.DomainEvent) {
+	b.events = append(b.events, event)
+}
+
+// isValidImportSource checks if the statement format is supported.
+func isValidImportSource(source string) bool {
+	switch source {
+	case ImportSourceCSV, ImportSourceOFX, ImportSourceQIF:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestNewImportBatch(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	tests := []struct {
+		name      string
+		userID    identityvalueobjects.UserID
+		accountID accountvalueobjects.AccountID
+		source    string
+		count     int
+		wantError bool
+	}{
+		{"valid batch", userID, accountID, "csv", 3, false},
+		{"empty user ID", identityvalueobjects.UserID{}, accountID, ImportSourceCSV, 3, true},
+		{"empty account ID", userID, accountvalueobjects.AccountID{}, ImportSourceCSV, 3, true},
+		{"unknown source", userID, accountID, "XLS", 3, true},
+		{"no transactions", userID, accountID, ImportSourceCSV, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch, err := NewImportBatch(tt.userID, tt.accountID, tt.source, " extrato.csv ", tt.count)
+			if tt.wantError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if batch.Source() != ImportSourceCSV || batch.FileName() != "extrato.csv" {
+				t.Errorf("expected source CSV and file extrato.csv, got %s and %q", batch.Source(), batch.FileName())
+			}
+			if batch.Status() != ImportBatchStatusCommitted || batch.IsRolledBack() {
+				t.Errorf("expected COMMITTED batch, got %s", batch.Status())
+			}
+			if len(batch.GetEvents()) != 1 || batch.GetEvents()[0].EventType() != "ImportBatchCreated" {
+				t.Errorf("expected ImportBatchCreated event, got %v", batch.GetEvents())
+			}
+		})
+	}
+}
+
+func TestImportBatch_RollBack(t *testing.T) {
+	batch, _ := NewImportBatch(identityvalueobjects.GenerateUserID(), accountvalueobjects.GenerateAccountID(), ImportSourceCSV, "extrato.csv", 2)
+	batch.ClearEvents()
+
+	if err := batch.RollBack(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !batch.IsRolledBack() || batch.RolledBackAt() == nil {
+		t.Error("expected batch to be rolled back with a timestamp")
+	}
+	if len(batch.GetEvents()) != 1 || batch.GetEvents()[0].EventType() != "ImportBatchRolledBack" {
+		t.Errorf("expected ImportBatchRolledBack event, got %v", batch.GetEvents())
+	}
+
+	if err := batch.RollBack(); err == nil {
+		t.Error("expected error when rolling back twice")
+	}
+}
+
+func TestTransaction_AttachToImportBatch(t *testing.T) {
+	amount, _ := sharedvalueobjects.NewMoney(5000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Padaria")
+	transaction, _ := NewTransaction(identityvalueobjects.GenerateUserID(), accountvalueobjects.GenerateAccountID(), transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+
+	if transaction.IsImported() {
+		t.Fatal("expected new transaction not to be imported")
+	}
+	if err := transaction.AttachToImportBatch(transactionvalueobjects.ImportBatchID{}); err == nil {
+		t.Error("expected error for empty import batch ID")
+	}
+
+	batchID := transactionvalueobjects.GenerateImportBatchID()
+	if err := transaction.AttachToImportBatch(batchID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !transaction.IsImported() || !transaction.ImportBatchID().Equals(batchID) {
+		t.Errorf("expected transaction to belong to batch %s", batchID.Value())
+	}
+	if err := transaction.AttachToImportBatch(transactionvalueobjects.GenerateImportBatchID()); err == nil {
+		t.Error("expected error when attaching to a second batch")
+	}
+}
+
+func TestTransaction_AssignExternalID(t *testing.T) {
+	amount, _ := sharedvalueobjects.NewMoney(5000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Padaria")
+	transaction, _ := NewTransaction(identityvalueobjects.GenerateUserID(), accountvalueobjects.GenerateAccountID(), transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+
+	if err := transaction.AssignExternalID("  "); err == nil {
+		t.Error("expected error for empty external ID")
+	}
+	if err := transaction.AssignExternalID(strings.Repeat("9", MaxExternalIDLength+1)); err == nil {
+		t.Error("expected error for external ID that is too long")
+	}
+
+	if err := transaction.AssignExternalID(" 20261001001 "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transaction.ExternalID() != "20261001001" {
+		t.Errorf("expected external ID 20261001001, got %q", transaction.ExternalID())
+	}
+	if err := transaction.AssignExternalID("20261001002"); err == nil {
+		t.Error("expected error when assigning a second external ID")
+	}
+}
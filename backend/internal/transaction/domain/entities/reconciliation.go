@@ -0,0 +1,284 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// Reconciliation status values
+const (
+	ReconciliationStatusInProgress = "IN_PROGRESS" // The user is matching transactions against the statement
+	ReconciliationStatusCompleted  = "COMPLETED"   // Cleared transactions were marked as reconciled
+	ReconciliationStatusCanceled   = "CANCELED"    // Abandoned without reconciling any transaction
+)
+
+// Reconciliation represents an account reconciliation session in the Transaction context.
+// The user enters the end date and closing balance of a bank statement and clears the matching
+// transactions; the session can only be completed when the cleared balance equals the statement
+// balance, at which point the cleared transactions up to the statement date become reconciled.
+type Reconciliation struct {
+	id               transactionvalueobjects.ReconciliationID
+	userID           identityvalueobjects.UserID
+	accountID        accountvalueobjects.AccountID
+	statementDate    time.Time
+	statementBalance sharedvalueobjects.Money
+	status           string
+	reconciledCount  int
+	createdAt        time.Time
+	updatedAt        time.Time
+	completedAt      *time.Time
+	canceledAt       *time.Time
+
+	// Domain events
+	events []events.DomainEvent
+}
+
+// NewReconciliation starts a reconciliation of the given account against a bank statement
+// ending on statementDate with statementBalance as closing balance.
+func NewReconciliation(
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	statementDate time.Time,
+	statementBalance sharedvalueobjects.Money,
+) (*Reconciliation, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if accountID.IsEmpty() {
+		return nil, errors.New("account ID cannot be empty")
+	}
+
+	if statementDate.IsZero() {
+		return nil, errors.New("statement date cannot be zero")
+	}
+
+	now := time.Now()
+
+	reconciliation := &Reconciliation{
+		id:               transactionvalueobjects.GenerateReconciliationID(),
+		userID:           userID,
+		accountID:        accountID,
+		statementDate:    statementDate,
+		statementBalance: statementBalance,
+		status:           ReconciliationStatusInProgress,
+		createdAt:        now,
+		updatedAt:        now,
+		events:           []events.DomainEvent{},
+	}
+
+	// Add domain event
+	reconciliation.addEvent(events.NewBaseDomainEvent(
+		"ReconciliationStarted",
+		reconciliation.id.Value(),
+		"Reconciliation",
+	))
+
+	return reconciliation, nil
+}
+
+// ReconciliationFromPersistence reconstructs a Reconciliation aggregate from persisted data.
+// This method does not trigger domain events, as it's used for loading existing data.
+func ReconciliationFromPersistence(
+	id transactionvalueobjects.ReconciliationID,
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	statementDate time.Time,
+	statementBalance sharedvalueobjects.Money,
+	status string,
+	reconciledCount int,
+	createdAt time.Time,
+	updatedAt time.Time,
+	completedAt *time.Time,
+	canceledAt *time.Time,
+) (*Reconciliation, error) {
+	if id.IsEmpty() {
+		return nil, errors.New("reconciliation ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	if accountID.IsEmpty() {
+		return nil, errors.New("account ID cannot be empty")
+	}
+
+	if !isValidReconciliationStatus(status) {
+		return nil, errors.New("invalid reconciliation status: " + status)
+	}
+
+	return &Reconciliation{
+		id:               id,
+		userID:           userID,
+		accountID:        accountID,
+		statementDate:    statementDate,
+		statementBalance: statementBalance,
+		status:           status,
+		reconciledCount:  reconciledCount,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+		completedAt:      completedAt,
+		canceledAt:       canceledAt,
+		events:           []events.DomainEvent{},
+	}, nil
+}
+
+// ID returns the reconciliation ID.
+func (r *Reconciliation) ID() transactionvalueobjects.ReconciliationID {
+	return r.id
+}
+
+// UserID returns the user ID who owns the reconciliation.
+func (r *Reconciliation) UserID() identityvalueobjects.UserID {
+	return r.userID
+}
+
+// AccountID returns the account being reconciled.
+func (r *Reconciliation) AccountID() accountvalueobjects.AccountID {
+	return r.accountID
+}
+
+// StatementDate returns the end date of the bank statement.
+func (r *Reconciliation) StatementDate() time.Time {
+	return r.statementDate
+}
+
+// StatementBalance returns the closing balance of the bank statement.
+func (r *Reconciliation) StatementBalance() sharedvalueobjects.Money {
+	return r.statementBalance
+}
+
+// Status returns the reconciliation status (IN_PROGRESS, COMPLETED or CANCELED).
+func (r *Reconciliation) Status() string {
+	return r.status
+}
+
+// ReconciledCount returns how many transactions were reconciled on completion.
+func (r *Reconciliation) ReconciledCount() int {
+	return r.reconciledCount
+}
+
+// CreatedAt returns when the reconciliation was started.
+func (r *Reconciliation) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns when the reconciliation was last updated.
+func (r *Reconciliation) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// CompletedAt returns when the reconciliation was completed (nil unless completed).
+func (r *Reconciliation) CompletedAt() *time.Time {
+	return r.completedAt
+}
+
+// CanceledAt returns when the reconciliation was canceled (nil unless canceled).
+func (r *Reconciliation) CanceledAt() *time.Time {
+	return r.canceledAt
+}
+
+// IsInProgress returns true if the reconciliation can still be completed or canceled.
+func (r *Reconciliation) IsInProgress() bool {
+	return r.status == ReconciliationStatusInProgress
+}
+
+// IsCompleted returns true if the reconciliation was completed.
+func (r *Reconciliation) IsCompleted() bool {
+	return r.status == ReconciliationStatusCompleted
+}
+
+// Difference returns the statement balance minus the given cleared balance.
+// The reconciliation is balanced when the difference is zero.
+func (r *Reconciliation) Difference(clearedBalance sharedvalueobjects.Money) (sharedvalueobjects.Money, error) {
+	return r.statementBalance.Subtract(clearedBalance)
+}
+
+// Complete closes the reconciliation once the cleared balance matches the statement balance.
+// Marking the cleared transactions as reconciled is up to the caller; reconciledCount records
+// how many there were.
+func (r *Reconciliation) Complete(clearedBalance sharedvalueobjects.Money, reconciledCount int) error {
+	if !r.IsInProgress() {
+		return fmt.Errorf("reconciliation cannot be completed: it is %s", r.status)
+	}
+
+	difference, err := r.Difference(clearedBalance)
+	if err != nil {
+		return fmt.Errorf("cleared balance currency must match the statement balance: %w", err)
+	}
+	if !difference.IsZero() {
+		return fmt.Errorf("reconciliation cannot be completed: cleared balance %s differs from statement balance %s by %s",
+			clearedBalance.String(), r.statementBalance.String(), difference.String())
+	}
+
+	if reconciledCount < 0 {
+		return errors.New("reconciled transaction count cannot be negative")
+	}
+
+	now := time.Now()
+	r.status = ReconciliationStatusCompleted
+	r.reconciledCount = reconciledCount
+	r.completedAt = &now
+	r.updatedAt = now
+
+	r.addEvent(events.NewBaseDomainEvent(
+		"ReconciliationCompleted",
+		r.id.Value(),
+		"Reconciliation",
+	))
+
+	return nil
+}
+
+// Cancel abandons the reconciliation. Transaction statuses are left as they are.
+func (r *Reconciliation) Cancel() error {
+	if !r.IsInProgress() {
+		return fmt.Errorf("reconciliation cannot be canceled: it is %s", r.status)
+	}
+
+	now := time.Now()
+	r.status = ReconciliationStatusCanceled
+	r.canceledAt = &now
+	r.updatedAt = now
+
+	r.addEvent(events.NewBaseDomainEvent(
+		"ReconciliationCanceled",
+		r.id.Value(),
+		"Reconciliation",
+	))
+
+	return nil
+}
+
+// GetEvents returns all domain events that occurred on this aggregate.
+func (r *Reconciliation) GetEvents() []events.DomainEvent {
+	return r.events
+}
+
+// ClearEvents clears all domain events from this aggregate.
+func (r *Reconciliation) ClearEvents() {
+	r.events = []events.DomainEvent{}
+}
+
+// addEvent adds a domain event to the aggregate.
+func (r *Reconciliation) addEvent(event events.DomainEvent) {
+	r.events = append(r.events, event)
+}
+
+// isValidReconciliationStatus checks if the reconciliation status is supported.
+func isValidReconciliationStatus(status string) bool {
+	switch status {
+	case ReconciliationStatusInProgress, ReconciliationStatusCompleted, ReconciliationStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
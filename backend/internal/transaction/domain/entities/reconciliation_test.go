@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestNewReconciliation(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	balance, _ := sharedvalueobjects.NewMoney(152035, sharedvalueobjects.MustCurrency("BRL"))
+	statementDate := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		userID        identityvalueobjects.UserID
+		accountID     accountvalueobjects.AccountID
+		statementDate time.Time
+		wantError     bool
+	}{
+		{"valid reconciliation", userID, accountID, statementDate, false},
+		{"empty user ID", identityvalueobjects.UserID{}, accountID, statementDate, true},
+		{"empty account ID", userID, accountvalueobjects.AccountID{}, statementDate, true},
+		{"zero statement date", userID, accountID, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciliation, err := NewReconciliation(tt.userID, tt.accountID, tt.statementDate, balance)
+			if tt.wantError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reconciliation.IsInProgress() {
+				t.Errorf("expected status IN_PROGRESS, got %s", reconciliation.Status())
+			}
+			if len(reconciliation.GetEvents()) != 1 || reconciliation.GetEvents()[0].EventType() != "ReconciliationStarted" {
+				t.Errorf("expected a ReconciliationStarted event, got %v", reconciliation.GetEvents())
+			}
+		})
+	}
+}
+
+func TestReconciliation_CompleteAndCancel(t *testing.T) {
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	statementBalance, _ := sharedvalueobjects.NewMoney(152035, brl)
+	unbalanced, _ := sharedvalueobjects.NewMoney(150035, brl)
+
+	newReconciliation := func() *Reconciliation {
+		reconciliation, _ := NewReconciliation(identityvalueobjects.GenerateUserID(), accountvalueobjects.GenerateAccountID(), time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC), statementBalance)
+		reconciliation.ClearEvents()
+		return reconciliation
+	}
+
+	t.Run("requires a zero difference", func(t *testing.T) {
+		reconciliation := newReconciliation()
+		err := reconciliation.Complete(unbalanced, 3)
+		if err == nil || !strings.Contains(err.Error(), "differs from statement balance") {
+			t.Fatalf("expected difference error, got %v", err)
+		}
+		if !reconciliation.IsInProgress() {
+			t.Error("expected reconciliation to stay in progress")
+		}
+	})
+
+	t.Run("completes when balanced", func(t *testing.T) {
+		reconciliation := newReconciliation()
+		if err := reconciliation.Complete(statementBalance, 3); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reconciliation.IsCompleted() || reconciliation.ReconciledCount() != 3 || reconciliation.CompletedAt() == nil {
+			t.Errorf("unexpected state: status %s, count %d", reconciliation.Status(), reconciliation.ReconciledCount())
+		}
+		if err := reconciliation.Cancel(); err == nil {
+			t.Error("expected error when canceling a completed reconciliation")
+		}
+	})
+
+	t.Run("cancels", func(t *testing.T) {
+		reconciliation := newReconciliation()
+		if err := reconciliation.Cancel(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if reconciliation.Status() != ReconciliationStatusCanceled || reconciliation.CanceledAt() == nil {
+			t.Errorf("expected status CANCELED, got %s", reconciliation.Status())
+		}
+		if err := reconciliation.Complete(statementBalance, 0); err == nil {
+			t.Error("expected error when completing a canceled reconciliation")
+		}
+	})
+}
@@ -2,16 +2,25 @@ package entities
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/calendar"
 	"gestao-financeira/backend/internal/shared/domain/events"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
 	transactionevents "gestao-financeira/backend/internal/transaction/domain/events"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
+// MaxExternalIDLength is the maximum length of the bank identifier of a statement entry.
+const MaxExternalIDLength = 255
+
 // Transaction represents a transaction aggregate root in the Transaction context.
 type Transaction struct {
 	id              transactionvalueobjects.TransactionID
@@ -24,16 +33,85 @@ type Transaction struct {
 	createdAt       time.Time
 	updatedAt       time.Time
 
+	// Optional category (nil if uncategorized)
+	categoryID *categoryvalueobjects.CategoryID
+
+	// Optional split lines; when present they take precedence over categoryID
+	splits []transactionvalueobjects.TransactionSplit
+
+	// User-defined tags (empty if untagged)
+	tagIDs []tagvalueobjects.TagID
+
+	// Merchant or counterparty (nil if none), matched from the description or set by the user
+	payeeID *payeevalueobjects.PayeeID
+
+	// Original amount and exchange rate of a transaction made in a foreign currency (nil if it was
+	// made in the account currency); amount always holds the converted amount in the account currency
+	currencyConversion *transactionvalueobjects.CurrencyConversion
+
+	// Purchase a fee was charged for, e.g. the IOF of a foreign purchase (nil unless the transaction is a fee)
+	feeForTransactionID *transactionvalueobjects.TransactionID
+
 	// Recurrence fields
 	isRecurring         bool
 	recurrenceFrequency *transactionvalueobjects.RecurrenceFrequency
 	recurrenceEndDate   *time.Time
 	parentTransactionID *transactionvalueobjects.TransactionID
 
+	// Counterpart leg of a transfer (nil unless the transaction is a transfer leg)
+	linkedTransactionID *transactionvalueobjects.TransactionID
+
+	// Statement import batch the transaction came from (nil if entered manually)
+	importBatchID *transactionvalueobjects.ImportBatchID
+
+	// Identifier given by the bank to the statement entry, e.g. the OFX FITID (empty if none)
+	externalID string
+
+	// Whether the transaction was seen on the bank statement and reconciled
+	status           transactionvalueobjects.TransactionStatus
+	reconciliationID *transactionvalueobjects.ReconciliationID
+
+	// Position within an installment purchase (nil unless the transaction is an installment).
+	// Installments after the first reference the first one as their parent transaction.
+	installment *transactionvalueobjects.TransactionInstallment
+
+	// Management of a recurring series (only used on the transaction that starts the series):
+	// pause, dates left out of it, and amount changes for "this and future occurrences"
+	recurrencePausedAt      *time.Time
+	skippedOccurrences      []time.Time
+	recurrenceAmountChanges []transactionvalueobjects.RecurrenceAmountChange
+
+	// Schedule rules of a recurring series (nil anchor keeps the day of the month), and the last
+	// day up to which its occurrences were generated (nil until the first generation)
+	recurrenceAnchor         *transactionvalueobjects.RecurrenceAnchor
+	recurrenceBusinessDays   bool
+	recurrenceGeneratedUntil *time.Time
+
 	// Domain events
 	events []events.DomainEvent
 }
 
+// TransactionParams holds the fields of a new transaction. The optional fields are left at their
+// zero value when unused: a nil CategoryID creates an uncategorized transaction and IsRecurring
+// false a one-off transaction.
+type TransactionParams struct {
+	UserID      identityvalueobjects.UserID
+	AccountID   accountvalueobjects.AccountID
+	Type        transactionvalueobjects.TransactionType
+	Amount      sharedvalueobjects.Money
+	Description transactionvalueobjects.TransactionDescription
+	Date        time.Time
+
+	// Optional category (nil if uncategorized)
+	CategoryID *categoryvalueobjects.CategoryID
+
+	// Recurrence fields; ParentTransactionID is set on the occurrences generated from a series
+	IsRecurring         bool
+	RecurrenceFrequency *transactionvalueobjects.RecurrenceFrequency
+	RecurrenceEndDate   *time.Time
+	ParentTransactionID *transactionvalueobjects.TransactionID
+}
+
 // NewTransaction creates a new Transaction aggregate.
 func NewTransaction(
 	userID identityvalueobjects.UserID,
@@ -43,7 +121,14 @@ func NewTransaction(
 	description transactionvalueobjects.TransactionDescription,
 	date time.Time,
 ) (*Transaction, error) {
-	return NewTransactionWithRecurrence(userID, accountID, transactionType, amount, description, date, false, nil, nil, nil)
+	return NewTransactionWithParams(TransactionParams{
+		UserID:      userID,
+		AccountID:   accountID,
+		Type:        transactionType,
+		Amount:      amount,
+		Description: description,
+		Date:        date,
+	})
 }
 
 // NewTransactionWithRecurrence creates a new Transaction aggregate with recurrence support.
@@ -59,45 +144,272 @@ func NewTransactionWithRecurrence(
 	recurrenceEndDate *time.Time,
 	parentTransactionID *transactionvalueobjects.TransactionID,
 ) (*Transaction, error) {
-	if userID.IsEmpty() {
+	return NewTransactionWithParams(TransactionParams{
+		UserID:              userID,
+		AccountID:           accountID,
+		Type:                transactionType,
+		Amount:              amount,
+		Description:         description,
+		Date:                date,
+		IsRecurring:         isRecurring,
+		RecurrenceFrequency: recurrenceFrequency,
+		RecurrenceEndDate:   recurrenceEndDate,
+		ParentTransactionID: parentTransactionID,
+	})
+}
+
+// NewTransactionWithParams creates a new income or expense Transaction aggregate from params.
+func NewTransactionWithParams(params TransactionParams) (*Transaction, error) {
+	if params.Type.IsTransfer() {
+		return nil, errors.New("transfer transactions must be created with NewTransfer")
+	}
+	if params.Type.IsAdjustment() {
+		return nil, errors.New("balance adjustments must be created with NewBalanceAdjustment")
+	}
+
+	transaction, err := newTransaction(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add domain event with transaction details
+	transaction.addEvent(transactionevents.NewTransactionCreated(
+		transaction.id.Value(),
+		transaction.accountID.Value(),
+		transaction.transactionType.Value(),
+		transaction.amount,
+	))
+
+	return transaction, nil
+}
+
+// NewTransfer creates the two linked legs of a transfer between accounts of the same user.
+// The outgoing leg (TRANSFER_OUT) takes amount from fromAccountID and the incoming leg (TRANSFER_IN)
+// adds destinationAmount to toAccountID. The amounts may be in different currencies when the
+// accounts do not share a currency. A TransferCreated event is raised on the outgoing leg.
+func NewTransfer(
+	userID identityvalueobjects.UserID,
+	fromAccountID accountvalueobjects.AccountID,
+	toAccountID accountvalueobjects.AccountID,
+	amount sharedvalueobjects.Money,
+	destinationAmount sharedvalueobjects.Money,
+	description transactionvalueobjects.TransactionDescription,
+	date time.Time,
+) (*Transaction, *Transaction, error) {
+	if !fromAccountID.IsEmpty() && fromAccountID.Equals(toAccountID) {
+		return nil, nil, errors.New("transfer source and destination accounts must be different")
+	}
+
+	outgoing, err := newTransaction(TransactionParams{
+		UserID:      userID,
+		AccountID:   fromAccountID,
+		Type:        transactionvalueobjects.TransferOutType(),
+		Amount:      amount,
+		Description: description,
+		Date:        date,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	incoming, err := newTransaction(TransactionParams{
+		UserID:      userID,
+		AccountID:   toAccountID,
+		Type:        transactionvalueobjects.TransferInType(),
+		Amount:      destinationAmount,
+		Description: description,
+		Date:        date,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outgoingID := outgoing.id
+	incomingID := incoming.id
+	outgoing.linkedTransactionID = &incomingID
+	incoming.linkedTransactionID = &outgoingID
+
+	outgoing.addEvent(transactionevents.NewTransferCreated(
+		outgoing.id.Value(),
+		incoming.id.Value(),
+		fromAccountID.Value(),
+		toAccountID.Value(),
+		amount,
+		destinationAmount,
+	))
+
+	return outgoing, incoming, nil
+}
+
+// NewBalanceAdjustment creates the transaction that records a manual correction of the balance of
+// an account (including its opening balance), so that the balance always equals the sum of its transactions.
+// A positive difference raises the balance (ADJUSTMENT_IN) and a negative one lowers it
+// (ADJUSTMENT_OUT). Adjustments are not income or expenses and are left out of reports.
+func NewBalanceAdjustment(
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	difference sharedvalueobjects.Money,
+	description transactionvalueobjects.TransactionDescription,
+	date time.Time,
+) (*Transaction, error) {
+	if difference.IsZero() {
+		return nil, errors.New("balance adjustment amount cannot be zero")
+	}
+
+	transactionType := transactionvalueobjects.AdjustmentInType()
+	amount := difference
+	if difference.IsNegative() {
+		transactionType = transactionvalueobjects.AdjustmentOutType()
+		amount = difference.Negate()
+	}
+
+	transaction, err := newTransaction(TransactionParams{
+		UserID:      userID,
+		AccountID:   accountID,
+		Type:        transactionType,
+		Amount:      amount,
+		Description: description,
+		Date:        date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transaction.addEvent(transactionevents.NewTransactionCreated(
+		transaction.id.Value(),
+		transaction.accountID.Value(),
+		transaction.transactionType.Value(),
+		transaction.amount,
+	))
+
+	return transaction, nil
+}
+
+// NewInstallmentPurchase creates the installments of a purchase paid in len(amounts) monthly
+// installments ("parcelamento"), the first one on firstDate. Each installment is an expense of
+// the given amount; the installments after the first reference it as their parent transaction,
+// so the first installment's ID identifies the purchase. Dates falling past the end of a shorter
+// month are moved to its last day (a purchase on Jan 31 is billed on Feb 28).
+// An InstallmentPurchaseCreated event is raised on the first installment.
+func NewInstallmentPurchase(
+	userID identityvalueobjects.UserID,
+	accountID accountvalueobjects.AccountID,
+	amounts []sharedvalueobjects.Money,
+	description transactionvalueobjects.TransactionDescription,
+	firstDate time.Time,
+	categoryID *categoryvalueobjects.CategoryID,
+) ([]*Transaction, error) {
+	installments := make([]*Transaction, 0, len(amounts))
+	for i, amount := range amounts {
+		installment, err := transactionvalueobjects.NewTransactionInstallment(i+1, len(amounts))
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && !amount.Currency().Equals(amounts[0].Currency()) {
+			return nil, errors.New("installments must all have the same currency")
+		}
+
+		var parentTransactionID *transactionvalueobjects.TransactionID
+		if i > 0 {
+			firstID := installments[0].id
+			parentTransactionID = &firstID
+		}
+
+		transaction, err := newTransaction(TransactionParams{
+			UserID:              userID,
+			AccountID:           accountID,
+			Type:                transactionvalueobjects.ExpenseType(),
+			Amount:              amount,
+			Description:         description,
+			Date:                AddMonthsClamped(firstDate, i),
+			CategoryID:          categoryID,
+			ParentTransactionID: parentTransactionID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		transaction.installment = &installment
+
+		transaction.addEvent(transactionevents.NewTransactionCreated(
+			transaction.id.Value(),
+			transaction.accountID.Value(),
+			transaction.transactionType.Value(),
+			transaction.amount,
+		))
+
+		installments = append(installments, transaction)
+	}
+
+	if len(installments) > 0 {
+		installments[0].addEvent(events.NewBaseDomainEvent(
+			"InstallmentPurchaseCreated",
+			installments[0].id.Value(),
+			"Transaction",
+		))
+	}
+
+	return installments, nil
+}
+
+// AddMonthsClamped adds months to date keeping its day of the month, or using the last day of
+// the resulting month when it is shorter (unlike time.AddDate, which overflows into the next month).
+func AddMonthsClamped(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month(), 1, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+	target := firstOfMonth.AddDate(0, months, 0)
+	lastDay := target.AddDate(0, 1, -1).Day()
+
+	day := date.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(target.Year(), target.Month(), day, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+}
+
+// newTransaction validates the given fields and builds a Transaction without raising domain events.
+func newTransaction(params TransactionParams) (*Transaction, error) {
+	if params.UserID.IsEmpty() {
 		return nil, errors.New("user ID cannot be empty")
 	}
 
-	if accountID.IsEmpty() {
+	if params.AccountID.IsEmpty() {
 		return nil, errors.New("account ID cannot be empty")
 	}
 
-	if transactionType.Value() == "" {
+	if params.Type.Value() == "" {
 		return nil, errors.New("transaction type cannot be empty")
 	}
 
-	if amount.IsZero() {
+	if params.Amount.IsZero() {
 		return nil, errors.New("transaction amount cannot be zero")
 	}
 
-	if amount.IsNegative() {
+	if params.Amount.IsNegative() {
 		return nil, errors.New("transaction amount cannot be negative")
 	}
 
-	if description.IsEmpty() {
+	if params.Description.IsEmpty() {
 		return nil, errors.New("transaction description cannot be empty")
 	}
 
-	if date.IsZero() {
+	if params.Date.IsZero() {
 		return nil, errors.New("transaction date cannot be zero")
 	}
 
+	if params.CategoryID != nil && params.CategoryID.IsEmpty() {
+		return nil, errors.New("category ID cannot be empty")
+	}
+
 	// Validate recurrence fields
-	if isRecurring {
-		if recurrenceFrequency == nil {
+	if params.IsRecurring {
+		if params.RecurrenceFrequency == nil {
 			return nil, errors.New("recurrence frequency is required for recurring transactions")
 		}
-		if recurrenceEndDate != nil && !recurrenceEndDate.IsZero() && recurrenceEndDate.Before(date) {
+		if params.RecurrenceEndDate != nil && !params.RecurrenceEndDate.IsZero() && params.RecurrenceEndDate.Before(params.Date) {
 			return nil, errors.New("recurrence end date cannot be before transaction date")
 		}
 	} else {
 		// If not recurring, ensure recurrence fields are nil
-		if recurrenceFrequency != nil || recurrenceEndDate != nil {
+		if params.RecurrenceFrequency != nil || params.RecurrenceEndDate != nil {
 			return nil, errors.New("recurrence fields should be nil for non-recurring transactions")
 		}
 	}
@@ -106,95 +418,128 @@ func NewTransactionWithRecurrence(
 
 	transaction := &Transaction{
 		id:                  transactionvalueobjects.GenerateTransactionID(),
-		userID:              userID,
-		accountID:           accountID,
-		transactionType:     transactionType,
-		amount:              amount,
-		description:         description,
-		date:                date,
-		isRecurring:         isRecurring,
-		recurrenceFrequency: recurrenceFrequency,
-		recurrenceEndDate:   recurrenceEndDate,
-		parentTransactionID: parentTransactionID,
+		userID:              params.UserID,
+		accountID:           params.AccountID,
+		transactionType:     params.Type,
+		amount:              params.Amount,
+		description:         params.Description,
+		date:                params.Date,
+		categoryID:          params.CategoryID,
+		isRecurring:         params.IsRecurring,
+		recurrenceFrequency: params.RecurrenceFrequency,
+		recurrenceEndDate:   params.RecurrenceEndDate,
+		parentTransactionID: params.ParentTransactionID,
+		status:              transactionvalueobjects.PendingStatus(),
 		createdAt:           now,
 		updatedAt:           now,
 		events:              []events.DomainEvent{},
 	}
 
-	// Add domain event with transaction details
-	transaction.addEvent(transactionevents.NewTransactionCreated(
-		transaction.id.Value(),
-		transaction.accountID.Value(),
-		transaction.transactionType.Value(),
-		transaction.amount,
-	))
-
 	return transaction, nil
 }
 
-// TransactionFromPersistence reconstructs a Transaction aggregate from persisted data.
-// This method does not trigger domain events, as it's used for loading existing data.
-func TransactionFromPersistence(
-	id transactionvalueobjects.TransactionID,
-	userID identityvalueobjects.UserID,
-	accountID accountvalueobjects.AccountID,
-	transactionType transactionvalueobjects.TransactionType,
-	amount sharedvalueobjects.Money,
-	description transactionvalueobjects.TransactionDescription,
-	date time.Time,
-	createdAt time.Time,
-	updatedAt time.Time,
-) (*Transaction, error) {
-	return TransactionFromPersistenceWithRecurrence(id, userID, accountID, transactionType, amount, description, date, createdAt, updatedAt, false, nil, nil, nil)
+// TransactionPersistenceSnapshot holds the persisted state of a transaction, as loaded by a
+// repository. The optional fields are left at their zero value when unused, and a zero Status
+// loads the transaction as pending.
+type TransactionPersistenceSnapshot struct {
+	ID          transactionvalueobjects.TransactionID
+	UserID      identityvalueobjects.UserID
+	AccountID   accountvalueobjects.AccountID
+	Type        transactionvalueobjects.TransactionType
+	Amount      sharedvalueobjects.Money
+	Description transactionvalueobjects.TransactionDescription
+	Date        time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	CategoryID          *categoryvalueobjects.CategoryID
+	Splits              []transactionvalueobjects.TransactionSplit
+	TagIDs              []tagvalueobjects.TagID
+	PayeeID             *payeevalueobjects.PayeeID
+	CurrencyConversion  *transactionvalueobjects.CurrencyConversion
+	FeeForTransactionID *transactionvalueobjects.TransactionID
+	LinkedTransactionID *transactionvalueobjects.TransactionID
+	ImportBatchID       *transactionvalueobjects.ImportBatchID
+	ExternalID          string
+	Status              transactionvalueobjects.TransactionStatus
+	ReconciliationID    *transactionvalueobjects.ReconciliationID
+	Installment         *transactionvalueobjects.TransactionInstallment
+
+	// Recurrence fields
+	IsRecurring         bool
+	RecurrenceFrequency *transactionvalueobjects.RecurrenceFrequency
+	RecurrenceEndDate   *time.Time
+	ParentTransactionID *transactionvalueobjects.TransactionID
+
+	// Management and schedule rules of a recurring series
+	RecurrencePausedAt       *time.Time
+	SkippedOccurrences       []time.Time
+	RecurrenceAmountChanges  []transactionvalueobjects.RecurrenceAmountChange
+	RecurrenceAnchor         *transactionvalueobjects.RecurrenceAnchor
+	RecurrenceBusinessDays   bool
+	RecurrenceGeneratedUntil *time.Time
 }
 
-// TransactionFromPersistenceWithRecurrence reconstructs a Transaction aggregate from persisted data with recurrence support.
-func TransactionFromPersistenceWithRecurrence(
-	id transactionvalueobjects.TransactionID,
-	userID identityvalueobjects.UserID,
-	accountID accountvalueobjects.AccountID,
-	transactionType transactionvalueobjects.TransactionType,
-	amount sharedvalueobjects.Money,
-	description transactionvalueobjects.TransactionDescription,
-	date time.Time,
-	createdAt time.Time,
-	updatedAt time.Time,
-	isRecurring bool,
-	recurrenceFrequency *transactionvalueobjects.RecurrenceFrequency,
-	recurrenceEndDate *time.Time,
-	parentTransactionID *transactionvalueobjects.TransactionID,
-) (*Transaction, error) {
-	if id.IsEmpty() {
+// TransactionFromPersistence reconstructs a Transaction aggregate from persisted data.
+// This method does not trigger domain events, as it's used for loading existing data.
+func TransactionFromPersistence(snapshot TransactionPersistenceSnapshot) (*Transaction, error) {
+	if snapshot.ID.IsEmpty() {
 		return nil, errors.New("transaction ID cannot be empty")
 	}
-	if userID.IsEmpty() {
+	if snapshot.UserID.IsEmpty() {
 		return nil, errors.New("user ID cannot be empty")
 	}
-	if accountID.IsEmpty() {
+	if snapshot.AccountID.IsEmpty() {
 		return nil, errors.New("account ID cannot be empty")
 	}
-	if transactionType.Value() == "" {
+	if snapshot.Type.Value() == "" {
 		return nil, errors.New("transaction type cannot be empty")
 	}
-	if description.IsEmpty() {
+	if snapshot.Description.IsEmpty() {
 		return nil, errors.New("transaction description cannot be empty")
 	}
 
+	status := snapshot.Status
+	if status.Value() == "" {
+		status = transactionvalueobjects.PendingStatus()
+	}
+	if status.IsReconciled() != (snapshot.ReconciliationID != nil) {
+		return nil, errors.New("reconciled transactions must reference their reconciliation")
+	}
+
 	return &Transaction{
-		id:                  id,
-		userID:              userID,
-		accountID:           accountID,
-		transactionType:     transactionType,
-		amount:              amount,
-		description:         description,
-		date:                date,
-		isRecurring:         isRecurring,
-		recurrenceFrequency: recurrenceFrequency,
-		recurrenceEndDate:   recurrenceEndDate,
-		parentTransactionID: parentTransactionID,
-		createdAt:           createdAt,
-		updatedAt:           updatedAt,
-		events:              []events.DomainEvent{},
+		id:                       snapshot.ID,
+		userID:                   snapshot.UserID,
+		accountID:                snapshot.AccountID,
+		transactionType:          snapshot.Type,
+		amount:                   snapshot.Amount,
+		description:              snapshot.Description,
+		date:                     snapshot.Date,
+		categoryID:               snapshot.CategoryID,
+		isRecurring:              snapshot.IsRecurring,
+		recurrenceFrequency:      snapshot.RecurrenceFrequency,
+		recurrenceEndDate:        snapshot.RecurrenceEndDate,
+		parentTransactionID:      snapshot.ParentTransactionID,
+		linkedTransactionID:      snapshot.LinkedTransactionID,
+		splits:                   snapshot.Splits,
+		tagIDs:                   snapshot.TagIDs,
+		payeeID:                  snapshot.PayeeID,
+		currencyConversion:       snapshot.CurrencyConversion,
+		feeForTransactionID:      snapshot.FeeForTransactionID,
+		importBatchID:            snapshot.ImportBatchID,
+		externalID:               snapshot.ExternalID,
+		status:                   status,
+		reconciliationID:         snapshot.ReconciliationID,
+		installment:              snapshot.Installment,
+		recurrencePausedAt:       snapshot.RecurrencePausedAt,
+		skippedOccurrences:       snapshot.SkippedOccurrences,
+		recurrenceAmountChanges:  snapshot.RecurrenceAmountChanges,
+		recurrenceAnchor:         snapshot.RecurrenceAnchor,
+		recurrenceBusinessDays:   snapshot.RecurrenceBusinessDays,
+		recurrenceGeneratedUntil: snapshot.RecurrenceGeneratedUntil,
+		createdAt:                snapshot.CreatedAt,
+		updatedAt:                snapshot.UpdatedAt,
+		events:                   []events.DomainEvent{},
 	}, nil
 }
 
@@ -243,6 +588,16 @@ func (t *Transaction) UpdatedAt() time.Time {
 	return t.updatedAt
 }
 
+// CategoryID returns the category ID (nil if uncategorized).
+func (t *Transaction) CategoryID() *categoryvalueobjects.CategoryID {
+	return t.categoryID
+}
+
+// HasCategory checks if the transaction is assigned to a category.
+func (t *Transaction) HasCategory() bool {
+	return t.categoryID != nil
+}
+
 // IsRecurring returns whether the transaction is recurring.
 func (t *Transaction) IsRecurring() bool {
 	return t.isRecurring
@@ -263,98 +618,1050 @@ func (t *Transaction) ParentTransactionID() *transactionvalueobjects.Transaction
 	return t.parentTransactionID
 }
 
-// UpdateAmount updates the transaction amount.
-func (t *Transaction) UpdateAmount(amount sharedvalueobjects.Money) error {
-	if amount.IsZero() {
-		return errors.New("transaction amount cannot be zero")
+// LinkedTransactionID returns the counterpart leg of a transfer (nil if not a transfer).
+func (t *Transaction) LinkedTransactionID() *transactionvalueobjects.TransactionID {
+	return t.linkedTransactionID
+}
+
+// IsTransfer returns true if the transaction is one of the legs of a transfer.
+func (t *Transaction) IsTransfer() bool {
+	return t.transactionType.IsTransfer()
+}
+
+// IsAdjustment returns true if the transaction is a balance adjustment.
+func (t *Transaction) IsAdjustment() bool {
+	return t.transactionType.IsAdjustment()
+}
+
+// Splits returns the split lines of the transaction (empty if the transaction is not split).
+func (t *Transaction) Splits() []transactionvalueobjects.TransactionSplit {
+	splits := make([]transactionvalueobjects.TransactionSplit, len(t.splits))
+	copy(splits, t.splits)
+	return splits
+}
+
+// HasSplits returns true if the transaction amount is split across categories.
+func (t *Transaction) HasSplits() bool {
+	return len(t.splits) > 0
+}
+
+// AmountForCategory returns the part of the transaction amount that belongs to the given category.
+// For split transactions it is the sum of the matching split lines; otherwise it is the whole
+// amount when the transaction is assigned to the category, or zero.
+func (t *Transaction) AmountForCategory(categoryID categoryvalueobjects.CategoryID) sharedvalueobjects.Money {
+	total := sharedvalueobjects.Zero(t.amount.Currency())
+
+	if t.HasSplits() {
+		for _, split := range t.splits {
+			if split.CategoryID().Equals(categoryID) {
+				total, _ = total.Add(split.Amount())
+			}
+		}
+		return total
 	}
 
-	if amount.IsNegative() {
-		return errors.New("transaction amount cannot be negative")
+	if t.categoryID != nil && t.categoryID.Equals(categoryID) {
+		return t.amount
 	}
 
-	// Ensure currencies match
-	if !t.amount.Currency().Equals(amount.Currency()) {
-		return errors.New("cannot update amount with different currency")
+	return total
+}
+
+// TagIDs returns the tags attached to the transaction (empty if untagged).
+func (t *Transaction) TagIDs() []tagvalueobjects.TagID {
+	tagIDs := make([]tagvalueobjects.TagID, len(t.tagIDs))
+	copy(tagIDs, t.tagIDs)
+	return tagIDs
+}
+
+// HasTag returns true if the given tag is attached to the transaction.
+func (t *Transaction) HasTag(tagID tagvalueobjects.TagID) bool {
+	for _, id := range t.tagIDs {
+		if id.Equals(tagID) {
+			return true
+		}
 	}
+	return false
+}
 
-	t.amount = amount
-	t.updatedAt = time.Now()
+// PayeeID returns the payee of the transaction (nil if none).
+func (t *Transaction) PayeeID() *payeevalueobjects.PayeeID {
+	return t.payeeID
+}
 
-	t.addEvent(events.NewBaseDomainEvent(
-		"TransactionAmountUpdated",
-		t.id.Value(),
-		"Transaction",
-	))
+// HasPayee checks if the transaction is assigned to a payee.
+func (t *Transaction) HasPayee() bool {
+	return t.payeeID != nil
+}
 
-	return nil
+// CurrencyConversion returns the original amount and exchange rate of a transaction made in a
+// foreign currency (nil if it was made in the account currency).
+func (t *Transaction) CurrencyConversion() *transactionvalueobjects.CurrencyConversion {
+	return t.currencyConversion
 }
 
-// UpdateDescription updates the transaction description.
-func (t *Transaction) UpdateDescription(description transactionvalueobjects.TransactionDescription) error {
-	if description.IsEmpty() {
-		return errors.New("transaction description cannot be empty")
-	}
+// IsForeignCurrency returns true if the transaction was made in a currency other than the account currency.
+func (t *Transaction) IsForeignCurrency() bool {
+	return t.currencyConversion != nil
+}
 
-	t.description = description
-	t.updatedAt = time.Now()
+// FeeForTransactionID returns the purchase the transaction is a fee for (nil unless it is a fee).
+func (t *Transaction) FeeForTransactionID() *transactionvalueobjects.TransactionID {
+	return t.feeForTransactionID
+}
 
-	t.addEvent(events.NewBaseDomainEvent(
-		"TransactionDescriptionUpdated",
-		t.id.Value(),
-		"Transaction",
-	))
+// IsFee returns true if the transaction is a fee charged for another transaction.
+func (t *Transaction) IsFee() bool {
+	return t.feeForTransactionID != nil
+}
 
-	return nil
+// ImportBatchID returns the statement import batch the transaction came from (nil if entered manually).
+func (t *Transaction) ImportBatchID() *transactionvalueobjects.ImportBatchID {
+	return t.importBatchID
 }
 
-// UpdateDate updates the transaction date.
-func (t *Transaction) UpdateDate(date time.Time) error {
-	if date.IsZero() {
-		return errors.New("transaction date cannot be zero")
+// IsImported returns true if the transaction was created by a statement import.
+func (t *Transaction) IsImported() bool {
+	return t.importBatchID != nil
+}
+
+// AttachToImportBatch records the statement import batch the transaction came from.
+// A transaction belongs to at most one batch.
+func (t *Transaction) AttachToImportBatch(batchID transactionvalueobjects.ImportBatchID) error {
+	if batchID.IsEmpty() {
+		return errors.New("import batch ID cannot be empty")
+	}
+	if t.importBatchID != nil {
+		return errors.New("transaction already belongs to an import batch")
 	}
 
-	t.date = date
-	t.updatedAt = time.Now()
+	t.importBatchID = &batchID
+	return nil
+}
 
-	t.addEvent(events.NewBaseDomainEvent(
-		"TransactionDateUpdated",
-		t.id.Value(),
-		"Transaction",
-	))
+// ExternalID returns the identifier given by the bank to the statement entry (empty if none).
+func (t *Transaction) ExternalID() string {
+	return t.externalID
+}
 
+// AssignExternalID records the identifier given by the bank to the statement entry (the OFX FITID),
+// which is used to avoid importing the same entry twice. It cannot be changed once assigned.
+func (t *Transaction) AssignExternalID(externalID string) error {
+	externalID = strings.TrimSpace(externalID)
+	if externalID == "" {
+		return errors.New("external ID cannot be empty")
+	}
+	if len(externalID) > MaxExternalIDLength {
+		return fmt.Errorf("external ID must have at most %d characters", MaxExternalIDLength)
+	}
+	if t.externalID != "" {
+		return errors.New("transaction already has an external ID")
+	}
+
+	t.externalID = externalID
 	return nil
 }
 
-// UpdateType updates the transaction type.
-func (t *Transaction) UpdateType(transactionType transactionvalueobjects.TransactionType) error {
-	if transactionType.Value() == "" {
-		return errors.New("transaction type cannot be empty")
+// Status returns whether the transaction is pending, cleared or reconciled.
+func (t *Transaction) Status() transactionvalueobjects.TransactionStatus {
+	return t.status
+}
+
+// IsReconciled returns true if the transaction was matched by a completed account reconciliation.
+// Reconciled transactions are locked against changes to their amount, type and date.
+func (t *Transaction) IsReconciled() bool {
+	return t.status.IsReconciled()
+}
+
+// ReconciliationID returns the reconciliation that matched the transaction (nil unless reconciled).
+func (t *Transaction) ReconciliationID() *transactionvalueobjects.ReconciliationID {
+	return t.reconciliationID
+}
+
+// Installment returns the position of the transaction within an installment purchase
+// (nil unless the transaction is an installment).
+func (t *Transaction) Installment() *transactionvalueobjects.TransactionInstallment {
+	return t.installment
+}
+
+// IsInstallment returns true if the transaction is an installment of a purchase.
+func (t *Transaction) IsInstallment() bool {
+	return t.installment != nil
+}
+
+// InstallmentPurchaseID returns the ID of the first installment, which identifies the purchase
+// the installment belongs to (nil unless the transaction is an installment).
+func (t *Transaction) InstallmentPurchaseID() *transactionvalueobjects.TransactionID {
+	if t.installment == nil {
+		return nil
 	}
+	if t.parentTransactionID != nil {
+		return t.parentTransactionID
+	}
+	id := t.id
+	return &id
+}
 
-	t.transactionType = transactionType
-	t.updatedAt = time.Now()
+// IsRecurringSeries checks if the transaction starts a recurring series, i.e. it is recurring and
+// not an occurrence generated from another transaction.
+func (t *Transaction) IsRecurringSeries() bool {
+	return t.isRecurring && t.recurrenceFrequency != nil && t.parentTransactionID == nil
+}
 
-	t.addEvent(events.NewBaseDomainEvent(
-		"TransactionTypeUpdated",
-		t.id.Value(),
-		"Transaction",
-	))
+// RecurrencePausedAt returns when the recurring series was paused (nil unless it is paused).
+func (t *Transaction) RecurrencePausedAt() *time.Time {
+	return t.recurrencePausedAt
+}
 
-	return nil
+// IsRecurrencePaused checks if the generation of occurrences of the series is paused.
+func (t *Transaction) IsRecurrencePaused() bool {
+	return t.recurrencePausedAt != nil
 }
 
-// GetEvents returns all domain events that occurred on this aggregate.
-func (t *Transaction) GetEvents() []events.DomainEvent {
-	return t.events
+// SkippedOccurrences returns the dates left out of the recurring series, in ascending order.
+func (t *Transaction) SkippedOccurrences() []time.Time {
+	skipped := make([]time.Time, len(t.skippedOccurrences))
+	copy(skipped, t.skippedOccurrences)
+	return skipped
 }
 
-// ClearEvents clears all domain events from this aggregate.
-func (t *Transaction) ClearEvents() {
-	t.events = []events.DomainEvent{}
+// RecurrenceAmountChanges returns the amount changes of the recurring series, by effective date.
+func (t *Transaction) RecurrenceAmountChanges() []transactionvalueobjects.RecurrenceAmountChange {
+	changes := make([]transactionvalueobjects.RecurrenceAmountChange, len(t.recurrenceAmountChanges))
+	copy(changes, t.recurrenceAmountChanges)
+	return changes
 }
 
-// addEvent adds a domain event to the aggregate.
-func (t *Transaction) addEvent(event events.DomainEvent) {
-	t.events = append(t.events, event)
+// RecurrenceAnchor returns how the day of the month of the occurrences is chosen (nil keeps the
+// day of the month of the first occurrence).
+func (t *Transaction) RecurrenceAnchor() *transactionvalueobjects.RecurrenceAnchor {
+	return t.recurrenceAnchor
+}
+
+// ShiftsToBusinessDay checks if occurrences falling on weekends or Brazilian holidays are moved
+// to the next business day.
+func (t *Transaction) ShiftsToBusinessDay() bool {
+	return t.recurrenceBusinessDays
+}
+
+// RecurrenceGeneratedUntil returns the last day up to which the occurrences of the series were
+// generated (nil until the first generation).
+func (t *Transaction) RecurrenceGeneratedUntil() *time.Time {
+	return t.recurrenceGeneratedUntil
+}
+
+// OccurrenceDate returns the date of the n-th occurrence of the recurring series, the transaction
+// itself being occurrence 0. Monthly and yearly dates follow the anchor of the series; by default
+// dates falling past the end of a shorter month are moved to its last day without shifting the
+// following occurrences (Jan 31, Feb 28, Mar 31...). When the series shifts to business days,
+// occurrences after the first one falling on a weekend or holiday move to the next business day.
+func (t *Transaction) OccurrenceDate(n int) time.Time {
+	date := t.nominalOccurrenceDate(n)
+	if n > 0 && t.recurrenceBusinessDays {
+		date = calendar.NextBusinessDay(date)
+	}
+	return date
+}
+
+// nominalOccurrenceDate returns the date of the n-th occurrence before any business day shift.
+func (t *Transaction) nominalOccurrenceDate(n int) time.Time {
+	frequency := t.recurrenceFrequency
+	anchor := transactionvalueobjects.DayOfMonthRecurrenceAnchor()
+	if t.recurrenceAnchor != nil {
+		anchor = *t.recurrenceAnchor
+	}
+
+	var months int
+	switch {
+	case frequency == nil:
+		return t.date
+	case frequency.IsDaily():
+		return t.date.AddDate(0, 0, n)
+	case frequency.IsWeekly():
+		return t.date.AddDate(0, 0, 7*n)
+	case frequency.IsMonthly():
+		months = n
+	case frequency.IsYearly():
+		months = 12 * n
+	default:
+		return t.date
+	}
+
+	month := time.Date(t.date.Year(), t.date.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, months, 0)
+	return anchor.DateIn(t.date, month.Year(), month.Month())
+}
+
+// IsOccurrenceDate checks if the recurring series has an occurrence on the day of date, skipped
+// or not, up to its end date.
+func (t *Transaction) IsOccurrenceDate(date time.Time) bool {
+	if !t.IsRecurringSeries() || t.isPastRecurrenceEnd(date) {
+		return false
+	}
+	day := calendarDay(date)
+	return calendarDay(t.OccurrenceDate(t.occurrenceIndexOnOrBefore(day))).Equal(day)
+}
+
+// IsOccurrenceSkipped checks if the occurrence on the day of date was left out of the series.
+func (t *Transaction) IsOccurrenceSkipped(date time.Time) bool {
+	day := calendarDay(date)
+	for _, skipped := range t.skippedOccurrences {
+		if calendarDay(skipped).Equal(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOccurrences returns up to limit occurrence dates of the recurring series on or after the
+// day of from, leaving out the transaction itself (occurrence 0, which always exists), skipped
+// dates and dates past the end date. Whether the series is paused is up to the caller.
+func (t *Transaction) NextOccurrences(from time.Time, limit int) []time.Time {
+	if !t.IsRecurringSeries() || limit <= 0 {
+		return nil
+	}
+
+	day := calendarDay(from)
+	var dates []time.Time
+	for n := max(t.occurrenceIndexOnOrBefore(day), 1); len(dates) < limit; n++ {
+		date := t.OccurrenceDate(n)
+		if t.isPastRecurrenceEnd(date) {
+			break
+		}
+		if calendarDay(date).Before(day) || t.IsOccurrenceSkipped(date) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// DueOccurrences returns the occurrence dates of the recurring series due on or before the day of
+// asOf that were not generated yet, i.e. after RecurrenceGeneratedUntil (or after the transaction
+// itself), leaving out skipped dates and dates past the end date. Whether the series is paused is
+// up to the caller.
+func (t *Transaction) DueOccurrences(asOf time.Time) []time.Time {
+	if !t.IsRecurringSeries() {
+		return nil
+	}
+
+	from := calendarDay(t.date).AddDate(0, 0, 1)
+	if t.recurrenceGeneratedUntil != nil {
+		from = calendarDay(*t.recurrenceGeneratedUntil).AddDate(0, 0, 1)
+	}
+	until := calendarDay(asOf)
+
+	var dates []time.Time
+	for n := max(t.occurrenceIndexOnOrBefore(from), 1); ; n++ {
+		date := t.OccurrenceDate(n)
+		if t.isPastRecurrenceEnd(date) || calendarDay(date).After(until) {
+			break
+		}
+		if calendarDay(date).Before(from) || t.IsOccurrenceSkipped(date) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// MarkOccurrencesGenerated records that the occurrences of the recurring series were generated up
+// to the day of until, so they are not generated again (e.g. after being deleted).
+func (t *Transaction) MarkOccurrencesGenerated(until time.Time) {
+	day := calendarDay(until)
+	if t.recurrenceGeneratedUntil != nil && !day.After(calendarDay(*t.recurrenceGeneratedUntil)) {
+		return
+	}
+	t.recurrenceGeneratedUntil = &day
+}
+
+// RecurrenceAmountOn returns the amount of the occurrence of the series on the given date: the
+// amount of the latest change effective on or before it, or the transaction amount.
+func (t *Transaction) RecurrenceAmountOn(date time.Time) sharedvalueobjects.Money {
+	day := calendarDay(date)
+	amount := t.amount
+	for _, change := range t.recurrenceAmountChanges {
+		if calendarDay(change.EffectiveDate()).After(day) {
+			break
+		}
+		amount = change.Amount()
+	}
+	return amount
+}
+
+// occurrenceIndexOnOrBefore returns the index of the last occurrence on or before day (0 when
+// day is not after the first occurrence).
+func (t *Transaction) occurrenceIndexOnOrBefore(day time.Time) int {
+	start := calendarDay(t.date)
+	if !day.After(start) || t.recurrenceFrequency == nil {
+		return 0
+	}
+
+	var n int
+	switch {
+	case t.recurrenceFrequency.IsDaily():
+		n = int(day.Sub(start).Hours() / 24)
+	case t.recurrenceFrequency.IsWeekly():
+		n = int(day.Sub(start).Hours()/24) / 7
+	case t.recurrenceFrequency.IsMonthly():
+		n = (day.Year()-start.Year())*12 + int(day.Month()-start.Month())
+	case t.recurrenceFrequency.IsYearly():
+		n = day.Year() - start.Year()
+	}
+
+	// Step back when the estimate lands after day (e.g. Jan 31 monthly and day Feb 27)
+	for n > 0 && calendarDay(t.OccurrenceDate(n)).After(day) {
+		n--
+	}
+	return n
+}
+
+// isPastRecurrenceEnd checks if date falls after the end date of the recurrence.
+func (t *Transaction) isPastRecurrenceEnd(date time.Time) bool {
+	return t.recurrenceEndDate != nil && !t.recurrenceEndDate.IsZero() &&
+		calendarDay(date).After(calendarDay(*t.recurrenceEndDate))
+}
+
+// UpdateStatus marks the transaction as pending or cleared.
+// Transactions only become reconciled by completing a reconciliation (see Reconcile); setting
+// a reconciled transaction back to pending or cleared deliberately unlocks it for editing.
+func (t *Transaction) UpdateStatus(status transactionvalueobjects.TransactionStatus) error {
+	if status.Value() == "" {
+		return errors.New("transaction status cannot be empty")
+	}
+	if status.IsReconciled() {
+		return errors.New("transactions cannot be marked as reconciled directly; complete an account reconciliation instead")
+	}
+
+	t.status = status
+	t.reconciliationID = nil
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionStatusUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// Reconcile marks a cleared transaction as reconciled by the given reconciliation.
+func (t *Transaction) Reconcile(reconciliationID transactionvalueobjects.ReconciliationID) error {
+	if reconciliationID.IsEmpty() {
+		return errors.New("reconciliation ID cannot be empty")
+	}
+	if !t.status.IsCleared() {
+		return fmt.Errorf("only cleared transactions can be reconciled (transaction is %s)", t.status.Value())
+	}
+
+	t.status = transactionvalueobjects.ReconciledStatus()
+	t.reconciliationID = &reconciliationID
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionReconciled",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// MergeDuplicate absorbs a duplicate of this transaction before the duplicate is deleted.
+// Both must be regular (non-transfer) transactions of the same account, type and currency.
+// The transaction keeps its own amount, date and description; it takes the duplicate's category
+// when it has none, the union of both tag sets, the duplicate's external ID when it has none,
+// so the bank entry is still recognized as imported, and the cleared status of the duplicate.
+func (t *Transaction) MergeDuplicate(duplicate *Transaction) error {
+	if duplicate == nil {
+		return errors.New("duplicate transaction cannot be empty")
+	}
+	if t.id.Equals(duplicate.id) {
+		return errors.New("transaction cannot be merged with itself")
+	}
+	if t.IsTransfer() || duplicate.IsTransfer() {
+		return errors.New("transfers cannot be merged; delete the duplicate transfer instead")
+	}
+	if t.IsAdjustment() || duplicate.IsAdjustment() {
+		return errors.New("balance adjustments cannot be merged; delete the duplicate adjustment instead")
+	}
+	if !t.accountID.Equals(duplicate.accountID) {
+		return errors.New("duplicate transaction must be in the same account")
+	}
+	if !t.transactionType.Equals(duplicate.transactionType) {
+		return errors.New("duplicate transaction must have the same type")
+	}
+	if t.amount.Currency().Code() != duplicate.amount.Currency().Code() {
+		return errors.New("duplicate transaction must have the same currency")
+	}
+	if duplicate.IsReconciled() {
+		return errors.New("reconciled duplicate cannot be merged; keep the reconciled transaction instead")
+	}
+
+	if t.categoryID == nil && len(t.splits) == 0 && duplicate.categoryID != nil {
+		categoryID := *duplicate.categoryID
+		t.categoryID = &categoryID
+	}
+
+	for _, tagID := range duplicate.tagIDs {
+		if !t.HasTag(tagID) {
+			t.tagIDs = append(t.tagIDs, tagID)
+		}
+	}
+
+	if t.externalID == "" {
+		t.externalID = duplicate.externalID
+	}
+
+	if t.status.IsPending() && duplicate.status.IsCleared() {
+		t.status = duplicate.status
+	}
+
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionDuplicateMerged",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// PauseRecurrence stops generating occurrences of the recurring series until it is resumed.
+// Occurrences falling due while the series is paused are not created.
+func (t *Transaction) PauseRecurrence() error {
+	if !t.IsRecurringSeries() {
+		return errors.New("invalid recurring series: transaction does not start a recurring series")
+	}
+	if t.IsRecurrencePaused() {
+		return errors.New("recurring series cannot be paused: it is already paused")
+	}
+
+	now := time.Now()
+	t.recurrencePausedAt = &now
+	t.updatedAt = now
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"RecurringSeriesPaused",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// ResumeRecurrence resumes generating occurrences of a paused recurring series.
+func (t *Transaction) ResumeRecurrence() error {
+	if !t.IsRecurringSeries() {
+		return errors.New("invalid recurring series: transaction does not start a recurring series")
+	}
+	if !t.IsRecurrencePaused() {
+		return errors.New("recurring series cannot be resumed: it is not paused")
+	}
+
+	// Occurrences that fell due while paused are not generated afterwards
+	now := time.Now()
+	t.recurrencePausedAt = nil
+	t.MarkOccurrencesGenerated(now.AddDate(0, 0, -1))
+	t.updatedAt = now
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"RecurringSeriesResumed",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// SkipOccurrence leaves the occurrence on the day of date out of the recurring series, so it is
+// never generated. The first occurrence is the transaction itself and cannot be skipped.
+func (t *Transaction) SkipOccurrence(date time.Time) error {
+	if !t.IsRecurringSeries() {
+		return errors.New("invalid recurring series: transaction does not start a recurring series")
+	}
+	day := calendarDay(date)
+	if !t.IsOccurrenceDate(date) {
+		return fmt.Errorf("invalid occurrence date: the series has no occurrence on %s", day.Format("2006-01-02"))
+	}
+	if day.Equal(calendarDay(t.date)) {
+		return errors.New("first occurrence cannot be skipped: it is the transaction that starts the series")
+	}
+	if t.IsOccurrenceSkipped(date) {
+		return fmt.Errorf("occurrence cannot be skipped: %s is already skipped", day.Format("2006-01-02"))
+	}
+
+	position := len(t.skippedOccurrences)
+	for i, skipped := range t.skippedOccurrences {
+		if calendarDay(skipped).After(day) {
+			position = i
+			break
+		}
+	}
+	t.skippedOccurrences = append(t.skippedOccurrences, time.Time{})
+	copy(t.skippedOccurrences[position+1:], t.skippedOccurrences[position:])
+	t.skippedOccurrences[position] = day
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"RecurringOccurrenceSkipped",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// ChangeRecurrenceAmount sets the amount of the occurrences of the recurring series on or after
+// the occurrence on the day of from ("this and future occurrences"), replacing later changes.
+// Occurrences already created are not updated here.
+func (t *Transaction) ChangeRecurrenceAmount(from time.Time, amount sharedvalueobjects.Money) error {
+	if !t.IsRecurringSeries() {
+		return errors.New("invalid recurring series: transaction does not start a recurring series")
+	}
+	if !t.IsOccurrenceDate(from) {
+		return fmt.Errorf("invalid occurrence date: the series has no occurrence on %s", calendarDay(from).Format("2006-01-02"))
+	}
+	if !amount.Currency().Equals(t.amount.Currency()) {
+		return fmt.Errorf("invalid recurrence amount: currency must be %s", t.amount.Currency().Code())
+	}
+
+	change, err := transactionvalueobjects.NewRecurrenceAmountChange(calendarDay(from), amount)
+	if err != nil {
+		return err
+	}
+
+	changes := make([]transactionvalueobjects.RecurrenceAmountChange, 0, len(t.recurrenceAmountChanges)+1)
+	for _, existing := range t.recurrenceAmountChanges {
+		if existing.EffectiveDate().Before(change.EffectiveDate()) {
+			changes = append(changes, existing)
+		}
+	}
+	t.recurrenceAmountChanges = append(changes, change)
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"RecurringSeriesAmountChanged",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateRecurrenceSchedule sets the schedule rules of the recurring series: the anchor choosing
+// the day of the month of monthly and yearly occurrences (nil keeps the day of the month), and
+// whether occurrences falling on weekends or Brazilian holidays move to the next business day.
+func (t *Transaction) UpdateRecurrenceSchedule(anchor *transactionvalueobjects.RecurrenceAnchor, shiftToBusinessDay bool) error {
+	if !t.IsRecurringSeries() {
+		return errors.New("invalid recurring series: transaction does not start a recurring series")
+	}
+	if anchor != nil && anchor.IsDayOfMonth() {
+		anchor = nil
+	}
+	if anchor != nil {
+		if !t.recurrenceFrequency.IsMonthly() && !t.recurrenceFrequency.IsYearly() {
+			return fmt.Errorf("invalid recurrence anchor: %s requires a MONTHLY or YEARLY series", anchor.Value())
+		}
+		if err := anchor.ValidateFirst(t.date); err != nil {
+			return err
+		}
+	}
+	if shiftToBusinessDay && t.recurrenceFrequency.IsDaily() {
+		return errors.New("invalid business day shift: daily series cannot be shifted to business days")
+	}
+
+	t.recurrenceAnchor = anchor
+	t.recurrenceBusinessDays = shiftToBusinessDay
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"RecurringSeriesScheduleChanged",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// EndRecurrence ends the recurring series on the day of endDate: no occurrence after it is
+// generated. Occurrences already created after it are kept.
+func (t *Transaction) EndRecurrence(endDate time.Time) error {
+	if !t.IsRecurringSeries() {
+		return errors.New("invalid recurring series: transaction does not start a recurring series")
+	}
+	day := calendarDay(endDate)
+	if day.Before(calendarDay(t.date)) {
+		return fmt.Errorf("invalid end date: must be on or after the first occurrence (%s)", t.date.Format("2006-01-02"))
+	}
+
+	t.recurrenceEndDate = &day
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"RecurringSeriesEnded",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateAmount updates the transaction amount.
+// Split lines are rescaled proportionally to the new amount (see rescaleSplits).
+func (t *Transaction) UpdateAmount(amount sharedvalueobjects.Money) error {
+	if amount.IsZero() {
+		return errors.New("transaction amount cannot be zero")
+	}
+
+	if amount.IsNegative() {
+		return errors.New("transaction amount cannot be negative")
+	}
+
+	// Ensure currencies match
+	if !t.amount.Currency().Equals(amount.Currency()) {
+		return errors.New("cannot update amount with different currency")
+	}
+
+	// A corrected amount of a foreign currency transaction keeps the original amount
+	// and changes the exchange rate
+	var conversion *transactionvalueobjects.CurrencyConversion
+	if t.currencyConversion != nil {
+		updated, err := t.currencyConversion.WithConvertedAmount(amount)
+		if err != nil {
+			return fmt.Errorf("invalid currency conversion: %w", err)
+		}
+		conversion = &updated
+	}
+
+	if t.HasSplits() {
+		splits, err := rescaleSplits(t.splits, amount)
+		if err != nil {
+			return err
+		}
+		t.splits = splits
+	}
+
+	t.amount = amount
+	t.currencyConversion = conversion
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionAmountUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateDescription updates the transaction description.
+func (t *Transaction) UpdateDescription(description transactionvalueobjects.TransactionDescription) error {
+	if description.IsEmpty() {
+		return errors.New("transaction description cannot be empty")
+	}
+
+	t.description = description
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionDescriptionUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateDate updates the transaction date.
+func (t *Transaction) UpdateDate(date time.Time) error {
+	if date.IsZero() {
+		return errors.New("transaction date cannot be zero")
+	}
+
+	t.date = date
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionDateUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateType updates the transaction type.
+func (t *Transaction) UpdateType(transactionType transactionvalueobjects.TransactionType) error {
+	if transactionType.Value() == "" {
+		return errors.New("transaction type cannot be empty")
+	}
+
+	if t.transactionType.IsTransfer() || transactionType.IsTransfer() {
+		if !t.transactionType.Equals(transactionType) {
+			return errors.New("cannot change the type of a transfer transaction")
+		}
+	}
+	if t.transactionType.IsAdjustment() || transactionType.IsAdjustment() {
+		if !t.transactionType.Equals(transactionType) {
+			return errors.New("cannot change the type of a balance adjustment")
+		}
+	}
+
+	t.transactionType = transactionType
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionTypeUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateCategory assigns the transaction to a category.
+// Passing nil removes the current category.
+func (t *Transaction) UpdateCategory(categoryID *categoryvalueobjects.CategoryID) error {
+	if categoryID != nil && categoryID.IsEmpty() {
+		return errors.New("category ID cannot be empty")
+	}
+
+	t.categoryID = categoryID
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionCategoryUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// MoveToAccount moves the transaction to another account of the same user.
+// Transfer legs and installments belong to the account they were created on, and reconciled
+// transactions are locked, so none of them can be moved.
+func (t *Transaction) MoveToAccount(accountID accountvalueobjects.AccountID) error {
+	if accountID.IsEmpty() {
+		return errors.New("account ID cannot be empty")
+	}
+	if t.IsTransfer() {
+		return errors.New("cannot move a transfer transaction to another account")
+	}
+	if t.IsAdjustment() {
+		return errors.New("cannot move a balance adjustment to another account")
+	}
+	if t.IsInstallment() {
+		return errors.New("cannot move an installment to another account")
+	}
+	if t.IsReconciled() {
+		return errors.New("cannot move a reconciled transaction to another account: set its status back to CLEARED first")
+	}
+
+	t.accountID = accountID
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionAccountChanged",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateSplits replaces the split lines of the transaction.
+// Split lines must use the transaction currency and add up exactly to the transaction amount.
+// Passing an empty slice removes the split.
+func (t *Transaction) UpdateSplits(splits []transactionvalueobjects.TransactionSplit) error {
+	if len(splits) > 0 {
+		if t.IsTransfer() {
+			return errors.New("transfer transactions cannot be split")
+		}
+		if t.IsAdjustment() {
+			return errors.New("balance adjustments cannot be split")
+		}
+		if len(splits) < 2 {
+			return errors.New("split transactions must have at least two split lines")
+		}
+
+		total := sharedvalueobjects.Zero(t.amount.Currency())
+		for _, split := range splits {
+			if !split.Amount().Currency().Equals(t.amount.Currency()) {
+				return errors.New("split line currency must match the transaction currency")
+			}
+			total, _ = total.Add(split.Amount())
+		}
+		if !total.Equals(t.amount) {
+			return fmt.Errorf("split lines must add up to the transaction amount: got %s, expected %s", total.String(), t.amount.String())
+		}
+	}
+
+	t.splits = make([]transactionvalueobjects.TransactionSplit, len(splits))
+	copy(t.splits, splits)
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionSplitsUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdateTags replaces the tags attached to the transaction.
+// Duplicate tag IDs are ignored. Passing an empty slice removes all tags.
+func (t *Transaction) UpdateTags(tagIDs []tagvalueobjects.TagID) error {
+	unique := make([]tagvalueobjects.TagID, 0, len(tagIDs))
+	seen := make(map[string]bool, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if tagID.IsEmpty() {
+			return errors.New("tag ID cannot be empty")
+		}
+		if seen[tagID.Value()] {
+			continue
+		}
+		seen[tagID.Value()] = true
+		unique = append(unique, tagID)
+	}
+
+	t.tagIDs = unique
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionTagsUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// UpdatePayee assigns the transaction to a payee.
+// Passing nil removes the current payee.
+func (t *Transaction) UpdatePayee(payeeID *payeevalueobjects.PayeeID) error {
+	if payeeID != nil && payeeID.IsEmpty() {
+		return errors.New("payee ID cannot be empty")
+	}
+
+	t.payeeID = payeeID
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionPayeeUpdated",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// ConvertCurrency records that a new transaction was made in a foreign currency and moves its
+// amount into the account currency. The transaction amount must be the original amount of the
+// conversion; it becomes the converted amount and split lines are rescaled to it.
+func (t *Transaction) ConvertCurrency(conversion transactionvalueobjects.CurrencyConversion) error {
+	if t.IsTransfer() {
+		return errors.New("transfer transactions cannot be converted: set the destination amount of the transfer instead")
+	}
+	if t.IsAdjustment() {
+		return errors.New("balance adjustments cannot be converted")
+	}
+	if t.currencyConversion != nil {
+		return errors.New("transaction was already converted to the account currency")
+	}
+	if !conversion.OriginalAmount().Equals(t.amount) {
+		return fmt.Errorf("currency conversion must start from the transaction amount: got %s, expected %s", conversion.OriginalAmount().String(), t.amount.String())
+	}
+
+	amount := conversion.ConvertedAmount()
+	if t.HasSplits() {
+		splits, err := rescaleSplits(t.splits, amount)
+		if err != nil {
+			return err
+		}
+		t.splits = splits
+	}
+
+	t.amount = amount
+	t.currencyConversion = &conversion
+	t.updatedAt = time.Now()
+
+	t.addEvent(events.NewBaseDomainEvent(
+		"TransactionCurrencyConverted",
+		t.id.Value(),
+		"Transaction",
+	))
+
+	return nil
+}
+
+// MarkAsFeeFor links the transaction as a fee charged for another transaction, e.g. the IOF
+// (tax on foreign purchases) charged for a purchase made abroad.
+func (t *Transaction) MarkAsFeeFor(transactionID transactionvalueobjects.TransactionID) error {
+	if transactionID.IsEmpty() {
+		return errors.New("fee transaction ID cannot be empty")
+	}
+	if transactionID.Equals(t.id) {
+		return errors.New("transaction cannot be a fee for itself")
+	}
+	if !t.transactionType.IsExpense() {
+		return errors.New("fee transactions must be expenses")
+	}
+
+	t.feeForTransactionID = &transactionID
+	t.updatedAt = time.Now()
+
+	return nil
+}
+
+// rescaleSplits distributes a new amount across existing split lines in proportion to their
+// current amounts. Leftover cents are assigned deterministically by Money.Allocate, so the
+// split lines always add up exactly to the new amount.
+func rescaleSplits(
+	splits []transactionvalueobjects.TransactionSplit,
+	amount sharedvalueobjects.Money,
+) ([]transactionvalueobjects.TransactionSplit, error) {
+	ratios := make([]int64, len(splits))
+	for i, split := range splits {
+		ratios[i] = split.Amount().Amount()
+	}
+
+	parts, err := amount.Allocate(ratios)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rescale split lines: %w", err)
+	}
+
+	rescaled := make([]transactionvalueobjects.TransactionSplit, len(splits))
+	for i, split := range splits {
+		rescaled[i], err = split.WithAmount(parts[i])
+		if err != nil {
+			return nil, errors.New("transaction amount is too small to keep its split lines; update the split lines as well")
+		}
+	}
+
+	return rescaled, nil
+}
+
+// GetEvents returns all domain events that occurred on this aggregate.
+func (t *Transaction) GetEvents() []events.DomainEvent {
+	return t.events
+}
+
+// ClearEvents clears all domain events from this aggregate.
+func (t *Transaction) ClearEvents() {
+	t.events = []events.DomainEvent{}
+}
+
+// addEvent adds a domain event to the aggregate.
+func (t *Transaction) addEvent(event events.DomainEvent) {
+	t.events = append(t.events, event)
+}
+
+// calendarDay returns the calendar day of date (in its own location) as midnight UTC, so days
+// can be compared regardless of time of day and location.
+func calendarDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,388 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// Transaction revision actions
+const (
+	RevisionActionCreate  = "CREATE"
+	RevisionActionUpdate  = "UPDATE"
+	RevisionActionDelete  = "DELETE"
+	RevisionActionRestore = "RESTORE"
+	RevisionActionRevert  = "REVERT" // Update that brought the transaction back to an earlier revision
+)
+
+// TransactionSnapshot is the state of a transaction at one point of its history.
+// Split lines are not part of the snapshot.
+type TransactionSnapshot struct {
+	AccountID   string
+	Type        string
+	Amount      int64 // Amount in cents
+	Currency    string
+	Description string
+	Date        time.Time
+	CategoryID  string
+	TagIDs      []string
+	PayeeID     string
+	Status      string
+}
+
+// SnapshotOf captures the current state of a transaction.
+func SnapshotOf(transaction *Transaction) *TransactionSnapshot {
+	snapshot := &TransactionSnapshot{
+		AccountID:   transaction.AccountID().Value(),
+		Type:        transaction.TransactionType().Value(),
+		Amount:      transaction.Amount().Amount(),
+		Currency:    transaction.Amount().Currency().Code(),
+		Description: transaction.Description().Value(),
+		Date:        transaction.Date(),
+		Status:      transaction.Status().Value(),
+		TagIDs:      make([]string, 0, len(transaction.TagIDs())),
+	}
+	if transaction.CategoryID() != nil {
+		snapshot.CategoryID = transaction.CategoryID().Value()
+	}
+	for _, tagID := range transaction.TagIDs() {
+		snapshot.TagIDs = append(snapshot.TagIDs, tagID.Value())
+	}
+	if transaction.PayeeID() != nil {
+		snapshot.PayeeID = transaction.PayeeID().Value()
+	}
+	return snapshot
+}
+
+// ChangedFields lists the fields that differ between two snapshots, in a fixed order.
+func (s TransactionSnapshot) ChangedFields(other TransactionSnapshot) []string {
+	changed := []string{}
+	if s.AccountID != other.AccountID {
+		changed = append(changed, "account_id")
+	}
+	if s.Type != other.Type {
+		changed = append(changed, "type")
+	}
+	if s.Amount != other.Amount || s.Currency != other.Currency {
+		changed = append(changed, "amount")
+	}
+	if s.Description != other.Description {
+		changed = append(changed, "description")
+	}
+	if !s.Date.Equal(other.Date) {
+		changed = append(changed, "date")
+	}
+	if s.CategoryID != other.CategoryID {
+		changed = append(changed, "category_id")
+	}
+	if !sameTagIDs(s.TagIDs, other.TagIDs) {
+		changed = append(changed, "tag_ids")
+	}
+	if s.PayeeID != other.PayeeID {
+		changed = append(changed, "payee_id")
+	}
+	if s.Status != other.Status {
+		changed = append(changed, "status")
+	}
+	return changed
+}
+
+// sameTagIDs checks if two lists hold the same tag IDs, in any order.
+func sameTagIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]bool, len(a))
+	for _, tagID := range a {
+		seen[tagID] = true
+	}
+	for _, tagID := range b {
+		if !seen[tagID] {
+			return false
+		}
+	}
+	return true
+}
+
+// TransactionRevision is an immutable record of a change to a transaction: the state before
+// and after the change, who made it and in which request. Creations have no state before and
+// deletions have no state after.
+type TransactionRevision struct {
+	id                 transactionvalueobjects.TransactionRevisionID
+	transactionID      transactionvalueobjects.TransactionID
+	userID             identityvalueobjects.UserID  // Owner of the transaction
+	actorID            *identityvalueobjects.UserID // Nil for changes made by the system (e.g. recurring generation)
+	requestID          string
+	action             string
+	before             *TransactionSnapshot
+	after              *TransactionSnapshot
+	revertedRevisionID *transactionvalueobjects.TransactionRevisionID
+	createdAt          time.Time
+}
+
+// NewTransactionRevision records a change to a transaction.
+func NewTransactionRevision(
+	transactionID transactionvalueobjects.TransactionID,
+	userID identityvalueobjects.UserID,
+	action string,
+	before *TransactionSnapshot,
+	after *TransactionSnapshot,
+	actorID *identityvalueobjects.UserID,
+	requestID string,
+) (*TransactionRevision, error) {
+	if transactionID.IsEmpty() {
+		return nil, errors.New("transaction ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	switch action {
+	case RevisionActionCreate, RevisionActionRestore:
+		if before != nil || after == nil {
+			return nil, fmt.Errorf("invalid revision: %s records the state after the change only", action)
+		}
+	case RevisionActionDelete:
+		if before == nil || after != nil {
+			return nil, errors.New("invalid revision: DELETE records the state before the change only")
+		}
+	case RevisionActionUpdate, RevisionActionRevert:
+		if before == nil || after == nil {
+			return nil, fmt.Errorf("invalid revision: %s records the state before and after the change", action)
+		}
+	default:
+		return nil, fmt.Errorf("invalid revision action: %s", action)
+	}
+
+	return &TransactionRevision{
+		id:            transactionvalueobjects.GenerateTransactionRevisionID(),
+		transactionID: transactionID,
+		userID:        userID,
+		actorID:       actorID,
+		requestID:     requestID,
+		action:        action,
+		before:        before,
+		after:         after,
+		createdAt:     time.Now(),
+	}, nil
+}
+
+// NewTransactionRevertRevision records the change that brought a transaction back to the state
+// after an earlier revision.
+func NewTransactionRevertRevision(
+	transactionID transactionvalueobjects.TransactionID,
+	userID identityvalueobjects.UserID,
+	before *TransactionSnapshot,
+	after *TransactionSnapshot,
+	actorID *identityvalueobjects.UserID,
+	requestID string,
+	revertedRevisionID transactionvalueobjects.TransactionRevisionID,
+) (*TransactionRevision, error) {
+	revision, err := NewTransactionRevision(transactionID, userID, RevisionActionRevert, before, after, actorID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	revision.revertedRevisionID = &revertedRevisionID
+	return revision, nil
+}
+
+// TransactionRevisionFromPersistence recreates a TransactionRevision from persisted data.
+func TransactionRevisionFromPersistence(
+	id transactionvalueobjects.TransactionRevisionID,
+	transactionID transactionvalueobjects.TransactionID,
+	userID identityvalueobjects.UserID,
+	actorID *identityvalueobjects.UserID,
+	requestID string,
+	action string,
+	before *TransactionSnapshot,
+	after *TransactionSnapshot,
+	revertedRevisionID *transactionvalueobjects.TransactionRevisionID,
+	createdAt time.Time,
+) *TransactionRevision {
+	return &TransactionRevision{
+		id:                 id,
+		transactionID:      transactionID,
+		userID:             userID,
+		actorID:            actorID,
+		requestID:          requestID,
+		action:             action,
+		before:             before,
+		after:              after,
+		revertedRevisionID: revertedRevisionID,
+		createdAt:          createdAt,
+	}
+}
+
+// ID returns the revision ID.
+func (r *TransactionRevision) ID() transactionvalueobjects.TransactionRevisionID {
+	return r.id
+}
+
+// TransactionID returns the ID of the changed transaction.
+func (r *TransactionRevision) TransactionID() transactionvalueobjects.TransactionID {
+	return r.transactionID
+}
+
+// UserID returns the ID of the user who owns the transaction.
+func (r *TransactionRevision) UserID() identityvalueobjects.UserID {
+	return r.userID
+}
+
+// ActorID returns the ID of the user who made the change (nil for changes made by the system).
+func (r *TransactionRevision) ActorID() *identityvalueobjects.UserID {
+	return r.actorID
+}
+
+// RequestID returns the ID of the HTTP request that made the change (empty if unknown).
+func (r *TransactionRevision) RequestID() string {
+	return r.requestID
+}
+
+// Action returns what was done to the transaction (CREATE, UPDATE, DELETE, RESTORE or REVERT).
+func (r *TransactionRevision) Action() string {
+	return r.action
+}
+
+// Before returns the state of the transaction before the change (nil for creations and restorations).
+func (r *TransactionRevision) Before() *TransactionSnapshot {
+	return r.before
+}
+
+// After returns the state of the transaction after the change (nil for deletions).
+func (r *TransactionRevision) After() *TransactionSnapshot {
+	return r.after
+}
+
+// RevertedRevisionID returns the revision a REVERT brought the transaction back to.
+func (r *TransactionRevision) RevertedRevisionID() *transactionvalueobjects.TransactionRevisionID {
+	return r.revertedRevisionID
+}
+
+// CreatedAt returns when the change was made.
+func (r *TransactionRevision) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// ChangedFields lists the fields changed by an update or revert (nil for other actions).
+func (r *TransactionRevision) ChangedFields() []string {
+	if r.before == nil || r.after == nil {
+		return nil
+	}
+	return r.before.ChangedFields(*r.after)
+}
+
+// RevertTo brings the transaction back to the state of a snapshot: account, type, amount,
+// description, date, category and tags. The status is not reverted, since reconciliation
+// owns it; reconciled transactions only accept a revert that keeps what affects the balance.
+// Transfer legs must stay consistent with each other, so they cannot be reverted.
+func (t *Transaction) RevertTo(snapshot TransactionSnapshot) error {
+	if t.IsTransfer() {
+		return errors.New("cannot revert a transfer transaction: edit the transfer instead")
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(snapshot.AccountID)
+	if err != nil {
+		return fmt.Errorf("invalid revision: %w", err)
+	}
+	transactionType, err := transactionvalueobjects.NewTransactionType(snapshot.Type)
+	if err != nil {
+		return fmt.Errorf("invalid revision: %w", err)
+	}
+	amount, err := sharedvalueobjects.NewMoneyFromString(snapshot.Amount, snapshot.Currency)
+	if err != nil {
+		return fmt.Errorf("invalid revision: %w", err)
+	}
+	description, err := transactionvalueobjects.NewTransactionDescription(snapshot.Description)
+	if err != nil {
+		return fmt.Errorf("invalid revision: %w", err)
+	}
+	var categoryID *categoryvalueobjects.CategoryID
+	if snapshot.CategoryID != "" {
+		id, err := categoryvalueobjects.NewCategoryID(snapshot.CategoryID)
+		if err != nil {
+			return fmt.Errorf("invalid revision: %w", err)
+		}
+		categoryID = &id
+	}
+	tagIDs := make([]tagvalueobjects.TagID, 0, len(snapshot.TagIDs))
+	for _, rawTagID := range snapshot.TagIDs {
+		tagID, err := tagvalueobjects.NewTagID(rawTagID)
+		if err != nil {
+			return fmt.Errorf("invalid revision: %w", err)
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+	var payeeID *payeevalueobjects.PayeeID
+	if snapshot.PayeeID != "" {
+		id, err := payeevalueobjects.NewPayeeID(snapshot.PayeeID)
+		if err != nil {
+			return fmt.Errorf("invalid revision: %w", err)
+		}
+		payeeID = &id
+	}
+
+	current := SnapshotOf(t)
+	balanceChanged := current.AccountID != snapshot.AccountID || current.Type != snapshot.Type ||
+		current.Amount != snapshot.Amount || current.Currency != snapshot.Currency || !current.Date.Equal(snapshot.Date)
+	if balanceChanged && t.IsReconciled() {
+		return errors.New("reconciled transaction cannot be reverted: set its status back to CLEARED first")
+	}
+
+	if current.AccountID != snapshot.AccountID {
+		if err := t.MoveToAccount(accountID); err != nil {
+			return err
+		}
+	}
+	if current.Type != snapshot.Type {
+		if err := t.UpdateType(transactionType); err != nil {
+			return err
+		}
+	}
+	// A transaction with a category has no split lines, so drop them before any amount change
+	if categoryID != nil && t.HasSplits() {
+		if err := t.UpdateSplits(nil); err != nil {
+			return err
+		}
+	}
+	if current.Amount != snapshot.Amount || current.Currency != snapshot.Currency {
+		if err := t.UpdateAmount(amount); err != nil {
+			return err
+		}
+	}
+	if current.Description != snapshot.Description {
+		if err := t.UpdateDescription(description); err != nil {
+			return err
+		}
+	}
+	if !current.Date.Equal(snapshot.Date) {
+		if err := t.UpdateDate(snapshot.Date); err != nil {
+			return err
+		}
+	}
+	if current.CategoryID != snapshot.CategoryID {
+		if err := t.UpdateCategory(categoryID); err != nil {
+			return err
+		}
+	}
+	if !sameTagIDs(current.TagIDs, snapshot.TagIDs) {
+		if err := t.UpdateTags(tagIDs); err != nil {
+			return err
+		}
+	}
+	if current.PayeeID != snapshot.PayeeID {
+		if err := t.UpdatePayee(payeeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
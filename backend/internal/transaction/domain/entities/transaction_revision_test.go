@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestNewTransactionRevision(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	amount, _ := sharedvalueobjects.NewMoney(4500, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Padaria")
+	transaction, _ := NewTransaction(userID, accountvalueobjects.GenerateAccountID(), transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	snapshot := SnapshotOf(transaction)
+
+	tests := []struct {
+		name      string
+		action    string
+		before    *TransactionSnapshot
+		after     *TransactionSnapshot
+		wantError bool
+	}{
+		{"create", RevisionActionCreate, nil, snapshot, false},
+		{"update", RevisionActionUpdate, snapshot, snapshot, false},
+		{"delete", RevisionActionDelete, snapshot, nil, false},
+		{"restore", RevisionActionRestore, nil, snapshot, false},
+		{"create with state before", RevisionActionCreate, snapshot, snapshot, true},
+		{"update without state after", RevisionActionUpdate, snapshot, nil, true},
+		{"delete with state after", RevisionActionDelete, snapshot, snapshot, true},
+		{"unknown action", "ARCHIVE", nil, snapshot, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision, err := NewTransactionRevision(transaction.ID(), userID, tt.action, tt.before, tt.after, &userID, "req-123")
+			if (err != nil) != tt.wantError {
+				t.Fatalf("NewTransactionRevision() error = %v, wantError %v", err, tt.wantError)
+			}
+			if !tt.wantError && (revision.ID().IsEmpty() || revision.RequestID() != "req-123" || revision.CreatedAt().IsZero()) {
+				t.Errorf("NewTransactionRevision() = %+v", revision)
+			}
+		})
+	}
+}
+
+func TestTransaction_RevertTo(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(4500, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Padaria")
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	categoryID := categoryvalueobjects.GenerateCategoryID()
+	tagID := tagvalueobjects.GenerateTagID()
+	payeeID := payeevalueobjects.GeneratePayeeID()
+
+	transaction, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, date)
+	original := SnapshotOf(transaction)
+
+	// Edit every field the revert brings back
+	newAmount, _ := sharedvalueobjects.NewMoney(9900, sharedvalueobjects.MustCurrency("BRL"))
+	newDescription, _ := transactionvalueobjects.NewTransactionDescription("Padaria e mercado")
+	_ = transaction.UpdateAmount(newAmount)
+	_ = transaction.UpdateDescription(newDescription)
+	_ = transaction.UpdateDate(date.AddDate(0, 0, 1))
+	_ = transaction.UpdateCategory(&categoryID)
+	_ = transaction.UpdateTags([]tagvalueobjects.TagID{tagID})
+	_ = transaction.UpdatePayee(&payeeID)
+	_ = transaction.MoveToAccount(accountvalueobjects.GenerateAccountID())
+
+	edited := SnapshotOf(transaction)
+	if changed := original.ChangedFields(*edited); len(changed) != 7 {
+		t.Fatalf("ChangedFields() = %v, want 7 fields", changed)
+	}
+
+	if err := transaction.RevertTo(*original); err != nil {
+		t.Fatalf("RevertTo() error = %v", err)
+	}
+	if changed := original.ChangedFields(*SnapshotOf(transaction)); len(changed) != 0 {
+		t.Errorf("RevertTo() left fields changed: %v", changed)
+	}
+
+	// Reconciled transactions only accept reverts that keep the balance fields
+	_ = transaction.UpdateStatus(transactionvalueobjects.ClearedStatus())
+	_ = transaction.Reconcile(transactionvalueobjects.GenerateReconciliationID())
+	if err := transaction.RevertTo(*edited); err == nil {
+		t.Error("RevertTo() expected error for a reconciled transaction")
+	}
+	labelOnly := *original
+	labelOnly.Description = "Padaria do bairro"
+	if err := transaction.RevertTo(labelOnly); err != nil {
+		t.Errorf("RevertTo() of the description of a reconciled transaction error = %v", err)
+	}
+
+	// Transfer legs are reverted through the transfer
+	outgoing, _, _ := NewTransfer(userID, accountID, accountvalueobjects.GenerateAccountID(), amount, amount, description, date)
+	if err := outgoing.RevertTo(*SnapshotOf(outgoing)); err == nil {
+		t.Error("RevertTo() expected error for a transfer")
+	}
+}
@@ -0,0 +1,231 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/events"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// MaxRuleNameLength is the maximum number of characters of a rule name.
+const MaxRuleNameLength = 100
+
+// TransactionRule represents an auto-categorization rule aggregate root in the Transaction context.
+// A rule matches transactions by description, amount range, account and type, and sets their
+// category, adds tags or replaces the bank description with a cleaned-up one. Rules of a user run
+// in priority order (lowest first) when transactions are created or imported, or on demand over
+// existing transactions.
+type TransactionRule struct {
+	id         transactionvalueobjects.RuleID
+	userID     identityvalueobjects.UserID
+	name       string
+	priority   int
+	enabled    bool
+	conditions transactionvalueobjects.RuleConditions
+	actions    transactionvalueobjects.RuleActions
+	createdAt  time.Time
+	updatedAt  time.Time
+
+	// Domain events
+	events []events.DomainEvent
+}
+
+// NewTransactionRule creates a new, enabled TransactionRule aggregate.
+func NewTransactionRule(
+	userID identityvalueobjects.UserID,
+	name string,
+	priority int,
+	conditions transactionvalueobjects.RuleConditions,
+	actions transactionvalueobjects.RuleActions,
+) (*TransactionRule, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	name, err := validateRuleDefinition(name, priority, conditions, actions)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	rule := &TransactionRule{
+		id:         transactionvalueobjects.GenerateRuleID(),
+		userID:     userID,
+		name:       name,
+		priority:   priority,
+		enabled:    true,
+		conditions: conditions,
+		actions:    actions,
+		createdAt:  now,
+		updatedAt:  now,
+		events:     []events.DomainEvent{},
+	}
+
+	// Add domain event
+	rule.addEvent(events.NewBaseDomainEvent(
+		"TransactionRuleCreated",
+		rule.id.Value(),
+		"TransactionRule",
+	))
+
+	return rule, nil
+}
+
+// TransactionRuleFromPersistence reconstructs a TransactionRule aggregate from persisted data.
+// This method does not trigger domain events, as it's used for loading existing data.
+func TransactionRuleFromPersistence(
+	id transactionvalueobjects.RuleID,
+	userID identityvalueobjects.UserID,
+	name string,
+	priority int,
+	enabled bool,
+	conditions transactionvalueobjects.RuleConditions,
+	actions transactionvalueobjects.RuleActions,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*TransactionRule, error) {
+	if id.IsEmpty() {
+		return nil, errors.New("rule ID cannot be empty")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID cannot be empty")
+	}
+
+	return &TransactionRule{
+		id:         id,
+		userID:     userID,
+		name:       name,
+		priority:   priority,
+		enabled:    enabled,
+		conditions: conditions,
+		actions:    actions,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+		events:     []events.DomainEvent{},
+	}, nil
+}
+
+// ID returns the rule ID.
+func (r *TransactionRule) ID() transactionvalueobjects.RuleID {
+	return r.id
+}
+
+// UserID returns the user ID.
+func (r *TransactionRule) UserID() identityvalueobjects.UserID {
+	return r.userID
+}
+
+// Name returns the rule name.
+func (r *TransactionRule) Name() string {
+	return r.name
+}
+
+// Priority returns the rule priority. Rules with a lower priority run first.
+func (r *TransactionRule) Priority() int {
+	return r.priority
+}
+
+// IsEnabled checks if the rule runs automatically.
+func (r *TransactionRule) IsEnabled() bool {
+	return r.enabled
+}
+
+// Conditions returns the rule conditions.
+func (r *TransactionRule) Conditions() transactionvalueobjects.RuleConditions {
+	return r.conditions
+}
+
+// Actions returns the rule actions.
+func (r *TransactionRule) Actions() transactionvalueobjects.RuleActions {
+	return r.actions
+}
+
+// CreatedAt returns the creation timestamp.
+func (r *TransactionRule) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the last update timestamp.
+func (r *TransactionRule) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Update replaces the rule definition.
+func (r *TransactionRule) Update(
+	name string,
+	priority int,
+	enabled bool,
+	conditions transactionvalueobjects.RuleConditions,
+	actions transactionvalueobjects.RuleActions,
+) error {
+	name, err := validateRuleDefinition(name, priority, conditions, actions)
+	if err != nil {
+		return err
+	}
+
+	r.name = name
+	r.priority = priority
+	r.enabled = enabled
+	r.conditions = conditions
+	r.actions = actions
+	r.updatedAt = time.Now()
+
+	r.addEvent(events.NewBaseDomainEvent(
+		"TransactionRuleUpdated",
+		r.id.Value(),
+		"TransactionRule",
+	))
+
+	return nil
+}
+
+// GetEvents returns all domain events that occurred on this aggregate.
+func (r *TransactionRule) GetEvents() []events.DomainEvent {
+	return r.events
+}
+
+// ClearEvents clears all domain events from this aggregate.
+func (r *TransactionRule) ClearEvents() {
+	r.events = []events.DomainEvent{}
+}
+
+// addEvent adds a domain event to the aggregate.
+func (r *TransactionRule) addEvent(event events.DomainEvent) {
+	r.events = append(r.events, event)
+}
+
+// validateRuleDefinition validates the definition of a rule and returns the trimmed name.
+func validateRuleDefinition(
+	name string,
+	priority int,
+	conditions transactionvalueobjects.RuleConditions,
+	actions transactionvalueobjects.RuleActions,
+) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("rule name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > MaxRuleNameLength {
+		return "", fmt.Errorf("rule name must be at most %d characters", MaxRuleNameLength)
+	}
+
+	if priority < 0 {
+		return "", errors.New("rule priority must be zero or greater")
+	}
+
+	if conditions.IsEmpty() {
+		return "", errors.New("rule must have at least one condition")
+	}
+	if actions.IsEmpty() {
+		return "", errors.New("rule must have at least one action")
+	}
+
+	return name, nil
+}
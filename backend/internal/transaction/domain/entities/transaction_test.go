@@ -5,8 +5,10 @@ import (
 	"time"
 
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 )
 
@@ -153,6 +155,165 @@ func TestTransaction_UpdateDate(t *testing.T) {
 	}
 }
 
+func TestTransaction_UpdateCategory(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	transactionType := transactionvalueobjects.ExpenseType()
+	amount, _ := sharedvalueobjects.NewMoney(10000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Compra de supermercado")
+	categoryID := categoryvalueobjects.GenerateCategoryID()
+
+	transaction, err := NewTransactionWithParams(TransactionParams{
+		UserID:      userID,
+		AccountID:   accountID,
+		Type:        transactionType,
+		Amount:      amount,
+		Description: description,
+		Date:        time.Now(),
+		CategoryID:  &categoryID,
+	})
+	if err != nil {
+		t.Fatalf("NewTransactionWithParams() error = %v, want nil", err)
+	}
+
+	if !transaction.HasCategory() || !transaction.CategoryID().Equals(categoryID) {
+		t.Errorf("Transaction.CategoryID() = %v, want %v", transaction.CategoryID(), categoryID)
+	}
+
+	// Change category
+	newCategoryID := categoryvalueobjects.GenerateCategoryID()
+	if err := transaction.UpdateCategory(&newCategoryID); err != nil {
+		t.Errorf("Transaction.UpdateCategory() error = %v, want nil", err)
+	}
+
+	if !transaction.CategoryID().Equals(newCategoryID) {
+		t.Errorf("Transaction.CategoryID() = %v, want %v", transaction.CategoryID(), newCategoryID)
+	}
+
+	// Remove category
+	if err := transaction.UpdateCategory(nil); err != nil {
+		t.Errorf("Transaction.UpdateCategory(nil) error = %v, want nil", err)
+	}
+
+	if transaction.HasCategory() {
+		t.Error("Transaction.HasCategory() should be false after removing the category")
+	}
+
+	// Try to update with empty category ID
+	if err := transaction.UpdateCategory(&categoryvalueobjects.CategoryID{}); err == nil {
+		t.Error("Transaction.UpdateCategory() should fail with empty category ID")
+	}
+}
+
+func TestTransaction_UpdateSplits(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	amount, _ := sharedvalueobjects.NewMoney(10000, brl) // 100.00 BRL
+	description, _ := transactionvalueobjects.NewTransactionDescription("Compra no mercado")
+	foodID := categoryvalueobjects.GenerateCategoryID()
+	homeID := categoryvalueobjects.GenerateCategoryID()
+
+	newSplit := func(categoryID categoryvalueobjects.CategoryID, cents int64, currency sharedvalueobjects.Currency) transactionvalueobjects.TransactionSplit {
+		money, _ := sharedvalueobjects.NewMoney(cents, currency)
+		split, err := transactionvalueobjects.NewTransactionSplit(categoryID, money, "")
+		if err != nil {
+			t.Fatalf("NewTransactionSplit() error = %v", err)
+		}
+		return split
+	}
+
+	transaction, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+
+	// Lines that do not add up to the amount
+	if err := transaction.UpdateSplits([]transactionvalueobjects.TransactionSplit{newSplit(foodID, 6000, brl), newSplit(homeID, 3000, brl)}); err == nil {
+		t.Error("Transaction.UpdateSplits() should fail when lines do not add up to the amount")
+	}
+
+	// A single line is not a split
+	if err := transaction.UpdateSplits([]transactionvalueobjects.TransactionSplit{newSplit(foodID, 10000, brl)}); err == nil {
+		t.Error("Transaction.UpdateSplits() should fail with a single line")
+	}
+
+	// Lines in another currency
+	if err := transaction.UpdateSplits([]transactionvalueobjects.TransactionSplit{newSplit(foodID, 6000, brl), newSplit(homeID, 4000, sharedvalueobjects.MustCurrency("USD"))}); err == nil {
+		t.Error("Transaction.UpdateSplits() should fail with lines in another currency")
+	}
+
+	if err := transaction.UpdateSplits([]transactionvalueobjects.TransactionSplit{newSplit(foodID, 6000, brl), newSplit(homeID, 4000, brl)}); err != nil {
+		t.Fatalf("Transaction.UpdateSplits() error = %v, want nil", err)
+	}
+	if !transaction.HasSplits() || len(transaction.Splits()) != 2 {
+		t.Fatalf("Transaction.Splits() length = %d, want 2", len(transaction.Splits()))
+	}
+	if got := transaction.AmountForCategory(foodID).Amount(); got != 6000 {
+		t.Errorf("Transaction.AmountForCategory(food) = %d, want 6000", got)
+	}
+	if got := transaction.AmountForCategory(categoryvalueobjects.GenerateCategoryID()).Amount(); got != 0 {
+		t.Errorf("Transaction.AmountForCategory(other) = %d, want 0", got)
+	}
+
+	// Changing the amount rescales the lines; leftover cents go to the first line
+	newAmount, _ := sharedvalueobjects.NewMoney(3333, brl)
+	if err := transaction.UpdateAmount(newAmount); err != nil {
+		t.Fatalf("Transaction.UpdateAmount() error = %v, want nil", err)
+	}
+	splits := transaction.Splits()
+	if splits[0].Amount().Amount() != 2000 || splits[1].Amount().Amount() != 1333 {
+		t.Errorf("Transaction.Splits() amounts = %d/%d, want 2000/1333", splits[0].Amount().Amount(), splits[1].Amount().Amount())
+	}
+
+	// Too small to keep both lines
+	oneCent, _ := sharedvalueobjects.NewMoney(1, brl)
+	if err := transaction.UpdateAmount(oneCent); err == nil {
+		t.Error("Transaction.UpdateAmount() should fail when a split line would become zero")
+	}
+
+	// Remove the split
+	if err := transaction.UpdateSplits(nil); err != nil {
+		t.Errorf("Transaction.UpdateSplits(nil) error = %v, want nil", err)
+	}
+	if transaction.HasSplits() {
+		t.Error("Transaction.HasSplits() should be false after removing the split")
+	}
+}
+
+func TestTransaction_UpdateTags(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(10000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Hotel em Lisboa")
+	tripID := tagvalueobjects.GenerateTagID()
+	reimbursableID := tagvalueobjects.GenerateTagID()
+
+	transaction, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	transaction.ClearEvents()
+
+	if err := transaction.UpdateTags([]tagvalueobjects.TagID{tripID, reimbursableID, tripID}); err != nil {
+		t.Fatalf("Transaction.UpdateTags() error = %v, want nil", err)
+	}
+	if len(transaction.TagIDs()) != 2 {
+		t.Errorf("Transaction.TagIDs() length = %d, want 2 (duplicates ignored)", len(transaction.TagIDs()))
+	}
+	if !transaction.HasTag(tripID) || !transaction.HasTag(reimbursableID) {
+		t.Error("Transaction.HasTag() should be true for both tags")
+	}
+	if events := transaction.GetEvents(); len(events) != 1 || events[0].EventType() != "TransactionTagsUpdated" {
+		t.Errorf("Transaction.GetEvents() = %v, want a single TransactionTagsUpdated", events)
+	}
+
+	if err := transaction.UpdateTags([]tagvalueobjects.TagID{{}}); err == nil {
+		t.Error("Transaction.UpdateTags() should fail with an empty tag ID")
+	}
+
+	if err := transaction.UpdateTags(nil); err != nil {
+		t.Errorf("Transaction.UpdateTags(nil) error = %v, want nil", err)
+	}
+	if transaction.HasTag(tripID) || len(transaction.TagIDs()) != 0 {
+		t.Error("Transaction.TagIDs() should be empty after removing all tags")
+	}
+}
+
 func TestTransaction_UpdateType(t *testing.T) {
 	userID := identityvalueobjects.GenerateUserID()
 	accountID := accountvalueobjects.GenerateAccountID()
@@ -186,7 +347,17 @@ func TestTransactionFromPersistence(t *testing.T) {
 	createdAt := time.Now()
 	updatedAt := time.Now()
 
-	transaction, err := TransactionFromPersistence(transactionID, userID, accountID, transactionType, amount, description, date, createdAt, updatedAt)
+	transaction, err := TransactionFromPersistence(TransactionPersistenceSnapshot{
+		ID:          transactionID,
+		UserID:      userID,
+		AccountID:   accountID,
+		Type:        transactionType,
+		Amount:      amount,
+		Description: description,
+		Date:        date,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	})
 	if err != nil {
 		t.Errorf("TransactionFromPersistence() error = %v, want nil", err)
 	}
@@ -407,7 +578,7 @@ func TestNewTransactionWithRecurrence_InvalidInput(t *testing.T) {
 	}
 }
 
-func TestTransactionFromPersistenceWithRecurrence(t *testing.T) {
+func TestTransactionFromPersistence_Recurrence(t *testing.T) {
 	transactionID := transactionvalueobjects.GenerateTransactionID()
 	userID := identityvalueobjects.GenerateUserID()
 	accountID := accountvalueobjects.GenerateAccountID()
@@ -420,23 +591,22 @@ func TestTransactionFromPersistenceWithRecurrence(t *testing.T) {
 	recurrenceFrequency := transactionvalueobjects.MonthlyFrequency()
 	endDate := date.AddDate(1, 0, 0)
 
-	transaction, err := TransactionFromPersistenceWithRecurrence(
-		transactionID,
-		userID,
-		accountID,
-		transactionType,
-		amount,
-		description,
-		date,
-		createdAt,
-		updatedAt,
-		true,
-		&recurrenceFrequency,
-		&endDate,
-		nil,
-	)
+	transaction, err := TransactionFromPersistence(TransactionPersistenceSnapshot{
+		ID:                  transactionID,
+		UserID:              userID,
+		AccountID:           accountID,
+		Type:                transactionType,
+		Amount:              amount,
+		Description:         description,
+		Date:                date,
+		CreatedAt:           createdAt,
+		UpdatedAt:           updatedAt,
+		IsRecurring:         true,
+		RecurrenceFrequency: &recurrenceFrequency,
+		RecurrenceEndDate:   &endDate,
+	})
 	if err != nil {
-		t.Fatalf("TransactionFromPersistenceWithRecurrence() error = %v, want nil", err)
+		t.Fatalf("TransactionFromPersistence() error = %v, want nil", err)
 	}
 
 	if !transaction.IsRecurring() {
@@ -454,6 +624,563 @@ func TestTransactionFromPersistenceWithRecurrence(t *testing.T) {
 	// Should not have events
 	events := transaction.GetEvents()
 	if len(events) != 0 {
-		t.Error("TransactionFromPersistenceWithRecurrence() should not create domain events")
+		t.Error("TransactionFromPersistence() should not create domain events")
+	}
+}
+
+func TestNewTransfer(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	fromAccountID := accountvalueobjects.GenerateAccountID()
+	toAccountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(55000, sharedvalueobjects.MustCurrency("BRL"))            // 550.00 BRL
+	destinationAmount, _ := sharedvalueobjects.NewMoney(10000, sharedvalueobjects.MustCurrency("USD")) // 100.00 USD
+	description, _ := transactionvalueobjects.NewTransactionDescription("Compra de dólares")
+	date := time.Now()
+
+	outgoing, incoming, err := NewTransfer(userID, fromAccountID, toAccountID, amount, destinationAmount, description, date)
+	if err != nil {
+		t.Fatalf("NewTransfer() error = %v, want nil", err)
+	}
+
+	if outgoing.TransactionType().Value() != transactionvalueobjects.TransferOut || !outgoing.AccountID().Equals(fromAccountID) {
+		t.Errorf("outgoing leg = %s on %s, want TRANSFER_OUT on source account", outgoing.TransactionType().Value(), outgoing.AccountID().Value())
+	}
+	if incoming.TransactionType().Value() != transactionvalueobjects.TransferIn || !incoming.AccountID().Equals(toAccountID) {
+		t.Errorf("incoming leg = %s on %s, want TRANSFER_IN on destination account", incoming.TransactionType().Value(), incoming.AccountID().Value())
+	}
+	if !incoming.Amount().Equals(destinationAmount) {
+		t.Errorf("incoming amount = %v, want %v", incoming.Amount(), destinationAmount)
+	}
+	if outgoing.LinkedTransactionID() == nil || !outgoing.LinkedTransactionID().Equals(incoming.ID()) {
+		t.Error("outgoing leg should be linked to the incoming leg")
+	}
+	if incoming.LinkedTransactionID() == nil || !incoming.LinkedTransactionID().Equals(outgoing.ID()) {
+		t.Error("incoming leg should be linked to the outgoing leg")
+	}
+
+	events := outgoing.GetEvents()
+	if len(events) != 1 || events[0].EventType() != "TransferCreated" {
+		t.Errorf("outgoing events = %v, want a single TransferCreated", events)
+	}
+	if len(incoming.GetEvents()) != 0 {
+		t.Errorf("incoming leg should not raise events, got %d", len(incoming.GetEvents()))
+	}
+
+	// A transfer leg cannot become an income or expense
+	if err := outgoing.UpdateType(transactionvalueobjects.ExpenseType()); err == nil {
+		t.Error("UpdateType() on a transfer leg should fail")
+	}
+
+	// Source and destination must differ
+	if _, _, err := NewTransfer(userID, fromAccountID, fromAccountID, amount, amount, description, date); err == nil {
+		t.Error("NewTransfer() with the same account should fail")
+	}
+
+	// Transfer types are not accepted by the regular constructor
+	if _, err := NewTransaction(userID, fromAccountID, transactionvalueobjects.TransferOutType(), amount, description, date); err == nil {
+		t.Error("NewTransaction() with a transfer type should fail")
+	}
+}
+
+func TestNewBalanceAdjustment(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	description, _ := transactionvalueobjects.NewTransactionDescription("Ajuste de saldo")
+	date := time.Now()
+
+	// A negative difference lowers the balance
+	difference, _ := sharedvalueobjects.NewMoney(-1250, brl)
+	adjustment, err := NewBalanceAdjustment(userID, accountID, difference, description, date)
+	if err != nil {
+		t.Fatalf("NewBalanceAdjustment() error = %v, want nil", err)
+	}
+	if adjustment.TransactionType().Value() != transactionvalueobjects.AdjustmentOut || adjustment.Amount().Amount() != 1250 {
+		t.Errorf("adjustment = %s of %v, want ADJUSTMENT_OUT of 12.50 BRL", adjustment.TransactionType().Value(), adjustment.Amount())
+	}
+	if !adjustment.IsAdjustment() || adjustment.IsTransfer() {
+		t.Error("adjustment should be an adjustment and not a transfer")
+	}
+	events := adjustment.GetEvents()
+	if len(events) != 1 || events[0].EventType() != "TransactionCreated" {
+		t.Errorf("adjustment events = %v, want a single TransactionCreated", events)
+	}
+
+	// A positive difference raises it
+	increase, err := NewBalanceAdjustment(userID, accountID, difference.Negate(), description, date)
+	if err != nil || increase.TransactionType().Value() != transactionvalueobjects.AdjustmentIn {
+		t.Errorf("NewBalanceAdjustment() with a positive difference = %v, %v, want ADJUSTMENT_IN", increase, err)
+	}
+
+	// Nothing to adjust
+	if _, err := NewBalanceAdjustment(userID, accountID, sharedvalueobjects.Zero(brl), description, date); err == nil {
+		t.Error("NewBalanceAdjustment() with a zero difference should fail")
+	}
+
+	// An adjustment cannot become an income or expense, be split or moved
+	if err := adjustment.UpdateType(transactionvalueobjects.ExpenseType()); err == nil {
+		t.Error("UpdateType() on an adjustment should fail")
+	}
+	if err := adjustment.MoveToAccount(accountvalueobjects.GenerateAccountID()); err == nil {
+		t.Error("MoveToAccount() on an adjustment should fail")
+	}
+
+	// Adjustment types are not accepted by the regular constructor
+	if _, err := NewTransaction(userID, accountID, transactionvalueobjects.AdjustmentInType(), difference.Negate(), description, date); err == nil {
+		t.Error("NewTransaction() with an adjustment type should fail")
+	}
+}
+
+func TestTransaction_MergeDuplicate(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(4500, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Padaria")
+	categoryID := categoryvalueobjects.GenerateCategoryID()
+	tagID := tagvalueobjects.GenerateTagID()
+
+	kept, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	duplicate, _ := NewTransactionWithParams(TransactionParams{
+		UserID:      userID,
+		AccountID:   accountID,
+		Type:        transactionvalueobjects.ExpenseType(),
+		Amount:      amount,
+		Description: description,
+		Date:        time.Now(),
+		CategoryID:  &categoryID,
+	})
+	_ = duplicate.UpdateTags([]tagvalueobjects.TagID{tagID})
+	_ = duplicate.AssignExternalID("20261001001")
+
+	if err := kept.MergeDuplicate(kept); err == nil {
+		t.Error("MergeDuplicate() expected error when merging with itself")
+	}
+
+	otherAccount, _ := NewTransaction(userID, accountvalueobjects.GenerateAccountID(), transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	if err := kept.MergeDuplicate(otherAccount); err == nil {
+		t.Error("MergeDuplicate() expected error for a transaction of another account")
+	}
+
+	income, _ := NewTransaction(userID, accountID, transactionvalueobjects.IncomeType(), amount, description, time.Now())
+	if err := kept.MergeDuplicate(income); err == nil {
+		t.Error("MergeDuplicate() expected error for a transaction of another type")
+	}
+
+	if err := kept.MergeDuplicate(duplicate); err != nil {
+		t.Fatalf("MergeDuplicate() error = %v, want nil", err)
+	}
+	if kept.CategoryID() == nil || !kept.CategoryID().Equals(categoryID) {
+		t.Errorf("MergeDuplicate() category = %v, want %s", kept.CategoryID(), categoryID.Value())
+	}
+	if !kept.HasTag(tagID) {
+		t.Error("MergeDuplicate() expected the duplicate's tags to be kept")
+	}
+	if kept.ExternalID() != "20261001001" {
+		t.Errorf("MergeDuplicate() external ID = %q, want 20261001001", kept.ExternalID())
+	}
+	if !kept.Amount().Equals(amount) {
+		t.Errorf("MergeDuplicate() amount = %v, want %v", kept.Amount(), amount)
+	}
+}
+
+func TestTransaction_StatusAndReconcile(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(4500, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Padaria")
+	reconciliationID := transactionvalueobjects.GenerateReconciliationID()
+
+	transaction, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	if !transaction.Status().IsPending() {
+		t.Fatalf("NewTransaction() status = %s, want PENDING", transaction.Status().Value())
+	}
+
+	if err := transaction.Reconcile(reconciliationID); err == nil {
+		t.Error("Reconcile() expected error for a pending transaction")
+	}
+	if err := transaction.UpdateStatus(transactionvalueobjects.ReconciledStatus()); err == nil {
+		t.Error("UpdateStatus() expected error when marking as reconciled directly")
+	}
+
+	if err := transaction.UpdateStatus(transactionvalueobjects.ClearedStatus()); err != nil {
+		t.Fatalf("UpdateStatus() error = %v, want nil", err)
+	}
+	if err := transaction.Reconcile(reconciliationID); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	if !transaction.IsReconciled() || !transaction.ReconciliationID().Equals(reconciliationID) {
+		t.Errorf("Reconcile() status = %s, reconciliation = %v", transaction.Status().Value(), transaction.ReconciliationID())
+	}
+
+	// A reconciled duplicate must be the one that is kept
+	kept, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	if err := kept.MergeDuplicate(transaction); err == nil {
+		t.Error("MergeDuplicate() expected error for a reconciled duplicate")
+	}
+
+	// Going back to cleared releases the reconciliation
+	if err := transaction.UpdateStatus(transactionvalueobjects.ClearedStatus()); err != nil {
+		t.Fatalf("UpdateStatus() error = %v, want nil", err)
+	}
+	if transaction.IsReconciled() || transaction.ReconciliationID() != nil {
+		t.Error("UpdateStatus() expected the reconciliation to be released")
+	}
+}
+
+func TestNewInstallmentPurchase(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	description, _ := transactionvalueobjects.NewTransactionDescription("Notebook")
+	firstDate := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	amounts := make([]sharedvalueobjects.Money, 3)
+	for i, cents := range []int64{3334, 3333, 3333} {
+		amounts[i], _ = sharedvalueobjects.NewMoney(cents, sharedvalueobjects.MustCurrency("BRL"))
+	}
+
+	installments, err := NewInstallmentPurchase(userID, accountID, amounts, description, firstDate, nil)
+	if err != nil {
+		t.Fatalf("NewInstallmentPurchase() error = %v, want nil", err)
+	}
+	if len(installments) != 3 {
+		t.Fatalf("NewInstallmentPurchase() returned %d installments, want 3", len(installments))
+	}
+
+	wantDates := []string{"2026-01-31", "2026-02-28", "2026-03-31"}
+	wantLabels := []string{"1/3", "2/3", "3/3"}
+	purchaseID := installments[0].ID()
+	for i, installment := range installments {
+		if !installment.IsInstallment() || installment.Installment().String() != wantLabels[i] {
+			t.Errorf("installment %d label = %v, want %s", i+1, installment.Installment(), wantLabels[i])
+		}
+		if got := installment.Date().Format("2006-01-02"); got != wantDates[i] {
+			t.Errorf("installment %d date = %s, want %s", i+1, got, wantDates[i])
+		}
+		if !installment.TransactionType().IsExpense() {
+			t.Errorf("installment %d should be an expense", i+1)
+		}
+		if !installment.Amount().Equals(amounts[i]) {
+			t.Errorf("installment %d amount = %s, want %s", i+1, installment.Amount().String(), amounts[i].String())
+		}
+		if !installment.InstallmentPurchaseID().Equals(purchaseID) {
+			t.Errorf("installment %d purchase ID = %s, want %s", i+1, installment.InstallmentPurchaseID().Value(), purchaseID.Value())
+		}
+	}
+
+	if installments[0].ParentTransactionID() != nil {
+		t.Error("first installment should not have a parent transaction")
+	}
+	if parent := installments[2].ParentTransactionID(); parent == nil || !parent.Equals(purchaseID) {
+		t.Errorf("last installment parent = %v, want %s", parent, purchaseID.Value())
+	}
+	if events := installments[0].GetEvents(); len(events) != 2 || events[1].EventType() != "InstallmentPurchaseCreated" {
+		t.Errorf("first installment events = %v, want TransactionCreated and InstallmentPurchaseCreated", events)
+	}
+
+	if _, err := NewInstallmentPurchase(userID, accountID, amounts[:1], description, firstDate, nil); err == nil {
+		t.Error("NewInstallmentPurchase() expected error for a single installment")
+	}
+}
+
+func TestTransaction_RecurringSeriesSchedule(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	amount, _ := sharedvalueobjects.NewMoney(150000, brl)
+	description, _ := transactionvalueobjects.NewTransactionDescription("Aluguel")
+	frequency := transactionvalueobjects.MonthlyFrequency()
+	start := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	series, err := NewTransactionWithRecurrence(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, start, true, &frequency, &endDate, nil)
+	if err != nil {
+		t.Fatalf("NewTransactionWithRecurrence() error = %v", err)
+	}
+	if !series.IsRecurringSeries() {
+		t.Fatal("IsRecurringSeries() = false, want true")
+	}
+
+	// Monthly occurrences keep the day of the month, clamped to shorter months
+	if got := series.OccurrenceDate(1).Format("2006-01-02"); got != "2026-02-28" {
+		t.Errorf("OccurrenceDate(1) = %s, want 2026-02-28", got)
+	}
+	if got := series.OccurrenceDate(2).Format("2006-01-02"); got != "2026-03-31" {
+		t.Errorf("OccurrenceDate(2) = %s, want 2026-03-31", got)
+	}
+	if series.IsOccurrenceDate(time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsOccurrenceDate() = true for a day without occurrence")
+	}
+
+	if err := series.SkipOccurrence(start); err == nil {
+		t.Error("SkipOccurrence() expected error for the first occurrence")
+	}
+	if err := series.SkipOccurrence(time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("SkipOccurrence() expected error for a day without occurrence")
+	}
+	march := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	if err := series.SkipOccurrence(march); err != nil {
+		t.Fatalf("SkipOccurrence() error = %v", err)
+	}
+	if err := series.SkipOccurrence(march); err == nil {
+		t.Error("SkipOccurrence() expected error for an occurrence already skipped")
+	}
+
+	newAmount, _ := sharedvalueobjects.NewMoney(165000, brl)
+	if err := series.ChangeRecurrenceAmount(time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC), newAmount); err != nil {
+		t.Fatalf("ChangeRecurrenceAmount() error = %v", err)
+	}
+	usd, _ := sharedvalueobjects.NewMoney(1000, sharedvalueobjects.MustCurrency("USD"))
+	if err := series.ChangeRecurrenceAmount(time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC), usd); err == nil {
+		t.Error("ChangeRecurrenceAmount() expected error for another currency")
+	}
+
+	// Skipped occurrences are left out and the schedule stops at the end date
+	occurrences := series.NextOccurrences(start, 10)
+	want := []string{"2026-02-28", "2026-04-30", "2026-05-31", "2026-06-30"}
+	if len(occurrences) != len(want) {
+		t.Fatalf("NextOccurrences() returned %d dates, want %d", len(occurrences), len(want))
+	}
+	for i, date := range occurrences {
+		if got := date.Format("2006-01-02"); got != want[i] {
+			t.Errorf("NextOccurrences()[%d] = %s, want %s", i, got, want[i])
+		}
+	}
+
+	if got := series.RecurrenceAmountOn(time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)); got.Amount() != 150000 {
+		t.Errorf("RecurrenceAmountOn(February) = %d, want 150000", got.Amount())
+	}
+	if got := series.RecurrenceAmountOn(time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)); got.Amount() != 165000 {
+		t.Errorf("RecurrenceAmountOn(May) = %d, want 165000", got.Amount())
+	}
+
+	if err := series.EndRecurrence(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("EndRecurrence() expected error before the first occurrence")
+	}
+	if err := series.EndRecurrence(time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("EndRecurrence() error = %v", err)
+	}
+	if got := series.NextOccurrences(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), 10); len(got) != 0 {
+		t.Errorf("NextOccurrences() after the end date returned %d dates, want 0", len(got))
+	}
+}
+
+func TestTransaction_PauseAndResumeRecurrence(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(9990, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Academia")
+	frequency := transactionvalueobjects.MonthlyFrequency()
+
+	series, _ := NewTransactionWithRecurrence(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now(), true, &frequency, nil, nil)
+	series.ClearEvents()
+
+	if err := series.ResumeRecurrence(); err == nil {
+		t.Error("ResumeRecurrence() expected error for an active series")
+	}
+	if err := series.PauseRecurrence(); err != nil {
+		t.Fatalf("PauseRecurrence() error = %v", err)
+	}
+	if !series.IsRecurrencePaused() || series.RecurrencePausedAt() == nil {
+		t.Error("PauseRecurrence() expected the series to be paused")
+	}
+	if err := series.PauseRecurrence(); err == nil {
+		t.Error("PauseRecurrence() expected error for a paused series")
+	}
+	if err := series.ResumeRecurrence(); err != nil {
+		t.Fatalf("ResumeRecurrence() error = %v", err)
+	}
+	if series.IsRecurrencePaused() {
+		t.Error("ResumeRecurrence() expected the series to be active")
+	}
+
+	events := series.GetEvents()
+	if len(events) != 2 || events[0].EventType() != "RecurringSeriesPaused" || events[1].EventType() != "RecurringSeriesResumed" {
+		t.Errorf("GetEvents() = %v, want RecurringSeriesPaused and RecurringSeriesResumed", events)
+	}
+
+	// Only the transaction that starts a series can be managed
+	single, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	if err := single.PauseRecurrence(); err == nil {
+		t.Error("PauseRecurrence() expected error for a transaction that is not recurring")
+	}
+}
+
+func TestTransaction_RecurrenceScheduleRules(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(250000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Salário")
+	monthly := transactionvalueobjects.MonthlyFrequency()
+	daily := transactionvalueobjects.DailyFrequency()
+
+	newSeries := func(date time.Time, frequency transactionvalueobjects.RecurrenceFrequency) *Transaction {
+		series, err := NewTransactionWithRecurrence(userID, accountID, transactionvalueobjects.IncomeType(), amount, description, date, true, &frequency, nil, nil)
+		if err != nil {
+			t.Fatalf("NewTransactionWithRecurrence() error = %v", err)
+		}
+		return series
+	}
+	anchor := func(value string) *transactionvalueobjects.RecurrenceAnchor {
+		a, _ := transactionvalueobjects.NewRecurrenceAnchor(value)
+		return &a
+	}
+
+	// Last day of the month, moved to the next business day
+	series := newSeries(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), monthly)
+	if err := series.UpdateRecurrenceSchedule(anchor(transactionvalueobjects.LastDayOfMonthAnchor), true); err != nil {
+		t.Fatalf("UpdateRecurrenceSchedule() error = %v", err)
+	}
+	want := []string{
+		"2026-03-02", // Feb 28 is a Saturday
+		"2026-03-31",
+		"2026-04-30",
+		"2026-06-01", // May 31 is a Sunday
+	}
+	for i, date := range want {
+		if got := series.OccurrenceDate(i + 1).Format("2006-01-02"); got != date {
+			t.Errorf("OccurrenceDate(%d) = %s, want %s", i+1, got, date)
+		}
+	}
+	if !series.IsOccurrenceDate(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsOccurrenceDate() = false for an occurrence moved to the next business day")
+	}
+
+	// Second Tuesday of the month
+	nth := newSeries(time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC), monthly)
+	if err := nth.UpdateRecurrenceSchedule(anchor(transactionvalueobjects.NthWeekdayAnchor), false); err != nil {
+		t.Fatalf("UpdateRecurrenceSchedule() error = %v", err)
+	}
+	if got := nth.OccurrenceDate(1).Format("2006-01-02"); got != "2026-02-10" {
+		t.Errorf("OccurrenceDate(1) = %s, want 2026-02-10", got)
+	}
+
+	// Anchors must match the first occurrence and frequency
+	if err := nth.UpdateRecurrenceSchedule(anchor(transactionvalueobjects.LastDayOfMonthAnchor), false); err == nil {
+		t.Error("UpdateRecurrenceSchedule() expected error for an anchor not matching the first occurrence")
+	}
+	dailySeries := newSeries(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), daily)
+	if err := dailySeries.UpdateRecurrenceSchedule(anchor(transactionvalueobjects.LastDayOfMonthAnchor), false); err == nil {
+		t.Error("UpdateRecurrenceSchedule() expected error for an anchor on a daily series")
+	}
+	if err := dailySeries.UpdateRecurrenceSchedule(nil, true); err == nil {
+		t.Error("UpdateRecurrenceSchedule() expected error for a daily series shifted to business days")
+	}
+}
+
+func TestTransaction_DueOccurrences(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(8000, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Diarista")
+	weekly := transactionvalueobjects.WeeklyFrequency()
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	series, _ := NewTransactionWithRecurrence(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, start, true, &weekly, nil, nil)
+	_ = series.SkipOccurrence(time.Date(2026, 9, 15, 0, 0, 0, 0, time.UTC))
+
+	// Every occurrence missed since the first one is due, except skipped ones
+	asOf := time.Date(2026, 9, 30, 18, 0, 0, 0, time.UTC)
+	due := series.DueOccurrences(asOf)
+	want := []string{"2026-09-08", "2026-09-22", "2026-09-29"}
+	if len(due) != len(want) {
+		t.Fatalf("DueOccurrences() returned %d dates, want %d", len(due), len(want))
+	}
+	for i, date := range due {
+		if got := date.Format("2006-01-02"); got != want[i] {
+			t.Errorf("DueOccurrences()[%d] = %s, want %s", i, got, want[i])
+		}
+	}
+
+	series.MarkOccurrencesGenerated(asOf)
+	if got := series.DueOccurrences(asOf); len(got) != 0 {
+		t.Errorf("DueOccurrences() after MarkOccurrencesGenerated() returned %d dates, want 0", len(got))
+	}
+	if got := series.DueOccurrences(time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC)); len(got) != 1 {
+		t.Errorf("DueOccurrences() a week later returned %d dates, want 1", len(got))
+	}
+
+	// Occurrences that fell due while paused are not generated after resuming
+	paused, _ := NewTransactionWithRecurrence(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now().AddDate(0, 0, -21), true, &weekly, nil, nil)
+	_ = paused.PauseRecurrence()
+	_ = paused.ResumeRecurrence()
+	for _, date := range paused.DueOccurrences(time.Now()) {
+		if calendarDay(date).Before(calendarDay(time.Now())) {
+			t.Errorf("DueOccurrences() after resuming returned %s, before the resume", date.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestTransaction_ConvertCurrency(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	original, _ := sharedvalueobjects.NewMoney(10000, sharedvalueobjects.MustCurrency("USD"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Hotel em Lisboa")
+
+	transaction, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), original, description, time.Now())
+	first, second := categoryvalueobjects.GenerateCategoryID(), categoryvalueobjects.GenerateCategoryID()
+	firstAmount, _ := sharedvalueobjects.NewMoney(7500, original.Currency())
+	secondAmount, _ := sharedvalueobjects.NewMoney(2500, original.Currency())
+	firstSplit, _ := transactionvalueobjects.NewTransactionSplit(first, firstAmount, "")
+	secondSplit, _ := transactionvalueobjects.NewTransactionSplit(second, secondAmount, "")
+	if err := transaction.UpdateSplits([]transactionvalueobjects.TransactionSplit{firstSplit, secondSplit}); err != nil {
+		t.Fatalf("UpdateSplits() error = %v", err)
+	}
+
+	otherOriginal, _ := sharedvalueobjects.NewMoney(5000, original.Currency())
+	mismatch, _ := transactionvalueobjects.NewCurrencyConversion(otherOriginal, 5.5, brl)
+	if err := transaction.ConvertCurrency(mismatch); err == nil {
+		t.Error("ConvertCurrency() expected error for a conversion of another amount")
+	}
+
+	conversion, _ := transactionvalueobjects.NewCurrencyConversion(original, 5.5, brl)
+	if err := transaction.ConvertCurrency(conversion); err != nil {
+		t.Fatalf("ConvertCurrency() error = %v", err)
+	}
+	if transaction.Amount().Amount() != 55000 || !transaction.Amount().Currency().Equals(brl) {
+		t.Errorf("ConvertCurrency() amount = %s, want 550.00 BRL", transaction.Amount().String())
+	}
+	if !transaction.IsForeignCurrency() || !transaction.CurrencyConversion().OriginalAmount().Equals(original) {
+		t.Error("ConvertCurrency() expected the original amount to be kept")
+	}
+	if got := transaction.AmountForCategory(first); got.Amount() != 41250 || !got.Currency().Equals(brl) {
+		t.Errorf("ConvertCurrency() split amount = %s, want 412.50 BRL", got.String())
+	}
+	if err := transaction.ConvertCurrency(conversion); err == nil {
+		t.Error("ConvertCurrency() expected error when converting twice")
+	}
+
+	// Correcting the amount keeps the original amount and derives the exchange rate again
+	corrected, _ := sharedvalueobjects.NewMoney(56000, brl)
+	if err := transaction.UpdateAmount(corrected); err != nil {
+		t.Fatalf("UpdateAmount() error = %v", err)
+	}
+	if transaction.CurrencyConversion().ExchangeRate() != 5.6 || !transaction.CurrencyConversion().OriginalAmount().Equals(original) {
+		t.Errorf("UpdateAmount() conversion = %s, want 100.00 USD at 5.6", transaction.CurrencyConversion().String())
+	}
+}
+
+func TestTransaction_MarkAsFeeFor(t *testing.T) {
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	amount, _ := sharedvalueobjects.NewMoney(1901, sharedvalueobjects.MustCurrency("BRL"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("IOF - Hotel em Lisboa")
+
+	fee, _ := NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, time.Now())
+	if err := fee.MarkAsFeeFor(fee.ID()); err == nil {
+		t.Error("MarkAsFeeFor() expected error for the transaction itself")
+	}
+
+	purchaseID := transactionvalueobjects.GenerateTransactionID()
+	if err := fee.MarkAsFeeFor(purchaseID); err != nil {
+		t.Fatalf("MarkAsFeeFor() error = %v", err)
+	}
+	if !fee.IsFee() || !fee.FeeForTransactionID().Equals(purchaseID) {
+		t.Errorf("MarkAsFeeFor() fee for = %v, want %s", fee.FeeForTransactionID(), purchaseID.Value())
+	}
+
+	income, _ := NewTransaction(userID, accountID, transactionvalueobjects.IncomeType(), amount, description, time.Now())
+	if err := income.MarkAsFeeFor(purchaseID); err == nil {
+		t.Error("MarkAsFeeFor() expected error for an income")
 	}
 }
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// TransferCreated represents a domain event that occurs when a transfer between accounts is created.
+// The aggregate ID is the outgoing (TRANSFER_OUT) leg; the incoming leg is referenced by IncomingTransactionID.
+type TransferCreated struct {
+	events.BaseDomainEvent
+	incomingTransactionID string
+	fromAccountID         string
+	toAccountID           string
+	amount                int64 // Amount debited from the source account, in cents
+	currency              string
+	destinationAmount     int64 // Amount credited to the destination account, in cents
+	destinationCurrency   string
+}
+
+// NewTransferCreated creates a new TransferCreated event.
+func NewTransferCreated(
+	outgoingTransactionID string,
+	incomingTransactionID string,
+	fromAccountID string,
+	toAccountID string,
+	amount sharedvalueobjects.Money,
+	destinationAmount sharedvalueobjects.Money,
+) *TransferCreated {
+	baseEvent := events.NewBaseDomainEvent(
+		"TransferCreated",
+		outgoingTransactionID,
+		"Transaction",
+	)
+
+	return &TransferCreated{
+		BaseDomainEvent:       baseEvent,
+		incomingTransactionID: incomingTransactionID,
+		fromAccountID:         fromAccountID,
+		toAccountID:           toAccountID,
+		amount:                amount.Amount(),
+		currency:              amount.Currency().Code(),
+		destinationAmount:     destinationAmount.Amount(),
+		destinationCurrency:   destinationAmount.Currency().Code(),
+	}
+}
+
+// IncomingTransactionID returns the ID of the incoming (TRANSFER_IN) leg.
+func (e *TransferCreated) IncomingTransactionID() string {
+	return e.incomingTransactionID
+}
+
+// FromAccountID returns the source account ID.
+func (e *TransferCreated) FromAccountID() string {
+	return e.fromAccountID
+}
+
+// ToAccountID returns the destination account ID.
+func (e *TransferCreated) ToAccountID() string {
+	return e.toAccountID
+}
+
+// Amount returns the amount debited from the source account in cents.
+func (e *TransferCreated) Amount() int64 {
+	return e.amount
+}
+
+// Currency returns the source currency code.
+func (e *TransferCreated) Currency() string {
+	return e.currency
+}
+
+// DestinationAmount returns the amount credited to the destination account in cents.
+func (e *TransferCreated) DestinationAmount() int64 {
+	return e.destinationAmount
+}
+
+// DestinationCurrency returns the destination currency code.
+func (e *TransferCreated) DestinationCurrency() string {
+	return e.destinationCurrency
+}
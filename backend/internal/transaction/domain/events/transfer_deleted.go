@@ -0,0 +1,81 @@
+package events
+
+import (
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// TransferDeleted represents a domain event that occurs when a transfer between accounts is deleted.
+// Both legs are deleted together; this event includes their amounts to allow handlers to reverse balance updates.
+type TransferDeleted struct {
+	events.BaseDomainEvent
+	incomingTransactionID string
+	fromAccountID         string
+	toAccountID           string
+	amount                int64 // Amount of the outgoing leg, in cents
+	currency              string
+	destinationAmount     int64 // Amount of the incoming leg, in cents
+	destinationCurrency   string
+}
+
+// NewTransferDeleted creates a new TransferDeleted event.
+func NewTransferDeleted(
+	outgoingTransactionID string,
+	incomingTransactionID string,
+	fromAccountID string,
+	toAccountID string,
+	amount sharedvalueobjects.Money,
+	destinationAmount sharedvalueobjects.Money,
+) *TransferDeleted {
+	baseEvent := events.NewBaseDomainEvent(
+		"TransferDeleted",
+		outgoingTransactionID,
+		"Transaction",
+	)
+
+	return &TransferDeleted{
+		BaseDomainEvent:       baseEvent,
+		incomingTransactionID: incomingTransactionID,
+		fromAccountID:         fromAccountID,
+		toAccountID:           toAccountID,
+		amount:                amount.Amount(),
+		currency:              amount.Currency().Code(),
+		destinationAmount:     destinationAmount.Amount(),
+		destinationCurrency:   destinationAmount.Currency().Code(),
+	}
+}
+
+// IncomingTransactionID returns the ID of the incoming (TRANSFER_IN) leg.
+func (e *TransferDeleted) IncomingTransactionID() string {
+	return e.incomingTransactionID
+}
+
+// FromAccountID returns the source account ID.
+func (e *TransferDeleted) FromAccountID() string {
+	return e.fromAccountID
+}
+
+// ToAccountID returns the destination account ID.
+func (e *TransferDeleted) ToAccountID() string {
+	return e.toAccountID
+}
+
+// Amount returns the amount of the outgoing leg in cents.
+func (e *TransferDeleted) Amount() int64 {
+	return e.amount
+}
+
+// Currency returns the source currency code.
+func (e *TransferDeleted) Currency() string {
+	return e.currency
+}
+
+// DestinationAmount returns the amount of the incoming leg in cents.
+func (e *TransferDeleted) DestinationAmount() int64 {
+	return e.destinationAmount
+}
+
+// DestinationCurrency returns the destination currency code.
+func (e *TransferDeleted) DestinationCurrency() string {
+	return e.destinationCurrency
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"gestao-financeira/backend/internal/shared/domain/events"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// TransferUpdated represents a domain event that occurs when a transfer between accounts is updated.
+// This event includes the old amounts of both legs to allow handlers to reverse previous balance updates.
+type TransferUpdated struct {
+	events.BaseDomainEvent
+	incomingTransactionID string
+	fromAccountID         string
+	toAccountID           string
+	oldAmount             int64 // Old amount of the outgoing leg, in cents
+	newAmount             int64 // New amount of the outgoing leg, in cents
+	currency              string
+	oldDestinationAmount  int64 // Old amount of the incoming leg, in cents
+	newDestinationAmount  int64 // New amount of the incoming leg, in cents
+	destinationCurrency   string
+}
+
+// NewTransferUpdated creates a new TransferUpdated event.
+func NewTransferUpdated(
+	outgoingTransactionID string,
+	incomingTransactionID string,
+	fromAccountID string,
+	toAccountID string,
+	oldAmount sharedvalueobjects.Money,
+	newAmount sharedvalueobjects.Money,
+	oldDestinationAmount sharedvalueobjects.Money,
+	newDestinationAmount sharedvalueobjects.Money,
+) *TransferUpdated {
+	baseEvent := events.NewBaseDomainEvent(
+		"TransferUpdated",
+		outgoingTransactionID,
+		"Transaction",
+	)
+
+	return &TransferUpdated{
+		BaseDomainEvent:       baseEvent,
+		incomingTransactionID: incomingTransactionID,
+		fromAccountID:         fromAccountID,
+		toAccountID:           toAccountID,
+		oldAmount:             oldAmount.Amount(),
+		newAmount:             newAmount.Amount(),
+		currency:              newAmount.Currency().Code(),
+		oldDestinationAmount:  oldDestinationAmount.Amount(),
+		newDestinationAmount:  newDestinationAmount.Amount(),
+		destinationCurrency:   newDestinationAmount.Currency().Code(),
+	}
+}
+
+// IncomingTransactionID returns the ID of the incoming (TRANSFER_IN) leg.
+func (e *TransferUpdated) IncomingTransactionID() string {
+	return e.incomingTransactionID
+}
+
+// FromAccountID returns the source account ID.
+func (e *TransferUpdated) FromAccountID() string {
+	return e.fromAccountID
+}
+
+// ToAccountID returns the destination account ID.
+func (e *TransferUpdated) ToAccountID() string {
+	return e.toAccountID
+}
+
+// OldAmount returns the old amount of the outgoing leg in cents.
+func (e *TransferUpdated) OldAmount() int64 {
+	return e.oldAmount
+}
+
+// NewAmount returns the new amount of the outgoing leg in cents.
+func (e *TransferUpdated) NewAmount() int64 {
+	return e.newAmount
+}
+
+// Currency returns the source currency code.
+func (e *TransferUpdated) Currency() string {
+	return e.currency
+}
+
+// OldDestinationAmount returns the old amount of the incoming leg in cents.
+func (e *TransferUpdated) OldDestinationAmount() int64 {
+	return e.oldDestinationAmount
+}
+
+// NewDestinationAmount returns the new amount of the incoming leg in cents.
+func (e *TransferUpdated) NewDestinationAmount() int64 {
+	return e.newDestinationAmount
+}
+
+// DestinationCurrency returns the destination currency code.
+func (e *TransferUpdated) DestinationCurrency() string {
+	return e.destinationCurrency
+}
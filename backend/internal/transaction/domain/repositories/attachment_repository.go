@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// AttachmentRepository defines the interface for transaction attachment metadata persistence.
+// The files themselves are kept in a storage.FileStorage.
+type AttachmentRepository interface {
+	// FindByID finds an attachment by its ID.
+	// Returns nil if the attachment is not found.
+	FindByID(id transactionvalueobjects.AttachmentID) (*entities.Attachment, error)
+
+	// FindByTransactionID finds all attachments of a transaction, oldest first.
+	FindByTransactionID(transactionID transactionvalueobjects.TransactionID) ([]*entities.Attachment, error)
+
+	// Save saves an attachment.
+	Save(attachment *entities.Attachment) error
+
+	// Delete permanently deletes an attachment by its ID.
+	Delete(id transactionvalueobjects.AttachmentID) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// ImportBatchRepository defines the interface for statement import batch persistence operations.
+type ImportBatchRepository interface {
+	// FindByID finds an import batch by its ID.
+	// Returns nil if the import batch is not found.
+	FindByID(id transactionvalueobjects.ImportBatchID) (*entities.ImportBatch, error)
+
+	// FindByUserID finds all import batches for a given user, newest first.
+	FindByUserID(userID identityvalueobjects.UserID) ([]*entities.ImportBatch, error)
+
+	// Save saves or updates an import batch.
+	Save(batch *entities.ImportBatch) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// ReconciliationRepository defines the interface for account reconciliation persistence operations.
+type ReconciliationRepository interface {
+	// FindByID finds a reconciliation by its ID.
+	// Returns nil if the reconciliation is not found.
+	FindByID(id transactionvalueobjects.ReconciliationID) (*entities.Reconciliation, error)
+
+	// FindByAccountID finds the reconciliation history of an account, latest statement first.
+	FindByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Reconciliation, error)
+
+	// Save saves or updates a reconciliation.
+	Save(reconciliation *entities.Reconciliation) error
+}
@@ -0,0 +1,199 @@
+package repositories
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// TransactionSortField is a field transactions can be sorted by.
+type TransactionSortField string
+
+// Supported sort fields.
+const (
+	SortByDate        TransactionSortField = "date"
+	SortByAmount      TransactionSortField = "amount"
+	SortByDescription TransactionSortField = "description"
+)
+
+// TransactionFilter holds the optional criteria for searching the transactions of a user.
+// Zero values mean "no restriction"; every criterion that is set must hold.
+type TransactionFilter struct {
+	AccountID *accountvalueobjects.AccountID
+	Type      *transactionvalueobjects.TransactionType
+	Status    *transactionvalueobjects.TransactionStatus
+	PayeeID   *payeevalueobjects.PayeeID
+
+	// TagIDs keeps transactions with any of the tags, or with all of them if MatchAllTags is set.
+	TagIDs       []tagvalueobjects.TagID
+	MatchAllTags bool
+
+	StartDate *time.Time // Inclusive
+	EndDate   *time.Time // Inclusive
+	MinAmount *int64     // In cents, inclusive
+	MaxAmount *int64     // In cents, inclusive
+	Currency  *sharedvalueobjects.Currency
+
+	// Search is a full-text query on the description. The database ignores accents and matches
+	// Portuguese word variations (e.g. "mercado" finds "Mercados").
+	Search string
+
+	// RecurringOnly keeps recurring transactions and the occurrences generated from them.
+	RecurringOnly bool
+
+	SortBy        TransactionSortField // Default: date
+	SortAscending bool                 // Default: descending
+}
+
+// Matches checks whether a transaction meets every criterion of the filter. It is the in-memory
+// equivalent of the repository query; Search requires every word of the query to appear in the
+// description, ignoring case and accents, without stemming.
+func (f TransactionFilter) Matches(transaction *entities.Transaction) bool {
+	if f.AccountID != nil && !transaction.AccountID().Equals(*f.AccountID) {
+		return false
+	}
+	if f.Type != nil && !transaction.TransactionType().Equals(*f.Type) {
+		return false
+	}
+	if f.Status != nil && !transaction.Status().Equals(*f.Status) {
+		return false
+	}
+	if f.PayeeID != nil && (transaction.PayeeID() == nil || !transaction.PayeeID().Equals(*f.PayeeID)) {
+		return false
+	}
+	if !f.matchesTags(transaction) {
+		return false
+	}
+
+	date := transaction.Date()
+	if f.StartDate != nil && date.Before(*f.StartDate) {
+		return false
+	}
+	if f.EndDate != nil && date.After(*f.EndDate) {
+		return false
+	}
+
+	amount := transaction.Amount()
+	if f.MinAmount != nil && amount.Amount() < *f.MinAmount {
+		return false
+	}
+	if f.MaxAmount != nil && amount.Amount() > *f.MaxAmount {
+		return false
+	}
+	if f.Currency != nil && !amount.Currency().Equals(*f.Currency) {
+		return false
+	}
+
+	if f.RecurringOnly && !transaction.IsRecurring() && transaction.ParentTransactionID() == nil {
+		return false
+	}
+
+	if search := transactionvalueobjects.FoldDescription(f.Search); search != "" {
+		description := transactionvalueobjects.FoldDescription(transaction.Description().Value())
+		for _, word := range strings.Fields(search) {
+			if !strings.Contains(description, word) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchesTags checks the tag criterion of the filter.
+func (f TransactionFilter) matchesTags(transaction *entities.Transaction) bool {
+	if len(f.TagIDs) == 0 {
+		return true
+	}
+
+	for _, tagID := range f.TagIDs {
+		hasTag := transaction.HasTag(tagID)
+		if hasTag && !f.MatchAllTags {
+			return true
+		}
+		if !hasTag && f.MatchAllTags {
+			return false
+		}
+	}
+
+	return f.MatchAllTags
+}
+
+// Sort orders transactions by the sort field and direction of the filter. Ties are broken by
+// date, creation time and ID, in the same direction, so the order matches the keyset of each transaction.
+func (f TransactionFilter) Sort(transactions []*entities.Transaction) {
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return f.CompareKeysets(f.KeysetOf(transactions[i]), f.KeysetOf(transactions[j])) < 0
+	})
+}
+
+// compareInt64 returns -1, 0 or 1 depending on whether a is less than, equal to or greater than b.
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// TransactionKeyset is the position of a transaction in a list sorted by a filter, used for
+// cursor (keyset) pagination: the value of the sort field, then the date, creation time and ID
+// that break ties, so that every transaction has a distinct position.
+type TransactionKeyset struct {
+	Amount      int64  // Amount in cents; only compared when sorting by amount
+	Description string // Lowercased description; only compared when sorting by description
+	Date        time.Time
+	CreatedAt   time.Time
+	ID          transactionvalueobjects.TransactionID
+}
+
+// KeysetOf returns the position of a transaction in the order of the filter.
+func (f TransactionFilter) KeysetOf(transaction *entities.Transaction) TransactionKeyset {
+	keyset := TransactionKeyset{
+		Date:      transaction.Date(),
+		CreatedAt: transaction.CreatedAt(),
+		ID:        transaction.ID(),
+	}
+	switch f.SortBy {
+	case SortByAmount:
+		keyset.Amount = transaction.Amount().Amount()
+	case SortByDescription:
+		keyset.Description = strings.ToLower(transaction.Description().Value())
+	}
+	return keyset
+}
+
+// CompareKeysets returns a negative number when a comes before b in the order of the filter,
+// a positive number when it comes after b, and zero when both are the same position.
+func (f TransactionFilter) CompareKeysets(a, b TransactionKeyset) int {
+	var cmp int
+	switch f.SortBy {
+	case SortByAmount:
+		cmp = compareInt64(a.Amount, b.Amount)
+	case SortByDescription:
+		cmp = strings.Compare(a.Description, b.Description)
+	}
+	if cmp == 0 {
+		cmp = a.Date.Compare(b.Date)
+	}
+	if cmp == 0 {
+		cmp = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if cmp == 0 {
+		cmp = strings.Compare(a.ID.Value(), b.ID.Value())
+	}
+
+	if f.SortAscending {
+		return cmp
+	}
+	return -cmp
+}
@@ -44,19 +44,49 @@ type TransactionRepository interface {
 	// Returns transactions where isRecurring = true and (recurrenceEndDate IS NULL OR recurrenceEndDate >= currentDate).
 	FindActiveRecurringTransactions() ([]*entities.Transaction, error)
 
+	// FindRecurringSeriesByUserID finds the transactions that start a recurring series of a user
+	// (isRecurring = true, without a parent), ended or not, ordered by description.
+	FindRecurringSeriesByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error)
+
 	// FindByParentIDAndDate finds a transaction instance by parent transaction ID and date.
 	// Returns nil if not found.
 	FindByParentIDAndDate(parentID transactionvalueobjects.TransactionID, date time.Time) (*entities.Transaction, error)
 
-	// FindByUserIDAndFiltersWithPagination finds transactions with filters and pagination.
+	// FindByParentID finds the transactions that reference the given parent transaction
+	// (instances of a recurring transaction, installments after the first), oldest first.
+	FindByParentID(parentID transactionvalueobjects.TransactionID) ([]*entities.Transaction, error)
+
+	// FindByImportBatchID finds all transactions created by a statement import batch.
+	FindByImportBatchID(batchID transactionvalueobjects.ImportBatchID) ([]*entities.Transaction, error)
+
+	// FindExistingExternalIDs returns which of the given external IDs (bank identifiers of statement
+	// entries) are already used by transactions of the account. Deleted transactions are not considered.
+	FindExistingExternalIDs(accountID accountvalueobjects.AccountID, externalIDs []string) (map[string]bool, error)
+
+	// FindUnreconciledByAccountID finds the pending and cleared transactions of an account,
+	// oldest first. Deleted transactions are not considered.
+	FindUnreconciledByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error)
+
+	// FindByUserIDAndFiltersWithPagination finds the transactions of a user that match the filter,
+	// sorted as the filter asks, with pagination.
 	// Returns transactions, total count, and error.
 	FindByUserIDAndFiltersWithPagination(
 		userID identityvalueobjects.UserID,
-		accountID string,
-		transactionType string,
+		filter TransactionFilter,
 		offset, limit int,
 	) ([]*entities.Transaction, int64, error)
 
+	// FindByUserIDAndFiltersWithKeyset finds up to limit transactions of a user that match the filter
+	// and come after the keyset in the order of the filter (from the first one when keyset is nil).
+	// When backward is set it finds the transactions that come before the keyset instead, nearest first.
+	FindByUserIDAndFiltersWithKeyset(
+		userID identityvalueobjects.UserID,
+		filter TransactionFilter,
+		keyset *TransactionKeyset,
+		backward bool,
+		limit int,
+	) ([]*entities.Transaction, error)
+
 	// FindByUserIDAndDateRange finds transactions for a given user within a date range.
 	// Returns transactions filtered by startDate and endDate (inclusive).
 	FindByUserIDAndDateRange(
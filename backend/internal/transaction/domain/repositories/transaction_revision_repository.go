@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// TransactionRevisionRepository defines the interface for transaction edit history persistence operations.
+// Revisions are immutable: they are only ever created, never updated or deleted.
+type TransactionRevisionRepository interface {
+	// FindByID finds a revision by its ID.
+	// Returns nil if the revision is not found.
+	FindByID(id transactionvalueobjects.TransactionRevisionID) (*entities.TransactionRevision, error)
+
+	// FindByTransactionID finds the edit history of a transaction, oldest revision first.
+	FindByTransactionID(transactionID transactionvalueobjects.TransactionID) ([]*entities.TransactionRevision, error)
+
+	// Save creates a revision.
+	Save(revision *entities.TransactionRevision) error
+}
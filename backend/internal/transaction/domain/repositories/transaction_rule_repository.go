@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// TransactionRuleRepository defines the interface for transaction rule persistence operations.
+type TransactionRuleRepository interface {
+	// FindByID finds a rule by its ID.
+	// Returns nil if the rule is not found.
+	FindByID(id transactionvalueobjects.RuleID) (*entities.TransactionRule, error)
+
+	// FindByUserID finds all rules for a given user, in the order they run
+	// (priority, then creation date).
+	FindByUserID(userID identityvalueobjects.UserID) ([]*entities.TransactionRule, error)
+
+	// Save saves or updates a rule.
+	Save(rule *entities.TransactionRule) error
+
+	// Delete permanently deletes a rule by its ID.
+	Delete(id transactionvalueobjects.RuleID) error
+}
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+const (
+	// DefaultDuplicateDateWindowDays is how many days apart two entries of the same purchase may be.
+	// Banks post card purchases a few days after the user enters them manually.
+	DefaultDuplicateDateWindowDays = 3
+
+	// DefaultDuplicateMinSimilarity is the minimum description similarity (0 to 1) of a likely duplicate.
+	DefaultDuplicateMinSimilarity = 0.6
+)
+
+// TransactionFingerprint holds the fields used to compare transactions for duplicates.
+// It can be built for a saved transaction or for a statement line that was not saved yet.
+type TransactionFingerprint struct {
+	TransactionID string // Empty for entries that were not saved yet
+	AccountID     accountvalueobjects.AccountID
+	Type          transactionvalueobjects.TransactionType
+	Amount        sharedvalueobjects.Money
+	Date          time.Time
+	Description   string // Normalized with NormalizeDescription
+
+	// Recurring series or installment purchase the transaction belongs to (its own ID for the
+	// parent); entries of the same series are expected to look alike and are never reported as
+	// duplicates.
+	seriesID string
+}
+
+// NewTransactionFingerprint creates the fingerprint of an entry that was not saved yet.
+func NewTransactionFingerprint(
+	accountID accountvalueobjects.AccountID,
+	transactionType transactionvalueobjects.TransactionType,
+	amount sharedvalueobjects.Money,
+	date time.Time,
+	description string,
+) TransactionFingerprint {
+	return TransactionFingerprint{
+		AccountID:   accountID,
+		Type:        transactionType,
+		Amount:      amount,
+		Date:        truncateToDay(date),
+		Description: NormalizeDescription(description),
+	}
+}
+
+// FingerprintOf creates the fingerprint of a saved transaction.
+func FingerprintOf(transaction *entities.Transaction) TransactionFingerprint {
+	fingerprint := NewTransactionFingerprint(
+		transaction.AccountID(),
+		transaction.TransactionType(),
+		transaction.Amount(),
+		transaction.Date(),
+		transaction.Description().Value(),
+	)
+	fingerprint.TransactionID = transaction.ID().Value()
+
+	if transaction.ParentTransactionID() != nil {
+		fingerprint.seriesID = transaction.ParentTransactionID().Value()
+	} else if transaction.IsRecurring() || transaction.IsInstallment() {
+		fingerprint.seriesID = transaction.ID().Value()
+	}
+	return fingerprint
+}
+
+// DuplicateMatch is a transaction that likely duplicates another one.
+type DuplicateMatch struct {
+	TransactionID string
+	Similarity    float64 // Description similarity, from 0 to 1
+	DaysApart     int
+}
+
+// DuplicatePair is a pair of saved transactions that likely are the same purchase.
+// Original is the one created first.
+type DuplicatePair struct {
+	Original   *entities.Transaction
+	Duplicate  *entities.Transaction
+	Similarity float64
+	DaysApart  int
+}
+
+// DuplicateDetector finds transactions that likely are the same real-world purchase entered twice,
+// e.g. typed in manually and later imported from the bank statement. Two transactions match when
+// they are in the same account, have the same type and amount, are at most a few days apart
+// (DefaultDuplicateDateWindowDays) and their normalized descriptions are similar enough
+// (DefaultDuplicateMinSimilarity).
+// Transfer legs are never matched: they are created in pairs on purpose. Neither are balance
+// adjustments, which correct the balance rather than record a purchase.
+type DuplicateDetector struct {
+	dateWindowDays int
+	minSimilarity  float64
+}
+
+// NewDuplicateDetector creates a DuplicateDetector with the default date window and similarity.
+func NewDuplicateDetector() *DuplicateDetector {
+	return &DuplicateDetector{
+		dateWindowDays: DefaultDuplicateDateWindowDays,
+		minSimilarity:  DefaultDuplicateMinSimilarity,
+	}
+}
+
+// DateWindowDays returns how many days apart two duplicates may be.
+func (d *DuplicateDetector) DateWindowDays() int {
+	return d.dateWindowDays
+}
+
+// Match compares two fingerprints and returns the match if they are likely duplicates.
+func (d *DuplicateDetector) Match(a, b TransactionFingerprint) (DuplicateMatch, bool) {
+	if a.TransactionID != "" && a.TransactionID == b.TransactionID {
+		return DuplicateMatch{}, false
+	}
+	if !a.AccountID.Equals(b.AccountID) || !a.Type.Equals(b.Type) || !a.Amount.Equals(b.Amount) {
+		return DuplicateMatch{}, false
+	}
+	if a.Type.IsTransfer() || a.Type.IsAdjustment() {
+		return DuplicateMatch{}, false
+	}
+	if a.seriesID != "" && a.seriesID == b.seriesID {
+		return DuplicateMatch{}, false
+	}
+
+	daysApart := int(a.Date.Sub(b.Date).Hours() / 24)
+	if daysApart < 0 {
+		daysApart = -daysApart
+	}
+	if daysApart > d.dateWindowDays {
+		return DuplicateMatch{}, false
+	}
+
+	similarity := DescriptionSimilarity(a.Description, b.Description)
+	if similarity < d.minSimilarity {
+		return DuplicateMatch{}, false
+	}
+
+	return DuplicateMatch{TransactionID: b.TransactionID, Similarity: similarity, DaysApart: daysApart}, true
+}
+
+// FindMatches returns the transactions among existing that likely duplicate the candidate,
+// best match first.
+func (d *DuplicateDetector) FindMatches(candidate TransactionFingerprint, existing []*entities.Transaction) []DuplicateMatch {
+	matches := []DuplicateMatch{}
+	for _, transaction := range existing {
+		if match, ok := d.Match(candidate, FingerprintOf(transaction)); ok {
+			matches = append(matches, match)
+		}
+	}
+	sortMatches(matches)
+	return matches
+}
+
+// FindPairs returns the likely duplicate pairs among the given transactions, most similar first.
+// Transactions are only compared with others of the same account, type and amount.
+func (d *DuplicateDetector) FindPairs(transactions []*entities.Transaction) []DuplicatePair {
+	groups := make(map[string][]*entities.Transaction)
+	for _, transaction := range transactions {
+		key := transaction.AccountID().Value() + "|" + transaction.TransactionType().Value() + "|" +
+			transaction.Amount().Currency().Code() + "|" + strconv.FormatInt(transaction.Amount().Amount(), 10)
+		groups[key] = append(groups[key], transaction)
+	}
+
+	pairs := []DuplicatePair{}
+	for _, group := range groups {
+		fingerprints := make([]TransactionFingerprint, len(group))
+		for i, transaction := range group {
+			fingerprints[i] = FingerprintOf(transaction)
+		}
+
+		for i := 0; i < len(group); i++ {
+			for j := i + 1; j < len(group); j++ {
+				match, ok := d.Match(fingerprints[i], fingerprints[j])
+				if !ok {
+					continue
+				}
+
+				original, duplicate := group[i], group[j]
+				if duplicate.CreatedAt().Before(original.CreatedAt()) {
+					original, duplicate = duplicate, original
+				}
+				pairs = append(pairs, DuplicatePair{
+					Original:   original,
+					Duplicate:  duplicate,
+					Similarity: match.Similarity,
+					DaysApart:  match.DaysApart,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		if pairs[i].Similarity != pairs[j].Similarity {
+			return pairs[i].Similarity > pairs[j].Similarity
+		}
+		if !pairs[i].Duplicate.Date().Equal(pairs[j].Duplicate.Date()) {
+			return pairs[i].Duplicate.Date().After(pairs[j].Duplicate.Date())
+		}
+		return pairs[i].Duplicate.ID().Value() < pairs[j].Duplicate.ID().Value()
+	})
+	return pairs
+}
+
+// NormalizeDescription lowercases a description, removes accents, punctuation and numbers
+// (card and document numbers, installment counters) and collapses spaces, so that
+// "COMPRA CARTÃO 1234 - PADARIA" and "compra cartao padaria" compare equal.
+func NormalizeDescription(description string) string {
+	description = transactionvalueobjects.FoldDescription(description)
+
+	words := strings.FieldsFunc(description, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := make([]string, 0, len(words))
+	for _, word := range words {
+		if strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue // Only digits
+		}
+		kept = append(kept, word)
+	}
+	return strings.Join(kept, " ")
+}
+
+// DescriptionSimilarity returns how alike two normalized descriptions are, from 0 to 1, as the
+// Sørensen-Dice coefficient of their character bigrams. A description contained in the other
+// (e.g. "padaria" and "padaria pao quente") counts as very similar.
+func DescriptionSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+
+	shorter, longer := a, b
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	if len(shorter) >= 4 && strings.Contains(longer, shorter) {
+		return 0.9
+	}
+
+	bigramsA := bigrams(a)
+	bigramsB := bigrams(b)
+	if len(bigramsA) == 0 || len(bigramsB) == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int, len(bigramsA))
+	for _, bigram := range bigramsA {
+		counts[bigram]++
+	}
+	common := 0
+	for _, bigram := range bigramsB {
+		if counts[bigram] > 0 {
+			counts[bigram]--
+			common++
+		}
+	}
+
+	return 2 * float64(common) / float64(len(bigramsA)+len(bigramsB))
+}
+
+// bigrams returns the character bigrams of each word of a description.
+func bigrams(description string) []string {
+	var result []string
+	for _, word := range strings.Fields(description) {
+		runes := []rune(word)
+		for i := 0; i < len(runes)-1; i++ {
+			result = append(result, string(runes[i:i+2]))
+		}
+	}
+	return result
+}
+
+// sortMatches orders matches by similarity, then by date distance.
+func sortMatches(matches []DuplicateMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Similarity != matches[j].Similarity {
+			return matches[i].Similarity > matches[j].Similarity
+		}
+		return matches[i].DaysApart < matches[j].DaysApart
+	})
+}
+
+// truncateToDay drops the time of day so dates compare by calendar day.
+func truncateToDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func newTestTransaction(t *testing.T, accountID accountvalueobjects.AccountID, cents int64, description string, date time.Time) *entities.Transaction {
+	t.Helper()
+
+	amount, _ := sharedvalueobjects.NewMoney(cents, sharedvalueobjects.MustCurrency("BRL"))
+	desc, _ := transactionvalueobjects.NewTransactionDescription(description)
+	transaction, err := entities.NewTransaction(identityvalueobjects.GenerateUserID(), accountID, transactionvalueobjects.ExpenseType(), amount, desc, date)
+	if err != nil {
+		t.Fatalf("failed to create transaction: %v", err)
+	}
+	return transaction
+}
+
+func TestNormalizeDescription(t *testing.T) {
+	tests := []struct {
+		description string
+		want        string
+	}{
+		{description: "COMPRA CARTÃO 1234 - PADARIA", want: "compra cartao padaria"},
+		{description: "  Farmácia  São João ", want: "farmacia sao joao"},
+		{description: "Parcela 03/10 Loja X2", want: "parcela loja x2"},
+		{description: "123 456", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeDescription(tt.description); got != tt.want {
+			t.Errorf("NormalizeDescription(%q) = %q, want %q", tt.description, got, tt.want)
+		}
+	}
+}
+
+func TestDescriptionSimilarity(t *testing.T) {
+	if got := DescriptionSimilarity("padaria", "padaria"); got != 1 {
+		t.Errorf("expected identical descriptions to have similarity 1, got %.2f", got)
+	}
+	if got := DescriptionSimilarity("padaria", "compra padaria pao quente"); got < DefaultDuplicateMinSimilarity {
+		t.Errorf("expected contained description to be similar, got %.2f", got)
+	}
+	if got := DescriptionSimilarity("supermercado extra", "supermercado extr"); got < DefaultDuplicateMinSimilarity {
+		t.Errorf("expected typo to be similar, got %.2f", got)
+	}
+	if got := DescriptionSimilarity("padaria", "posto ipiranga"); got >= DefaultDuplicateMinSimilarity {
+		t.Errorf("expected different descriptions not to be similar, got %.2f", got)
+	}
+	if got := DescriptionSimilarity("", "padaria"); got != 0 {
+		t.Errorf("expected empty description to have similarity 0, got %.2f", got)
+	}
+}
+
+func TestDuplicateDetector_Match(t *testing.T) {
+	detector := NewDuplicateDetector()
+	accountID := accountvalueobjects.GenerateAccountID()
+	date := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+	original := FingerprintOf(newTestTransaction(t, accountID, 4500, "Padaria Pão Quente", date))
+
+	tests := []struct {
+		name      string
+		candidate *entities.Transaction
+		want      bool
+	}{
+		{name: "same purchase posted two days later", candidate: newTestTransaction(t, accountID, 4500, "COMPRA CARTAO 1234 PADARIA PAO QUENTE", date.AddDate(0, 0, 2)), want: true},
+		{name: "outside the date window", candidate: newTestTransaction(t, accountID, 4500, "Padaria Pão Quente", date.AddDate(0, 0, 4)), want: false},
+		{name: "different amount", candidate: newTestTransaction(t, accountID, 4501, "Padaria Pão Quente", date), want: false},
+		{name: "different account", candidate: newTestTransaction(t, accountvalueobjects.GenerateAccountID(), 4500, "Padaria Pão Quente", date), want: false},
+		{name: "different description", candidate: newTestTransaction(t, accountID, 4500, "Posto Ipiranga", date), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, ok := detector.Match(original, FingerprintOf(tt.candidate))
+			if ok != tt.want {
+				t.Fatalf("Match() = %v, want %v (similarity %.2f)", ok, tt.want, match.Similarity)
+			}
+			if ok && match.TransactionID != tt.candidate.ID().Value() {
+				t.Errorf("Match() transaction = %s, want %s", match.TransactionID, tt.candidate.ID().Value())
+			}
+		})
+	}
+}
+
+func TestDuplicateDetector_FindPairs(t *testing.T) {
+	detector := NewDuplicateDetector()
+	accountID := accountvalueobjects.GenerateAccountID()
+	date := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+
+	manual := newTestTransaction(t, accountID, 4500, "Padaria", date)
+	time.Sleep(time.Millisecond)
+	imported := newTestTransaction(t, accountID, 4500, "PADARIA 0042", date.AddDate(0, 0, 1))
+	unrelated := newTestTransaction(t, accountID, 4500, "Posto Ipiranga", date)
+
+	pairs := detector.FindPairs([]*entities.Transaction{imported, unrelated, manual})
+	if len(pairs) != 1 {
+		t.Fatalf("FindPairs() = %d pairs, want 1", len(pairs))
+	}
+	if pairs[0].Original != manual || pairs[0].Duplicate != imported {
+		t.Errorf("FindPairs() expected the manual entry as original and the imported entry as duplicate")
+	}
+	if pairs[0].DaysApart != 1 {
+		t.Errorf("FindPairs() days apart = %d, want 1", pairs[0].DaysApart)
+	}
+}
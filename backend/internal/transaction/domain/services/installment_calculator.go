@@ -0,0 +1,67 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// MaxMonthlyInterestRate is the highest monthly interest rate accepted for installment purchases (in percent).
+const MaxMonthlyInterestRate = 30.0
+
+// CalculateInstallmentAmounts returns the amounts of a purchase of price paid in count monthly
+// installments with the given monthly interest rate (in percent, e.g. 1.99).
+//
+// Without interest ("sem juros") the installments add up exactly to the price; the leftover
+// cents go to the first installments, so R$ 100,00 in 3x is 33,34 + 33,33 + 33,33.
+// With interest the installments follow the Price table (equal payments of
+// price * i / (1 - (1 + i)^-count)), rounded to the cent; the rounding remainder goes to the
+// last installment, so the installments add up exactly to the financed total rounded to the cent.
+func CalculateInstallmentAmounts(
+	price sharedvalueobjects.Money,
+	count int,
+	monthlyInterestRate float64,
+) ([]sharedvalueobjects.Money, error) {
+	if !price.IsPositive() {
+		return nil, errors.New("installment purchase amount must be greater than zero")
+	}
+	if count < 2 || count > transactionvalueobjects.MaxInstallments {
+		return nil, fmt.Errorf("installment count must be between 2 and %d", transactionvalueobjects.MaxInstallments)
+	}
+	if monthlyInterestRate < 0 || monthlyInterestRate > MaxMonthlyInterestRate || math.IsNaN(monthlyInterestRate) {
+		return nil, fmt.Errorf("interest rate must be between 0 and %.0f%% a month", MaxMonthlyInterestRate)
+	}
+	if price.Amount() < int64(count) {
+		return nil, errors.New("installment purchase amount must be at least one cent per installment")
+	}
+
+	if monthlyInterestRate == 0 {
+		ratios := make([]int64, count)
+		for i := range ratios {
+			ratios[i] = 1
+		}
+		return price.Allocate(ratios)
+	}
+
+	rate := monthlyInterestRate / 100
+	payment := float64(price.Amount()) * rate / (1 - math.Pow(1+rate, -float64(count)))
+	total := int64(math.Round(payment * float64(count)))
+	installment, err := sharedvalueobjects.NewMoney(int64(math.Round(payment)), price.Currency())
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate installment amount: %w", err)
+	}
+	last, err := sharedvalueobjects.NewMoney(total-installment.Amount()*int64(count-1), price.Currency())
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate installment amount: %w", err)
+	}
+
+	amounts := make([]sharedvalueobjects.Money, count)
+	for i := range amounts {
+		amounts[i] = installment
+	}
+	amounts[count-1] = last
+	return amounts, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"testing"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestCalculateInstallmentAmounts(t *testing.T) {
+	brl := sharedvalueobjects.MustCurrency("BRL")
+
+	tests := []struct {
+		name      string
+		cents     int64
+		count     int
+		rate      float64
+		wantErr   bool
+		wantCents []int64
+		wantTotal int64 // Financed total rounded to the cent (with interest only)
+	}{
+		{
+			name:      "without interest splits leftover cents into the first installments",
+			cents:     10000,
+			count:     3,
+			rate:      0,
+			wantCents: []int64{3334, 3333, 3333},
+		},
+		{
+			name:      "without interest exact split",
+			cents:     120000,
+			count:     4,
+			rate:      0,
+			wantCents: []int64{30000, 30000, 30000, 30000},
+		},
+		{
+			name:      "with interest follows the Price table",
+			cents:     100000,
+			count:     10,
+			rate:      2,
+			wantCents: []int64{11133, 11133, 11133, 11133, 11133, 11133, 11133, 11133, 11133, 11130},
+			wantTotal: 111327,
+		},
+		{
+			name:      "with interest puts the rounding remainder on the last installment",
+			cents:     50000,
+			count:     12,
+			rate:      3.49,
+			wantCents: []int64{5171, 5171, 5171, 5171, 5171, 5171, 5171, 5171, 5171, 5171, 5171, 5173},
+			wantTotal: 62054,
+		},
+		{
+			name:    "single installment",
+			cents:   10000,
+			count:   1,
+			wantErr: true,
+		},
+		{
+			name:    "too many installments",
+			cents:   10000,
+			count:   49,
+			wantErr: true,
+		},
+		{
+			name:    "negative interest rate",
+			cents:   10000,
+			count:   3,
+			rate:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "interest rate above maximum",
+			cents:   10000,
+			count:   3,
+			rate:    MaxMonthlyInterestRate + 0.01,
+			wantErr: true,
+		},
+		{
+			name:    "less than one cent per installment",
+			cents:   2,
+			count:   3,
+			wantErr: true,
+		},
+		{
+			name:    "zero price",
+			cents:   0,
+			count:   3,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, _ := sharedvalueobjects.NewMoney(tt.cents, brl)
+			got, err := CalculateInstallmentAmounts(price, tt.count, tt.rate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CalculateInstallmentAmounts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.wantCents) {
+				t.Fatalf("expected %d installments, got %d", len(tt.wantCents), len(got))
+			}
+			var total int64
+			for i, amount := range got {
+				if amount.Amount() != tt.wantCents[i] {
+					t.Errorf("installment %d = %d cents, want %d", i+1, amount.Amount(), tt.wantCents[i])
+				}
+				total += amount.Amount()
+			}
+			if tt.wantTotal != 0 && total != tt.wantTotal {
+				t.Errorf("installments add up to %d cents, want %d", total, tt.wantTotal)
+			}
+		})
+	}
+}
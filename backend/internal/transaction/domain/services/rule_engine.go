@@ -0,0 +1,172 @@
+package services
+
+import (
+	"sort"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+// RuleOutcome is the combined effect of the rules that match a transaction.
+type RuleOutcome struct {
+	RuleIDs     []transactionvalueobjects.RuleID // Matching rules, in the order they ran
+	CategoryID  *categoryvalueobjects.CategoryID
+	TagIDs      []tagvalueobjects.TagID
+	Description *transactionvalueobjects.TransactionDescription
+}
+
+// Matched checks if any rule matched.
+func (o RuleOutcome) Matched() bool {
+	return len(o.RuleIDs) > 0
+}
+
+// RuleChange describes what applying the rules changed on a transaction.
+type RuleChange struct {
+	RuleIDs             []transactionvalueobjects.RuleID
+	PreviousCategoryID  *categoryvalueobjects.CategoryID
+	CategoryID          *categoryvalueobjects.CategoryID // Nil when the category did not change
+	AddedTagIDs         []tagvalueobjects.TagID
+	PreviousDescription string
+	Description         *transactionvalueobjects.TransactionDescription // Nil when the description did not change
+}
+
+// HasChanges checks if the transaction was changed.
+func (c RuleChange) HasChanges() bool {
+	return c.CategoryID != nil || len(c.AddedTagIDs) > 0 || c.Description != nil
+}
+
+// RuleEngine runs the transaction rules of a user in priority order (lowest first; rules with the
+// same priority run in creation order). Every matching rule adds its tags; the category and the
+// description are set by the first matching rule that defines them, so a more specific rule can
+// take precedence by getting a lower priority. Conditions are always checked against the original
+// description, not the one rewritten by an earlier rule.
+type RuleEngine struct {
+	rules []*entities.TransactionRule
+}
+
+// NewRuleEngine creates a RuleEngine for the given rules. Disabled rules are not filtered out,
+// so callers decide which rules run.
+func NewRuleEngine(rules []*entities.TransactionRule) *RuleEngine {
+	sorted := make([]*entities.TransactionRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority() != sorted[j].Priority() {
+			return sorted[i].Priority() < sorted[j].Priority()
+		}
+		return sorted[i].CreatedAt().Before(sorted[j].CreatedAt())
+	})
+
+	return &RuleEngine{rules: sorted}
+}
+
+// HasRules checks if the engine has any rule to run.
+func (e *RuleEngine) HasRules() bool {
+	return len(e.rules) > 0
+}
+
+// Evaluate returns the combined effect of the rules on a transaction with the given values.
+// Transfers and balance adjustments are never changed by rules.
+func (e *RuleEngine) Evaluate(
+	accountID accountvalueobjects.AccountID,
+	transactionType transactionvalueobjects.TransactionType,
+	amount sharedvalueobjects.Money,
+	description string,
+) RuleOutcome {
+	outcome := RuleOutcome{}
+	if transactionType.IsTransfer() || transactionType.IsAdjustment() {
+		return outcome
+	}
+
+	seenTags := make(map[string]bool)
+	for _, rule := range e.rules {
+		if !rule.Conditions().Matches(accountID, transactionType, amount.Amount(), description) {
+			continue
+		}
+		outcome.RuleIDs = append(outcome.RuleIDs, rule.ID())
+
+		actions := rule.Actions()
+		if outcome.CategoryID == nil && actions.CategoryID() != nil {
+			outcome.CategoryID = actions.CategoryID()
+		}
+		if outcome.Description == nil && actions.Description() != nil {
+			outcome.Description = actions.Description()
+		}
+		for _, tagID := range actions.TagIDs() {
+			if !seenTags[tagID.Value()] {
+				seenTags[tagID.Value()] = true
+				outcome.TagIDs = append(outcome.TagIDs, tagID)
+			}
+		}
+	}
+
+	return outcome
+}
+
+// Plan returns what the rules would change on a transaction, without changing it. The category is
+// only set when the transaction has none (or overwriteCategory is set) and is not split; tags are
+// added to the existing ones.
+func (e *RuleEngine) Plan(transaction *entities.Transaction, overwriteCategory bool) RuleChange {
+	outcome := e.Evaluate(
+		transaction.AccountID(),
+		transaction.TransactionType(),
+		transaction.Amount(),
+		transaction.Description().Value(),
+	)
+
+	change := RuleChange{
+		RuleIDs:             outcome.RuleIDs,
+		PreviousCategoryID:  transaction.CategoryID(),
+		PreviousDescription: transaction.Description().Value(),
+	}
+	if !outcome.Matched() {
+		return change
+	}
+
+	if outcome.CategoryID != nil && !transaction.HasSplits() && (!transaction.HasCategory() || overwriteCategory) {
+		current := transaction.CategoryID()
+		if current == nil || !current.Equals(*outcome.CategoryID) {
+			change.CategoryID = outcome.CategoryID
+		}
+	}
+
+	for _, tagID := range outcome.TagIDs {
+		if !transaction.HasTag(tagID) {
+			change.AddedTagIDs = append(change.AddedTagIDs, tagID)
+		}
+	}
+
+	if outcome.Description != nil && !outcome.Description.Equals(transaction.Description()) {
+		change.Description = outcome.Description
+	}
+
+	return change
+}
+
+// Apply runs the rules on a transaction and changes it as described by Plan.
+func (e *RuleEngine) Apply(transaction *entities.Transaction, overwriteCategory bool) (RuleChange, error) {
+	change := e.Plan(transaction, overwriteCategory)
+
+	if change.CategoryID != nil {
+		if err := transaction.UpdateCategory(change.CategoryID); err != nil {
+			return RuleChange{}, err
+		}
+	}
+
+	if len(change.AddedTagIDs) > 0 {
+		if err := transaction.UpdateTags(append(transaction.TagIDs(), change.AddedTagIDs...)); err != nil {
+			return RuleChange{}, err
+		}
+	}
+
+	if change.Description != nil {
+		if err := transaction.UpdateDescription(*change.Description); err != nil {
+			return RuleChange{}, err
+		}
+	}
+
+	return change, nil
+}
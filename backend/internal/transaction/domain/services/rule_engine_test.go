@@ -0,0 +1,135 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func newTestRule(
+	t *testing.T,
+	priority int,
+	pattern string,
+	categoryID *categoryvalueobjects.CategoryID,
+	tagIDs []tagvalueobjects.TagID,
+	description string,
+) *entities.TransactionRule {
+	t.Helper()
+
+	conditions, err := transactionvalueobjects.NewRuleConditions("", pattern, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create rule conditions: %v", err)
+	}
+
+	var desc *transactionvalueobjects.TransactionDescription
+	if description != "" {
+		parsed, _ := transactionvalueobjects.NewTransactionDescription(description)
+		desc = &parsed
+	}
+	actions, err := transactionvalueobjects.NewRuleActions(categoryID, tagIDs, desc)
+	if err != nil {
+		t.Fatalf("failed to create rule actions: %v", err)
+	}
+
+	rule, err := entities.NewTransactionRule(identityvalueobjects.GenerateUserID(), "Regra "+pattern, priority, conditions, actions)
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+	return rule
+}
+
+func TestRuleEngine_Evaluate(t *testing.T) {
+	food := categoryvalueobjects.GenerateCategoryID()
+	transport := categoryvalueobjects.GenerateCategoryID()
+	work := tagvalueobjects.GenerateTagID()
+	refundable := tagvalueobjects.GenerateTagID()
+
+	generic := newTestRule(t, 20, "uber", &transport, []tagvalueobjects.TagID{work}, "Uber")
+	specific := newTestRule(t, 10, "uber eats", &food, []tagvalueobjects.TagID{refundable}, "")
+	engine := NewRuleEngine([]*entities.TransactionRule{generic, specific})
+
+	transaction := newTestTransaction(t, accountvalueobjects.GenerateAccountID(), 4590, "UBER EATS *PEDIDO", time.Now())
+	outcome := engine.Evaluate(transaction.AccountID(), transaction.TransactionType(), transaction.Amount(), transaction.Description().Value())
+
+	if len(outcome.RuleIDs) != 2 || !outcome.RuleIDs[0].Equals(specific.ID()) {
+		t.Fatalf("expected both rules to match, the lower priority first, got %v", outcome.RuleIDs)
+	}
+	if outcome.CategoryID == nil || !outcome.CategoryID.Equals(food) {
+		t.Errorf("expected category of the first matching rule, got %v", outcome.CategoryID)
+	}
+	if len(outcome.TagIDs) != 2 {
+		t.Errorf("expected tags of every matching rule, got %d", len(outcome.TagIDs))
+	}
+	if outcome.Description == nil || outcome.Description.Value() != "Uber" {
+		t.Errorf("expected description of the only rule that defines one, got %v", outcome.Description)
+	}
+
+	transfer := transactionvalueobjects.MustTransactionType(transactionvalueobjects.TransferOut)
+	if engine.Evaluate(transaction.AccountID(), transfer, transaction.Amount(), "UBER").Matched() {
+		t.Error("expected transfers never to match")
+	}
+}
+
+func TestRuleEngine_PlanAndApply(t *testing.T) {
+	food := categoryvalueobjects.GenerateCategoryID()
+	existing := categoryvalueobjects.GenerateCategoryID()
+	tagID := tagvalueobjects.GenerateTagID()
+	engine := NewRuleEngine([]*entities.TransactionRule{
+		newTestRule(t, 0, "padaria", &food, []tagvalueobjects.TagID{tagID}, "Padaria"),
+	})
+
+	t.Run("plan does not change the transaction", func(t *testing.T) {
+		transaction := newTestTransaction(t, accountvalueobjects.GenerateAccountID(), 1250, "COMPRA PADARIA 1234", time.Now())
+
+		change := engine.Plan(transaction, false)
+		if !change.HasChanges() || change.CategoryID == nil || len(change.AddedTagIDs) != 1 || change.Description == nil {
+			t.Fatalf("expected category, tag and description changes, got %+v", change)
+		}
+		if transaction.HasCategory() || transaction.HasTag(tagID) || transaction.Description().Value() != "COMPRA PADARIA 1234" {
+			t.Error("expected Plan to leave the transaction unchanged")
+		}
+	})
+
+	t.Run("apply keeps an existing category", func(t *testing.T) {
+		transaction := newTestTransaction(t, accountvalueobjects.GenerateAccountID(), 1250, "COMPRA PADARIA 1234", time.Now())
+		_ = transaction.UpdateCategory(&existing)
+
+		change, err := engine.Apply(transaction, false)
+		if err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		if change.CategoryID != nil || !transaction.CategoryID().Equals(existing) {
+			t.Errorf("expected existing category to be kept, got %v", transaction.CategoryID())
+		}
+		if !transaction.HasTag(tagID) || transaction.Description().Value() != "Padaria" {
+			t.Error("expected tag and description to be applied")
+		}
+
+		// Running again changes nothing
+		again, err := engine.Apply(transaction, false)
+		if err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		if again.HasChanges() {
+			t.Errorf("expected no changes on the second run, got %+v", again)
+		}
+	})
+
+	t.Run("apply overwrites the category when asked", func(t *testing.T) {
+		transaction := newTestTransaction(t, accountvalueobjects.GenerateAccountID(), 1250, "PADARIA", time.Now())
+		_ = transaction.UpdateCategory(&existing)
+
+		if _, err := engine.Apply(transaction, true); err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		if !transaction.CategoryID().Equals(food) {
+			t.Errorf("expected category to be overwritten, got %v", transaction.CategoryID())
+		}
+	})
+}
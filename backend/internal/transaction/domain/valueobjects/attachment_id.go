@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentID represents a transaction attachment identifier value object.
+type AttachmentID struct {
+	value string
+}
+
+// NewAttachmentID creates a new AttachmentID from a string.
+func NewAttachmentID(id string) (AttachmentID, error) {
+	if id == "" {
+		return AttachmentID{}, errors.New("attachment ID cannot be empty")
+	}
+
+	// Validate UUID format
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return AttachmentID{}, errors.New("invalid attachment ID format (must be UUID)")
+	}
+
+	return AttachmentID{value: id}, nil
+}
+
+// GenerateAttachmentID generates a new AttachmentID.
+func GenerateAttachmentID() AttachmentID {
+	return AttachmentID{value: uuid.New().String()}
+}
+
+// MustAttachmentID creates a new AttachmentID and panics if invalid.
+// Use this only when you are certain the ID is valid (e.g., in tests).
+func MustAttachmentID(id string) AttachmentID {
+	aid, err := NewAttachmentID(id)
+	if err != nil {
+		panic(err)
+	}
+	return aid
+}
+
+// Value returns the attachment ID as a string.
+func (aid AttachmentID) Value() string {
+	return aid.value
+}
+
+// String returns the attachment ID as a string (implements fmt.Stringer).
+func (aid AttachmentID) String() string {
+	return aid.value
+}
+
+// Equals checks if two AttachmentID values are equal.
+func (aid AttachmentID) Equals(other AttachmentID) bool {
+	return aid.value == other.value
+}
+
+// IsEmpty checks if the attachment ID is empty.
+func (aid AttachmentID) IsEmpty() bool {
+	return aid.value == ""
+}
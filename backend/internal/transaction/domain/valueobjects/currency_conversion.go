@@ -0,0 +1,149 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// exchangeRateScale is the precision exchange rates are rounded to (8 decimal places).
+const exchangeRateScale = 1e8
+
+// CurrencyConversion represents the conversion of a transaction made in a foreign currency
+// into the currency of its account, e.g. a 100.00 USD purchase charged as 543.21 BRL on a BRL card.
+// The converted amount is what moves the account balance; the original amount and the exchange
+// rate are kept for reference.
+type CurrencyConversion struct {
+	originalAmount  sharedvalueobjects.Money
+	exchangeRate    float64
+	convertedAmount sharedvalueobjects.Money
+}
+
+// NewCurrencyConversion creates a new CurrencyConversion value object by converting the original
+// amount into the target currency at the given exchange rate (units of the target currency per
+// unit of the original currency). The converted amount is rounded to the nearest cent.
+func NewCurrencyConversion(
+	originalAmount sharedvalueobjects.Money,
+	exchangeRate float64,
+	target sharedvalueobjects.Currency,
+) (CurrencyConversion, error) {
+	if err := validateConversionCurrencies(originalAmount, target); err != nil {
+		return CurrencyConversion{}, err
+	}
+
+	if math.IsNaN(exchangeRate) || math.IsInf(exchangeRate, 0) || exchangeRate <= 0 {
+		return CurrencyConversion{}, errors.New("exchange rate must be greater than zero")
+	}
+	exchangeRate = roundExchangeRate(exchangeRate)
+	if exchangeRate == 0 {
+		return CurrencyConversion{}, errors.New("exchange rate must be greater than zero")
+	}
+
+	cents := int64(math.Round(float64(originalAmount.Amount()) * exchangeRate))
+	convertedAmount, _ := sharedvalueobjects.NewMoney(cents, target)
+	if !convertedAmount.IsPositive() {
+		return CurrencyConversion{}, errors.New("converted amount must be greater than zero")
+	}
+
+	return CurrencyConversion{
+		originalAmount:  originalAmount,
+		exchangeRate:    exchangeRate,
+		convertedAmount: convertedAmount,
+	}, nil
+}
+
+// NewCurrencyConversionFromAmounts creates a new CurrencyConversion value object from the
+// original amount and the amount actually charged in the account currency, as printed on a
+// card statement. The exchange rate is derived from both amounts.
+func NewCurrencyConversionFromAmounts(
+	originalAmount sharedvalueobjects.Money,
+	convertedAmount sharedvalueobjects.Money,
+) (CurrencyConversion, error) {
+	if err := validateConversionCurrencies(originalAmount, convertedAmount.Currency()); err != nil {
+		return CurrencyConversion{}, err
+	}
+
+	if !convertedAmount.IsPositive() {
+		return CurrencyConversion{}, errors.New("converted amount must be greater than zero")
+	}
+
+	return CurrencyConversion{
+		originalAmount:  originalAmount,
+		exchangeRate:    roundExchangeRate(float64(convertedAmount.Amount()) / float64(originalAmount.Amount())),
+		convertedAmount: convertedAmount,
+	}, nil
+}
+
+// CurrencyConversionFromPersistence reconstructs a CurrencyConversion value object from
+// persisted data, keeping the stored exchange rate as is.
+func CurrencyConversionFromPersistence(
+	originalAmount sharedvalueobjects.Money,
+	exchangeRate float64,
+	convertedAmount sharedvalueobjects.Money,
+) (CurrencyConversion, error) {
+	if err := validateConversionCurrencies(originalAmount, convertedAmount.Currency()); err != nil {
+		return CurrencyConversion{}, err
+	}
+
+	if exchangeRate <= 0 {
+		return CurrencyConversion{}, errors.New("exchange rate must be greater than zero")
+	}
+
+	return CurrencyConversion{
+		originalAmount:  originalAmount,
+		exchangeRate:    exchangeRate,
+		convertedAmount: convertedAmount,
+	}, nil
+}
+
+// OriginalAmount returns the amount in the currency the transaction was made in.
+func (c CurrencyConversion) OriginalAmount() sharedvalueobjects.Money {
+	return c.originalAmount
+}
+
+// ExchangeRate returns the units of the account currency paid per unit of the original currency.
+func (c CurrencyConversion) ExchangeRate() float64 {
+	return c.exchangeRate
+}
+
+// ConvertedAmount returns the amount in the account currency.
+func (c CurrencyConversion) ConvertedAmount() sharedvalueobjects.Money {
+	return c.convertedAmount
+}
+
+// WithConvertedAmount returns a copy of the conversion with a corrected converted amount.
+// The original amount is kept and the exchange rate is derived again from both amounts.
+func (c CurrencyConversion) WithConvertedAmount(convertedAmount sharedvalueobjects.Money) (CurrencyConversion, error) {
+	return NewCurrencyConversionFromAmounts(c.originalAmount, convertedAmount)
+}
+
+// Equals checks if two CurrencyConversion values are equal.
+func (c CurrencyConversion) Equals(other CurrencyConversion) bool {
+	return c.originalAmount.Equals(other.originalAmount) &&
+		c.exchangeRate == other.exchangeRate &&
+		c.convertedAmount.Equals(other.convertedAmount)
+}
+
+// String returns the conversion in the "100.00 USD @ 5.4321 = 543.21 BRL" format.
+func (c CurrencyConversion) String() string {
+	return fmt.Sprintf("%s @ %g = %s", c.originalAmount.String(), c.exchangeRate, c.convertedAmount.String())
+}
+
+// validateConversionCurrencies checks that the original amount is positive and in a currency
+// other than the target currency.
+func validateConversionCurrencies(originalAmount sharedvalueobjects.Money, target sharedvalueobjects.Currency) error {
+	if !originalAmount.IsPositive() {
+		return errors.New("original amount must be greater than zero")
+	}
+	if originalAmount.Currency().Equals(target) {
+		return fmt.Errorf("original currency must differ from the account currency %s", target.Code())
+	}
+	return nil
+}
+
+// roundExchangeRate rounds an exchange rate to 8 decimal places.
+func roundExchangeRate(rate float64) float64 {
+	return math.Round(rate*exchangeRateScale) / exchangeRateScale
+}
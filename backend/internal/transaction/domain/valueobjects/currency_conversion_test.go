@@ -0,0 +1,151 @@
+package valueobjects
+
+import (
+	"testing"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestNewCurrencyConversion(t *testing.T) {
+	brl := sharedvalueobjects.MustCurrency("BRL")
+	usd := sharedvalueobjects.MustCurrency("USD")
+
+	tests := []struct {
+		name          string
+		originalCents int64
+		originalCode  string
+		exchangeRate  float64
+		wantErr       bool
+		wantCents     int64
+	}{
+		{
+			name:          "dollar purchase",
+			originalCents: 10000,
+			originalCode:  "USD",
+			exchangeRate:  5.4321,
+			wantErr:       false,
+			wantCents:     54321,
+		},
+		{
+			name:          "converted amount rounded to the nearest cent",
+			originalCents: 1999,
+			originalCode:  "EUR",
+			exchangeRate:  6.1234,
+			wantErr:       false,
+			wantCents:     12241, // 12240.68
+		},
+		{
+			name:          "same currency as the account",
+			originalCents: 10000,
+			originalCode:  "BRL",
+			exchangeRate:  1,
+			wantErr:       true,
+		},
+		{
+			name:          "zero exchange rate",
+			originalCents: 10000,
+			originalCode:  "USD",
+			exchangeRate:  0,
+			wantErr:       true,
+		},
+		{
+			name:          "negative exchange rate",
+			originalCents: 10000,
+			originalCode:  "USD",
+			exchangeRate:  -5.43,
+			wantErr:       true,
+		},
+		{
+			name:          "zero original amount",
+			originalCents: 0,
+			originalCode:  "USD",
+			exchangeRate:  5.43,
+			wantErr:       true,
+		},
+		{
+			name:          "converted amount rounds to zero",
+			originalCents: 1,
+			originalCode:  "USD",
+			exchangeRate:  0.001,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original, _ := sharedvalueobjects.NewMoneyFromString(tt.originalCents, tt.originalCode)
+			got, err := NewCurrencyConversion(original, tt.exchangeRate, brl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCurrencyConversion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.ConvertedAmount().Amount() != tt.wantCents || !got.ConvertedAmount().Currency().Equals(brl) {
+				t.Errorf("NewCurrencyConversion() converted = %s, want %d cents in BRL", got.ConvertedAmount().String(), tt.wantCents)
+			}
+			if !got.OriginalAmount().Equals(original) {
+				t.Errorf("NewCurrencyConversion() original = %s, want %s", got.OriginalAmount().String(), original.String())
+			}
+		})
+	}
+
+	// The exchange rate is kept with 8 decimal places
+	original, _ := sharedvalueobjects.NewMoney(10000, usd)
+	conversion, err := NewCurrencyConversion(original, 5.123456789, brl)
+	if err != nil {
+		t.Fatalf("NewCurrencyConversion() error = %v", err)
+	}
+	if conversion.ExchangeRate() != 5.12345679 {
+		t.Errorf("NewCurrencyConversion() rate = %v, want 5.12345679", conversion.ExchangeRate())
+	}
+}
+
+func TestNewCurrencyConversionFromAmounts(t *testing.T) {
+	usd := sharedvalueobjects.MustCurrency("USD")
+	brl := sharedvalueobjects.MustCurrency("BRL")
+
+	original, _ := sharedvalueobjects.NewMoney(2000, usd)
+	charged, _ := sharedvalueobjects.NewMoney(10950, brl)
+
+	conversion, err := NewCurrencyConversionFromAmounts(original, charged)
+	if err != nil {
+		t.Fatalf("NewCurrencyConversionFromAmounts() error = %v", err)
+	}
+	if conversion.ExchangeRate() != 5.475 {
+		t.Errorf("NewCurrencyConversionFromAmounts() rate = %v, want 5.475", conversion.ExchangeRate())
+	}
+	if !conversion.ConvertedAmount().Equals(charged) {
+		t.Errorf("NewCurrencyConversionFromAmounts() converted = %s, want %s", conversion.ConvertedAmount().String(), charged.String())
+	}
+
+	zero := sharedvalueobjects.Zero(brl)
+	if _, err := NewCurrencyConversionFromAmounts(original, zero); err == nil {
+		t.Error("NewCurrencyConversionFromAmounts() should fail with a zero converted amount")
+	}
+	sameCurrency, _ := sharedvalueobjects.NewMoney(2000, usd)
+	if _, err := NewCurrencyConversionFromAmounts(original, sameCurrency); err == nil {
+		t.Error("NewCurrencyConversionFromAmounts() should fail when both amounts use the same currency")
+	}
+}
+
+func TestCurrencyConversion_WithConvertedAmount(t *testing.T) {
+	usd := sharedvalueobjects.MustCurrency("USD")
+	brl := sharedvalueobjects.MustCurrency("BRL")
+
+	original, _ := sharedvalueobjects.NewMoney(10000, usd)
+	conversion, _ := NewCurrencyConversion(original, 5.5, brl)
+
+	corrected, _ := sharedvalueobjects.NewMoney(56000, brl)
+	updated, err := conversion.WithConvertedAmount(corrected)
+	if err != nil {
+		t.Fatalf("WithConvertedAmount() error = %v", err)
+	}
+	if !updated.OriginalAmount().Equals(original) || updated.ExchangeRate() != 5.6 {
+		t.Errorf("WithConvertedAmount() = %s, want the original amount kept at a 5.6 rate", updated.String())
+	}
+	if conversion.Equals(updated) {
+		t.Error("WithConvertedAmount() should not change the original conversion")
+	}
+}
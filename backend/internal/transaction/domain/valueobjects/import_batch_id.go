@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ImportBatchID represents an import batch identifier value object.
+type ImportBatchID struct {
+	value string
+}
+
+// NewImportBatchID creates a new ImportBatchID from a string.
+func NewImportBatchID(id string) (ImportBatchID, error) {
+	if id == "" {
+		return ImportBatchID{}, errors.New("import batch ID cannot be empty")
+	}
+
+	// Validate UUID format
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return ImportBatchID{}, errors.New("invalid import batch ID format (must be UUID)")
+	}
+
+	return ImportBatchID{value: id}, nil
+}
+
+// GenerateImportBatchID generates a new ImportBatchID.
+func GenerateImportBatchID() ImportBatchID {
+	return ImportBatchID{value: uuid.New().String()}
+}
+
+// MustImportBatchID creates a new ImportBatchID and panics if invalid.
+// Use this only when you are certain the ID is valid (e.g., in tests).
+func MustImportBatchID(id string) ImportBatchID {
+	bid, err := NewImportBatchID(id)
+	if err != nil {
+		panic(err)
+	}
+	return bid
+}
+
+// Value returns the import batch ID as a string.
+func (bid ImportBatchID) Value() string {
+	return bid.value
+}
+
+// String returns the import batch ID as a string (implements fmt.Stringer).
+func (bid ImportBatchID) String() string {
+	return bid.value
+}
+
+// Equals checks if two ImportBatchID values are equal.
+func (bid ImportBatchID) Equals(other ImportBatchID) bool {
+	return bid.value == other.value
+}
+
+// IsEmpty checks if the import batch ID is empty.
+func (bid ImportBatchID) IsEmpty() bool {
+	return bid.value == ""
+}
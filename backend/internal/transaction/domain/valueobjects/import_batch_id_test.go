@@ -0,0 +1,132 @@
+package valueobjects
+
+import (
+	"testing"
+)
+
+func TestNewImportBatchID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{
+			name:    "valid UUID",
+			id:      "550e8400-e29b-41d4-a716-446655440000",
+			wantErr: false,
+		},
+		{
+			name:    "empty string",
+			id:      "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid UUID format",
+			id:      "invalid-uuid",
+			wantErr: true,
+		},
+		{
+			name:    "invalid UUID with spaces",
+			id:      "550e8400-e29b-41d4-a716-446655440000 ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewImportBatchID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewImportBatchID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if got.IsEmpty() {
+					t.Error("NewImportBatchID() returned empty ImportBatchID")
+				}
+				if got.Value() != tt.id {
+					t.Errorf("NewImportBatchID() value = %v, want %v", got.Value(), tt.id)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateImportBatchID(t *testing.T) {
+	bid1 := GenerateImportBatchID()
+	bid2 := GenerateImportBatchID()
+
+	if bid1.IsEmpty() {
+		t.Error("GenerateImportBatchID() returned empty ImportBatchID")
+	}
+
+	if bid2.IsEmpty() {
+		t.Error("GenerateImportBatchID() returned empty ImportBatchID")
+	}
+
+	if bid1.Equals(bid2) {
+		t.Error("GenerateImportBatchID() should generate unique IDs")
+	}
+}
+
+func TestMustImportBatchID(t *testing.T) {
+	validID := "550e8400-e29b-41d4-a716-446655440000"
+	bid := MustImportBatchID(validID)
+
+	if bid.Value() != validID {
+		t.Errorf("MustImportBatchID() value = %v, want %v", bid.Value(), validID)
+	}
+
+	// Test panic with invalid ID
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustImportBatchID() should panic with invalid ID")
+		}
+	}()
+	MustImportBatchID("invalid-uuid")
+}
+
+func TestImportBatchID_Value(t *testing.T) {
+	id := "550e8400-e29b-41d4-a716-446655440000"
+	bid, _ := NewImportBatchID(id)
+
+	if bid.Value() != id {
+		t.Errorf("ImportBatchID.Value() = %v, want %v", bid.Value(), id)
+	}
+}
+
+func TestImportBatchID_String(t *testing.T) {
+	id := "550e8400-e29b-41d4-a716-446655440000"
+	bid, _ := NewImportBatchID(id)
+
+	if bid.String() != id {
+		t.Errorf("ImportBatchID.String() = %v, want %v", bid.String(), id)
+	}
+}
+
+func TestImportBatchID_Equals(t *testing.T) {
+	id := "550e8400-e29b-41d4-a716-446655440000"
+	bid1, _ := NewImportBatchID(id)
+	bid2, _ := NewImportBatchID(id)
+	bid3 := GenerateImportBatchID()
+
+	if !bid1.Equals(bid2) {
+		t.Error("ImportBatchID.Equals() = false, want true for same IDs")
+	}
+
+	if bid1.Equals(bid3) {
+		t.Error("ImportBatchID.Equals() = true, want false for different IDs")
+	}
+}
+
+func TestImportBatchID_IsEmpty(t *testing.T) {
+	bid, _ := NewImportBatchID("550e8400-e29b-41d4-a716-446655440000")
+	emptyTid := ImportBatchID{}
+
+	if bid.IsEmpty() {
+		t.Error("ImportBatchID.IsEmpty() = true, want false for non-empty ID")
+	}
+
+	if !emptyTid.IsEmpty() {
+		t.Error("ImportBatchID.IsEmpty() = false, want true for empty ID")
+	}
+}
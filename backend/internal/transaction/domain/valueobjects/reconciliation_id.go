@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ReconciliationID represents a reconciliation identifier value object.
+type ReconciliationID struct {
+	value string
+}
+
+// NewReconciliationID creates a new ReconciliationID from a string.
+func NewReconciliationID(id string) (ReconciliationID, error) {
+	if id == "" {
+		return ReconciliationID{}, errors.New("reconciliation ID cannot be empty")
+	}
+
+	// Validate UUID format
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return ReconciliationID{}, errors.New("invalid reconciliation ID format (must be UUID)")
+	}
+
+	return ReconciliationID{value: id}, nil
+}
+
+// GenerateReconciliationID generates a new ReconciliationID.
+func GenerateReconciliationID() ReconciliationID {
+	return ReconciliationID{value: uuid.New().String()}
+}
+
+// MustReconciliationID creates a new ReconciliationID and panics if invalid.
+// Use this only when you are certain the ID is valid (e.g., in tests).
+func MustReconciliationID(id string) ReconciliationID {
+	rid, err := NewReconciliationID(id)
+	if err != nil {
+		panic(err)
+	}
+	return rid
+}
+
+// Value returns the reconciliation ID as a string.
+func (rid ReconciliationID) Value() string {
+	return rid.value
+}
+
+// String returns the reconciliation ID as a string (implements fmt.Stringer).
+func (rid ReconciliationID) String() string {
+	return rid.value
+}
+
+// Equals checks if two ReconciliationID values are equal.
+func (rid ReconciliationID) Equals(other ReconciliationID) bool {
+	return rid.value == other.value
+}
+
+// IsEmpty checks if the reconciliation ID is empty.
+func (rid ReconciliationID) IsEmpty() bool {
+	return rid.value == ""
+}
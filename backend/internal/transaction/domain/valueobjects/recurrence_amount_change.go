@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+	"time"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// RecurrenceAmountChange represents a change of the amount of a recurring series, applied to
+// the occurrences on or after its effective date ("this and future occurrences").
+type RecurrenceAmountChange struct {
+	effectiveDate time.Time
+	amount        sharedvalueobjects.Money
+}
+
+// NewRecurrenceAmountChange creates a new RecurrenceAmountChange value object.
+// The effective date is truncated to the day.
+func NewRecurrenceAmountChange(effectiveDate time.Time, amount sharedvalueobjects.Money) (RecurrenceAmountChange, error) {
+	if effectiveDate.IsZero() {
+		return RecurrenceAmountChange{}, errors.New("recurrence amount change date cannot be zero")
+	}
+
+	if !amount.IsPositive() {
+		return RecurrenceAmountChange{}, errors.New("recurrence amount must be greater than zero")
+	}
+
+	return RecurrenceAmountChange{
+		effectiveDate: time.Date(effectiveDate.Year(), effectiveDate.Month(), effectiveDate.Day(), 0, 0, 0, 0, effectiveDate.Location()),
+		amount:        amount,
+	}, nil
+}
+
+// EffectiveDate returns the date of the first occurrence with the new amount.
+func (c RecurrenceAmountChange) EffectiveDate() time.Time {
+	return c.effectiveDate
+}
+
+// Amount returns the new amount of the occurrences.
+func (c RecurrenceAmountChange) Amount() sharedvalueobjects.Money {
+	return c.amount
+}
@@ -0,0 +1,56 @@
+package valueobjects
+
+import (
+	"testing"
+	"time"
+
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+func TestNewRecurrenceAmountChange(t *testing.T) {
+	amount, _ := sharedvalueobjects.NewMoney(185000, sharedvalueobjects.MustCurrency("BRL"))
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		amount  sharedvalueobjects.Money
+		wantErr bool
+	}{
+		{
+			name:    "valid change",
+			date:    time.Date(2027, 1, 5, 14, 30, 0, 0, time.UTC),
+			amount:  amount,
+			wantErr: false,
+		},
+		{
+			name:    "zero date",
+			date:    time.Time{},
+			amount:  amount,
+			wantErr: true,
+		},
+		{
+			name:    "zero amount",
+			date:    time.Date(2027, 1, 5, 0, 0, 0, 0, time.UTC),
+			amount:  sharedvalueobjects.Zero(sharedvalueobjects.MustCurrency("BRL")),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, err := NewRecurrenceAmountChange(tt.date, tt.amount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRecurrenceAmountChange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := change.EffectiveDate(); !got.Equal(time.Date(2027, 1, 5, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("EffectiveDate() = %v, want the day of the change", got)
+			}
+			if !change.Amount().Equals(tt.amount) {
+				t.Errorf("Amount() = %s, want %s", change.Amount().String(), tt.amount.String())
+			}
+		})
+	}
+}
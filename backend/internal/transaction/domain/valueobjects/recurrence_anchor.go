@@ -0,0 +1,121 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RecurrenceAnchor represents how the day of the month of the occurrences of a monthly or yearly
+// recurring series is chosen, relative to its first occurrence.
+type RecurrenceAnchor struct {
+	value string
+}
+
+// Valid recurrence anchor values
+const (
+	DayOfMonthAnchor     = "DAY_OF_MONTH"      // Same day of the month, or the last day of shorter months
+	LastDayOfMonthAnchor = "LAST_DAY_OF_MONTH" // Last day of every month
+	NthWeekdayAnchor     = "NTH_WEEKDAY"       // Same weekday and week of the month, e.g. the second Tuesday
+	LastWeekdayAnchor    = "LAST_WEEKDAY"      // Last occurrence of the same weekday, e.g. the last Friday
+)
+
+// ValidRecurrenceAnchors is a map of all supported recurrence anchors.
+var ValidRecurrenceAnchors = map[string]string{
+	DayOfMonthAnchor:     "Day of month",
+	LastDayOfMonthAnchor: "Last day of month",
+	NthWeekdayAnchor:     "Nth weekday of month",
+	LastWeekdayAnchor:    "Last weekday of month",
+}
+
+// NewRecurrenceAnchor creates a new RecurrenceAnchor value object.
+func NewRecurrenceAnchor(value string) (RecurrenceAnchor, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	if _, exists := ValidRecurrenceAnchors[value]; !exists {
+		return RecurrenceAnchor{}, fmt.Errorf("invalid recurrence anchor: %s. Supported values: DAY_OF_MONTH, LAST_DAY_OF_MONTH, NTH_WEEKDAY, LAST_WEEKDAY", value)
+	}
+
+	return RecurrenceAnchor{value: value}, nil
+}
+
+// Value returns the recurrence anchor value.
+func (a RecurrenceAnchor) Value() string {
+	return a.value
+}
+
+// String returns the recurrence anchor value as a string.
+func (a RecurrenceAnchor) String() string {
+	return a.value
+}
+
+// IsDayOfMonth checks if the anchor keeps the day of the month of the first occurrence.
+func (a RecurrenceAnchor) IsDayOfMonth() bool {
+	return a.value == DayOfMonthAnchor
+}
+
+// Equals checks if two RecurrenceAnchor values are equal.
+func (a RecurrenceAnchor) Equals(other RecurrenceAnchor) bool {
+	return a.value == other.value
+}
+
+// ValidateFirst checks that the first occurrence of a series falls on a day matching the anchor.
+func (a RecurrenceAnchor) ValidateFirst(first time.Time) error {
+	lastDay := daysIn(first.Year(), first.Month())
+
+	switch a.value {
+	case LastDayOfMonthAnchor:
+		if first.Day() != lastDay {
+			return fmt.Errorf("invalid recurrence anchor: the first occurrence (%s) is not the last day of its month", first.Format("2006-01-02"))
+		}
+	case NthWeekdayAnchor:
+		if first.Day() > 28 {
+			return fmt.Errorf("invalid recurrence anchor: the first occurrence (%s) is a fifth weekday, which not every month has; use LAST_WEEKDAY", first.Format("2006-01-02"))
+		}
+	case LastWeekdayAnchor:
+		if first.Day()+7 <= lastDay {
+			return fmt.Errorf("invalid recurrence anchor: the first occurrence (%s) is not the last %s of its month", first.Format("2006-01-02"), first.Weekday())
+		}
+	}
+	return nil
+}
+
+// DateIn returns the occurrence date in the given month for a series whose first occurrence is
+// first, keeping its time of day.
+func (a RecurrenceAnchor) DateIn(first time.Time, year int, month time.Month) time.Time {
+	lastDay := daysIn(year, month)
+
+	var day int
+	switch a.value {
+	case LastDayOfMonthAnchor:
+		day = lastDay
+	case NthWeekdayAnchor:
+		week := (first.Day() - 1) / 7
+		day = firstWeekdayIn(year, month, first.Weekday()) + 7*week
+	case LastWeekdayAnchor:
+		day = firstWeekdayIn(year, month, first.Weekday())
+		for day+7 <= lastDay {
+			day += 7
+		}
+	default:
+		day = min(first.Day(), lastDay)
+	}
+
+	return time.Date(year, month, day, first.Hour(), first.Minute(), first.Second(), first.Nanosecond(), first.Location())
+}
+
+// DayOfMonthRecurrenceAnchor returns a RecurrenceAnchor for the day of the month (the default).
+func DayOfMonthRecurrenceAnchor() RecurrenceAnchor {
+	return RecurrenceAnchor{value: DayOfMonthAnchor}
+}
+
+// daysIn returns the number of days of the month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// firstWeekdayIn returns the day of the month of the first given weekday in the month.
+func firstWeekdayIn(year int, month time.Month, weekday time.Weekday) int {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	return 1 + (int(weekday)-int(first)+7)%7
+}
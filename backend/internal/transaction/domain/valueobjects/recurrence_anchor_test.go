@@ -0,0 +1,84 @@
+package valueobjects
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewRecurrenceAnchor(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "DAY_OF_MONTH", want: DayOfMonthAnchor},
+		{value: " last_day_of_month ", want: LastDayOfMonthAnchor},
+		{value: "NTH_WEEKDAY", want: NthWeekdayAnchor},
+		{value: "LAST_WEEKDAY", want: LastWeekdayAnchor},
+		{value: "FIRST_BUSINESS_DAY", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			anchor, err := NewRecurrenceAnchor(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRecurrenceAnchor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && anchor.Value() != tt.want {
+				t.Errorf("Value() = %s, want %s", anchor.Value(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceAnchor_DateIn(t *testing.T) {
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	tests := []struct {
+		name   string
+		anchor string
+		first  string
+		month  time.Month
+		want   string
+	}{
+		{name: "day of month clamped", anchor: DayOfMonthAnchor, first: "2026-01-31", month: time.February, want: "2026-02-28"},
+		{name: "day of month restored", anchor: DayOfMonthAnchor, first: "2026-01-31", month: time.March, want: "2026-03-31"},
+		{name: "last day of month", anchor: LastDayOfMonthAnchor, first: "2026-01-31", month: time.April, want: "2026-04-30"},
+		{name: "second tuesday", anchor: NthWeekdayAnchor, first: "2026-01-13", month: time.March, want: "2026-03-10"},
+		{name: "last friday", anchor: LastWeekdayAnchor, first: "2026-01-30", month: time.May, want: "2026-05-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anchor, _ := NewRecurrenceAnchor(tt.anchor)
+			if err := anchor.ValidateFirst(date(tt.first)); err != nil {
+				t.Fatalf("ValidateFirst() error = %v", err)
+			}
+			if got := anchor.DateIn(date(tt.first), 2026, tt.month).Format("2006-01-02"); got != tt.want {
+				t.Errorf("DateIn() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceAnchor_ValidateFirst(t *testing.T) {
+	tests := []struct {
+		anchor string
+		first  time.Time
+	}{
+		{anchor: LastDayOfMonthAnchor, first: time.Date(2026, 1, 30, 0, 0, 0, 0, time.UTC)},
+		{anchor: NthWeekdayAnchor, first: time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC)},
+		{anchor: LastWeekdayAnchor, first: time.Date(2026, 1, 23, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		anchor, _ := NewRecurrenceAnchor(tt.anchor)
+		if err := anchor.ValidateFirst(tt.first); err == nil {
+			t.Errorf("ValidateFirst(%s) with %s expected error", tt.first.Format("2006-01-02"), tt.anchor)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package valueobjects
+
+import (
+	"errors"
+
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+)
+
+// RuleActions represents what a rule changes on the transactions it matches: the category,
+// tags to add and a cleaned-up description. At least one action is required.
+type RuleActions struct {
+	categoryID  *categoryvalueobjects.CategoryID
+	tagIDs      []tagvalueobjects.TagID
+	description *TransactionDescription
+}
+
+// NewRuleActions creates a new RuleActions value object. Duplicate tag IDs are ignored.
+func NewRuleActions(
+	categoryID *categoryvalueobjects.CategoryID,
+	tagIDs []tagvalueobjects.TagID,
+	description *TransactionDescription,
+) (RuleActions, error) {
+	if categoryID != nil && categoryID.IsEmpty() {
+		return RuleActions{}, errors.New("category ID cannot be empty")
+	}
+	if description != nil && description.IsEmpty() {
+		return RuleActions{}, errors.New("rule description cannot be empty")
+	}
+
+	unique := make([]tagvalueobjects.TagID, 0, len(tagIDs))
+	seen := make(map[string]bool, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if tagID.IsEmpty() {
+			return RuleActions{}, errors.New("tag ID cannot be empty")
+		}
+		if seen[tagID.Value()] {
+			continue
+		}
+		seen[tagID.Value()] = true
+		unique = append(unique, tagID)
+	}
+
+	actions := RuleActions{
+		categoryID:  categoryID,
+		tagIDs:      unique,
+		description: description,
+	}
+	if actions.IsEmpty() {
+		return RuleActions{}, errors.New("rule must have at least one action")
+	}
+
+	return actions, nil
+}
+
+// CategoryID returns the category set by the rule, or nil.
+func (a RuleActions) CategoryID() *categoryvalueobjects.CategoryID {
+	return a.categoryID
+}
+
+// TagIDs returns the tags added by the rule.
+func (a RuleActions) TagIDs() []tagvalueobjects.TagID {
+	result := make([]tagvalueobjects.TagID, len(a.tagIDs))
+	copy(result, a.tagIDs)
+	return result
+}
+
+// Description returns the description set by the rule, or nil.
+func (a RuleActions) Description() *TransactionDescription {
+	return a.description
+}
+
+// IsEmpty checks if no action is set.
+func (a RuleActions) IsEmpty() bool {
+	return a.categoryID == nil && len(a.tagIDs) == 0 && a.description == nil
+}
+
+// RuleActionsFromPersistence reconstructs RuleActions from persisted data without validation.
+// A rule whose category and tags were deleted is loaded with no actions and changes nothing.
+func RuleActionsFromPersistence(
+	categoryID *categoryvalueobjects.CategoryID,
+	tagIDs []tagvalueobjects.TagID,
+	description *TransactionDescription,
+) RuleActions {
+	return RuleActions{
+		categoryID:  categoryID,
+		tagIDs:      tagIDs,
+		description: description,
+	}
+}
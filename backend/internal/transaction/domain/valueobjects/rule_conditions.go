@@ -0,0 +1,174 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+)
+
+// Description match operators of a transaction rule.
+const (
+	DescriptionMatchContains   = "CONTAINS"    // Description contains the pattern
+	DescriptionMatchStartsWith = "STARTS_WITH" // Description starts with the pattern
+	DescriptionMatchRegex      = "REGEX"       // Description matches the regular expression
+)
+
+// MaxRulePatternLength is the maximum number of characters of a rule description pattern.
+const MaxRulePatternLength = 255
+
+// RuleConditions represents what a transaction must look like for a rule to apply.
+// Every condition that is set must hold; at least one condition is required.
+// CONTAINS and STARTS_WITH ignore case and accents; REGEX ignores case.
+type RuleConditions struct {
+	descriptionMatch   string
+	descriptionPattern string
+	foldedPattern      string
+	regex              *regexp.Regexp
+	minAmount          *int64 // In cents, inclusive
+	maxAmount          *int64 // In cents, inclusive
+	accountID          *accountvalueobjects.AccountID
+	transactionType    *TransactionType
+}
+
+// NewRuleConditions creates a new RuleConditions value object.
+// Amounts are in cents of the transaction currency; transactionType is INCOME or EXPENSE,
+// since transfers are never changed by rules.
+func NewRuleConditions(
+	descriptionMatch string,
+	descriptionPattern string,
+	minAmount *int64,
+	maxAmount *int64,
+	accountID *accountvalueobjects.AccountID,
+	transactionType *TransactionType,
+) (RuleConditions, error) {
+	conditions := RuleConditions{
+		minAmount:       minAmount,
+		maxAmount:       maxAmount,
+		accountID:       accountID,
+		transactionType: transactionType,
+	}
+
+	descriptionMatch = strings.ToUpper(strings.TrimSpace(descriptionMatch))
+	descriptionPattern = strings.TrimSpace(descriptionPattern)
+	if descriptionPattern != "" {
+		if descriptionMatch == "" {
+			descriptionMatch = DescriptionMatchContains
+		}
+		if utf8.RuneCountInString(descriptionPattern) > MaxRulePatternLength {
+			return RuleConditions{}, fmt.Errorf("description pattern must be at most %d characters", MaxRulePatternLength)
+		}
+
+		switch descriptionMatch {
+		case DescriptionMatchContains, DescriptionMatchStartsWith:
+			conditions.foldedPattern = FoldDescription(descriptionPattern)
+		case DescriptionMatchRegex:
+			regex, err := regexp.Compile("(?i)" + descriptionPattern)
+			if err != nil {
+				return RuleConditions{}, fmt.Errorf("invalid description pattern: %w", err)
+			}
+			conditions.regex = regex
+		default:
+			return RuleConditions{}, fmt.Errorf("invalid description match: %s. Supported values: CONTAINS, STARTS_WITH, REGEX", descriptionMatch)
+		}
+		conditions.descriptionMatch = descriptionMatch
+		conditions.descriptionPattern = descriptionPattern
+	} else if descriptionMatch != "" {
+		return RuleConditions{}, errors.New("description pattern cannot be empty when a description match is given")
+	}
+
+	if minAmount != nil && *minAmount < 0 {
+		return RuleConditions{}, errors.New("minimum amount must be zero or greater")
+	}
+	if maxAmount != nil && *maxAmount <= 0 {
+		return RuleConditions{}, errors.New("maximum amount must be greater than zero")
+	}
+	if minAmount != nil && maxAmount != nil && *minAmount > *maxAmount {
+		return RuleConditions{}, errors.New("minimum amount must be less than or equal to the maximum amount")
+	}
+
+	if accountID != nil && accountID.IsEmpty() {
+		return RuleConditions{}, errors.New("account ID cannot be empty")
+	}
+
+	if transactionType != nil && !transactionType.IsIncome() && !transactionType.IsExpense() {
+		return RuleConditions{}, errors.New("rule transaction type must be INCOME or EXPENSE")
+	}
+
+	if conditions.IsEmpty() {
+		return RuleConditions{}, errors.New("rule must have at least one condition")
+	}
+
+	return conditions, nil
+}
+
+// DescriptionMatch returns the description match operator (empty when there is no description condition).
+func (c RuleConditions) DescriptionMatch() string {
+	return c.descriptionMatch
+}
+
+// DescriptionPattern returns the description pattern as given by the user.
+func (c RuleConditions) DescriptionPattern() string {
+	return c.descriptionPattern
+}
+
+// MinAmount returns the minimum amount in cents, or nil.
+func (c RuleConditions) MinAmount() *int64 {
+	return c.minAmount
+}
+
+// MaxAmount returns the maximum amount in cents, or nil.
+func (c RuleConditions) MaxAmount() *int64 {
+	return c.maxAmount
+}
+
+// AccountID returns the account the rule is restricted to, or nil.
+func (c RuleConditions) AccountID() *accountvalueobjects.AccountID {
+	return c.accountID
+}
+
+// TransactionType returns the transaction type the rule is restricted to, or nil.
+func (c RuleConditions) TransactionType() *TransactionType {
+	return c.transactionType
+}
+
+// IsEmpty checks if no condition is set.
+func (c RuleConditions) IsEmpty() bool {
+	return c.descriptionMatch == "" && c.minAmount == nil && c.maxAmount == nil &&
+		c.accountID == nil && c.transactionType == nil
+}
+
+// Matches checks whether a transaction with the given values meets every condition.
+// amountCents is the (positive) transaction amount in cents.
+func (c RuleConditions) Matches(
+	accountID accountvalueobjects.AccountID,
+	transactionType TransactionType,
+	amountCents int64,
+	description string,
+) bool {
+	if c.accountID != nil && !c.accountID.Equals(accountID) {
+		return false
+	}
+	if c.transactionType != nil && !c.transactionType.Equals(transactionType) {
+		return false
+	}
+	if c.minAmount != nil && amountCents < *c.minAmount {
+		return false
+	}
+	if c.maxAmount != nil && amountCents > *c.maxAmount {
+		return false
+	}
+
+	switch c.descriptionMatch {
+	case DescriptionMatchContains:
+		return strings.Contains(FoldDescription(description), c.foldedPattern)
+	case DescriptionMatchStartsWith:
+		return strings.HasPrefix(FoldDescription(strings.TrimSpace(description)), c.foldedPattern)
+	case DescriptionMatchRegex:
+		return c.regex.MatchString(description)
+	}
+	return true
+}
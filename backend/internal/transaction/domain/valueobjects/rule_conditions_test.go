@@ -0,0 +1,92 @@
+package valueobjects
+
+import (
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+)
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+func TestNewRuleConditions(t *testing.T) {
+	expense := ExpenseType()
+	transfer := MustTransactionType(TransferOut)
+
+	tests := []struct {
+		name      string
+		match     string
+		pattern   string
+		minAmount *int64
+		maxAmount *int64
+		txType    *TransactionType
+		wantMatch string
+		wantErr   bool
+	}{
+		{name: "pattern defaults to contains", pattern: "uber", wantMatch: DescriptionMatchContains},
+		{name: "starts with", match: "starts_with", pattern: "PIX", wantMatch: DescriptionMatchStartsWith},
+		{name: "regex", match: "REGEX", pattern: `^ifood\s+\*`, wantMatch: DescriptionMatchRegex},
+		{name: "amount range only", minAmount: int64Pointer(1000), maxAmount: int64Pointer(5000)},
+		{name: "type only", txType: &expense},
+		{name: "no condition", wantErr: true},
+		{name: "match without pattern", match: "CONTAINS", wantErr: true},
+		{name: "unknown match", match: "EQUALS", pattern: "uber", wantErr: true},
+		{name: "invalid regex", match: "REGEX", pattern: "uber(", wantErr: true},
+		{name: "min above max", minAmount: int64Pointer(5000), maxAmount: int64Pointer(1000), wantErr: true},
+		{name: "negative min", minAmount: int64Pointer(-1), wantErr: true},
+		{name: "transfer type", txType: &transfer, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, err := NewRuleConditions(tt.match, tt.pattern, tt.minAmount, tt.maxAmount, nil, tt.txType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRuleConditions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && conditions.DescriptionMatch() != tt.wantMatch {
+				t.Errorf("DescriptionMatch() = %q, want %q", conditions.DescriptionMatch(), tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestRuleConditions_Matches(t *testing.T) {
+	accountID := accountvalueobjects.GenerateAccountID()
+	otherAccountID := accountvalueobjects.GenerateAccountID()
+	expense := ExpenseType()
+	income := IncomeType()
+
+	contains, _ := NewRuleConditions("", "padaria", nil, nil, nil, nil)
+	startsWith, _ := NewRuleConditions(DescriptionMatchStartsWith, "pix", nil, nil, nil, nil)
+	regex, _ := NewRuleConditions(DescriptionMatchRegex, `^uber\s*\*?\s*trip`, nil, nil, nil, nil)
+	ranged, _ := NewRuleConditions("", "", int64Pointer(1000), int64Pointer(5000), &accountID, &expense)
+
+	tests := []struct {
+		name       string
+		conditions RuleConditions
+		accountID  accountvalueobjects.AccountID
+		txType     TransactionType
+		cents      int64
+		desc       string
+		want       bool
+	}{
+		{name: "contains ignores case and accents", conditions: contains, accountID: accountID, txType: expense, cents: 100, desc: "COMPRA PADÁRIA SÃO JOÃO", want: true},
+		{name: "contains no match", conditions: contains, accountID: accountID, txType: expense, cents: 100, desc: "Mercado", want: false},
+		{name: "starts with", conditions: startsWith, accountID: accountID, txType: income, cents: 100, desc: "PIX RECEBIDO", want: true},
+		{name: "starts with in the middle", conditions: startsWith, accountID: accountID, txType: income, cents: 100, desc: "TED PIX", want: false},
+		{name: "regex ignores case", conditions: regex, accountID: accountID, txType: expense, cents: 100, desc: "UBER *TRIP HELP.UBER.COM", want: true},
+		{name: "amount in range", conditions: ranged, accountID: accountID, txType: expense, cents: 5000, desc: "Qualquer", want: true},
+		{name: "amount below range", conditions: ranged, accountID: accountID, txType: expense, cents: 999, desc: "Qualquer", want: false},
+		{name: "other account", conditions: ranged, accountID: otherAccountID, txType: expense, cents: 2000, desc: "Qualquer", want: false},
+		{name: "other type", conditions: ranged, accountID: accountID, txType: income, cents: 2000, desc: "Qualquer", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.conditions.Matches(tt.accountID, tt.txType, tt.cents, tt.desc); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
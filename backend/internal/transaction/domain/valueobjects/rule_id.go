@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// RuleID represents a transaction rule identifier value object.
+type RuleID struct {
+	value string
+}
+
+// NewRuleID creates a new RuleID from a string.
+func NewRuleID(id string) (RuleID, error) {
+	if id == "" {
+		return RuleID{}, errors.New("rule ID cannot be empty")
+	}
+
+	// Validate UUID format
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return RuleID{}, errors.New("invalid rule ID format (must be UUID)")
+	}
+
+	return RuleID{value: id}, nil
+}
+
+// GenerateRuleID generates a new RuleID.
+func GenerateRuleID() RuleID {
+	return RuleID{value: uuid.New().String()}
+}
+
+// MustRuleID creates a new RuleID and panics if invalid.
+// Use this only when you are certain the ID is valid (e.g., in tests).
+func MustRuleID(id string) RuleID {
+	rid, err := NewRuleID(id)
+	if err != nil {
+		panic(err)
+	}
+	return rid
+}
+
+// Value returns the rule ID as a string.
+func (rid RuleID) Value() string {
+	return rid.value
+}
+
+// String returns the rule ID as a string (implements fmt.Stringer).
+func (rid RuleID) String() string {
+	return rid.value
+}
+
+// Equals checks if two RuleID values are equal.
+func (rid RuleID) Equals(other RuleID) bool {
+	return rid.value == other.value
+}
+
+// IsEmpty checks if the rule ID is empty.
+func (rid RuleID) IsEmpty() bool {
+	return rid.value == ""
+}
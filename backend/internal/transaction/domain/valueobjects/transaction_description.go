@@ -12,6 +12,22 @@ const (
 	MaxDescriptionLength = 500
 )
 
+// accentReplacer removes the accents used in Portuguese descriptions.
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// FoldDescription lowercases a description and removes its accents, so that "CARTÃO" and
+// "cartao" compare equal.
+func FoldDescription(description string) string {
+	return accentReplacer.Replace(strings.ToLower(description))
+}
+
 // TransactionDescription represents a transaction description value object.
 type TransactionDescription struct {
 	value string
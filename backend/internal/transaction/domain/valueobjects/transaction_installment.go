@@ -0,0 +1,59 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxInstallments is the maximum number of installments of a purchase.
+const MaxInstallments = 48
+
+// TransactionInstallment represents the position of a transaction within an installment
+// purchase ("parcelamento"), e.g. installment 3 of 10.
+type TransactionInstallment struct {
+	number int
+	count  int
+}
+
+// NewTransactionInstallment creates a new TransactionInstallment value object.
+func NewTransactionInstallment(number, count int) (TransactionInstallment, error) {
+	if count < 2 || count > MaxInstallments {
+		return TransactionInstallment{}, fmt.Errorf("installment count must be between 2 and %d", MaxInstallments)
+	}
+
+	if number < 1 || number > count {
+		return TransactionInstallment{}, errors.New("installment number must be between 1 and the installment count")
+	}
+
+	return TransactionInstallment{number: number, count: count}, nil
+}
+
+// Number returns the position of the installment, starting at 1.
+func (i TransactionInstallment) Number() int {
+	return i.number
+}
+
+// Count returns the total number of installments of the purchase.
+func (i TransactionInstallment) Count() int {
+	return i.count
+}
+
+// IsFirst checks if this is the first installment of the purchase.
+func (i TransactionInstallment) IsFirst() bool {
+	return i.number == 1
+}
+
+// IsLast checks if this is the last installment of the purchase.
+func (i TransactionInstallment) IsLast() bool {
+	return i.number == i.count
+}
+
+// String returns the installment in the "3/10" format.
+func (i TransactionInstallment) String() string {
+	return fmt.Sprintf("%d/%d", i.number, i.count)
+}
+
+// Equals checks if two TransactionInstallment values are equal.
+func (i TransactionInstallment) Equals(other TransactionInstallment) bool {
+	return i.number == other.number && i.count == other.count
+}
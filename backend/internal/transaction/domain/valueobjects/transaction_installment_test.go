@@ -0,0 +1,98 @@
+package valueobjects
+
+import (
+	"testing"
+)
+
+func TestNewTransactionInstallment(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  int
+		count   int
+		wantErr bool
+		wantStr string
+	}{
+		{
+			name:    "first of two",
+			number:  1,
+			count:   2,
+			wantErr: false,
+			wantStr: "1/2",
+		},
+		{
+			name:    "third of ten",
+			number:  3,
+			count:   10,
+			wantErr: false,
+			wantStr: "3/10",
+		},
+		{
+			name:    "last of maximum",
+			number:  MaxInstallments,
+			count:   MaxInstallments,
+			wantErr: false,
+			wantStr: "48/48",
+		},
+		{
+			name:    "single installment",
+			number:  1,
+			count:   1,
+			wantErr: true,
+		},
+		{
+			name:    "count above maximum",
+			number:  1,
+			count:   MaxInstallments + 1,
+			wantErr: true,
+		},
+		{
+			name:    "number zero",
+			number:  0,
+			count:   10,
+			wantErr: true,
+		},
+		{
+			name:    "number above count",
+			number:  11,
+			count:   10,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTransactionInstallment(tt.number, tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTransactionInstallment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.String() != tt.wantStr {
+				t.Errorf("NewTransactionInstallment() string = %v, want %v", got.String(), tt.wantStr)
+			}
+		})
+	}
+}
+
+func TestTransactionInstallment_Predicates(t *testing.T) {
+	first, _ := NewTransactionInstallment(1, 3)
+	middle, _ := NewTransactionInstallment(2, 3)
+	last, _ := NewTransactionInstallment(3, 3)
+
+	if !first.IsFirst() || first.IsLast() {
+		t.Error("1/3 should only be the first installment")
+	}
+	if middle.IsFirst() || middle.IsLast() {
+		t.Error("2/3 should be neither the first nor the last installment")
+	}
+	if last.IsFirst() || !last.IsLast() {
+		t.Error("3/3 should only be the last installment")
+	}
+
+	other, _ := NewTransactionInstallment(2, 3)
+	if !middle.Equals(other) {
+		t.Error("Equals() should be true for the same number and count")
+	}
+	if middle.Equals(last) {
+		t.Error("Equals() should be false for different numbers")
+	}
+}
@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// TransactionRevisionID represents a transaction revision identifier value object.
+type TransactionRevisionID struct {
+	value string
+}
+
+// NewTransactionRevisionID creates a new TransactionRevisionID from a string.
+func NewTransactionRevisionID(id string) (TransactionRevisionID, error) {
+	if id == "" {
+		return TransactionRevisionID{}, errors.New("transaction revision ID cannot be empty")
+	}
+
+	// Validate UUID format
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return TransactionRevisionID{}, errors.New("invalid transaction revision ID format (must be UUID)")
+	}
+
+	return TransactionRevisionID{value: id}, nil
+}
+
+// GenerateTransactionRevisionID generates a new TransactionRevisionID.
+func GenerateTransactionRevisionID() TransactionRevisionID {
+	return TransactionRevisionID{value: uuid.New().String()}
+}
+
+// MustTransactionRevisionID creates a new TransactionRevisionID and panics if invalid.
+// Use this only when you are certain the ID is valid (e.g., in tests).
+func MustTransactionRevisionID(id string) TransactionRevisionID {
+	trid, err := NewTransactionRevisionID(id)
+	if err != nil {
+		panic(err)
+	}
+	return trid
+}
+
+// Value returns the transaction revision ID as a string.
+func (trid TransactionRevisionID) Value() string {
+	return trid.value
+}
+
+// String returns the transaction revision ID as a string (implements fmt.Stringer).
+func (trid TransactionRevisionID) String() string {
+	return trid.value
+}
+
+// Equals checks if two TransactionRevisionID values are equal.
+func (trid TransactionRevisionID) Equals(other TransactionRevisionID) bool {
+	return trid.value == other.value
+}
+
+// IsEmpty checks if the transaction revision ID is empty.
+func (trid TransactionRevisionID) IsEmpty() bool {
+	return trid.value == ""
+}
@@ -0,0 +1,73 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+)
+
+// MaxSplitMemoLength is the maximum number of characters allowed in a split line memo.
+const MaxSplitMemoLength = 255
+
+// TransactionSplit represents a split line of a transaction: the part of its amount
+// that belongs to a category, with an optional memo.
+type TransactionSplit struct {
+	categoryID categoryvalueobjects.CategoryID
+	amount     sharedvalueobjects.Money
+	memo       string
+}
+
+// NewTransactionSplit creates a new TransactionSplit value object.
+func NewTransactionSplit(
+	categoryID categoryvalueobjects.CategoryID,
+	amount sharedvalueobjects.Money,
+	memo string,
+) (TransactionSplit, error) {
+	if categoryID.IsEmpty() {
+		return TransactionSplit{}, errors.New("split category ID cannot be empty")
+	}
+
+	if !amount.IsPositive() {
+		return TransactionSplit{}, errors.New("split amount must be greater than zero")
+	}
+
+	memo = strings.TrimSpace(memo)
+	if utf8.RuneCountInString(memo) > MaxSplitMemoLength {
+		return TransactionSplit{}, fmt.Errorf("split memo must be at most %d characters", MaxSplitMemoLength)
+	}
+
+	return TransactionSplit{
+		categoryID: categoryID,
+		amount:     amount,
+		memo:       memo,
+	}, nil
+}
+
+// CategoryID returns the category of the split line.
+func (s TransactionSplit) CategoryID() categoryvalueobjects.CategoryID {
+	return s.categoryID
+}
+
+// Amount returns the amount of the split line.
+func (s TransactionSplit) Amount() sharedvalueobjects.Money {
+	return s.amount
+}
+
+// Memo returns the optional memo of the split line.
+func (s TransactionSplit) Memo() string {
+	return s.memo
+}
+
+// WithAmount returns a copy of the split line with a different amount.
+func (s TransactionSplit) WithAmount(amount sharedvalueobjects.Money) (TransactionSplit, error) {
+	return NewTransactionSplit(s.categoryID, amount, s.memo)
+}
+
+// Equals checks if two split lines are equal.
+func (s TransactionSplit) Equals(other TransactionSplit) bool {
+	return s.categoryID.Equals(other.categoryID) && s.amount.Equals(other.amount) && s.memo == other.memo
+}
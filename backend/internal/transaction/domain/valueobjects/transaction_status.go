@@ -0,0 +1,98 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TransactionStatus represents how far a transaction has been matched against the bank statement.
+type TransactionStatus struct {
+	value string
+}
+
+// Valid transaction status values
+const (
+	Pending    = "PENDING"    // Entered in the app but not yet seen on the bank statement
+	Cleared    = "CLEARED"    // Seen on the bank statement
+	Reconciled = "RECONCILED" // Matched by a completed account reconciliation
+)
+
+// ValidTransactionStatuses is a map of all supported transaction statuses.
+var ValidTransactionStatuses = map[string]string{
+	Pending:    "Pending",
+	Cleared:    "Cleared",
+	Reconciled: "Reconciled",
+}
+
+// NewTransactionStatus creates a new TransactionStatus value object.
+func NewTransactionStatus(value string) (TransactionStatus, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	if !IsValidTransactionStatus(value) {
+		return TransactionStatus{}, fmt.Errorf("invalid transaction status: %s. Supported values: PENDING, CLEARED, RECONCILED", value)
+	}
+
+	return TransactionStatus{value: value}, nil
+}
+
+// MustTransactionStatus creates a new TransactionStatus value object and panics if the value is invalid.
+// Use this only when you are certain the transaction status value is valid.
+func MustTransactionStatus(value string) TransactionStatus {
+	ts, err := NewTransactionStatus(value)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+// IsValidTransactionStatus checks if a transaction status value is valid.
+func IsValidTransactionStatus(value string) bool {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	_, exists := ValidTransactionStatuses[value]
+	return exists
+}
+
+// Value returns the transaction status value (PENDING, CLEARED or RECONCILED).
+func (ts TransactionStatus) Value() string {
+	return ts.value
+}
+
+// String returns the transaction status value as a string.
+func (ts TransactionStatus) String() string {
+	return ts.value
+}
+
+// IsPending checks if the transaction has not been seen on the bank statement yet.
+func (ts TransactionStatus) IsPending() bool {
+	return ts.value == Pending
+}
+
+// IsCleared checks if the transaction has been seen on the bank statement.
+func (ts TransactionStatus) IsCleared() bool {
+	return ts.value == Cleared
+}
+
+// IsReconciled checks if the transaction was matched by a completed reconciliation.
+func (ts TransactionStatus) IsReconciled() bool {
+	return ts.value == Reconciled
+}
+
+// Equals checks if two TransactionStatus values are equal.
+func (ts TransactionStatus) Equals(other TransactionStatus) bool {
+	return ts.value == other.value
+}
+
+// PendingStatus returns a TransactionStatus for Pending.
+func PendingStatus() TransactionStatus {
+	return TransactionStatus{value: Pending}
+}
+
+// ClearedStatus returns a TransactionStatus for Cleared.
+func ClearedStatus() TransactionStatus {
+	return TransactionStatus{value: Cleared}
+}
+
+// ReconciledStatus returns a TransactionStatus for Reconciled.
+func ReconciledStatus() TransactionStatus {
+	return TransactionStatus{value: Reconciled}
+}
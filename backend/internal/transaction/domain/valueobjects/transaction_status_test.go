@@ -0,0 +1,71 @@
+package valueobjects
+
+import (
+	"testing"
+)
+
+func TestNewTransactionStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+		wantVal string
+	}{
+		{
+			name:    "valid PENDING",
+			value:   "PENDING",
+			wantErr: false,
+			wantVal: "PENDING",
+		},
+		{
+			name:    "valid cleared lowercase with spaces",
+			value:   "  cleared ",
+			wantErr: false,
+			wantVal: "CLEARED",
+		},
+		{
+			name:    "valid RECONCILED",
+			value:   "RECONCILED",
+			wantErr: false,
+			wantVal: "RECONCILED",
+		},
+		{
+			name:    "invalid status",
+			value:   "VOID",
+			wantErr: true,
+		},
+		{
+			name:    "empty string",
+			value:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTransactionStatus(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTransactionStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.Value() != tt.wantVal {
+				t.Errorf("NewTransactionStatus() value = %v, want %v", got.Value(), tt.wantVal)
+			}
+		})
+	}
+}
+
+func TestTransactionStatus_Predicates(t *testing.T) {
+	if !PendingStatus().IsPending() || PendingStatus().IsCleared() {
+		t.Error("PendingStatus() should only be pending")
+	}
+	if !ClearedStatus().IsCleared() || ClearedStatus().IsReconciled() {
+		t.Error("ClearedStatus() should only be cleared")
+	}
+	if !ReconciledStatus().IsReconciled() || ReconciledStatus().IsPending() {
+		t.Error("ReconciledStatus() should only be reconciled")
+	}
+	if !ClearedStatus().Equals(MustTransactionStatus("CLEARED")) {
+		t.Error("ClearedStatus() should equal MustTransactionStatus(\"CLEARED\")")
+	}
+}
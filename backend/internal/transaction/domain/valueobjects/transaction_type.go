@@ -13,14 +13,22 @@ type TransactionType struct {
 
 // Valid transaction type values
 const (
-	Income  = "INCOME"  // Income transaction
-	Expense = "EXPENSE" // Expense transaction
+	Income        = "INCOME"         // Income transaction
+	Expense       = "EXPENSE"        // Expense transaction
+	TransferOut   = "TRANSFER_OUT"   // Outgoing leg of a transfer between accounts
+	TransferIn    = "TRANSFER_IN"    // Incoming leg of a transfer between accounts
+	AdjustmentIn  = "ADJUSTMENT_IN"  // Balance adjustment that raises the account balance
+	AdjustmentOut = "ADJUSTMENT_OUT" // Balance adjustment that lowers the account balance
 )
 
 // ValidTransactionTypes is a map of all supported transaction types.
 var ValidTransactionTypes = map[string]string{
-	Income:  "Income",
-	Expense: "Expense",
+	Income:        "Income",
+	Expense:       "Expense",
+	TransferOut:   "Transfer Out",
+	TransferIn:    "Transfer In",
+	AdjustmentIn:  "Balance Adjustment In",
+	AdjustmentOut: "Balance Adjustment Out",
 }
 
 // NewTransactionType creates a new TransactionType value object.
@@ -28,7 +36,7 @@ func NewTransactionType(value string) (TransactionType, error) {
 	value = strings.ToUpper(strings.TrimSpace(value))
 
 	if !IsValidTransactionType(value) {
-		return TransactionType{}, fmt.Errorf("invalid transaction type: %s. Supported values: INCOME, EXPENSE", value)
+		return TransactionType{}, fmt.Errorf("invalid transaction type: %s. Supported values: INCOME, EXPENSE, TRANSFER_OUT, TRANSFER_IN, ADJUSTMENT_IN, ADJUSTMENT_OUT", value)
 	}
 
 	return TransactionType{value: value}, nil
@@ -51,7 +59,7 @@ func IsValidTransactionType(value string) bool {
 	return exists
 }
 
-// Value returns the transaction type value (INCOME or EXPENSE).
+// Value returns the transaction type value (INCOME, EXPENSE, TRANSFER_OUT, TRANSFER_IN, ADJUSTMENT_IN or ADJUSTMENT_OUT).
 func (tt TransactionType) Value() string {
 	return tt.value
 }
@@ -79,6 +87,26 @@ func (tt TransactionType) IsExpense() bool {
 	return tt.value == Expense
 }
 
+// IsTransfer checks if the transaction type is one of the legs of a transfer.
+func (tt TransactionType) IsTransfer() bool {
+	return tt.value == TransferOut || tt.value == TransferIn
+}
+
+// IsAdjustment checks if the transaction type is a balance adjustment.
+func (tt TransactionType) IsAdjustment() bool {
+	return tt.value == AdjustmentIn || tt.value == AdjustmentOut
+}
+
+// IsCredit checks if the transaction type adds money to the account (Income, Transfer In or Adjustment In).
+func (tt TransactionType) IsCredit() bool {
+	return tt.value == Income || tt.value == TransferIn || tt.value == AdjustmentIn
+}
+
+// IsDebit checks if the transaction type subtracts money from the account (Expense, Transfer Out or Adjustment Out).
+func (tt TransactionType) IsDebit() bool {
+	return tt.value == Expense || tt.value == TransferOut || tt.value == AdjustmentOut
+}
+
 // Equals checks if two TransactionType values are equal.
 func (tt TransactionType) Equals(other TransactionType) bool {
 	return tt.value == other.value
@@ -94,6 +122,26 @@ func ExpenseType() TransactionType {
 	return TransactionType{value: Expense}
 }
 
+// TransferOutType returns a TransactionType for the outgoing leg of a transfer.
+func TransferOutType() TransactionType {
+	return TransactionType{value: TransferOut}
+}
+
+// TransferInType returns a TransactionType for the incoming leg of a transfer.
+func TransferInType() TransactionType {
+	return TransactionType{value: TransferIn}
+}
+
+// AdjustmentInType returns a TransactionType for a balance adjustment that raises the balance.
+func AdjustmentInType() TransactionType {
+	return TransactionType{value: AdjustmentIn}
+}
+
+// AdjustmentOutType returns a TransactionType for a balance adjustment that lowers the balance.
+func AdjustmentOutType() TransactionType {
+	return TransactionType{value: AdjustmentOut}
+}
+
 // ParseTransactionType attempts to parse a transaction type from a string.
 // It accepts both uppercase and lowercase values.
 func ParseTransactionType(s string) (TransactionType, error) {
@@ -109,5 +157,9 @@ func AllTransactionTypes() []TransactionType {
 	return []TransactionType{
 		IncomeType(),
 		ExpenseType(),
+		TransferOutType(),
+		TransferInType(),
+		AdjustmentInType(),
+		AdjustmentOutType(),
 	}
 }
@@ -219,8 +219,8 @@ func TestParseTransactionType(t *testing.T) {
 
 func TestAllTransactionTypes(t *testing.T) {
 	types := AllTransactionTypes()
-	if len(types) != 2 {
-		t.Errorf("AllTransactionTypes() returned %d types, want 2", len(types))
+	if len(types) != 6 {
+		t.Errorf("AllTransactionTypes() returned %d types, want 6", len(types))
 	}
 
 	hasIncome := false
@@ -242,3 +242,38 @@ func TestAllTransactionTypes(t *testing.T) {
 		t.Error("AllTransactionTypes() should include EXPENSE")
 	}
 }
+
+func TestTransactionType_BalanceDirection(t *testing.T) {
+	tests := []struct {
+		name           string
+		tt             TransactionType
+		wantCredit     bool
+		wantDebit      bool
+		wantTransfer   bool
+		wantAdjustment bool
+	}{
+		{"INCOME", IncomeType(), true, false, false, false},
+		{"EXPENSE", ExpenseType(), false, true, false, false},
+		{"TRANSFER_OUT", TransferOutType(), false, true, true, false},
+		{"TRANSFER_IN", TransferInType(), true, false, true, false},
+		{"ADJUSTMENT_IN", AdjustmentInType(), true, false, false, true},
+		{"ADJUSTMENT_OUT", AdjustmentOutType(), false, true, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tt.IsCredit(); got != tt.wantCredit {
+				t.Errorf("IsCredit() = %v, want %v", got, tt.wantCredit)
+			}
+			if got := tt.tt.IsDebit(); got != tt.wantDebit {
+				t.Errorf("IsDebit() = %v, want %v", got, tt.wantDebit)
+			}
+			if got := tt.tt.IsTransfer(); got != tt.wantTransfer {
+				t.Errorf("IsTransfer() = %v, want %v", got, tt.wantTransfer)
+			}
+			if got := tt.tt.IsAdjustment(); got != tt.wantAdjustment {
+				t.Errorf("IsAdjustment() = %v, want %v", got, tt.wantAdjustment)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package exporters
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// csvWriter writes transactions as CSV, flushing after every row so large exports are streamed.
+type csvWriter struct {
+	writer        *csv.Writer
+	locale        Locale
+	headerWritten bool
+	out           io.Writer
+}
+
+func newCSVWriter(w io.Writer, locale Locale) *csvWriter {
+	writer := csv.NewWriter(w)
+	if locale == LocalePtBR {
+		writer.Comma = ';'
+	}
+	return &csvWriter{writer: writer, locale: locale, out: w}
+}
+
+// Write writes the row, preceded by the header on the first call.
+func (w *csvWriter) Write(row Row) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	values := row.values(w.locale)
+	record := make([]string, 0, len(values))
+	for _, v := range values {
+		switch v.kind {
+		case dateValue:
+			record = append(record, formatDate(v.date, w.locale))
+		case amountValue:
+			record = append(record, formatAmount(v.cents, w.locale))
+		default:
+			record = append(record, escapeFormula(v.text))
+		}
+	}
+	if err := w.writer.Write(record); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// Close writes the header if no row was written, so an empty export still has its columns.
+func (w *csvWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *csvWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+
+	// Excel only detects UTF-8 (and the accents of pt-BR headers) with a byte order mark
+	if w.locale == LocalePtBR {
+		if _, err := io.WriteString(w.out, "\ufeff"); err != nil {
+			return err
+		}
+	}
+	return w.writer.Write(headers(w.locale))
+}
+
+// escapeFormula prefixes text that spreadsheets would run as a formula (descriptions come
+// from users and bank statements) with an apostrophe, which makes it plain text.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,246 @@
+package exporters
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is the file format of a transaction export.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	FormatJSON Format = "json"
+)
+
+// ContentType returns the MIME type of files in the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatJSON:
+		return "application/json"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Locale selects the column headers and, for CSV, the number and date formatting.
+type Locale string
+
+const (
+	// LocalePtBR writes "1.234,56" and DD/MM/YYYY, separated by ";" as Excel expects in Brazil.
+	LocalePtBR Locale = "pt-BR"
+	// LocaleEN writes "1234.56" and YYYY-MM-DD, separated by ",".
+	LocaleEN Locale = "en"
+)
+
+// Row is a transaction written to an export file.
+type Row struct {
+	TransactionID       string
+	Date                time.Time
+	Description         string
+	Type                string
+	Amount              int64 // Amount in cents (never negative; the type gives the direction)
+	Currency            string
+	Status              string
+	AccountID           string
+	AccountName         string
+	CategoryID          string     // Empty when the transaction is uncategorized or split
+	CategoryName        string     // For split transactions, the categories of the splits
+	Recurring           bool       // The transaction starts a recurring series
+	RecurrenceFrequency string     // Frequency of the series (empty if not recurring)
+	RecurrenceEndDate   *time.Time // Last date of the series (nil if open-ended or not recurring)
+	ParentTransactionID string     // Series or installment purchase the transaction belongs to
+	InstallmentNumber   int        // Installment number, starting at 1 (0 if not an installment)
+	InstallmentCount    int        // Number of installments of the purchase
+}
+
+// Writer writes transactions to an export file, one row at a time.
+// Close must be called once every row is written to complete the file.
+type Writer interface {
+	Write(row Row) error
+	Close() error
+}
+
+// ParseFormat returns the format with the given name; an empty name selects CSV.
+func ParseFormat(raw string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(raw))); format {
+	case "":
+		return FormatCSV, nil
+	case FormatCSV, FormatXLSX, FormatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid export format: must be csv, xlsx or json, got %s", raw)
+	}
+}
+
+// ParseLocale returns the locale with the given name; an empty name selects pt-BR.
+func ParseLocale(raw string) (Locale, error) {
+	switch locale := Locale(strings.TrimSpace(raw)); locale {
+	case "":
+		return LocalePtBR, nil
+	case LocalePtBR, LocaleEN:
+		return locale, nil
+	default:
+		return "", fmt.Errorf("invalid export locale: must be pt-BR or en, got %s", raw)
+	}
+}
+
+// NewWriter returns a writer for the format and locale. The locale is ignored for JSON,
+// which always uses decimal numbers and ISO 8601 dates.
+func NewWriter(w io.Writer, format Format, locale Locale) (Writer, error) {
+	if locale != LocalePtBR && locale != LocaleEN {
+		return nil, fmt.Errorf("invalid export locale: must be pt-BR or en, got %s", locale)
+	}
+
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, locale), nil
+	case FormatXLSX:
+		return newXLSXWriter(w, locale)
+	case FormatJSON:
+		return newJSONWriter(w), nil
+	default:
+		return nil, fmt.Errorf("invalid export format: must be csv, xlsx or json, got %s", format)
+	}
+}
+
+// column is a column of the CSV and XLSX exports.
+type column struct {
+	en   string
+	ptBR string
+}
+
+// columns lists the CSV and XLSX columns in the order of Row.values.
+var columns = []column{
+	{"Date", "Data"},
+	{"Description", "Descrição"},
+	{"Type", "Tipo"},
+	{"Amount", "Valor"},
+	{"Currency", "Moeda"},
+	{"Status", "Situação"},
+	{"Account", "Conta"},
+	{"Category", "Categoria"},
+	{"Recurring", "Recorrente"},
+	{"Recurrence frequency", "Frequência"},
+	{"Recurrence end date", "Fim da recorrência"},
+	{"Installment", "Parcela"},
+	{"Parent transaction ID", "Transação de origem"},
+	{"Account ID", "ID da conta"},
+	{"Category ID", "ID da categoria"},
+	{"Transaction ID", "ID da transação"},
+}
+
+// headers returns the column headers in the locale.
+func headers(locale Locale) []string {
+	values := make([]string, 0, len(columns))
+	for _, col := range columns {
+		if locale == LocalePtBR {
+			values = append(values, col.ptBR)
+		} else {
+			values = append(values, col.en)
+		}
+	}
+	return values
+}
+
+// valueKind tells how a cell value is formatted.
+type valueKind int
+
+const (
+	textValue valueKind = iota
+	dateValue
+	amountValue
+)
+
+// value is a cell of a CSV or XLSX row. Dates and amounts keep their type so that spreadsheets
+// get real dates and numbers; an empty date is written as empty text.
+type value struct {
+	kind  valueKind
+	text  string
+	date  time.Time
+	cents int64
+}
+
+func text(s string) value { return value{kind: textValue, text: s} }
+
+// values returns the cells of the row in the order of columns.
+func (r Row) values(locale Locale) []value {
+	recurring := "No"
+	if locale == LocalePtBR {
+		recurring = "Não"
+	}
+	if r.Recurring {
+		recurring = "Yes"
+		if locale == LocalePtBR {
+			recurring = "Sim"
+		}
+	}
+
+	endDate := text("")
+	if r.RecurrenceEndDate != nil {
+		endDate = value{kind: dateValue, date: *r.RecurrenceEndDate}
+	}
+
+	installment := ""
+	if r.InstallmentNumber > 0 {
+		installment = fmt.Sprintf("%d/%d", r.InstallmentNumber, r.InstallmentCount)
+	}
+
+	return []value{
+		{kind: dateValue, date: r.Date},
+		text(r.Description),
+		text(r.Type),
+		{kind: amountValue, cents: r.Amount},
+		text(r.Currency),
+		text(r.Status),
+		text(r.AccountName),
+		text(r.CategoryName),
+		text(recurring),
+		text(r.RecurrenceFrequency),
+		endDate,
+		text(installment),
+		text(r.ParentTransactionID),
+		text(r.AccountID),
+		text(r.CategoryID),
+		text(r.TransactionID),
+	}
+}
+
+// formatDate formats a date as DD/MM/YYYY (pt-BR) or YYYY-MM-DD (en).
+func formatDate(date time.Time, locale Locale) string {
+	if locale == LocalePtBR {
+		return date.Format("02/01/2006")
+	}
+	return date.Format("2006-01-02")
+}
+
+// formatAmount formats an amount in cents as "1.234,56" (pt-BR) or "1234.56" (en).
+func formatAmount(cents int64, locale Locale) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	units := strconv.FormatInt(cents/100, 10)
+	fraction := fmt.Sprintf("%02d", cents%100)
+
+	if locale != LocalePtBR {
+		return sign + units + "." + fraction
+	}
+
+	// Group the units in thousands with "."
+	var grouped strings.Builder
+	for i, digit := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+	return sign + grouped.String() + "," + fraction
+}
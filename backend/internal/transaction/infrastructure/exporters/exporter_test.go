@@ -0,0 +1,174 @@
+package exporters
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRows() []Row {
+	endDate := time.Date(2027, 3, 10, 0, 0, 0, 0, time.UTC)
+	return []Row{
+		{
+			TransactionID:       "tx-1",
+			Date:                time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC),
+			Description:         "Aluguel",
+			Type:                "EXPENSE",
+			Amount:              123456,
+			Currency:            "BRL",
+			Status:              "CLEARED",
+			AccountID:           "acc-1",
+			AccountName:         "Conta Corrente",
+			CategoryID:          "cat-1",
+			CategoryName:        "Moradia",
+			Recurring:           true,
+			RecurrenceFrequency: "MONTHLY",
+			RecurrenceEndDate:   &endDate,
+		},
+		{
+			TransactionID:       "tx-2",
+			Date:                time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC),
+			Description:         "=HYPERLINK(\"x\")",
+			Type:                "INCOME",
+			Amount:              5,
+			Currency:            "BRL",
+			Status:              "PENDING",
+			AccountID:           "acc-1",
+			AccountName:         "Conta Corrente",
+			ParentTransactionID: "tx-0",
+			InstallmentNumber:   2,
+			InstallmentCount:    10,
+		},
+	}
+}
+
+func writeAll(t *testing.T, format Format, locale Locale, rows []Row) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, format, locale)
+	require.NoError(t, err)
+	for _, row := range rows {
+		require.NoError(t, writer.Write(row))
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		cents  int64
+		locale Locale
+		want   string
+	}{
+		{123456789, LocalePtBR, "1.234.567,89"},
+		{123456, LocalePtBR, "1.234,56"},
+		{99900, LocalePtBR, "999,00"},
+		{5, LocalePtBR, "0,05"},
+		{-123456, LocalePtBR, "-1.234,56"},
+		{123456789, LocaleEN, "1234567.89"},
+		{-5, LocaleEN, "-0.05"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatAmount(tt.cents, tt.locale))
+	}
+}
+
+func TestNewWriter_Invalid(t *testing.T) {
+	_, err := NewWriter(io.Discard, "pdf", LocaleEN)
+	assert.ErrorContains(t, err, "invalid export format")
+
+	_, err = NewWriter(io.Discard, FormatCSV, "fr")
+	assert.ErrorContains(t, err, "invalid export locale")
+}
+
+func TestCSVWriter(t *testing.T) {
+	t.Run("pt-BR", func(t *testing.T) {
+		content := string(writeAll(t, FormatCSV, LocalePtBR, testRows()))
+		lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+		require.Len(t, lines, 3)
+
+		assert.True(t, strings.HasPrefix(lines[0], "\ufeffData;Descrição;Tipo;Valor;"))
+		assert.Equal(t, "05/10/2026;Aluguel;EXPENSE;1.234,56;BRL;CLEARED;Conta Corrente;Moradia;Sim;MONTHLY;10/03/2027;;;acc-1;cat-1;tx-1", lines[1])
+		assert.Equal(t, `06/10/2026;"'=HYPERLINK(""x"")";INCOME;0,05;BRL;PENDING;Conta Corrente;;Não;;;2/10;tx-0;acc-1;;tx-2`, lines[2])
+	})
+
+	t.Run("en", func(t *testing.T) {
+		content := string(writeAll(t, FormatCSV, LocaleEN, testRows()[:1]))
+		lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+		require.Len(t, lines, 2)
+
+		assert.True(t, strings.HasPrefix(lines[0], "Date,Description,Type,Amount,"))
+		assert.Equal(t, "2026-10-05,Aluguel,EXPENSE,1234.56,BRL,CLEARED,Conta Corrente,Moradia,Yes,MONTHLY,2027-03-10,,,acc-1,cat-1,tx-1", lines[1])
+	})
+
+	t.Run("empty export keeps the header", func(t *testing.T) {
+		content := string(writeAll(t, FormatCSV, LocaleEN, nil))
+		assert.Equal(t, 1, strings.Count(content, "\n"))
+		assert.True(t, strings.HasPrefix(content, "Date,"))
+	})
+}
+
+func TestXLSXWriter(t *testing.T) {
+	content := writeAll(t, FormatXLSX, LocalePtBR, testRows())
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+
+	parts := map[string]string{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		parts[file.Name] = string(data)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml"} {
+		assert.Contains(t, parts, name)
+	}
+	assert.Contains(t, parts["xl/workbook.xml"], `<sheet name="Transações"`)
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Equal(t, 3, strings.Count(sheet, "<row "))
+	assert.Contains(t, sheet, `<c r="A1" s="3" t="inlineStr"><is><t xml:space="preserve">Data</t></is></c>`)
+	// Dates and amounts are real spreadsheet values: 2026-10-05 is serial 46300
+	assert.Contains(t, sheet, `<c r="A2" s="1"><v>46300</v></c>`)
+	assert.Contains(t, sheet, `<c r="D2" s="2"><v>1234.56</v></c>`)
+	assert.Contains(t, sheet, `<c r="K2" s="1"><v>46456</v></c>`)
+	assert.Contains(t, sheet, `=HYPERLINK(&#34;x&#34;)`)
+	assert.Contains(t, sheet, `<c r="L3" s="0" t="inlineStr"><is><t xml:space="preserve">2/10</t></is></c>`)
+	assert.True(t, strings.HasSuffix(sheet, "</sheetData></worksheet>"))
+}
+
+func TestXLSXColumnName(t *testing.T) {
+	assert.Equal(t, "A", xlsxColumnName(0))
+	assert.Equal(t, "P", xlsxColumnName(15))
+	assert.Equal(t, "Z", xlsxColumnName(25))
+	assert.Equal(t, "AA", xlsxColumnName(26))
+	assert.Equal(t, "AZ", xlsxColumnName(51))
+}
+
+func TestJSONWriter(t *testing.T) {
+	var rows []map[string]any
+	require.NoError(t, json.Unmarshal(writeAll(t, FormatJSON, LocalePtBR, testRows()), &rows))
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, "2026-10-05", rows[0]["date"])
+	assert.Equal(t, 1234.56, rows[0]["amount"])
+	assert.Equal(t, "Conta Corrente", rows[0]["account_name"])
+	assert.Equal(t, "Moradia", rows[0]["category_name"])
+	assert.Equal(t, true, rows[0]["is_recurring"])
+	assert.Equal(t, "2027-03-10", rows[0]["recurrence_end_date"])
+	assert.Equal(t, float64(2), rows[1]["installment_number"])
+	assert.NotContains(t, rows[1], "category_id")
+
+	assert.Equal(t, "[]\n", string(writeAll(t, FormatJSON, LocaleEN, nil)))
+}
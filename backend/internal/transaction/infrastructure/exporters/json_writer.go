@@ -0,0 +1,85 @@
+package exporters
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// jsonRow is a transaction in the JSON export.
+type jsonRow struct {
+	TransactionID       string  `json:"transaction_id"`
+	Date                string  `json:"date"`
+	Description         string  `json:"description"`
+	Type                string  `json:"type"`
+	Amount              float64 `json:"amount"`
+	Currency            string  `json:"currency"`
+	Status              string  `json:"status"`
+	AccountID           string  `json:"account_id"`
+	AccountName         string  `json:"account_name"`
+	CategoryID          string  `json:"category_id,omitempty"`
+	CategoryName        string  `json:"category_name,omitempty"`
+	IsRecurring         bool    `json:"is_recurring"`
+	RecurrenceFrequency string  `json:"recurrence_frequency,omitempty"`
+	RecurrenceEndDate   string  `json:"recurrence_end_date,omitempty"`
+	ParentTransactionID string  `json:"parent_transaction_id,omitempty"`
+	InstallmentNumber   int     `json:"installment_number,omitempty"`
+	InstallmentCount    int     `json:"installment_count,omitempty"`
+}
+
+// jsonWriter writes transactions as a JSON array, one element per row.
+type jsonWriter struct {
+	out     io.Writer
+	encoder *json.Encoder
+	rows    int
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	return &jsonWriter{out: w, encoder: json.NewEncoder(w)}
+}
+
+// Write writes the row as the next element of the array.
+func (w *jsonWriter) Write(row Row) error {
+	separator := ","
+	if w.rows == 0 {
+		separator = "["
+	}
+	if _, err := io.WriteString(w.out, separator); err != nil {
+		return err
+	}
+	w.rows++
+
+	element := jsonRow{
+		TransactionID:       row.TransactionID,
+		Date:                row.Date.Format("2006-01-02"),
+		Description:         row.Description,
+		Type:                row.Type,
+		Amount:              float64(row.Amount) / 100,
+		Currency:            row.Currency,
+		Status:              row.Status,
+		AccountID:           row.AccountID,
+		AccountName:         row.AccountName,
+		CategoryID:          row.CategoryID,
+		CategoryName:        row.CategoryName,
+		IsRecurring:         row.Recurring,
+		RecurrenceFrequency: row.RecurrenceFrequency,
+		ParentTransactionID: row.ParentTransactionID,
+		InstallmentNumber:   row.InstallmentNumber,
+		InstallmentCount:    row.InstallmentCount,
+	}
+	if row.RecurrenceEndDate != nil {
+		element.RecurrenceEndDate = row.RecurrenceEndDate.Format("2006-01-02")
+	}
+
+	// The encoder ends every element with a newline
+	return w.encoder.Encode(element)
+}
+
+// Close ends the array.
+func (w *jsonWriter) Close() error {
+	closing := "]\n"
+	if w.rows == 0 {
+		closing = "[]\n"
+	}
+	_, err := io.WriteString(w.out, closing)
+	return err
+}
@@ -0,0 +1,192 @@
+package exporters
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Cell styles declared in xlsxStyles (indexes into cellXfs).
+const (
+	xlsxStyleDate   = 1 // Built-in short date format, shown in the spreadsheet locale
+	xlsxStyleAmount = 2 // Built-in "#,##0.00" format
+	xlsxStyleHeader = 3 // Bold text
+)
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="14" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`
+
+const xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const xlsxSheetEnd = `</sheetData></worksheet>`
+
+// xlsxWriter writes transactions as an Office Open XML workbook with a single sheet.
+// The fixed parts of the package are written up front and the sheet is written last,
+// row by row, so the workbook is streamed instead of being built in memory.
+type xlsxWriter struct {
+	archive       *zip.Writer
+	sheet         *bufio.Writer
+	locale        Locale
+	rowNumber     int
+	headerWritten bool
+}
+
+func newXLSXWriter(w io.Writer, locale Locale) (*xlsxWriter, error) {
+	archive := zip.NewWriter(w)
+
+	sheetName := "Transactions"
+	if locale == LocalePtBR {
+		sheetName = "Transações"
+	}
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, sheetName)},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for _, part := range parts {
+		entry, err := archive.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+		if _, err := io.WriteString(entry, part.content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+
+	entry, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to write sheet: %w", err)
+	}
+	sheet := bufio.NewWriter(entry)
+	if _, err := sheet.WriteString(xlsxSheetStart); err != nil {
+		return nil, fmt.Errorf("failed to write sheet: %w", err)
+	}
+
+	return &xlsxWriter{archive: archive, sheet: sheet, locale: locale}, nil
+}
+
+// Write writes the row, preceded by the header on the first call.
+func (w *xlsxWriter) Write(row Row) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.writeRow(row.values(w.locale), 0)
+}
+
+// Close completes the sheet and the package.
+func (w *xlsxWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	if _, err := w.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.archive.Close()
+}
+
+func (w *xlsxWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+
+	names := headers(w.locale)
+	values := make([]value, 0, len(names))
+	for _, name := range names {
+		values = append(values, text(name))
+	}
+	return w.writeRow(values, xlsxStyleHeader)
+}
+
+// writeRow writes a row of cells; textStyle is applied to the text cells.
+func (w *xlsxWriter) writeRow(values []value, textStyle int) error {
+	w.rowNumber++
+	row := strconv.Itoa(w.rowNumber)
+
+	if _, err := w.sheet.WriteString(`<row r="` + row + `">`); err != nil {
+		return err
+	}
+	for i, v := range values {
+		ref := xlsxColumnName(i) + row
+		switch {
+		case v.kind == dateValue:
+			fmt.Fprintf(w.sheet, `<c r="%s" s="%d"><v>%d</v></c>`, ref, xlsxStyleDate, xlsxSerialDate(v.date))
+		case v.kind == amountValue:
+			fmt.Fprintf(w.sheet, `<c r="%s" s="%d"><v>%s</v></c>`, ref, xlsxStyleAmount, formatAmount(v.cents, LocaleEN))
+		case v.text == "":
+			// Empty cells are left out
+		default:
+			fmt.Fprintf(w.sheet, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">`, ref, textStyle)
+			if err := xml.EscapeText(w.sheet, []byte(v.text)); err != nil {
+				return err
+			}
+			w.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+// xlsxColumnName returns the column letters of a zero-based column index (0 is A, 26 is AA).
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// xlsxSerialDate returns the spreadsheet serial number of a date (days since 1899-12-30).
+func xlsxSerialDate(date time.Time) int64 {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	epoch := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	return int64(day.Sub(epoch).Hours() / 24)
+}
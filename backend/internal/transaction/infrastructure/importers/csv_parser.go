@@ -0,0 +1,376 @@
+package importers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported date formats for statement columns, keyed by the name used in the API.
+var csvDateLayouts = map[string]string{
+	"DD/MM/YYYY": "02/01/2006",
+	"DD/MM/YY":   "02/01/06",
+	"DD-MM-YYYY": "02-01-2006",
+	"DD.MM.YYYY": "02.01.2006",
+	"MM/DD/YYYY": "01/02/2006",
+	"YYYY-MM-DD": "2006-01-02",
+	"YYYY/MM/DD": "2006/01/02",
+}
+
+// CSVMapping describes how the columns of a bank statement CSV map to transaction fields.
+// Columns are referenced either by header name (case-insensitive) or by 1-based position.
+// Amounts come either from a single signed AmountColumn or from separate DebitColumn and
+// CreditColumn, as many Brazilian banks export them.
+type CSVMapping struct {
+	Delimiter         rune   // Field delimiter (defaults to ',')
+	HasHeader         bool   // First line holds column names
+	DateColumn        string // Column with the transaction date
+	DateFormat        string // One of the keys of csvDateLayouts (e.g. DD/MM/YYYY)
+	AmountColumn      string // Signed amount column (negative for debits)
+	DebitColumn       string // Debit amount column (used with CreditColumn instead of AmountColumn)
+	CreditColumn      string // Credit amount column (used with DebitColumn instead of AmountColumn)
+	DescriptionColumn string // Column with the transaction description
+	DecimalSeparator  string // "," or "." (defaults to ".")
+}
+
+// ParsedRow is a statement line that was parsed successfully.
+type ParsedRow struct {
+	Line        int       // Line number in the file (1-based, header included)
+	Date        time.Time // Transaction date
+	Amount      int64     // Signed amount in cents (negative for debits)
+	Description string    // Trimmed description
+}
+
+// RowError describes why a statement line could not be parsed.
+type RowError struct {
+	Line    int
+	Message string
+}
+
+// CSVParseResult holds the parsed rows and the per-row errors of a statement.
+type CSVParseResult struct {
+	Rows   []ParsedRow
+	Errors []RowError
+}
+
+// SupportedDateFormats returns the date format names accepted in CSVMapping.DateFormat.
+func SupportedDateFormats() []string {
+	return []string{"DD/MM/YYYY", "DD/MM/YY", "DD-MM-YYYY", "DD.MM.YYYY", "MM/DD/YYYY", "YYYY-MM-DD", "YYYY/MM/DD"}
+}
+
+// ParseCSV parses a bank statement using the given column mapping.
+// It returns an error only when the file or the mapping cannot be used at all;
+// problems with individual lines are reported in CSVParseResult.Errors.
+func ParseCSV(r io.Reader, mapping CSVMapping) (*CSVParseResult, error) {
+	layout, ok := csvDateLayouts[strings.ToUpper(strings.TrimSpace(mapping.DateFormat))]
+	if !ok {
+		return nil, fmt.Errorf("invalid date format: %s. Supported values: %s", mapping.DateFormat, strings.Join(SupportedDateFormats(), ", "))
+	}
+
+	decimalSeparator := mapping.DecimalSeparator
+	if decimalSeparator == "" {
+		decimalSeparator = "."
+	}
+	if decimalSeparator != "." && decimalSeparator != "," {
+		return nil, errors.New("invalid decimal separator: must be \".\" or \",\"")
+	}
+
+	useDebitCredit := mapping.AmountColumn == ""
+	if useDebitCredit && (mapping.DebitColumn == "" || mapping.CreditColumn == "") {
+		return nil, errors.New("invalid column mapping: amount column or both debit and credit columns must be provided")
+	}
+	if !useDebitCredit && (mapping.DebitColumn != "" || mapping.CreditColumn != "") {
+		return nil, errors.New("invalid column mapping: amount column cannot be combined with debit and credit columns")
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = ','
+	if mapping.Delimiter != 0 {
+		reader.Comma = mapping.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var header []string
+	if mapping.HasHeader {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("invalid statement: file is empty")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid statement: %w", err)
+		}
+		header = record
+		if len(header) > 0 {
+			header[0] = strings.TrimPrefix(header[0], "\ufeff") // UTF-8 byte order mark
+		}
+	}
+
+	columns := map[string]string{
+		"date":        mapping.DateColumn,
+		"description": mapping.DescriptionColumn,
+	}
+	if useDebitCredit {
+		columns["debit"] = mapping.DebitColumn
+		columns["credit"] = mapping.CreditColumn
+	} else {
+		columns["amount"] = mapping.AmountColumn
+	}
+
+	indexes := make(map[string]int, len(columns))
+	for field, column := range columns {
+		index, err := resolveColumn(column, header, mapping.HasHeader)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s column: %w", field, err)
+		}
+		indexes[field] = index
+	}
+
+	result := &CSVParseResult{
+		Rows:   []ParsedRow{},
+		Errors: []RowError{},
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				result.Errors = append(result.Errors, RowError{Line: parseErr.Line, Message: parseErr.Err.Error()})
+				continue
+			}
+			return nil, fmt.Errorf("invalid statement: %w", err)
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		row, err := parseRecord(record, indexes, layout, decimalSeparator, useDebitCredit)
+		if err != nil {
+			result.Errors = append(result.Errors, RowError{Line: line, Message: err.Error()})
+			continue
+		}
+		row.Line = line
+		result.Rows = append(result.Rows, row)
+	}
+
+	if len(result.Rows) == 0 && len(result.Errors) == 0 {
+		return nil, errors.New("invalid statement: no transactions found")
+	}
+
+	return result, nil
+}
+
+// parseRecord converts a CSV record into a ParsedRow using the resolved column indexes.
+func parseRecord(record []string, indexes map[string]int, layout, decimalSeparator string, useDebitCredit bool) (ParsedRow, error) {
+	field := func(name string) (string, error) {
+		index := indexes[name]
+		if index >= len(record) {
+			return "", fmt.Errorf("missing %s column", name)
+		}
+		return strings.TrimSpace(record[index]), nil
+	}
+
+	rawDate, err := field("date")
+	if err != nil {
+		return ParsedRow{}, err
+	}
+	date, err := time.Parse(layout, rawDate)
+	if err != nil {
+		return ParsedRow{}, fmt.Errorf("invalid date %q", rawDate)
+	}
+
+	description, err := field("description")
+	if err != nil {
+		return ParsedRow{}, err
+	}
+	if description == "" {
+		return ParsedRow{}, errors.New("description cannot be empty")
+	}
+
+	var amount int64
+	if useDebitCredit {
+		rawDebit, err := field("debit")
+		if err != nil {
+			return ParsedRow{}, err
+		}
+		rawCredit, err := field("credit")
+		if err != nil {
+			return ParsedRow{}, err
+		}
+		amount, err = parseDebitCredit(rawDebit, rawCredit, decimalSeparator)
+		if err != nil {
+			return ParsedRow{}, err
+		}
+	} else {
+		rawAmount, err := field("amount")
+		if err != nil {
+			return ParsedRow{}, err
+		}
+		amount, err = ParseAmount(rawAmount, decimalSeparator)
+		if err != nil {
+			return ParsedRow{}, err
+		}
+	}
+	if amount == 0 {
+		return ParsedRow{}, errors.New("amount must be different from zero")
+	}
+
+	return ParsedRow{
+		Date:        date,
+		Amount:      amount,
+		Description: description,
+	}, nil
+}
+
+// parseDebitCredit returns the signed amount of a line with separate debit and credit columns.
+// Exactly one of them must hold a value; debits are always negative and credits positive.
+func parseDebitCredit(rawDebit, rawCredit, decimalSeparator string) (int64, error) {
+	var debit, credit int64
+	var err error
+	if rawDebit != "" {
+		if debit, err = ParseAmount(rawDebit, decimalSeparator); err != nil {
+			return 0, err
+		}
+	}
+	if rawCredit != "" {
+		if credit, err = ParseAmount(rawCredit, decimalSeparator); err != nil {
+			return 0, err
+		}
+	}
+
+	switch {
+	case debit != 0 && credit != 0:
+		return 0, errors.New("debit and credit cannot both be filled")
+	case debit != 0:
+		return -absCents(debit), nil
+	default:
+		return absCents(credit), nil
+	}
+}
+
+// ParseAmount converts a statement amount to signed cents.
+// The decimal separator is "," or "."; the other character is treated as a thousands separator.
+// Currency symbols and spaces are ignored, and a leading or trailing minus sign, or parentheses
+// around the value, mark a negative amount (e.g. "R$ -1.234,56", "1,234.56-", "(12.00)").
+func ParseAmount(raw, decimalSeparator string) (int64, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return 0, errors.New("amount cannot be empty")
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+
+	var cleaned strings.Builder
+	for i, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			cleaned.WriteRune(r)
+		case string(r) == decimalSeparator:
+			cleaned.WriteRune('.')
+		case string(r) == thousandsSeparator:
+			// Ignore thousands separators
+		case r == '-':
+			if negative {
+				return 0, fmt.Errorf("invalid amount %q", raw)
+			}
+			negative = true
+		case r == '+' && i == 0:
+			// Explicit positive sign
+		case r == 'R' || r == '$' || r == '€' || r == ' ' || r == '\u00a0':
+			// Currency symbols and spaces
+		default:
+			return 0, fmt.Errorf("invalid amount %q", raw)
+		}
+	}
+
+	digits := cleaned.String()
+	if digits == "" || strings.Count(digits, ".") > 1 {
+		return 0, fmt.Errorf("invalid amount %q", raw)
+	}
+
+	units, fraction, _ := strings.Cut(digits, ".")
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > 2 {
+		return 0, fmt.Errorf("invalid amount %q: more than 2 decimal places", raw)
+	}
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+	if units == "" {
+		units = "0"
+	}
+
+	cents, err := strconv.ParseInt(units+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", raw)
+	}
+
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+// resolveColumn returns the 0-based index of a column given by header name or 1-based position.
+func resolveColumn(column string, header []string, hasHeader bool) (int, error) {
+	column = strings.TrimSpace(column)
+	if column == "" {
+		return 0, errors.New("column cannot be empty")
+	}
+
+	if position, err := strconv.Atoi(column); err == nil {
+		if position < 1 {
+			return 0, fmt.Errorf("column position must be 1 or greater, got %d", position)
+		}
+		return position - 1, nil
+	}
+
+	if !hasHeader {
+		return 0, fmt.Errorf("column %q must be a position when the file has no header", column)
+	}
+
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("column %q not found in header", column)
+}
+
+// isBlankRecord returns true if every field of the record is empty.
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// absCents returns the absolute value of an amount in cents.
+func absCents(cents int64) int64 {
+	if cents < 0 {
+		return -cents
+	}
+	return cents
+}
@@ -0,0 +1,169 @@
+package importers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name             string
+		raw              string
+		decimalSeparator string
+		want             int64
+		wantErr          bool
+	}{
+		{name: "dot decimal", raw: "1234.56", decimalSeparator: ".", want: 123456},
+		{name: "dot decimal with thousands", raw: "1,234.56", decimalSeparator: ".", want: 123456},
+		{name: "comma decimal with thousands", raw: "1.234,56", decimalSeparator: ",", want: 123456},
+		{name: "negative with currency symbol", raw: "R$ -1.234,56", decimalSeparator: ",", want: -123456},
+		{name: "trailing minus", raw: "50,00-", decimalSeparator: ",", want: -5000},
+		{name: "parentheses", raw: "(12.00)", decimalSeparator: ".", want: -1200},
+		{name: "explicit plus", raw: "+10", decimalSeparator: ".", want: 1000},
+		{name: "single decimal digit", raw: "10,5", decimalSeparator: ",", want: 1050},
+		{name: "trailing zero decimals", raw: "3.1400", decimalSeparator: ".", want: 314},
+		{name: "too many decimals", raw: "3.141", decimalSeparator: ".", wantErr: true},
+		{name: "empty", raw: " ", decimalSeparator: ".", wantErr: true},
+		{name: "letters", raw: "abc", decimalSeparator: ".", wantErr: true},
+		{name: "two decimal separators", raw: "1,2,3", decimalSeparator: ",", wantErr: true},
+		{name: "double minus", raw: "-10-", decimalSeparator: ".", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAmount(tt.raw, tt.decimalSeparator)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCSV_AmountColumn(t *testing.T) {
+	content := "\ufeffData;Descrição;Valor\n" +
+		"05/10/2026;Salário;5.000,00\n" +
+		"06/10/2026;Mercado;-123,45\n" +
+		"\n" +
+		"07/10/2026;Padaria;abc\n" +
+		"31/02/2026;Farmácia;-10,00\n" +
+		"08/10/2026;;-5,00\n"
+
+	result, err := ParseCSV(strings.NewReader(content), CSVMapping{
+		Delimiter:         ';',
+		HasHeader:         true,
+		DateColumn:        "data",
+		DateFormat:        "DD/MM/YYYY",
+		AmountColumn:      "Valor",
+		DescriptionColumn: "Descrição",
+		DecimalSeparator:  ",",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Rows, 2)
+	assert.Equal(t, 2, result.Rows[0].Line)
+	assert.Equal(t, time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), result.Rows[0].Date)
+	assert.Equal(t, int64(500000), result.Rows[0].Amount)
+	assert.Equal(t, "Salário", result.Rows[0].Description)
+	assert.Equal(t, int64(-12345), result.Rows[1].Amount)
+
+	require.Len(t, result.Errors, 3)
+	assert.Equal(t, 5, result.Errors[0].Line)
+	assert.Contains(t, result.Errors[0].Message, "invalid amount")
+	assert.Equal(t, 6, result.Errors[1].Line)
+	assert.Contains(t, result.Errors[1].Message, "invalid date")
+	assert.Equal(t, 7, result.Errors[2].Line)
+	assert.Contains(t, result.Errors[2].Message, "description cannot be empty")
+}
+
+func TestParseCSV_DebitCreditColumns(t *testing.T) {
+	content := "2026-10-01,Aluguel,1500.00,\n" +
+		"2026-10-02,Reembolso,,80.50\n" +
+		"2026-10-03,Ajuste,10.00,10.00\n" +
+		"2026-10-04,Vazio,,\n"
+
+	result, err := ParseCSV(strings.NewReader(content), CSVMapping{
+		DateColumn:        "1",
+		DateFormat:        "YYYY-MM-DD",
+		DescriptionColumn: "2",
+		DebitColumn:       "3",
+		CreditColumn:      "4",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Rows, 2)
+	assert.Equal(t, int64(-150000), result.Rows[0].Amount)
+	assert.Equal(t, 1, result.Rows[0].Line)
+	assert.Equal(t, int64(8050), result.Rows[1].Amount)
+
+	require.Len(t, result.Errors, 2)
+	assert.Contains(t, result.Errors[0].Message, "cannot both be filled")
+	assert.Contains(t, result.Errors[1].Message, "different from zero")
+}
+
+func TestParseCSV_InvalidMapping(t *testing.T) {
+	content := "date,description,amount\n2026-10-01,Coffee,-5.00\n"
+
+	tests := []struct {
+		name    string
+		mapping CSVMapping
+		wantErr string
+	}{
+		{
+			name:    "unknown date format",
+			mapping: CSVMapping{HasHeader: true, DateColumn: "date", DateFormat: "YYYYMMDD", AmountColumn: "amount", DescriptionColumn: "description"},
+			wantErr: "invalid date format",
+		},
+		{
+			name:    "invalid decimal separator",
+			mapping: CSVMapping{HasHeader: true, DateColumn: "date", DateFormat: "YYYY-MM-DD", AmountColumn: "amount", DescriptionColumn: "description", DecimalSeparator: ";"},
+			wantErr: "invalid decimal separator",
+		},
+		{
+			name:    "no amount columns",
+			mapping: CSVMapping{HasHeader: true, DateColumn: "date", DateFormat: "YYYY-MM-DD", DescriptionColumn: "description"},
+			wantErr: "invalid column mapping",
+		},
+		{
+			name:    "amount and debit columns",
+			mapping: CSVMapping{HasHeader: true, DateColumn: "date", DateFormat: "YYYY-MM-DD", AmountColumn: "amount", DebitColumn: "amount", DescriptionColumn: "description"},
+			wantErr: "invalid column mapping",
+		},
+		{
+			name:    "unknown header",
+			mapping: CSVMapping{HasHeader: true, DateColumn: "posted", DateFormat: "YYYY-MM-DD", AmountColumn: "amount", DescriptionColumn: "description"},
+			wantErr: "invalid date column",
+		},
+		{
+			name:    "header name without header",
+			mapping: CSVMapping{DateColumn: "date", DateFormat: "YYYY-MM-DD", AmountColumn: "3", DescriptionColumn: "2"},
+			wantErr: "must be a position",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(content), tt.mapping)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParseCSV_EmptyFile(t *testing.T) {
+	_, err := ParseCSV(strings.NewReader("date,description,amount\n"), CSVMapping{
+		HasHeader:         true,
+		DateColumn:        "date",
+		DateFormat:        "YYYY-MM-DD",
+		AmountColumn:      "amount",
+		DescriptionColumn: "description",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no transactions found")
+}
@@ -0,0 +1,192 @@
+package importers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ofxEntities are the character entities allowed in OFX element values.
+var ofxEntities = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", "\"", "&apos;", "'", "&nbsp;", " ")
+
+// ofxTransaction collects the elements of a STMTTRN aggregate while it is being read.
+type ofxTransaction struct {
+	line     int
+	fitID    string
+	datePost string
+	amount   string
+	name     string
+	memo     string
+}
+
+// ParseOFX parses an OFX bank or credit card statement. Both OFX 1.x (SGML, where element
+// values have no closing tags) and OFX 2.x (XML) are supported.
+//
+// Each STMTTRN aggregate becomes a StatementEntry with its FITID as external ID, DTPOSTED as
+// date, TRNAMT as amount and NAME/MEMO as description. LEDGERBAL is returned as the ledger
+// balance and CURDEF as the currency. It returns an error only when the file is not an OFX
+// statement; problems with individual entries are reported in StatementParseResult.Errors.
+func ParseOFX(r io.Reader) (*StatementParseResult, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statement: %w", err)
+	}
+	text := decodeStatementText(content)
+
+	start := strings.Index(strings.ToUpper(text), "<OFX>")
+	if start < 0 {
+		return nil, errors.New("invalid statement: OFX root element not found")
+	}
+
+	result := &StatementParseResult{
+		Entries: []StatementEntry{},
+		Errors:  []RowError{},
+	}
+
+	var (
+		current      *ofxTransaction
+		inLedger     bool
+		ledgerAmount string
+		ledgerDate   string
+		found        bool
+	)
+
+	line := strings.Count(text[:start], "\n") + 1
+	position := start
+	for position < len(text) {
+		open := strings.IndexByte(text[position:], '<')
+		if open < 0 {
+			break
+		}
+		line += strings.Count(text[position:position+open], "\n")
+		position += open
+
+		end := strings.IndexByte(text[position:], '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.ToUpper(strings.TrimSpace(text[position+1 : position+end]))
+		position += end + 1
+
+		// The element value runs up to the next tag (SGML leaves have no closing tag)
+		next := strings.IndexByte(text[position:], '<')
+		if next < 0 {
+			next = len(text) - position
+		}
+		value := strings.TrimSpace(ofxEntities.Replace(text[position : position+next]))
+
+		switch {
+		case tag == "STMTTRN":
+			current = &ofxTransaction{line: line}
+			found = true
+		case tag == "/STMTTRN":
+			if current != nil {
+				result.addOFXTransaction(current)
+				current = nil
+			}
+		case tag == "LEDGERBAL":
+			inLedger = true
+		case tag == "/LEDGERBAL":
+			inLedger = false
+		case strings.HasPrefix(tag, "/") || strings.HasPrefix(tag, "?") || strings.HasPrefix(tag, "!"):
+			// Closing tags of elements, XML declaration and comments
+		case current != nil:
+			switch tag {
+			case "FITID":
+				current.fitID = value
+			case "DTPOSTED":
+				current.datePost = value
+			case "TRNAMT":
+				current.amount = value
+			case "NAME":
+				current.name = value
+			case "MEMO":
+				current.memo = value
+			}
+		case inLedger:
+			switch tag {
+			case "BALAMT":
+				ledgerAmount = value
+			case "DTASOF":
+				ledgerDate = value
+			}
+		case tag == "CURDEF" && result.Currency == "":
+			result.Currency = strings.ToUpper(value)
+		}
+	}
+
+	// SGML files may omit the closing tag of the last entry
+	if current != nil {
+		result.addOFXTransaction(current)
+	}
+
+	if !found {
+		return nil, errors.New("invalid statement: no transactions found")
+	}
+
+	if ledgerAmount != "" {
+		amount, amountErr := ParseAmount(ledgerAmount, guessDecimalSeparator(ledgerAmount))
+		date, dateErr := parseOFXDate(ledgerDate)
+		if amountErr == nil && dateErr == nil {
+			result.LedgerBalance = &StatementBalance{Amount: amount, Date: date}
+		}
+	}
+
+	return result, nil
+}
+
+// addOFXTransaction validates a STMTTRN aggregate and adds it as an entry or as an error.
+func (result *StatementParseResult) addOFXTransaction(transaction *ofxTransaction) {
+	entry, err := transaction.toEntry()
+	if err != nil {
+		result.Errors = append(result.Errors, RowError{Line: transaction.line, Message: err.Error()})
+		return
+	}
+	result.Entries = append(result.Entries, entry)
+}
+
+// toEntry converts the collected STMTTRN elements into a StatementEntry.
+func (t *ofxTransaction) toEntry() (StatementEntry, error) {
+	if t.fitID == "" {
+		return StatementEntry{}, errors.New("FITID is missing")
+	}
+	if t.amount == "" {
+		return StatementEntry{}, errors.New("TRNAMT is missing")
+	}
+
+	date, err := parseOFXDate(t.datePost)
+	if err != nil {
+		return StatementEntry{}, fmt.Errorf("invalid DTPOSTED: %w", err)
+	}
+
+	amount, err := ParseAmount(t.amount, guessDecimalSeparator(t.amount))
+	if err != nil {
+		return StatementEntry{}, fmt.Errorf("invalid TRNAMT: %w", err)
+	}
+	if amount == 0 {
+		return StatementEntry{}, errors.New("amount cannot be zero")
+	}
+
+	return StatementEntry{
+		Line:        t.line,
+		ExternalID:  t.fitID,
+		Date:        date,
+		Amount:      amount,
+		Description: entryDescription(t.name, t.memo),
+	}, nil
+}
+
+// parseOFXDate parses an OFX date (YYYYMMDD, optionally followed by time, fraction and
+// time zone, e.g. "20261001120000[-3:BRT]"). Only the calendar date is kept, as posted by the bank.
+func parseOFXDate(raw string) (time.Time, error) {
+	if len(raw) < 8 {
+		return time.Time{}, fmt.Errorf("%q is not an OFX date", raw)
+	}
+	date, err := time.Parse("20060102", raw[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not an OFX date", raw)
+	}
+	return date, nil
+}
@@ -0,0 +1,99 @@
+package importers
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openFixture(t *testing.T, name string) *os.File {
+	t.Helper()
+
+	file, err := os.Open("testdata/" + name)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = file.Close() })
+	return file
+}
+
+func TestParseOFX_SGML(t *testing.T) {
+	result, err := ParseOFX(openFixture(t, "statement_sgml.ofx"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "BRL", result.Currency)
+	require.Len(t, result.Entries, 3)
+
+	// Windows-1252 content is converted to UTF-8 and entities are decoded
+	assert.Equal(t, StatementEntry{
+		Line:        39,
+		ExternalID:  "20261001001",
+		Date:        time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Amount:      300000,
+		Description: "SALÁRIO OUTUBRO",
+	}, result.Entries[0])
+	assert.Equal(t, "SUPERMERCADO PÃO & CIA", result.Entries[1].Description)
+	assert.Equal(t, int64(-45025), result.Entries[1].Amount)
+
+	// Comma decimal separator
+	assert.Equal(t, "20261004001", result.Entries[2].ExternalID)
+	assert.Equal(t, int64(-14975), result.Entries[2].Amount)
+
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 53, result.Errors[0].Line)
+	assert.Contains(t, result.Errors[0].Message, "DTPOSTED")
+
+	require.NotNil(t, result.LedgerBalance)
+	assert.Equal(t, int64(250000), result.LedgerBalance.Amount)
+	assert.Equal(t, time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), result.LedgerBalance.Date)
+}
+
+func TestParseOFX_XML(t *testing.T) {
+	result, err := ParseOFX(openFixture(t, "statement_xml.ofx"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "BRL", result.Currency)
+	assert.Empty(t, result.Errors)
+	require.Len(t, result.Entries, 3)
+
+	assert.Equal(t, "6a1b2c3d-0002", result.Entries[1].ExternalID)
+	assert.Equal(t, "Posto Ipiranga - Combustível", result.Entries[1].Description)
+	assert.Equal(t, int64(-12000), result.Entries[1].Amount)
+	assert.Equal(t, int64(5990), result.Entries[2].Amount)
+
+	require.NotNil(t, result.LedgerBalance)
+	assert.Equal(t, int64(-12000), result.LedgerBalance.Amount)
+}
+
+func TestParseOFX_InvalidFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "not OFX", content: "Data;Valor\n01/10/2026;10,00\n", wantErr: "OFX root element not found"},
+		{name: "no transactions", content: "<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>BRL</STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>", wantErr: "no transactions found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseOFX(strings.NewReader(tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParseOFX_EntryWithoutFITID(t *testing.T) {
+	content := "<OFX>\n<STMTTRN>\n<DTPOSTED>20261001\n<TRNAMT>-1.00\n<MEMO>Sem FITID\n</STMTTRN>\n</OFX>\n"
+
+	result, err := ParseOFX(strings.NewReader(content))
+	require.NoError(t, err)
+
+	assert.Empty(t, result.Entries)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 2, result.Errors[0].Line)
+	assert.Contains(t, result.Errors[0].Message, "FITID")
+}
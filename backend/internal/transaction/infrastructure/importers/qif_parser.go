@@ -0,0 +1,216 @@
+package importers
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultQIFDateFormat is the date format used for QIF files when none is given.
+const DefaultQIFDateFormat = "DD/MM/YYYY"
+
+// qifRecord collects the fields of a QIF record while it is being read.
+type qifRecord struct {
+	line   int
+	date   string
+	amount string
+	payee  string
+	memo   string
+}
+
+// ParseQIF parses a QIF bank, cash or credit card statement.
+// QIF does not define the date order, so dateFormat is one of SupportedDateFormats (default
+// DD/MM/YYYY); the Quicken variants with an apostrophe before the year ("1/10'26") and unpadded
+// days or months are accepted as well. Sections other than bank, cash and card transactions
+// (categories, classes, investments, memorized items) are skipped.
+//
+// QIF entries carry no bank identifier, so the external ID is derived from the date, amount
+// and description; identical entries within one file are numbered to keep them apart.
+// It returns an error only when the file cannot be used at all; problems with individual
+// records are reported in StatementParseResult.Errors.
+func ParseQIF(r io.Reader, dateFormat string) (*StatementParseResult, error) {
+	if strings.TrimSpace(dateFormat) == "" {
+		dateFormat = DefaultQIFDateFormat
+	}
+	layout, ok := csvDateLayouts[strings.ToUpper(strings.TrimSpace(dateFormat))]
+	if !ok {
+		return nil, fmt.Errorf("invalid date format: %s. Supported values: %s", dateFormat, strings.Join(SupportedDateFormats(), ", "))
+	}
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statement: %w", err)
+	}
+
+	result := &StatementParseResult{
+		Entries: []StatementEntry{},
+		Errors:  []RowError{},
+	}
+	occurrences := make(map[string]int)
+
+	var current *qifRecord
+	inTransactions := true
+	found := false
+
+	scanner := bufio.NewScanner(strings.NewReader(decodeStatementText(content)))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "!") {
+			inTransactions = isQIFTransactionSection(line)
+			current = nil
+			continue
+		}
+		if !inTransactions {
+			continue
+		}
+
+		if current == nil {
+			current = &qifRecord{line: lineNumber}
+		}
+
+		field, value := line[0], strings.TrimSpace(line[1:])
+		switch field {
+		case 'D':
+			current.date = value
+		case 'T', 'U':
+			// U is the same amount with more precision in newer Quicken versions
+			if current.amount == "" || field == 'T' {
+				current.amount = value
+			}
+		case 'P':
+			current.payee = value
+		case 'M':
+			current.memo = value
+		case '^':
+			found = true
+			result.addQIFRecord(current, layout, occurrences)
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid statement: %w", err)
+	}
+
+	// Files may omit the terminator of the last record
+	if current != nil && current.date != "" {
+		found = true
+		result.addQIFRecord(current, layout, occurrences)
+	}
+
+	if !found {
+		return nil, errors.New("invalid statement: no transactions found")
+	}
+
+	return result, nil
+}
+
+// isQIFTransactionSection reports whether a QIF header starts a section of account transactions.
+func isQIFTransactionSection(header string) bool {
+	header = strings.ToLower(strings.TrimSpace(header))
+	switch strings.TrimSpace(strings.TrimPrefix(header, "!type:")) {
+	case "bank", "cash", "ccard", "oth a", "oth l":
+		return true
+	default:
+		return false
+	}
+}
+
+// addQIFRecord validates a QIF record and adds it as an entry or as an error.
+func (result *StatementParseResult) addQIFRecord(record *qifRecord, layout string, occurrences map[string]int) {
+	entry, err := record.toEntry(layout)
+	if err != nil {
+		result.Errors = append(result.Errors, RowError{Line: record.line, Message: err.Error()})
+		return
+	}
+
+	key := entry.Date.Format("2006-01-02") + "|" + strconv.FormatInt(entry.Amount, 10) + "|" + strings.ToLower(entry.Description)
+	sum := sha256.Sum256([]byte(key))
+	occurrences[key]++
+
+	entry.ExternalID = "QIF-" + hex.EncodeToString(sum[:10])
+	if occurrences[key] > 1 {
+		entry.ExternalID += "-" + strconv.Itoa(occurrences[key])
+	}
+	result.Entries = append(result.Entries, entry)
+}
+
+// toEntry converts the collected QIF fields into a StatementEntry (without external ID).
+func (r *qifRecord) toEntry(layout string) (StatementEntry, error) {
+	if r.date == "" {
+		return StatementEntry{}, errors.New("date is missing")
+	}
+	if r.amount == "" {
+		return StatementEntry{}, errors.New("amount is missing")
+	}
+
+	date, err := parseQIFDate(r.date, layout)
+	if err != nil {
+		return StatementEntry{}, err
+	}
+
+	amount, err := ParseAmount(r.amount, guessDecimalSeparator(r.amount))
+	if err != nil {
+		return StatementEntry{}, err
+	}
+	if amount == 0 {
+		return StatementEntry{}, errors.New("amount cannot be zero")
+	}
+
+	return StatementEntry{
+		Line:        r.line,
+		Date:        date,
+		Amount:      amount,
+		Description: entryDescription(r.payee, r.memo),
+	}, nil
+}
+
+// parseQIFDate parses a QIF date with the given layout. Quicken writes years after 1999 with an
+// apostrophe ("1/10'26") and pads days and months with spaces, so the date is normalized first.
+func parseQIFDate(raw, layout string) (time.Time, error) {
+	normalized := strings.ReplaceAll(strings.ReplaceAll(raw, "'", "/"), " ", "")
+
+	separator := string(layout[2])
+	if strings.HasPrefix(layout, "2006") {
+		separator = string(layout[4])
+	}
+	parts := strings.Split(normalized, separator)
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("invalid date %q", raw)
+	}
+
+	yearIndex := 2
+	if strings.HasPrefix(layout, "2006") {
+		yearIndex = 0
+	}
+	for i, part := range parts {
+		if i == yearIndex {
+			continue
+		}
+		if len(part) == 1 {
+			parts[i] = "0" + part
+		}
+	}
+
+	// Two-digit years are expanded when the layout expects four digits
+	if strings.Contains(layout, "2006") && len(parts[yearIndex]) == 2 {
+		parts[yearIndex] = "20" + parts[yearIndex]
+	}
+
+	date, err := time.Parse(layout, strings.Join(parts, separator))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", raw)
+	}
+	return date, nil
+}
@@ -0,0 +1,60 @@
+package importers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQIF(t *testing.T) {
+	result, err := ParseQIF(openFixture(t, "statement.qif"), "")
+	require.NoError(t, err)
+
+	assert.Empty(t, result.Currency)
+	assert.Nil(t, result.LedgerBalance)
+	require.Len(t, result.Entries, 5)
+
+	assert.Equal(t, 6, result.Entries[0].Line)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), result.Entries[0].Date)
+	assert.Equal(t, int64(300000), result.Entries[0].Amount)
+	assert.Equal(t, "Salário", result.Entries[0].Description)
+
+	// Quicken date with apostrophe and unpadded day
+	assert.Equal(t, time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), result.Entries[1].Date)
+	assert.Equal(t, "Supermercado - Compras do mês", result.Entries[1].Description)
+
+	// Identical entries get distinct external IDs
+	assert.True(t, strings.HasPrefix(result.Entries[2].ExternalID, "QIF-"))
+	assert.Equal(t, result.Entries[2].ExternalID+"-2", result.Entries[3].ExternalID)
+
+	// Last record without terminator
+	assert.Equal(t, int64(-14975), result.Entries[4].Amount)
+
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 23, result.Errors[0].Line)
+	assert.Contains(t, result.Errors[0].Message, "invalid date")
+}
+
+func TestParseQIF_ExternalIDIsStable(t *testing.T) {
+	content := "!Type:CCard\nD10/01/2026\nT-59.90\nPStreaming\n^\n"
+
+	first, err := ParseQIF(strings.NewReader(content), "MM/DD/YYYY")
+	require.NoError(t, err)
+	second, err := ParseQIF(strings.NewReader(content), "MM/DD/YYYY")
+	require.NoError(t, err)
+
+	require.Len(t, first.Entries, 1)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), first.Entries[0].Date)
+	assert.Equal(t, first.Entries[0].ExternalID, second.Entries[0].ExternalID)
+}
+
+func TestParseQIF_InvalidFiles(t *testing.T) {
+	_, err := ParseQIF(strings.NewReader("!Type:Bank\nD01/10/2026\nT1,00\n^\n"), "DD/MM")
+	assert.ErrorContains(t, err, "invalid date format")
+
+	_, err = ParseQIF(strings.NewReader("!Type:Invst\nD01/10/2026\nT1,00\n^\n"), "")
+	assert.ErrorContains(t, err, "no transactions found")
+}
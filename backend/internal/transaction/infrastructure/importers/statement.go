@@ -0,0 +1,91 @@
+package importers
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// StatementEntry is a transaction read from an OFX or QIF statement.
+type StatementEntry struct {
+	Line        int       // Line number where the entry starts (1-based)
+	ExternalID  string    // Bank identifier of the entry (OFX FITID, or derived from the entry for QIF)
+	Date        time.Time // Posting date
+	Amount      int64     // Signed amount in cents (negative for debits)
+	Description string    // Trimmed description
+}
+
+// StatementBalance is a balance reported by the bank at a given date.
+type StatementBalance struct {
+	Amount int64     // Balance in cents
+	Date   time.Time // Date the balance refers to
+}
+
+// StatementParseResult holds the entries, the per-entry errors and the optional
+// balance information of an OFX or QIF statement.
+type StatementParseResult struct {
+	Currency      string            // Statement currency (OFX CURDEF; empty for QIF)
+	Entries       []StatementEntry  // Entries parsed successfully, in file order
+	Errors        []RowError        // Entries that could not be parsed
+	LedgerBalance *StatementBalance // Closing balance (OFX LEDGERBAL; nil if not present)
+}
+
+// decodeStatementText returns the statement as a UTF-8 string.
+// Brazilian banks often export OFX and QIF files in Windows-1252 (or ISO-8859-1); such content
+// is not valid UTF-8 and is converted byte by byte, which is exact for all accented letters.
+func decodeStatementText(content []byte) string {
+	text := string(content)
+	if !utf8.ValidString(text) {
+		var builder strings.Builder
+		builder.Grow(len(content) * 2)
+		for _, b := range content {
+			builder.WriteRune(rune(b))
+		}
+		text = builder.String()
+	}
+	return strings.TrimPrefix(text, "\ufeff") // UTF-8 byte order mark
+}
+
+// guessDecimalSeparator returns the decimal separator of an amount written by a bank that does not
+// declare its number format. When both "," and "." appear the last one is the decimal separator;
+// a lone "," is the decimal separator unless it is followed by exactly three digits ("1,500").
+func guessDecimalSeparator(raw string) string {
+	lastComma := strings.LastIndex(raw, ",")
+	lastDot := strings.LastIndex(raw, ".")
+
+	switch {
+	case lastComma < 0:
+		return "."
+	case lastDot > lastComma:
+		return "."
+	case lastDot >= 0:
+		return ","
+	}
+
+	digits := 0
+	for _, r := range raw[lastComma+1:] {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	if digits == 3 {
+		return "."
+	}
+	return ","
+}
+
+// entryDescription combines the payee and memo of a statement entry into one description.
+// Banks fill either one or both; when both are present and differ they are joined.
+func entryDescription(payee, memo string) string {
+	payee = strings.Join(strings.Fields(payee), " ")
+	memo = strings.Join(strings.Fields(memo), " ")
+
+	switch {
+	case payee == "":
+		return memo
+	case memo == "" || strings.EqualFold(payee, memo):
+		return payee
+	default:
+		return payee + " - " + memo
+	}
+}
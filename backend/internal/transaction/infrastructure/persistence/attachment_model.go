@@ -0,0 +1,22 @@
+package persistence
+
+import "time"
+
+// AttachmentModel represents the database model for Attachment entity.
+// This is the persistence model, separate from the domain entity; the file itself is kept in
+// the file storage under StorageKey.
+type AttachmentModel struct {
+	ID            string    `gorm:"type:uuid;primary_key"`
+	TransactionID string    `gorm:"type:uuid;index:idx_transaction_attachments_transaction;not null"`
+	UserID        string    `gorm:"type:uuid;index;not null"`
+	FileName      string    `gorm:"type:varchar(255);not null"`
+	ContentType   string    `gorm:"type:varchar(100);not null"`
+	SizeBytes     int64     `gorm:"type:bigint;not null"`
+	StorageKey    string    `gorm:"type:varchar(500);not null;uniqueIndex"`
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (AttachmentModel) TableName() string {
+	return "transaction_attachments"
+}
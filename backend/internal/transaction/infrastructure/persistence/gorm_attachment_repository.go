@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+
+	"gorm.io/gorm"
+)
+
+// GormAttachmentRepository implements AttachmentRepository using GORM.
+type GormAttachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAttachmentRepository creates a new GORM attachment repository.
+func NewGormAttachmentRepository(db *gorm.DB) repositories.AttachmentRepository {
+	return &GormAttachmentRepository{db: db}
+}
+
+// FindByID finds an attachment by its ID.
+func (r *GormAttachmentRepository) FindByID(id transactionvalueobjects.AttachmentID) (*entities.Attachment, error) {
+	var model AttachmentModel
+	if err := r.db.Where("id = ?", id.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find attachment by ID: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByTransactionID finds all attachments of a transaction, oldest first.
+// Uses index idx_transaction_attachments_transaction.
+func (r *GormAttachmentRepository) FindByTransactionID(transactionID transactionvalueobjects.TransactionID) ([]*entities.Attachment, error) {
+	var models []AttachmentModel
+	if err := r.db.Where("transaction_id = ?", transactionID.Value()).
+		Order("created_at ASC, id ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find attachments by transaction ID: %w", err)
+	}
+
+	attachments := make([]*entities.Attachment, 0, len(models))
+	for _, model := range models {
+		attachment, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert attachment model to domain: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+// Save saves an attachment.
+func (r *GormAttachmentRepository) Save(attachment *entities.Attachment) error {
+	if err := r.db.Save(r.toModel(attachment)).Error; err != nil {
+		return fmt.Errorf("failed to save attachment: %w", err)
+	}
+	return nil
+}
+
+// Delete permanently deletes an attachment by its ID.
+func (r *GormAttachmentRepository) Delete(id transactionvalueobjects.AttachmentID) error {
+	if err := r.db.Where("id = ?", id.Value()).Delete(&AttachmentModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return nil
+}
+
+// toDomain converts an AttachmentModel to an Attachment entity.
+func (r *GormAttachmentRepository) toDomain(model *AttachmentModel) (*entities.Attachment, error) {
+	id, err := transactionvalueobjects.NewAttachmentID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attachment ID: %w", err)
+	}
+
+	transactionID, err := transactionvalueobjects.NewTransactionID(model.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	return entities.AttachmentFromPersistence(
+		id,
+		transactionID,
+		userID,
+		model.FileName,
+		model.ContentType,
+		model.SizeBytes,
+		model.StorageKey,
+		model.CreatedAt,
+	)
+}
+
+// toModel converts an Attachment entity to an AttachmentModel.
+func (r *GormAttachmentRepository) toModel(attachment *entities.Attachment) *AttachmentModel {
+	return &AttachmentModel{
+		ID:            attachment.ID().Value(),
+		TransactionID: attachment.TransactionID().Value(),
+		UserID:        attachment.UserID().Value(),
+		FileName:      attachment.FileName(),
+		ContentType:   attachment.ContentType(),
+		SizeBytes:     attachment.Size(),
+		StorageKey:    attachment.StorageKey(),
+		CreatedAt:     attachment.CreatedAt(),
+	}
+}
@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"testing"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestGormAttachmentRepository_SaveFindAndDelete(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormAttachmentRepository(db)
+
+	userID := identityvalueobjects.GenerateUserID()
+	transactionID := transactionvalueobjects.GenerateTransactionID()
+
+	receipt, err := entities.NewAttachment(transactionID, userID, "recibo.pdf", "application/pdf", 2048)
+	if err != nil {
+		t.Fatalf("NewAttachment() error = %v", err)
+	}
+	photo, err := entities.NewAttachment(transactionID, userID, "nota.jpg", "image/jpeg", 4096)
+	if err != nil {
+		t.Fatalf("NewAttachment() error = %v", err)
+	}
+	other, _ := entities.NewAttachment(transactionvalueobjects.GenerateTransactionID(), userID, "outro.png", "image/png", 100)
+
+	for _, attachment := range []*entities.Attachment{receipt, photo, other} {
+		if err := repo.Save(attachment); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	found, err := repo.FindByID(receipt.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found == nil || found.FileName() != "recibo.pdf" || found.ContentType() != "application/pdf" ||
+		found.Size() != 2048 || found.StorageKey() != receipt.StorageKey() || !found.TransactionID().Equals(transactionID) {
+		t.Errorf("FindByID() = %+v, want the saved receipt", found)
+	}
+
+	attachments, err := repo.FindByTransactionID(transactionID)
+	if err != nil {
+		t.Fatalf("FindByTransactionID() error = %v", err)
+	}
+	if len(attachments) != 2 {
+		t.Fatalf("FindByTransactionID() returned %d attachments, want 2", len(attachments))
+	}
+
+	if err := repo.Delete(receipt.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	found, err = repo.FindByID(receipt.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found != nil {
+		t.Error("FindByID() after Delete() should return nil")
+	}
+}
@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+
+	"gorm.io/gorm"
+)
+
+// GormImportBatchRepository implements ImportBatchRepository using GORM.
+type GormImportBatchRepository struct {
+	db *gorm.DB
+}
+
+// NewGormImportBatchRepository creates a new GORM import batch repository.
+func NewGormImportBatchRepository(db *gorm.DB) repositories.ImportBatchRepository {
+	return &GormImportBatchRepository{db: db}
+}
+
+// FindByID finds an import batch by its ID.
+func (r *GormImportBatchRepository) FindByID(id transactionvalueobjects.ImportBatchID) (*entities.ImportBatch, error) {
+	var model ImportBatchModel
+	if err := r.db.Where("id = ?", id.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find import batch by ID: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByUserID finds all import batches for a given user, newest first.
+func (r *GormImportBatchRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.ImportBatch, error) {
+	var models []ImportBatchModel
+	if err := r.db.Where("user_id = ?", userID.Value()).Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find import batches by user ID: %w", err)
+	}
+
+	batches := make([]*entities.ImportBatch, 0, len(models))
+	for _, model := range models {
+		batch, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert import batch model to domain: %w", err)
+		}
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
+
+// Save saves or updates an import batch.
+func (r *GormImportBatchRepository) Save(batch *entities.ImportBatch) error {
+	model := r.toModel(batch)
+
+	if err := r.db.Save(model).Error; err != nil {
+		return fmt.Errorf("failed to save import batch: %w", err)
+	}
+
+	return nil
+}
+
+// toDomain converts an ImportBatchModel to an ImportBatch entity.
+func (r *GormImportBatchRepository) toDomain(model *ImportBatchModel) (*entities.ImportBatch, error) {
+	id, err := transactionvalueobjects.NewImportBatchID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid import batch ID: %w", err)
+	}
+
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(model.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	return entities.ImportBatchFromPersistence(
+		id,
+		userID,
+		accountID,
+		model.Source,
+		model.FileName,
+		model.Status,
+		model.TransactionCount,
+		model.CreatedAt,
+		model.UpdatedAt,
+		model.RolledBackAt,
+	)
+}
+
+// toModel converts an ImportBatch entity to an ImportBatchModel.
+func (r *GormImportBatchRepository) toModel(batch *entities.ImportBatch) *ImportBatchModel {
+	return &ImportBatchModel{
+		ID:               batch.ID().Value(),
+		UserID:           batch.UserID().Value(),
+		AccountID:        batch.AccountID().Value(),
+		Source:           batch.Source(),
+		FileName:         batch.FileName(),
+		Status:           batch.Status(),
+		TransactionCount: batch.TransactionCount(),
+		CreatedAt:        batch.CreatedAt(),
+		UpdatedAt:        batch.UpdatedAt(),
+		RolledBackAt:     batch.RolledBackAt(),
+	}
+}
@@ -0,0 +1,123 @@
+package persistence
+
+import (
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+)
+
+func TestGormImportBatchRepository_SaveAndFind(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	batchRepo := NewGormImportBatchRepository(db)
+	transactionRepo := NewGormTransactionRepository(db)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	batch, err := entities.NewImportBatch(userID, accountID, entities.ImportSourceCSV, "extrato.csv", 2)
+	if err != nil {
+		t.Fatalf("NewImportBatch() error = %v", err)
+	}
+	if err := batchRepo.Save(batch); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// Two imported transactions and one manual transaction
+	for i := 0; i < 2; i++ {
+		transaction := createTestTransactionEntity(t, userID, accountID)
+		if err := transaction.AttachToImportBatch(batch.ID()); err != nil {
+			t.Fatalf("AttachToImportBatch() error = %v", err)
+		}
+		if err := transactionRepo.Save(transaction); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	if err := transactionRepo.Save(createTestTransactionEntity(t, userID, accountID)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	imported, err := transactionRepo.FindByImportBatchID(batch.ID())
+	if err != nil {
+		t.Fatalf("FindByImportBatchID() error = %v", err)
+	}
+	if len(imported) != 2 {
+		t.Fatalf("FindByImportBatchID() = %d transactions, want 2", len(imported))
+	}
+	if imported[0].ImportBatchID() == nil || !imported[0].ImportBatchID().Equals(batch.ID()) {
+		t.Errorf("FindByImportBatchID() transaction import batch = %v, want %s", imported[0].ImportBatchID(), batch.ID().Value())
+	}
+
+	// Rolling back updates the stored batch
+	if err := batch.RollBack(); err != nil {
+		t.Fatalf("RollBack() error = %v", err)
+	}
+	if err := batchRepo.Save(batch); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := batchRepo.FindByID(batch.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found == nil || !found.IsRolledBack() || found.RolledBackAt() == nil {
+		t.Fatalf("FindByID() = %+v, want rolled back batch", found)
+	}
+	if found.FileName() != "extrato.csv" || found.TransactionCount() != 2 {
+		t.Errorf("FindByID() file = %q, count = %d, want extrato.csv and 2", found.FileName(), found.TransactionCount())
+	}
+
+	batches, err := batchRepo.FindByUserID(userID)
+	if err != nil {
+		t.Fatalf("FindByUserID() error = %v", err)
+	}
+	if len(batches) != 1 {
+		t.Errorf("FindByUserID() = %d batches, want 1", len(batches))
+	}
+}
+
+func TestGormTransactionRepository_FindExistingExternalIDs(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := NewGormTransactionRepository(db)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+
+	imported := createTestTransactionEntity(t, userID, accountID)
+	if err := imported.AssignExternalID("20261001001"); err != nil {
+		t.Fatalf("AssignExternalID() error = %v", err)
+	}
+	deleted := createTestTransactionEntity(t, userID, accountID)
+	if err := deleted.AssignExternalID("20261002001"); err != nil {
+		t.Fatalf("AssignExternalID() error = %v", err)
+	}
+	otherAccount := createTestTransactionEntity(t, userID, accountvalueobjects.GenerateAccountID())
+	if err := otherAccount.AssignExternalID("20261003001"); err != nil {
+		t.Fatalf("AssignExternalID() error = %v", err)
+	}
+	for _, transaction := range []*entities.Transaction{imported, deleted, otherAccount} {
+		if err := transactionRepo.Save(transaction); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	if err := transactionRepo.Delete(deleted.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	existing, err := transactionRepo.FindExistingExternalIDs(accountID, []string{"20261001001", "20261002001", "20261003001", "20261004001"})
+	if err != nil {
+		t.Fatalf("FindExistingExternalIDs() error = %v", err)
+	}
+	if len(existing) != 1 || !existing["20261001001"] {
+		t.Errorf("FindExistingExternalIDs() = %v, want only 20261001001", existing)
+	}
+
+	found, err := transactionRepo.FindByID(imported.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.ExternalID() != "20261001001" {
+		t.Errorf("FindByID() external ID = %q, want 20261001001", found.ExternalID())
+	}
+}
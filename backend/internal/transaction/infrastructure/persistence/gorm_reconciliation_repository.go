@@ -0,0 +1,125 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+
+	"gorm.io/gorm"
+)
+
+// GormReconciliationRepository implements ReconciliationRepository using GORM.
+type GormReconciliationRepository struct {
+	db *gorm.DB
+}
+
+// NewGormReconciliationRepository creates a new GORM reconciliation repository.
+func NewGormReconciliationRepository(db *gorm.DB) repositories.ReconciliationRepository {
+	return &GormReconciliationRepository{db: db}
+}
+
+// FindByID finds a reconciliation by its ID.
+func (r *GormReconciliationRepository) FindByID(id transactionvalueobjects.ReconciliationID) (*entities.Reconciliation, error) {
+	var model ReconciliationModel
+	if err := r.db.Where("id = ?", id.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find reconciliation by ID: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByAccountID finds the reconciliation history of an account, latest statement first.
+func (r *GormReconciliationRepository) FindByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Reconciliation, error) {
+	var models []ReconciliationModel
+	if err := r.db.Where("account_id = ?", accountID.Value()).Order("statement_date DESC, created_at DESC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find reconciliations by account ID: %w", err)
+	}
+
+	reconciliations := make([]*entities.Reconciliation, 0, len(models))
+	for _, model := range models {
+		reconciliation, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert reconciliation model to domain: %w", err)
+		}
+		reconciliations = append(reconciliations, reconciliation)
+	}
+
+	return reconciliations, nil
+}
+
+// Save saves or updates a reconciliation.
+func (r *GormReconciliationRepository) Save(reconciliation *entities.Reconciliation) error {
+	model := r.toModel(reconciliation)
+
+	if err := r.db.Save(model).Error; err != nil {
+		return fmt.Errorf("failed to save reconciliation: %w", err)
+	}
+
+	return nil
+}
+
+// toDomain converts a ReconciliationModel to a Reconciliation entity.
+func (r *GormReconciliationRepository) toDomain(model *ReconciliationModel) (*entities.Reconciliation, error) {
+	id, err := transactionvalueobjects.NewReconciliationID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reconciliation ID: %w", err)
+	}
+
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	accountID, err := accountvalueobjects.NewAccountID(model.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	statementBalance, err := valueobjects.NewMoneyFromString(model.StatementBalance, model.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statement balance: %w", err)
+	}
+
+	return entities.ReconciliationFromPersistence(
+		id,
+		userID,
+		accountID,
+		model.StatementDate,
+		statementBalance,
+		model.Status,
+		model.ReconciledCount,
+		model.CreatedAt,
+		model.UpdatedAt,
+		model.CompletedAt,
+		model.CanceledAt,
+	)
+}
+
+// toModel converts a Reconciliation entity to a ReconciliationModel.
+func (r *GormReconciliationRepository) toModel(reconciliation *entities.Reconciliation) *ReconciliationModel {
+	statementBalance := reconciliation.StatementBalance()
+
+	return &ReconciliationModel{
+		ID:               reconciliation.ID().Value(),
+		UserID:           reconciliation.UserID().Value(),
+		AccountID:        reconciliation.AccountID().Value(),
+		StatementDate:    reconciliation.StatementDate(),
+		StatementBalance: statementBalance.Amount(), // Amount in cents
+		Currency:         statementBalance.Currency().Code(),
+		Status:           reconciliation.Status(),
+		ReconciledCount:  reconciliation.ReconciledCount(),
+		CreatedAt:        reconciliation.CreatedAt(),
+		UpdatedAt:        reconciliation.UpdatedAt(),
+		CompletedAt:      reconciliation.CompletedAt(),
+		CanceledAt:       reconciliation.CanceledAt(),
+	}
+}
@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestGormReconciliationRepository_SaveAndFind(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	reconciliationRepo := NewGormReconciliationRepository(db)
+	transactionRepo := NewGormTransactionRepository(db)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	statementBalance, _ := sharedvalueobjects.NewMoney(100000, sharedvalueobjects.BRLCurrency())
+
+	previous, err := entities.NewReconciliation(userID, accountID, time.Date(2026, 8, 31, 0, 0, 0, 0, time.UTC), statementBalance)
+	if err != nil {
+		t.Fatalf("NewReconciliation() error = %v", err)
+	}
+	if err := previous.Cancel(); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if err := reconciliationRepo.Save(previous); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reconciliation, err := entities.NewReconciliation(userID, accountID, time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC), statementBalance)
+	if err != nil {
+		t.Fatalf("NewReconciliation() error = %v", err)
+	}
+	if err := reconciliationRepo.Save(reconciliation); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// One cleared transaction gets reconciled, one stays pending
+	cleared := createTestTransactionEntity(t, userID, accountID)
+	if err := cleared.UpdateStatus(transactionvalueobjects.ClearedStatus()); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+	if err := cleared.Reconcile(reconciliation.ID()); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := transactionRepo.Save(cleared); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	pending := createTestTransactionEntity(t, userID, accountID)
+	if err := transactionRepo.Save(pending); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if err := reconciliation.Complete(statementBalance, 1); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if err := reconciliationRepo.Save(reconciliation); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := reconciliationRepo.FindByID(reconciliation.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found == nil {
+		t.Fatal("FindByID() = nil, want reconciliation")
+	}
+	if !found.IsCompleted() || found.ReconciledCount() != 1 || found.CompletedAt() == nil {
+		t.Errorf("FindByID() status = %s, count = %d, completedAt = %v", found.Status(), found.ReconciledCount(), found.CompletedAt())
+	}
+	if !found.StatementBalance().Equals(statementBalance) {
+		t.Errorf("FindByID() statement balance = %s, want %s", found.StatementBalance(), statementBalance)
+	}
+
+	history, err := reconciliationRepo.FindByAccountID(accountID)
+	if err != nil {
+		t.Fatalf("FindByAccountID() error = %v", err)
+	}
+	if len(history) != 2 || !history[0].ID().Equals(reconciliation.ID()) {
+		t.Fatalf("FindByAccountID() = %d reconciliations, want latest statement first", len(history))
+	}
+
+	missing, err := reconciliationRepo.FindByID(transactionvalueobjects.GenerateReconciliationID())
+	if err != nil || missing != nil {
+		t.Errorf("FindByID() missing = %v, %v, want nil, nil", missing, err)
+	}
+
+	// Reconciled transactions keep their status and are left out of the unreconciled ones
+	stored, err := transactionRepo.FindByID(cleared.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !stored.IsReconciled() || stored.ReconciliationID() == nil || !stored.ReconciliationID().Equals(reconciliation.ID()) {
+		t.Errorf("stored transaction status = %s, reconciliation = %v", stored.Status(), stored.ReconciliationID())
+	}
+
+	unreconciled, err := transactionRepo.FindUnreconciledByAccountID(accountID)
+	if err != nil {
+		t.Fatalf("FindUnreconciledByAccountID() error = %v", err)
+	}
+	if len(unreconciled) != 1 || !unreconciled[0].ID().Equals(pending.ID()) {
+		t.Fatalf("FindUnreconciledByAccountID() = %d transactions, want only the pending one", len(unreconciled))
+	}
+	if !unreconciled[0].Status().IsPending() {
+		t.Errorf("FindUnreconciledByAccountID() status = %s, want PENDING", unreconciled[0].Status())
+	}
+}
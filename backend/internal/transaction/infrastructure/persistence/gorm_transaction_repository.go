@@ -3,16 +3,22 @@ package persistence
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	payeevalueobjects "gestao-financeira/backend/internal/payee/domain/valueobjects"
 	"gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
 	"gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GormTransactionRepository implements TransactionRepository using GORM.
@@ -28,7 +34,7 @@ func NewGormTransactionRepository(db *gorm.DB) repositories.TransactionRepositor
 // FindByID finds a transaction by its ID.
 func (r *GormTransactionRepository) FindByID(id transactionvalueobjects.TransactionID) (*entities.Transaction, error) {
 	var model TransactionModel
-	if err := r.db.Where("id = ?", id.Value()).First(&model).Error; err != nil {
+	if err := r.db.Where("id = ?", id.Value()).Scopes(preloadSplits, preloadTags, preloadRecurrence).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -41,7 +47,7 @@ func (r *GormTransactionRepository) FindByID(id transactionvalueobjects.Transact
 // FindByUserID finds all transactions for a given user.
 func (r *GormTransactionRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error) {
 	var models []TransactionModel
-	if err := r.db.Where("user_id = ?", userID.Value()).Order("date DESC, created_at DESC").Find(&models).Error; err != nil {
+	if err := r.db.Where("user_id = ?", userID.Value()).Order("date DESC, created_at DESC").Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to find transactions by user ID: %w", err)
 	}
 
@@ -73,7 +79,7 @@ func (r *GormTransactionRepository) FindByUserIDWithPagination(userID identityva
 		Order("date DESC, created_at DESC").
 		Offset(offset).
 		Limit(limit).
-		Find(&models).Error; err != nil {
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to find transactions by user ID: %w", err)
 	}
 
@@ -89,25 +95,17 @@ func (r *GormTransactionRepository) FindByUserIDWithPagination(userID identityva
 	return transactions, total, nil
 }
 
-// FindByUserIDAndFiltersWithPagination finds transactions with filters and pagination.
+// FindByUserIDAndFiltersWithPagination finds the transactions of a user that match the filter,
+// sorted as the filter asks, with pagination.
 func (r *GormTransactionRepository) FindByUserIDAndFiltersWithPagination(
 	userID identityvalueobjects.UserID,
-	accountID string,
-	transactionType string,
+	filter repositories.TransactionFilter,
 	offset, limit int,
 ) ([]*entities.Transaction, int64, error) {
 	var models []TransactionModel
 	var total int64
 
-	query := r.db.Model(&TransactionModel{}).Where("user_id = ?", userID.Value())
-
-	if accountID != "" {
-		query = query.Where("account_id = ?", accountID)
-	}
-
-	if transactionType != "" {
-		query = query.Where("type = ?", transactionType)
-	}
+	query := r.applyTransactionFilter(r.db.Model(&TransactionModel{}).Where("user_id = ?", userID.Value()), filter)
 
 	// Count total - optimized to use appropriate index
 	if err := query.Count(&total).Error; err != nil {
@@ -119,10 +117,10 @@ func (r *GormTransactionRepository) FindByUserIDAndFiltersWithPagination(
 	// If account is specified, use idx_transactions_account_date
 	// Otherwise, use idx_transactions_user_date
 	if err := query.
-		Order("date DESC, created_at DESC").
+		Order(transactionOrder(filter)).
 		Offset(offset).
 		Limit(limit).
-		Find(&models).Error; err != nil {
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to find transactions: %w", err)
 	}
 
@@ -138,10 +136,161 @@ func (r *GormTransactionRepository) FindByUserIDAndFiltersWithPagination(
 	return transactions, total, nil
 }
 
+// FindByUserIDAndFiltersWithKeyset finds up to limit transactions of a user that match the filter
+// and come after (or, backward, before) the keyset in the order of the filter.
+// The position is compared as a row value on the sort columns, which an index on
+// (user_id, date, created_at, id) serves without scanning the skipped transactions.
+func (r *GormTransactionRepository) FindByUserIDAndFiltersWithKeyset(
+	userID identityvalueobjects.UserID,
+	filter repositories.TransactionFilter,
+	keyset *repositories.TransactionKeyset,
+	backward bool,
+	limit int,
+) ([]*entities.Transaction, error) {
+	query := r.applyTransactionFilter(r.db.Model(&TransactionModel{}).Where("user_id = ?", userID.Value()), filter)
+
+	// Sort columns and the keyset values compared with them (used only when keyset is set)
+	var position repositories.TransactionKeyset
+	if keyset != nil {
+		position = *keyset
+	}
+	columns := []string{"date", "created_at", "id"}
+	values := []any{position.Date, position.CreatedAt, position.ID.Value()}
+	switch filter.SortBy {
+	case repositories.SortByAmount:
+		columns = append([]string{"amount"}, columns...)
+		values = append([]any{position.Amount}, values...)
+	case repositories.SortByDescription:
+		columns = append([]string{"LOWER(description)"}, columns...)
+		values = append([]any{position.Description}, values...)
+	}
+
+	// Going backward reads the list in the opposite order, starting next to the keyset
+	direction, comparison := "DESC", "<"
+	if filter.SortAscending != backward {
+		direction, comparison = "ASC", ">"
+	}
+
+	if keyset != nil {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+		query = query.Where(fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), comparison, placeholders), values...)
+	}
+
+	order := make([]string, 0, len(columns))
+	for _, column := range columns {
+		order = append(order, column+" "+direction)
+	}
+
+	var models []TransactionModel
+	if err := query.
+		Order(strings.Join(order, ", ")).
+		Limit(limit).
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	transactions := make([]*entities.Transaction, 0, len(models))
+	for _, model := range models {
+		transaction, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transaction model to domain: %w", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+// applyTransactionFilter adds the criteria of the filter to a transactions query.
+func (r *GormTransactionRepository) applyTransactionFilter(query *gorm.DB, filter repositories.TransactionFilter) *gorm.DB {
+	if filter.AccountID != nil {
+		query = query.Where("account_id = ?", filter.AccountID.Value())
+	}
+
+	if filter.Type != nil {
+		query = query.Where("type = ?", filter.Type.Value())
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", filter.Status.Value())
+	}
+
+	if filter.PayeeID != nil {
+		query = query.Where("payee_id = ?", filter.PayeeID.Value())
+	}
+
+	if len(filter.TagIDs) > 0 {
+		tagIDs := make([]string, 0, len(filter.TagIDs))
+		for _, tagID := range filter.TagIDs {
+			tagIDs = append(tagIDs, tagID.Value())
+		}
+		tagged := r.db.Model(&TransactionTagModel{}).Select("transaction_id").Where("tag_id IN ?", tagIDs)
+		if filter.MatchAllTags {
+			tagged = tagged.Group("transaction_id").Having("COUNT(DISTINCT tag_id) = ?", len(tagIDs))
+		}
+		query = query.Where("id IN (?)", tagged)
+	}
+
+	if filter.StartDate != nil {
+		query = query.Where("date >= ?", filter.StartDate.Format("2006-01-02"))
+	}
+	if filter.EndDate != nil {
+		query = query.Where("date <= ?", filter.EndDate.Format("2006-01-02"))
+	}
+
+	if filter.MinAmount != nil {
+		query = query.Where("amount >= ?", *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filter.MaxAmount)
+	}
+
+	if filter.Currency != nil {
+		query = query.Where("currency = ?", filter.Currency.Code())
+	}
+
+	if filter.RecurringOnly {
+		query = query.Where("(is_recurring = ? OR parent_transaction_id IS NOT NULL)", true)
+	}
+
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		if r.db.Dialector.Name() == "postgres" {
+			// search_vector is maintained by the database with the portuguese_unaccent configuration
+			// (see migration 000020), so accents are ignored and word variations match
+			query = query.Where("search_vector @@ websearch_to_tsquery('portuguese_unaccent', ?)", search)
+		} else {
+			// Databases without full-text search (tests) fall back to matching every word
+			for _, word := range strings.Fields(strings.ToLower(search)) {
+				query = query.Where("LOWER(description) LIKE ?", "%"+word+"%")
+			}
+		}
+	}
+
+	return query
+}
+
+// transactionOrder returns the ORDER BY clause for the sort field and direction of the filter.
+// Ties are broken by date, creation time and ID in the same direction, like the keyset order.
+func transactionOrder(filter repositories.TransactionFilter) string {
+	direction := "DESC"
+	if filter.SortAscending {
+		direction = "ASC"
+	}
+
+	switch filter.SortBy {
+	case repositories.SortByAmount:
+		return fmt.Sprintf("amount %[1]s, date %[1]s, created_at %[1]s, id %[1]s", direction)
+	case repositories.SortByDescription:
+		return fmt.Sprintf("LOWER(description) %[1]s, date %[1]s, created_at %[1]s, id %[1]s", direction)
+	default:
+		return fmt.Sprintf("date %[1]s, created_at %[1]s, id %[1]s", direction)
+	}
+}
+
 // FindByAccountID finds all transactions for a given account.
 func (r *GormTransactionRepository) FindByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
 	var models []TransactionModel
-	if err := r.db.Where("account_id = ?", accountID.Value()).Find(&models).Error; err != nil {
+	if err := r.db.Where("account_id = ?", accountID.Value()).Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to find transactions by account ID: %w", err)
 	}
 
@@ -157,10 +306,71 @@ func (r *GormTransactionRepository) FindByAccountID(accountID accountvalueobject
 	return transactions, nil
 }
 
+// FindByImportBatchID finds all transactions created by a statement import batch.
+func (r *GormTransactionRepository) FindByImportBatchID(batchID transactionvalueobjects.ImportBatchID) ([]*entities.Transaction, error) {
+	var models []TransactionModel
+	if err := r.db.Where("import_batch_id = ?", batchID.Value()).Order("date ASC, created_at ASC").Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find transactions by import batch ID: %w", err)
+	}
+
+	transactions := make([]*entities.Transaction, 0, len(models))
+	for _, model := range models {
+		transaction, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transaction model to domain: %w", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+// FindExistingExternalIDs returns which of the given external IDs are already used by transactions of the account.
+// Soft deleted transactions are excluded, so entries of a rolled back import can be imported again.
+func (r *GormTransactionRepository) FindExistingExternalIDs(accountID accountvalueobjects.AccountID, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	var found []string
+	if err := r.db.Model(&TransactionModel{}).
+		Where("account_id = ? AND external_id IN ?", accountID.Value(), externalIDs).
+		Pluck("external_id", &found).Error; err != nil {
+		return nil, fmt.Errorf("failed to find existing external IDs: %w", err)
+	}
+
+	for _, externalID := range found {
+		existing[externalID] = true
+	}
+	return existing, nil
+}
+
+// FindUnreconciledByAccountID finds the pending and cleared transactions of an account, oldest first.
+func (r *GormTransactionRepository) FindUnreconciledByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
+	var models []TransactionModel
+	if err := r.db.Where("account_id = ? AND status <> ?", accountID.Value(), transactionvalueobjects.Reconciled).
+		Order("date ASC, created_at ASC").
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find unreconciled transactions by account ID: %w", err)
+	}
+
+	transactions := make([]*entities.Transaction, 0, len(models))
+	for _, model := range models {
+		transaction, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transaction model to domain: %w", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
 // FindByUserIDAndAccountID finds all transactions for a given user and account.
 func (r *GormTransactionRepository) FindByUserIDAndAccountID(userID identityvalueobjects.UserID, accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
 	var models []TransactionModel
-	if err := r.db.Where("user_id = ? AND account_id = ?", userID.Value(), accountID.Value()).Find(&models).Error; err != nil {
+	if err := r.db.Where("user_id = ? AND account_id = ?", userID.Value(), accountID.Value()).Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to find transactions by user ID and account ID: %w", err)
 	}
 
@@ -179,7 +389,7 @@ func (r *GormTransactionRepository) FindByUserIDAndAccountID(userID identityvalu
 // FindByUserIDAndType finds all transactions for a given user filtered by type.
 func (r *GormTransactionRepository) FindByUserIDAndType(userID identityvalueobjects.UserID, transactionType transactionvalueobjects.TransactionType) ([]*entities.Transaction, error) {
 	var models []TransactionModel
-	if err := r.db.Where("user_id = ? AND type = ?", userID.Value(), transactionType.Value()).Find(&models).Error; err != nil {
+	if err := r.db.Where("user_id = ? AND type = ?", userID.Value(), transactionType.Value()).Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to find transactions by user ID and type: %w", err)
 	}
 
@@ -205,21 +415,114 @@ func (r *GormTransactionRepository) Save(transaction *entities.Transaction) erro
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		// Create new transaction
-		if err := r.db.Create(model).Error; err != nil {
+		if err := r.db.Omit(clause.Associations).Create(model).Error; err != nil {
 			return fmt.Errorf("failed to create transaction: %w", err)
 		}
 	} else if err != nil {
 		return fmt.Errorf("failed to check transaction existence: %w", err)
 	} else {
 		// Update existing transaction
-		if err := r.db.Save(model).Error; err != nil {
+		if err := r.db.Omit(clause.Associations).Save(model).Error; err != nil {
 			return fmt.Errorf("failed to update transaction: %w", err)
 		}
 	}
 
+	if err := r.replaceSplits(model); err != nil {
+		return err
+	}
+
+	if err := r.replaceTags(model); err != nil {
+		return err
+	}
+
+	return r.replaceRecurrence(model)
+}
+
+// replaceSplits replaces the persisted split lines of a transaction with the ones in the model.
+func (r *GormTransactionRepository) replaceSplits(model *TransactionModel) error {
+	if err := r.db.Where("transaction_id = ?", model.ID).Delete(&TransactionSplitModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete transaction splits: %w", err)
+	}
+
+	if len(model.Splits) == 0 {
+		return nil
+	}
+
+	if err := r.db.Create(&model.Splits).Error; err != nil {
+		return fmt.Errorf("failed to create transaction splits: %w", err)
+	}
+
+	return nil
+}
+
+// preloadSplits loads the split lines of the queried transactions in their original order.
+func preloadSplits(db *gorm.DB) *gorm.DB {
+	return db.Preload("Splits", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	})
+}
+
+// replaceTags replaces the persisted tags of a transaction with the ones in the model.
+func (r *GormTransactionRepository) replaceTags(model *TransactionModel) error {
+	if err := r.db.Where("transaction_id = ?", model.ID).Delete(&TransactionTagModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete transaction tags: %w", err)
+	}
+
+	if len(model.Tags) == 0 {
+		return nil
+	}
+
+	if err := r.db.Create(&model.Tags).Error; err != nil {
+		return fmt.Errorf("failed to create transaction tags: %w", err)
+	}
+
+	return nil
+}
+
+// preloadTags loads the tags of the queried transactions.
+func preloadTags(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC, tag_id ASC")
+	})
+}
+
+// replaceRecurrence replaces the persisted skipped dates and amount changes of a recurring series
+// with the ones in the model. Only transactions that start a series can have them.
+func (r *GormTransactionRepository) replaceRecurrence(model *TransactionModel) error {
+	if !model.IsRecurring || model.ParentTransactionID != nil {
+		return nil
+	}
+
+	if err := r.db.Where("transaction_id = ?", model.ID).Delete(&TransactionRecurrenceSkipModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete recurrence skips: %w", err)
+	}
+	if len(model.RecurrenceSkips) > 0 {
+		if err := r.db.Create(&model.RecurrenceSkips).Error; err != nil {
+			return fmt.Errorf("failed to create recurrence skips: %w", err)
+		}
+	}
+
+	if err := r.db.Where("transaction_id = ?", model.ID).Delete(&TransactionRecurrenceAmountModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete recurrence amount changes: %w", err)
+	}
+	if len(model.RecurrenceAmounts) > 0 {
+		if err := r.db.Create(&model.RecurrenceAmounts).Error; err != nil {
+			return fmt.Errorf("failed to create recurrence amount changes: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// preloadRecurrence loads the skipped dates and amount changes of the queried recurring series.
+func preloadRecurrence(db *gorm.DB) *gorm.DB {
+	return db.Preload("RecurrenceSkips", func(db *gorm.DB) *gorm.DB {
+		return db.Order("date ASC")
+	}).Preload("RecurrenceAmounts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("effective_date ASC")
+	})
+}
+
 // Delete deletes a transaction by its ID (soft delete).
 func (r *GormTransactionRepository) Delete(id transactionvalueobjects.TransactionID) error {
 	if err := r.db.Where("id = ?", id.Value()).Delete(&TransactionModel{}).Error; err != nil {
@@ -296,13 +599,14 @@ func (r *GormTransactionRepository) FindActiveRecurringTransactions() ([]*entiti
 
 	// Find transactions where:
 	// - is_recurring = true
-	// - (recurrence_end_date IS NULL OR recurrence_end_date >= today)
+	// - (recurrence_end_date IS NULL OR recurrence_end_date >= today), or the generation stopped
+	//   before the end date (catch-up after the processor did not run for a while)
 	// - parent_transaction_id IS NULL (only parent transactions, not generated instances)
 	// Uses index idx_transactions_active_recurring for optimal performance
 	query := r.db.Where("is_recurring = ? AND parent_transaction_id IS NULL", true).
-		Where("recurrence_end_date IS NULL OR recurrence_end_date >= ?", today)
+		Where("recurrence_end_date IS NULL OR recurrence_end_date >= ? OR recurrence_generated_until < recurrence_end_date", today)
 
-	if err := query.Find(&models).Error; err != nil {
+	if err := query.Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to find active recurring transactions: %w", err)
 	}
 
@@ -318,6 +622,28 @@ func (r *GormTransactionRepository) FindActiveRecurringTransactions() ([]*entiti
 	return transactions, nil
 }
 
+// FindRecurringSeriesByUserID finds the transactions that start a recurring series of a user,
+// ended or not, ordered by description.
+func (r *GormTransactionRepository) FindRecurringSeriesByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error) {
+	var models []TransactionModel
+	if err := r.db.Where("user_id = ? AND is_recurring = ? AND parent_transaction_id IS NULL", userID.Value(), true).
+		Order("description ASC, date ASC").
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find recurring series: %w", err)
+	}
+
+	transactions := make([]*entities.Transaction, 0, len(models))
+	for _, model := range models {
+		transaction, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transaction model to domain: %w", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
 // FindByParentIDAndDate finds a transaction instance by parent transaction ID and date.
 func (r *GormTransactionRepository) FindByParentIDAndDate(
 	parentID transactionvalueobjects.TransactionID,
@@ -329,7 +655,7 @@ func (r *GormTransactionRepository) FindByParentIDAndDate(
 	dateEnd := dateStart.Add(24 * time.Hour)
 
 	// Use date range to handle timezone differences
-	if err := r.db.Where("parent_transaction_id = ? AND date >= ? AND date < ?", parentID.Value(), dateStart, dateEnd).First(&model).Error; err != nil {
+	if err := r.db.Where("parent_transaction_id = ? AND date >= ? AND date < ?", parentID.Value(), dateStart, dateEnd).Scopes(preloadSplits, preloadTags, preloadRecurrence).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -339,6 +665,27 @@ func (r *GormTransactionRepository) FindByParentIDAndDate(
 	return r.toDomain(&model)
 }
 
+// FindByParentID finds the transactions that reference the given parent transaction, oldest first.
+func (r *GormTransactionRepository) FindByParentID(parentID transactionvalueobjects.TransactionID) ([]*entities.Transaction, error) {
+	var models []TransactionModel
+	if err := r.db.Where("parent_transaction_id = ?", parentID.Value()).
+		Order("date ASC, created_at ASC").
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find transactions by parent ID: %w", err)
+	}
+
+	transactions := make([]*entities.Transaction, 0, len(models))
+	for _, model := range models {
+		transaction, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transaction model to domain: %w", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
 // FindByUserIDAndDateRange finds transactions for a given user within a date range.
 func (r *GormTransactionRepository) FindByUserIDAndDateRange(
 	userID identityvalueobjects.UserID,
@@ -351,7 +698,7 @@ func (r *GormTransactionRepository) FindByUserIDAndDateRange(
 	// Use index idx_transactions_user_date for optimal performance
 	if err := r.db.Where("user_id = ? AND date >= ? AND date <= ?", userID.Value(), startDateStr, endDateStr).
 		Order("date DESC, created_at DESC").
-		Find(&models).Error; err != nil {
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to find transactions by user ID and date range: %w", err)
 	}
 
@@ -380,7 +727,7 @@ func (r *GormTransactionRepository) FindByUserIDAndDateRangeWithCurrency(
 	// Use index idx_transactions_user_date for optimal performance
 	if err := r.db.Where("user_id = ? AND date >= ? AND date <= ? AND currency = ?", userID.Value(), startDateStr, endDateStr, currency).
 		Order("date DESC, created_at DESC").
-		Find(&models).Error; err != nil {
+		Scopes(preloadSplits, preloadTags, preloadRecurrence).Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to find transactions by user ID, date range and currency: %w", err)
 	}
 
@@ -453,22 +800,187 @@ func (r *GormTransactionRepository) toDomain(model *TransactionModel) (*entities
 		parentTransactionID = &pid
 	}
 
+	var categoryID *categoryvalueobjects.CategoryID
+	if model.CategoryID != nil {
+		cid, err := categoryvalueobjects.NewCategoryID(*model.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid category ID: %w", err)
+		}
+		categoryID = &cid
+	}
+
+	var linkedTransactionID *transactionvalueobjects.TransactionID
+	if model.LinkedTransactionID != nil {
+		lid, err := transactionvalueobjects.NewTransactionID(*model.LinkedTransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid linked transaction ID: %w", err)
+		}
+		linkedTransactionID = &lid
+	}
+
+	splits := make([]transactionvalueobjects.TransactionSplit, 0, len(model.Splits))
+	for _, splitModel := range model.Splits {
+		splitCategoryID, err := categoryvalueobjects.NewCategoryID(splitModel.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid split category ID: %w", err)
+		}
+		splitAmount, err := valueobjects.NewMoneyFromString(splitModel.Amount, splitModel.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid split amount: %w", err)
+		}
+		split, err := transactionvalueobjects.NewTransactionSplit(splitCategoryID, splitAmount, splitModel.Memo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid split line: %w", err)
+		}
+		splits = append(splits, split)
+	}
+
+	tagIDs := make([]tagvalueobjects.TagID, 0, len(model.Tags))
+	for _, tagModel := range model.Tags {
+		tagID, err := tagvalueobjects.NewTagID(tagModel.TagID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag ID: %w", err)
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	var importBatchID *transactionvalueobjects.ImportBatchID
+	if model.ImportBatchID != nil {
+		bid, err := transactionvalueobjects.NewImportBatchID(*model.ImportBatchID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid import batch ID: %w", err)
+		}
+		importBatchID = &bid
+	}
+
+	var externalID string
+	if model.ExternalID != nil {
+		externalID = *model.ExternalID
+	}
+
+	// Rows written before the status column existed are pending
+	status := transactionvalueobjects.PendingStatus()
+	if model.Status != "" {
+		status, err = transactionvalueobjects.NewTransactionStatus(model.Status)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction status: %w", err)
+		}
+	}
+
+	var reconciliationID *transactionvalueobjects.ReconciliationID
+	if model.ReconciliationID != nil {
+		rid, err := transactionvalueobjects.NewReconciliationID(*model.ReconciliationID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reconciliation ID: %w", err)
+		}
+		reconciliationID = &rid
+	}
+
+	var installment *transactionvalueobjects.TransactionInstallment
+	if model.InstallmentNumber != nil && model.InstallmentCount != nil {
+		inst, err := transactionvalueobjects.NewTransactionInstallment(*model.InstallmentNumber, *model.InstallmentCount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid installment: %w", err)
+		}
+		installment = &inst
+	}
+
+	skippedOccurrences := make([]time.Time, 0, len(model.RecurrenceSkips))
+	for _, skipModel := range model.RecurrenceSkips {
+		skippedOccurrences = append(skippedOccurrences, skipModel.Date)
+	}
+
+	amountChanges := make([]transactionvalueobjects.RecurrenceAmountChange, 0, len(model.RecurrenceAmounts))
+	for _, amountModel := range model.RecurrenceAmounts {
+		changeCurrency, err := valueobjects.NewCurrency(amountModel.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence amount currency: %w", err)
+		}
+		changeAmount, err := valueobjects.NewMoney(amountModel.Amount, changeCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence amount: %w", err)
+		}
+		change, err := transactionvalueobjects.NewRecurrenceAmountChange(amountModel.EffectiveDate, changeAmount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence amount change: %w", err)
+		}
+		amountChanges = append(amountChanges, change)
+	}
+
+	var recurrenceAnchor *transactionvalueobjects.RecurrenceAnchor
+	if model.RecurrenceAnchor != nil {
+		anchor, err := transactionvalueobjects.NewRecurrenceAnchor(*model.RecurrenceAnchor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence anchor: %w", err)
+		}
+		recurrenceAnchor = &anchor
+	}
+
+	var payeeID *payeevalueobjects.PayeeID
+	if model.PayeeID != nil {
+		pid, err := payeevalueobjects.NewPayeeID(*model.PayeeID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payee ID: %w", err)
+		}
+		payeeID = &pid
+	}
+
+	var currencyConversion *transactionvalueobjects.CurrencyConversion
+	if model.OriginalAmount != nil && model.OriginalCurrency != nil && model.ExchangeRate != nil {
+		originalAmount, err := valueobjects.NewMoneyFromString(*model.OriginalAmount, *model.OriginalCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid original amount: %w", err)
+		}
+		conversion, err := transactionvalueobjects.CurrencyConversionFromPersistence(originalAmount, *model.ExchangeRate, amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid currency conversion: %w", err)
+		}
+		currencyConversion = &conversion
+	}
+
+	var feeForTransactionID *transactionvalueobjects.TransactionID
+	if model.FeeForTransactionID != nil {
+		fid, err := transactionvalueobjects.NewTransactionID(*model.FeeForTransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee transaction ID: %w", err)
+		}
+		feeForTransactionID = &fid
+	}
+
 	// Reconstruct transaction entity from persisted data
-	return entities.TransactionFromPersistenceWithRecurrence(
-		transactionID,
-		userID,
-		accountID,
-		transactionType,
-		amount,
-		description,
-		model.Date,
-		model.CreatedAt,
-		model.UpdatedAt,
-		model.IsRecurring,
-		recurrenceFrequency,
-		model.RecurrenceEndDate,
-		parentTransactionID,
-	)
+	return entities.TransactionFromPersistence(entities.TransactionPersistenceSnapshot{
+		ID:                       transactionID,
+		UserID:                   userID,
+		AccountID:                accountID,
+		Type:                     transactionType,
+		Amount:                   amount,
+		Description:              description,
+		Date:                     model.Date,
+		CreatedAt:                model.CreatedAt,
+		UpdatedAt:                model.UpdatedAt,
+		CategoryID:               categoryID,
+		Splits:                   splits,
+		TagIDs:                   tagIDs,
+		PayeeID:                  payeeID,
+		CurrencyConversion:       currencyConversion,
+		FeeForTransactionID:      feeForTransactionID,
+		LinkedTransactionID:      linkedTransactionID,
+		ImportBatchID:            importBatchID,
+		ExternalID:               externalID,
+		Status:                   status,
+		ReconciliationID:         reconciliationID,
+		Installment:              installment,
+		IsRecurring:              model.IsRecurring,
+		RecurrenceFrequency:      recurrenceFrequency,
+		RecurrenceEndDate:        model.RecurrenceEndDate,
+		ParentTransactionID:      parentTransactionID,
+		RecurrencePausedAt:       model.RecurrencePausedAt,
+		SkippedOccurrences:       skippedOccurrences,
+		RecurrenceAmountChanges:  amountChanges,
+		RecurrenceAnchor:         recurrenceAnchor,
+		RecurrenceBusinessDays:   model.RecurrenceBusinessDays,
+		RecurrenceGeneratedUntil: model.RecurrenceGeneratedUntil,
+	})
 }
 
 // toModel converts a Transaction entity to a TransactionModel.
@@ -481,26 +993,158 @@ func (r *GormTransactionRepository) toModel(transaction *entities.Transaction) *
 		recurrenceFrequency = &freq
 	}
 
+	var recurrenceAnchor *string
+	if transaction.RecurrenceAnchor() != nil {
+		anchor := transaction.RecurrenceAnchor().Value()
+		recurrenceAnchor = &anchor
+	}
+
 	var parentTransactionID *string
 	if transaction.ParentTransactionID() != nil {
 		pid := transaction.ParentTransactionID().Value()
 		parentTransactionID = &pid
 	}
 
+	var categoryID *string
+	if transaction.CategoryID() != nil {
+		cid := transaction.CategoryID().Value()
+		categoryID = &cid
+	}
+
+	var payeeID *string
+	if transaction.PayeeID() != nil {
+		pid := transaction.PayeeID().Value()
+		payeeID = &pid
+	}
+
+	var originalAmount *int64
+	var originalCurrency *string
+	var exchangeRate *float64
+	if conversion := transaction.CurrencyConversion(); conversion != nil {
+		cents := conversion.OriginalAmount().Amount()
+		code := conversion.OriginalAmount().Currency().Code()
+		rate := conversion.ExchangeRate()
+		originalAmount = &cents
+		originalCurrency = &code
+		exchangeRate = &rate
+	}
+
+	var feeForTransactionID *string
+	if transaction.FeeForTransactionID() != nil {
+		fid := transaction.FeeForTransactionID().Value()
+		feeForTransactionID = &fid
+	}
+
+	var linkedTransactionID *string
+	if transaction.LinkedTransactionID() != nil {
+		lid := transaction.LinkedTransactionID().Value()
+		linkedTransactionID = &lid
+	}
+
+	var importBatchID *string
+	if transaction.ImportBatchID() != nil {
+		bid := transaction.ImportBatchID().Value()
+		importBatchID = &bid
+	}
+
+	var externalID *string
+	if transaction.ExternalID() != "" {
+		eid := transaction.ExternalID()
+		externalID = &eid
+	}
+
+	var reconciliationID *string
+	if transaction.ReconciliationID() != nil {
+		rid := transaction.ReconciliationID().Value()
+		reconciliationID = &rid
+	}
+
+	var installmentNumber, installmentCount *int
+	if transaction.Installment() != nil {
+		number := transaction.Installment().Number()
+		count := transaction.Installment().Count()
+		installmentNumber = &number
+		installmentCount = &count
+	}
+
+	splits := make([]TransactionSplitModel, 0, len(transaction.Splits()))
+	for position, split := range transaction.Splits() {
+		splits = append(splits, TransactionSplitModel{
+			ID:            uuid.New().String(),
+			TransactionID: transaction.ID().Value(),
+			CategoryID:    split.CategoryID().Value(),
+			Amount:        split.Amount().Amount(),
+			Currency:      split.Amount().Currency().Code(),
+			Memo:          split.Memo(),
+			Position:      position,
+			CreatedAt:     transaction.UpdatedAt(),
+		})
+	}
+
+	tags := make([]TransactionTagModel, 0, len(transaction.TagIDs()))
+	for _, tagID := range transaction.TagIDs() {
+		tags = append(tags, TransactionTagModel{
+			TransactionID: transaction.ID().Value(),
+			TagID:         tagID.Value(),
+			CreatedAt:     transaction.UpdatedAt(),
+		})
+	}
+
+	recurrenceSkips := make([]TransactionRecurrenceSkipModel, 0, len(transaction.SkippedOccurrences()))
+	for _, date := range transaction.SkippedOccurrences() {
+		recurrenceSkips = append(recurrenceSkips, TransactionRecurrenceSkipModel{
+			TransactionID: transaction.ID().Value(),
+			Date:          date,
+			CreatedAt:     transaction.UpdatedAt(),
+		})
+	}
+
+	recurrenceAmounts := make([]TransactionRecurrenceAmountModel, 0, len(transaction.RecurrenceAmountChanges()))
+	for _, change := range transaction.RecurrenceAmountChanges() {
+		recurrenceAmounts = append(recurrenceAmounts, TransactionRecurrenceAmountModel{
+			TransactionID: transaction.ID().Value(),
+			EffectiveDate: change.EffectiveDate(),
+			Amount:        change.Amount().Amount(),
+			Currency:      change.Amount().Currency().Code(),
+			CreatedAt:     transaction.UpdatedAt(),
+		})
+	}
+
 	return &TransactionModel{
-		ID:                  transaction.ID().Value(),
-		UserID:              transaction.UserID().Value(),
-		AccountID:           transaction.AccountID().Value(),
-		Type:                transaction.TransactionType().Value(),
-		Amount:              amount.Amount(), // Amount in cents
-		Currency:            amount.Currency().Code(),
-		Description:         transaction.Description().Value(),
-		Date:                transaction.Date(),
-		IsRecurring:         transaction.IsRecurring(),
-		RecurrenceFrequency: recurrenceFrequency,
-		RecurrenceEndDate:   transaction.RecurrenceEndDate(),
-		ParentTransactionID: parentTransactionID,
-		CreatedAt:           transaction.CreatedAt(),
-		UpdatedAt:           transaction.UpdatedAt(),
+		ID:                       transaction.ID().Value(),
+		UserID:                   transaction.UserID().Value(),
+		AccountID:                transaction.AccountID().Value(),
+		Type:                     transaction.TransactionType().Value(),
+		Amount:                   amount.Amount(), // Amount in cents
+		Currency:                 amount.Currency().Code(),
+		Description:              transaction.Description().Value(),
+		Date:                     transaction.Date(),
+		CategoryID:               categoryID,
+		PayeeID:                  payeeID,
+		OriginalAmount:           originalAmount,
+		OriginalCurrency:         originalCurrency,
+		ExchangeRate:             exchangeRate,
+		FeeForTransactionID:      feeForTransactionID,
+		IsRecurring:              transaction.IsRecurring(),
+		RecurrenceFrequency:      recurrenceFrequency,
+		RecurrenceEndDate:        transaction.RecurrenceEndDate(),
+		RecurrencePausedAt:       transaction.RecurrencePausedAt(),
+		RecurrenceAnchor:         recurrenceAnchor,
+		RecurrenceBusinessDays:   transaction.ShiftsToBusinessDay(),
+		RecurrenceGeneratedUntil: transaction.RecurrenceGeneratedUntil(),
+		ParentTransactionID:      parentTransactionID,
+		LinkedTransactionID:      linkedTransactionID,
+		ImportBatchID:            importBatchID,
+		ExternalID:               externalID,
+		Status:                   transaction.Status().Value(),
+		ReconciliationID:         reconciliationID,
+		InstallmentNumber:        installmentNumber,
+		InstallmentCount:         installmentCount,
+		CreatedAt:                transaction.CreatedAt(),
+		UpdatedAt:                transaction.UpdatedAt(),
+		Splits:                   splits,
+		Tags:                     tags,
+		RecurrenceSkips:          recurrenceSkips,
+		RecurrenceAmounts:        recurrenceAmounts,
 	}
 }
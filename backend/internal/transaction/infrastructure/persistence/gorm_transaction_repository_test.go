@@ -1,13 +1,18 @@
 package persistence
 
 import (
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
 	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
 	sharedvalueobjects "gestao-financeira/backend/internal/shared/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
 	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
 
 	"gorm.io/driver/sqlite"
@@ -22,7 +27,7 @@ func setupTransactionTestDB(t *testing.T) *gorm.DB {
 	}
 
 	// Auto-migrate the schema
-	err = db.AutoMigrate(&TransactionModel{})
+	err = db.AutoMigrate(&TransactionModel{}, &TransactionSplitModel{}, &TransactionTagModel{}, &ImportBatchModel{}, &TransactionRuleModel{}, &TransactionRuleTagModel{}, &AttachmentModel{}, &ReconciliationModel{}, &TransactionRecurrenceSkipModel{}, &TransactionRecurrenceAmountModel{}, &TransactionRevisionModel{})
 	if err != nil {
 		t.Fatalf("Failed to migrate database: %v", err)
 	}
@@ -274,6 +279,341 @@ func TestGormTransactionRepository_Save(t *testing.T) {
 	}
 }
 
+func TestGormTransactionRepository_SaveSplits(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	transaction := createTestTransactionEntity(t, userID, accountID) // 1000.00 BRL
+
+	newSplit := func(cents int64, memo string) transactionvalueobjects.TransactionSplit {
+		amount, _ := sharedvalueobjects.NewMoney(cents, sharedvalueobjects.MustCurrency("BRL"))
+		split, err := transactionvalueobjects.NewTransactionSplit(categoryvalueobjects.GenerateCategoryID(), amount, memo)
+		if err != nil {
+			t.Fatalf("Failed to create split line: %v", err)
+		}
+		return split
+	}
+
+	splits := []transactionvalueobjects.TransactionSplit{newSplit(60000, "Comida"), newSplit(40000, "")}
+	if err := transaction.UpdateSplits(splits); err != nil {
+		t.Fatalf("UpdateSplits() error = %v", err)
+	}
+	if err := repo.Save(transaction); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	saved, err := repo.FindByID(transaction.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if len(saved.Splits()) != 2 {
+		t.Fatalf("Save() split lines = %d, want 2", len(saved.Splits()))
+	}
+	for i, split := range saved.Splits() {
+		if !split.Equals(splits[i]) {
+			t.Errorf("Save() split line %d = %v, want %v", i, split, splits[i])
+		}
+	}
+
+	// Replacing the split lines removes the old ones
+	replaced := []transactionvalueobjects.TransactionSplit{newSplit(10000, ""), newSplit(20000, ""), newSplit(70000, "")}
+	if err := transaction.UpdateSplits(replaced); err != nil {
+		t.Fatalf("UpdateSplits() error = %v", err)
+	}
+	if err := repo.Save(transaction); err != nil {
+		t.Fatalf("Save() error on update = %v", err)
+	}
+
+	var count int64
+	db.Model(&TransactionSplitModel{}).Where("transaction_id = ?", transaction.ID().Value()).Count(&count)
+	if count != 3 {
+		t.Errorf("Save() stored %d split lines, want 3", count)
+	}
+
+	updated, _ := repo.FindByID(transaction.ID())
+	if len(updated.Splits()) != 3 || updated.Splits()[2].Amount().Amount() != 70000 {
+		t.Errorf("Save() split lines after update = %v, want 3 lines in order", updated.Splits())
+	}
+}
+
+func TestGormTransactionRepository_SaveCurrencyConversion(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	original, _ := sharedvalueobjects.NewMoney(10000, sharedvalueobjects.MustCurrency("USD"))
+	description, _ := transactionvalueobjects.NewTransactionDescription("Assinatura anual")
+	purchase, err := entities.NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), original, description, time.Now())
+	if err != nil {
+		t.Fatalf("Failed to create transaction: %v", err)
+	}
+	conversion, _ := transactionvalueobjects.NewCurrencyConversion(original, 5.4321, sharedvalueobjects.MustCurrency("BRL"))
+	if err := purchase.ConvertCurrency(conversion); err != nil {
+		t.Fatalf("ConvertCurrency() error = %v", err)
+	}
+
+	iofAmount, _ := sharedvalueobjects.NewMoney(1901, sharedvalueobjects.MustCurrency("BRL"))
+	iofDescription, _ := transactionvalueobjects.NewTransactionDescription("IOF - Assinatura anual")
+	fee, _ := entities.NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), iofAmount, iofDescription, time.Now())
+	if err := fee.MarkAsFeeFor(purchase.ID()); err != nil {
+		t.Fatalf("MarkAsFeeFor() error = %v", err)
+	}
+
+	for _, transaction := range []*entities.Transaction{purchase, fee} {
+		if err := repo.Save(transaction); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	saved, err := repo.FindByID(purchase.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if saved.Amount().Amount() != 54321 || saved.Amount().Currency().Code() != "BRL" {
+		t.Errorf("Save() amount = %s, want 543.21 BRL", saved.Amount().String())
+	}
+	if saved.CurrencyConversion() == nil || !saved.CurrencyConversion().Equals(conversion) {
+		t.Errorf("Save() conversion = %v, want %s", saved.CurrencyConversion(), conversion.String())
+	}
+
+	savedFee, _ := repo.FindByID(fee.ID())
+	if savedFee.IsForeignCurrency() || !savedFee.IsFee() || !savedFee.FeeForTransactionID().Equals(purchase.ID()) {
+		t.Errorf("Save() expected the fee to be linked to the purchase without a conversion")
+	}
+}
+
+func TestGormTransactionRepository_FindByUserIDAndFiltersWithPagination_Tags(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	tripID := tagvalueobjects.GenerateTagID()
+	reimbursableID := tagvalueobjects.GenerateTagID()
+
+	saveTagged := func(tagIDs ...tagvalueobjects.TagID) *entities.Transaction {
+		transaction := createTestTransactionEntity(t, userID, accountID)
+		if err := transaction.UpdateTags(tagIDs); err != nil {
+			t.Fatalf("UpdateTags() error = %v", err)
+		}
+		if err := repo.Save(transaction); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		return transaction
+	}
+
+	both := saveTagged(tripID, reimbursableID)
+	saveTagged(tripID)
+	saveTagged(reimbursableID)
+	saveTagged()
+
+	saved, err := repo.FindByID(both.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !saved.HasTag(tripID) || !saved.HasTag(reimbursableID) {
+		t.Errorf("FindByID() tags = %v, want both tags", saved.TagIDs())
+	}
+
+	tagIDs := []tagvalueobjects.TagID{tripID, reimbursableID}
+
+	_, total, err := repo.FindByUserIDAndFiltersWithPagination(userID, repositories.TransactionFilter{TagIDs: tagIDs}, 0, 10)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithPagination() error = %v", err)
+	}
+	if total != 3 {
+		t.Errorf("FindByUserIDAndFiltersWithPagination() any tag total = %d, want 3", total)
+	}
+
+	transactions, total, err := repo.FindByUserIDAndFiltersWithPagination(userID, repositories.TransactionFilter{TagIDs: tagIDs, MatchAllTags: true}, 0, 10)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithPagination() error = %v", err)
+	}
+	if total != 1 || len(transactions) != 1 || !transactions[0].ID().Equals(both.ID()) {
+		t.Errorf("FindByUserIDAndFiltersWithPagination() all tags = %d results, want only the transaction with both tags", total)
+	}
+
+	// Removing the tags deletes the links
+	if err := both.UpdateTags(nil); err != nil {
+		t.Fatalf("UpdateTags() error = %v", err)
+	}
+	if err := repo.Save(both); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	var count int64
+	db.Model(&TransactionTagModel{}).Where("transaction_id = ?", both.ID().Value()).Count(&count)
+	if count != 0 {
+		t.Errorf("Save() kept %d tag links, want 0", count)
+	}
+}
+
+func TestGormTransactionRepository_FindByUserIDAndFiltersWithPagination_Filter(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+
+	save := func(cents int64, desc string, date time.Time) *entities.Transaction {
+		amount, _ := sharedvalueobjects.NewMoney(cents, currency)
+		description, _ := transactionvalueobjects.NewTransactionDescription(desc)
+		transaction, err := entities.NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, date)
+		if err != nil {
+			t.Fatalf("NewTransaction() error = %v", err)
+		}
+		if err := repo.Save(transaction); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		return transaction
+	}
+
+	january := save(5000, "Padaria do bairro", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC))
+	february := save(25000, "Supermercado Extra", time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC))
+	march := save(12000, "Supermercado Dia", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	startDate := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	transactions, total, err := repo.FindByUserIDAndFiltersWithPagination(userID, repositories.TransactionFilter{StartDate: &startDate, EndDate: &endDate}, 0, 10)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithPagination() error = %v", err)
+	}
+	if total != 1 || !transactions[0].ID().Equals(february.ID()) {
+		t.Errorf("FindByUserIDAndFiltersWithPagination() date range = %d results, want only the February transaction", total)
+	}
+
+	minAmount, maxAmount := int64(10000), int64(20000)
+	transactions, total, err = repo.FindByUserIDAndFiltersWithPagination(userID, repositories.TransactionFilter{MinAmount: &minAmount, MaxAmount: &maxAmount}, 0, 10)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithPagination() error = %v", err)
+	}
+	if total != 1 || !transactions[0].ID().Equals(march.ID()) {
+		t.Errorf("FindByUserIDAndFiltersWithPagination() amount range = %d results, want only the March transaction", total)
+	}
+
+	transactions, total, err = repo.FindByUserIDAndFiltersWithPagination(userID, repositories.TransactionFilter{
+		Search:        "supermercado",
+		SortBy:        repositories.SortByAmount,
+		SortAscending: true,
+	}, 0, 10)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithPagination() error = %v", err)
+	}
+	if total != 2 || !transactions[0].ID().Equals(march.ID()) || !transactions[1].ID().Equals(february.ID()) {
+		t.Errorf("FindByUserIDAndFiltersWithPagination() search sorted by amount = %d results, want March then February", total)
+	}
+
+	transactions, _, err = repo.FindByUserIDAndFiltersWithPagination(userID, repositories.TransactionFilter{SortBy: repositories.SortByDescription, SortAscending: true}, 0, 1)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithPagination() error = %v", err)
+	}
+	if len(transactions) != 1 || !transactions[0].ID().Equals(january.ID()) {
+		t.Errorf("FindByUserIDAndFiltersWithPagination() sorted by description first = %v, want the January transaction", transactions)
+	}
+
+	// Transactions with the same sort keys are ordered by ID, so pages neither repeat nor skip them
+	tied := time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)
+	var tiedIDs []string
+	for i := 0; i < 4; i++ {
+		tiedIDs = append(tiedIDs, save(7000, "Academia", tied).ID().Value())
+	}
+	if err := db.Model(&TransactionModel{}).Where("id IN ?", tiedIDs).Update("created_at", tied).Error; err != nil {
+		t.Fatalf("failed to set creation time: %v", err)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(tiedIDs)))
+	var paged []string
+	for offset := 0; offset < 4; offset += 2 {
+		transactions, _, err = repo.FindByUserIDAndFiltersWithPagination(userID, repositories.TransactionFilter{Search: "academia"}, offset, 2)
+		if err != nil {
+			t.Fatalf("FindByUserIDAndFiltersWithPagination() error = %v", err)
+		}
+		for _, transaction := range transactions {
+			paged = append(paged, transaction.ID().Value())
+		}
+	}
+	if strings.Join(paged, ",") != strings.Join(tiedIDs, ",") {
+		t.Errorf("FindByUserIDAndFiltersWithPagination() tied pages = %v, want %v", paged, tiedIDs)
+	}
+}
+
+func TestGormTransactionRepository_FindByUserIDAndFiltersWithKeyset(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	currency, _ := sharedvalueobjects.NewCurrency("BRL")
+
+	save := func(cents int64, desc string, date time.Time) {
+		amount, _ := sharedvalueobjects.NewMoney(cents, currency)
+		description, _ := transactionvalueobjects.NewTransactionDescription(desc)
+		transaction, err := entities.NewTransaction(userID, accountID, transactionvalueobjects.ExpenseType(), amount, description, date)
+		if err != nil {
+			t.Fatalf("NewTransaction() error = %v", err)
+		}
+		if err := repo.Save(transaction); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	// Several transactions on the same day, so ties are broken by creation time and ID
+	october := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	for i, desc := range []string{"Padaria", "Farmácia", "Mercado", "Posto", "Cinema"} {
+		save(int64(1000*(i+1)), desc, october.AddDate(0, 0, i/2))
+	}
+
+	descriptions := func(transactions []*entities.Transaction) string {
+		values := make([]string, 0, len(transactions))
+		for _, transaction := range transactions {
+			values = append(values, transaction.Description().Value())
+		}
+		return strings.Join(values, ",")
+	}
+
+	filter := repositories.TransactionFilter{}
+	first, err := repo.FindByUserIDAndFiltersWithKeyset(userID, filter, nil, false, 2)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithKeyset() error = %v", err)
+	}
+	if got := descriptions(first); got != "Cinema,Posto" {
+		t.Fatalf("first page = %s, want Cinema,Posto", got)
+	}
+
+	// A transaction created meanwhile at the top of the list does not shift the next page
+	save(9900, "Restaurante", october.AddDate(0, 0, 5))
+
+	keyset := filter.KeysetOf(first[1])
+	second, err := repo.FindByUserIDAndFiltersWithKeyset(userID, filter, &keyset, false, 2)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithKeyset() error = %v", err)
+	}
+	if got := descriptions(second); got != "Mercado,Farmácia" {
+		t.Fatalf("second page = %s, want Mercado,Farmácia", got)
+	}
+
+	keyset = filter.KeysetOf(second[0])
+	previous, err := repo.FindByUserIDAndFiltersWithKeyset(userID, filter, &keyset, true, 10)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithKeyset() error = %v", err)
+	}
+	if got := descriptions(previous); got != "Posto,Cinema,Restaurante" {
+		t.Errorf("backward from Mercado = %s, want Posto,Cinema,Restaurante (nearest first)", got)
+	}
+
+	byAmount := repositories.TransactionFilter{SortBy: repositories.SortByAmount, SortAscending: true}
+	keyset = byAmount.KeysetOf(second[1]) // Farmácia, 20.00
+	after, err := repo.FindByUserIDAndFiltersWithKeyset(userID, byAmount, &keyset, false, 10)
+	if err != nil {
+		t.Fatalf("FindByUserIDAndFiltersWithKeyset() error = %v", err)
+	}
+	if got := descriptions(after); got != "Mercado,Posto,Cinema,Restaurante" {
+		t.Errorf("after Farmácia by amount = %s, want Mercado,Posto,Cinema,Restaurante", got)
+	}
+}
+
 func TestGormTransactionRepository_Delete(t *testing.T) {
 	db := setupTransactionTestDB(t)
 	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
@@ -620,3 +960,145 @@ func TestGormTransactionRepository_SaveRecurringTransaction(t *testing.T) {
 		t.Errorf("Save() recurrenceEndDate = %v, want %v", saved.RecurrenceEndDate(), endDate)
 	}
 }
+
+func TestGormTransactionRepository_SaveRecurringSeriesSchedule(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	start := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	series := createTestRecurringTransactionEntity(t, userID, accountID, transactionvalueobjects.MonthlyFrequency(), start, nil)
+
+	newAmount, _ := sharedvalueobjects.NewMoney(6500, sharedvalueobjects.MustCurrency("BRL"))
+	if err := series.SkipOccurrence(time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("SkipOccurrence() error = %v", err)
+	}
+	if err := series.ChangeRecurrenceAmount(time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC), newAmount); err != nil {
+		t.Fatalf("ChangeRecurrenceAmount() error = %v", err)
+	}
+	if err := series.PauseRecurrence(); err != nil {
+		t.Fatalf("PauseRecurrence() error = %v", err)
+	}
+	if err := repo.Save(series); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := repo.FindRecurringSeriesByUserID(userID)
+	if err != nil {
+		t.Fatalf("FindRecurringSeriesByUserID() error = %v", err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("FindRecurringSeriesByUserID() returned %d series, want 1", len(found))
+	}
+	saved := found[0]
+	if !saved.IsRecurrencePaused() {
+		t.Error("Save() expected the series to stay paused")
+	}
+	if skipped := saved.SkippedOccurrences(); len(skipped) != 1 || skipped[0].Format("2006-01-02") != "2026-03-10" {
+		t.Errorf("SkippedOccurrences() = %v, want [2026-03-10]", skipped)
+	}
+	if got := saved.RecurrenceAmountOn(time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC)); got.Amount() != 6500 {
+		t.Errorf("RecurrenceAmountOn(June) = %d, want 6500", got.Amount())
+	}
+
+	// Saving again replaces the schedule instead of adding to it
+	if err := saved.ResumeRecurrence(); err != nil {
+		t.Fatalf("ResumeRecurrence() error = %v", err)
+	}
+	if err := saved.SkipOccurrence(time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("SkipOccurrence() error = %v", err)
+	}
+	if err := repo.Save(saved); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	reloaded, _ := repo.FindByID(series.ID())
+	if reloaded.IsRecurrencePaused() || len(reloaded.SkippedOccurrences()) != 2 || len(reloaded.RecurrenceAmountChanges()) != 1 {
+		t.Errorf("FindByID() paused = %v, skipped = %v, amount changes = %d", reloaded.IsRecurrencePaused(), reloaded.SkippedOccurrences(), len(reloaded.RecurrenceAmountChanges()))
+	}
+}
+
+func TestGormTransactionRepository_SaveRecurrenceScheduleRules(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	start := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	series := createTestRecurringTransactionEntity(t, userID, accountID, transactionvalueobjects.MonthlyFrequency(), start, nil)
+
+	anchor, _ := transactionvalueobjects.NewRecurrenceAnchor(transactionvalueobjects.LastDayOfMonthAnchor)
+	if err := series.UpdateRecurrenceSchedule(&anchor, true); err != nil {
+		t.Fatalf("UpdateRecurrenceSchedule() error = %v", err)
+	}
+	series.MarkOccurrencesGenerated(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	if err := repo.Save(series); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	saved, err := repo.FindByID(series.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if got := saved.RecurrenceAnchor(); got == nil || !got.Equals(anchor) {
+		t.Errorf("RecurrenceAnchor() = %v, want %s", got, anchor)
+	}
+	if !saved.ShiftsToBusinessDay() {
+		t.Error("ShiftsToBusinessDay() = false, want true")
+	}
+	if until := saved.RecurrenceGeneratedUntil(); until == nil || until.Format("2006-01-02") != "2026-03-02" {
+		t.Errorf("RecurrenceGeneratedUntil() = %v, want 2026-03-02", until)
+	}
+	if got := saved.OccurrenceDate(1).Format("2006-01-02"); got != "2026-03-02" {
+		t.Errorf("OccurrenceDate(1) = %s, want 2026-03-02", got)
+	}
+}
+
+func TestGormTransactionRepository_FindByParentID_Installments(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRepository(db).(*GormTransactionRepository)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	description, _ := transactionvalueobjects.NewTransactionDescription("Notebook")
+	amounts := make([]sharedvalueobjects.Money, 3)
+	for i := range amounts {
+		amounts[i], _ = sharedvalueobjects.NewMoney(40000, sharedvalueobjects.MustCurrency("BRL"))
+	}
+
+	installments, err := entities.NewInstallmentPurchase(userID, accountID, amounts, description, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), nil)
+	if err != nil {
+		t.Fatalf("NewInstallmentPurchase() error = %v", err)
+	}
+	// Save out of order to check the ordering by date
+	for _, i := range []int{2, 0, 1} {
+		if err := repo.Save(installments[i]); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	found, err := repo.FindByParentID(installments[0].ID())
+	if err != nil {
+		t.Fatalf("FindByParentID() error = %v", err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("FindByParentID() returned %d transactions, want 2", len(found))
+	}
+	for i, transaction := range found {
+		want := installments[i+1]
+		if !transaction.ID().Equals(want.ID()) {
+			t.Errorf("FindByParentID()[%d] = %s, want %s", i, transaction.ID().Value(), want.ID().Value())
+		}
+		if !transaction.IsInstallment() || !transaction.Installment().Equals(*want.Installment()) {
+			t.Errorf("FindByParentID()[%d] installment = %v, want %s", i, transaction.Installment(), want.Installment().String())
+		}
+	}
+
+	first, err := repo.FindByID(installments[0].ID())
+	if err != nil || first == nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if first.Installment() == nil || first.Installment().String() != "1/3" || !first.InstallmentPurchaseID().Equals(first.ID()) {
+		t.Errorf("FindByID() installment = %v, want 1/3 identifying the purchase", first.Installment())
+	}
+}
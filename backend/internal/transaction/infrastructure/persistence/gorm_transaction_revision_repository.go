@@ -0,0 +1,183 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+
+	"gorm.io/gorm"
+)
+
+// GormTransactionRevisionRepository implements TransactionRevisionRepository using GORM.
+type GormTransactionRevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTransactionRevisionRepository creates a new GORM transaction revision repository.
+func NewGormTransactionRevisionRepository(db *gorm.DB) repositories.TransactionRevisionRepository {
+	return &GormTransactionRevisionRepository{db: db}
+}
+
+// FindByID finds a revision by its ID.
+func (r *GormTransactionRevisionRepository) FindByID(id transactionvalueobjects.TransactionRevisionID) (*entities.TransactionRevision, error) {
+	var model TransactionRevisionModel
+	if err := r.db.Where("id = ?", id.Value()).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find transaction revision by ID: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByTransactionID finds the edit history of a transaction, oldest revision first.
+func (r *GormTransactionRevisionRepository) FindByTransactionID(transactionID transactionvalueobjects.TransactionID) ([]*entities.TransactionRevision, error) {
+	var models []TransactionRevisionModel
+	if err := r.db.Where("transaction_id = ?", transactionID.Value()).Order("created_at ASC, id ASC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find transaction revisions by transaction ID: %w", err)
+	}
+
+	revisions := make([]*entities.TransactionRevision, 0, len(models))
+	for _, model := range models {
+		revision, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transaction revision model to domain: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// Save creates a revision. Revisions are immutable, so saving an existing one fails.
+func (r *GormTransactionRevisionRepository) Save(revision *entities.TransactionRevision) error {
+	model := r.toModel(revision)
+
+	if err := r.db.Create(model).Error; err != nil {
+		return fmt.Errorf("failed to save transaction revision: %w", err)
+	}
+
+	return nil
+}
+
+// toDomain converts a TransactionRevisionModel to a TransactionRevision entity.
+func (r *GormTransactionRevisionRepository) toDomain(model *TransactionRevisionModel) (*entities.TransactionRevision, error) {
+	id, err := transactionvalueobjects.NewTransactionRevisionID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction revision ID: %w", err)
+	}
+
+	transactionID, err := transactionvalueobjects.NewTransactionID(model.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	var actorID *identityvalueobjects.UserID
+	if model.ActorID != nil {
+		id, err := identityvalueobjects.NewUserID(*model.ActorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid actor ID: %w", err)
+		}
+		actorID = &id
+	}
+
+	var revertedRevisionID *transactionvalueobjects.TransactionRevisionID
+	if model.RevertedRevisionID != nil {
+		id, err := transactionvalueobjects.NewTransactionRevisionID(*model.RevertedRevisionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reverted revision ID: %w", err)
+		}
+		revertedRevisionID = &id
+	}
+
+	return entities.TransactionRevisionFromPersistence(
+		id,
+		transactionID,
+		userID,
+		actorID,
+		model.RequestID,
+		model.Action,
+		snapshotToDomain(model.Before),
+		snapshotToDomain(model.After),
+		revertedRevisionID,
+		model.CreatedAt,
+	), nil
+}
+
+// toModel converts a TransactionRevision entity to a TransactionRevisionModel.
+func (r *GormTransactionRevisionRepository) toModel(revision *entities.TransactionRevision) *TransactionRevisionModel {
+	model := &TransactionRevisionModel{
+		ID:            revision.ID().Value(),
+		TransactionID: revision.TransactionID().Value(),
+		UserID:        revision.UserID().Value(),
+		RequestID:     revision.RequestID(),
+		Action:        revision.Action(),
+		Before:        snapshotToModel(revision.Before()),
+		After:         snapshotToModel(revision.After()),
+		CreatedAt:     revision.CreatedAt(),
+	}
+
+	if revision.ActorID() != nil {
+		actorID := revision.ActorID().Value()
+		model.ActorID = &actorID
+	}
+
+	if revision.RevertedRevisionID() != nil {
+		revertedRevisionID := revision.RevertedRevisionID().Value()
+		model.RevertedRevisionID = &revertedRevisionID
+	}
+
+	return model
+}
+
+// snapshotToDomain converts a persisted snapshot to its domain representation.
+func snapshotToDomain(snapshot *TransactionSnapshot) *entities.TransactionSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	tagIDs := snapshot.TagIDs
+	if tagIDs == nil {
+		tagIDs = []string{}
+	}
+	return &entities.TransactionSnapshot{
+		AccountID:   snapshot.AccountID,
+		Type:        snapshot.Type,
+		Amount:      snapshot.Amount,
+		Currency:    snapshot.Currency,
+		Description: snapshot.Description,
+		Date:        snapshot.Date,
+		CategoryID:  snapshot.CategoryID,
+		TagIDs:      tagIDs,
+		PayeeID:     snapshot.PayeeID,
+		Status:      snapshot.Status,
+	}
+}
+
+// snapshotToModel converts a domain snapshot to its persisted representation.
+func snapshotToModel(snapshot *entities.TransactionSnapshot) *TransactionSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &TransactionSnapshot{
+		AccountID:   snapshot.AccountID,
+		Type:        snapshot.Type,
+		Amount:      snapshot.Amount,
+		Currency:    snapshot.Currency,
+		Description: snapshot.Description,
+		Date:        snapshot.Date,
+		CategoryID:  snapshot.CategoryID,
+		TagIDs:      snapshot.TagIDs,
+		PayeeID:     snapshot.PayeeID,
+		Status:      snapshot.Status,
+	}
+}
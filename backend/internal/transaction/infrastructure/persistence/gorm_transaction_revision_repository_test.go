@@ -0,0 +1,81 @@
+package persistence
+
+import (
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestGormTransactionRevisionRepository_SaveAndFind(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	revisionRepo := NewGormTransactionRevisionRepository(db)
+
+	userID := identityvalueobjects.GenerateUserID()
+	transaction := createTestTransactionEntity(t, userID, accountvalueobjects.GenerateAccountID())
+
+	created, err := entities.NewTransactionRevision(transaction.ID(), userID, entities.RevisionActionCreate, nil, entities.SnapshotOf(transaction), &userID, "req-1")
+	if err != nil {
+		t.Fatalf("NewTransactionRevision() error = %v", err)
+	}
+	if err := revisionRepo.Save(created); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	before := entities.SnapshotOf(transaction)
+	description, _ := transactionvalueobjects.NewTransactionDescription("Salário de outubro")
+	if err := transaction.UpdateDescription(description); err != nil {
+		t.Fatalf("UpdateDescription() error = %v", err)
+	}
+	reverted, err := entities.NewTransactionRevertRevision(transaction.ID(), userID, before, entities.SnapshotOf(transaction), nil, "", created.ID())
+	if err != nil {
+		t.Fatalf("NewTransactionRevertRevision() error = %v", err)
+	}
+	if err := revisionRepo.Save(reverted); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// Revisions are immutable
+	if err := revisionRepo.Save(created); err == nil {
+		t.Error("Save() of an existing revision expected error")
+	}
+
+	found, err := revisionRepo.FindByID(created.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found == nil {
+		t.Fatal("FindByID() = nil, want revision")
+	}
+	if found.Action() != entities.RevisionActionCreate || found.Before() != nil || found.After() == nil {
+		t.Errorf("FindByID() action = %s, before = %v, after = %v", found.Action(), found.Before(), found.After())
+	}
+	if found.ActorID() == nil || !found.ActorID().Equals(userID) || found.RequestID() != "req-1" {
+		t.Errorf("FindByID() actor = %v, request = %q", found.ActorID(), found.RequestID())
+	}
+	if found.After().Amount != transaction.Amount().Amount() || !found.After().Date.Equal(transaction.Date()) {
+		t.Errorf("FindByID() after = %+v, want the created transaction", found.After())
+	}
+
+	history, err := revisionRepo.FindByTransactionID(transaction.ID())
+	if err != nil {
+		t.Fatalf("FindByTransactionID() error = %v", err)
+	}
+	if len(history) != 2 || !history[0].ID().Equals(created.ID()) {
+		t.Fatalf("FindByTransactionID() = %d revisions, want oldest first", len(history))
+	}
+	latest := history[1]
+	if latest.ActorID() != nil || latest.RevertedRevisionID() == nil || !latest.RevertedRevisionID().Equals(created.ID()) {
+		t.Errorf("FindByTransactionID() revert actor = %v, reverted = %v", latest.ActorID(), latest.RevertedRevisionID())
+	}
+	if changed := latest.ChangedFields(); len(changed) != 1 || changed[0] != "description" {
+		t.Errorf("ChangedFields() = %v, want [description]", changed)
+	}
+
+	missing, err := revisionRepo.FindByID(transactionvalueobjects.GenerateTransactionRevisionID())
+	if err != nil || missing != nil {
+		t.Errorf("FindByID() missing = %v, %v, want nil, nil", missing, err)
+	}
+}
@@ -0,0 +1,245 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	"gestao-financeira/backend/internal/transaction/domain/repositories"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormTransactionRuleRepository implements TransactionRuleRepository using GORM.
+type GormTransactionRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTransactionRuleRepository creates a new GORM transaction rule repository.
+func NewGormTransactionRuleRepository(db *gorm.DB) repositories.TransactionRuleRepository {
+	return &GormTransactionRuleRepository{db: db}
+}
+
+// FindByID finds a rule by its ID.
+func (r *GormTransactionRuleRepository) FindByID(id transactionvalueobjects.RuleID) (*entities.TransactionRule, error) {
+	var model TransactionRuleModel
+	if err := r.db.Where("id = ?", id.Value()).Scopes(preloadRuleTags).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find transaction rule by ID: %w", err)
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByUserID finds all rules for a given user, in the order they run.
+// Uses index idx_transaction_rules_user_priority.
+func (r *GormTransactionRuleRepository) FindByUserID(userID identityvalueobjects.UserID) ([]*entities.TransactionRule, error) {
+	var models []TransactionRuleModel
+	if err := r.db.Where("user_id = ?", userID.Value()).
+		Order("priority ASC, created_at ASC").
+		Scopes(preloadRuleTags).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to find transaction rules by user ID: %w", err)
+	}
+
+	rules := make([]*entities.TransactionRule, 0, len(models))
+	for _, model := range models {
+		rule, err := r.toDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transaction rule model to domain: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Save saves or updates a rule and replaces its tags.
+func (r *GormTransactionRuleRepository) Save(rule *entities.TransactionRule) error {
+	model := r.toModel(rule)
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(model).Error; err != nil {
+			return fmt.Errorf("failed to save transaction rule: %w", err)
+		}
+
+		if err := tx.Where("rule_id = ?", model.ID).Delete(&TransactionRuleTagModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete transaction rule tags: %w", err)
+		}
+		if len(model.Tags) > 0 {
+			if err := tx.Create(&model.Tags).Error; err != nil {
+				return fmt.Errorf("failed to create transaction rule tags: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Delete permanently deletes a rule by its ID.
+func (r *GormTransactionRuleRepository) Delete(id transactionvalueobjects.RuleID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("rule_id = ?", id.Value()).Delete(&TransactionRuleTagModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete transaction rule tags: %w", err)
+		}
+		if err := tx.Where("id = ?", id.Value()).Delete(&TransactionRuleModel{}).Error; err != nil {
+			return fmt.Errorf("failed to delete transaction rule: %w", err)
+		}
+		return nil
+	})
+}
+
+// preloadRuleTags loads the tags of the queried rules.
+func preloadRuleTags(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC, tag_id ASC")
+	})
+}
+
+// toDomain converts a TransactionRuleModel to a TransactionRule entity.
+func (r *GormTransactionRuleRepository) toDomain(model *TransactionRuleModel) (*entities.TransactionRule, error) {
+	id, err := transactionvalueobjects.NewRuleID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule ID: %w", err)
+	}
+
+	userID, err := identityvalueobjects.NewUserID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	var accountID *accountvalueobjects.AccountID
+	if model.AccountID != nil {
+		parsed, err := accountvalueobjects.NewAccountID(*model.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID: %w", err)
+		}
+		accountID = &parsed
+	}
+
+	var transactionType *transactionvalueobjects.TransactionType
+	if model.TransactionType != nil {
+		parsed, err := transactionvalueobjects.NewTransactionType(*model.TransactionType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction type: %w", err)
+		}
+		transactionType = &parsed
+	}
+
+	conditions, err := transactionvalueobjects.NewRuleConditions(
+		stringValue(model.DescriptionMatch),
+		stringValue(model.DescriptionPattern),
+		model.MinAmount,
+		model.MaxAmount,
+		accountID,
+		transactionType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule conditions: %w", err)
+	}
+
+	var categoryID *categoryvalueobjects.CategoryID
+	if model.CategoryID != nil {
+		parsed, err := categoryvalueobjects.NewCategoryID(*model.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid category ID: %w", err)
+		}
+		categoryID = &parsed
+	}
+
+	tagIDs := make([]tagvalueobjects.TagID, 0, len(model.Tags))
+	for _, tagModel := range model.Tags {
+		tagID, err := tagvalueobjects.NewTagID(tagModel.TagID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag ID: %w", err)
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	var description *transactionvalueobjects.TransactionDescription
+	if model.SetDescription != nil {
+		parsed, err := transactionvalueobjects.NewTransactionDescription(*model.SetDescription)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule description: %w", err)
+		}
+		description = &parsed
+	}
+
+	return entities.TransactionRuleFromPersistence(
+		id,
+		userID,
+		model.Name,
+		model.Priority,
+		model.Enabled,
+		conditions,
+		transactionvalueobjects.RuleActionsFromPersistence(categoryID, tagIDs, description),
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
+// toModel converts a TransactionRule entity to a TransactionRuleModel.
+func (r *GormTransactionRuleRepository) toModel(rule *entities.TransactionRule) *TransactionRuleModel {
+	conditions := rule.Conditions()
+	actions := rule.Actions()
+
+	model := &TransactionRuleModel{
+		ID:                 rule.ID().Value(),
+		UserID:             rule.UserID().Value(),
+		Name:               rule.Name(),
+		Priority:           rule.Priority(),
+		Enabled:            rule.IsEnabled(),
+		DescriptionMatch:   stringPointer(conditions.DescriptionMatch()),
+		DescriptionPattern: stringPointer(conditions.DescriptionPattern()),
+		MinAmount:          conditions.MinAmount(),
+		MaxAmount:          conditions.MaxAmount(),
+		CreatedAt:          rule.CreatedAt(),
+		UpdatedAt:          rule.UpdatedAt(),
+	}
+
+	if conditions.AccountID() != nil {
+		model.AccountID = stringPointer(conditions.AccountID().Value())
+	}
+	if conditions.TransactionType() != nil {
+		model.TransactionType = stringPointer(conditions.TransactionType().Value())
+	}
+	if actions.CategoryID() != nil {
+		model.CategoryID = stringPointer(actions.CategoryID().Value())
+	}
+	if actions.Description() != nil {
+		model.SetDescription = stringPointer(actions.Description().Value())
+	}
+
+	for _, tagID := range actions.TagIDs() {
+		model.Tags = append(model.Tags, TransactionRuleTagModel{
+			RuleID:    model.ID,
+			TagID:     tagID.Value(),
+			CreatedAt: rule.UpdatedAt(),
+		})
+	}
+
+	return model
+}
+
+// stringValue returns the value of an optional string, or "" when it is nil.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// stringPointer returns a pointer to the string, or nil when it is empty.
+func stringPointer(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
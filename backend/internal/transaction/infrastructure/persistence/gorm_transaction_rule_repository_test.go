@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"testing"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	categoryvalueobjects "gestao-financeira/backend/internal/category/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	tagvalueobjects "gestao-financeira/backend/internal/tag/domain/valueobjects"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+)
+
+func TestGormTransactionRuleRepository_SaveAndFind(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := NewGormTransactionRuleRepository(db)
+
+	userID := identityvalueobjects.GenerateUserID()
+	accountID := accountvalueobjects.GenerateAccountID()
+	categoryID := categoryvalueobjects.GenerateCategoryID()
+	tagID := tagvalueobjects.GenerateTagID()
+	expense := transactionvalueobjects.ExpenseType()
+	minAmount, maxAmount := int64(1000), int64(5000)
+
+	conditions, err := transactionvalueobjects.NewRuleConditions(transactionvalueobjects.DescriptionMatchRegex, `^uber`, &minAmount, &maxAmount, &accountID, &expense)
+	if err != nil {
+		t.Fatalf("NewRuleConditions() error = %v", err)
+	}
+	description, _ := transactionvalueobjects.NewTransactionDescription("Uber")
+	actions, err := transactionvalueobjects.NewRuleActions(&categoryID, []tagvalueobjects.TagID{tagID}, &description)
+	if err != nil {
+		t.Fatalf("NewRuleActions() error = %v", err)
+	}
+
+	rule, err := entities.NewTransactionRule(userID, "Uber", 20, conditions, actions)
+	if err != nil {
+		t.Fatalf("NewTransactionRule() error = %v", err)
+	}
+	if err := repo.Save(rule); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	found, err := repo.FindByID(rule.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found == nil {
+		t.Fatal("FindByID() = nil, want rule")
+	}
+	foundConditions := found.Conditions()
+	if foundConditions.DescriptionMatch() != transactionvalueobjects.DescriptionMatchRegex || foundConditions.DescriptionPattern() != "^uber" {
+		t.Errorf("FindByID() description condition = %s %q", foundConditions.DescriptionMatch(), foundConditions.DescriptionPattern())
+	}
+	if foundConditions.MinAmount() == nil || *foundConditions.MinAmount() != minAmount || foundConditions.MaxAmount() == nil || *foundConditions.MaxAmount() != maxAmount {
+		t.Errorf("FindByID() amount range = %v..%v, want 1000..5000", foundConditions.MinAmount(), foundConditions.MaxAmount())
+	}
+	if foundConditions.AccountID() == nil || !foundConditions.AccountID().Equals(accountID) || foundConditions.TransactionType() == nil || !foundConditions.TransactionType().IsExpense() {
+		t.Error("FindByID() expected account and type conditions to be loaded")
+	}
+	foundActions := found.Actions()
+	if foundActions.CategoryID() == nil || !foundActions.CategoryID().Equals(categoryID) {
+		t.Errorf("FindByID() category = %v, want %s", foundActions.CategoryID(), categoryID.Value())
+	}
+	if len(foundActions.TagIDs()) != 1 || !foundActions.TagIDs()[0].Equals(tagID) {
+		t.Errorf("FindByID() tags = %v, want [%s]", foundActions.TagIDs(), tagID.Value())
+	}
+	if foundActions.Description() == nil || foundActions.Description().Value() != "Uber" {
+		t.Errorf("FindByID() description = %v, want Uber", foundActions.Description())
+	}
+
+	// Updating replaces the tags
+	otherTagID := tagvalueobjects.GenerateTagID()
+	newActions, _ := transactionvalueobjects.NewRuleActions(nil, []tagvalueobjects.TagID{otherTagID}, nil)
+	if err := rule.Update("Uber trabalho", 5, false, conditions, newActions); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := repo.Save(rule); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	second, _ := entities.NewTransactionRule(userID, "Outra", 10, conditions, actions)
+	if err := repo.Save(second); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	rules, err := repo.FindByUserID(userID)
+	if err != nil {
+		t.Fatalf("FindByUserID() error = %v", err)
+	}
+	if len(rules) != 2 || !rules[0].ID().Equals(rule.ID()) {
+		t.Fatalf("FindByUserID() = %d rules, want 2 in priority order", len(rules))
+	}
+	if rules[0].IsEnabled() || rules[0].Name() != "Uber trabalho" || rules[0].Actions().CategoryID() != nil {
+		t.Errorf("FindByUserID() first rule = %q enabled=%v, want updated definition", rules[0].Name(), rules[0].IsEnabled())
+	}
+	if tags := rules[0].Actions().TagIDs(); len(tags) != 1 || !tags[0].Equals(otherTagID) {
+		t.Errorf("FindByUserID() first rule tags = %v, want [%s]", tags, otherTagID.Value())
+	}
+
+	if err := repo.Delete(rule.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	deleted, err := repo.FindByID(rule.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if deleted != nil {
+		t.Error("FindByID() after Delete() = rule, want nil")
+	}
+}
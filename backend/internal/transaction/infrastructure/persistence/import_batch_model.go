@@ -0,0 +1,25 @@
+package persistence
+
+import (
+	"time"
+)
+
+// ImportBatchModel represents the database model for ImportBatch entity.
+// This is the persistence model, separate from the domain entity.
+type ImportBatchModel struct {
+	ID               string     `gorm:"type:uuid;primary_key"`
+	UserID           string     `gorm:"type:uuid;index;not null"`
+	AccountID        string     `gorm:"type:uuid;index;not null"`
+	Source           string     `gorm:"type:varchar(10);not null"` // CSV
+	FileName         string     `gorm:"type:varchar(255);not null;default:''"`
+	Status           string     `gorm:"type:varchar(20);not null"` // COMMITTED, ROLLED_BACK
+	TransactionCount int        `gorm:"type:integer;not null;default:0"`
+	CreatedAt        time.Time  `gorm:"not null"`
+	UpdatedAt        time.Time  `gorm:"not null"`
+	RolledBackAt     *time.Time `gorm:"null"`
+}
+
+// TableName specifies the table name for GORM
+func (ImportBatchModel) TableName() string {
+	return "import_batches"
+}
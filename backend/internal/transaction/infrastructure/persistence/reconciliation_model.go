@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"time"
+)
+
+// ReconciliationModel represents the database model for Reconciliation entity.
+// This is the persistence model, separate from the domain entity.
+type ReconciliationModel struct {
+	ID               string     `gorm:"type:uuid;primary_key"`
+	UserID           string     `gorm:"type:uuid;index;not null"`
+	AccountID        string     `gorm:"type:uuid;index;not null"`
+	StatementDate    time.Time  `gorm:"type:date;not null"`
+	StatementBalance int64      `gorm:"type:bigint;not null"`                   // Closing balance in cents
+	Currency         string     `gorm:"type:varchar(3);not null;default:'BRL'"` // Currency code
+	Status           string     `gorm:"type:varchar(20);not null"`              // IN_PROGRESS, COMPLETED, CANCELED
+	ReconciledCount  int        `gorm:"type:integer;not null;default:0"`
+	CreatedAt        time.Time  `gorm:"not null"`
+	UpdatedAt        time.Time  `gorm:"not null"`
+	CompletedAt      *time.Time `gorm:"null"`
+	CanceledAt       *time.Time `gorm:"null"`
+}
+
+// TableName specifies the table name for GORM
+func (ReconciliationModel) TableName() string {
+	return "account_reconciliations"
+}
@@ -9,21 +9,48 @@ import (
 // TransactionModel represents the database model for Transaction entity.
 // This is the persistence model, separate from the domain entity.
 type TransactionModel struct {
-	ID                  string         `gorm:"type:uuid;primary_key"`
-	UserID              string         `gorm:"type:uuid;index;not null"`
-	AccountID           string         `gorm:"type:uuid;index;not null"`
-	Type                string         `gorm:"type:varchar(20);not null"`              // INCOME, EXPENSE
-	Amount              int64          `gorm:"type:bigint;not null"`                   // Amount in cents
-	Currency            string         `gorm:"type:varchar(3);not null;default:'BRL'"` // Currency code
-	Description         string         `gorm:"type:varchar(500);not null"`
-	Date                time.Time      `gorm:"type:date;not null;index"`
-	IsRecurring         bool           `gorm:"type:boolean;not null;default:false"`
-	RecurrenceFrequency *string        `gorm:"type:varchar(20);null"` // DAILY, WEEKLY, MONTHLY, YEARLY
-	RecurrenceEndDate   *time.Time     `gorm:"type:date;null"`
-	ParentTransactionID *string        `gorm:"type:uuid;null;index"`
-	CreatedAt           time.Time      `gorm:"not null"`
-	UpdatedAt           time.Time      `gorm:"not null"`
-	DeletedAt           gorm.DeletedAt `gorm:"index"`
+	ID                       string         `gorm:"type:uuid;primary_key"`
+	UserID                   string         `gorm:"type:uuid;index;not null"`
+	AccountID                string         `gorm:"type:uuid;index;not null"`
+	Type                     string         `gorm:"type:varchar(20);not null"`              // INCOME, EXPENSE, TRANSFER_OUT, TRANSFER_IN, ADJUSTMENT_IN, ADJUSTMENT_OUT
+	Amount                   int64          `gorm:"type:bigint;not null"`                   // Amount in cents
+	Currency                 string         `gorm:"type:varchar(3);not null;default:'BRL'"` // Currency code
+	Description              string         `gorm:"type:varchar(500);not null"`
+	Date                     time.Time      `gorm:"type:date;not null;index"`
+	CategoryID               *string        `gorm:"type:uuid;null;index"`
+	PayeeID                  *string        `gorm:"type:uuid;null;index"`    // Merchant or counterparty
+	OriginalAmount           *int64         `gorm:"type:bigint;null"`        // Amount in cents in the foreign currency the transaction was made in
+	OriginalCurrency         *string        `gorm:"type:varchar(3);null"`    // Foreign currency code
+	ExchangeRate             *float64       `gorm:"type:decimal(18,8);null"` // Account currency units per unit of the foreign currency
+	FeeForTransactionID      *string        `gorm:"type:uuid;null;index"`    // Purchase a fee (e.g. IOF) was charged for
+	IsRecurring              bool           `gorm:"type:boolean;not null;default:false"`
+	RecurrenceFrequency      *string        `gorm:"type:varchar(20);null"` // DAILY, WEEKLY, MONTHLY, YEARLY
+	RecurrenceEndDate        *time.Time     `gorm:"type:date;null"`
+	RecurrencePausedAt       *time.Time     `gorm:"null"`                                // Set while the recurring series is paused
+	RecurrenceAnchor         *string        `gorm:"type:varchar(20);null"`               // LAST_DAY_OF_MONTH, NTH_WEEKDAY, LAST_WEEKDAY (NULL keeps the day of the month)
+	RecurrenceBusinessDays   bool           `gorm:"type:boolean;not null;default:false"` // Shift occurrences to the next business day
+	RecurrenceGeneratedUntil *time.Time     `gorm:"type:date;null"`                      // Last day up to which occurrences were generated
+	ParentTransactionID      *string        `gorm:"type:uuid;null;index"`
+	LinkedTransactionID      *string        `gorm:"type:uuid;null;index"`                        // Counterpart leg of a transfer
+	ImportBatchID            *string        `gorm:"type:uuid;null;index"`                        // Statement import batch
+	ExternalID               *string        `gorm:"type:varchar(255);null"`                      // Bank identifier of the statement entry (OFX FITID)
+	Status                   string         `gorm:"type:varchar(20);not null;default:'PENDING'"` // PENDING, CLEARED, RECONCILED
+	ReconciliationID         *string        `gorm:"type:uuid;null;index"`                        // Reconciliation that matched the transaction
+	InstallmentNumber        *int           `gorm:"type:smallint;null"`                          // Position within an installment purchase
+	InstallmentCount         *int           `gorm:"type:smallint;null"`                          // Installments of the purchase
+	CreatedAt                time.Time      `gorm:"not null"`
+	UpdatedAt                time.Time      `gorm:"not null"`
+	DeletedAt                gorm.DeletedAt `gorm:"index"`
+
+	// Split lines (loaded with preloadSplits, saved by replaceSplits)
+	Splits []TransactionSplitModel `gorm:"foreignKey:TransactionID"`
+
+	// Tags (loaded with preloadTags, saved by replaceTags)
+	Tags []TransactionTagModel `gorm:"foreignKey:TransactionID"`
+
+	// Recurring series management (loaded with preloadRecurrence, saved by replaceRecurrence)
+	RecurrenceSkips   []TransactionRecurrenceSkipModel   `gorm:"foreignKey:TransactionID"`
+	RecurrenceAmounts []TransactionRecurrenceAmountModel `gorm:"foreignKey:TransactionID"`
 }
 
 // TableName specifies the table name for GORM
@@ -0,0 +1,31 @@
+package persistence
+
+import "time"
+
+// TransactionRecurrenceSkipModel represents the database model for an occurrence left out of a
+// recurring series. Skipped dates are replaced as a whole on every save, like split lines.
+type TransactionRecurrenceSkipModel struct {
+	TransactionID string    `gorm:"type:uuid;primaryKey"` // Transaction that starts the series
+	Date          time.Time `gorm:"type:date;primaryKey"`
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TransactionRecurrenceSkipModel) TableName() string {
+	return "transaction_recurrence_skips"
+}
+
+// TransactionRecurrenceAmountModel represents the database model for an amount change of a
+// recurring series, effective from an occurrence on. Replaced as a whole on every save.
+type TransactionRecurrenceAmountModel struct {
+	TransactionID string    `gorm:"type:uuid;primaryKey"` // Transaction that starts the series
+	EffectiveDate time.Time `gorm:"type:date;primaryKey"`
+	Amount        int64     `gorm:"type:bigint;not null"` // Amount in cents
+	Currency      string    `gorm:"type:varchar(3);not null;default:'BRL'"`
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TransactionRecurrenceAmountModel) TableName() string {
+	return "transaction_recurrence_amounts"
+}
@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TransactionRevisionModel represents the database model for TransactionRevision entity.
+// This is the persistence model, separate from the domain entity.
+type TransactionRevisionModel struct {
+	ID                 string               `gorm:"type:uuid;primary_key"`
+	TransactionID      string               `gorm:"type:uuid;index;not null"`
+	UserID             string               `gorm:"type:uuid;index;not null"`
+	ActorID            *string              `gorm:"type:uuid"`                 // Nil for changes made by the system
+	RequestID          string               `gorm:"type:varchar(100)"`         // X-Request-ID of the change
+	Action             string               `gorm:"type:varchar(20);not null"` // CREATE, UPDATE, DELETE, RESTORE, REVERT
+	Before             *TransactionSnapshot `gorm:"type:jsonb"`
+	After              *TransactionSnapshot `gorm:"type:jsonb"`
+	RevertedRevisionID *string              `gorm:"type:uuid"`
+	CreatedAt          time.Time            `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TransactionRevisionModel) TableName() string {
+	return "transaction_revisions"
+}
+
+// TransactionSnapshot is the JSONB representation of the state of a transaction in a revision.
+type TransactionSnapshot struct {
+	AccountID   string    `json:"account_id"`
+	Type        string    `json:"type"`
+	Amount      int64     `json:"amount"` // Amount in cents
+	Currency    string    `json:"currency"`
+	Description string    `json:"description"`
+	Date        time.Time `json:"date"`
+	CategoryID  string    `json:"category_id,omitempty"`
+	TagIDs      []string  `json:"tag_ids"`
+	PayeeID     string    `json:"payee_id,omitempty"`
+	Status      string    `json:"status"`
+}
+
+// Value implements the driver.Valuer interface
+func (s TransactionSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan implements the sql.Scanner interface
+func (s *TransactionSnapshot) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("unsupported transaction snapshot type: %T", value)
+	}
+}
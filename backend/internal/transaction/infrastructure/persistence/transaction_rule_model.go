@@ -0,0 +1,46 @@
+package persistence
+
+import "time"
+
+// TransactionRuleModel represents the database model for TransactionRule entity.
+// This is the persistence model, separate from the domain entity.
+type TransactionRuleModel struct {
+	ID       string `gorm:"type:uuid;primary_key"`
+	UserID   string `gorm:"type:uuid;index:idx_transaction_rules_user_priority;not null"`
+	Name     string `gorm:"type:varchar(100);not null"`
+	Priority int    `gorm:"type:integer;not null;default:0"`
+	Enabled  bool   `gorm:"not null;default:true"`
+
+	// Conditions
+	DescriptionMatch   *string `gorm:"type:varchar(20);null"` // CONTAINS, STARTS_WITH, REGEX
+	DescriptionPattern *string `gorm:"type:varchar(255);null"`
+	MinAmount          *int64  `gorm:"type:bigint;null"` // In cents
+	MaxAmount          *int64  `gorm:"type:bigint;null"` // In cents
+	AccountID          *string `gorm:"type:uuid;null"`
+	TransactionType    *string `gorm:"type:varchar(20);null"` // INCOME, EXPENSE
+
+	// Actions
+	CategoryID     *string                   `gorm:"type:uuid;null"`
+	SetDescription *string                   `gorm:"type:varchar(500);null"`
+	Tags           []TransactionRuleTagModel `gorm:"foreignKey:RuleID"` // Loaded with Preload, saved by replaceTags
+
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TransactionRuleModel) TableName() string {
+	return "transaction_rules"
+}
+
+// TransactionRuleTagModel represents the database model for a tag added by a transaction rule.
+type TransactionRuleTagModel struct {
+	RuleID    string    `gorm:"type:uuid;primaryKey"`
+	TagID     string    `gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TransactionRuleTagModel) TableName() string {
+	return "transaction_rule_tags"
+}
@@ -0,0 +1,21 @@
+package persistence
+
+import "time"
+
+// TransactionSplitModel represents the database model for a split line of a transaction.
+// Split lines are value objects in the domain, so they are replaced as a whole on every save.
+type TransactionSplitModel struct {
+	ID            string    `gorm:"type:uuid;primary_key"`
+	TransactionID string    `gorm:"type:uuid;index;not null"`
+	CategoryID    string    `gorm:"type:uuid;index;not null"`
+	Amount        int64     `gorm:"type:bigint;not null"` // Amount in cents
+	Currency      string    `gorm:"type:varchar(3);not null;default:'BRL'"`
+	Memo          string    `gorm:"type:varchar(255);not null;default:''"`
+	Position      int       `gorm:"type:integer;not null;default:0"` // Order of the line within the transaction
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TransactionSplitModel) TableName() string {
+	return "transaction_splits"
+}
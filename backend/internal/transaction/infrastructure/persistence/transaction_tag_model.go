@@ -0,0 +1,16 @@
+package persistence
+
+import "time"
+
+// TransactionTagModel represents the database model for the link between a transaction and a tag.
+// Tags are replaced as a whole on every save, like split lines.
+type TransactionTagModel struct {
+	TransactionID string    `gorm:"type:uuid;primaryKey"`
+	TagID         string    `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (TransactionTagModel) TableName() string {
+	return "transaction_tags"
+}
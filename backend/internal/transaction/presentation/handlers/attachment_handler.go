@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+)
+
+// AttachmentHandler handles HTTP requests for receipts and documents attached to transactions.
+type AttachmentHandler struct {
+	uploadAttachmentUseCase   *usecases.UploadAttachmentUseCase
+	listAttachmentsUseCase    *usecases.ListAttachmentsUseCase
+	downloadAttachmentUseCase *usecases.DownloadAttachmentUseCase
+	deleteAttachmentUseCase   *usecases.DeleteAttachmentUseCase
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler instance.
+func NewAttachmentHandler(
+	uploadAttachmentUseCase *usecases.UploadAttachmentUseCase,
+	listAttachmentsUseCase *usecases.ListAttachmentsUseCase,
+	downloadAttachmentUseCase *usecases.DownloadAttachmentUseCase,
+	deleteAttachmentUseCase *usecases.DeleteAttachmentUseCase,
+) *AttachmentHandler {
+	return &AttachmentHandler{
+		uploadAttachmentUseCase:   uploadAttachmentUseCase,
+		listAttachmentsUseCase:    listAttachmentsUseCase,
+		downloadAttachmentUseCase: downloadAttachmentUseCase,
+		deleteAttachmentUseCase:   deleteAttachmentUseCase,
+	}
+}
+
+// Upload handles attaching a file to a transaction.
+// @Summary Attach a receipt or document to a transaction
+// @Description Uploads an image or PDF (e.g. a receipt for tax declarations or reimbursements) and attaches it to a transaction of the authenticated user.
+//
+// **Tipos aceitos**: JPEG, PNG, GIF, WebP e PDF, até 8 MB. O tipo é detectado pelo conteúdo do arquivo, não pela extensão.
+//
+// @Tags transactions
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID"
+// @Param file formData file true "Image or PDF (max 8 MB)"
+// @Success 201 {object} dtos.AttachmentOutput "Attachment uploaded successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - missing file, invalid file type or size" example({"error":"invalid attachment: invalid attachment file type: application/octet-stream. Supported types: JPEG, PNG, GIF, WebP and PDF","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"transaction not found: 550e8400-e29b-41d4-a716-446655440000","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/{id}/attachments [post]
+func (h *AttachmentHandler) Upload(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Attachment file is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+	if fileHeader.Size > entities.MaxAttachmentSize {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Attachment file must be at most %d MB", entities.MaxAttachmentSize/(1024*1024)),
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to open attachment file")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid attachment file",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+	defer file.Close()
+
+	// Read one byte past the limit so the use case can reject files larger than announced
+	content, err := io.ReadAll(io.LimitReader(file, entities.MaxAttachmentSize+1))
+	if err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to read attachment file")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid attachment file",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	output, err := h.uploadAttachmentUseCase.Execute(dtos.UploadAttachmentInput{
+		UserID:        userID,
+		TransactionID: c.Params("id"),
+		FileName:      fileHeader.Filename,
+		Content:       content,
+	})
+	if err != nil {
+		return handleAttachmentError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Attachment uploaded successfully",
+		"data":    output,
+	})
+}
+
+// List handles listing the files attached to a transaction.
+// @Summary List transaction attachments
+// @Description Lists the receipts and documents attached to a transaction of the authenticated user, oldest first.
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID"
+// @Success 200 {object} dtos.ListAttachmentsOutput "Attachments retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid transaction ID" example({"error":"invalid transaction ID: invalid transaction ID format (must be UUID)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"transaction not found: 550e8400-e29b-41d4-a716-446655440000","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/{id}/attachments [get]
+func (h *AttachmentHandler) List(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	output, err := h.listAttachmentsUseCase.Execute(dtos.ListAttachmentsInput{
+		UserID:        userID,
+		TransactionID: c.Params("id"),
+	})
+	if err != nil {
+		return handleAttachmentError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Attachments retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Download handles downloading a file attached to a transaction.
+// @Summary Download a transaction attachment
+// @Description Downloads a receipt or document attached to a transaction. Only the owner of the transaction can download its attachments.
+// @Tags transactions
+// @Produce application/pdf
+// @Produce image/jpeg
+// @Produce image/png
+// @Produce image/gif
+// @Produce image/webp
+// @Security Bearer
+// @Param id path string true "Transaction ID"
+// @Param attachmentId path string true "Attachment ID"
+// @Success 200 {file} file "Attachment file"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid IDs" example({"error":"invalid attachment ID: invalid attachment ID format (must be UUID)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - attachment does not belong to user" example({"error":"attachment does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - attachment does not exist" example({"error":"attachment not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/{id}/attachments/{attachmentId} [get]
+func (h *AttachmentHandler) Download(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	output, err := h.downloadAttachmentUseCase.Execute(dtos.DownloadAttachmentInput{
+		UserID:        userID,
+		TransactionID: c.Params("id"),
+		AttachmentID:  c.Params("attachmentId"),
+	})
+	if err != nil {
+		return handleAttachmentError(err)
+	}
+
+	// Always download instead of rendering inline, and never let the browser guess another type
+	c.Attachment(output.FileName)
+	c.Set(fiber.HeaderContentType, output.ContentType)
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	// The response body stream is closed by fasthttp once it has been sent
+	return c.Status(fiber.StatusOK).SendStream(output.Content, int(output.Size))
+}
+
+// Delete handles removing a file attached to a transaction.
+// @Summary Delete a transaction attachment
+// @Description Deletes a receipt or document attached to a transaction, including the stored file. This action cannot be undone.
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID"
+// @Param attachmentId path string true "Attachment ID"
+// @Success 200 {object} dtos.DeleteAttachmentOutput "Attachment deleted successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid IDs" example({"error":"invalid attachment ID: invalid attachment ID format (must be UUID)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - attachment does not belong to user" example({"error":"attachment does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - attachment does not exist" example({"error":"attachment not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/{id}/attachments/{attachmentId} [delete]
+func (h *AttachmentHandler) Delete(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	output, err := h.deleteAttachmentUseCase.Execute(dtos.DeleteAttachmentInput{
+		UserID:        userID,
+		TransactionID: c.Params("id"),
+		AttachmentID:  c.Params("attachmentId"),
+	})
+	if err != nil {
+		return handleAttachmentError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Attachment deleted successfully",
+		"data":    output,
+	})
+}
+
+// handleAttachmentError maps an attachment error to an application error and logs it.
+func handleAttachmentError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Attachment operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Attachment operation failed")
+	}
+	return appErr
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	accountvalueobjects "gestao-financeira/backend/internal/account/domain/valueobjects"
+	identityvalueobjects "gestao-financeira/backend/internal/identity/domain/valueobjects"
+	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	sharedstorage "gestao-financeira/backend/internal/shared/infrastructure/storage"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	"gestao-financeira/backend/internal/transaction/domain/entities"
+	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	"gestao-financeira/backend/pkg/config"
+)
+
+// mockAttachmentRepositoryForHandler is a mock implementation of AttachmentRepository for handler testing.
+type mockAttachmentRepositoryForHandler struct {
+	attachments map[string]*entities.Attachment
+}
+
+func (m *mockAttachmentRepositoryForHandler) FindByID(id transactionvalueobjects.AttachmentID) (*entities.Attachment, error) {
+	return m.attachments[id.Value()], nil
+}
+
+func (m *mockAttachmentRepositoryForHandler) FindByTransactionID(transactionID transactionvalueobjects.TransactionID) ([]*entities.Attachment, error) {
+	var result []*entities.Attachment
+	for _, attachment := range m.attachments {
+		if attachment.TransactionID().Value() == transactionID.Value() {
+			result = append(result, attachment)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAttachmentRepositoryForHandler) Save(attachment *entities.Attachment) error {
+	m.attachments[attachment.ID().Value()] = attachment
+	return nil
+}
+
+func (m *mockAttachmentRepositoryForHandler) Delete(id transactionvalueobjects.AttachmentID) error {
+	delete(m.attachments, id.Value())
+	return nil
+}
+
+func TestAttachmentHandler_Upload(t *testing.T) {
+	// Use the default request body limit, as the API does
+	cfg, err := config.Load()
+	require.NoError(t, err)
+
+	userID := identityvalueobjects.GenerateUserID()
+	txRepo := newMockTransactionRepositoryForHandler()
+	transaction, err := createTestTransactionForHandler(userID, accountvalueobjects.GenerateAccountID(), "EXPENSE", 150.00, "BRL", "Consulta médica", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, txRepo.Save(transaction))
+
+	fileStorage, err := sharedstorage.NewLocalFileStorage(t.TempDir())
+	require.NoError(t, err)
+	attachmentRepo := &mockAttachmentRepositoryForHandler{attachments: make(map[string]*entities.Attachment)}
+	handler := NewAttachmentHandler(
+		usecases.NewUploadAttachmentUseCase(txRepo, attachmentRepo, fileStorage, eventbus.NewEventBus()),
+		nil, nil, nil,
+	)
+
+	app := fiber.New(fiber.Config{BodyLimit: int(cfg.Server.BodyLimit)})
+	app.Post("/transactions/:id/attachments", func(c *fiber.Ctx) error {
+		c.Locals("userID", userID.Value())
+		return handler.Upload(c)
+	})
+
+	tests := []struct {
+		name           string
+		size           int
+		expectedStatus int
+	}{
+		{
+			name:           "file of the maximum size fits in the request body limit",
+			size:           entities.MaxAttachmentSize,
+			expectedStatus: fiber.StatusCreated,
+		},
+		{
+			name:           "file over the maximum size is rejected by the handler",
+			size:           entities.MaxAttachmentSize + 1,
+			expectedStatus: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A PDF header padded to the requested size
+			content := make([]byte, tt.size)
+			copy(content, "%PDF-1.4\n")
+
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			part, err := writer.CreateFormFile("file", "recibo.pdf")
+			require.NoError(t, err)
+			_, err = part.Write(content)
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+
+			req := httptest.NewRequest("POST", "/transactions/"+transaction.ID().Value()+"/attachments", body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// BulkHandler handles HTTP requests for applying one operation to many transactions at once.
+type BulkHandler struct {
+	bulkTransactionsUseCase *usecases.BulkTransactionsUseCase
+}
+
+// NewBulkHandler creates a new BulkHandler instance.
+func NewBulkHandler(bulkTransactionsUseCase *usecases.BulkTransactionsUseCase) *BulkHandler {
+	return &BulkHandler{
+		bulkTransactionsUseCase: bulkTransactionsUseCase,
+	}
+}
+
+// Apply handles bulk transaction operations.
+// @Summary Apply an operation to many transactions
+// @Description Recategorizes, moves to another account, adds or removes tags, deletes or restores up to 500 transactions at once, atomically using Unit of Work pattern, with the account balances adjusted accordingly.
+//
+// **Seleção**: `transaction_ids` ou `filter` (os mesmos filtros da listagem), nunca os dois. `RESTORE` aceita apenas IDs, pois os filtros não encontram transações excluídas.
+//
+// **Operações**: `RECATEGORIZE` (`category_id`, vazio remove a categoria; transações divididas voltam a ter uma só categoria), `CHANGE_ACCOUNT` (`account_id`, mesma moeda; transferências, parcelas e transações conciliadas não podem ser movidas), `ADD_TAGS` e `REMOVE_TAGS` (`tag_ids`), `DELETE` e `RESTORE` (transferências são excluídas e restauradas com a outra ponta).
+//
+// **Resultado**: cada transação aparece como `UPDATED`, `UNCHANGED` ou `FAILED`. Se alguma falhar, nenhuma alteração é aplicada e a resposta é 422 com os resultados.
+//
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.BulkTransactionsInput true "Bulk operation" example({"operation":"RECATEGORIZE","transaction_ids":["550e8400-e29b-41d4-a716-446655440010","550e8400-e29b-41d4-a716-446655440011"],"category_id":"550e8400-e29b-41d4-a716-446655440020"})
+// @Success 200 {object} dtos.BulkTransactionsOutput "Bulk operation applied successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid operation or selection" example({"error":"invalid bulk selection: give either transaction IDs or a filter, not both","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - category, tag or account does not belong to user" example({"error":"destination account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - some transactions failed and nothing was applied" example({"error":"Bulk operation not applied: 1 of 2 transactions failed","code":422,"data":{"operation":"DELETE","applied":false,"count":2,"updated_count":1,"unchanged_count":0,"failed_count":1,"results":[]}})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/bulk [post]
+func (h *BulkHandler) Apply(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.BulkTransactionsInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.RequestID = middleware.GetRequestID(c)
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.bulkTransactionsUseCase.Execute(input)
+	if err != nil {
+		appErr := apperrors.MapDomainError(err)
+		if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+			log.Warn().Err(err).Str("error_type", string(appErr.Type)).Str("operation", input.Operation).Msg("Bulk transaction operation failed")
+		} else {
+			log.Error().Err(err).Str("error_type", string(appErr.Type)).Str("operation", input.Operation).Msg("Bulk transaction operation failed")
+		}
+		return appErr
+	}
+
+	if !output.Applied {
+		log.Warn().Str("operation", output.Operation).Int("failed_count", output.FailedCount).Msg("Bulk transaction operation not applied")
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error": fmt.Sprintf("Bulk operation not applied: %d of %d transactions failed", output.FailedCount, output.Count),
+			"code":  fiber.StatusUnprocessableEntity,
+			"data":  output,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Bulk operation applied successfully",
+		"data":    output,
+	})
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// DuplicateHandler handles HTTP requests for reviewing and merging duplicate transactions.
+type DuplicateHandler struct {
+	listDuplicateTransactionsUseCase  *usecases.ListDuplicateTransactionsUseCase
+	mergeDuplicateTransactionsUseCase *usecases.MergeDuplicateTransactionsUseCase
+}
+
+// NewDuplicateHandler creates a new DuplicateHandler instance.
+func NewDuplicateHandler(
+	listDuplicateTransactionsUseCase *usecases.ListDuplicateTransactionsUseCase,
+	mergeDuplicateTransactionsUseCase *usecases.MergeDuplicateTransactionsUseCase,
+) *DuplicateHandler {
+	return &DuplicateHandler{
+		listDuplicateTransactionsUseCase:  listDuplicateTransactionsUseCase,
+		mergeDuplicateTransactionsUseCase: mergeDuplicateTransactionsUseCase,
+	}
+}
+
+// List handles listing likely duplicate transactions.
+// @Summary List likely duplicate transactions
+// @Description Lists pairs of transactions that likely are the same purchase entered twice (e.g. typed in manually and later imported from the bank statement), most similar first.
+//
+// **Critérios**: mesma conta, mesmo tipo e valor, datas com até 3 dias de diferença e descrições semelhantes (sem acentos, pontuação e números, como números de cartão). Transferências e ocorrências da mesma série recorrente não são comparadas.
+//
+// **Período**: padrão são os últimos 90 dias; no máximo 366 dias por consulta.
+//
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param account_id query string false "Filter by account ID"
+// @Param start_date query string false "Start date (YYYY-MM-DD)"
+// @Param end_date query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dtos.ListDuplicateTransactionsOutput "Duplicate transactions retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid period or account ID" example({"error":"invalid period: must be at most 366 days","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/duplicates [get]
+func (h *DuplicateHandler) List(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.ListDuplicateTransactionsInput{
+		UserID:    userID,
+		AccountID: c.Query("account_id"),
+		StartDate: c.Query("start_date"),
+		EndDate:   c.Query("end_date"),
+	}
+
+	output, err := h.listDuplicateTransactionsUseCase.Execute(input)
+	if err != nil {
+		return handleDuplicateError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Duplicate transactions retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Merge handles merging a duplicate transaction into the one that is kept.
+// @Summary Merge duplicate transactions
+// @Description Keeps one transaction and soft-deletes its duplicate, reversing the duplicate's effect on the account balance atomically using Unit of Work pattern.
+//
+// **Transação mantida**: conserva valor, data e descrição; recebe a categoria da duplicata se não tiver uma, a união das tags e o identificador bancário (FITID) da duplicata se não tiver um, para que a reimportação do extrato continue a ignorá-la.
+//
+// **Restrições**: as duas transações devem ser do usuário, da mesma conta, do mesmo tipo e moeda. Transferências não podem ser mescladas (exclua a transferência duplicada).
+//
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.MergeDuplicateTransactionsInput true "Transactions to merge" example({"keep_transaction_id":"550e8400-e29b-41d4-a716-446655440010","duplicate_transaction_id":"550e8400-e29b-41d4-a716-446655440011"})
+// @Success 200 {object} dtos.MergeDuplicateTransactionsOutput "Duplicate transactions merged successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid IDs or transactions that cannot be merged" example({"error":"invalid merge: duplicate transaction must be in the same account","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"transaction not found: 550e8400-e29b-41d4-a716-446655440011","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - balance cannot be corrected" example({"error":"failed to reverse duplicate transaction: insufficient balance","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/duplicates/merge [post]
+func (h *DuplicateHandler) Merge(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.MergeDuplicateTransactionsInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.mergeDuplicateTransactionsUseCase.Execute(input)
+	if err != nil {
+		return handleDuplicateError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Duplicate transactions merged successfully",
+		"data":    output,
+	})
+}
+
+// handleDuplicateError maps a duplicate review error to an application error and logs it.
+func handleDuplicateError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Duplicate transaction operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Duplicate transaction operation failed")
+	}
+	return appErr
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// ExportHandler handles HTTP requests for exporting transactions.
+type ExportHandler struct {
+	exportTransactionsUseCase *usecases.ExportTransactionsUseCase
+}
+
+// NewExportHandler creates a new ExportHandler instance.
+func NewExportHandler(exportTransactionsUseCase *usecases.ExportTransactionsUseCase) *ExportHandler {
+	return &ExportHandler{
+		exportTransactionsUseCase: exportTransactionsUseCase,
+	}
+}
+
+// Export handles downloading the transactions of the user as a spreadsheet or JSON file.
+// @Summary Export transactions
+// @Description Downloads every transaction of the authenticated user that matches the filters, as CSV, XLSX or JSON. The filters and sorting are the same as `GET /transactions`; pagination parameters are ignored.
+//
+// **Colunas**: data, descrição, tipo, valor, moeda, situação, nome da conta, categoria (nas transações divididas, as categorias das divisões), recorrência (se inicia uma série, frequência e data final), parcela (`2/10`), transação de origem (série ou compra parcelada) e os IDs da conta, da categoria e da transação.
+//
+// **Formatos**:
+// - `csv` (padrão): com `locale=pt-BR` (padrão) usa `;` como separador, valores `1.234,56`, datas `DD/MM/YYYY`, cabeçalhos em português e BOM UTF-8 para o Excel; com `locale=en` usa `,`, valores `1234.56` e datas `YYYY-MM-DD`
+// - `xlsx`: planilha com datas e valores numéricos reais (formatados pelo idioma da planilha); o `locale` define os cabeçalhos
+// - `json`: array de transações com valores decimais e datas `YYYY-MM-DD`; o `locale` é ignorado
+//
+// **Streaming**: o arquivo é gerado enquanto é enviado, lendo as transações do banco em lotes, sem carregar tudo em memória.
+//
+// @Tags transactions
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce json
+// @Security Bearer
+// @Param format query string false "File format (default: csv)" Enums(csv, xlsx, json) example(xlsx)
+// @Param locale query string false "Number/date formatting and headers (default: pt-BR)" Enums(pt-BR, en) example(pt-BR)
+// @Param account_id query string false "Filter by account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
+// @Param type query string false "Filter by transaction type" Enums(INCOME, EXPENSE, TRANSFER_OUT, TRANSFER_IN, ADJUSTMENT_IN, ADJUSTMENT_OUT) example(EXPENSE)
+// @Param status query string false "Filter by reconciliation status" Enums(PENDING, CLEARED, RECONCILED) example(CLEARED)
+// @Param tag_ids query string false "Filter by tag IDs (comma-separated UUIDs)" example(550e8400-e29b-41d4-a716-446655440010)
+// @Param tag_match query string false "How tag_ids are matched (default: any)" Enums(any, all) example(all)
+// @Param start_date query string false "Start date (YYYY-MM-DD, inclusive)" example(2026-01-01)
+// @Param end_date query string false "End date (YYYY-MM-DD, inclusive)" example(2026-12-31)
+// @Param min_amount query number false "Minimum amount (inclusive)" example(10.00)
+// @Param max_amount query number false "Maximum amount (inclusive)" example(500.00)
+// @Param currency query string false "Filter by currency" Enums(BRL, USD, EUR) example(BRL)
+// @Param q query string false "Full-text search on the description" example(supermercado)
+// @Param recurring query bool false "Only recurring transactions and their occurrences" example(true)
+// @Param sort_by query string false "Sort field (default: date)" Enums(date, amount, description) example(date)
+// @Param sort_order query string false "Sort direction (default: desc)" Enums(asc, desc) example(asc)
+// @Success 200 {file} file "Exported transactions"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid format, locale or filters" example({"error":"invalid export format: must be csv, xlsx or json, got pdf","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/export [get]
+func (h *ExportHandler) Export(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.ExportTransactionsInput{
+		ListTransactionsInput: listTransactionsInputFromQuery(c, userID),
+		Format:                c.Query("format", ""),
+		Locale:                c.Query("locale", ""),
+	}
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.exportTransactionsUseCase.Execute(input)
+	if err != nil {
+		appErr := apperrors.MapDomainError(err)
+		if appErr.Type == apperrors.ErrorTypeValidation {
+			log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Transaction export failed")
+		} else {
+			log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Transaction export failed")
+		}
+		return appErr
+	}
+
+	c.Attachment(output.FileName)
+	c.Set(fiber.HeaderContentType, output.ContentType)
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	// The size is unknown until the export ends, so the file is sent in chunks; fasthttp closes
+	// the content once it has been sent (or the client disconnects), which stops the export
+	return c.Status(fiber.StatusOK).SendStream(output.Content)
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// HistoryHandler handles HTTP requests for the edit history of transactions.
+type HistoryHandler struct {
+	getTransactionHistoryUseCase *usecases.GetTransactionHistoryUseCase
+	revertTransactionUseCase     *usecases.RevertTransactionUseCase
+}
+
+// NewHistoryHandler creates a new HistoryHandler instance.
+func NewHistoryHandler(
+	getTransactionHistoryUseCase *usecases.GetTransactionHistoryUseCase,
+	revertTransactionUseCase *usecases.RevertTransactionUseCase,
+) *HistoryHandler {
+	return &HistoryHandler{
+		getTransactionHistoryUseCase: getTransactionHistoryUseCase,
+		revertTransactionUseCase:     revertTransactionUseCase,
+	}
+}
+
+// Get handles retrieving the edit history of a transaction.
+// @Summary Get transaction edit history
+// @Description Returns every change made to a transaction, oldest first. Each revision is immutable and holds the state before and after the change, the user who made it, the request ID (`X-Request-ID`) and when it happened.
+//
+// **Ações**: `CREATE` e `RESTORE` têm apenas `after`; `DELETE` tem apenas `before`; `UPDATE` e `REVERT` têm os dois e listam os campos alterados em `changed_fields`. Alterações feitas pelo sistema (ex.: geração de recorrências) não têm `actor_id`.
+//
+// **Transações excluídas** mantêm o histórico, que continua disponível após a exclusão.
+//
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID" example("550e8400-e29b-41d4-a716-446655440010")
+// @Success 200 {object} dtos.GetTransactionHistoryOutput "Transaction history retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid transaction ID" example({"error":"invalid transaction ID: invalid UUID format","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"transaction not found: 550e8400-e29b-41d4-a716-446655440010","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/{id}/history [get]
+func (h *HistoryHandler) Get(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.GetTransactionHistoryInput{
+		UserID:        userID,
+		TransactionID: c.Params("id"),
+	}
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.getTransactionHistoryUseCase.Execute(input)
+	if err != nil {
+		return handleHistoryError(err, input.TransactionID)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Transaction history retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Revert handles bringing a transaction back to the state after one of its revisions.
+// @Summary Revert a transaction to a revision
+// @Description Brings the transaction back to the state recorded after the given revision (account, type, amount, description, date, category and tags) and adjusts the account balances by the difference, atomically using Unit of Work pattern. The revert is recorded as a new `REVERT` revision, so it can be reverted as well.
+//
+// **Restrições**: não é possível reverter para uma revisão `DELETE` (use a restauração), nem transações excluídas ou transferências. O status não é revertido; transações conciliadas só aceitam reverter campos que não afetam o saldo.
+//
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID" example("550e8400-e29b-41d4-a716-446655440010")
+// @Param revisionId path string true "Revision ID" example("550e8400-e29b-41d4-a716-446655440030")
+// @Success 200 {object} dtos.RevertTransactionOutput "Transaction reverted successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - revision cannot be reverted to" example({"error":"invalid revision: a deletion has no state to revert to","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction or revision does not exist" example({"error":"revision not found: 550e8400-e29b-41d4-a716-446655440030","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - transaction cannot be reverted" example({"error":"cannot revert a transfer transaction: edit the transfer instead","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/{id}/history/{revisionId}/revert [post]
+func (h *HistoryHandler) Revert(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.RevertTransactionInput{
+		UserID:        userID,
+		TransactionID: c.Params("id"),
+		RevisionID:    c.Params("revisionId"),
+		RequestID:     middleware.GetRequestID(c),
+	}
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.revertTransactionUseCase.Execute(input)
+	if err != nil {
+		return handleHistoryError(err, input.TransactionID)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Transaction reverted successfully",
+		"data":    output,
+	})
+}
+
+func handleHistoryError(err error, transactionID string) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Str("transaction_id", transactionID).Msg("Transaction history operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Str("transaction_id", transactionID).Msg("Transaction history operation failed")
+	}
+	return appErr
+}
@@ -0,0 +1,438 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// maxStatementFileSize is the largest bank statement file accepted for import (2 MB).
+const maxStatementFileSize = 2 * 1024 * 1024
+
+// ImportHandler handles HTTP requests for bank statement imports.
+type ImportHandler struct {
+	previewCSVImportUseCase       *usecases.PreviewCSVImportUseCase
+	importCSVUseCase              *usecases.ImportCSVUseCase
+	previewStatementImportUseCase *usecases.PreviewStatementImportUseCase
+	importStatementUseCase        *usecases.ImportStatementUseCase
+	listImportBatchesUseCase      *usecases.ListImportBatchesUseCase
+	rollbackImportBatchUseCase    *usecases.RollbackImportBatchUseCase
+}
+
+// NewImportHandler creates a new ImportHandler instance.
+func NewImportHandler(
+	previewCSVImportUseCase *usecases.PreviewCSVImportUseCase,
+	importCSVUseCase *usecases.ImportCSVUseCase,
+	previewStatementImportUseCase *usecases.PreviewStatementImportUseCase,
+	importStatementUseCase *usecases.ImportStatementUseCase,
+	listImportBatchesUseCase *usecases.ListImportBatchesUseCase,
+	rollbackImportBatchUseCase *usecases.RollbackImportBatchUseCase,
+) *ImportHandler {
+	return &ImportHandler{
+		previewCSVImportUseCase:       previewCSVImportUseCase,
+		importCSVUseCase:              importCSVUseCase,
+		previewStatementImportUseCase: previewStatementImportUseCase,
+		importStatementUseCase:        importStatementUseCase,
+		listImportBatchesUseCase:      listImportBatchesUseCase,
+		rollbackImportBatchUseCase:    rollbackImportBatchUseCase,
+	}
+}
+
+// PreviewCSV handles CSV statement preview (dry run) requests.
+// @Summary Preview a CSV statement import
+// @Description Parses a CSV bank statement with the given column mapping and returns the rows that would be imported, the errors per line and the totals. Nothing is saved.
+//
+// **Mapeamento de colunas**: As colunas podem ser indicadas pelo nome no cabeçalho ou pela posição (começando em 1). Use `amount_column` para um valor com sinal (negativo = despesa) ou `debit_column` e `credit_column` para colunas separadas.
+//
+// **Formatos**: `date_format` aceita DD/MM/YYYY, DD/MM/YY, DD-MM-YYYY, DD.MM.YYYY, MM/DD/YYYY, YYYY-MM-DD e YYYY/MM/DD. `decimal_separator` é "," ou "." (o outro caractere é tratado como separador de milhar).
+//
+// @Tags transactions
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "CSV statement (max 2 MB)"
+// @Param account_id formData string true "Account to import into"
+// @Param date_column formData string true "Date column (header name or 1-based position)"
+// @Param date_format formData string true "Date format" example(DD/MM/YYYY)
+// @Param description_column formData string true "Description column"
+// @Param amount_column formData string false "Signed amount column"
+// @Param debit_column formData string false "Debit column (with credit_column)"
+// @Param credit_column formData string false "Credit column (with debit_column)"
+// @Param decimal_separator formData string false "Decimal separator (\",\" or \".\", default \".\")"
+// @Param delimiter formData string false "Field delimiter (\",\", \";\", \"|\" or \"tab\", default \",\")"
+// @Param has_header formData boolean false "First line is a header (default true)"
+// @Success 200 {object} dtos.PreviewCSVImportOutput "Statement parsed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid file or column mapping" example({"error":"invalid date column: column \"Data\" not found in header","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"target account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"target account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/imports/preview [post]
+func (h *ImportHandler) PreviewCSV(c *fiber.Ctx) error {
+	input, err := parseCSVImportRequest(c)
+	if input == nil {
+		return err
+	}
+
+	output, err := h.previewCSVImportUseCase.Execute(*input)
+	if err != nil {
+		return handleImportError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Statement parsed successfully",
+		"data":    output,
+	})
+}
+
+// ImportCSV handles CSV statement import requests.
+// @Summary Import a CSV statement
+// @Description Imports a CSV bank statement into an account. All rows are saved atomically with a single balance update and recorded in an import batch that can be rolled back.
+//
+// **Linhas com erro**: Por padrão a importação falha se alguma linha tiver erro. Envie `skip_invalid_rows=true` para importar apenas as linhas válidas (as ignoradas são retornadas em `skipped_rows`).
+//
+// **Desfazer**: Use `POST /transactions/imports/{id}/rollback` para remover todas as transações do lote.
+//
+// @Tags transactions
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "CSV statement (max 2 MB)"
+// @Param account_id formData string true "Account to import into"
+// @Param date_column formData string true "Date column (header name or 1-based position)"
+// @Param date_format formData string true "Date format" example(DD/MM/YYYY)
+// @Param description_column formData string true "Description column"
+// @Param amount_column formData string false "Signed amount column"
+// @Param debit_column formData string false "Debit column (with credit_column)"
+// @Param credit_column formData string false "Credit column (with debit_column)"
+// @Param decimal_separator formData string false "Decimal separator (\",\" or \".\", default \".\")"
+// @Param delimiter formData string false "Field delimiter (\",\", \";\", \"|\" or \"tab\", default \",\")"
+// @Param has_header formData boolean false "First line is a header (default true)"
+// @Param skip_invalid_rows formData boolean false "Import only the valid rows"
+// @Success 201 {object} dtos.ImportCSVOutput "Statement imported successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid file, column mapping or rows with errors" example({"error":"invalid statement: 1 row(s) have errors; fix them or set skip_invalid_rows (first error on line 4: invalid amount \"abc\")","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"target account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"target account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - insufficient balance" example({"error":"failed to debit account: insufficient balance","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/imports [post]
+func (h *ImportHandler) ImportCSV(c *fiber.Ctx) error {
+	input, err := parseCSVImportRequest(c)
+	if input == nil {
+		return err
+	}
+
+	output, err := h.importCSVUseCase.Execute(*input)
+	if err != nil {
+		return handleImportError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Statement imported successfully",
+		"data":    output,
+	})
+}
+
+// PreviewStatement handles OFX and QIF statement preview (dry run) requests.
+// @Summary Preview an OFX or QIF statement import
+// @Description Parses an OFX or QIF bank statement and returns the entries that would be imported, the entries already imported before (same bank identifier), the errors per entry and the totals. Nothing is saved.
+//
+// **Formato**: Detectado pela extensão do arquivo (.ofx ou .qif) ou informado em `format`. OFX 1.x (SGML) e 2.x (XML) são aceitos, inclusive em Windows-1252.
+//
+// **Conciliação**: Quando o OFX traz `LEDGERBAL`, a resposta compara o saldo da conta após a importação com o saldo do extrato.
+//
+// @Tags transactions
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "OFX or QIF statement (max 2 MB)"
+// @Param account_id formData string true "Account to import into"
+// @Param format formData string false "OFX or QIF (default: from the file extension)"
+// @Param date_format formData string false "QIF date format (default DD/MM/YYYY)"
+// @Success 200 {object} dtos.PreviewStatementImportOutput "Statement parsed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid file, format or currency" example({"error":"invalid statement format: TXT. Supported values: OFX, QIF","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"target account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"target account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/imports/statement/preview [post]
+func (h *ImportHandler) PreviewStatement(c *fiber.Ctx) error {
+	input, err := parseStatementImportRequest(c)
+	if input == nil {
+		return err
+	}
+
+	output, err := h.previewStatementImportUseCase.Execute(*input)
+	if err != nil {
+		return handleImportError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Statement parsed successfully",
+		"data":    output,
+	})
+}
+
+// ImportStatement handles OFX and QIF statement import requests.
+// @Summary Import an OFX or QIF statement
+// @Description Imports an OFX or QIF bank statement into an account. Entries already imported before (same FITID) are skipped, so overlapping statements can be imported safely. New entries are saved atomically with a single balance update and recorded in an import batch that can be rolled back.
+//
+// **Linhas com erro**: Por padrão a importação falha se alguma entrada tiver erro. Envie `skip_invalid_rows=true` para importar apenas as válidas.
+//
+// **Conciliação**: Com `reconcile_balance=true`, a importação falha se o saldo da conta após a importação for diferente do saldo `LEDGERBAL` do extrato OFX.
+//
+// @Tags transactions
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "OFX or QIF statement (max 2 MB)"
+// @Param account_id formData string true "Account to import into"
+// @Param format formData string false "OFX or QIF (default: from the file extension)"
+// @Param date_format formData string false "QIF date format (default DD/MM/YYYY)"
+// @Param skip_invalid_rows formData boolean false "Import only the valid entries"
+// @Param reconcile_balance formData boolean false "Fail if the balance does not match the statement ledger balance"
+// @Success 201 {object} dtos.ImportStatementOutput "Statement imported successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid file, format, currency or entries with errors" example({"error":"invalid statement: currency USD does not match account currency BRL","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"target account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"target account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 409 {object} map[string]interface{} "Conflict - every entry was already imported" example({"error":"duplicate statement: all 3 entries were already imported into the account","error_type":"CONFLICT","code":409})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - balance does not reconcile or insufficient balance" example({"error":"failed to reconcile statement: account balance after import would be 2400.00 but the statement balance on 2026-10-05 is 2500.00","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/imports/statement [post]
+func (h *ImportHandler) ImportStatement(c *fiber.Ctx) error {
+	input, err := parseStatementImportRequest(c)
+	if input == nil {
+		return err
+	}
+
+	output, err := h.importStatementUseCase.Execute(*input)
+	if err != nil {
+		return handleImportError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Statement imported successfully",
+		"data":    output,
+	})
+}
+
+// List handles listing the import batches of the authenticated user.
+// @Summary List statement imports
+// @Description Lists the statement import batches of the authenticated user, newest first.
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dtos.ListImportBatchesOutput "Import batches retrieved successfully"
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/imports [get]
+func (h *ImportHandler) List(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	output, err := h.listImportBatchesUseCase.Execute(dtos.ListImportBatchesInput{UserID: userID})
+	if err != nil {
+		return handleImportError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Import batches retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Rollback handles import batch rollback requests.
+// @Summary Roll back a statement import
+// @Description Removes every transaction created by an import batch and reverses its effect on the account balance with a single update. Transactions already deleted individually are left as they are.
+// @Tags transactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Import batch ID"
+// @Success 200 {object} dtos.RollbackImportBatchOutput "Import batch rolled back successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid batch ID" example({"error":"invalid import batch ID: invalid import batch ID format (must be UUID)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - batch does not belong to user" example({"error":"import batch does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - batch does not exist" example({"error":"import batch not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - batch already rolled back or insufficient balance" example({"error":"import batch cannot be rolled back twice","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/imports/{id}/rollback [post]
+func (h *ImportHandler) Rollback(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	batchID := c.Params("id")
+	if batchID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Import batch ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	output, err := h.rollbackImportBatchUseCase.Execute(dtos.RollbackImportBatchInput{
+		UserID:  userID,
+		BatchID: batchID,
+	})
+	if err != nil {
+		return handleImportError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Import batch rolled back successfully",
+		"data":    output,
+	})
+}
+
+// parseCSVImportRequest reads the statement file and the column mapping from a multipart request.
+// When the request cannot be used it returns a nil input; the error response has then either been
+// written already or is returned as the error, so callers just return the error.
+func parseCSVImportRequest(c *fiber.Ctx) (*dtos.CSVImportInput, error) {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return nil, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.CSVImportInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	file, err := readStatementFile(c)
+	if file == nil {
+		return nil, err
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.FileName = file.name
+	input.Content = file.content
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return nil, err
+	}
+
+	return &input, nil
+}
+
+// parseStatementImportRequest reads the OFX or QIF statement file and the import options from a
+// multipart request. It follows the same contract as parseCSVImportRequest.
+func parseStatementImportRequest(c *fiber.Ctx) (*dtos.StatementImportInput, error) {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return nil, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.StatementImportInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	file, err := readStatementFile(c)
+	if file == nil {
+		return nil, err
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.FileName = file.name
+	input.Content = file.content
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return nil, err
+	}
+
+	return &input, nil
+}
+
+// statementFile is an uploaded bank statement.
+type statementFile struct {
+	name    string
+	content []byte
+}
+
+// readStatementFile reads the "file" field of a multipart request, up to maxStatementFileSize.
+// When the file is missing or cannot be read it writes a 400 response and returns a nil file.
+func readStatementFile(c *fiber.Ctx) (*statementFile, error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Statement file is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+	if fileHeader.Size > maxStatementFileSize {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Statement file must be at most %d MB", maxStatementFileSize/(1024*1024)),
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to open statement file")
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid statement file",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxStatementFileSize))
+	if err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to read statement file")
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid statement file",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	return &statementFile{name: fileHeader.Filename, content: content}, nil
+}
+
+// handleImportError maps use case errors to HTTP errors and logs them.
+func handleImportError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Import operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Import operation failed")
+	}
+	return appErr
+}
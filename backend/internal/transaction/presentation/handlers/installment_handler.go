@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// InstallmentHandler handles HTTP requests for purchases paid in installments.
+type InstallmentHandler struct {
+	createInstallmentPurchaseUseCase *usecases.CreateInstallmentPurchaseUseCase
+	getInstallmentPurchaseUseCase    *usecases.GetInstallmentPurchaseUseCase
+	cancelInstallmentPurchaseUseCase *usecases.CancelInstallmentPurchaseUseCase
+	prepayInstallmentsUseCase        *usecases.PrepayInstallmentsUseCase
+}
+
+// NewInstallmentHandler creates a new InstallmentHandler instance.
+func NewInstallmentHandler(
+	createInstallmentPurchaseUseCase *usecases.CreateInstallmentPurchaseUseCase,
+	getInstallmentPurchaseUseCase *usecases.GetInstallmentPurchaseUseCase,
+	cancelInstallmentPurchaseUseCase *usecases.CancelInstallmentPurchaseUseCase,
+	prepayInstallmentsUseCase *usecases.PrepayInstallmentsUseCase,
+) *InstallmentHandler {
+	return &InstallmentHandler{
+		createInstallmentPurchaseUseCase: createInstallmentPurchaseUseCase,
+		getInstallmentPurchaseUseCase:    getInstallmentPurchaseUseCase,
+		cancelInstallmentPurchaseUseCase: cancelInstallmentPurchaseUseCase,
+		prepayInstallmentsUseCase:        prepayInstallmentsUseCase,
+	}
+}
+
+// Create handles creating an installment purchase.
+// @Summary Create an installment purchase
+// @Description Creates a credit card purchase paid in monthly installments. One expense transaction is created per installment, labeled "1/10", "2/10"... and dated on the same day of consecutive months (clamped to the end of shorter months). The whole purchase is debited from the card at once, atomically using Unit of Work pattern.
+//
+// **Juros**: com `interest_rate` zero (padrão, "sem juros") o valor é dividido igualmente e os centavos restantes vão para as primeiras parcelas; com juros, as parcelas seguem a Tabela Price (taxa mensal em %).
+//
+// **Parcelas**: cada parcela é uma transação comum e pode ser editada, marcada como conferida ou excluída individualmente. Consulte a compra por `GET /transactions/installments/{id}` com o ID de qualquer parcela.
+//
+// @Tags transaction-installments
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreateInstallmentPurchaseInput true "Purchase data" example({"account_id":"550e8400-e29b-41d4-a716-446655440000","amount":1200.00,"currency":"BRL","description":"Notebook","first_date":"2026-10-15","installments":10,"category_id":"550e8400-e29b-41d4-a716-446655440001"})
+// @Success 201 {object} dtos.InstallmentPurchaseOutput "Installment purchase created successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid purchase data or account is not a credit card" example({"error":"invalid installments: installment count must be between 2 and 48","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"credit card account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"credit card account not found: 550e8400-e29b-41d4-a716-446655440000","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/installments [post]
+func (h *InstallmentHandler) Create(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.CreateInstallmentPurchaseInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.createInstallmentPurchaseUseCase.Execute(input)
+	if err != nil {
+		return handleInstallmentError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Installment purchase created successfully",
+		"data":    output,
+	})
+}
+
+// Get handles retrieving an installment purchase.
+// @Summary Get an installment purchase
+// @Description Returns an installment purchase with its installments, given the ID of any of them. Installments dated after today count as remaining.
+// @Tags transaction-installments
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID of any installment" example("550e8400-e29b-41d4-a716-446655440002")
+// @Success 200 {object} dtos.InstallmentPurchaseOutput "Installment purchase retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - transaction is not an installment" example({"error":"invalid installment purchase: transaction is not an installment","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"transaction not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/installments/{id} [get]
+func (h *InstallmentHandler) Get(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	transactionID := c.Params("id")
+	if transactionID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Transaction ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	output, err := h.getInstallmentPurchaseUseCase.Execute(dtos.GetInstallmentPurchaseInput{
+		UserID:        userID,
+		TransactionID: transactionID,
+	})
+	if err != nil {
+		return handleInstallmentError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Installment purchase retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Cancel handles canceling the remaining installments of a purchase.
+// @Summary Cancel an installment purchase
+// @Description Cancels the installments dated after the given date (default today), e.g. when the purchase is returned. They are deleted and credited back to the card atomically using Unit of Work pattern; installments already due are kept.
+// @Tags transaction-installments
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID of any installment" example("550e8400-e29b-41d4-a716-446655440002")
+// @Param request body dtos.CancelInstallmentPurchaseInput false "Cancellation date" example({"date":"2026-12-01"})
+// @Success 200 {object} dtos.CancelInstallmentPurchaseOutput "Installment purchase canceled successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid date or transaction is not an installment" example({"error":"invalid date format: expected YYYY-MM-DD, got 01/12/2026","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"transaction not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - no installments left to cancel" example({"error":"installment purchase cannot be changed: it has no installments due after 2026-12-01","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/installments/{id}/cancel [post]
+func (h *InstallmentHandler) Cancel(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	transactionID := c.Params("id")
+	if transactionID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Transaction ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	var input dtos.CancelInstallmentPurchaseInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+	}
+
+	input.UserID = userID
+	input.TransactionID = transactionID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.cancelInstallmentPurchaseUseCase.Execute(input)
+	if err != nil {
+		return handleInstallmentError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Installment purchase canceled successfully",
+		"data":    output,
+	})
+}
+
+// Prepay handles paying the remaining installments of a purchase in advance.
+// @Summary Prepay installments
+// @Description Pays the installments dated after the payment date (default today) in advance: they are moved to the payment date, keeping their numbering, atomically using Unit of Work pattern.
+//
+// **Desconto**: informe em `amount` o valor efetivamente pago quando a operadora concede desconto na antecipação; ele é distribuído entre as parcelas proporcionalmente e a diferença é creditada de volta no cartão.
+//
+// @Tags transaction-installments
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Transaction ID of any installment" example("550e8400-e29b-41d4-a716-446655440002")
+// @Param request body dtos.PrepayInstallmentsInput false "Payment data" example({"date":"2026-12-10","amount":800.00})
+// @Success 200 {object} dtos.PrepayInstallmentsOutput "Installments prepaid successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid payment data" example({"error":"invalid prepayment amount: must be at most the remaining total 840.00 BRL","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - transaction does not belong to user" example({"error":"transaction does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"transaction not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - no installments left to prepay" example({"error":"installment purchase cannot be changed: it has no installments due after 2026-12-10","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/installments/{id}/prepay [post]
+func (h *InstallmentHandler) Prepay(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	transactionID := c.Params("id")
+	if transactionID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Transaction ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	var input dtos.PrepayInstallmentsInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+	}
+
+	input.UserID = userID
+	input.TransactionID = transactionID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.prepayInstallmentsUseCase.Execute(input)
+	if err != nil {
+		return handleInstallmentError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Installments prepaid successfully",
+		"data":    output,
+	})
+}
+
+// handleInstallmentError maps an installment purchase error to an application error and logs it.
+func handleInstallmentError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Installment purchase operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Installment purchase operation failed")
+	}
+	return appErr
+}
@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// ReconciliationHandler handles HTTP requests for reconciling accounts against bank statements.
+type ReconciliationHandler struct {
+	startReconciliationUseCase    *usecases.StartReconciliationUseCase
+	getReconciliationUseCase      *usecases.GetReconciliationUseCase
+	listReconciliationsUseCase    *usecases.ListReconciliationsUseCase
+	completeReconciliationUseCase *usecases.CompleteReconciliationUseCase
+	cancelReconciliationUseCase   *usecases.CancelReconciliationUseCase
+}
+
+// NewReconciliationHandler creates a new ReconciliationHandler instance.
+func NewReconciliationHandler(
+	startReconciliationUseCase *usecases.StartReconciliationUseCase,
+	getReconciliationUseCase *usecases.GetReconciliationUseCase,
+	listReconciliationsUseCase *usecases.ListReconciliationsUseCase,
+	completeReconciliationUseCase *usecases.CompleteReconciliationUseCase,
+	cancelReconciliationUseCase *usecases.CancelReconciliationUseCase,
+) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		startReconciliationUseCase:    startReconciliationUseCase,
+		getReconciliationUseCase:      getReconciliationUseCase,
+		listReconciliationsUseCase:    listReconciliationsUseCase,
+		completeReconciliationUseCase: completeReconciliationUseCase,
+		cancelReconciliationUseCase:   cancelReconciliationUseCase,
+	}
+}
+
+// Start handles starting an account reconciliation.
+// @Summary Start an account reconciliation
+// @Description Starts reconciling an account against a bank statement, given the statement end date and closing balance. Returns the unreconciled transactions up to the statement date and the difference between the statement balance and the cleared balance.
+//
+// **Fluxo**: marque como `CLEARED` (via `PUT /transactions/{id}` com `{"status":"CLEARED"}`) as transações que aparecem no extrato, consulte a conciliação para acompanhar a diferença e conclua quando ela for zero.
+//
+// **Restrições**: apenas uma conciliação em andamento por conta; a data do extrato não pode ser anterior à da última conciliação concluída.
+//
+// @Tags transaction-reconciliations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.StartReconciliationInput true "Statement data" example({"account_id":"550e8400-e29b-41d4-a716-446655440000","statement_date":"2026-09-30","statement_balance":1520.35})
+// @Success 201 {object} dtos.ReconciliationOutput "Reconciliation started successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid statement data" example({"error":"invalid statement date format: expected YYYY-MM-DD, got 30/09/2026","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"reconciled account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"reconciled account not found: 550e8400-e29b-41d4-a716-446655440000","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - a reconciliation is already in progress" example({"error":"reconciliation cannot be started: the account already has a reconciliation in progress","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/reconciliations [post]
+func (h *ReconciliationHandler) Start(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.StartReconciliationInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.startReconciliationUseCase.Execute(input)
+	if err != nil {
+		return handleReconciliationError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Reconciliation started successfully",
+		"data":    output,
+	})
+}
+
+// List handles listing the reconciliation history of an account.
+// @Summary List account reconciliations
+// @Description Lists the reconciliations of an account, latest statement first. Transactions are not listed; get a single reconciliation for them.
+// @Tags transaction-reconciliations
+// @Produce json
+// @Security Bearer
+// @Param account_id query string true "Account ID"
+// @Success 200 {object} dtos.ListReconciliationsOutput "Reconciliations retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - missing or invalid account ID" example({"error":"invalid account ID: invalid UUID length: 3","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"reconciled account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"reconciled account not found: 550e8400-e29b-41d4-a716-446655440000","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/reconciliations [get]
+func (h *ReconciliationHandler) List(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.ListReconciliationsInput{
+		UserID:    userID,
+		AccountID: c.Query("account_id"),
+	}
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.listReconciliationsUseCase.Execute(input)
+	if err != nil {
+		return handleReconciliationError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Reconciliations retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Get handles retrieving a reconciliation.
+// @Summary Get an account reconciliation
+// @Description Returns a reconciliation. While it is in progress, the reconciled balance, cleared balance, difference and the unreconciled transactions up to the statement date reflect the current transaction statuses.
+// @Tags transaction-reconciliations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Reconciliation ID" example("550e8400-e29b-41d4-a716-446655440030")
+// @Success 200 {object} dtos.ReconciliationOutput "Reconciliation retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid reconciliation ID" example({"error":"invalid reconciliation ID: invalid UUID length: 3","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - reconciliation does not belong to user" example({"error":"reconciliation does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - reconciliation does not exist" example({"error":"reconciliation not found: 550e8400-e29b-41d4-a716-446655440030","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/reconciliations/{id} [get]
+func (h *ReconciliationHandler) Get(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	reconciliationID := c.Params("id")
+	if reconciliationID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Reconciliation ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	output, err := h.getReconciliationUseCase.Execute(dtos.GetReconciliationInput{
+		UserID:           userID,
+		ReconciliationID: reconciliationID,
+	})
+	if err != nil {
+		return handleReconciliationError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Reconciliation retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Complete handles completing a reconciliation.
+// @Summary Complete an account reconciliation
+// @Description Completes a reconciliation whose difference is zero, marking the cleared transactions up to the statement date as reconciled atomically using Unit of Work pattern.
+//
+// **Transações conciliadas**: não podem ser excluídas nem ter tipo, valor ou data alterados; para corrigi-las, volte o status para `CLEARED`.
+//
+// @Tags transaction-reconciliations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Reconciliation ID" example("550e8400-e29b-41d4-a716-446655440030")
+// @Success 200 {object} dtos.ReconciliationOutput "Reconciliation completed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid reconciliation ID" example({"error":"invalid reconciliation ID: invalid UUID length: 3","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - reconciliation does not belong to user" example({"error":"reconciliation does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - reconciliation does not exist" example({"error":"reconciliation not found: 550e8400-e29b-41d4-a716-446655440030","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - the cleared balance does not match the statement" example({"error":"reconciliation cannot be completed: cleared balance 1500.35 BRL differs from statement balance 1520.35 BRL by 20.00 BRL","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/reconciliations/{id}/complete [post]
+func (h *ReconciliationHandler) Complete(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	reconciliationID := c.Params("id")
+	if reconciliationID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Reconciliation ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	output, err := h.completeReconciliationUseCase.Execute(dtos.CompleteReconciliationInput{
+		UserID:           userID,
+		ReconciliationID: reconciliationID,
+	})
+	if err != nil {
+		return handleReconciliationError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Reconciliation completed successfully",
+		"data":    output,
+	})
+}
+
+// Cancel handles canceling a reconciliation.
+// @Summary Cancel an account reconciliation
+// @Description Abandons a reconciliation in progress. Transaction statuses are kept, so cleared transactions stay cleared for the next reconciliation.
+// @Tags transaction-reconciliations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Reconciliation ID" example("550e8400-e29b-41d4-a716-446655440030")
+// @Success 200 {object} dtos.ReconciliationOutput "Reconciliation canceled successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid reconciliation ID" example({"error":"invalid reconciliation ID: invalid UUID length: 3","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - reconciliation does not belong to user" example({"error":"reconciliation does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - reconciliation does not exist" example({"error":"reconciliation not found: 550e8400-e29b-41d4-a716-446655440030","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - reconciliation is no longer in progress" example({"error":"reconciliation cannot be canceled: it is COMPLETED","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/reconciliations/{id}/cancel [post]
+func (h *ReconciliationHandler) Cancel(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	reconciliationID := c.Params("id")
+	if reconciliationID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Reconciliation ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	output, err := h.cancelReconciliationUseCase.Execute(dtos.CancelReconciliationInput{
+		UserID:           userID,
+		ReconciliationID: reconciliationID,
+	})
+	if err != nil {
+		return handleReconciliationError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Reconciliation canceled successfully",
+		"data":    output,
+	})
+}
+
+// handleReconciliationError maps a reconciliation error to an application error and logs it.
+func handleReconciliationError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Reconciliation operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Reconciliation operation failed")
+	}
+	return appErr
+}
@@ -0,0 +1,508 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// RecurringSeriesHandler handles HTTP requests for managing recurring transaction series.
+type RecurringSeriesHandler struct {
+	listRecurringSeriesUseCase     *usecases.ListRecurringSeriesUseCase
+	previewRecurringSeriesUseCase  *usecases.PreviewRecurringSeriesUseCase
+	pauseRecurringSeriesUseCase    *usecases.PauseRecurringSeriesUseCase
+	resumeRecurringSeriesUseCase   *usecases.ResumeRecurringSeriesUseCase
+	skipRecurringOccurrenceUseCase *usecases.SkipRecurringOccurrenceUseCase
+	changeRecurringAmountUseCase   *usecases.ChangeRecurringAmountUseCase
+	endRecurringSeriesUseCase      *usecases.EndRecurringSeriesUseCase
+	updateRecurringScheduleUseCase *usecases.UpdateRecurringScheduleUseCase
+}
+
+// NewRecurringSeriesHandler creates a new RecurringSeriesHandler instance.
+func NewRecurringSeriesHandler(
+	listRecurringSeriesUseCase *usecases.ListRecurringSeriesUseCase,
+	previewRecurringSeriesUseCase *usecases.PreviewRecurringSeriesUseCase,
+	pauseRecurringSeriesUseCase *usecases.PauseRecurringSeriesUseCase,
+	resumeRecurringSeriesUseCase *usecases.ResumeRecurringSeriesUseCase,
+	skipRecurringOccurrenceUseCase *usecases.SkipRecurringOccurrenceUseCase,
+	changeRecurringAmountUseCase *usecases.ChangeRecurringAmountUseCase,
+	endRecurringSeriesUseCase *usecases.EndRecurringSeriesUseCase,
+	updateRecurringScheduleUseCase *usecases.UpdateRecurringScheduleUseCase,
+) *RecurringSeriesHandler {
+	return &RecurringSeriesHandler{
+		listRecurringSeriesUseCase:     listRecurringSeriesUseCase,
+		previewRecurringSeriesUseCase:  previewRecurringSeriesUseCase,
+		pauseRecurringSeriesUseCase:    pauseRecurringSeriesUseCase,
+		resumeRecurringSeriesUseCase:   resumeRecurringSeriesUseCase,
+		skipRecurringOccurrenceUseCase: skipRecurringOccurrenceUseCase,
+		changeRecurringAmountUseCase:   changeRecurringAmountUseCase,
+		endRecurringSeriesUseCase:      endRecurringSeriesUseCase,
+		updateRecurringScheduleUseCase: updateRecurringScheduleUseCase,
+	}
+}
+
+// List handles listing the recurring series of the user.
+// @Summary List recurring series
+// @Description Lists the recurring series of the authenticated user with their status and next occurrence. A series is the recurring transaction that starts it; the occurrences generated from it are regular transactions.
+//
+// **Status**: `ACTIVE` (gerando ocorrências), `PAUSED` (pausada, nenhuma ocorrência é criada até ser retomada) ou `ENDED` (sem próximas ocorrências).
+//
+// @Tags transaction-recurring
+// @Produce json
+// @Security Bearer
+// @Param status query string false "Filter by status" Enums(ACTIVE, PAUSED, ENDED)
+// @Success 200 {object} dtos.ListRecurringSeriesOutput "Recurring series retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid status" example({"error":"Validation failed","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring [get]
+func (h *RecurringSeriesHandler) List(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	input := dtos.ListRecurringSeriesInput{
+		UserID: userID,
+		Status: c.Query("status", ""),
+	}
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.listRecurringSeriesUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring series retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Preview handles previewing the next occurrences of a recurring series.
+// @Summary Preview the next occurrences of a recurring series
+// @Description Returns the next occurrences of the series from today on, with the amount of each one. Skipped occurrences are left out and the list stops at the end date of the series.
+// @Tags transaction-recurring
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Series ID (transaction that starts the series, or any of its occurrences)" example("550e8400-e29b-41d4-a716-446655440002")
+// @Param count query int false "Number of occurrences (1-100, default 12)" example(12)
+// @Success 200 {object} dtos.PreviewRecurringSeriesOutput "Recurring series preview retrieved successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - transaction is not recurring" example({"error":"invalid recurring series: transaction 550e8400-e29b-41d4-a716-446655440002 is not recurring","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - series does not belong to user" example({"error":"recurring series does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - series does not exist" example({"error":"recurring series not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring/{id}/preview [get]
+func (h *RecurringSeriesHandler) Preview(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	seriesID := c.Params("id")
+	if seriesID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Series ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	input := dtos.PreviewRecurringSeriesInput{
+		UserID:   userID,
+		SeriesID: seriesID,
+		Count:    c.QueryInt("count", 0),
+	}
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.previewRecurringSeriesUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring series preview retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Pause handles pausing a recurring series.
+// @Summary Pause a recurring series
+// @Description Pauses the series: occurrences falling due while it is paused are not created, and are not created retroactively when it is resumed.
+// @Tags transaction-recurring
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Series ID (transaction that starts the series, or any of its occurrences)" example("550e8400-e29b-41d4-a716-446655440002")
+// @Success 200 {object} dtos.RecurringSeriesOutput "Recurring series paused successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - transaction is not recurring" example({"error":"invalid recurring series: transaction 550e8400-e29b-41d4-a716-446655440002 is not recurring","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - series does not belong to user" example({"error":"recurring series does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - series does not exist" example({"error":"recurring series not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - series is already paused" example({"error":"recurring series cannot be paused: it is already paused","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring/{id}/pause [post]
+func (h *RecurringSeriesHandler) Pause(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	seriesID := c.Params("id")
+	if seriesID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Series ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	input := dtos.PauseRecurringSeriesInput{
+		UserID:   userID,
+		SeriesID: seriesID,
+	}
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.pauseRecurringSeriesUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring series paused successfully",
+		"data":    output,
+	})
+}
+
+// Resume handles resuming a paused recurring series.
+// @Summary Resume a recurring series
+// @Description Resumes a paused series from the next occurrence due.
+// @Tags transaction-recurring
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Series ID (transaction that starts the series, or any of its occurrences)" example("550e8400-e29b-41d4-a716-446655440002")
+// @Success 200 {object} dtos.RecurringSeriesOutput "Recurring series resumed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - transaction is not recurring" example({"error":"invalid recurring series: transaction 550e8400-e29b-41d4-a716-446655440002 is not recurring","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - series does not belong to user" example({"error":"recurring series does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - series does not exist" example({"error":"recurring series not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - series is not paused" example({"error":"recurring series cannot be resumed: it is not paused","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring/{id}/resume [post]
+func (h *RecurringSeriesHandler) Resume(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	seriesID := c.Params("id")
+	if seriesID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Series ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	input := dtos.ResumeRecurringSeriesInput{
+		UserID:   userID,
+		SeriesID: seriesID,
+	}
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.resumeRecurringSeriesUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring series resumed successfully",
+		"data":    output,
+	})
+}
+
+// Skip handles skipping an occurrence of a recurring series.
+// @Summary Skip an occurrence of a recurring series
+// @Description Leaves a single occurrence out of the series. If the occurrence was already created, it is deleted and its effect on the account balance is reversed atomically using Unit of Work pattern. The first occurrence (the transaction that starts the series) cannot be skipped.
+// @Tags transaction-recurring
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Series ID (transaction that starts the series, or any of its occurrences)" example("550e8400-e29b-41d4-a716-446655440002")
+// @Param request body dtos.SkipRecurringOccurrenceInput true "Request data" example({"date":"2026-12-05"})
+// @Success 200 {object} dtos.SkipRecurringOccurrenceOutput "Recurring occurrence skipped successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - the series has no occurrence on the date" example({"error":"invalid occurrence date: the series has no occurrence on 2026-12-06","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - series does not belong to user" example({"error":"recurring series does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - series does not exist" example({"error":"recurring series not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - occurrence already skipped or reconciled" example({"error":"occurrence cannot be skipped: 2026-12-05 is already skipped","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring/{id}/skip [post]
+func (h *RecurringSeriesHandler) Skip(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	seriesID := c.Params("id")
+	if seriesID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Series ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	var input dtos.SkipRecurringOccurrenceInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.SeriesID = seriesID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.skipRecurringOccurrenceUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring occurrence skipped successfully",
+		"data":    output,
+	})
+}
+
+// ChangeAmount handles changing the amount of a recurring series.
+// @Summary Change the amount of a recurring series
+// @Description Changes the amount of the occurrence on `from_date` and all the following ones ("this and future occurrences"), replacing later changes. Occurrences already created on or after that date are updated along with the account balance atomically using Unit of Work pattern; earlier occurrences keep their amount.
+// @Tags transaction-recurring
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Series ID (transaction that starts the series, or any of its occurrences)" example("550e8400-e29b-41d4-a716-446655440002")
+// @Param request body dtos.ChangeRecurringAmountInput true "Request data" example({"from_date":"2027-01-05","amount":1850.00})
+// @Success 200 {object} dtos.ChangeRecurringAmountOutput "Recurring series amount changed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - the series has no occurrence on the date" example({"error":"invalid occurrence date: the series has no occurrence on 2027-01-06","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - series does not belong to user" example({"error":"recurring series does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - series does not exist" example({"error":"recurring series not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - an occurrence to update was reconciled" example({"error":"reconciled transaction cannot be changed: the occurrence on 2027-01-05 was reconciled","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring/{id}/amount [put]
+func (h *RecurringSeriesHandler) ChangeAmount(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	seriesID := c.Params("id")
+	if seriesID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Series ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	var input dtos.ChangeRecurringAmountInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.SeriesID = seriesID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.changeRecurringAmountUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring series amount changed successfully",
+		"data":    output,
+	})
+}
+
+// End handles ending a recurring series.
+// @Summary End a recurring series
+// @Description Sets the last day of the series: no occurrence after it is created. Occurrences already created after the end date are kept.
+// @Tags transaction-recurring
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Series ID (transaction that starts the series, or any of its occurrences)" example("550e8400-e29b-41d4-a716-446655440002")
+// @Param request body dtos.EndRecurringSeriesInput true "Request data" example({"end_date":"2027-06-30"})
+// @Success 200 {object} dtos.RecurringSeriesOutput "Recurring series ended successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - end date before the start of the series" example({"error":"invalid end date: must be on or after the first occurrence (2026-01-05)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - series does not belong to user" example({"error":"recurring series does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - series does not exist" example({"error":"recurring series not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring/{id}/end [post]
+func (h *RecurringSeriesHandler) End(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	seriesID := c.Params("id")
+	if seriesID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Series ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	var input dtos.EndRecurringSeriesInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.SeriesID = seriesID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.endRecurringSeriesUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring series ended successfully",
+		"data":    output,
+	})
+}
+
+// UpdateSchedule handles changing the schedule rules of a recurring series.
+// @Summary Change the schedule rules of a recurring series
+// @Description Sets how the day of the month of monthly and yearly occurrences is chosen and whether occurrences falling on weekends or Brazilian national and banking holidays move to the next business day. Occurrences already created keep their dates.
+//
+// **Âncora**: `DAY_OF_MONTH` (padrão: mesmo dia do mês, ou o último dia em meses mais curtos), `LAST_DAY_OF_MONTH` (último dia de cada mês), `NTH_WEEKDAY` (mesmo dia da semana e semana do mês da primeira ocorrência, ex.: segunda terça-feira) ou `LAST_WEEKDAY` (último dia da semana do mês, ex.: última sexta-feira).
+//
+// @Tags transaction-recurring
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Series ID (transaction that starts the series, or any of its occurrences)" example("550e8400-e29b-41d4-a716-446655440002")
+// @Param request body dtos.UpdateRecurringScheduleInput true "Schedule rules" example({"anchor":"LAST_DAY_OF_MONTH","shift_to_business_day":true})
+// @Success 200 {object} dtos.RecurringSeriesOutput "Recurring series schedule updated successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - anchor does not match the first occurrence or frequency" example({"error":"invalid recurrence anchor: the first occurrence (2026-01-30) is not the last day of its month","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - series does not belong to user" example({"error":"recurring series does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - series does not exist" example({"error":"recurring series not found: 550e8400-e29b-41d4-a716-446655440002","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/recurring/{id}/schedule [put]
+func (h *RecurringSeriesHandler) UpdateSchedule(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	seriesID := c.Params("id")
+	if seriesID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Series ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	var input dtos.UpdateRecurringScheduleInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.SeriesID = seriesID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.updateRecurringScheduleUseCase.Execute(input)
+	if err != nil {
+		return handleRecurringSeriesError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring series schedule updated successfully",
+		"data":    output,
+	})
+}
+
+// handleRecurringSeriesError maps a recurring series error to an application error and logs it.
+func handleRecurringSeriesError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Recurring series operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Recurring series operation failed")
+	}
+	return appErr
+}
@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// RuleHandler handles HTTP requests for auto-categorization rules.
+type RuleHandler struct {
+	createTransactionRuleUseCase *usecases.CreateTransactionRuleUseCase
+	listTransactionRulesUseCase  *usecases.ListTransactionRulesUseCase
+	updateTransactionRuleUseCase *usecases.UpdateTransactionRuleUseCase
+	deleteTransactionRuleUseCase *usecases.DeleteTransactionRuleUseCase
+	applyTransactionRulesUseCase *usecases.ApplyTransactionRulesUseCase
+}
+
+// NewRuleHandler creates a new RuleHandler instance.
+func NewRuleHandler(
+	createTransactionRuleUseCase *usecases.CreateTransactionRuleUseCase,
+	listTransactionRulesUseCase *usecases.ListTransactionRulesUseCase,
+	updateTransactionRuleUseCase *usecases.UpdateTransactionRuleUseCase,
+	deleteTransactionRuleUseCase *usecases.DeleteTransactionRuleUseCase,
+	applyTransactionRulesUseCase *usecases.ApplyTransactionRulesUseCase,
+) *RuleHandler {
+	return &RuleHandler{
+		createTransactionRuleUseCase: createTransactionRuleUseCase,
+		listTransactionRulesUseCase:  listTransactionRulesUseCase,
+		updateTransactionRuleUseCase: updateTransactionRuleUseCase,
+		deleteTransactionRuleUseCase: deleteTransactionRuleUseCase,
+		applyTransactionRulesUseCase: applyTransactionRulesUseCase,
+	}
+}
+
+// Create handles rule creation requests.
+// @Summary Create an auto-categorization rule
+// @Description Creates a rule that categorizes, tags or renames transactions of the authenticated user automatically. Enabled rules run when a transaction is created and when a bank statement is imported; use the apply endpoints to run them over existing transactions.
+//
+// **Condições** (todas as informadas devem ser atendidas; ao menos uma é obrigatória):
+// - `description_pattern` com `description_match`: `CONTAINS` (padrão) ou `STARTS_WITH`, que ignoram maiúsculas e acentos, ou `REGEX` (expressão regular, ignora maiúsculas)
+// - `min_amount` / `max_amount`: faixa de valor (inclusiva)
+// - `account_id` e `type` (`INCOME` ou `EXPENSE`). Transferências nunca são alteradas
+//
+// **Ações** (ao menos uma): `category_id`, `tag_ids` (adicionadas às existentes) e `description` (substitui a descrição do banco por uma mais legível).
+//
+// **Prioridade**: as regras rodam da menor para a maior prioridade. Todas as regras que casam adicionam suas tags; categoria e descrição vêm da primeira regra que as define. Uma categoria informada na criação da transação nunca é substituída.
+//
+// @Tags transaction-rules
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreateTransactionRuleInput true "Rule definition" example({"name":"Uber","priority":10,"conditions":{"description_match":"CONTAINS","description_pattern":"uber","type":"EXPENSE"},"actions":{"category_id":"550e8400-e29b-41d4-a716-446655440002","description":"Uber"}})
+// @Success 201 {object} dtos.TransactionRuleOutput "Rule created successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid conditions or actions" example({"error":"invalid rule conditions: invalid description pattern: error parsing regexp: missing closing ): `(?i)uber(`","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account, category or tag does not belong to user" example({"error":"category does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/rules [post]
+func (h *RuleHandler) Create(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.CreateTransactionRuleInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.createTransactionRuleUseCase.Execute(input)
+	if err != nil {
+		return handleRuleError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Rule created successfully",
+		"data":    output,
+	})
+}
+
+// List handles listing the rules of the user.
+// @Summary List auto-categorization rules
+// @Description Lists the rules of the authenticated user in the order they run (priority, then creation date).
+// @Tags transaction-rules
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dtos.ListTransactionRulesOutput "Rules retrieved successfully"
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/rules [get]
+func (h *RuleHandler) List(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	output, err := h.listTransactionRulesUseCase.Execute(dtos.ListTransactionRulesInput{UserID: userID})
+	if err != nil {
+		return handleRuleError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Rules retrieved successfully",
+		"data":    output,
+	})
+}
+
+// Update handles rule update requests.
+// @Summary Update an auto-categorization rule
+// @Description Replaces the name, priority, conditions and actions of a rule; `enabled` keeps its current value when omitted. Transactions already changed by the rule are not affected.
+// @Tags transaction-rules
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Rule ID" example("550e8400-e29b-41d4-a716-446655440020")
+// @Param request body dtos.UpdateTransactionRuleInput true "Rule definition" example({"name":"Uber","priority":10,"enabled":false,"conditions":{"description_pattern":"uber"},"actions":{"category_id":"550e8400-e29b-41d4-a716-446655440002"}})
+// @Success 200 {object} dtos.TransactionRuleOutput "Rule updated successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid rule ID, conditions or actions" example({"error":"invalid rule conditions: rule must have at least one condition","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - rule does not belong to user" example({"error":"rule does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - rule does not exist" example({"error":"rule not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/rules/{id} [put]
+func (h *RuleHandler) Update(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	ruleID := c.Params("id")
+	if ruleID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Rule ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	var input dtos.UpdateTransactionRuleInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	input.UserID = userID
+	input.RuleID = ruleID
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.updateTransactionRuleUseCase.Execute(input)
+	if err != nil {
+		return handleRuleError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Rule updated successfully",
+		"data":    output,
+	})
+}
+
+// Delete handles rule deletion requests.
+// @Summary Delete an auto-categorization rule
+// @Description Deletes a rule of the authenticated user. Transactions already changed by the rule are not affected.
+// @Tags transaction-rules
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Rule ID" example("550e8400-e29b-41d4-a716-446655440020")
+// @Success 200 {object} dtos.DeleteTransactionRuleOutput "Rule deleted successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid rule ID" example({"error":"invalid rule ID: invalid rule ID format (must be UUID)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - rule does not belong to user" example({"error":"rule does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - rule does not exist" example({"error":"rule not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/rules/{id} [delete]
+func (h *RuleHandler) Delete(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	ruleID := c.Params("id")
+	if ruleID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Rule ID is required",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	output, err := h.deleteTransactionRuleUseCase.Execute(dtos.DeleteTransactionRuleInput{
+		UserID: userID,
+		RuleID: ruleID,
+	})
+	if err != nil {
+		return handleRuleError(err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Rule deleted successfully",
+		"data":    output,
+	})
+}
+
+// PreviewApply handles dry runs of the rules over existing transactions.
+// @Summary Preview applying rules to existing transactions
+// @Description Shows what running the rules over the existing transactions of the authenticated user would change, without saving anything (dry run). Accepts the same body as the apply endpoint.
+// @Tags transaction-rules
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.ApplyTransactionRulesInput true "Rules and transactions to check" example({"start_date":"2026-01-01","end_date":"2026-06-30","overwrite_category":false})
+// @Success 200 {object} dtos.ApplyTransactionRulesOutput "Rule changes previewed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid period or IDs" example({"error":"invalid period: start date must be before end date","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - rule does not belong to user" example({"error":"rule does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - rule does not exist" example({"error":"rule not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/rules/apply/preview [post]
+func (h *RuleHandler) PreviewApply(c *fiber.Ctx) error {
+	return h.apply(c, true)
+}
+
+// Apply handles running the rules over existing transactions.
+// @Summary Apply rules to existing transactions
+// @Description Runs the rules over the existing transactions of the authenticated user and saves the changes atomically using Unit of Work pattern.
+//
+// **Regras**: por padrão todas as regras ativas; com `rule_ids`, apenas as informadas (mesmo inativas).
+//
+// **Transações**: todas do usuário, ou as da conta e do período informados. Categorias já definidas só são substituídas com `overwrite_category`; transações divididas em várias categorias e transferências nunca recebem categoria.
+//
+// @Tags transaction-rules
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.ApplyTransactionRulesInput true "Rules and transactions to change" example({"rule_ids":["550e8400-e29b-41d4-a716-446655440020"],"account_id":"550e8400-e29b-41d4-a716-446655440001","overwrite_category":true})
+// @Success 200 {object} dtos.ApplyTransactionRulesOutput "Rules applied successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid period or IDs" example({"error":"invalid start date format (expected YYYY-MM-DD): parsing time \"01/01/2026\" as \"2006-01-02\": cannot parse \"01/01/2026\" as \"2006\"","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - rule does not belong to user" example({"error":"rule does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - rule does not exist" example({"error":"rule not found","error_type":"NOT_FOUND","code":404})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/rules/apply [post]
+func (h *RuleHandler) Apply(c *fiber.Ctx) error {
+	return h.apply(c, false)
+}
+
+// apply runs the rules over existing transactions, for real or as a dry run.
+func (h *RuleHandler) apply(c *fiber.Ctx, dryRun bool) error {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	var input dtos.ApplyTransactionRulesInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+				"code":  fiber.StatusBadRequest,
+			})
+		}
+	}
+
+	input.UserID = userID
+	input.DryRun = dryRun
+
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	output, err := h.applyTransactionRulesUseCase.Execute(input)
+	if err != nil {
+		return handleRuleError(err)
+	}
+
+	message := "Rules applied successfully"
+	if dryRun {
+		message = "Rule changes previewed successfully"
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": message,
+		"data":    output,
+	})
+}
+
+// handleRuleError maps a rule operation error to an application error and logs it.
+func handleRuleError(err error) error {
+	appErr := apperrors.MapDomainError(err)
+	if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+		log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Transaction rule operation failed")
+	} else {
+		log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Transaction rule operation failed")
+	}
+	return appErr
+}
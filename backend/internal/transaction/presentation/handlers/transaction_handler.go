@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"net/url"
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog/log"
 
@@ -56,21 +59,37 @@ func NewTransactionHandler(
 //
 // **Validações**:
 // - Account ID deve existir e pertencer ao usuário autenticado
+// - Category ID (opcional) deve existir e pertencer ao usuário autenticado
 // - Amount deve ser maior que zero
 // - Currency deve ser válida (ex: BRL, USD, EUR)
 // - Date deve estar no formato YYYY-MM-DD
 //
+// **Divisão entre categorias** (`splits`, opcional):
+// - Pelo menos duas linhas, cada uma com `category_id` (do usuário), `amount` opcional e `memo` opcional
+// - Linhas sem `amount` dividem igualmente o valor restante; centavos que sobram vão para as primeiras linhas
+// - A soma das linhas deve ser igual ao `amount` da transação; `category_id` da transação deve ficar vazio
+// - Orçamentos e relatório por categoria consideram o valor de cada linha na sua categoria
+//
+// **Tags** (`tag_ids`, opcional): IDs de tags do usuário associadas à transação.
+//
+// **Beneficiário** (`payee_id`, opcional): Quando omitido, o beneficiário é identificado pela descrição normalizada (nome e apelidos dos beneficiários do usuário). A categoria padrão do beneficiário só é usada se a transação ficar sem categoria.
+//
+// **Moeda estrangeira** (`currency` diferente da moeda da conta):
+// - Informe `exchange_rate` (unidades da moeda da conta por unidade de `currency`) ou `converted_amount` (valor cobrado na moeda da conta), nunca os dois
+// - O saldo da conta sempre é movimentado pelo valor convertido; `amount`/`currency` da resposta são o valor convertido e `currency_conversion` guarda o valor original e a cotação
+// - `iof_rate` (opcional, despesas): percentual de IOF sobre o valor convertido (ex: 3.5), lançado como despesa separada vinculada à compra (`iof_transaction`)
+//
 // @Tags transactions
 // @Accept json
 // @Produce json
 // @Security Bearer
-// @Param request body dtos.CreateTransactionInput true "Transaction creation data" example({"account_id":"550e8400-e29b-41d4-a716-446655440000","type":"INCOME","amount":150.50,"currency":"BRL","description":"Salário","date":"2025-12-29"})
+// @Param request body dtos.CreateTransactionInput true "Transaction creation data" example({"account_id":"550e8400-e29b-41d4-a716-446655440000","type":"INCOME","amount":150.50,"currency":"BRL","description":"Salário","date":"2025-12-29","category_id":"550e8400-e29b-41d4-a716-446655440002"})
 // @Success 201 {object} map[string]interface{} "Transaction created successfully" example({"message":"Transaction created successfully","data":{"transaction_id":"550e8400-e29b-41d4-a716-446655440001","user_id":"550e8400-e29b-41d4-a716-446655440000","account_id":"550e8400-e29b-41d4-a716-446655440000","type":"INCOME","amount":150.50,"currency":"BRL","description":"Salário","date":"2025-12-29","created_at":"2025-12-29T10:00:00Z","updated_at":"2025-12-29T10:00:00Z"}})
 // @Success 201 {object} dtos.CreateTransactionOutput "Transaction data with all fields"
 // @Failure 400 {object} map[string]interface{} "Bad request - invalid input data, validation failed, or account not found" example({"error":"Invalid transaction data","error_type":"VALIDATION_ERROR","code":400,"details":{"field":"amount","message":"amount must be greater than 0"}})
 // @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
 // @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"Account not found","error_type":"NOT_FOUND","code":404,"details":{"resource":"Account","identifier":"550e8400-e29b-41d4-a716-446655440000"}})
-// @Failure 422 {object} map[string]interface{} "Unprocessable entity - domain validation failed" example({"error":"Account balance would become negative","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - debit beyond the balance floor of the account" example({"error":"failed to debit account: insufficient balance: account Conta Corrente would be left at -1050.00 BRL, beyond its overdraft limit of 1000.00 BRL","error_type":"DOMAIN_ERROR","code":422})
 // @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
 // @Router /transactions [post]
 func (h *TransactionHandler) Create(c *fiber.Ctx) error {
@@ -95,6 +114,7 @@ func (h *TransactionHandler) Create(c *fiber.Ctx) error {
 
 	// Set user ID from context (override any user_id in request body for security)
 	input.UserID = userID
+	input.RequestID = middleware.GetRequestID(c)
 
 	// Validate input
 	if err := validator.Validate(&input); err != nil {
@@ -120,32 +140,64 @@ func (h *TransactionHandler) Create(c *fiber.Ctx) error {
 
 // List handles transaction listing requests.
 // @Summary List transactions
-// @Description Lists all transactions for the authenticated user. Supports filtering by account_id and/or type, and pagination.
+// @Description Lists all transactions for the authenticated user. Supports filtering by account_id, type, tags, period, amount range, currency, description search and recurrence, sorting, and pagination.
 //
 // **Filtros Disponíveis**:
 // - `account_id`: Filtra transações por conta específica (UUID)
-// - `type`: Filtra por tipo de transação (`INCOME` ou `EXPENSE`)
+// - `type`: Filtra por tipo de transação (`INCOME`, `EXPENSE`, `TRANSFER_OUT`, `TRANSFER_IN`, `ADJUSTMENT_IN` ou `ADJUSTMENT_OUT`)
+// - `tag_ids`: Filtra por tags (UUIDs separados por vírgula)
+// - `tag_match`: `any` (padrão) retorna transações com ao menos uma das tags; `all` exige todas
+// - `payee_id`: Filtra por beneficiário/estabelecimento (UUID)
+// - `start_date` / `end_date`: Período (YYYY-MM-DD, inclusivo)
+// - `min_amount` / `max_amount`: Faixa de valor (inclusiva)
+// - `currency`: Moeda (`BRL`, `USD` ou `EUR`)
+// - `q`: Busca textual na descrição; ignora acentos e variações das palavras (ex.: `mercado` encontra "Mercados")
+// - `recurring`: `true` retorna apenas transações recorrentes e as ocorrências geradas por elas
 //
 // **Paginação**:
 // - `page`: Número da página (1-based, padrão: 1)
 // - `limit`: Itens por página (padrão: 10, máximo: 100)
 //
-// **Ordenação**: Transações são ordenadas por data (mais recentes primeiro) e depois por data de criação.
+// **Paginação por cursor** (recomendada para rolagem infinita):
+// - `pagination=cursor`: Retorna a primeira página em `cursor_pagination`, com `next_cursor`/`prev_cursor` e os links `next`/`prev`
+// - `cursor`: Continua a partir do cursor de uma página anterior (mantendo os mesmos filtros e ordenação)
+// - As páginas são posicionadas por (campo de ordenação, data, criação, ID): transações criadas ou excluídas durante a rolagem não causam duplicatas nem saltos. Os cursores são opacos e assinados; não há `total`.
+//
+// **Ordenação**:
+// - `sort_by`: `date` (padrão), `amount` ou `description`
+// - `sort_order`: `desc` (padrão) ou `asc`. Empates são desfeitos pela data e depois pela data de criação.
 //
 // **Exemplo sem paginação**: Retorna todas as transações (compatibilidade retroativa)
 // **Exemplo com paginação**: `GET /transactions?page=2&limit=20&type=INCOME`
+// **Exemplo com cursor**: `GET /transactions?pagination=cursor&limit=20` e depois `GET /transactions?cursor=<next_cursor>&limit=20`
+// **Exemplo de busca**: `GET /transactions?q=supermercado&start_date=2026-01-01&end_date=2026-03-31&sort_by=amount`
 //
 // @Tags transactions
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param account_id query string false "Filter by account ID (UUID)" example(550e8400-e29b-41d4-a716-446655440000)
-// @Param type query string false "Filter by transaction type (INCOME or EXPENSE)" Enums(INCOME, EXPENSE) example(INCOME)
+// @Param type query string false "Filter by transaction type" Enums(INCOME, EXPENSE, TRANSFER_OUT, TRANSFER_IN, ADJUSTMENT_IN, ADJUSTMENT_OUT) example(INCOME)
+// @Param status query string false "Filter by reconciliation status" Enums(PENDING, CLEARED, RECONCILED) example(CLEARED)
+// @Param tag_ids query string false "Filter by tag IDs (comma-separated UUIDs)" example(550e8400-e29b-41d4-a716-446655440010,550e8400-e29b-41d4-a716-446655440011)
+// @Param tag_match query string false "How tag_ids are matched (default: any)" Enums(any, all) example(all)
+// @Param payee_id query string false "Filter by payee ID (UUID)" example(550e8400-e29b-41d4-a716-446655440020)
+// @Param start_date query string false "Start date (YYYY-MM-DD, inclusive)" example(2026-01-01)
+// @Param end_date query string false "End date (YYYY-MM-DD, inclusive)" example(2026-03-31)
+// @Param min_amount query number false "Minimum amount (inclusive)" example(10.00)
+// @Param max_amount query number false "Maximum amount (inclusive)" example(500.00)
+// @Param currency query string false "Filter by currency" Enums(BRL, USD, EUR) example(BRL)
+// @Param q query string false "Full-text search on the description" example(supermercado)
+// @Param recurring query bool false "Only recurring transactions and their occurrences" example(true)
+// @Param sort_by query string false "Sort field (default: date)" Enums(date, amount, description) example(amount)
+// @Param sort_order query string false "Sort direction (default: desc)" Enums(asc, desc) example(asc)
 // @Param page query string false "Page number (1-based, default: 1)" example(1)
 // @Param limit query string false "Items per page (default: 10, max: 100)" example(20)
+// @Param pagination query string false "Pagination mode (default: offset)" Enums(offset, cursor) example(cursor)
+// @Param cursor query string false "Cursor of the next or previous page (from next_cursor or prev_cursor)"
 // @Success 200 {object} map[string]interface{} "Transactions retrieved successfully" example({"message":"Transactions retrieved successfully","data":{"transactions":[{"transaction_id":"550e8400-e29b-41d4-a716-446655440001","type":"INCOME","amount":150.50,"currency":"BRL","description":"Salário","date":"2025-12-29"}],"count":20,"pagination":{"page":1,"limit":20,"total":45,"total_pages":3,"has_next":true,"has_prev":false}}})
 // @Success 200 {object} dtos.ListTransactionsOutput "List of transactions with count and pagination metadata"
-// @Failure 400 {object} map[string]interface{} "Bad request - invalid user ID, account ID, type, or pagination parameters" example({"error":"Invalid account ID format","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid user ID, account ID, type, filters, sorting, pagination parameters or cursor" example({"error":"invalid sort field: must be date, amount or description, got category","error_type":"VALIDATION_ERROR","code":400})
 // @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
 // @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
 // @Router /transactions [get]
@@ -160,19 +212,7 @@ func (h *TransactionHandler) List(c *fiber.Ctx) error {
 	}
 
 	// Get optional filters from query parameters
-	accountID := c.Query("account_id", "")
-	transactionType := c.Query("type", "")
-	page := c.Query("page", "")
-	limit := c.Query("limit", "")
-
-	// Build input
-	input := dtos.ListTransactionsInput{
-		UserID:    userID,
-		AccountID: accountID,
-		Type:      transactionType,
-		Page:      page,
-		Limit:     limit,
-	}
+	input := listTransactionsInputFromQuery(c, userID)
 
 	// Execute use case
 	output, err := h.listTransactionsUseCase.Execute(input)
@@ -180,6 +220,12 @@ func (h *TransactionHandler) List(c *fiber.Ctx) error {
 		return h.handleUseCaseError(c, err)
 	}
 
+	// Cursor pagination links repeat the request with the cursor of the next and previous pages
+	if output.Cursor != nil {
+		query, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+		output.Cursor.SetLinks(c.Path(), query)
+	}
+
 	// Return success response
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Transactions retrieved successfully",
@@ -187,6 +233,39 @@ func (h *TransactionHandler) List(c *fiber.Ctx) error {
 	})
 }
 
+// listTransactionsInputFromQuery builds the list filters, sorting and pagination from the query parameters.
+func listTransactionsInputFromQuery(c *fiber.Ctx, userID string) dtos.ListTransactionsInput {
+	var tagIDs []string
+	for _, tagID := range strings.Split(c.Query("tag_ids", ""), ",") {
+		if tagID = strings.TrimSpace(tagID); tagID != "" {
+			tagIDs = append(tagIDs, tagID)
+		}
+	}
+
+	return dtos.ListTransactionsInput{
+		UserID:        userID,
+		AccountID:     c.Query("account_id", ""),
+		Type:          c.Query("type", ""),
+		Status:        c.Query("status", ""),
+		PayeeID:       c.Query("payee_id", ""),
+		TagIDs:        tagIDs,
+		TagMatch:      c.Query("tag_match", ""),
+		StartDate:     c.Query("start_date", ""),
+		EndDate:       c.Query("end_date", ""),
+		MinAmount:     c.Query("min_amount", ""),
+		MaxAmount:     c.Query("max_amount", ""),
+		Currency:      c.Query("currency", ""),
+		Search:        c.Query("q", ""),
+		RecurringOnly: c.QueryBool("recurring", false),
+		SortBy:        c.Query("sort_by", ""),
+		SortOrder:     c.Query("sort_order", ""),
+		Page:          c.Query("page", ""),
+		Limit:         c.Query("limit", ""),
+		Pagination:    c.Query("pagination", ""),
+		Cursor:        c.Query("cursor", ""),
+	}
+}
+
 // Get handles transaction retrieval requests.
 // @Summary Get transaction by ID
 // @Description Retrieves a specific transaction by its ID. Only returns transactions that belong to the authenticated user.
@@ -260,6 +339,9 @@ func (h *TransactionHandler) Get(c *fiber.Ctx) error {
 // - Campos não fornecidos permanecem inalterados
 // - Se `amount` ou `type` mudarem, o saldo da conta é ajustado automaticamente
 // - O efeito antigo é revertido e o novo efeito é aplicado
+// - Em transferências, `description` e `date` são replicados na outra perna; `amount` também, se as contas usarem a mesma moeda
+// - O tipo de uma transferência (TRANSFER_OUT/TRANSFER_IN) não pode ser alterado
+// - O tipo de um ajuste de saldo (ADJUSTMENT_IN/ADJUSTMENT_OUT) não pode ser alterado
 //
 // **Campos Atualizáveis**:
 // - `type`: INCOME ou EXPENSE (atualiza saldo da conta)
@@ -267,6 +349,15 @@ func (h *TransactionHandler) Get(c *fiber.Ctx) error {
 // - `currency`: Moeda (BRL, USD, EUR, etc.)
 // - `description`: Descrição da transação
 // - `date`: Data da transação (formato YYYY-MM-DD)
+// - `category_id`: Categoria da transação (string vazia remove a categoria; atribuir uma categoria remove a divisão entre categorias)
+// - `splits`: Linhas de divisão entre categorias (substituem as atuais; lista vazia remove a divisão)
+// - `tag_ids`: Tags da transação (substituem as atuais; lista vazia remove todas)
+// - `payee_id`: Beneficiário da transação (string vazia remove o beneficiário; a categoria não é alterada)
+// - `status`: PENDING ou CLEARED (compensada no extrato); RECONCILED só é atribuído ao concluir uma conciliação
+//
+// **Divisão entre categorias**: Se apenas `amount` mudar, as linhas existentes são reajustadas proporcionalmente ao novo valor.
+//
+// **Transações conciliadas**: `type`, `amount`, `currency` e `date` de uma transação RECONCILED (ou de uma transferência cuja outra perna esteja conciliada) não podem ser alterados. Para editá-los, desfaça a conciliação antes, em uma requisição separada que envie apenas `status` PENDING ou CLEARED.
 //
 // @Tags transactions
 // @Accept json
@@ -279,7 +370,7 @@ func (h *TransactionHandler) Get(c *fiber.Ctx) error {
 // @Failure 400 {object} map[string]interface{} "Bad request - invalid transaction ID, invalid data, or no fields provided" example({"error":"At least one field must be provided for update","error_type":"VALIDATION_ERROR","code":400})
 // @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
 // @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"Transaction not found","error_type":"NOT_FOUND","code":404})
-// @Failure 422 {object} map[string]interface{} "Unprocessable entity - domain validation failed" example({"error":"Account balance would become negative","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - debit beyond the balance floor of the account" example({"error":"failed to apply new expense transaction: insufficient balance: account Conta Corrente would be left at -1050.00 BRL, beyond its overdraft limit of 1000.00 BRL","error_type":"DOMAIN_ERROR","code":422})
 // @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
 // @Router /transactions/{id} [put]
 func (h *TransactionHandler) Update(c *fiber.Ctx) error {
@@ -313,6 +404,8 @@ func (h *TransactionHandler) Update(c *fiber.Ctx) error {
 
 	// Set transaction ID from path parameter (override any transaction_id in request body)
 	input.TransactionID = transactionID
+	input.ActorID = userID
+	input.RequestID = middleware.GetRequestID(c)
 
 	// Execute use case
 	output, err := h.updateTransactionUseCase.Execute(input)
@@ -354,6 +447,9 @@ func (h *TransactionHandler) Update(c *fiber.Ctx) error {
 // **Reversão de Saldo**:
 // - Se era INCOME: valor é subtraído do saldo
 // - Se era EXPENSE: valor é adicionado ao saldo
+// - Se era uma transferência: as duas pernas são deletadas e os saldos das duas contas são revertidos
+//
+// **Transações conciliadas**: Transações RECONCILED não podem ser deletadas; altere o status para CLEARED primeiro (`PUT /transactions/{id}`).
 //
 // @Tags transactions
 // @Accept json
@@ -365,6 +461,7 @@ func (h *TransactionHandler) Update(c *fiber.Ctx) error {
 // @Failure 400 {object} map[string]interface{} "Bad request - invalid transaction ID format" example({"error":"Invalid transaction ID format","error_type":"VALIDATION_ERROR","code":400})
 // @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
 // @Failure 404 {object} map[string]interface{} "Not found - transaction does not exist" example({"error":"Transaction not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - transaction is reconciled" example({"error":"reconciled transaction cannot be deleted: set its status back to CLEARED first","error_type":"DOMAIN_ERROR","code":422})
 // @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
 // @Router /transactions/{id} [delete]
 func (h *TransactionHandler) Delete(c *fiber.Ctx) error {
@@ -399,6 +496,8 @@ func (h *TransactionHandler) Delete(c *fiber.Ctx) error {
 	// Build input
 	input := dtos.DeleteTransactionInput{
 		TransactionID: transactionID,
+		ActorID:       userID,
+		RequestID:     middleware.GetRequestID(c),
 	}
 
 	// Execute use case
@@ -556,6 +655,8 @@ func (h *TransactionHandler) Restore(c *fiber.Ctx) error {
 	// Build input
 	input := dtos.RestoreTransactionInput{
 		TransactionID: transactionID,
+		ActorID:       userID,
+		RequestID:     middleware.GetRequestID(c),
 	}
 
 	// Execute use case
@@ -573,7 +674,7 @@ func (h *TransactionHandler) Restore(c *fiber.Ctx) error {
 
 // PermanentDelete handles permanent transaction deletion requests (admin only).
 // @Summary Permanently delete a transaction
-// @Description Permanently deletes a transaction from the database (hard delete). This action cannot be undone.
+// @Description Permanently deletes a transaction from the database (hard delete), together with its attachment files. This action cannot be undone.
 // @Tags transactions
 // @Accept json
 // @Produce json
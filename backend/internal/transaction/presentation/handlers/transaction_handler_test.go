@@ -22,6 +22,7 @@ import (
 	"gestao-financeira/backend/internal/transaction/domain/entities"
 	transactionrepositories "gestao-financeira/backend/internal/transaction/domain/repositories"
 	transactionvalueobjects "gestao-financeira/backend/internal/transaction/domain/valueobjects"
+	"gestao-financeira/backend/pkg/pagination"
 )
 
 // Helper function to create a test transaction (from usecase tests)
@@ -170,6 +171,56 @@ func (m *mockTransactionRepositoryForHandler) FindByUserIDAndDateRangeWithCurren
 	}
 	return result, nil
 }
+func (m *mockTransactionRepositoryForHandler) FindByImportBatchID(batchID transactionvalueobjects.ImportBatchID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ImportBatchID() != nil && tx.ImportBatchID().Equals(batchID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepositoryForHandler) FindExistingExternalIDs(accountID accountvalueobjects.AccountID, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for _, tx := range m.transactions {
+		if !tx.AccountID().Equals(accountID) || tx.ExternalID() == "" {
+			continue
+		}
+		for _, externalID := range externalIDs {
+			if tx.ExternalID() == externalID {
+				existing[externalID] = true
+			}
+		}
+	}
+	return existing, nil
+}
+func (m *mockTransactionRepositoryForHandler) FindUnreconciledByAccountID(accountID accountvalueobjects.AccountID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.AccountID().Equals(accountID) && !tx.IsReconciled() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepositoryForHandler) FindByParentID(parentID transactionvalueobjects.TransactionID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.ParentTransactionID() != nil && tx.ParentTransactionID().Equals(parentID) {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+func (m *mockTransactionRepositoryForHandler) FindRecurringSeriesByUserID(userID identityvalueobjects.UserID) ([]*entities.Transaction, error) {
+	var result []*entities.Transaction
+	for _, tx := range m.transactions {
+		if tx.UserID().Equals(userID) && tx.IsRecurringSeries() {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
 func (m *mockTransactionRepositoryForHandler) FindByUserIDWithPagination(userID identityvalueobjects.UserID, offset, limit int) ([]*entities.Transaction, int64, error) {
 	all, _ := m.FindByUserID(userID)
 	total := int64(len(all))
@@ -183,14 +234,11 @@ func (m *mockTransactionRepositoryForHandler) FindByUserIDWithPagination(userID
 	}
 	return all[start:end], total, nil
 }
-func (m *mockTransactionRepositoryForHandler) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, accountID string, transactionType string, offset, limit int) ([]*entities.Transaction, int64, error) {
+func (m *mockTransactionRepositoryForHandler) FindByUserIDAndFiltersWithPagination(userID identityvalueobjects.UserID, filter transactionrepositories.TransactionFilter, offset, limit int) ([]*entities.Transaction, int64, error) {
 	all, _ := m.FindByUserID(userID)
 	var filtered []*entities.Transaction
 	for _, tx := range all {
-		if accountID != "" && tx.AccountID().Value() != accountID {
-			continue
-		}
-		if transactionType != "" && tx.TransactionType().Value() != transactionType {
+		if !filter.Matches(tx) {
 			continue
 		}
 		filtered = append(filtered, tx)
@@ -207,16 +255,51 @@ func (m *mockTransactionRepositoryForHandler) FindByUserIDAndFiltersWithPaginati
 	return filtered[start:end], total, nil
 }
 
+func (m *mockTransactionRepositoryForHandler) FindByUserIDAndFiltersWithKeyset(userID identityvalueobjects.UserID, filter transactionrepositories.TransactionFilter, keyset *transactionrepositories.TransactionKeyset, backward bool, limit int) ([]*entities.Transaction, error) {
+	return nil, nil
+}
+
+// mockTransactionRevisionRepositoryForHandler is a mock implementation of TransactionRevisionRepository for handler testing.
+type mockTransactionRevisionRepositoryForHandler struct {
+	revisions []*entities.TransactionRevision
+}
+
+func (m *mockTransactionRevisionRepositoryForHandler) FindByID(id transactionvalueobjects.TransactionRevisionID) (*entities.TransactionRevision, error) {
+	for _, revision := range m.revisions {
+		if revision.ID().Equals(id) {
+			return revision, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockTransactionRevisionRepositoryForHandler) FindByTransactionID(transactionID transactionvalueobjects.TransactionID) ([]*entities.TransactionRevision, error) {
+	var result []*entities.TransactionRevision
+	for _, revision := range m.revisions {
+		if revision.TransactionID().Equals(transactionID) {
+			result = append(result, revision)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTransactionRevisionRepositoryForHandler) Save(revision *entities.TransactionRevision) error {
+	m.revisions = append(m.revisions, revision)
+	return nil
+}
+
 // mockUnitOfWorkForHandler is a mock implementation of UnitOfWork for handler testing.
 type mockUnitOfWorkForHandler struct {
 	transactionRepository *mockTransactionRepositoryForHandler
 	accountRepository     *mockAccountRepositoryForHandler
+	revisionRepository    *mockTransactionRevisionRepositoryForHandler
 }
 
 func newMockUnitOfWorkForHandler() *mockUnitOfWorkForHandler {
 	return &mockUnitOfWorkForHandler{
 		transactionRepository: newMockTransactionRepositoryForHandler(),
 		accountRepository:     newMockAccountRepositoryForHandler(),
+		revisionRepository:    &mockTransactionRevisionRepositoryForHandler{},
 	}
 }
 
@@ -232,6 +315,14 @@ func (m *mockUnitOfWorkForHandler) Rollback() error {
 	return nil
 }
 
+func (m *mockUnitOfWorkForHandler) SavePoint(name string) error {
+	return nil
+}
+
+func (m *mockUnitOfWorkForHandler) RollbackTo(name string) error {
+	return nil
+}
+
 func (m *mockUnitOfWorkForHandler) TransactionRepository() transactionrepositories.TransactionRepository {
 	return m.transactionRepository
 }
@@ -240,6 +331,22 @@ func (m *mockUnitOfWorkForHandler) AccountRepository() accountrepositories.Accou
 	return m.accountRepository
 }
 
+func (m *mockUnitOfWorkForHandler) CreditCardStatementRepository() accountrepositories.CreditCardStatementRepository {
+	return nil
+}
+
+func (m *mockUnitOfWorkForHandler) ImportBatchRepository() transactionrepositories.ImportBatchRepository {
+	return nil
+}
+
+func (m *mockUnitOfWorkForHandler) ReconciliationRepository() transactionrepositories.ReconciliationRepository {
+	return nil
+}
+
+func (m *mockUnitOfWorkForHandler) TransactionRevisionRepository() transactionrepositories.TransactionRevisionRepository {
+	return m.revisionRepository
+}
+
 func (m *mockUnitOfWorkForHandler) IsInTransaction() bool {
 	return false
 }
@@ -273,6 +380,10 @@ func (m *mockAccountRepositoryForHandler) FindByUserIDAndContext(userID identity
 	return nil, nil
 }
 
+func (m *mockAccountRepositoryForHandler) FindByType(accountType accountvalueobjects.AccountType) ([]*accountentities.Account, error) {
+	return []*accountentities.Account{}, nil
+}
+
 func (m *mockAccountRepositoryForHandler) Save(account *accountentities.Account) error {
 	m.accounts[account.ID().Value()] = account
 	return nil
@@ -304,13 +415,13 @@ func TestTransactionHandler_Create(t *testing.T) {
 	app := fiber.New()
 	mockUOW := newMockUnitOfWorkForHandler()
 	eventBus := eventbus.NewEventBus()
-	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, eventBus)
-	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository())
+	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, nil, nil, nil, nil, eventBus)
+	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository(), pagination.NewCursorSigner("test-secret"))
 	getUseCase := usecases.NewGetTransactionUseCase(mockUOW.TransactionRepository())
-	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, eventBus)
+	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, nil, nil, nil, eventBus)
 	deleteUseCase := usecases.NewDeleteTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW.TransactionRepository())
-	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository())
+	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository(), nil, nil)
 	handler := NewTransactionHandler(createUseCase, listUseCase, getUseCase, updateUseCase, deleteUseCase, restoreUseCase, permanentDeleteUseCase)
 
 	app.Post("/transactions", func(c *fiber.Ctx) error {
@@ -388,13 +499,13 @@ func TestTransactionHandler_List(t *testing.T) {
 
 	app := fiber.New()
 	mockUOW := newMockUnitOfWorkForHandler()
-	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository())
-	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository(), pagination.NewCursorSigner("test-secret"))
+	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, nil, nil, nil, nil, eventbus.NewEventBus())
 	getUseCase := usecases.NewGetTransactionUseCase(mockUOW.TransactionRepository())
-	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, nil, nil, nil, eventbus.NewEventBus())
 	deleteUseCase := usecases.NewDeleteTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW.TransactionRepository())
-	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository())
+	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository(), nil, nil)
 	handler := NewTransactionHandler(createUseCase, listUseCase, getUseCase, updateUseCase, deleteUseCase, restoreUseCase, permanentDeleteUseCase)
 
 	app.Get("/transactions", func(c *fiber.Ctx) error {
@@ -459,12 +570,12 @@ func TestTransactionHandler_Get(t *testing.T) {
 	app := fiber.New()
 	mockUOW := newMockUnitOfWorkForHandler()
 	getUseCase := usecases.NewGetTransactionUseCase(mockUOW.TransactionRepository())
-	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository())
-	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, nil, nil, nil, nil, eventbus.NewEventBus())
+	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository(), pagination.NewCursorSigner("test-secret"))
+	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, nil, nil, nil, eventbus.NewEventBus())
 	deleteUseCase := usecases.NewDeleteTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW.TransactionRepository())
-	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository())
+	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository(), nil, nil)
 	handler := NewTransactionHandler(createUseCase, listUseCase, getUseCase, updateUseCase, deleteUseCase, restoreUseCase, permanentDeleteUseCase)
 
 	app.Get("/transactions/:id", func(c *fiber.Ctx) error {
@@ -531,13 +642,13 @@ func TestTransactionHandler_Update(t *testing.T) {
 
 	app := fiber.New()
 	mockUOW := newMockUnitOfWorkForHandler()
-	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository())
+	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, nil, nil, nil, eventbus.NewEventBus())
+	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, nil, nil, nil, nil, eventbus.NewEventBus())
+	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository(), pagination.NewCursorSigner("test-secret"))
 	getUseCase := usecases.NewGetTransactionUseCase(mockUOW.TransactionRepository())
 	deleteUseCase := usecases.NewDeleteTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW.TransactionRepository())
-	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository())
+	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository(), nil, nil)
 	handler := NewTransactionHandler(createUseCase, listUseCase, getUseCase, updateUseCase, deleteUseCase, restoreUseCase, permanentDeleteUseCase)
 
 	app.Put("/transactions/:id", func(c *fiber.Ctx) error {
@@ -614,12 +725,12 @@ func TestTransactionHandler_Delete(t *testing.T) {
 	app := fiber.New()
 	mockUOW := newMockUnitOfWorkForHandler()
 	deleteUseCase := usecases.NewDeleteTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository())
+	createUseCase := usecases.NewCreateTransactionUseCase(mockUOW, nil, nil, nil, nil, eventbus.NewEventBus())
+	listUseCase := usecases.NewListTransactionsUseCase(mockUOW.TransactionRepository(), pagination.NewCursorSigner("test-secret"))
 	getUseCase := usecases.NewGetTransactionUseCase(mockUOW.TransactionRepository())
-	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, eventbus.NewEventBus())
-	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW.TransactionRepository())
-	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository())
+	updateUseCase := usecases.NewUpdateTransactionUseCase(mockUOW, nil, nil, nil, eventbus.NewEventBus())
+	restoreUseCase := usecases.NewRestoreTransactionUseCase(mockUOW, eventbus.NewEventBus())
+	permanentDeleteUseCase := usecases.NewPermanentDeleteTransactionUseCase(mockUOW.TransactionRepository(), nil, nil)
 	handler := NewTransactionHandler(createUseCase, listUseCase, getUseCase, updateUseCase, deleteUseCase, restoreUseCase, permanentDeleteUseCase)
 
 	app.Delete("/transactions/:id", func(c *fiber.Ctx) error {
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"gestao-financeira/backend/internal/transaction/application/dtos"
+	"gestao-financeira/backend/internal/transaction/application/usecases"
+	apperrors "gestao-financeira/backend/pkg/errors"
+	"gestao-financeira/backend/pkg/middleware"
+	"gestao-financeira/backend/pkg/validator"
+)
+
+// TransferHandler handles HTTP requests for transfers between accounts.
+type TransferHandler struct {
+	createTransferUseCase *usecases.CreateTransferUseCase
+}
+
+// NewTransferHandler creates a new TransferHandler instance.
+func NewTransferHandler(createTransferUseCase *usecases.CreateTransferUseCase) *TransferHandler {
+	return &TransferHandler{
+		createTransferUseCase: createTransferUseCase,
+	}
+}
+
+// Create handles transfer creation requests.
+// @Summary Transfer money between accounts
+// @Description Moves money from one account to another account of the authenticated user. Creates two linked transactions (TRANSFER_OUT on the source account and TRANSFER_IN on the destination account) and updates both balances atomically using Unit of Work pattern.
+//
+// **Relatórios**: Transferências não são contabilizadas como receita ou despesa nos relatórios.
+//
+// **Moedas diferentes**: Se as contas usam moedas diferentes, `destination_amount` (valor creditado na moeda da conta de destino) é obrigatório. Para contas na mesma moeda, pode ser omitido.
+//
+// **Edição e exclusão**: Editar descrição/data de uma das pernas atualiza a outra; excluir uma perna exclui a transferência inteira e reverte os dois saldos.
+//
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreateTransferInput true "Transfer data" example({"from_account_id":"550e8400-e29b-41d4-a716-446655440000","to_account_id":"550e8400-e29b-41d4-a716-446655440003","amount":500.00,"description":"Pagamento da fatura","date":"2025-12-29"})
+// @Success 201 {object} dtos.CreateTransferOutput "Transfer created successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request - invalid input data or missing destination amount" example({"error":"destination amount must be provided when transferring between different currencies (BRL to USD)","error_type":"VALIDATION_ERROR","code":400})
+// @Failure 401 {object} map[string]interface{} "Unauthorized - missing or invalid JWT token" example({"error":"Unauthorized","code":401})
+// @Failure 403 {object} map[string]interface{} "Forbidden - account does not belong to user" example({"error":"source account does not belong to user","error_type":"FORBIDDEN","code":403})
+// @Failure 404 {object} map[string]interface{} "Not found - account does not exist" example({"error":"destination account not found","error_type":"NOT_FOUND","code":404})
+// @Failure 422 {object} map[string]interface{} "Unprocessable entity - insufficient balance" example({"error":"failed to debit source account: insufficient balance","error_type":"DOMAIN_ERROR","code":422})
+// @Failure 500 {object} map[string]interface{} "Internal server error" example({"error":"An unexpected error occurred","error_type":"INTERNAL_ERROR","code":500,"request_id":"req-123"})
+// @Router /transactions/transfers [post]
+func (h *TransferHandler) Create(c *fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+			"code":  fiber.StatusUnauthorized,
+		})
+	}
+
+	// Parse request body
+	var input dtos.CreateTransferInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Warn().Err(err).Str("request_id", middleware.GetRequestID(c)).Msg("Failed to parse request body")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+			"code":  fiber.StatusBadRequest,
+		})
+	}
+
+	// Set user ID from context (override any user_id in request body for security)
+	input.UserID = userID
+	input.RequestID = middleware.GetRequestID(c)
+
+	// Validate input
+	if err := validator.Validate(&input); err != nil {
+		return err
+	}
+
+	// Execute use case
+	output, err := h.createTransferUseCase.Execute(input)
+	if err != nil {
+		appErr := apperrors.MapDomainError(err)
+		if appErr.Type == apperrors.ErrorTypeValidation || appErr.Type == apperrors.ErrorTypeNotFound {
+			log.Warn().Err(err).Str("error_type", string(appErr.Type)).Msg("Transfer operation failed")
+		} else {
+			log.Error().Err(err).Str("error_type", string(appErr.Type)).Msg("Transfer operation failed")
+		}
+		return appErr
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Transfer created successfully",
+		"data":    output,
+	})
+}
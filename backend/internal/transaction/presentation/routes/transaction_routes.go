@@ -11,7 +11,7 @@ import (
 )
 
 // SetupTransactionRoutes configures transaction routes.
-func SetupTransactionRoutes(router fiber.Router, transactionHandler *handlers.TransactionHandler, jwtService *services.JWTService, userRepository repositories.UserRepository, cacheService *cache.CacheService) {
+func SetupTransactionRoutes(router fiber.Router, transactionHandler *handlers.TransactionHandler, transferHandler *handlers.TransferHandler, importHandler *handlers.ImportHandler, duplicateHandler *handlers.DuplicateHandler, ruleHandler *handlers.RuleHandler, attachmentHandler *handlers.AttachmentHandler, reconciliationHandler *handlers.ReconciliationHandler, installmentHandler *handlers.InstallmentHandler, recurringSeriesHandler *handlers.RecurringSeriesHandler, bulkHandler *handlers.BulkHandler, historyHandler *handlers.HistoryHandler, exportHandler *handlers.ExportHandler, jwtService *services.JWTService, userRepository repositories.UserRepository, cacheService *cache.CacheService) {
 	transactions := router.Group("/transactions")
 
 	// Apply authentication middleware to all transaction routes
@@ -23,11 +23,51 @@ func SetupTransactionRoutes(router fiber.Router, transactionHandler *handlers.Tr
 
 	{
 		transactions.Post("/", transactionHandler.Create)
+		transactions.Post("/transfers", transferHandler.Create)
+		transactions.Post("/imports/preview", importHandler.PreviewCSV)
+		transactions.Post("/imports", importHandler.ImportCSV)
+		transactions.Post("/imports/statement/preview", importHandler.PreviewStatement)
+		transactions.Post("/imports/statement", importHandler.ImportStatement)
+		transactions.Get("/imports", importHandler.List)
+		transactions.Post("/imports/:id/rollback", importHandler.Rollback)
+		transactions.Post("/bulk", bulkHandler.Apply)
+		transactions.Get("/export", exportHandler.Export)
+		transactions.Get("/duplicates", duplicateHandler.List)
+		transactions.Post("/duplicates/merge", duplicateHandler.Merge)
+		transactions.Post("/rules", ruleHandler.Create)
+		transactions.Get("/rules", ruleHandler.List)
+		transactions.Post("/rules/apply/preview", ruleHandler.PreviewApply)
+		transactions.Post("/rules/apply", ruleHandler.Apply)
+		transactions.Put("/rules/:id", ruleHandler.Update)
+		transactions.Delete("/rules/:id", ruleHandler.Delete)
+		transactions.Post("/reconciliations", reconciliationHandler.Start)
+		transactions.Get("/reconciliations", reconciliationHandler.List)
+		transactions.Get("/reconciliations/:id", reconciliationHandler.Get)
+		transactions.Post("/reconciliations/:id/complete", reconciliationHandler.Complete)
+		transactions.Post("/reconciliations/:id/cancel", reconciliationHandler.Cancel)
+		transactions.Post("/installments", installmentHandler.Create)
+		transactions.Get("/installments/:id", installmentHandler.Get)
+		transactions.Post("/installments/:id/cancel", installmentHandler.Cancel)
+		transactions.Post("/installments/:id/prepay", installmentHandler.Prepay)
+		transactions.Get("/recurring", recurringSeriesHandler.List)
+		transactions.Get("/recurring/:id/preview", recurringSeriesHandler.Preview)
+		transactions.Post("/recurring/:id/pause", recurringSeriesHandler.Pause)
+		transactions.Post("/recurring/:id/resume", recurringSeriesHandler.Resume)
+		transactions.Post("/recurring/:id/skip", recurringSeriesHandler.Skip)
+		transactions.Put("/recurring/:id/amount", recurringSeriesHandler.ChangeAmount)
+		transactions.Post("/recurring/:id/end", recurringSeriesHandler.End)
+		transactions.Put("/recurring/:id/schedule", recurringSeriesHandler.UpdateSchedule)
 		transactions.Get("/", transactionHandler.List)
 		transactions.Get("/:id", transactionHandler.Get)
 		transactions.Put("/:id", transactionHandler.Update)
 		transactions.Delete("/:id", transactionHandler.Delete)
 		transactions.Post("/:id/restore", transactionHandler.Restore)
 		transactions.Delete("/:id/permanent", transactionHandler.PermanentDelete)
+		transactions.Get("/:id/history", historyHandler.Get)
+		transactions.Post("/:id/history/:revisionId/revert", historyHandler.Revert)
+		transactions.Post("/:id/attachments", attachmentHandler.Upload)
+		transactions.Get("/:id/attachments", attachmentHandler.List)
+		transactions.Get("/:id/attachments/:attachmentId", attachmentHandler.Download)
+		transactions.Delete("/:id/attachments/:attachmentId", attachmentHandler.Delete)
 	}
 }
@@ -0,0 +1,5 @@
+-- Rollback: Remove category from transactions table
+DROP INDEX IF EXISTS idx_transactions_user_category_date;
+DROP INDEX IF EXISTS idx_transactions_category_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS fk_transactions_category_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS category_id;
@@ -0,0 +1,20 @@
+-- Migration: Add category to transactions table
+-- Created: 2026-10-16
+-- Description: Allows transactions to be assigned to a user category (used by budgets and category reports)
+
+-- Add category_id column (nullable: transactions may be uncategorized)
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS category_id UUID NULL;
+
+-- Add foreign key constraint for category_id
+ALTER TABLE transactions
+ADD CONSTRAINT fk_transactions_category_id
+FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE SET NULL;
+
+-- Add index for category_id
+CREATE INDEX IF NOT EXISTS idx_transactions_category_id ON transactions(category_id) WHERE category_id IS NOT NULL;
+
+-- Add composite index for budget progress and category reports
+CREATE INDEX IF NOT EXISTS idx_transactions_user_category_date ON transactions(user_id, category_id, date DESC) WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN transactions.category_id IS 'Optional category the transaction belongs to';
@@ -0,0 +1,8 @@
+-- Rollback: Remove transfers from transactions table
+DELETE FROM transactions WHERE type IN ('TRANSFER_OUT', 'TRANSFER_IN');
+DROP INDEX IF EXISTS idx_transactions_linked_transaction_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS fk_transactions_linked_transaction_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS linked_transaction_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_type;
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_type CHECK (type IN ('INCOME', 'EXPENSE'));
@@ -0,0 +1,24 @@
+-- Migration: Add transfers between accounts to transactions table
+-- Created: 2026-10-16
+-- Description: Adds TRANSFER_OUT/TRANSFER_IN transaction types and links the two legs of a transfer
+
+-- Allow transfer legs as transaction types
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_type;
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_type CHECK (type IN ('INCOME', 'EXPENSE', 'TRANSFER_OUT', 'TRANSFER_IN'));
+
+-- Add linked_transaction_id column (counterpart leg of a transfer)
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS linked_transaction_id UUID NULL;
+
+-- Add foreign key constraint for linked_transaction_id
+-- Deferred so both legs can reference each other when inserted in the same transaction
+ALTER TABLE transactions
+ADD CONSTRAINT fk_transactions_linked_transaction_id
+FOREIGN KEY (linked_transaction_id) REFERENCES transactions(id) ON DELETE SET NULL
+DEFERRABLE INITIALLY DEFERRED;
+
+-- Add index for linked_transaction_id
+CREATE INDEX IF NOT EXISTS idx_transactions_linked_transaction_id ON transactions(linked_transaction_id) WHERE linked_transaction_id IS NOT NULL;
+
+COMMENT ON COLUMN transactions.linked_transaction_id IS 'Counterpart leg of a transfer between accounts (NULL for income and expense transactions)';
@@ -0,0 +1,4 @@
+-- Rollback: Drop transaction_splits table
+DROP INDEX IF EXISTS idx_transaction_splits_category_id;
+DROP INDEX IF EXISTS idx_transaction_splits_transaction_id;
+DROP TABLE IF EXISTS transaction_splits;
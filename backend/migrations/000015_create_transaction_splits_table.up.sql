@@ -0,0 +1,35 @@
+-- Migration: Create transaction_splits table
+-- Created: 2026-10-16
+-- Description: Stores the split lines of transactions whose amount is spread across several categories
+
+-- Create transaction_splits table
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id UUID PRIMARY KEY,
+    transaction_id UUID NOT NULL,
+    category_id UUID NOT NULL,
+    amount BIGINT NOT NULL, -- Amount in cents
+    currency VARCHAR(3) NOT NULL DEFAULT 'BRL', -- Currency code (ISO 4217), same as the transaction
+    memo VARCHAR(255) NOT NULL DEFAULT '',
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_transaction_splits_transaction_id FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_splits_category_id FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE RESTRICT,
+
+    -- Check constraints
+    CONSTRAINT chk_transaction_splits_amount CHECK (amount > 0),
+    CONSTRAINT chk_transaction_splits_currency CHECK (currency IN ('BRL', 'USD', 'EUR'))
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction_id ON transaction_splits(transaction_id, position);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category_id ON transaction_splits(category_id);
+
+-- Add comments to table
+COMMENT ON TABLE transaction_splits IS 'Split lines of transactions spread across several categories (amounts add up to the transaction amount)';
+COMMENT ON COLUMN transaction_splits.transaction_id IS 'Foreign key to transactions table';
+COMMENT ON COLUMN transaction_splits.category_id IS 'Category this part of the transaction belongs to';
+COMMENT ON COLUMN transaction_splits.amount IS 'Split line amount in cents';
+COMMENT ON COLUMN transaction_splits.memo IS 'Optional memo for the split line';
+COMMENT ON COLUMN transaction_splits.position IS 'Order of the split line within the transaction';
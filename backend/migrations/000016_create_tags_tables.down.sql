@@ -0,0 +1,6 @@
+-- Rollback: Drop tags and transaction_tags tables
+DROP INDEX IF EXISTS idx_transaction_tags_tag_id;
+DROP TABLE IF EXISTS transaction_tags;
+DROP TRIGGER IF EXISTS update_tags_updated_at ON tags;
+DROP INDEX IF EXISTS idx_tags_user_name;
+DROP TABLE IF EXISTS tags;
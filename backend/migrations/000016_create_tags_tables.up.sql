@@ -0,0 +1,46 @@
+-- Migration: Create tags and transaction_tags tables
+-- Created: 2026-10-16
+-- Description: Creates user-defined tags for the Tag context and the link table between transactions and tags
+
+-- Create tags table
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_tags_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create indexes (tag names are unique per user, case-insensitive)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_user_name ON tags(user_id, LOWER(name));
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_tags_updated_at
+    BEFORE UPDATE ON tags
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create transaction_tags table
+CREATE TABLE IF NOT EXISTS transaction_tags (
+    transaction_id UUID NOT NULL,
+    tag_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT pk_transaction_tags PRIMARY KEY (transaction_id, tag_id),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_transaction_tags_transaction_id FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_tags_tag_id FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- Create indexes (the primary key already covers lookups by transaction)
+CREATE INDEX IF NOT EXISTS idx_transaction_tags_tag_id ON transaction_tags(tag_id, transaction_id);
+
+-- Add comments to tables
+COMMENT ON TABLE tags IS 'User-defined labels that can be attached to any number of transactions';
+COMMENT ON COLUMN tags.user_id IS 'User who owns this tag (foreign key to users.id)';
+COMMENT ON COLUMN tags.name IS 'Tag name (e.g., viagem-2026, reembolsavel), unique per user ignoring case';
+COMMENT ON TABLE transaction_tags IS 'Tags attached to transactions';
+COMMENT ON COLUMN transaction_tags.transaction_id IS 'Foreign key to transactions table';
+COMMENT ON COLUMN transaction_tags.tag_id IS 'Foreign key to tags table';
@@ -0,0 +1,8 @@
+-- Rollback: Drop import_batches table and transactions.import_batch_id
+DROP INDEX IF EXISTS idx_transactions_import_batch_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS fk_transactions_import_batch_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS import_batch_id;
+DROP TRIGGER IF EXISTS update_import_batches_updated_at ON import_batches;
+DROP INDEX IF EXISTS idx_import_batches_account_id;
+DROP INDEX IF EXISTS idx_import_batches_user_created;
+DROP TABLE IF EXISTS import_batches;
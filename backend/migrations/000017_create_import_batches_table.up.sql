@@ -0,0 +1,46 @@
+-- Migration: Create import_batches table and link imported transactions
+-- Created: 2026-10-16
+-- Description: Records bank statement imports so that all transactions of an import can be rolled back together
+
+-- Create import_batches table
+CREATE TABLE IF NOT EXISTS import_batches (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    account_id UUID NOT NULL,
+    source VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    transaction_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rolled_back_at TIMESTAMP NULL,
+    CONSTRAINT fk_import_batches_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_import_batches_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT chk_import_batches_status CHECK (status IN ('COMMITTED', 'ROLLED_BACK')),
+    CONSTRAINT chk_import_batches_transaction_count CHECK (transaction_count >= 0)
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_import_batches_user_created ON import_batches(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_import_batches_account_id ON import_batches(account_id);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_import_batches_updated_at
+    BEFORE UPDATE ON import_batches
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Add import_batch_id column to transactions (nullable: only imported transactions have it)
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS import_batch_id UUID NULL;
+
+-- Add foreign key constraint for import_batch_id
+ALTER TABLE transactions
+ADD CONSTRAINT fk_transactions_import_batch_id
+FOREIGN KEY (import_batch_id) REFERENCES import_batches(id) ON DELETE SET NULL;
+
+-- Add index for import_batch_id
+CREATE INDEX IF NOT EXISTS idx_transactions_import_batch_id ON transactions(import_batch_id) WHERE import_batch_id IS NOT NULL;
+
+COMMENT ON TABLE import_batches IS 'Bank statement imports; rolling back a batch soft deletes its transactions';
+COMMENT ON COLUMN transactions.import_batch_id IS 'Statement import batch the transaction was created by';
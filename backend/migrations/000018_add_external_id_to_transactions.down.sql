@@ -0,0 +1,4 @@
+-- Rollback: Remove external_id from transactions
+ALTER TABLE import_batches DROP CONSTRAINT IF EXISTS chk_import_batches_source;
+DROP INDEX IF EXISTS idx_transactions_account_external_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS external_id;
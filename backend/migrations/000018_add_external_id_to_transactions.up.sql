@@ -0,0 +1,19 @@
+-- Migration: Add external_id to transactions
+-- Created: 2026-10-16
+-- Description: Keeps the bank identifier of imported statement entries (OFX FITID) so the same entry is not imported twice
+
+-- Add external_id column to transactions (nullable: only imported OFX/QIF entries have it)
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS external_id VARCHAR(255) NULL;
+
+-- An entry can be imported only once per account; deleted transactions (e.g. a rolled back
+-- import) do not count, so their entries can be imported again
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_account_external_id
+ON transactions(account_id, external_id)
+WHERE external_id IS NOT NULL AND deleted_at IS NULL;
+
+-- Allow OFX and QIF import batches
+ALTER TABLE import_batches
+ADD CONSTRAINT chk_import_batches_source CHECK (source IN ('CSV', 'OFX', 'QIF'));
+
+COMMENT ON COLUMN transactions.external_id IS 'Bank identifier of the imported statement entry (OFX FITID, or derived from the entry for QIF)';
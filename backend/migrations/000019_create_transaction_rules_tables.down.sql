@@ -0,0 +1,5 @@
+-- Rollback: Drop transaction_rule_tags and transaction_rules tables
+DROP TABLE IF EXISTS transaction_rule_tags;
+DROP TRIGGER IF EXISTS update_transaction_rules_updated_at ON transaction_rules;
+DROP INDEX IF EXISTS idx_transaction_rules_user_priority;
+DROP TABLE IF EXISTS transaction_rules;
@@ -0,0 +1,66 @@
+-- Migration: Create transaction_rules and transaction_rule_tags tables
+-- Created: 2026-10-16
+-- Description: Stores per-user auto-categorization rules that set the category, tags or description of matching transactions
+
+-- Create transaction_rules table
+CREATE TABLE IF NOT EXISTS transaction_rules (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Conditions (all that are set must hold)
+    description_match VARCHAR(20) NULL,
+    description_pattern VARCHAR(255) NULL,
+    min_amount BIGINT NULL,
+    max_amount BIGINT NULL,
+    account_id UUID NULL,
+    transaction_type VARCHAR(20) NULL,
+
+    -- Actions
+    category_id UUID NULL,
+    set_description VARCHAR(500) NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_transaction_rules_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    -- A rule limited to an account is meaningless without it
+    CONSTRAINT fk_transaction_rules_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_rules_category FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE SET NULL,
+    CONSTRAINT chk_transaction_rules_priority CHECK (priority >= 0),
+    CONSTRAINT chk_transaction_rules_description_match CHECK (description_match IS NULL OR description_match IN ('CONTAINS', 'STARTS_WITH', 'REGEX')),
+    CONSTRAINT chk_transaction_rules_transaction_type CHECK (transaction_type IS NULL OR transaction_type IN ('INCOME', 'EXPENSE')),
+    CONSTRAINT chk_transaction_rules_amounts CHECK (min_amount IS NULL OR max_amount IS NULL OR min_amount <= max_amount)
+);
+
+-- Create indexes (rules are always loaded per user, in the order they run)
+CREATE INDEX IF NOT EXISTS idx_transaction_rules_user_priority ON transaction_rules(user_id, priority, created_at);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_transaction_rules_updated_at
+    BEFORE UPDATE ON transaction_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create transaction_rule_tags table
+CREATE TABLE IF NOT EXISTS transaction_rule_tags (
+    rule_id UUID NOT NULL,
+    tag_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT pk_transaction_rule_tags PRIMARY KEY (rule_id, tag_id),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_transaction_rule_tags_rule_id FOREIGN KEY (rule_id) REFERENCES transaction_rules(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_rule_tags_tag_id FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- Add comments to tables
+COMMENT ON TABLE transaction_rules IS 'Auto-categorization rules; run in priority order on create, on import and on demand';
+COMMENT ON COLUMN transaction_rules.priority IS 'Rules with a lower priority run first';
+COMMENT ON COLUMN transaction_rules.min_amount IS 'Minimum amount in cents (inclusive)';
+COMMENT ON COLUMN transaction_rules.max_amount IS 'Maximum amount in cents (inclusive)';
+COMMENT ON COLUMN transaction_rules.set_description IS 'Cleaned-up description that replaces the original one';
+COMMENT ON TABLE transaction_rule_tags IS 'Tags added by transaction rules';
@@ -0,0 +1,5 @@
+-- Rollback: Remove full-text search on transaction descriptions
+DROP INDEX IF EXISTS idx_transactions_user_amount;
+DROP INDEX IF EXISTS idx_transactions_search_vector;
+ALTER TABLE transactions DROP COLUMN IF EXISTS search_vector;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS portuguese_unaccent;
@@ -0,0 +1,32 @@
+-- Migration: Add full-text search on transaction descriptions
+-- Created: 2026-10-16
+-- Description: Adds a Portuguese, accent-insensitive search vector on transaction descriptions and an index for amount range filters
+
+-- Text search configuration that removes accents before stemming, so "cafe" finds "Café"
+-- and "mercado" finds "Mercados"
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'portuguese_unaccent') THEN
+        CREATE TEXT SEARCH CONFIGURATION portuguese_unaccent (COPY = portuguese);
+        ALTER TEXT SEARCH CONFIGURATION portuguese_unaccent
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+    END IF;
+END
+$$;
+
+-- Search vector kept up to date by the database
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS search_vector tsvector
+GENERATED ALWAYS AS (to_tsvector('portuguese_unaccent'::regconfig, coalesce(description, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_search_vector
+ON transactions USING GIN (search_vector);
+
+-- Amount range filters
+CREATE INDEX IF NOT EXISTS idx_transactions_user_amount
+ON transactions(user_id, amount)
+WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN transactions.search_vector IS 'Full-text search vector of the description (portuguese_unaccent configuration)';
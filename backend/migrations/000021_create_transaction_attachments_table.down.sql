@@ -0,0 +1,4 @@
+-- Rollback: Drop transaction_attachments table
+DROP INDEX IF EXISTS idx_transaction_attachments_user_id;
+DROP INDEX IF EXISTS idx_transaction_attachments_transaction;
+DROP TABLE IF EXISTS transaction_attachments;
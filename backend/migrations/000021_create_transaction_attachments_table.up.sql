@@ -0,0 +1,31 @@
+-- Migration: Create transaction_attachments table
+-- Created: 2026-10-16
+-- Description: Stores the metadata of receipts and documents attached to transactions; the files are kept in the file storage
+
+-- Create transaction_attachments table
+CREATE TABLE IF NOT EXISTS transaction_attachments (
+    id UUID PRIMARY KEY,
+    transaction_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key VARCHAR(500) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_transaction_attachments_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_attachments_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_transaction_attachments_storage_key UNIQUE (storage_key),
+    CONSTRAINT chk_transaction_attachments_size CHECK (size_bytes > 0),
+    CONSTRAINT chk_transaction_attachments_content_type CHECK (content_type IN ('image/jpeg', 'image/png', 'image/gif', 'image/webp', 'application/pdf'))
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_transaction_attachments_transaction ON transaction_attachments(transaction_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_transaction_attachments_user_id ON transaction_attachments(user_id);
+
+-- Add comments to table
+COMMENT ON TABLE transaction_attachments IS 'Receipts and documents (images and PDFs) attached to transactions';
+COMMENT ON COLUMN transaction_attachments.file_name IS 'Original file name, without directories';
+COMMENT ON COLUMN transaction_attachments.content_type IS 'MIME type detected from the file content';
+COMMENT ON COLUMN transaction_attachments.storage_key IS 'Key of the file in the file storage (local directory or S3 bucket)';
@@ -0,0 +1,12 @@
+-- Rollback: Drop account_reconciliations table and transactions status columns
+DROP INDEX IF EXISTS idx_transactions_reconciliation_id;
+DROP INDEX IF EXISTS idx_transactions_account_status_date;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS fk_transactions_reconciliation_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_status;
+ALTER TABLE transactions DROP COLUMN IF EXISTS reconciliation_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS status;
+DROP TRIGGER IF EXISTS update_account_reconciliations_updated_at ON account_reconciliations;
+DROP INDEX IF EXISTS idx_account_reconciliations_in_progress;
+DROP INDEX IF EXISTS idx_account_reconciliations_user_id;
+DROP INDEX IF EXISTS idx_account_reconciliations_account_date;
+DROP TABLE IF EXISTS account_reconciliations;
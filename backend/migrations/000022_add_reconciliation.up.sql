@@ -0,0 +1,66 @@
+-- Migration: Add transaction status and account reconciliations
+-- Created: 2026-10-17
+-- Description: Tracks whether transactions were seen on the bank statement and records reconciliation sessions per account
+
+-- Create account_reconciliations table
+CREATE TABLE IF NOT EXISTS account_reconciliations (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    account_id UUID NOT NULL,
+    statement_date DATE NOT NULL,
+    statement_balance BIGINT NOT NULL, -- Closing balance in cents
+    currency VARCHAR(3) NOT NULL DEFAULT 'BRL', -- Currency code (ISO 4217), same as the account
+    status VARCHAR(20) NOT NULL,
+    reconciled_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL,
+    canceled_at TIMESTAMP NULL,
+    CONSTRAINT fk_account_reconciliations_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_account_reconciliations_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT chk_account_reconciliations_status CHECK (status IN ('IN_PROGRESS', 'COMPLETED', 'CANCELED')),
+    CONSTRAINT chk_account_reconciliations_currency CHECK (currency IN ('BRL', 'USD', 'EUR')),
+    CONSTRAINT chk_account_reconciliations_reconciled_count CHECK (reconciled_count >= 0)
+);
+
+-- Create indexes (an account has at most one reconciliation in progress)
+CREATE INDEX IF NOT EXISTS idx_account_reconciliations_account_date ON account_reconciliations(account_id, statement_date DESC);
+CREATE INDEX IF NOT EXISTS idx_account_reconciliations_user_id ON account_reconciliations(user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_account_reconciliations_in_progress
+ON account_reconciliations(account_id)
+WHERE status = 'IN_PROGRESS';
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_account_reconciliations_updated_at
+    BEFORE UPDATE ON account_reconciliations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Add status and reconciliation_id columns to transactions
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'PENDING';
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS reconciliation_id UUID NULL;
+
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_status CHECK (status IN ('PENDING', 'CLEARED', 'RECONCILED'));
+
+-- Add foreign key constraint for reconciliation_id
+ALTER TABLE transactions
+ADD CONSTRAINT fk_transactions_reconciliation_id
+FOREIGN KEY (reconciliation_id) REFERENCES account_reconciliations(id) ON DELETE SET NULL;
+
+-- Add indexes used to list the unreconciled transactions of an account
+CREATE INDEX IF NOT EXISTS idx_transactions_account_status_date ON transactions(account_id, status, date) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_reconciliation_id ON transactions(reconciliation_id) WHERE reconciliation_id IS NOT NULL;
+
+-- Entries imported from bank statements have already cleared the bank
+UPDATE transactions SET status = 'CLEARED' WHERE import_batch_id IS NOT NULL;
+
+COMMENT ON TABLE account_reconciliations IS 'Reconciliation sessions of accounts against bank statements';
+COMMENT ON COLUMN account_reconciliations.statement_date IS 'End date of the bank statement';
+COMMENT ON COLUMN account_reconciliations.statement_balance IS 'Closing balance of the bank statement in cents';
+COMMENT ON COLUMN account_reconciliations.reconciled_count IS 'Number of transactions reconciled when the session was completed';
+COMMENT ON COLUMN transactions.status IS 'PENDING (not seen on the statement), CLEARED (seen on the statement) or RECONCILED (locked by a completed reconciliation)';
+COMMENT ON COLUMN transactions.reconciliation_id IS 'Reconciliation that marked the transaction as reconciled';
@@ -0,0 +1,4 @@
+-- Rollback: Remove installment fields from transactions
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_installment;
+ALTER TABLE transactions DROP COLUMN IF EXISTS installment_count;
+ALTER TABLE transactions DROP COLUMN IF EXISTS installment_number;
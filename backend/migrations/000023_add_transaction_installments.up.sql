@@ -0,0 +1,23 @@
+-- Migration: Add installment fields to transactions
+-- Created: 2026-10-17
+-- Description: Supports credit card purchases paid in monthly installments ("3/10"); installments 2..N point to the first one through parent_transaction_id
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS installment_number SMALLINT NULL;
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS installment_count SMALLINT NULL;
+
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_installment CHECK (
+    (installment_number IS NULL AND installment_count IS NULL)
+    OR (
+        installment_count BETWEEN 2 AND 48
+        AND installment_number BETWEEN 1 AND installment_count
+    )
+);
+
+-- Installments are listed through the existing idx_transactions_parent_transaction_id index
+
+COMMENT ON COLUMN transactions.installment_number IS 'Position of the installment within the purchase, starting at 1 (NULL when not an installment)';
+COMMENT ON COLUMN transactions.installment_count IS 'Total number of installments of the purchase (NULL when not an installment)';
@@ -0,0 +1,4 @@
+-- Rollback: Remove recurring series management
+DROP TABLE IF EXISTS transaction_recurrence_amounts;
+DROP TABLE IF EXISTS transaction_recurrence_skips;
+ALTER TABLE transactions DROP COLUMN IF EXISTS recurrence_paused_at;
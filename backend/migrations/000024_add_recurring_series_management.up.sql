@@ -0,0 +1,32 @@
+-- Migration: Add recurring series management
+-- Created: 2026-10-17
+-- Description: Lets a recurring series be paused, have single occurrences skipped and its amount changed from an occurrence on
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS recurrence_paused_at TIMESTAMP NULL;
+
+-- Create transaction_recurrence_skips table (occurrences left out of a series)
+CREATE TABLE IF NOT EXISTS transaction_recurrence_skips (
+    transaction_id UUID NOT NULL,
+    date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (transaction_id, date),
+    CONSTRAINT fk_transaction_recurrence_skips_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE
+);
+
+-- Create transaction_recurrence_amounts table (amount schedule of a series)
+CREATE TABLE IF NOT EXISTS transaction_recurrence_amounts (
+    transaction_id UUID NOT NULL,
+    effective_date DATE NOT NULL,
+    amount BIGINT NOT NULL, -- Amount in cents
+    currency VARCHAR(3) NOT NULL DEFAULT 'BRL', -- Currency code (ISO 4217), same as the series
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (transaction_id, effective_date),
+    CONSTRAINT fk_transaction_recurrence_amounts_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT chk_transaction_recurrence_amounts_amount CHECK (amount > 0),
+    CONSTRAINT chk_transaction_recurrence_amounts_currency CHECK (currency IN ('BRL', 'USD', 'EUR'))
+);
+
+COMMENT ON COLUMN transactions.recurrence_paused_at IS 'When the recurring series was paused (NULL when active); no occurrence is generated while paused';
+COMMENT ON TABLE transaction_recurrence_skips IS 'Occurrences left out of a recurring series, keyed by the transaction that starts the series';
+COMMENT ON TABLE transaction_recurrence_amounts IS 'Amount of a recurring series from an occurrence on ("this and future occurrences")';
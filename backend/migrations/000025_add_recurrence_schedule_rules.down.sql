@@ -0,0 +1,5 @@
+-- Rollback: Remove recurrence schedule rules
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_recurrence_anchor;
+ALTER TABLE transactions DROP COLUMN IF EXISTS recurrence_generated_until;
+ALTER TABLE transactions DROP COLUMN IF EXISTS recurrence_business_days;
+ALTER TABLE transactions DROP COLUMN IF EXISTS recurrence_anchor;
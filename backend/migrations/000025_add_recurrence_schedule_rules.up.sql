@@ -0,0 +1,33 @@
+-- Migration: Add recurrence schedule rules
+-- Created: 2026-10-17
+-- Description: Adds day-of-month anchors and business day shifting to recurring series, and tracks how far each series was generated so missed occurrences are caught up
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS recurrence_anchor VARCHAR(20) NULL;
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS recurrence_business_days BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS recurrence_generated_until DATE NULL;
+
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_recurrence_anchor CHECK (
+    recurrence_anchor IS NULL
+    OR recurrence_anchor IN ('LAST_DAY_OF_MONTH', 'NTH_WEEKDAY', 'LAST_WEEKDAY')
+);
+
+-- Existing series were generated up to their latest instance (deleted ones included), or only
+-- the transaction itself; occurrences missed since then are caught up on the next run
+UPDATE transactions AS series
+SET recurrence_generated_until = COALESCE(
+    (SELECT MAX(instance.date) FROM transactions AS instance WHERE instance.parent_transaction_id = series.id),
+    series.date
+)
+WHERE series.is_recurring = TRUE
+  AND series.parent_transaction_id IS NULL
+  AND series.recurrence_generated_until IS NULL;
+
+COMMENT ON COLUMN transactions.recurrence_anchor IS 'Day of the month of monthly and yearly occurrences: LAST_DAY_OF_MONTH, NTH_WEEKDAY or LAST_WEEKDAY (NULL keeps the day of the month of the first occurrence)';
+COMMENT ON COLUMN transactions.recurrence_business_days IS 'Whether occurrences falling on weekends or Brazilian national holidays move to the next business day';
+COMMENT ON COLUMN transactions.recurrence_generated_until IS 'Last day up to which the occurrences of the series were generated';
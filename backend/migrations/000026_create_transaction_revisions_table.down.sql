@@ -0,0 +1,4 @@
+-- Rollback: Drop transaction_revisions table
+DROP INDEX IF EXISTS idx_transaction_revisions_user_id;
+DROP INDEX IF EXISTS idx_transaction_revisions_transaction;
+DROP TABLE IF EXISTS transaction_revisions;
@@ -0,0 +1,34 @@
+-- Migration: Create transaction_revisions table
+-- Created: 2026-10-17
+-- Description: Stores the edit history of transactions as immutable revisions with the state before and after each change
+
+-- Create transaction_revisions table
+CREATE TABLE IF NOT EXISTS transaction_revisions (
+    id UUID PRIMARY KEY,
+    transaction_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    actor_id UUID NULL,
+    request_id VARCHAR(100) NULL,
+    action VARCHAR(20) NOT NULL,
+    before JSONB NULL,
+    after JSONB NULL,
+    reverted_revision_id UUID NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_transaction_revisions_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_revisions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_revisions_reverted FOREIGN KEY (reverted_revision_id) REFERENCES transaction_revisions(id) ON DELETE SET NULL,
+    CONSTRAINT chk_transaction_revisions_action CHECK (action IN ('CREATE', 'UPDATE', 'DELETE', 'RESTORE', 'REVERT'))
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_transaction_revisions_transaction ON transaction_revisions(transaction_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_transaction_revisions_user_id ON transaction_revisions(user_id);
+
+-- Add comments to table
+COMMENT ON TABLE transaction_revisions IS 'Immutable edit history of transactions: one row per create, update, delete, restore or revert';
+COMMENT ON COLUMN transaction_revisions.actor_id IS 'User who made the change; NULL for changes made by the system';
+COMMENT ON COLUMN transaction_revisions.request_id IS 'X-Request-ID of the HTTP request that made the change';
+COMMENT ON COLUMN transaction_revisions.before IS 'State of the transaction before the change; NULL for CREATE and RESTORE';
+COMMENT ON COLUMN transaction_revisions.after IS 'State of the transaction after the change; NULL for DELETE';
+COMMENT ON COLUMN transaction_revisions.reverted_revision_id IS 'Revision a REVERT brought the transaction back to';
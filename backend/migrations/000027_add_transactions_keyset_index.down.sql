@@ -0,0 +1,2 @@
+-- Rollback: Drop keyset pagination index on transactions
+DROP INDEX IF EXISTS idx_transactions_user_keyset;
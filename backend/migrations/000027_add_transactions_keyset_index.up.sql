@@ -0,0 +1,11 @@
+-- Migration: Add keyset pagination index on transactions
+-- Created: 2026-10-17
+-- Description: Adds an index matching the cursor pagination order of the transaction list (date, created_at, id),
+-- so each page starts at the cursor position instead of scanning the skipped rows
+
+-- Partial index on live transactions, in the default (newest first) order; ascending lists read it backward
+CREATE INDEX IF NOT EXISTS idx_transactions_user_keyset
+    ON transactions(user_id, date DESC, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+COMMENT ON INDEX idx_transactions_user_keyset IS 'Serves cursor (keyset) pagination of the transaction list by (date, created_at, id)';
@@ -0,0 +1,8 @@
+-- Rollback: Drop payees and payee_aliases tables
+DROP INDEX IF EXISTS idx_transactions_payee_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS fk_transactions_payee_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS payee_id;
+DROP TABLE IF EXISTS payee_aliases;
+DROP TRIGGER IF EXISTS update_payees_updated_at ON payees;
+DROP INDEX IF EXISTS idx_payees_user_name;
+DROP TABLE IF EXISTS payees;
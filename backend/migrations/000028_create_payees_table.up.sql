@@ -0,0 +1,55 @@
+-- Migration: Create payees and payee_aliases tables
+-- Created: 2026-10-17
+-- Description: Creates payees (merchants and counterparties) for the Payee context, their aliases
+-- used to match bank descriptions, and the payee of each transaction
+
+-- Create payees table
+CREATE TABLE IF NOT EXISTS payees (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    default_category_id UUID NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_payees_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_payees_default_category FOREIGN KEY (default_category_id) REFERENCES categories(id) ON DELETE SET NULL
+);
+
+-- Create indexes (payee names are unique per user, case-insensitive)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payees_user_name ON payees(user_id, LOWER(name));
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_payees_updated_at
+    BEFORE UPDATE ON payees
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create payee_aliases table
+CREATE TABLE IF NOT EXISTS payee_aliases (
+    payee_id UUID NOT NULL,
+    alias VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT pk_payee_aliases PRIMARY KEY (payee_id, alias),
+    CONSTRAINT fk_payee_aliases_payee_id FOREIGN KEY (payee_id) REFERENCES payees(id) ON DELETE CASCADE
+);
+
+-- Add payee_id column to transactions (nullable: most transactions have no payee)
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS payee_id UUID NULL;
+
+ALTER TABLE transactions
+ADD CONSTRAINT fk_transactions_payee_id
+FOREIGN KEY (payee_id) REFERENCES payees(id) ON DELETE SET NULL;
+
+-- Add index for payee filters and the top payees report
+CREATE INDEX IF NOT EXISTS idx_transactions_payee_id ON transactions(payee_id) WHERE payee_id IS NOT NULL;
+
+-- Add comments to tables
+COMMENT ON TABLE payees IS 'Merchants and counterparties of the user, matched to transactions by their bank description';
+COMMENT ON COLUMN payees.user_id IS 'User who owns this payee (foreign key to users.id)';
+COMMENT ON COLUMN payees.name IS 'Payee name (e.g., iFood), unique per user ignoring case';
+COMMENT ON COLUMN payees.default_category_id IS 'Category given to new transactions of the payee that have no category';
+COMMENT ON TABLE payee_aliases IS 'Normalized description fragments that identify a payee (e.g., ifood, ifd restaurante)';
+COMMENT ON COLUMN payee_aliases.alias IS 'Alias in normalized form: lowercase words without accents, digits or payment processor prefixes';
+COMMENT ON COLUMN transactions.payee_id IS 'Optional payee the transaction was made with';
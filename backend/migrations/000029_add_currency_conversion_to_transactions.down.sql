@@ -0,0 +1,8 @@
+-- Rollback: Remove foreign currency conversion from transactions table
+DROP INDEX IF EXISTS idx_transactions_fee_for_transaction_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS fk_transactions_fee_for_transaction_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS fee_for_transaction_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_currency_conversion;
+ALTER TABLE transactions DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE transactions DROP COLUMN IF EXISTS original_currency;
+ALTER TABLE transactions DROP COLUMN IF EXISTS original_amount;
@@ -0,0 +1,32 @@
+-- Migration: Add foreign currency conversion to transactions table
+-- Created: 2026-10-17
+-- Description: Records the original amount, currency and exchange rate of transactions made in a currency other than the account currency, and links fees such as IOF to the purchase they were charged for
+
+-- Add original amount columns (nullable: most transactions are made in the account currency)
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS original_amount BIGINT NULL,
+ADD COLUMN IF NOT EXISTS original_currency VARCHAR(3) NULL,
+ADD COLUMN IF NOT EXISTS exchange_rate DECIMAL(18,8) NULL;
+
+-- The original amount, currency and exchange rate are set together
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_currency_conversion CHECK (
+    (original_amount IS NULL AND original_currency IS NULL AND exchange_rate IS NULL)
+    OR (original_amount > 0 AND original_currency IS NOT NULL AND original_currency <> currency AND exchange_rate > 0)
+);
+
+-- Add fee_for_transaction_id column (purchase a fee was charged for)
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS fee_for_transaction_id UUID NULL;
+
+ALTER TABLE transactions
+ADD CONSTRAINT fk_transactions_fee_for_transaction_id
+FOREIGN KEY (fee_for_transaction_id) REFERENCES transactions(id) ON DELETE SET NULL;
+
+-- Add index for fee_for_transaction_id
+CREATE INDEX IF NOT EXISTS idx_transactions_fee_for_transaction_id ON transactions(fee_for_transaction_id) WHERE fee_for_transaction_id IS NOT NULL;
+
+COMMENT ON COLUMN transactions.original_amount IS 'Amount in cents in the foreign currency the transaction was made in (amount holds the converted amount in the account currency)';
+COMMENT ON COLUMN transactions.original_currency IS 'Foreign currency code of the original amount (e.g., USD)';
+COMMENT ON COLUMN transactions.exchange_rate IS 'Account currency units paid per unit of the original currency';
+COMMENT ON COLUMN transactions.fee_for_transaction_id IS 'Purchase a fee was charged for, e.g. the IOF of a foreign purchase (NULL for other transactions)';
@@ -0,0 +1,3 @@
+-- Rollback: Drop account_balance_snapshots table
+DROP INDEX IF EXISTS idx_account_balance_snapshots_user_id;
+DROP TABLE IF EXISTS account_balance_snapshots;
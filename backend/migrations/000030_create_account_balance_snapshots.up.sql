@@ -0,0 +1,28 @@
+-- Migration: Create account_balance_snapshots table
+-- Created: 2026-10-17
+-- Description: Stores the end-of-day balance of accounts at the end of past months, so the balance
+-- history report does not need to replay every transaction since the account was opened
+
+-- Create account_balance_snapshots table
+CREATE TABLE IF NOT EXISTS account_balance_snapshots (
+    account_id UUID NOT NULL,
+    snapshot_date DATE NOT NULL,
+    user_id UUID NOT NULL,
+    balance BIGINT NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    transactions_total BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT pk_account_balance_snapshots PRIMARY KEY (account_id, snapshot_date),
+    CONSTRAINT fk_account_balance_snapshots_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_account_balance_snapshots_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_account_balance_snapshots_user_id ON account_balance_snapshots(user_id);
+
+-- Add comments to table
+COMMENT ON TABLE account_balance_snapshots IS 'End-of-day account balances used as starting points of the balance history report';
+COMMENT ON COLUMN account_balance_snapshots.snapshot_date IS 'Day the balance was taken at (after all transactions of that day)';
+COMMENT ON COLUMN account_balance_snapshots.balance IS 'Account balance in cents at the end of snapshot_date';
+COMMENT ON COLUMN account_balance_snapshots.transactions_total IS 'Signed sum in cents of the account transactions up to snapshot_date when the snapshot was taken; a different sum means a transaction was added, changed or deleted since and the snapshot is outdated';
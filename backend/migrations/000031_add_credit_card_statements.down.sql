@@ -0,0 +1,7 @@
+-- Rollback: Drop credit_card_statements table and remove credit card terms from accounts
+DROP INDEX IF EXISTS idx_credit_card_statements_user_id;
+DROP TABLE IF EXISTS credit_card_statements;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS chk_accounts_credit_card_terms;
+ALTER TABLE accounts DROP COLUMN IF EXISTS due_day;
+ALTER TABLE accounts DROP COLUMN IF EXISTS closing_day;
+ALTER TABLE accounts DROP COLUMN IF EXISTS credit_limit;
@@ -0,0 +1,51 @@
+-- Migration: Add credit card terms to accounts and create credit_card_statements table
+-- Created: 2026-10-17
+-- Description: Gives credit card accounts a credit limit, closing day and due day, and stores the
+-- payments and late payment notices of their statements (totals are derived from the transactions)
+
+-- Add credit card terms columns (nullable: only credit card accounts have them)
+ALTER TABLE accounts
+ADD COLUMN IF NOT EXISTS credit_limit BIGINT NULL,
+ADD COLUMN IF NOT EXISTS closing_day SMALLINT NULL,
+ADD COLUMN IF NOT EXISTS due_day SMALLINT NULL;
+
+-- The credit limit, closing day and due day are set together, on credit card accounts only
+ALTER TABLE accounts
+ADD CONSTRAINT chk_accounts_credit_card_terms CHECK (
+    (credit_limit IS NULL AND closing_day IS NULL AND due_day IS NULL)
+    OR (type = 'CREDIT_CARD' AND credit_limit > 0 AND closing_day BETWEEN 1 AND 31 AND due_day BETWEEN 1 AND 31)
+);
+
+COMMENT ON COLUMN accounts.credit_limit IS 'Credit limit in cents of a credit card account (NULL for other accounts)';
+COMMENT ON COLUMN accounts.closing_day IS 'Day of the month the statement of a credit card closes (29 to 31 mean the last day in shorter months)';
+COMMENT ON COLUMN accounts.due_day IS 'Day of the month the statement of a credit card is due (same month if after closing_day, else next month)';
+
+-- Create credit_card_statements table
+CREATE TABLE IF NOT EXISTS credit_card_statements (
+    account_id UUID NOT NULL,
+    closing_date DATE NOT NULL,
+    user_id UUID NOT NULL,
+    period_start DATE NOT NULL,
+    due_date DATE NOT NULL,
+    paid_amount BIGINT NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL,
+    paid_at DATE NULL,
+    late_notified_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT pk_credit_card_statements PRIMARY KEY (account_id, closing_date),
+    CONSTRAINT fk_credit_card_statements_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_credit_card_statements_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_credit_card_statements_paid_amount CHECK (paid_amount >= 0)
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_credit_card_statements_user_id ON credit_card_statements(user_id);
+
+-- Add comments to table
+COMMENT ON TABLE credit_card_statements IS 'Payments and late payment notices of credit card statements (faturas); totals are derived from the transactions of each cycle';
+COMMENT ON COLUMN credit_card_statements.closing_date IS 'Last day of the billing cycle, which identifies the statement';
+COMMENT ON COLUMN credit_card_statements.paid_amount IS 'Amount in cents paid towards the statement';
+COMMENT ON COLUMN credit_card_statements.paid_at IS 'Date of the last payment (NULL if not paid)';
+COMMENT ON COLUMN credit_card_statements.late_notified_at IS 'When the user was notified that the statement is overdue (NULL if not notified)';
@@ -0,0 +1,5 @@
+-- Rollback: Remove balance limits from accounts
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS chk_accounts_balance_limit_mode;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS chk_accounts_overdraft_limit;
+ALTER TABLE accounts DROP COLUMN IF EXISTS balance_limit_mode;
+ALTER TABLE accounts DROP COLUMN IF EXISTS overdraft_limit;
@@ -0,0 +1,21 @@
+-- Migration: Add balance limits to accounts
+-- Created: 2026-10-17
+-- Description: Gives bank accounts an overdraft limit (cheque especial) and lets every account choose
+-- whether debits beyond its balance floor are rejected (ENFORCE) or only warned about (WARN)
+
+-- Add balance limits columns (existing accounts keep no overdraft and strict enforcement)
+ALTER TABLE accounts
+ADD COLUMN IF NOT EXISTS overdraft_limit BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS balance_limit_mode VARCHAR(10) NOT NULL DEFAULT 'ENFORCE';
+
+-- Only bank accounts have an overdraft limit
+ALTER TABLE accounts
+ADD CONSTRAINT chk_accounts_overdraft_limit CHECK (
+    overdraft_limit = 0 OR (type = 'BANK' AND overdraft_limit > 0)
+);
+
+ALTER TABLE accounts
+ADD CONSTRAINT chk_accounts_balance_limit_mode CHECK (balance_limit_mode IN ('ENFORCE', 'WARN'));
+
+COMMENT ON COLUMN accounts.overdraft_limit IS 'Overdraft limit in cents of a bank account: how far below zero its balance can go (0 for no overdraft)';
+COMMENT ON COLUMN accounts.balance_limit_mode IS 'ENFORCE rejects debits beyond the balance floor (zero, the overdraft limit or the credit limit); WARN accepts them and notifies the user';
@@ -0,0 +1,10 @@
+-- Rollback: Remove opening balances and balance adjustments
+-- Opening balances are part of the account balance again, so their adjustments are removed. Other
+-- adjustments (manual corrections and write-offs) are kept as income and expenses, so the balance
+-- still equals the sum of the transactions.
+DELETE FROM transactions WHERE type IN ('ADJUSTMENT_IN', 'ADJUSTMENT_OUT') AND description = 'Opening balance';
+UPDATE transactions SET type = 'INCOME' WHERE type = 'ADJUSTMENT_IN';
+UPDATE transactions SET type = 'EXPENSE' WHERE type = 'ADJUSTMENT_OUT';
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_type;
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_type CHECK (type IN ('INCOME', 'EXPENSE', 'TRANSFER_OUT', 'TRANSFER_IN'));
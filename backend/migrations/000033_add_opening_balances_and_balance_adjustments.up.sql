@@ -0,0 +1,35 @@
+-- Migration: Add opening balances and balance adjustments
+-- Created: 2026-10-17
+-- Description: Adds ADJUSTMENT_IN/ADJUSTMENT_OUT transaction types for manual balance corrections and
+-- records the opening balance of each account in the ledger as an adjustment, so an account balance
+-- always equals the sum of its transactions
+
+-- Allow balance adjustments as transaction types
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_type;
+ALTER TABLE transactions
+ADD CONSTRAINT chk_transactions_type CHECK (type IN ('INCOME', 'EXPENSE', 'TRANSFER_OUT', 'TRANSFER_IN', 'ADJUSTMENT_IN', 'ADJUSTMENT_OUT'));
+
+-- Existing accounts get an 'Opening balance' adjustment for the part of their balance not explained by
+-- their transactions, dated no later than their first transaction so the balance history starts from zero
+INSERT INTO transactions (id, user_id, account_id, type, amount, currency, description, date)
+SELECT
+    gen_random_uuid(),
+    openings.user_id,
+    openings.account_id,
+    CASE WHEN openings.amount > 0 THEN 'ADJUSTMENT_IN' ELSE 'ADJUSTMENT_OUT' END,
+    ABS(openings.amount),
+    openings.currency,
+    'Opening balance',
+    openings.date
+FROM (
+    SELECT
+        accounts.id AS account_id,
+        accounts.user_id,
+        accounts.currency,
+        accounts.balance - COALESCE(SUM(CASE WHEN t.type IN ('INCOME', 'TRANSFER_IN') THEN t.amount ELSE -t.amount END), 0) AS amount,
+        LEAST(accounts.created_at::date, COALESCE(MIN(t.date), accounts.created_at::date)) AS date
+    FROM accounts
+    LEFT JOIN transactions t ON t.account_id = accounts.id AND t.deleted_at IS NULL
+    GROUP BY accounts.id, accounts.user_id, accounts.currency, accounts.balance, accounts.created_at
+) openings
+WHERE openings.amount <> 0;
@@ -35,6 +35,12 @@ type Config struct {
 
 	// Observability
 	Observability ObservabilityConfig `json:"observability"`
+
+	// Storage
+	Storage StorageConfig `json:"storage"`
+
+	// Pagination
+	Pagination PaginationConfig `json:"pagination"`
 }
 
 // ServerConfig holds server configuration
@@ -67,6 +73,11 @@ type JWTConfig struct {
 	SigningMethod string        `json:"signing_method"`
 }
 
+// PaginationConfig holds pagination configuration
+type PaginationConfig struct {
+	CursorSecret string `json:"-"` // Key that signs pagination cursors (defaults to the JWT secret)
+}
+
 // RedisConfig holds Redis configuration
 type RedisConfig struct {
 	URL     string        `json:"url"`
@@ -103,6 +114,22 @@ type TracingConfig struct {
 	JaegerURL   string `json:"jaeger_url"`
 }
 
+// StorageConfig holds file storage configuration (transaction attachments)
+type StorageConfig struct {
+	Driver    string          `json:"driver"` // local, s3
+	LocalPath string          `json:"local_path"`
+	S3        S3StorageConfig `json:"s3"`
+}
+
+// S3StorageConfig holds the configuration of an S3-compatible object storage
+type S3StorageConfig struct {
+	Endpoint        string `json:"endpoint"`
+	Region          string `json:"region"`
+	Bucket          string `json:"bucket"`
+	AccessKeyID     string `json:"-"`
+	SecretAccessKey string `json:"-"`
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	cfg := &Config{
@@ -154,6 +181,20 @@ func Load() (*Config, error) {
 				JaegerURL:   getEnv("JAEGER_URL", "http://localhost:14268/api/traces"),
 			},
 		},
+		Storage: StorageConfig{
+			Driver:    getEnv("STORAGE_DRIVER", "local"),
+			LocalPath: getEnv("STORAGE_LOCAL_PATH", "./storage"),
+			S3: S3StorageConfig{
+				Endpoint:        getEnv("STORAGE_S3_ENDPOINT", ""),
+				Region:          getEnv("STORAGE_S3_REGION", "us-east-1"),
+				Bucket:          getEnv("STORAGE_S3_BUCKET", ""),
+				AccessKeyID:     getEnv("STORAGE_S3_ACCESS_KEY_ID", ""),
+				SecretAccessKey: getEnv("STORAGE_S3_SECRET_ACCESS_KEY", ""),
+			},
+		},
+		Pagination: PaginationConfig{
+			CursorSecret: getEnv("PAGINATION_CURSOR_SECRET", getEnv("JWT_SECRET", "your-secret-key-change-in-production")),
+		},
 	}
 
 	// Validate configuration
@@ -205,6 +246,15 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid log format: %s (must be one of: %v)", c.Logging.Format, validFormats)
 	}
 
+	// Validate storage configuration (empty driver means local)
+	validDrivers := []string{"local", "s3"}
+	if c.Storage.Driver != "" && !contains(validDrivers, strings.ToLower(c.Storage.Driver)) {
+		return fmt.Errorf("invalid storage driver: %s (must be one of: %v)", c.Storage.Driver, validDrivers)
+	}
+	if strings.ToLower(c.Storage.Driver) == "s3" && (c.Storage.S3.Endpoint == "" || c.Storage.S3.Bucket == "") {
+		return fmt.Errorf("S3 storage endpoint and bucket are required")
+	}
+
 	return nil
 }
 
@@ -232,6 +232,45 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid storage driver",
+			config: &Config{
+				Environment: "dev",
+				Database: DatabaseConfig{
+					Host:   "localhost",
+					User:   "postgres",
+					DBName: "testdb",
+				},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "console",
+				},
+				Storage: StorageConfig{
+					Driver: "ftp",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3 storage without bucket",
+			config: &Config{
+				Environment: "dev",
+				Database: DatabaseConfig{
+					Host:   "localhost",
+					User:   "postgres",
+					DBName: "testdb",
+				},
+				Logging: LoggingConfig{
+					Level:  "info",
+					Format: "console",
+				},
+				Storage: StorageConfig{
+					Driver: "s3",
+					S3:     S3StorageConfig{Endpoint: "http://localhost:9000"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
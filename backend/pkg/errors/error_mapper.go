@@ -1,10 +1,19 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
 	"strings"
 )
 
+// DetailedError is implemented by domain errors that carry structured details about a broken
+// business rule (e.g. the limit an account debit ran into). Domain packages cannot import this
+// package, so they implement the interface instead of returning an AppError.
+type DetailedError interface {
+	error
+	ErrorDetails() map[string]interface{}
+}
+
 // MapDomainError maps domain errors to AppError based on error message patterns.
 // This is a transitional helper to migrate from string matching to typed errors.
 // Eventually, all domain errors should return AppError directly.
@@ -18,6 +27,15 @@ func MapDomainError(err error) *AppError {
 		return appErr
 	}
 
+	// Business rule errors with details, matched before the message so that user-provided
+	// text in it (e.g. an account name) cannot change the classification
+	var detailedErr DetailedError
+	if stderrors.As(err, &detailedErr) {
+		appErr := NewDomainError(err.Error(), err)
+		appErr.Details = detailedErr.ErrorDetails()
+		return appErr
+	}
+
 	errMsg := strings.ToLower(err.Error())
 
 	// Validation errors
@@ -75,7 +93,8 @@ func MapDomainError(err error) *AppError {
 	// Domain/business logic errors
 	if strings.Contains(errMsg, "failed to") ||
 		strings.Contains(errMsg, "unable to") ||
-		strings.Contains(errMsg, "cannot") {
+		strings.Contains(errMsg, "cannot") ||
+		strings.Contains(errMsg, "insufficient") {
 		return NewDomainError(err.Error(), err)
 	}
 
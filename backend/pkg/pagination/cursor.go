@@ -0,0 +1,152 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Cursor is a position in a list paginated by keyset: the sort key of the item at the edge of
+// a page and whether the next request continues after it (next page) or before it (previous page).
+// Unlike offsets, cursors keep their place when items are added or removed while a user scrolls.
+type Cursor struct {
+	Key      []string `json:"k"`           // Sort key values of the edge item, in sort order
+	Backward bool     `json:"b,omitempty"` // Items before Key instead of after it
+	Scope    string   `json:"s"`           // List and sort order the cursor was issued for
+}
+
+// CursorSigner encodes cursors as opaque tokens signed with HMAC-SHA256, so that clients
+// can neither read nor forge positions, and rejects cursors issued for another list or order.
+type CursorSigner struct {
+	key []byte
+}
+
+// NewCursorSigner creates a CursorSigner with the given secret.
+func NewCursorSigner(secret string) *CursorSigner {
+	return &CursorSigner{key: []byte(secret)}
+}
+
+// Encode returns the opaque token of a cursor.
+func (s *CursorSigner) Encode(cursor Cursor) string {
+	payload, _ := json.Marshal(cursor) // Strings and a bool always marshal
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded))
+}
+
+// Decode verifies a token and returns its cursor. The cursor must have been issued for scope.
+func (s *CursorSigner) Decode(token string, scope string) (Cursor, error) {
+	var cursor Cursor
+
+	encoded, signature, found := strings.Cut(token, ".")
+	if !found {
+		return cursor, errors.New("invalid cursor: malformed token")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.sign(encoded)) {
+		return cursor, errors.New("invalid cursor: signature does not match")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, errors.New("invalid cursor: malformed token")
+	}
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return cursor, errors.New("invalid cursor: malformed token")
+	}
+	if cursor.Scope != scope {
+		return cursor, errors.New("invalid cursor: it was issued for another list or sort order")
+	}
+
+	return cursor, nil
+}
+
+func (s *CursorSigner) sign(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// CursorResult represents cursor pagination metadata in response.
+type CursorResult struct {
+	Limit      int          `json:"limit"`
+	HasNext    bool         `json:"has_next"`
+	HasPrev    bool         `json:"has_prev"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+	PrevCursor string       `json:"prev_cursor,omitempty"`
+	Links      *CursorLinks `json:"links,omitempty"`
+}
+
+// CursorLinks are the URLs of the next and previous pages.
+type CursorLinks struct {
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// SetLinks builds the next and previous page links from the request path and query,
+// replacing the cursor and dropping offset pagination parameters.
+func (r *CursorResult) SetLinks(path string, query url.Values) {
+	link := func(cursor string) string {
+		values := url.Values{}
+		for name, value := range query {
+			values[name] = slices.Clone(value)
+		}
+		values.Del("page")
+		values.Set("cursor", cursor)
+		values.Set("limit", strconv.Itoa(r.Limit))
+		return path + "?" + values.Encode()
+	}
+
+	links := &CursorLinks{}
+	if r.NextCursor != "" {
+		links.Next = link(r.NextCursor)
+	}
+	if r.PrevCursor != "" {
+		links.Prev = link(r.PrevCursor)
+	}
+	r.Links = links
+}
+
+// BuildCursorPage turns the items fetched for a keyset page into the page and its metadata.
+// Repositories fetch limit+1 items, so that the extra item tells whether more items follow,
+// and return the items of a backward page nearest first, which are put back in list order here.
+// cursor is the decoded request cursor (nil for the first page) and key returns the sort key of an item.
+func BuildCursorPage[T any](
+	signer *CursorSigner,
+	scope string,
+	items []T,
+	limit int,
+	cursor *Cursor,
+	key func(T) []string,
+) ([]T, CursorResult) {
+	more := len(items) > limit
+	if more {
+		items = items[:limit]
+	}
+
+	result := CursorResult{Limit: limit}
+	if cursor != nil && cursor.Backward {
+		slices.Reverse(items)
+		result.HasPrev = more
+		result.HasNext = true
+	} else {
+		result.HasNext = more
+		result.HasPrev = cursor != nil
+	}
+
+	if len(items) > 0 {
+		if result.HasNext {
+			result.NextCursor = signer.Encode(Cursor{Key: key(items[len(items)-1]), Scope: scope})
+		}
+		if result.HasPrev {
+			result.PrevCursor = signer.Encode(Cursor{Key: key(items[0]), Backward: true, Scope: scope})
+		}
+	}
+
+	return items, result
+}
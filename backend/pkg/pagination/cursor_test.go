@@ -0,0 +1,94 @@
+package pagination
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorSigner(t *testing.T) {
+	signer := NewCursorSigner("secret")
+	token := signer.Encode(Cursor{Key: []string{"2026-10-05", "abc"}, Backward: true, Scope: "items:desc"})
+
+	t.Run("round trip", func(t *testing.T) {
+		cursor, err := signer.Decode(token, "items:desc")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2026-10-05", "abc"}, cursor.Key)
+		assert.True(t, cursor.Backward)
+	})
+
+	t.Run("opaque", func(t *testing.T) {
+		assert.NotContains(t, token, "2026-10-05")
+	})
+
+	tests := map[string]struct {
+		signer *CursorSigner
+		token  string
+		scope  string
+	}{
+		"another scope":    {signer, token, "items:asc"},
+		"another secret":   {NewCursorSigner("other"), token, "items:desc"},
+		"tampered payload": {signer, "x" + token, "items:desc"},
+		"no signature":     {signer, strings.Split(token, ".")[0], "items:desc"},
+		"garbage":          {signer, "not a cursor", "items:desc"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.signer.Decode(tt.token, tt.scope)
+			assert.ErrorContains(t, err, "invalid cursor")
+		})
+	}
+}
+
+func TestBuildCursorPage(t *testing.T) {
+	signer := NewCursorSigner("secret")
+	key := func(item int) []string { return []string{strconv.Itoa(item)} }
+	decode := func(token string) Cursor {
+		cursor, err := signer.Decode(token, "numbers")
+		require.NoError(t, err)
+		return cursor
+	}
+
+	t.Run("first page", func(t *testing.T) {
+		items, result := BuildCursorPage(signer, "numbers", []int{1, 2, 3}, 2, nil, key)
+		assert.Equal(t, []int{1, 2}, items)
+		assert.True(t, result.HasNext)
+		assert.False(t, result.HasPrev)
+		assert.Equal(t, Cursor{Key: []string{"2"}, Scope: "numbers"}, decode(result.NextCursor))
+		assert.Empty(t, result.PrevCursor)
+	})
+
+	t.Run("last page going forward", func(t *testing.T) {
+		items, result := BuildCursorPage(signer, "numbers", []int{5}, 2, &Cursor{Key: []string{"4"}}, key)
+		assert.Equal(t, []int{5}, items)
+		assert.False(t, result.HasNext)
+		assert.True(t, result.HasPrev)
+		assert.Equal(t, Cursor{Key: []string{"5"}, Backward: true, Scope: "numbers"}, decode(result.PrevCursor))
+	})
+
+	t.Run("backward page is put back in list order", func(t *testing.T) {
+		// Items before 5, nearest first, with one extra
+		items, result := BuildCursorPage(signer, "numbers", []int{4, 3, 2}, 2, &Cursor{Key: []string{"5"}, Backward: true}, key)
+		assert.Equal(t, []int{3, 4}, items)
+		assert.True(t, result.HasNext)
+		assert.True(t, result.HasPrev)
+		assert.Equal(t, []string{"4"}, decode(result.NextCursor).Key)
+		assert.Equal(t, []string{"3"}, decode(result.PrevCursor).Key)
+	})
+}
+
+func TestCursorResult_SetLinks(t *testing.T) {
+	result := CursorResult{Limit: 20, NextCursor: "next-token"}
+	query := url.Values{"type": {"EXPENSE"}, "page": {"2"}, "cursor": {"old"}}
+
+	result.SetLinks("/api/v1/transactions", query)
+
+	require.NotNil(t, result.Links)
+	assert.Equal(t, "/api/v1/transactions?cursor=next-token&limit=20&type=EXPENSE", result.Links.Next)
+	assert.Empty(t, result.Links.Prev)
+	assert.Equal(t, []string{"old"}, query["cursor"], "the request query is not modified")
+}
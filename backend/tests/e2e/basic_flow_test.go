@@ -20,6 +20,7 @@ import (
 	identityhandlers "gestao-financeira/backend/internal/identity/presentation/handlers"
 	identityroutes "gestao-financeira/backend/internal/identity/presentation/routes"
 	"gestao-financeira/backend/internal/shared/infrastructure/eventbus"
+	sharedpersistence "gestao-financeira/backend/internal/shared/infrastructure/persistence"
 	transactionusecases "gestao-financeira/backend/internal/transaction/application/usecases"
 	transactionpersistence "gestao-financeira/backend/internal/transaction/infrastructure/persistence"
 	transactionhandlers "gestao-financeira/backend/internal/transaction/presentation/handlers"
@@ -51,13 +52,12 @@ func setupTestApp(t *testing.T) (*fiber.App, *gorm.DB) {
 
 	// Initialize repositories
 	userRepo := identitypersistence.NewGormUserRepository(db)
-	accountRepo := accountpersistence.NewGormAccountRepository(db)
 	transactionRepo := transactionpersistence.NewGormTransactionRepository(db)
 
 	// Initialize use cases
 	registerUserUseCase := identityusecases.NewRegisterUserUseCase(userRepo, eventBus)
 	loginUseCase := identityusecases.NewLoginUseCase(userRepo, jwtService)
-	createAccountUseCase := accountusecases.NewCreateAccountUseCase(accountRepo, eventBus)
+	createAccountUseCase := accountusecases.NewCreateAccountUseCase(sharedpersistence.NewGormUnitOfWork(db), eventBus)
 	createTransactionUseCase := transactionusecases.NewCreateTransactionUseCase(
 		transactionRepo,
 		eventBus,
@@ -65,11 +65,9 @@ func setupTestApp(t *testing.T) (*fiber.App, *gorm.DB) {
 
 	// Initialize handlers
 	authHandler := identityhandlers.NewAuthHandler(registerUserUseCase, loginUseCase)
-	accountHandler := accounthandlers.NewAccountHandler(
-		createAccountUseCase,
-		nil, // listAccountsUseCase not needed for this test
-		nil, // getAccountUseCase not needed for this test
-	)
+	accountHandler := accounthandlers.NewAccountHandler(accounthandlers.AccountHandlerDeps{
+		CreateAccountUseCase: createAccountUseCase,
+	})
 	transactionHandler := transactionhandlers.NewTransactionHandler(
 		createTransactionUseCase,
 		nil, // listTransactionsUseCase not needed for this test